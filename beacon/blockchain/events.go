@@ -0,0 +1,130 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain
+
+import (
+	"context"
+
+	engineerrors "github.com/berachain/beacon-kit/engine-primitives/errors"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/async"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/transition"
+)
+
+// publishHead publishes the NewHead and FinalizedCheckpoint events for a
+// processed block.
+func (s *Service[
+	_, _, BeaconBlockT, _, _, _, _, _, _, _, _,
+]) publishHead(ctx context.Context, blk BeaconBlockT) error {
+	root := blk.HashTreeRoot()
+	if err := s.dispatcher.Publish(
+		async.NewEvent(ctx, async.NewHead, async.Head{
+			Slot:      blk.GetSlot(),
+			BlockRoot: root,
+			StateRoot: blk.GetStateRoot(),
+			ExecutionBlockHash: blk.GetBody().
+				GetExecutionPayload().GetBlockHash(),
+		}),
+	); err != nil {
+		return err
+	}
+	return s.dispatcher.Publish(
+		async.NewEvent(ctx, async.FinalizedCheckpoint, async.Checkpoint{
+			Epoch:     s.chainSpec.SlotToEpoch(blk.GetSlot()),
+			BlockRoot: root,
+			StateRoot: blk.GetStateRoot(),
+		}),
+	)
+}
+
+// publishInvalidPayload publishes a PayloadInvalid event if the block was
+// rejected because the execution client found its payload invalid.
+func (s *Service[
+	_, _, BeaconBlockT, _, _, _, _, _, _, _, _,
+]) publishInvalidPayload(
+	ctx context.Context,
+	blk BeaconBlockT,
+	err error,
+) {
+	if !errors.Is(err, engineerrors.ErrInvalidPayloadStatus) {
+		return
+	}
+	if pErr := s.dispatcher.Publish(
+		async.NewEvent(ctx, async.PayloadInvalid, async.InvalidPayload{
+			Slot: blk.GetSlot(),
+			BlockHash: blk.GetBody().
+				GetExecutionPayload().GetBlockHash(),
+		}, err),
+	); pErr != nil {
+		s.logger.Error(
+			"Failed to publish invalid payload event", "error", pErr,
+		)
+	}
+}
+
+// publishValidatorSetUpdate publishes a ValidatorSetUpdated event if the
+// validator set changed at the given slot.
+func (s *Service[
+	_, _, _, _, _, _, _, _, _, _, _,
+]) publishValidatorSetUpdate(
+	ctx context.Context,
+	slot math.Slot,
+	updates transition.ValidatorUpdates,
+) {
+	if len(updates) == 0 {
+		return
+	}
+	if err := s.dispatcher.Publish(
+		async.NewEvent(ctx, async.ValidatorSetUpdated, async.ValidatorSetUpdate{
+			Slot:    slot,
+			Updates: updates,
+		}),
+	); err != nil {
+		s.logger.Error(
+			"Failed to publish validator set update event", "error", err,
+		)
+	}
+}
+
+// publishValidatorStatusChanges publishes a ValidatorStatusChanged event if
+// the processing of the given epoch changed the status of validators.
+func (s *Service[
+	_, _, _, _, _, _, _, _, _, _, _,
+]) publishValidatorStatusChanges(ctx context.Context, epoch math.Epoch) {
+	changes := s.stateProcessor.ValidatorStatusChanges(epoch)
+	if len(changes) == 0 {
+		return
+	}
+	if err := s.dispatcher.Publish(
+		async.NewEvent(
+			ctx, async.ValidatorStatusChanged, async.ValidatorStatusChanges{
+				Epoch:   epoch,
+				Changes: changes,
+			},
+		),
+	); err != nil {
+		s.logger.Error(
+			"Failed to publish validator status changes event",
+			"error", err,
+		)
+	}
+}
@@ -29,7 +29,9 @@ import (
 	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
 )
 
-// sendPostBlockFCU sends a forkchoice update to the execution client.
+// sendPostBlockFCU queues a forkchoice update to the execution client. The
+// updates are sent in order, and the ones without attributes are superseded
+// by the next update if they have not been sent yet.
 func (s *Service[
 	_, ConsensusBlockT, _, _, _, BeaconStateT, _, _, _, _, _,
 ]) sendPostBlockFCU(
@@ -46,27 +48,38 @@ func (s *Service[
 		return
 	}
 
+	update := ForkchoiceUpdate{
+		Send: func() { s.sendNextFCUWithoutAttributes(ctx, blk, lph) },
+	}
 	if !s.shouldBuildOptimisticPayloads() && s.localBuilder.Enabled() {
-		s.sendNextFCUWithAttributes(ctx, st, blk, lph)
-	} else {
-		s.sendNextFCUWithoutAttributes(ctx, blk, lph)
+		// The update may be sent after the next block is finalized, so it
+		// builds on a copy of the state.
+		stCopy := st.Copy()
+		update = ForkchoiceUpdate{
+			WithAttributes: true,
+			Send: func() {
+				s.sendNextFCUWithAttributes(ctx, stCopy, blk, lph)
+			},
+		}
 	}
+	s.metrics.markForkchoiceUpdatesSuperseded(
+		s.forkchoiceUpdates.Push(update),
+	)
 }
 
 // sendNextFCUWithAttributes sends a forkchoice update to the execution
-// client with attributes.
+// client with attributes. The given state is modified, so it must be a copy.
 func (s *Service[
 	_, ConsensusBlockT, _, _, _, BeaconStateT,
 	_, _, ExecutionPayloadHeaderT, _, _,
 ]) sendNextFCUWithAttributes(
 	ctx context.Context,
-	st BeaconStateT,
+	stCopy BeaconStateT,
 	blk ConsensusBlockT,
 	lph ExecutionPayloadHeaderT,
 ) {
 	beaconBlk := blk.GetBeaconBlock()
 
-	stCopy := st.Copy()
 	if _, err := s.stateProcessor.ProcessSlots(
 		stCopy, beaconBlk.GetSlot()+1,
 	); err != nil {
@@ -77,7 +90,9 @@ func (s *Service[
 		return
 	}
 
-	nextPayloadTime := payloadtime.Next(
+	nextPayloadTime := payloadtime.ForSlot(
+		s.chainSpec,
+		beaconBlk.GetSlot()+1,
 		blk.GetConsensusTime(),
 		lph.GetTimestamp(),
 		true, // buildOptimistically
@@ -94,7 +109,7 @@ func (s *Service[
 	}
 
 	prevBlockRoot := beaconBlk.HashTreeRoot()
-	if _, err := s.localBuilder.RequestPayloadAsync(
+	_, err := s.localBuilder.RequestPayloadAsync(
 		ctx,
 		stCopy,
 		beaconBlk.GetSlot()+1,
@@ -102,7 +117,9 @@ func (s *Service[
 		prevBlockRoot,
 		lph.GetBlockHash(),
 		lph.GetParentHash(),
-	); err != nil {
+	)
+	s.alerts.ObserveForkchoiceUpdate(beaconBlk.GetSlot(), err)
+	if err != nil {
 		s.logger.Error(
 			"failed to send forkchoice update with attributes in non-optimistic payload",
 			"error",
@@ -123,7 +140,7 @@ func (s *Service[
 ) {
 	beaconBlk := blk.GetBeaconBlock()
 
-	if _, _, err := s.executionEngine.NotifyForkchoiceUpdate(
+	_, _, err := s.executionEngine.NotifyForkchoiceUpdate(
 		ctx,
 		// TODO: Switch to New().
 		engineprimitives.
@@ -135,7 +152,9 @@ func (s *Service[
 			},
 			s.chainSpec.ActiveForkVersionForSlot(beaconBlk.GetSlot()),
 		),
-	); err != nil {
+	)
+	s.alerts.ObserveForkchoiceUpdate(beaconBlk.GetSlot(), err)
+	if err != nil {
 		s.logger.Error(
 			"failed to send forkchoice update without attributes",
 			"error", err,
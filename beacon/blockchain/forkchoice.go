@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain
+
+import "sync"
+
+// ForkchoiceUpdate is a forkchoice update queued for the execution client.
+type ForkchoiceUpdate struct {
+	// WithAttributes is true if the update requests the execution client to
+	// build a payload, in which case it is never superseded.
+	WithAttributes bool
+	// Send sends the update to the execution client.
+	Send func()
+}
+
+// ForkchoiceQueue sends the forkchoice updates of the finalized blocks to the
+// execution client one at a time and in order. When blocks finalize faster
+// than the execution client answers, e.g. while catching up, the pending
+// updates without attributes are superseded by the next update, so that only
+// the latest head, safe and finalized hashes are sent.
+type ForkchoiceQueue struct {
+	mu sync.Mutex
+	// pending are the updates waiting to be sent.
+	pending []ForkchoiceUpdate
+	// draining is true while a goroutine is sending the pending updates.
+	draining bool
+}
+
+// NewForkchoiceQueue creates a new forkchoice update queue.
+func NewForkchoiceQueue() *ForkchoiceQueue {
+	return &ForkchoiceQueue{}
+}
+
+// Push queues the update, superseding the pending updates without attributes
+// queued right before it, and returns the number of superseded updates.
+func (q *ForkchoiceQueue) Push(update ForkchoiceUpdate) int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	superseded := 0
+	for n := len(q.pending); n > 0 && !q.pending[n-1].WithAttributes; n-- {
+		q.pending[n-1] = ForkchoiceUpdate{}
+		q.pending = q.pending[:n-1]
+		superseded++
+	}
+	q.pending = append(q.pending, update)
+
+	if !q.draining {
+		q.draining = true
+		go q.drain()
+	}
+	return superseded
+}
+
+// drain sends the pending updates until there are none left.
+func (q *ForkchoiceQueue) drain() {
+	for {
+		q.mu.Lock()
+		if len(q.pending) == 0 {
+			q.draining = false
+			q.mu.Unlock()
+			return
+		}
+		update := q.pending[0]
+		q.pending[0] = ForkchoiceUpdate{}
+		q.pending = q.pending[1:]
+		q.mu.Unlock()
+
+		update.Send()
+	}
+}
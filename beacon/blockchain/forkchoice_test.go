@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/berachain/beacon-kit/beacon/blockchain"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForkchoiceQueueCoalescesUpdatesWithoutAttributes(t *testing.T) {
+	q := blockchain.NewForkchoiceQueue()
+
+	var (
+		mu      sync.Mutex
+		sent    []int
+		started = make(chan struct{})
+		release = make(chan struct{})
+		done    = make(chan struct{})
+	)
+	update := func(id int, withAttributes bool) blockchain.ForkchoiceUpdate {
+		return blockchain.ForkchoiceUpdate{
+			WithAttributes: withAttributes,
+			Send: func() {
+				if id == 1 {
+					close(started)
+					<-release
+				}
+				mu.Lock()
+				sent = append(sent, id)
+				mu.Unlock()
+				if id == 6 {
+					close(done)
+				}
+			},
+		}
+	}
+
+	// The first update is in flight while the next blocks finalize.
+	require.Zero(t, q.Push(update(1, false)))
+	<-started
+	require.Zero(t, q.Push(update(2, false)))
+	require.Equal(t, 1, q.Push(update(3, false)))
+	require.Equal(t, 1, q.Push(update(4, true)))
+	require.Zero(t, q.Push(update(5, true)))
+	require.Zero(t, q.Push(update(6, false)))
+	close(release)
+	<-done
+
+	// Updates with attributes are never dropped, and the updates are sent
+	// in order.
+	mu.Lock()
+	defer mu.Unlock()
+	require.Equal(t, []int{1, 4, 5, 6}, sent)
+}
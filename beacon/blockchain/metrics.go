@@ -108,6 +108,16 @@ func (cm *chainMetrics) markOptimisticPayloadBuildFailure(
 	)
 }
 
+// markForkchoiceUpdatesSuperseded increments the counter for the number of
+// forkchoice updates superseded by a later one before being sent.
+func (cm *chainMetrics) markForkchoiceUpdatesSuperseded(count int) {
+	for range count {
+		cm.sink.IncrementCounter(
+			"beacon_kit.blockchain.forkchoice_update_superseded",
+		)
+	}
+}
+
 // measureStateRootVerificationTime measures the time taken to verify the state
 // root of a block.
 // It records the duration from the provided start time to the current time.
@@ -116,3 +126,25 @@ func (cm *chainMetrics) measureStateRootVerificationTime(start time.Time) {
 		"beacon_kit.blockchain.state_root_verification_duration", start,
 	)
 }
+
+// markForkRehearsalSuccess increments the counter for the number of times
+// a fork rehearsal succeeded.
+func (cm *chainMetrics) markForkRehearsalSuccess(fork string) {
+	cm.sink.IncrementCounter(
+		"beacon_kit.blockchain.fork_rehearsal_success",
+		"fork",
+		fork,
+	)
+}
+
+// markForkRehearsalFailure increments the counter for the number of times
+// a fork rehearsal failed.
+func (cm *chainMetrics) markForkRehearsalFailure(fork string, err error) {
+	cm.sink.IncrementCounter(
+		"beacon_kit.blockchain.fork_rehearsal_failure",
+		"fork",
+		fork,
+		"error",
+		err.Error(),
+	)
+}
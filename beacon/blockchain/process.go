@@ -59,12 +59,29 @@ func (s *Service[
 		return nil, ErrNilBlk
 	}
 
+	// Profile the processing of the block, keeping the profile if slow.
+	defer s.profiler.StartBlock(beaconBlk.GetSlot(), beaconBlk.HashTreeRoot)()
+
 	st := s.storageBackend.StateFromContext(ctx)
+
+	// Rehearse the scheduled fork against the pre-state, if configured.
+	s.maybeRehearseFork(ctx, st, blk)
+
+	preSlot, err := st.GetSlot()
+	if err != nil {
+		return nil, err
+	}
+
 	valUpdates, err := s.executeStateTransition(ctx, st, blk)
 	if err != nil {
 		return nil, err
 	}
 
+	// The deadline of ctx bounds the execution client calls of the state
+	// transition only: the block is final, so the remaining work must not
+	// be cut short, and the forkchoice update outlives the call.
+	ctx = context.WithoutCancel(ctx)
+
 	// If the blobs needed to process the block are not available, we
 	// return an error. It is safe to use the slot off of the beacon block
 	// since it has been verified as correct already.
@@ -86,10 +103,22 @@ func (s *Service[
 	); err != nil {
 		return nil, err
 	}
+	if err = s.publishHead(ctx, beaconBlk); err != nil {
+		return nil, err
+	}
 
-	go s.sendPostBlockFCU(ctx, st, blk)
+	go s.precomputeNextSlot(st.Copy())
+	s.sendPostBlockFCU(ctx, st, blk)
 
-	return valUpdates.CanonicalSort(), nil
+	valUpdates = valUpdates.CanonicalSort()
+	s.publishValidatorSetUpdate(ctx, beaconBlk.GetSlot(), valUpdates)
+
+	// The processing of the epochs turned by the block is now final.
+	for epoch := s.chainSpec.SlotToEpoch(preSlot) + 1; epoch <=
+		s.chainSpec.SlotToEpoch(beaconBlk.GetSlot()); epoch++ {
+		s.publishValidatorStatusChanges(ctx, epoch)
+	}
+	return valUpdates, nil
 }
 
 // executeStateTransition runs the stf.
@@ -136,3 +165,13 @@ func (s *Service[
 	)
 	return valUpdates, err
 }
+
+// precomputeNextSlot computes ahead of the next block the state root its
+// slot processing starts with, given a copy of the finalized state.
+func (s *Service[
+	_, _, _, _, _, BeaconStateT, _, _, _, _, _,
+]) precomputeNextSlot(st BeaconStateT) {
+	if err := s.stateProcessor.PrecomputeNextSlot(st); err != nil {
+		s.logger.Error("Failed to precompute next slot", "error", err)
+	}
+}
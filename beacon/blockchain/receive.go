@@ -72,7 +72,10 @@ func (s *Service[
 	postState := preState.Copy()
 
 	// Verify the state root of the incoming block.
-	if err := s.verifyStateRoot(ctx, postState, blk); err != nil {
+	err := s.verifyStateRoot(ctx, postState, blk)
+	s.alerts.ObserveBlockVerification(beaconBlk.GetSlot(), err)
+	if err != nil {
+		s.publishInvalidPayload(ctx, beaconBlk, err)
 		s.logger.Error(
 			"Rejecting incoming beacon block ❌ ",
 			"state_root",
@@ -88,10 +91,13 @@ func (s *Service[
 				return err
 			}
 
+			// The payload build outlives the verification of the block.
 			go s.handleRebuildPayloadForRejectedBlock(
-				ctx,
+				context.WithoutCancel(ctx),
 				preState,
-				payloadtime.Next(
+				payloadtime.ForSlot(
+					s.chainSpec,
+					beaconBlk.GetSlot(),
 					consensusTime,
 					lph.GetTimestamp(),
 					true, // buildOptimistically
@@ -114,11 +120,14 @@ func (s *Service[
 			return err
 		}
 
+		// The payload build outlives the verification of the block.
 		go s.handleOptimisticPayloadBuild(
-			ctx,
+			context.WithoutCancel(ctx),
 			postState,
 			beaconBlk,
-			payloadtime.Next(
+			payloadtime.ForSlot(
+				s.chainSpec,
+				beaconBlk.GetSlot()+1,
 				consensusTime,
 				lph.GetTimestamp(),
 				true, // buildOptimistically
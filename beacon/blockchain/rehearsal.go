@@ -0,0 +1,192 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain
+
+import (
+	"context"
+	"time"
+
+	"github.com/berachain/beacon-kit/chain-spec/chain"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/transition"
+)
+
+const (
+	// RehearsalForkDenebPlus is the name used to rehearse the Deneb+ fork.
+	RehearsalForkDenebPlus = "deneb-plus"
+	// RehearsalForkElectra is the name used to rehearse the Electra fork.
+	RehearsalForkElectra = "electra"
+)
+
+// ErrUnknownRehearsalFork is returned when the configured rehearsal fork
+// is not supported.
+var ErrUnknownRehearsalFork = errors.New("unknown rehearsal fork")
+
+// RehearsalConfig is the configuration for the fork upgrade rehearsal mode.
+//
+//nolint:lll // struct tags.
+type RehearsalConfig struct {
+	// Enabled determines if the fork upgrade rehearsal mode is enabled.
+	Enabled bool `mapstructure:"enabled"`
+	// Fork is the name of the scheduled fork to rehearse.
+	Fork string `mapstructure:"fork"`
+	// OverrideHeight is the slot at which the rehearsed fork's rules are
+	// applied, in place of the activation epoch in the chain spec.
+	OverrideHeight uint64 `mapstructure:"override-height"`
+}
+
+// DefaultRehearsalConfig returns the default fork rehearsal configuration.
+func DefaultRehearsalConfig() RehearsalConfig {
+	return RehearsalConfig{
+		Enabled:        false,
+		Fork:           RehearsalForkElectra,
+		OverrideHeight: 0,
+	}
+}
+
+// NewRehearsalChainSpec returns a copy of the given chain spec in which the
+// configured fork activates at the epoch containing the override height.
+func NewRehearsalChainSpec(
+	cs common.ChainSpec,
+	cfg RehearsalConfig,
+) (common.ChainSpec, error) {
+	data := cs.Data()
+	epoch := cs.SlotToEpoch(math.Slot(cfg.OverrideHeight))
+	switch cfg.Fork {
+	case RehearsalForkDenebPlus:
+		data.DenebPlusForkEpoch = epoch
+		data.ElectraForkEpoch = max(data.ElectraForkEpoch, epoch)
+	case RehearsalForkElectra:
+		data.ElectraForkEpoch = epoch
+		data.DenebPlusForkEpoch = min(data.DenebPlusForkEpoch, epoch)
+	default:
+		return nil, errors.Wrap(ErrUnknownRehearsalFork, cfg.Fork)
+	}
+	return chain.NewChainSpec(data)
+}
+
+// ForkRehearsal replays the block at the override height on a copy of the
+// canonical state using a state processor configured with the rehearsed
+// fork's rules.
+type ForkRehearsal[
+	BeaconBlockT any,
+	BeaconStateT any,
+	DepositT any,
+	ExecutionPayloadHeaderT any,
+] struct {
+	// fork is the name of the rehearsed fork.
+	fork string
+	// slot is the slot at which the rehearsal is run.
+	slot math.Slot
+	// stateProcessor applies the rehearsed fork's rules.
+	stateProcessor StateProcessor[
+		BeaconBlockT,
+		BeaconStateT,
+		*transition.Context,
+		DepositT,
+		ExecutionPayloadHeaderT,
+	]
+}
+
+// NewForkRehearsal creates a new fork rehearsal.
+func NewForkRehearsal[
+	BeaconBlockT any,
+	BeaconStateT any,
+	DepositT any,
+	ExecutionPayloadHeaderT any,
+](
+	cfg RehearsalConfig,
+	stateProcessor StateProcessor[
+		BeaconBlockT,
+		BeaconStateT,
+		*transition.Context,
+		DepositT,
+		ExecutionPayloadHeaderT,
+	],
+) *ForkRehearsal[
+	BeaconBlockT, BeaconStateT, DepositT, ExecutionPayloadHeaderT,
+] {
+	return &ForkRehearsal[
+		BeaconBlockT, BeaconStateT, DepositT, ExecutionPayloadHeaderT,
+	]{
+		fork:           cfg.Fork,
+		slot:           math.Slot(cfg.OverrideHeight),
+		stateProcessor: stateProcessor,
+	}
+}
+
+// maybeRehearseFork runs the fork rehearsal if the block is at the
+// override height. The canonical state is never modified.
+func (s *Service[
+	_, ConsensusBlockT, _, _, _, BeaconStateT, _, _, _, _, _,
+]) maybeRehearseFork(
+	ctx context.Context,
+	st BeaconStateT,
+	blk ConsensusBlockT,
+) {
+	if s.forkRehearsal == nil {
+		return
+	}
+
+	slot := blk.GetBeaconBlock().GetSlot()
+	if slot != s.forkRehearsal.slot {
+		return
+	}
+
+	startTime := time.Now()
+	_, err := s.forkRehearsal.stateProcessor.Transition(
+		&transition.Context{
+			Context:          ctx,
+			OptimisticEngine: true,
+			// The payload has already been handed to the execution client
+			// by the canonical transition.
+			SkipPayloadVerification: true,
+			// The randao reveal and state root were produced under the
+			// canonical fork and are expected to differ.
+			SkipValidateRandao: true,
+			SkipValidateResult: true,
+			ProposerAddress:    blk.GetProposerAddress(),
+			ConsensusTime:      blk.GetConsensusTime(),
+		},
+		st.Copy(),
+		blk.GetBeaconBlock(),
+	)
+	if err != nil {
+		s.logger.Error(
+			"Fork rehearsal failed ❌ ",
+			"fork", s.forkRehearsal.fork,
+			"slot", slot.Base10(),
+			"error", err,
+		)
+		s.metrics.markForkRehearsalFailure(s.forkRehearsal.fork, err)
+		return
+	}
+
+	s.logger.Info(
+		"Fork rehearsal succeeded ✅ ",
+		"fork", s.forkRehearsal.fork,
+		"slot", slot.Base10(),
+		"duration", time.Since(startTime),
+	)
+	s.metrics.markForkRehearsalSuccess(s.forkRehearsal.fork)
+}
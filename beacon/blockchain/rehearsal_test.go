@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/beacon/blockchain"
+	"github.com/berachain/beacon-kit/config/spec"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRehearsalChainSpec(t *testing.T) {
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+	overrideHeight := 3 * cs.SlotsPerEpoch()
+
+	rehearsal, err := blockchain.NewRehearsalChainSpec(
+		cs,
+		blockchain.RehearsalConfig{
+			Enabled:        true,
+			Fork:           blockchain.RehearsalForkElectra,
+			OverrideHeight: overrideHeight,
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, math.Epoch(3), rehearsal.ElectraForkEpoch())
+	require.Equal(t, math.Epoch(3), rehearsal.DenebPlusForkEpoch())
+	require.Equal(
+		t,
+		version.Electra,
+		rehearsal.ActiveForkVersionForSlot(math.Slot(overrideHeight)),
+	)
+
+	// The original chain spec is left untouched.
+	require.NotEqual(t, math.Epoch(3), cs.ElectraForkEpoch())
+}
+
+func TestNewRehearsalChainSpecUnknownFork(t *testing.T) {
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+
+	_, err = blockchain.NewRehearsalChainSpec(
+		cs,
+		blockchain.RehearsalConfig{Enabled: true, Fork: "fulu"},
+	)
+	require.ErrorIs(t, err, blockchain.ErrUnknownRehearsalFork)
+}
@@ -73,6 +73,15 @@ type Service[
 		DepositT,
 		ExecutionPayloadHeaderT,
 	]
+	// forkRehearsal is the optional fork upgrade rehearsal, nil when
+	// rehearsal mode is disabled.
+	forkRehearsal *ForkRehearsal[
+		BeaconBlockT, BeaconStateT, DepositT, ExecutionPayloadHeaderT,
+	]
+	// alerts tracks consensus-critical conditions observed by the service.
+	alerts AlertManager
+	// profiler captures CPU profiles of slow blocks.
+	profiler BlockProfiler
 	// metrics is the metrics for the service.
 	metrics *chainMetrics
 	// optimisticPayloadBuilds is a flag used when the optimistic payload
@@ -80,6 +89,9 @@ type Service[
 	optimisticPayloadBuilds bool
 	// forceStartupSyncOnce is used to force a sync of the startup head.
 	forceStartupSyncOnce *sync.Once
+	// forkchoiceUpdates sends the forkchoice updates of the finalized
+	// blocks in order, coalescing them while catching up.
+	forkchoiceUpdates *ForkchoiceQueue
 
 	// subFinalBlkReceived is a channel holding FinalBeaconBlockReceived events.
 	subFinalBlkReceived chan async.Event[ConsensusBlockT]
@@ -122,6 +134,11 @@ func NewService[
 		DepositT,
 		ExecutionPayloadHeaderT,
 	],
+	forkRehearsal *ForkRehearsal[
+		BeaconBlockT, BeaconStateT, DepositT, ExecutionPayloadHeaderT,
+	],
+	alerts AlertManager,
+	profiler BlockProfiler,
 	telemetrySink TelemetrySink,
 	optimisticPayloadBuilds bool,
 ) *Service[
@@ -143,9 +160,13 @@ func NewService[
 		executionEngine:         executionEngine,
 		localBuilder:            localBuilder,
 		stateProcessor:          stateProcessor,
+		forkRehearsal:           forkRehearsal,
+		alerts:                  alerts,
+		profiler:                profiler,
 		metrics:                 newChainMetrics(telemetrySink),
 		optimisticPayloadBuilds: optimisticPayloadBuilds,
 		forceStartupSyncOnce:    new(sync.Once),
+		forkchoiceUpdates:       NewForkchoiceQueue(),
 		subFinalBlkReceived:     make(chan async.Event[ConsensusBlockT]),
 		subBlockReceived:        make(chan async.Event[ConsensusBlockT]),
 		subGenDataReceived:      make(chan async.Event[GenesisT]),
@@ -227,6 +248,8 @@ func (s *Service[
 	valUpdates, genesisErr = s.ProcessGenesisData(msg.Context(), msg.Data())
 	if genesisErr != nil {
 		s.logger.Error("Failed to process genesis data", "error", genesisErr)
+	} else {
+		s.publishValidatorSetUpdate(msg.Context(), 0, valUpdates)
 	}
 
 	// Emit the event containing the validator updates.
@@ -302,10 +325,11 @@ func (s *Service[
 		)
 	}
 
-	// Emit the event containing the validator updates.
+	// Emit the event containing the validator updates. They are awaited
+	// past the deadline of the block, so it must not fail the delivery.
 	if err := s.dispatcher.Publish(
 		async.NewEvent(
-			msg.Context(),
+			context.WithoutCancel(msg.Context()),
 			async.FinalValidatorUpdatesProcessed,
 			valUpdates,
 			finalizeErr,
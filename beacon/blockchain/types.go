@@ -31,6 +31,17 @@ import (
 	"github.com/berachain/beacon-kit/primitives/transition"
 )
 
+// AlertManager tracks consensus-critical conditions and raises alerts when
+// they persist.
+type AlertManager interface {
+	// ObserveBlockVerification records the outcome of verifying an incoming
+	// block.
+	ObserveBlockVerification(slot math.Slot, err error)
+	// ObserveForkchoiceUpdate records the outcome of the forkchoice update
+	// sent after finalizing a block.
+	ObserveForkchoiceUpdate(slot math.Slot, err error)
+}
+
 // AvailabilityStore interface is responsible for validating and storing
 // sidecars for specific blocks, as well as verifying sidecars that have already
 // been stored.
@@ -92,6 +103,14 @@ type BlobSidecars interface {
 	Len() int
 }
 
+// BlockProfiler captures profiles of slow block processing.
+type BlockProfiler interface {
+	// StartBlock starts profiling the block at the given slot, the returned
+	// function stops the profile once the block is processed. The block
+	// root is only computed when the profile is kept.
+	StartBlock(slot math.Slot, root func() common.Root) func()
+}
+
 // ExecutionEngine is the interface for the execution engine.
 type ExecutionEngine[PayloadAttributesT any] interface {
 	// NotifyForkchoiceUpdate notifies the execution client of a forkchoice
@@ -199,6 +218,9 @@ type StateProcessor[
 		ExecutionPayloadHeaderT,
 		common.Version,
 	) (transition.ValidatorUpdates, error)
+	// PrecomputeNextSlot computes ahead the state root the processing of
+	// the next slot starts with.
+	PrecomputeNextSlot(BeaconStateT) error
 	// ProcessSlots processes the state transition for a range of slots.
 	ProcessSlots(
 		BeaconStateT, math.Slot,
@@ -209,6 +231,9 @@ type StateProcessor[
 		BeaconStateT,
 		BeaconBlockT,
 	) (transition.ValidatorUpdates, error)
+	// ValidatorStatusChanges returns the validator status changes produced
+	// by the processing of the given epoch.
+	ValidatorStatusChanges(math.Epoch) []transition.ValidatorStatusChange
 }
 
 // StorageBackend defines an interface for accessing various storage components
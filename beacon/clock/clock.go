@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package clock
+
+import (
+	"time"
+
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// SlotClock maps wall-clock time to slots. The first block, at slot 1, is
+// expected at genesis time and every following slot starts one slot
+// duration after the previous one.
+type SlotClock struct {
+	genesis      time.Time
+	slotDuration time.Duration
+	now          func() time.Time
+}
+
+// New creates a new slot clock starting at the given genesis time.
+func New(genesis time.Time, slotDuration time.Duration) *SlotClock {
+	return NewWithTimeSource(genesis, slotDuration, time.Now)
+}
+
+// NewWithTimeSource creates a new slot clock reading the current time from
+// the given function.
+func NewWithTimeSource(
+	genesis time.Time,
+	slotDuration time.Duration,
+	now func() time.Time,
+) *SlotClock {
+	return &SlotClock{
+		genesis:      genesis,
+		slotDuration: slotDuration,
+		now:          now,
+	}
+}
+
+// GenesisTime returns the time the first slot starts at.
+func (c *SlotClock) GenesisTime() time.Time {
+	return c.genesis
+}
+
+// SlotDuration returns the duration of a slot.
+func (c *SlotClock) SlotDuration() time.Duration {
+	return c.slotDuration
+}
+
+// SlotAt returns the slot in progress at the given time, or zero before
+// genesis.
+func (c *SlotClock) SlotAt(t time.Time) math.Slot {
+	if t.Before(c.genesis) || c.slotDuration <= 0 {
+		return 0
+	}
+	//#nosec:G701 // elapsed time is positive.
+	return math.Slot(uint64(t.Sub(c.genesis)/c.slotDuration) + 1)
+}
+
+// SlotStart returns the time the given slot starts at.
+func (c *SlotClock) SlotStart(slot math.Slot) time.Time {
+	if slot == 0 {
+		return c.genesis
+	}
+	//#nosec:G701 // slots fit in a duration for any realistic chain.
+	return c.genesis.Add(time.Duration(slot.Unwrap()-1) * c.slotDuration)
+}
+
+// CurrentSlot returns the slot in progress.
+func (c *SlotClock) CurrentSlot() math.Slot {
+	return c.SlotAt(c.now())
+}
+
+// NextSlot returns the slot following the one in progress.
+func (c *SlotClock) NextSlot() math.Slot {
+	return c.CurrentSlot() + 1
+}
+
+// TimeUntilSlot returns the time left until the given slot starts, or zero
+// if it already started.
+func (c *SlotClock) TimeUntilSlot(slot math.Slot) time.Duration {
+	return max(c.SlotStart(slot).Sub(c.now()), 0)
+}
+
+// TimeUntilNextSlot returns the time left in the slot in progress.
+func (c *SlotClock) TimeUntilNextSlot() time.Duration {
+	return c.TimeUntilSlot(c.NextSlot())
+}
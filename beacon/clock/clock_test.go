@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package clock_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/beacon/clock"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSlotClock(t *testing.T) {
+	genesis := time.Unix(1_700_000_000, 0)
+	now := genesis.Add(-time.Second)
+	c := clock.NewWithTimeSource(
+		genesis, 2*time.Second, func() time.Time { return now },
+	)
+
+	// Before genesis, the first slot has yet to start.
+	require.Equal(t, math.Slot(0), c.CurrentSlot())
+	require.Equal(t, time.Second, c.TimeUntilSlot(1))
+
+	now = genesis
+	require.Equal(t, math.Slot(1), c.CurrentSlot())
+	require.Equal(t, math.Slot(2), c.NextSlot())
+	require.Equal(t, 2*time.Second, c.TimeUntilNextSlot())
+
+	now = genesis.Add(5500 * time.Millisecond)
+	require.Equal(t, math.Slot(3), c.CurrentSlot())
+	require.Equal(t, genesis.Add(6*time.Second), c.SlotStart(4))
+	require.Equal(t, 500*time.Millisecond, c.TimeUntilNextSlot())
+
+	// Slots which already started have no time left.
+	require.Equal(t, time.Duration(0), c.TimeUntilSlot(2))
+	require.Equal(t, genesis, c.SlotStart(0))
+}
@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package clock
+
+import "github.com/berachain/beacon-kit/primitives/math"
+
+// clockMetrics is a struct that contains metrics for the slot clock.
+type clockMetrics struct {
+	// sink is the sink for the metrics.
+	sink TelemetrySink
+}
+
+// newClockMetrics creates a new clockMetrics.
+func newClockMetrics(sink TelemetrySink) *clockMetrics {
+	return &clockMetrics{
+		sink: sink,
+	}
+}
+
+// setSlots records the slot of the clock, the head slot of consensus and
+// the lag between them.
+func (cm *clockMetrics) setSlots(current, head math.Slot, lag uint64) {
+	//#nosec:G701 // slots do not overflow an int64.
+	cm.sink.SetGauge("beacon_kit.clock.current_slot", int64(current))
+	//#nosec:G701 // slots do not overflow an int64.
+	cm.sink.SetGauge("beacon_kit.clock.head_slot", int64(head))
+	//#nosec:G701 // the lag is bounded by the current slot.
+	cm.sink.SetGauge("beacon_kit.clock.slot_lag", int64(lag))
+}
+
+// incrementMissedSlots increments the counter of slots which ended without
+// a finalized block.
+func (cm *clockMetrics) incrementMissedSlots() {
+	cm.sink.IncrementCounter("beacon_kit.clock.missed_slots")
+}
@@ -0,0 +1,185 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package clock
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	asynctypes "github.com/berachain/beacon-kit/async/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/primitives/async"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// ErrInvalidSlotDuration is returned when the slot duration is not positive.
+var ErrInvalidSlotDuration = errors.New("slot duration must be positive")
+
+// Service tracks the slot clock against the slots finalized by consensus,
+// detecting missed slots and consensus falling behind the clock.
+type Service[BeaconBlockT BeaconBlock] struct {
+	*SlotClock
+	// cfg is the configuration of the slot clock.
+	cfg Config
+	// logger is used for logging information and errors.
+	logger log.Logger
+	// dispatcher is the dispatcher for the service.
+	dispatcher asynctypes.EventDispatcher
+	// metrics is the metrics for the service.
+	metrics *clockMetrics
+	// headSlot is the slot of the last finalized block.
+	headSlot atomic.Uint64
+	// behind is set while consensus is more than MaxSlotLag slots behind
+	// the slot clock.
+	behind atomic.Bool
+	// subFinalizedBlkEvents is a channel holding BeaconBlockFinalized
+	// events.
+	subFinalizedBlkEvents chan async.Event[BeaconBlockT]
+}
+
+// NewService creates a new slot clock service.
+func NewService[BeaconBlockT BeaconBlock](
+	cfg Config,
+	clock *SlotClock,
+	logger log.Logger,
+	dispatcher asynctypes.EventDispatcher,
+	telemetrySink TelemetrySink,
+) *Service[BeaconBlockT] {
+	return &Service[BeaconBlockT]{
+		SlotClock:             clock,
+		cfg:                   cfg,
+		logger:                logger,
+		dispatcher:            dispatcher,
+		metrics:               newClockMetrics(telemetrySink),
+		subFinalizedBlkEvents: make(chan async.Event[BeaconBlockT]),
+	}
+}
+
+// Name returns the name of the service.
+func (s *Service[_]) Name() string {
+	return "slot-clock"
+}
+
+// Start subscribes the service to BeaconBlockFinalized events and starts
+// ticking at every slot boundary.
+func (s *Service[_]) Start(ctx context.Context) error {
+	if s.SlotDuration() <= 0 {
+		return ErrInvalidSlotDuration
+	}
+
+	if err := s.dispatcher.Subscribe(
+		async.BeaconBlockFinalized, s.subFinalizedBlkEvents,
+	); err != nil {
+		return err
+	}
+
+	s.logger.Info(
+		"Starting slot clock",
+		"genesis_time", s.GenesisTime().UTC().Format(time.RFC3339),
+		"slot_duration", s.SlotDuration().String(),
+		"current_slot", s.CurrentSlot().Base10(),
+	)
+	go s.eventLoop(ctx)
+	return nil
+}
+
+// HeadSlot returns the slot of the last finalized block.
+func (s *Service[_]) HeadSlot() math.Slot {
+	return math.Slot(s.headSlot.Load())
+}
+
+// SlotLag returns the number of slots consensus is behind the slot clock.
+func (s *Service[_]) SlotLag() uint64 {
+	current, head := s.CurrentSlot(), s.HeadSlot()
+	if current <= head {
+		return 0
+	}
+	return (current - head).Unwrap()
+}
+
+// IsBehind returns true while consensus is more than the configured number
+// of slots behind the slot clock.
+func (s *Service[_]) IsBehind() bool {
+	return s.behind.Load()
+}
+
+// eventLoop is the main event loop of the service.
+func (s *Service[_]) eventLoop(ctx context.Context) {
+	var (
+		timer    = time.NewTimer(s.TimeUntilNextSlot())
+		lastHead = s.HeadSlot()
+	)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event := <-s.subFinalizedBlkEvents:
+			s.headSlot.Store(event.Data().GetSlot().Unwrap())
+		case <-timer.C:
+			s.onSlotBoundary(lastHead)
+			lastHead = s.HeadSlot()
+			timer.Reset(s.TimeUntilNextSlot())
+		}
+	}
+}
+
+// onSlotBoundary checks the head of consensus against the slot clock when a
+// slot ends.
+func (s *Service[_]) onSlotBoundary(lastHead math.Slot) {
+	var (
+		current = s.CurrentSlot()
+		head    = s.HeadSlot()
+		lag     = s.SlotLag()
+	)
+	s.metrics.setSlots(current, head, lag)
+
+	// A slot passed without any block being finalized. Nothing can be
+	// missed before the first block is seen.
+	if head != 0 && head == lastHead {
+		s.metrics.incrementMissedSlots()
+		s.logger.Debug(
+			"Missed slot, no block finalized",
+			"slot", (current - 1).Base10(), "head_slot", head.Base10(),
+		)
+	}
+
+	behind := lag > s.cfg.MaxSlotLag
+	if s.behind.Swap(behind) == behind {
+		return
+	}
+	if behind {
+		s.logger.Warn(
+			"Consensus is behind the slot clock",
+			"current_slot", current.Base10(),
+			"head_slot", head.Base10(),
+			"lag", lag,
+		)
+		return
+	}
+	s.logger.Info(
+		"Consensus caught up with the slot clock",
+		"current_slot", current.Base10(), "head_slot", head.Base10(),
+	)
+}
@@ -23,19 +23,41 @@ package payloadtime
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/berachain/beacon-kit/primitives/math"
 )
 
-// ErrTooFarInTheFuture is returned when the payload timestamp
-// in a block exceeds the time bound.
-var ErrTooFarInTheFuture = errors.New("timestamp too far in the future")
+var (
+	// ErrTooFarInTheFuture is returned when the payload timestamp
+	// in a block exceeds the time bound.
+	ErrTooFarInTheFuture = errors.New("timestamp too far in the future")
 
+	// ErrBeforeGenesis is returned when the payload timestamp in a block
+	// precedes the genesis time of the chain.
+	ErrBeforeGenesis = errors.New("timestamp before genesis time")
+
+	// ErrSlotTimestampMismatch is returned when the payload timestamp in a
+	// block deviates from the start of its slot by more than the tolerance.
+	ErrSlotTimestampMismatch = errors.New("timestamp does not match slot")
+)
+
+// Verify checks that the payload timestamp is not before the genesis time,
+// if set, and at most one second ahead of both the consensus time and the
+// parent payload timestamp.
 func Verify(
+	genesisTime,
 	consensusTime,
 	parentPayloadTimestamp,
 	payloadTimestamp math.U64,
 ) error {
+	if payloadTimestamp < genesisTime {
+		return fmt.Errorf(
+			"%w: genesis time: %d, got: %d",
+			ErrBeforeGenesis,
+			genesisTime, payloadTimestamp,
+		)
+	}
 	bound := max(
 		consensusTime+1,
 		parentPayloadTimestamp+1,
@@ -50,7 +72,12 @@ func Verify(
 	return nil
 }
 
+// Next returns the timestamp of the next payload, which passes Verify.
+// Payloads built with sub-second slots run ahead of the consensus time by
+// one second per block within the same second, as execution timestamps must
+// strictly increase.
 func Next(
+	genesisTime,
 	consensusTime,
 	parentPayloadTimestamp math.U64,
 	buildOptimistically bool,
@@ -66,7 +93,86 @@ func Next(
 		delta = 1
 	}
 	return max(
+		genesisTime,
 		consensusTime+delta,
 		parentPayloadTimestamp+1,
 	)
 }
+
+// SlotTimestamp returns the Unix time, in seconds, at which the given slot
+// starts, rounded down for sub-second slots.
+func SlotTimestamp(
+	genesisTime math.U64,
+	slotDuration time.Duration,
+	slot math.Slot,
+) math.U64 {
+	//#nosec:G701 // slot durations are validated to be positive.
+	return genesisTime + slot*math.U64(slotDuration.Milliseconds())/1000
+}
+
+// VerifySlot checks that the payload timestamp is within tolerance seconds of
+// the expected slot timestamp.
+func VerifySlot(
+	slotTimestamp,
+	payloadTimestamp,
+	tolerance math.U64,
+) error {
+	lower := slotTimestamp - min(slotTimestamp, tolerance)
+	upper := slotTimestamp + tolerance
+	if payloadTimestamp < lower || payloadTimestamp > upper {
+		return fmt.Errorf(
+			"%w: slot timestamp: %d, tolerance: %d, got: %d",
+			ErrSlotTimestampMismatch,
+			slotTimestamp, tolerance, payloadTimestamp,
+		)
+	}
+	return nil
+}
+
+// ChainSpec is the part of the chain spec anchoring payload timestamps to
+// slots.
+type ChainSpec interface {
+	// GenesisTime returns the Unix time, in seconds, at which the first
+	// slot starts.
+	GenesisTime() uint64
+	// SlotDuration returns the duration of a slot.
+	SlotDuration() time.Duration
+	// SlotToEpoch returns the epoch of the given slot.
+	SlotToEpoch(math.Slot) math.Epoch
+	// SlotTimestampForkEpoch returns the epoch from which the timestamp of
+	// an execution payload must match the start of its slot.
+	SlotTimestampForkEpoch() math.Epoch
+}
+
+// Anchored returns true if the timestamp of the payload of the block for the
+// given slot must match the start of the slot. Slots lasting a fraction of a
+// second are never anchored, since consecutive slots could start within the
+// same second, while the execution client requires payload timestamps to
+// strictly increase.
+func Anchored(cs ChainSpec, slot math.Slot) bool {
+	return cs.GenesisTime() != 0 &&
+		cs.SlotDuration()%time.Second == 0 &&
+		cs.SlotToEpoch(slot) >= cs.SlotTimestampForkEpoch()
+}
+
+// ForSlot returns the timestamp of the payload to build for the given slot:
+// the start of the slot if payload timestamps are anchored to slots, the
+// timestamp following from the consensus time otherwise, see Next.
+func ForSlot(
+	cs ChainSpec,
+	slot math.Slot,
+	consensusTime,
+	parentPayloadTimestamp math.U64,
+	buildOptimistically bool,
+) math.U64 {
+	genesisTime := math.U64(cs.GenesisTime())
+	if Anchored(cs, slot) {
+		return SlotTimestamp(genesisTime, cs.SlotDuration(), slot)
+	}
+	return Next(
+		genesisTime,
+		consensusTime,
+		parentPayloadTimestamp,
+		buildOptimistically,
+	)
+}
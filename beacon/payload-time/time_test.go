@@ -25,6 +25,8 @@ import (
 	"time"
 
 	payloadtime "github.com/berachain/beacon-kit/beacon/payload-time"
+	"github.com/berachain/beacon-kit/chain-spec/chain"
+	"github.com/berachain/beacon-kit/config/spec"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/stretchr/testify/require"
 )
@@ -72,12 +74,14 @@ func TestNextTimestampVerifies(t *testing.T) {
 
 			// Optimistic build case
 			nextPayload := payloadtime.Next(
+				0, // genesisTime
 				math.U64(consensusTime.Unix()),
 				math.U64(parentPayloadTimestamp.Unix()),
 				true, // buildOptimistically
 			)
 
 			gotErr := payloadtime.Verify(
+				0, // genesisTime
 				math.U64(consensusTime.Unix()),
 				math.U64(parentPayloadTimestamp.Unix()),
 				nextPayload,
@@ -90,12 +94,14 @@ func TestNextTimestampVerifies(t *testing.T) {
 
 			// Just in time build case
 			nextPayload = payloadtime.Next(
+				0, // genesisTime
 				math.U64(consensusTime.Unix()),
 				math.U64(parentPayloadTimestamp.Unix()),
 				false, // buildOptimistically
 			)
 
 			gotErr = payloadtime.Verify(
+				0, // genesisTime
 				math.U64(consensusTime.Unix()),
 				math.U64(parentPayloadTimestamp.Unix()),
 				nextPayload,
@@ -108,3 +114,97 @@ func TestNextTimestampVerifies(t *testing.T) {
 		})
 	}
 }
+
+// TestGenesisTimeBound checks that payload timestamps never precede the
+// genesis time of the chain spec.
+func TestGenesisTimeBound(t *testing.T) {
+	const (
+		genesisTime   = math.U64(1_700_000_000)
+		consensusTime = genesisTime - 5
+	)
+
+	// A chain starting at a future genesis time builds its first payloads
+	// at genesis, which is accepted.
+	next := payloadtime.Next(genesisTime, consensusTime, 0, true)
+	require.Equal(t, genesisTime, next)
+	require.NoError(t, payloadtime.Verify(
+		genesisTime, genesisTime, consensusTime, next,
+	))
+
+	// Payloads timestamped before genesis are rejected.
+	require.ErrorIs(t,
+		payloadtime.Verify(genesisTime, consensusTime, 0, consensusTime),
+		payloadtime.ErrBeforeGenesis,
+	)
+
+	// Without genesis time, the historical behaviour is unchanged.
+	require.Equal(t,
+		consensusTime+1, payloadtime.Next(0, consensusTime, 0, true),
+	)
+}
+
+// TestVerifySlot checks that payload timestamps are checked against the
+// start of their slot, within the tolerance.
+func TestVerifySlot(t *testing.T) {
+	const genesisTime = math.U64(1_700_000_000)
+
+	slotTimestamp := payloadtime.SlotTimestamp(genesisTime, 2*time.Second, 10)
+	require.Equal(t, genesisTime+20, slotTimestamp)
+	require.NoError(t, payloadtime.VerifySlot(slotTimestamp, slotTimestamp, 0))
+	require.ErrorIs(t,
+		payloadtime.VerifySlot(slotTimestamp, slotTimestamp+1, 0),
+		payloadtime.ErrSlotTimestampMismatch,
+	)
+
+	// Legacy networks may tolerate drift between the EL and CL clocks.
+	require.NoError(t,
+		payloadtime.VerifySlot(slotTimestamp, slotTimestamp-2, 2),
+	)
+	require.ErrorIs(t,
+		payloadtime.VerifySlot(slotTimestamp, slotTimestamp+3, 2),
+		payloadtime.ErrSlotTimestampMismatch,
+	)
+
+	// Sub-second slots share the timestamp of the second they start in.
+	require.Equal(t,
+		genesisTime+2,
+		payloadtime.SlotTimestamp(genesisTime, 250*time.Millisecond, 11),
+	)
+}
+
+// TestForSlot checks that payloads are built with the start of their slot as
+// timestamp from the slot timestamp fork only.
+func TestForSlot(t *testing.T) {
+	const genesisTime = 1_700_000_000
+
+	newSpec := func(genesis, slotDurationMs uint64) payloadtime.ChainSpec {
+		data := spec.BaseSpec()
+		data.SlotsPerEpoch = 2
+		data.GenesisTime = genesis
+		data.SlotDurationMs = slotDurationMs
+		data.SlotTimestampForkEpoch = 5
+		cs, err := chain.NewChainSpec(data)
+		require.NoError(t, err)
+		return cs
+	}
+
+	cs := newSpec(genesisTime, 2000)
+	const consensusTime, parentTimestamp = genesisTime + 100, genesisTime + 50
+	require.False(t, payloadtime.Anchored(cs, 9))
+	require.Equal(t,
+		payloadtime.Next(genesisTime, consensusTime, parentTimestamp, true),
+		payloadtime.ForSlot(cs, 9, consensusTime, parentTimestamp, true),
+	)
+	require.True(t, payloadtime.Anchored(cs, 10))
+	require.Equal(t,
+		math.U64(genesisTime+20),
+		payloadtime.ForSlot(cs, 10, consensusTime, parentTimestamp, true),
+	)
+
+	// Without a genesis time, or with sub-second slots, timestamps are
+	// never anchored.
+	require.False(t, payloadtime.Anchored(newSpec(0, 2000), 10))
+	require.False(t, payloadtime.Anchored(newSpec(genesisTime, 500), 10))
+	require.False(t, payloadtime.Anchored(newSpec(genesisTime, 1500), 10))
+	require.True(t, payloadtime.Anchored(newSpec(genesisTime, 1000), 10))
+}
@@ -28,8 +28,11 @@ import (
 	payloadtime "github.com/berachain/beacon-kit/beacon/payload-time"
 	"github.com/berachain/beacon-kit/config/spec"
 	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/observability/proposal"
 	"github.com/berachain/beacon-kit/primitives/bytes"
 	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constants"
 	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/primitives/transition"
@@ -48,11 +51,35 @@ func (s *Service[
 		blk       BeaconBlockT
 		sidecars  BlobSidecarsT
 		startTime = time.Now()
-		g, _      = errgroup.WithContext(ctx)
 	)
 
 	defer s.metrics.measureRequestBlockForProposalTime(startTime)
 
+	// Refuse to propose unless this node holds the signing lease, before
+	// building a block the signer would refuse to sign anyway.
+	if s.lease != nil {
+		if err := s.lease.Acquire(slotData.GetSlot()); err != nil {
+			return blk, sidecars, errors.Join(ErrLeaseNotAcquired, err)
+		}
+	}
+
+	// Serve the block signed by the external proposer, which holds the
+	// validator keys.
+	if s.cfg.ExternalProposer {
+		return s.serveSubmittedBlock(slotData)
+	}
+
+	// Serve the proposal built in an earlier round of the same height.
+	key := newProposalKey(slotData)
+	if cached, ok := s.proposals.get(key); ok {
+		s.logger.Info(
+			"Reusing beacon block built in an earlier round",
+			"slot", slotData.GetSlot().Base10(),
+			"state_root", cached.blk.GetStateRoot(),
+		)
+		return cached.blk, cached.sidecars, nil
+	}
+
 	// The goal here is to acquire a payload whose parent is the previously
 	// finalized block, such that, if this payload is accepted, it will be
 	// the next finalized block in the chain. A byproduct of this design
@@ -60,6 +87,14 @@ func (s *Service[
 	// and safe block hashes to the execution client.
 	st := s.sb.StateFromContext(ctx)
 
+	// Refuse to propose until the execution client can build on the
+	// beacon head, otherwise the payload would carry a stale parent hash.
+	if err := s.verifyExecutionSynced(
+		ctx, st, slotData.GetSlot(),
+	); err != nil {
+		return blk, sidecars, err
+	}
+
 	// Prepare the state such that it is ready to build a block for
 	// the requested slot
 	if _, err := s.stateProcessor.ProcessSlots(
@@ -76,27 +111,70 @@ func (s *Service[
 		return blk, sidecars, err
 	}
 
-	// Create a new empty block from the current state.
-	blk, err = s.getEmptyBeaconBlockForSlot(st, slotData.GetSlot())
+	graffiti, err := s.graffiti()
 	if err != nil {
 		return blk, sidecars, err
 	}
+	built, err := s.buildBlock(ctx, st, slotData, reveal, graffiti)
+	if err != nil {
+		return blk, sidecars, err
+	}
+
+	s.logger.Info(
+		"Beacon block successfully built",
+		"slot", slotData.GetSlot().Base10(),
+		"state_root", built.blk.GetStateRoot(),
+		"duration", time.Since(startTime).String(),
+	)
+
+	s.proposals.put(key, built)
+	return built.blk, built.sidecars, nil
+}
+
+// buildBlock builds the block and sidecars for the slot data on the state,
+// which must already be processed up to the slot.
+func (s *Service[
+	_, BeaconBlockT, _, BeaconStateT, BlobSidecarsT, _, _, _, _, _, _, _,
+	SlotDataT,
+]) buildBlock(
+	ctx context.Context,
+	st BeaconStateT,
+	slotData SlotDataT,
+	reveal crypto.BLSSignature,
+	graffiti common.Bytes32,
+) (*builtProposal[BeaconBlockT, BlobSidecarsT], error) {
+	var (
+		sidecars BlobSidecarsT
+		g, _     = errgroup.WithContext(ctx)
+		report   = proposal.FromContext(ctx)
+	)
+
+	// Create a new empty block from the current state.
+	blk, err := s.getEmptyBeaconBlockForSlot(st, slotData.GetSlot())
+	if err != nil {
+		return nil, err
+	}
 
 	// Get the payload for the block.
-	envelope, err := s.retrieveExecutionPayload(ctx, st, blk, slotData)
+	envelope, err := s.retrievePayloadWithinDeadline(ctx, st, blk, slotData)
 	if err != nil {
-		return blk, sidecars, err
+		return nil, err
 	}
 	if envelope == nil {
-		return blk, sidecars, ErrNilPayload
+		return nil, ErrNilPayload
+	}
+	if blobsBundle := envelope.GetBlobsBundle(); blobsBundle != nil {
+		report.SetPayload(
+			envelope.GetValue(), uint64(len(blobsBundle.GetBlobs())),
+		)
 	}
 
 	// We have to assemble the block body prior to producing the sidecars
 	// since we need to generate the inclusion proofs.
 	if err = s.buildBlockBody(
-		ctx, st, blk, reveal, envelope, slotData,
+		ctx, st, blk, reveal, graffiti, envelope, slotData,
 	); err != nil {
-		return blk, sidecars, err
+		return nil, err
 	}
 
 	// Produce blob sidecars, we produce them in parallel to computing the state
@@ -106,6 +184,7 @@ func (s *Service[
 	// functions
 	// without giving up the parallelization benefits.
 	g.Go(func() error {
+		defer report.Observe(proposal.StageSidecarBuild, time.Now())
 		sidecars, err = s.blobFactory.BuildSidecars(
 			blk, envelope.GetBlobsBundle(),
 		)
@@ -114,6 +193,7 @@ func (s *Service[
 
 	// Compute the state root for the block.
 	g.Go(func() error {
+		defer report.Observe(proposal.StageStateRoot, time.Now())
 		return s.computeAndSetStateRoot(
 			ctx,
 			slotData.GetProposerAddress(),
@@ -125,17 +205,19 @@ func (s *Service[
 
 	// Wait for all the goroutines to finish.
 	if err = g.Wait(); err != nil {
-		return blk, sidecars, err
+		return nil, err
 	}
 
-	s.logger.Info(
-		"Beacon block successfully built",
-		"slot", slotData.GetSlot().Base10(),
-		"state_root", blk.GetStateRoot(),
-		"duration", time.Since(startTime).String(),
-	)
-
-	return blk, sidecars, nil
+	blkBz, err := blk.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	return &builtProposal[BeaconBlockT, BlobSidecarsT]{
+		blk:      blk,
+		sidecars: sidecars,
+		size: uint64(len(blkBz)) +
+			s.blobFactory.SidecarsSize(envelope.GetBlobsBundle()),
+	}, nil
 }
 
 // getEmptyBeaconBlockForSlot creates a new empty block.
@@ -155,9 +237,11 @@ func (s *Service[
 	}
 
 	// Get the proposer index for the slot.
-	proposerIndex, err := st.ValidatorIndexByPubkey(
-		s.signer.PublicKey(),
-	)
+	pubkey, err := s.proposerPubkey()
+	if err != nil {
+		return blk, err
+	}
+	proposerIndex, err := st.ValidatorIndexByPubkey(pubkey)
 	if err != nil {
 		return blk, err
 	}
@@ -172,11 +256,26 @@ func (s *Service[
 
 // buildRandaoReveal builds a randao reveal for the given slot.
 func (s *Service[
-	_, _, _, BeaconStateT, _, _, _, _, _, _, ForkDataT, _, _,
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _,
 ]) buildRandaoReveal(
 	st BeaconStateT,
 	slot math.Slot,
 ) (crypto.BLSSignature, error) {
+	signingRoot, err := s.randaoSigningRoot(st, slot)
+	if err != nil {
+		return crypto.BLSSignature{}, err
+	}
+	return s.signer.Sign(signingRoot[:])
+}
+
+// randaoSigningRoot returns the root the randao reveal of the given slot
+// is signed over.
+func (s *Service[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, ForkDataT, _, _,
+]) randaoSigningRoot(
+	st BeaconStateT,
+	slot math.Slot,
+) (common.Root, error) {
 	var (
 		forkData ForkDataT
 		epoch    = s.chainSpec.SlotToEpoch(slot)
@@ -184,18 +283,40 @@ func (s *Service[
 
 	genesisValidatorsRoot, err := st.GetGenesisValidatorsRoot()
 	if err != nil {
-		return crypto.BLSSignature{}, err
+		return common.Root{}, err
 	}
 
-	signingRoot := forkData.New(
+	return forkData.New(
 		version.FromUint32[common.Version](
 			s.chainSpec.ActiveForkVersionForEpoch(epoch),
 		), genesisValidatorsRoot,
 	).ComputeRandaoSigningRoot(
 		s.chainSpec.DomainTypeRandao(),
 		epoch,
+	), nil
+}
+
+// graffiti returns the configured graffiti of the blocks built by the node.
+func (s *Service[_, _, _, _, _, _, _, _, _, _, _, _, _]) graffiti() (
+	common.Bytes32, error,
+) {
+	rawGraffiti, err := bytes.NewBounded[bytes.Limit32](
+		[]byte(s.cfg.Graffiti),
 	)
-	return s.signer.Sign(signingRoot[:])
+	if err != nil {
+		return common.Bytes32{}, fmt.Errorf(
+			"failed processing graffiti: %w", err,
+		)
+	}
+	graffiti, err := bytes.ToBytes32(
+		bytes.ExtendToSize(rawGraffiti, bytes.B32Size),
+	)
+	if err != nil {
+		return common.Bytes32{}, fmt.Errorf(
+			"failed processing graffiti: %w", err,
+		)
+	}
+	return graffiti, nil
 }
 
 // retrieveExecutionPayload retrieves the execution payload for the block.
@@ -248,7 +369,9 @@ func (s *Service[
 		ctx,
 		st,
 		blk.GetSlot(),
-		payloadtime.Next(
+		payloadtime.ForSlot(
+			s.chainSpec,
+			blk.GetSlot(),
 			slotData.GetConsensusTime(),
 			lph.GetTimestamp(),
 			false, // buildOptimistically
@@ -261,13 +384,14 @@ func (s *Service[
 
 // BuildBlockBody assembles the block body with necessary components.
 func (s *Service[
-	_, BeaconBlockT, _, BeaconStateT, _, _, _, Eth1DataT, ExecutionPayloadT, _,
+	_, BeaconBlockT, _, BeaconStateT, _, _, _, _, ExecutionPayloadT, _,
 	_, _, SlotDataT,
 ]) buildBlockBody(
-	_ context.Context,
+	ctx context.Context,
 	st BeaconStateT,
 	blk BeaconBlockT,
 	reveal crypto.BLSSignature,
+	graffiti common.Bytes32,
 	envelope engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT],
 	slotData SlotDataT,
 ) error {
@@ -317,20 +441,7 @@ func (s *Service[
 	)
 	body.SetDeposits(deposits)
 
-	var eth1Data Eth1DataT
-	// TODO: assemble real eth1data.
-	body.SetEth1Data(eth1Data.New(
-		common.Root{},
-		0,
-		common.ExecutionHash{},
-	))
-
 	// Set the graffiti on the block body.
-	sizedGraffiti := bytes.ExtendToSize([]byte(s.cfg.Graffiti), bytes.B32Size)
-	graffiti, err := bytes.ToBytes32(sizedGraffiti)
-	if err != nil {
-		return fmt.Errorf("failed processing graffiti: %w", err)
-	}
 	body.SetGraffiti(graffiti)
 
 	// Get the epoch to find the active fork version.
@@ -339,6 +450,9 @@ func (s *Service[
 		epoch,
 	)
 	if activeForkVersion >= version.DenebPlus {
+		// Set the application-defined operations on the block body.
+		body.SetOperations(s.pendingOperations(ctx, blk.GetSlot()))
+
 		// Set the attestations on the block body.
 		body.SetAttestations(slotData.GetAttestationData())
 
@@ -347,9 +461,53 @@ func (s *Service[
 	}
 
 	body.SetExecutionPayload(envelope.GetExecutionPayload())
+	if err = s.fitBlockToBudget(
+		blk, blobsBundle, slotData.GetMaxBytes(),
+	); err != nil {
+		return err
+	}
+	proposal.FromContext(ctx).SetDepositCount(uint64(len(deposits)))
+
+	// Set the Eth1Data following from the deposits in the block, which is
+	// empty before the Eth1Data fork.
+	eth1Data, err := s.stateProcessor.Eth1Data(st, blk)
+	if err != nil {
+		return err
+	}
+	body.SetEth1Data(eth1Data)
 	return nil
 }
 
+// pendingOperations returns the application-defined operations to include
+// in the block proposed for the given slot. A failing pool must not prevent
+// the proposal, which is then built without operations.
+func (s *Service[
+	_, _, _, _, _, _, _, _, _, _, _, _, _,
+]) pendingOperations(
+	ctx context.Context,
+	slot math.Slot,
+) []*transition.Operation {
+	if s.operations == nil {
+		return nil
+	}
+	ops, err := s.operations.PendingOperations(ctx, slot)
+	if err != nil {
+		s.logger.Warn(
+			"Failed to get pending operations, proposing without them",
+			"slot", slot.Base10(), "error", err,
+		)
+		return nil
+	}
+	if limit := constants.MaxOperationsPerBlock; uint64(len(ops)) > limit {
+		s.logger.Warn(
+			"Trimming operations to the per-block limit",
+			"num_operations", len(ops), "limit", limit,
+		)
+		ops = ops[:limit]
+	}
+	return ops
+}
+
 // computeAndSetStateRoot computes the state root of an outgoing block
 // and sets it in the block.
 func (s *Service[
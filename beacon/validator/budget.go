@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"github.com/berachain/beacon-kit/beacon/validator/budget"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/errors"
+)
+
+// fitBlockToBudget checks that the encoded block and its blob sidecars fit
+// in maxBytes. The block without the transactions of its payload, deposits
+// included, is a fixed reserve, and only the transactions and blobs are given
+// up to fit the rest of the budget. They cannot be trimmed from a payload the
+// execution client has built, so an oversized payload fails the proposal and
+// the next proposals fall back to payloads without transactions and blobs.
+// A maxBytes of zero means unbounded.
+func (s *Service[
+	_, BeaconBlockT, _, _, _, _, _, _, _, _, _, _, _,
+]) fitBlockToBudget(
+	blk BeaconBlockT,
+	blobsBundle engineprimitives.BlobsBundle,
+	maxBytes uint64,
+) error {
+	if maxBytes == 0 {
+		return nil
+	}
+
+	blkBz, err := blk.MarshalSSZ()
+	if err != nil {
+		return err
+	}
+	payload := blk.GetBody().GetExecutionPayload()
+	p := budget.Proposal{
+		Block:        uint64(len(blkBz)),
+		Transactions: budget.TransactionsSize(payload.GetTransactions()),
+		Sidecars:     s.blobFactory.SidecarsSize(blobsBundle),
+	}
+	err = p.Fit(maxBytes)
+	if errors.Is(err, budget.ErrPayloadTooLarge) {
+		s.fallback.Engage()
+		s.logger.Warn(
+			"Payload exceeds proposal size limit - "+
+				"proposing the next blocks without transactions and blobs",
+			"slot", blk.GetSlot().Base10(),
+			"size", p.Size(),
+			"reserve", p.Reserve(),
+			"max_bytes", maxBytes,
+		)
+	}
+	return err
+}
@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package budget
+
+import "github.com/berachain/beacon-kit/errors"
+
+// offsetSize is the size of the SSZ offset of each transaction in the
+// encoding of an execution payload.
+const offsetSize = 4
+
+// Proposal is the encoded size of a proposal, split between the reserve
+// every proposal carries in full and the transactions and blobs of the
+// execution payload.
+//
+// Deposits are part of the reserve: every validator expects a block to carry
+// all of the deposits following the last processed one, up to the per-block
+// limit, so a block with deposits trimmed is rejected.
+type Proposal struct {
+	// Block is the size of the encoded beacon block.
+	Block uint64
+	// Transactions is the part of Block taken by the transactions of the
+	// execution payload.
+	Transactions uint64
+	// Sidecars is the size of the encoded blob sidecars.
+	Sidecars uint64
+}
+
+// Size returns the size of the encoded block and blob sidecars.
+func (p Proposal) Size() uint64 {
+	return p.Block + p.Sidecars
+}
+
+// Reserve returns the size of the block without the transactions of its
+// execution payload.
+func (p Proposal) Reserve() uint64 {
+	return p.Block - p.Transactions
+}
+
+// Payload returns the size of the transactions and blobs of the proposal.
+func (p Proposal) Payload() uint64 {
+	return p.Transactions + p.Sidecars
+}
+
+// Fit returns an error if the proposal does not fit in maxBytes. The reserve
+// is taken out of maxBytes first and the rest is left to the transactions and
+// blobs. A maxBytes of zero means unbounded.
+func (p Proposal) Fit(maxBytes uint64) error {
+	switch {
+	case maxBytes == 0 || p.Size() <= maxBytes:
+		return nil
+	case p.Reserve() > maxBytes:
+		return errors.Wrapf(
+			ErrReserveTooLarge, "%d bytes, limit %d", p.Reserve(), maxBytes,
+		)
+	default:
+		return errors.Wrapf(
+			ErrPayloadTooLarge, "%d bytes, %d left after a reserve of %d",
+			p.Payload(), maxBytes-p.Reserve(), p.Reserve(),
+		)
+	}
+}
+
+// TransactionsSize returns the number of bytes the transactions take in the
+// SSZ encoding of an execution payload, their offsets included.
+func TransactionsSize(txs [][]byte) uint64 {
+	var size uint64
+	for _, tx := range txs {
+		size += offsetSize + uint64(len(tx))
+	}
+	return size
+}
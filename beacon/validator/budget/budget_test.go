@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package budget_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/beacon/validator/budget"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransactionsSize_MatchesPayloadEncoding(t *testing.T) {
+	payload := &types.ExecutionPayload{}
+	emptyBz, err := payload.MarshalSSZ()
+	require.NoError(t, err)
+
+	payload.Transactions = [][]byte{{0x01}, make([]byte, 300), {}}
+	bz, err := payload.MarshalSSZ()
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		uint64(len(bz)-len(emptyBz)),
+		budget.TransactionsSize(payload.Transactions),
+	)
+}
+
+func TestProposal_ReserveIncludesDeposits(t *testing.T) {
+	blk, err := (&types.BeaconBlock{}).NewWithVersion(
+		1, 0, common.Root{}, version.Deneb,
+	)
+	require.NoError(t, err)
+	payload := &types.ExecutionPayload{
+		Transactions: [][]byte{make([]byte, 1000)},
+	}
+	blk.Body.SetExecutionPayload(payload)
+	withoutDeposits, err := blk.MarshalSSZ()
+	require.NoError(t, err)
+
+	blk.Body.SetDeposits(types.Deposits{{}, {}, {}})
+	bz, err := blk.MarshalSSZ()
+	require.NoError(t, err)
+
+	depositBz, err := (&types.Deposit{}).MarshalSSZ()
+	require.NoError(t, err)
+
+	p := budget.Proposal{
+		Block:        uint64(len(bz)),
+		Transactions: budget.TransactionsSize(payload.Transactions),
+	}
+	require.Equal(
+		t,
+		uint64(len(withoutDeposits))-p.Transactions+
+			3*uint64(len(depositBz)),
+		p.Reserve(),
+	)
+}
+
+func TestProposal_Fit(t *testing.T) {
+	p := budget.Proposal{Block: 1000, Transactions: 400, Sidecars: 300}
+	require.Equal(t, uint64(1300), p.Size())
+	require.Equal(t, uint64(600), p.Reserve())
+	require.Equal(t, uint64(700), p.Payload())
+
+	tests := []struct {
+		name     string
+		maxBytes uint64
+		wantErr  error
+	}{
+		{name: "unbounded", maxBytes: 0},
+		{name: "fits exactly", maxBytes: 1300},
+		{name: "fits", maxBytes: 2000},
+		{
+			name:     "payload over the rest of the budget",
+			maxBytes: 1299,
+			wantErr:  budget.ErrPayloadTooLarge,
+		},
+		{
+			name:     "room for the reserve only",
+			maxBytes: 600,
+			wantErr:  budget.ErrPayloadTooLarge,
+		},
+		{
+			name:     "reserve over the budget",
+			maxBytes: 599,
+			wantErr:  budget.ErrReserveTooLarge,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := p.Fit(tt.maxBytes)
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
@@ -20,6 +20,8 @@
 
 package validator
 
+import "time"
+
 const (
 	// defaultGraffiti is the default graffiti string.
 	defaultGraffiti = ""
@@ -27,6 +29,25 @@ const (
 	// defaultEnableOptimisticPayloadBuilds is the default
 	// for enabling the optimistic payload builder.
 	defaultEnableOptimisticPayloadBuilds = true
+
+	// defaultMaxExecutionHeadLag is the default number of blocks the
+	// execution head may trail the beacon head's payload by.
+	defaultMaxExecutionHeadLag = 1
+
+	// defaultWireFormat is the default wire format of proposals.
+	defaultWireFormat = "legacy"
+
+	// defaultPayloadDeadline is the default time the proposer waits for the
+	// execution payload, below the default CometBFT timeout_propose.
+	defaultPayloadDeadline = 1500 * time.Millisecond
+
+	// defaultFallbackAfterMissedDeadlines is the default number of
+	// consecutive missed payload deadlines before falling back.
+	defaultFallbackAfterMissedDeadlines = 3
+
+	// defaultLeaseDuration is the default time a signing lease is held for
+	// after each renewal.
+	defaultLeaseDuration = 30 * time.Second
 )
 
 // Config is the validator configuration.
@@ -39,6 +60,63 @@ type Config struct {
 
 	// EnableOptimisticPayloadBuilds is the optimistic block builder.
 	EnableOptimisticPayloadBuilds bool `mapstructure:"enable-optimistic-payload-builds"`
+
+	// MaxExecutionHeadLag is the number of blocks the execution head may
+	// trail the latest execution payload in the beacon state before the
+	// validator refuses to propose.
+	MaxExecutionHeadLag uint64 `mapstructure:"max-execution-head-lag"`
+
+	// WireFormat is the format the beacon block and blob sidecars of a
+	// proposal are encoded in, one of "legacy", "ssz" or "ssz-snappy". Every
+	// node decodes all of them, so it is only switched once the whole network
+	// has upgraded.
+	WireFormat string `mapstructure:"wire-format"`
+
+	// PayloadDeadline is the time the proposer waits for the execution
+	// client to deliver the payload of a proposal. It is capped at
+	// timeout_propose in the CometBFT configuration, so that the proposal
+	// is not missed. Zero disables the deadline.
+	PayloadDeadline time.Duration `mapstructure:"payload-deadline"`
+
+	// FallbackAfterMissedDeadlines is the number of consecutive proposals
+	// missing the payload deadline after which the proposer falls back to
+	// proposing the payload of the execution client as is, without waiting
+	// for it to be filled and without blobs, for as many proposals. Zero
+	// disables the fallback.
+	FallbackAfterMissedDeadlines uint64 `mapstructure:"fallback-after-missed-deadlines"`
+
+	// LeaseFile is the path of a signing lease file shared by redundant
+	// nodes running the same validator keys. A node only signs votes,
+	// proposals and blocks after acquiring the lease, so that a passive node
+	// takes over once the active node stopped renewing it. Empty disables
+	// the lease.
+	LeaseFile string `mapstructure:"lease-file"`
+
+	// LeaseHolder identifies this node in the lease file. Empty defaults to
+	// the hostname.
+	LeaseHolder string `mapstructure:"lease-holder"`
+
+	// LeaseDuration is the time the lease is held for after each renewal.
+	// The lease is renewed on every height the node votes at, so it must
+	// exceed the time between two blocks, or the passive node takes over
+	// while the active node is healthy.
+	LeaseDuration time.Duration `mapstructure:"lease-duration"`
+
+	// ExternalProposer hands proposing to an external validator client
+	// holding the validator key. The client requests an unsigned block for
+	// the next slot through the node API, with a randao reveal it signed,
+	// and submits it back signed. The block may also be requested and signed
+	// blinded, the node then revealing its execution payload as the builder.
+	// The node proposes the submitted block in its slot, and no block if none
+	// was submitted. CometBFT votes are still signed with the consensus key
+	// of the node.
+	ExternalProposer bool `mapstructure:"external-proposer"`
+
+	// ExternalProposerPubkey is the hex encoded BLS public key of the
+	// validator of the external proposer. The randao reveals and the blocks
+	// it submits are verified against it, and the blocks are built for its
+	// validator.
+	ExternalProposerPubkey string `mapstructure:"external-proposer-pubkey"`
 }
 
 // DefaultConfig returns the default fork configuration.
@@ -46,5 +124,10 @@ func DefaultConfig() Config {
 	return Config{
 		Graffiti:                      defaultGraffiti,
 		EnableOptimisticPayloadBuilds: defaultEnableOptimisticPayloadBuilds,
+		MaxExecutionHeadLag:           defaultMaxExecutionHeadLag,
+		WireFormat:                    defaultWireFormat,
+		PayloadDeadline:               defaultPayloadDeadline,
+		FallbackAfterMissedDeadlines:  defaultFallbackAfterMissedDeadlines,
+		LeaseDuration:                 defaultLeaseDuration,
 	}
 }
@@ -37,4 +37,53 @@ var (
 	// ErrNilDepositIndexStart is an error for when the deposit index start is
 	// nil.
 	ErrNilDepositIndexStart = errors.New("nil deposit index start")
+
+	// ErrProposalTooLarge is an error for when the block and its blob
+	// sidecars exceed the proposal size limit.
+	ErrProposalTooLarge = errors.New("proposal exceeds size limit")
+
+	// ErrExecutionSyncing is an error for when the execution client is
+	// still syncing and cannot build on the beacon head.
+	ErrExecutionSyncing = errors.New("execution client is syncing")
+
+	// ErrExecutionHeadBehind is an error for when the execution head trails
+	// the beacon head's payload by more than the configured distance.
+	ErrExecutionHeadBehind = errors.New(
+		"execution head is behind the beacon head",
+	)
+
+	// ErrLeaseNotAcquired is an error for when the signing lease could not
+	// be acquired, e.g. because a redundant node holds it.
+	ErrLeaseNotAcquired = errors.New("signing lease not acquired")
+
+	// ErrExternalProposerDisabled is an error for when a block is requested
+	// for or submitted by an external proposer while the node proposes on
+	// its own.
+	ErrExternalProposerDisabled = errors.New("external proposer disabled")
+
+	// ErrUnexpectedSlot is an error for when a block is requested for
+	// another slot than the one following the head.
+	ErrUnexpectedSlot = errors.New("slot does not follow the head")
+
+	// ErrInvalidExternalProposerPubkey is an error for when the configured
+	// public key of the external proposer is not a valid BLS public key.
+	ErrInvalidExternalProposerPubkey = errors.New(
+		"invalid external proposer pubkey",
+	)
+
+	// ErrInvalidRandaoReveal is an error for when the randao reveal of the
+	// external proposer is not signed by its configured key.
+	ErrInvalidRandaoReveal = errors.New("invalid randao reveal")
+
+	// ErrUnknownBlock is an error for when the submitted block was not
+	// produced by the node for the next slot.
+	ErrUnknownBlock = errors.New("block not produced for the next slot")
+
+	// ErrInvalidBlockSignature is an error for when the submitted block is
+	// not signed by the configured key of the external proposer.
+	ErrInvalidBlockSignature = errors.New("invalid block signature")
+
+	// ErrNoSubmittedBlock is an error for when the external proposer did
+	// not submit a signed block for the slot to propose.
+	ErrNoSubmittedBlock = errors.New("no block submitted for the slot")
 )
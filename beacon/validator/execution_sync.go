@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// verifyExecutionSynced returns an error if the execution client is syncing
+// or if its head trails the latest execution payload in the beacon state by
+// more than the configured distance. Proposing in either case would build on
+// a stale parent hash that the rest of the network rejects.
+func (s *Service[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _,
+]) verifyExecutionSynced(
+	ctx context.Context,
+	st BeaconStateT,
+	slot math.Slot,
+) error {
+	if s.executionSyncer == nil {
+		return nil
+	}
+
+	err := s.checkExecutionSynced(ctx, st)
+	if err != nil {
+		s.metrics.skippedProposalExecutionNotReady(slot, err)
+	}
+	return err
+}
+
+// checkExecutionSynced queries the execution client for its sync status and
+// compares its head against the beacon head's payload.
+func (s *Service[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _,
+]) checkExecutionSynced(
+	ctx context.Context,
+	st BeaconStateT,
+) error {
+	header, err := st.GetLatestExecutionPayloadHeader()
+	if err != nil {
+		return err
+	}
+	return checkExecutionHead(
+		ctx, s.executionSyncer, header.GetNumber(), s.cfg.MaxExecutionHeadLag,
+	)
+}
+
+// checkExecutionHead returns an error if the execution client is syncing or
+// if its head trails the expected block number by more than maxLag blocks.
+func checkExecutionHead(
+	ctx context.Context,
+	syncer ExecutionSyncer,
+	expected math.U64,
+	maxLag uint64,
+) error {
+	syncing, err := syncer.IsSyncing(ctx)
+	if err != nil {
+		return err
+	}
+	if syncing {
+		return ErrExecutionSyncing
+	}
+
+	number, _, err := syncer.LatestExecutionHead(ctx)
+	if err != nil {
+		return err
+	}
+	if number+math.U64(maxLag) < expected {
+		return errors.Wrapf(
+			ErrExecutionHeadBehind,
+			"execution head %d, beacon head payload %d, max lag %d",
+			number, expected, maxLag,
+		)
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/berachain/beacon-kit/beacon/validator"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/stretchr/testify/require"
+)
+
+var errUnavailable = errors.New("execution client unavailable")
+
+// executionSyncer is an execution client whose sync status is set by the
+// tests.
+type executionSyncer struct {
+	syncing    bool
+	head       math.U64
+	syncingErr error
+	headErr    error
+}
+
+func (s *executionSyncer) IsSyncing(context.Context) (bool, error) {
+	return s.syncing, s.syncingErr
+}
+
+func (s *executionSyncer) LatestExecutionHead(
+	context.Context,
+) (math.U64, common.ExecutionHash, error) {
+	return s.head, common.ExecutionHash{}, s.headErr
+}
+
+// TestCheckExecutionHead walks an execution client through its sync states,
+// with the beacon head payload at block 10 and a maximum lag of one block.
+func TestCheckExecutionHead(t *testing.T) {
+	const (
+		expected = math.U64(10)
+		maxLag   = 1
+	)
+	syncer := new(executionSyncer)
+	steps := []struct {
+		name    string
+		update  func(*executionSyncer)
+		wantErr error
+	}{
+		{
+			name:    "syncing",
+			update:  func(s *executionSyncer) { s.syncing = true },
+			wantErr: validator.ErrExecutionSyncing,
+		},
+		{
+			name: "synced far behind the beacon head",
+			update: func(s *executionSyncer) {
+				s.syncing, s.head = false, 5
+			},
+			wantErr: validator.ErrExecutionHeadBehind,
+		},
+		{
+			name:   "synced within the maximum lag",
+			update: func(s *executionSyncer) { s.head = 9 },
+		},
+		{
+			name:   "synced to the beacon head",
+			update: func(s *executionSyncer) { s.head = 10 },
+		},
+		{
+			name:    "syncing again",
+			update:  func(s *executionSyncer) { s.syncing = true },
+			wantErr: validator.ErrExecutionSyncing,
+		},
+		{
+			name: "sync status unavailable",
+			update: func(s *executionSyncer) {
+				s.syncing, s.syncingErr = false, errUnavailable
+			},
+			wantErr: errUnavailable,
+		},
+		{
+			name: "head unavailable",
+			update: func(s *executionSyncer) {
+				s.syncingErr, s.headErr = nil, errUnavailable
+			},
+			wantErr: errUnavailable,
+		},
+		{
+			name:   "available again",
+			update: func(s *executionSyncer) { s.headErr = nil },
+		},
+	}
+	for _, step := range steps {
+		step.update(syncer)
+		err := validator.CheckExecutionHead(
+			context.Background(), syncer, expected, maxLag,
+		)
+		if step.wantErr == nil {
+			require.NoError(t, err, step.name)
+		} else {
+			require.ErrorIs(t, err, step.wantErr, step.name)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import "github.com/berachain/beacon-kit/primitives/math"
+
+// Exported for the tests of the external test package.
+var CheckExecutionHead = checkExecutionHead
+
+type ProposalSlotData interface {
+	GetSlot() math.Slot
+	GetProposerAddress() []byte
+	GetConsensusTime() math.U64
+	GetMaxBytes() uint64
+}
+
+type ProposalCache[BeaconBlockT, BlobSidecarsT any] struct {
+	cache proposalCache[BeaconBlockT, BlobSidecarsT]
+}
+
+func (c *ProposalCache[BeaconBlockT, BlobSidecarsT]) Get(
+	slotData ProposalSlotData,
+) (BeaconBlockT, BlobSidecarsT, bool) {
+	built, ok := c.cache.get(newProposalKey(slotData))
+	if !ok {
+		var (
+			blk      BeaconBlockT
+			sidecars BlobSidecarsT
+		)
+		return blk, sidecars, false
+	}
+	return built.blk, built.sidecars, true
+}
+
+func (c *ProposalCache[BeaconBlockT, BlobSidecarsT]) Put(
+	slotData ProposalSlotData, blk BeaconBlockT, sidecars BlobSidecarsT,
+) {
+	c.cache.put(
+		newProposalKey(slotData),
+		&builtProposal[BeaconBlockT, BlobSidecarsT]{
+			blk: blk, sidecars: sidecars,
+		},
+	)
+}
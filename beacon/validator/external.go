@@ -0,0 +1,330 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	payloadtime "github.com/berachain/beacon-kit/beacon/payload-time"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/signing"
+	"github.com/berachain/beacon-kit/primitives/version"
+)
+
+// externalProposals holds the block produced for an external proposer and
+// the block it submitted back signed. Only the block produced last can be
+// submitted.
+type externalProposals[BeaconBlockT, BlobSidecarsT any] struct {
+	// buildMu serializes building the produced blocks.
+	buildMu sync.Mutex
+	// mu guards the proposals below.
+	mu sync.Mutex
+	// produced is the block produced last, along with its slot and signing
+	// root.
+	produced *externalProposal[BeaconBlockT, BlobSidecarsT]
+	// submitted is the block submitted last.
+	submitted *externalProposal[BeaconBlockT, BlobSidecarsT]
+}
+
+// externalProposal is a block built for an external proposer.
+type externalProposal[BeaconBlockT, BlobSidecarsT any] struct {
+	*builtProposal[BeaconBlockT, BlobSidecarsT]
+	// slot is the slot of the block.
+	slot math.Slot
+	// root is the hash tree root of the block.
+	root common.Root
+	// signingRoot is the root the proposer signs the block over.
+	signingRoot common.Root
+	// signature is the signature of the proposer over the block, once
+	// submitted.
+	signature crypto.BLSSignature
+}
+
+// newExternalProposals returns an empty set of external proposals.
+func newExternalProposals[
+	BeaconBlockT, BlobSidecarsT any,
+]() *externalProposals[BeaconBlockT, BlobSidecarsT] {
+	return &externalProposals[BeaconBlockT, BlobSidecarsT]{}
+}
+
+// ProduceBlock builds an unsigned block for the next slot on the state of
+// the given context, for the external proposer to sign. The randao reveal
+// is signed by the external proposer and must verify against its configured
+// key. The block is built on a copy of the state, which is left untouched.
+func (s *Service[
+	_, BeaconBlockT, _, _, BlobSidecarsT, _, _, _, _, _, _, _, SlotDataT,
+]) ProduceBlock(
+	ctx context.Context,
+	slot math.Slot,
+	reveal crypto.BLSSignature,
+	graffiti common.Bytes32,
+) (BeaconBlockT, error) {
+	var blk BeaconBlockT
+	if !s.cfg.ExternalProposer {
+		return blk, ErrExternalProposerDisabled
+	}
+	s.external.buildMu.Lock()
+	defer s.external.buildMu.Unlock()
+
+	// Processing the slots and the block writes to the state, so the block
+	// is built on a copy.
+	st := s.sb.StateFromContext(ctx).Copy()
+	headSlot, err := st.GetSlot()
+	if err != nil {
+		return blk, err
+	}
+	if slot != headSlot+1 {
+		return blk, errors.Wrapf(
+			ErrUnexpectedSlot, "requested %d, next slot %d",
+			slot, headSlot+1,
+		)
+	}
+
+	// Refuse to build until the execution client can build on the beacon
+	// head, as for the blocks the node proposes on its own.
+	if err = s.verifyExecutionSynced(ctx, st, slot); err != nil {
+		return blk, err
+	}
+	if _, err = s.stateProcessor.ProcessSlots(st, slot); err != nil {
+		return blk, err
+	}
+
+	pubkey, err := s.proposerPubkey()
+	if err != nil {
+		return blk, err
+	}
+	randaoRoot, err := s.randaoSigningRoot(st, slot)
+	if err != nil {
+		return blk, err
+	}
+	if err = s.signer.VerifySignature(
+		pubkey, randaoRoot[:], reveal,
+	); err != nil {
+		return blk, errors.Join(ErrInvalidRandaoReveal, err)
+	}
+
+	proposerAddress, err := crypto.GetAddressFromPubKey(pubkey)
+	if err != nil {
+		return blk, err
+	}
+	var slotData SlotDataT
+	slotData = slotData.New(
+		slot, nil, nil, proposerAddress, s.slotTime(slot),
+	)
+	built, err := s.buildBlock(ctx, st, slotData, reveal, graffiti)
+	if err != nil {
+		return blk, err
+	}
+	signingRoot, err := s.blockSigningRoot(st, built.blk)
+	if err != nil {
+		return blk, err
+	}
+
+	s.external.mu.Lock()
+	defer s.external.mu.Unlock()
+	s.external.produced = &externalProposal[BeaconBlockT, BlobSidecarsT]{
+		builtProposal: built,
+		slot:          slot,
+		root:          built.blk.HashTreeRoot(),
+		signingRoot:   signingRoot,
+	}
+	s.logger.Info(
+		"Beacon block produced for external proposer",
+		"slot", slot.Base10(),
+		"block_root", s.external.produced.root,
+	)
+	return built.blk, nil
+}
+
+// SubmitBlock accepts the block produced last, signed by the external
+// proposer with its configured key, to be proposed in its slot along with
+// the signature.
+func (s *Service[
+	_, BeaconBlockT, _, _, _, _, _, _, _, _, _, _, _,
+]) SubmitBlock(
+	blk BeaconBlockT,
+	signature crypto.BLSSignature,
+) error {
+	if !s.cfg.ExternalProposer {
+		return ErrExternalProposerDisabled
+	}
+	s.external.mu.Lock()
+	defer s.external.mu.Unlock()
+
+	produced, err := s.signedProduced(blk.HashTreeRoot(), signature)
+	if err != nil {
+		return err
+	}
+	submitted := *produced
+	submitted.signature = signature
+	s.external.submitted = &submitted
+	s.logger.Info(
+		"Signed beacon block submitted by external proposer",
+		"slot", submitted.slot.Base10(),
+		"block_root", submitted.root,
+		"signature", submitted.signature,
+	)
+	return nil
+}
+
+// RevealPayload returns the execution payload of the block produced last,
+// with the given root, once signed by the external proposer with its
+// configured key. It lets the proposer unblind the block it signed blinded, the
+// node acting as the builder of the payload.
+func (s *Service[
+	_, _, _, _, _, _, _, _, ExecutionPayloadT, _, _, _, _,
+]) RevealPayload(
+	root common.Root,
+	signature crypto.BLSSignature,
+) (ExecutionPayloadT, error) {
+	var payload ExecutionPayloadT
+	if !s.cfg.ExternalProposer {
+		return payload, ErrExternalProposerDisabled
+	}
+	s.external.mu.Lock()
+	defer s.external.mu.Unlock()
+
+	produced, err := s.signedProduced(root, signature)
+	if err != nil {
+		return payload, err
+	}
+	return produced.blk.GetBody().GetExecutionPayload(), nil
+}
+
+// signedProduced returns the block produced last if it has the given root
+// and the signature over it verifies against the configured key of the
+// external proposer. The caller must hold the lock of the external
+// proposals.
+func (s *Service[
+	_, BeaconBlockT, _, _, BlobSidecarsT, _, _, _, _, _, _, _, _,
+]) signedProduced(
+	root common.Root,
+	signature crypto.BLSSignature,
+) (*externalProposal[BeaconBlockT, BlobSidecarsT], error) {
+	produced := s.external.produced
+	if produced == nil || produced.root != root {
+		return nil, ErrUnknownBlock
+	}
+	pubkey, err := s.proposerPubkey()
+	if err != nil {
+		return nil, err
+	}
+	if err = s.signer.VerifySignature(
+		pubkey, produced.signingRoot[:], signature,
+	); err != nil {
+		return nil, errors.Join(ErrInvalidBlockSignature, err)
+	}
+	return produced, nil
+}
+
+// serveSubmittedBlock returns the block submitted by the external proposer
+// for the slot to propose.
+func (s *Service[
+	_, BeaconBlockT, _, _, BlobSidecarsT, _, _, _, _, _, _, _, SlotDataT,
+]) serveSubmittedBlock(
+	slotData SlotDataT,
+) (BeaconBlockT, BlobSidecarsT, error) {
+	var (
+		blk      BeaconBlockT
+		sidecars BlobSidecarsT
+	)
+	s.external.mu.Lock()
+	defer s.external.mu.Unlock()
+
+	submitted := s.external.submitted
+	if submitted == nil || submitted.slot != slotData.GetSlot() {
+		return blk, sidecars, errors.Wrapf(
+			ErrNoSubmittedBlock, "slot %d", slotData.GetSlot(),
+		)
+	}
+	if maxBytes := slotData.GetMaxBytes(); maxBytes > 0 &&
+		submitted.size > maxBytes {
+		return blk, sidecars, errors.Wrapf(
+			ErrProposalTooLarge, "%d bytes, limit %d",
+			submitted.size, maxBytes,
+		)
+	}
+	s.logger.Info(
+		"Proposing beacon block of external proposer",
+		"slot", submitted.slot.Base10(),
+		"block_root", submitted.root,
+		"signature", submitted.signature,
+	)
+	return submitted.blk, submitted.sidecars, nil
+}
+
+// proposerPubkey returns the public key of the validator the node proposes
+// for: the configured key of the external proposer if any, the key of the
+// signer otherwise.
+func (s *Service[
+	_, _, _, _, _, _, _, _, _, _, _, _, _,
+]) proposerPubkey() (crypto.BLSPubkey, error) {
+	if !s.cfg.ExternalProposer {
+		return s.signer.PublicKey(), nil
+	}
+	var pubkey crypto.BLSPubkey
+	if err := pubkey.UnmarshalText(
+		[]byte(s.cfg.ExternalProposerPubkey),
+	); err != nil {
+		return pubkey, errors.Join(ErrInvalidExternalProposerPubkey, err)
+	}
+	return pubkey, nil
+}
+
+// slotTime returns the time at which the given slot starts, if the chain
+// spec anchors slots to a genesis time, and the current time otherwise.
+func (s *Service[
+	_, _, _, _, _, _, _, _, _, _, _, _, _,
+]) slotTime(slot math.Slot) time.Time {
+	genesisTime := s.chainSpec.GenesisTime()
+	if genesisTime == 0 {
+		return time.Now()
+	}
+	//#nosec:G115 // slot times fit in an int64.
+	return time.Unix(int64(payloadtime.SlotTimestamp(
+		math.U64(genesisTime), s.chainSpec.SlotDuration(), slot,
+	).Unwrap()), 0)
+}
+
+// blockSigningRoot returns the root the proposer signs the block over.
+func (s *Service[
+	_, BeaconBlockT, _, BeaconStateT, _, _, _, _, _, _, ForkDataT, _, _,
+]) blockSigningRoot(
+	st BeaconStateT,
+	blk BeaconBlockT,
+) (common.Root, error) {
+	var forkData ForkDataT
+	genesisValidatorsRoot, err := st.GetGenesisValidatorsRoot()
+	if err != nil {
+		return common.Root{}, err
+	}
+	domain := forkData.New(
+		version.FromUint32[common.Version](
+			s.chainSpec.ActiveForkVersionForSlot(blk.GetSlot()),
+		), genesisValidatorsRoot,
+	).ComputeDomain(s.chainSpec.DomainTypeProposer())
+	return signing.ComputeSigningRoot(blk.HashTreeRoot(), domain), nil
+}
@@ -0,0 +1,162 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"context"
+
+	payloadtime "github.com/berachain/beacon-kit/beacon/payload-time"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/errors"
+	gethprimitives "github.com/berachain/beacon-kit/geth-primitives"
+	"github.com/berachain/beacon-kit/primitives/eip4844"
+)
+
+// withoutBlobs is a payload envelope whose blobs are dropped. Proposals in
+// the fallback mode skip blob inclusion, so they may only wrap payloads that
+// carry no blob transactions, see carriesBlobTransactions.
+type withoutBlobs[ExecutionPayloadT any] struct {
+	engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT]
+}
+
+// GetBlobsBundle returns an empty blobs bundle.
+func (withoutBlobs[_]) GetBlobsBundle() engineprimitives.BlobsBundle {
+	return &engineprimitives.BlobsBundleV1[
+		eip4844.KZGCommitment, eip4844.KZGProof, eip4844.Blob,
+	]{}
+}
+
+// carriesBlobTransactions returns true if any of the given EIP-2718 encoded
+// transactions is a blob transaction.
+func carriesBlobTransactions(txs engineprimitives.Transactions) bool {
+	for _, tx := range txs {
+		if len(tx) > 0 && tx[0] == gethprimitives.BlobTxType {
+			return true
+		}
+	}
+	return false
+}
+
+// retrievePayloadWithinDeadline retrieves the execution payload for the
+// block, giving up once the payload deadline has passed. After a number of
+// consecutive missed deadlines the payload is fetched without waiting for the
+// execution client to fill it, and without blobs, so that the proposer keeps
+// producing blocks.
+func (s *Service[
+	_, BeaconBlockT, _, BeaconStateT, _, _, _, _,
+	ExecutionPayloadT, ExecutionPayloadHeaderT, _, _, SlotDataT,
+]) retrievePayloadWithinDeadline(
+	ctx context.Context,
+	st BeaconStateT,
+	blk BeaconBlockT,
+	slotData SlotDataT,
+) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
+	payloadCtx, cancel := ctx, context.CancelFunc(func() {})
+	if s.cfg.PayloadDeadline > 0 {
+		payloadCtx, cancel = context.WithTimeout(ctx, s.cfg.PayloadDeadline)
+	}
+	defer cancel()
+
+	var (
+		envelope engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT]
+		err      error
+		fellBack = s.fallback.Active()
+	)
+	if fellBack {
+		s.metrics.proposedInFallbackMode(blk.GetSlot())
+		envelope, err = s.requestPayloadNow(payloadCtx, st, blk, slotData)
+		switch {
+		case err != nil || envelope == nil:
+		case carriesBlobTransactions(
+			envelope.GetExecutionPayload().GetTransactions(),
+		):
+			// Dropping the blobs would leave the payload committing to
+			// sidecars that are never published, so use the local build
+			// with its blobs instead.
+			s.logger.Warn(
+				"Fallback payload carries blob transactions - "+
+					"retrieving the local build instead",
+				"slot", blk.GetSlot().Base10(),
+			)
+			envelope, err = s.retrieveExecutionPayload(
+				payloadCtx, st, blk, slotData,
+			)
+		default:
+			envelope = withoutBlobs[ExecutionPayloadT]{envelope}
+		}
+	} else {
+		envelope, err = s.retrieveExecutionPayload(
+			payloadCtx, st, blk, slotData,
+		)
+	}
+
+	missed := errors.Is(payloadCtx.Err(), context.DeadlineExceeded)
+	if missed {
+		s.metrics.missedPayloadDeadline(blk.GetSlot())
+	}
+	if s.fallback.Observe(fellBack, missed) {
+		s.logger.Warn(
+			"Execution client keeps missing the payload deadline - "+
+				"proposing payloads without waiting for them to be filled",
+			"slot", blk.GetSlot().Base10(),
+			"payload_deadline", s.cfg.PayloadDeadline.String(),
+			"num_proposals", s.cfg.FallbackAfterMissedDeadlines,
+		)
+	}
+	return envelope, err
+}
+
+// requestPayloadNow requests the execution payload for the block and fetches
+// it without waiting for the execution client to fill it.
+func (s *Service[
+	_, BeaconBlockT, _, BeaconStateT, _, _, _, _,
+	ExecutionPayloadT, ExecutionPayloadHeaderT, _, _, SlotDataT,
+]) requestPayloadNow(
+	ctx context.Context,
+	st BeaconStateT,
+	blk BeaconBlockT,
+	slotData SlotDataT,
+) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
+	lph, err := st.GetLatestExecutionPayloadHeader()
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Warn(
+		"Proposing in fallback mode - not waiting for the payload to be filled",
+		"slot", blk.GetSlot().Base10(),
+	)
+	return s.localPayloadBuilder.RequestPayloadNow(
+		ctx,
+		st,
+		blk.GetSlot(),
+		payloadtime.ForSlot(
+			s.chainSpec,
+			blk.GetSlot(),
+			slotData.GetConsensusTime(),
+			lph.GetTimestamp(),
+			false, // buildOptimistically
+		).Unwrap(),
+		blk.GetParentBlockRoot(),
+		lph.GetBlockHash(),
+		lph.GetParentHash(),
+	)
+}
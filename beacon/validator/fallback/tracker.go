@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package fallback
+
+// Tracker tracks the payload deadlines missed by the proposer, to fall back
+// to proposing payloads as is while the execution client is too slow to fill
+// them in time.
+type Tracker struct {
+	// threshold is the number of consecutive missed deadlines before
+	// falling back, and the number of proposals the fallback lasts for.
+	threshold uint64
+	// missed is the number of consecutive missed deadlines.
+	missed uint64
+	// remaining is the number of proposals left in the fallback mode.
+	remaining uint64
+}
+
+// NewTracker creates a tracker falling back after threshold consecutive
+// missed deadlines, for as many proposals. Zero disables the fallback.
+func NewTracker(threshold uint64) *Tracker {
+	return &Tracker{threshold: threshold}
+}
+
+// Active returns true if the next proposal should fall back.
+func (t *Tracker) Active() bool {
+	return t.remaining > 0
+}
+
+// Observe records the outcome of a proposal and returns true if the
+// proposer just entered the fallback mode.
+func (t *Tracker) Observe(fellBack, missed bool) bool {
+	if fellBack {
+		t.remaining--
+		return false
+	}
+	if !missed {
+		t.missed = 0
+		return false
+	}
+	t.missed++
+	if t.threshold == 0 || t.missed < t.threshold {
+		return false
+	}
+	t.missed = 0
+	t.remaining = t.threshold
+	return true
+}
+
+// Engage makes the next proposals fall back regardless of missed deadlines,
+// for threshold proposals or at least the next one.
+func (t *Tracker) Engage() {
+	t.missed = 0
+	t.remaining = max(t.threshold, 1)
+}
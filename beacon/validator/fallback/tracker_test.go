@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package fallback_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/beacon/validator/fallback"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTracker_Disabled(t *testing.T) {
+	tracker := fallback.NewTracker(0)
+	for range 10 {
+		require.False(t, tracker.Observe(false, true))
+		require.False(t, tracker.Active())
+	}
+}
+
+func TestTracker_EntersAfterConsecutiveMisses(t *testing.T) {
+	tracker := fallback.NewTracker(3)
+
+	// A met deadline resets the count of consecutive misses.
+	require.False(t, tracker.Observe(false, true))
+	require.False(t, tracker.Observe(false, true))
+	require.False(t, tracker.Observe(false, false))
+	require.False(t, tracker.Active())
+
+	require.False(t, tracker.Observe(false, true))
+	require.False(t, tracker.Observe(false, true))
+	require.True(t, tracker.Observe(false, true))
+	require.True(t, tracker.Active())
+}
+
+func TestTracker_RecoversAfterThresholdProposals(t *testing.T) {
+	tracker := fallback.NewTracker(2)
+	require.False(t, tracker.Observe(false, true))
+	require.True(t, tracker.Observe(false, true))
+
+	// The fallback lasts for threshold proposals, missed or not.
+	require.True(t, tracker.Active())
+	require.False(t, tracker.Observe(true, true))
+	require.True(t, tracker.Active())
+	require.False(t, tracker.Observe(true, false))
+	require.False(t, tracker.Active())
+
+	// Misses are counted afresh once recovered.
+	require.False(t, tracker.Observe(false, true))
+	require.False(t, tracker.Active())
+	require.True(t, tracker.Observe(false, true))
+	require.True(t, tracker.Active())
+}
+
+func TestTracker_Engage(t *testing.T) {
+	tracker := fallback.NewTracker(2)
+	require.False(t, tracker.Observe(false, true))
+	tracker.Engage()
+	require.True(t, tracker.Active())
+	require.False(t, tracker.Observe(true, false))
+	require.True(t, tracker.Active())
+	require.False(t, tracker.Observe(true, false))
+	require.False(t, tracker.Active())
+
+	// The count of missed deadlines starts afresh.
+	require.False(t, tracker.Observe(false, true))
+	require.False(t, tracker.Active())
+
+	// Engaging a disabled tracker falls back for the next proposal only.
+	tracker = fallback.NewTracker(0)
+	tracker.Engage()
+	require.True(t, tracker.Active())
+	require.False(t, tracker.Observe(true, false))
+	require.False(t, tracker.Active())
+}
@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package lease
+
+import "github.com/berachain/beacon-kit/errors"
+
+var (
+	// ErrLeaseHeld is returned when the lease is held by another node that
+	// has renewed it within the lease duration.
+	ErrLeaseHeld = errors.New("signing lease is held by another node")
+
+	// ErrLeaseNotHeld is returned when renewing a lease this node does not
+	// hold.
+	ErrLeaseNotHeld = errors.New("signing lease is not held by this node")
+
+	// ErrSlotClaimed is returned when another node claimed the requested
+	// slot, or a later one, before its lease expired. The node may have
+	// proposed the slot, so it must not be proposed again.
+	ErrSlotClaimed = errors.New("slot was claimed by another node")
+
+	// ErrLeaseLocked is returned when another node is updating the lease.
+	ErrLeaseLocked = errors.New("signing lease is being updated")
+)
@@ -0,0 +1,207 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package lease
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/gofrs/flock"
+)
+
+const (
+	// lockSuffix is the suffix of the file guarding updates of the lease.
+	lockSuffix = ".lock"
+	// lockTimeout is the time to wait for another node updating the lease.
+	lockTimeout = time.Second
+	// lockRetryDelay is the delay between two attempts to take the lock.
+	lockRetryDelay = 10 * time.Millisecond
+)
+
+// record is the content of the lease file.
+type record struct {
+	// Holder identifies the node holding the lease.
+	Holder string `json:"holder"`
+	// Slot is the latest slot claimed by the holder.
+	Slot math.Slot `json:"slot"`
+	// Expiry is the time after which another node may take the lease.
+	Expiry time.Time `json:"expiry"`
+}
+
+// FileLease is a signing lease kept in a file shared by redundant nodes
+// running the same validator keys, e.g. on a shared volume. A node must
+// acquire the lease for a slot before signing anything for it, so that only
+// the active node signs while the passive node takes over once the active
+// node stopped renewing the lease for the lease duration.
+type FileLease struct {
+	// path is the path of the lease file.
+	path string
+	// holder identifies this node in the lease file.
+	holder string
+	// duration is the time the lease is held for after each renewal.
+	duration time.Duration
+}
+
+// NewFileLease returns a signing lease kept in the file at the given path,
+// held by the given holder for the given duration after each renewal.
+func NewFileLease(
+	path string,
+	holder string,
+	duration time.Duration,
+) *FileLease {
+	return &FileLease{
+		path:     path,
+		holder:   holder,
+		duration: duration,
+	}
+}
+
+// Acquire acquires or renews the lease to sign for the given slot. It fails
+// if another node holds the lease, or if another node claimed the slot
+// before its lease expired.
+func (l *FileLease) Acquire(slot math.Slot) error {
+	unlock, err := l.lock()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	rec, err := l.read()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	if rec != nil && rec.Holder != l.holder {
+		if now.Before(rec.Expiry) {
+			return errors.Wrapf(
+				ErrLeaseHeld, "holder %s until %s",
+				rec.Holder, rec.Expiry.Format(time.RFC3339),
+			)
+		}
+		if slot <= rec.Slot {
+			return errors.Wrapf(
+				ErrSlotClaimed, "slot %d claimed by %s up to slot %d",
+				slot, rec.Holder, rec.Slot,
+			)
+		}
+	}
+
+	next := record{
+		Holder: l.holder,
+		Slot:   slot,
+		Expiry: now.Add(l.duration),
+	}
+	if rec != nil && rec.Holder == l.holder && rec.Slot > slot {
+		next.Slot = rec.Slot
+	}
+	return l.write(next)
+}
+
+// Renew renews the lease held by this node, keeping the slot it claimed. It
+// fails if the lease was never acquired or is held by another node.
+func (l *FileLease) Renew() error {
+	unlock, err := l.lock()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	rec, err := l.read()
+	if err != nil {
+		return err
+	}
+	if rec == nil {
+		return ErrLeaseNotHeld
+	}
+	if rec.Holder != l.holder {
+		return errors.Wrapf(ErrLeaseNotHeld, "held by %s", rec.Holder)
+	}
+	rec.Expiry = time.Now().Add(l.duration)
+	return l.write(*rec)
+}
+
+// lock takes the lock guarding updates of the lease and returns a function
+// releasing it. The lock is an advisory lock of the operating system, which
+// is released when the node holding it stops, so it is never left behind.
+func (l *FileLease) lock() (func(), error) {
+	ctx, cancel := context.WithTimeout(context.Background(), lockTimeout)
+	defer cancel()
+
+	fl := flock.New(l.path + lockSuffix)
+	locked, err := fl.TryLockContext(ctx, lockRetryDelay)
+	if errors.Is(err, context.DeadlineExceeded) || (err == nil && !locked) {
+		return nil, ErrLeaseLocked
+	} else if err != nil {
+		return nil, err
+	}
+	return func() { _ = fl.Unlock() }, nil
+}
+
+// read returns the record of the lease file, or nil if the lease was never
+// acquired.
+func (l *FileLease) read() (*record, error) {
+	bz, err := os.ReadFile(l.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil //nolint:nilnil // no lease is not an error.
+	} else if err != nil {
+		return nil, err
+	}
+
+	rec := new(record)
+	if err = json.Unmarshal(bz, rec); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode lease %s", l.path)
+	}
+	return rec, nil
+}
+
+// write replaces the record of the lease file, such that a node reading it
+// concurrently never observes a partial record.
+func (l *FileLease) write(rec record) error {
+	bz, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(l.path), filepath.Base(l.path))
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	if _, err = tmp.Write(bz); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err = tmp.Sync(); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), l.path)
+}
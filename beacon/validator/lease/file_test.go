@@ -0,0 +1,100 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package lease_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/beacon/validator/lease"
+	"github.com/gofrs/flock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileLease_HeldByOtherNode(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "lease.json")
+	active := lease.NewFileLease(path, "active", time.Hour)
+	passive := lease.NewFileLease(path, "passive", time.Hour)
+
+	require.NoError(t, active.Acquire(1))
+	require.ErrorIs(t, passive.Acquire(1), lease.ErrLeaseHeld)
+	require.ErrorIs(t, passive.Acquire(2), lease.ErrLeaseHeld)
+
+	// The holder renews the lease, including for the same slot in a later
+	// round.
+	require.NoError(t, active.Acquire(1))
+	require.NoError(t, active.Acquire(2))
+}
+
+func TestFileLease_TakeOverAfterExpiry(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "lease.json")
+	active := lease.NewFileLease(path, "active", time.Millisecond)
+	passive := lease.NewFileLease(path, "passive", time.Hour)
+
+	require.NoError(t, active.Acquire(5))
+	time.Sleep(5 * time.Millisecond)
+
+	// The slots claimed by the previous holder are never proposed again.
+	require.ErrorIs(t, passive.Acquire(5), lease.ErrSlotClaimed)
+	require.ErrorIs(t, passive.Acquire(4), lease.ErrSlotClaimed)
+	require.NoError(t, passive.Acquire(6))
+
+	// The previous holder does not get the lease back.
+	require.ErrorIs(t, active.Acquire(7), lease.ErrLeaseHeld)
+}
+
+func TestFileLease_Locked(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "lease.json")
+	l := lease.NewFileLease(path, "active", time.Hour)
+
+	// A lock file left behind by a stopped node does not block the lease.
+	require.NoError(t, os.WriteFile(path+".lock", nil, 0o600))
+	require.NoError(t, l.Acquire(1))
+
+	// A node updating the lease blocks the others until it is done.
+	other := flock.New(path + ".lock")
+	locked, err := other.TryLock()
+	require.NoError(t, err)
+	require.True(t, locked)
+	require.ErrorIs(t, l.Acquire(2), lease.ErrLeaseLocked)
+	require.NoError(t, other.Unlock())
+	require.NoError(t, l.Acquire(2))
+}
+
+func TestFileLease_Renew(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "lease.json")
+	active := lease.NewFileLease(path, "active", time.Hour)
+	passive := lease.NewFileLease(path, "passive", time.Hour)
+
+	require.ErrorIs(t, active.Renew(), lease.ErrLeaseNotHeld)
+	require.NoError(t, active.Acquire(3))
+	require.NoError(t, active.Renew())
+	require.ErrorIs(t, passive.Renew(), lease.ErrLeaseNotHeld)
+
+	// Renewing keeps the slot claimed by the holder.
+	short := lease.NewFileLease(path, "active", time.Millisecond)
+	require.NoError(t, short.Renew())
+	time.Sleep(5 * time.Millisecond)
+	require.ErrorIs(t, passive.Acquire(3), lease.ErrSlotClaimed)
+	require.NoError(t, passive.Acquire(4))
+}
@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package lease
+
+import (
+	"github.com/berachain/beacon-kit/primitives/math"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+// PrivValidator is a CometBFT private validator that only signs while this
+// node holds the signing lease. Votes and proposals acquire the lease for
+// their height, which renews it on every height the node signs at, while
+// other messages require the lease to be held already.
+type PrivValidator struct {
+	cmttypes.PrivValidator
+	// lease is acquired or renewed before every signature.
+	lease *FileLease
+}
+
+// NewPrivValidator returns the given private validator guarded by the given
+// lease.
+func NewPrivValidator(
+	pv cmttypes.PrivValidator,
+	lease *FileLease,
+) *PrivValidator {
+	return &PrivValidator{PrivValidator: pv, lease: lease}
+}
+
+// SignVote signs the vote once the lease for its height is acquired.
+func (pv *PrivValidator) SignVote(
+	chainID string,
+	vote *cmtproto.Vote,
+	signExtension bool,
+) error {
+	//#nosec:G115 // heights are positive.
+	if err := pv.lease.Acquire(math.Slot(vote.Height)); err != nil {
+		return err
+	}
+	return pv.PrivValidator.SignVote(chainID, vote, signExtension)
+}
+
+// SignProposal signs the proposal once the lease for its height is
+// acquired.
+func (pv *PrivValidator) SignProposal(
+	chainID string,
+	proposal *cmtproto.Proposal,
+) error {
+	//#nosec:G115 // heights are positive.
+	if err := pv.lease.Acquire(math.Slot(proposal.Height)); err != nil {
+		return err
+	}
+	return pv.PrivValidator.SignProposal(chainID, proposal)
+}
+
+// SignBytes signs the bytes once the lease held by this node is renewed.
+func (pv *PrivValidator) SignBytes(bz []byte) ([]byte, error) {
+	if err := pv.lease.Renew(); err != nil {
+		return nil, err
+	}
+	return pv.PrivValidator.SignBytes(bz)
+}
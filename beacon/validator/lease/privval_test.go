@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package lease_test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/beacon/validator/lease"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrivValidator_SignsOnlyWithLease(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "lease.json")
+	mockPV := cmttypes.NewMockPV()
+	active := lease.NewPrivValidator(
+		mockPV, lease.NewFileLease(path, "active", time.Hour),
+	)
+	passive := lease.NewPrivValidator(
+		mockPV, lease.NewFileLease(path, "passive", time.Hour),
+	)
+
+	// Nothing is signed before a vote or a proposal acquired the lease.
+	_, err := active.SignBytes([]byte("randao"))
+	require.ErrorIs(t, err, lease.ErrLeaseNotHeld)
+
+	vote := &cmtproto.Vote{
+		Type:   cmtproto.PrevoteType,
+		Height: 1,
+	}
+	require.NoError(t, active.SignVote("chain", vote, false))
+	require.NotEmpty(t, vote.Signature)
+	_, err = active.SignBytes([]byte("randao"))
+	require.NoError(t, err)
+
+	// The passive node signs neither votes nor proposals nor bytes.
+	vote = &cmtproto.Vote{Type: cmtproto.PrecommitType, Height: 2}
+	require.ErrorIs(
+		t, passive.SignVote("chain", vote, false), lease.ErrLeaseHeld,
+	)
+	require.Empty(t, vote.Signature)
+	proposal := &cmtproto.Proposal{Type: cmtproto.ProposalType, Height: 2}
+	require.ErrorIs(
+		t, passive.SignProposal("chain", proposal), lease.ErrLeaseHeld,
+	)
+	require.Empty(t, proposal.Signature)
+	_, err = passive.SignBytes([]byte("randao"))
+	require.ErrorIs(t, err, lease.ErrLeaseNotHeld)
+
+	// The active node keeps signing.
+	require.NoError(t, active.SignProposal("chain", proposal))
+	require.NotEmpty(t, proposal.Signature)
+}
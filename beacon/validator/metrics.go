@@ -74,3 +74,37 @@ func (cm *validatorMetrics) failedToRetrievePayload(
 		err.Error(),
 	)
 }
+
+// skippedProposalExecutionNotReady increments the counter for the number of
+// proposals skipped because the execution client was not ready to build.
+func (cm *validatorMetrics) skippedProposalExecutionNotReady(
+	slot math.Slot, err error,
+) {
+	cm.sink.IncrementCounter(
+		"beacon_kit.validator.skipped_proposal_execution_not_ready",
+		"slot",
+		slot.Base10(),
+		"error",
+		err.Error(),
+	)
+}
+
+// missedPayloadDeadline increments the counter for the number of proposals
+// whose execution payload was not delivered within the deadline.
+func (cm *validatorMetrics) missedPayloadDeadline(slot math.Slot) {
+	cm.sink.IncrementCounter(
+		"beacon_kit.validator.missed_payload_deadline",
+		"slot",
+		slot.Base10(),
+	)
+}
+
+// proposedInFallbackMode increments the counter for the number of proposals
+// built in the fallback mode.
+func (cm *validatorMetrics) proposedInFallbackMode(slot math.Slot) {
+	cm.sink.IncrementCounter(
+		"beacon_kit.validator.fallback_proposal",
+		"slot",
+		slot.Base10(),
+	)
+}
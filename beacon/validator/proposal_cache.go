@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import "github.com/berachain/beacon-kit/primitives/math"
+
+// proposalKey identifies the inputs a proposal is built from. CometBFT
+// requests a new proposal for the same height whenever a round fails, and
+// since the consensus time is the median time of the last commit it is the
+// same in every round of a height. A proposal built in an earlier round can
+// therefore be served again instead of being rebuilt.
+type proposalKey struct {
+	slot            math.Slot
+	proposerAddress string
+	consensusTime   math.U64
+	maxBytes        uint64
+}
+
+// builtProposal is the block and sidecars built for a proposal key.
+type builtProposal[BeaconBlockT, BlobSidecarsT any] struct {
+	key      proposalKey
+	blk      BeaconBlockT
+	sidecars BlobSidecarsT
+	// size is the size of the encoded block and sidecars.
+	size uint64
+}
+
+// newProposalKey returns the key of the proposal requested by the slot data.
+func newProposalKey[SlotDataT interface {
+	GetSlot() math.Slot
+	GetProposerAddress() []byte
+	GetConsensusTime() math.U64
+	GetMaxBytes() uint64
+}](slotData SlotDataT) proposalKey {
+	return proposalKey{
+		slot:            slotData.GetSlot(),
+		proposerAddress: string(slotData.GetProposerAddress()),
+		consensusTime:   slotData.GetConsensusTime(),
+		maxBytes:        slotData.GetMaxBytes(),
+	}
+}
+
+// proposalCache holds the most recently built proposal. Only the proposal of
+// the current height can be requested again, so building a proposal evicts
+// the previous one.
+type proposalCache[BeaconBlockT, BlobSidecarsT any] struct {
+	latest *builtProposal[BeaconBlockT, BlobSidecarsT]
+}
+
+// get returns the proposal built for the key, if it is the latest one.
+func (c *proposalCache[BeaconBlockT, BlobSidecarsT]) get(
+	key proposalKey,
+) (*builtProposal[BeaconBlockT, BlobSidecarsT], bool) {
+	if c.latest == nil || c.latest.key != key {
+		return nil, false
+	}
+	return c.latest, true
+}
+
+// put records the proposal built for the key, evicting the previous one.
+func (c *proposalCache[BeaconBlockT, BlobSidecarsT]) put(
+	key proposalKey,
+	built *builtProposal[BeaconBlockT, BlobSidecarsT],
+) {
+	built.key = key
+	c.latest = built
+}
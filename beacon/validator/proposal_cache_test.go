@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/beacon/validator"
+	consensustypes "github.com/berachain/beacon-kit/consensus/types"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/stretchr/testify/require"
+)
+
+func newSlotData(
+	slot math.Slot, proposer string, consensusTime int64, maxBytes uint64,
+) *consensustypes.SlotData[any, any] {
+	slotData := new(consensustypes.SlotData[any, any]).New(
+		slot, nil, nil, []byte(proposer), time.Unix(consensusTime, 0),
+	)
+	slotData.SetMaxBytes(maxBytes)
+	return slotData
+}
+
+func TestProposalCache(t *testing.T) {
+	built := newSlotData(10, "proposer", 100, 1024)
+	tests := []struct {
+		name      string
+		requested *consensustypes.SlotData[any, any]
+		hit       bool
+	}{
+		{
+			name:      "later round of the height",
+			requested: newSlotData(10, "proposer", 100, 1024),
+			hit:       true,
+		},
+		{
+			name:      "later round with another proposer",
+			requested: newSlotData(10, "other", 100, 1024),
+		},
+		{
+			name:      "next height",
+			requested: newSlotData(11, "proposer", 100, 1024),
+		},
+		{
+			name:      "other consensus time",
+			requested: newSlotData(10, "proposer", 101, 1024),
+		},
+		{
+			name:      "other size limit",
+			requested: newSlotData(10, "proposer", 100, 512),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var cache validator.ProposalCache[string, int]
+			_, _, ok := cache.Get(tt.requested)
+			require.False(t, ok)
+
+			cache.Put(built, "block", 3)
+			blk, sidecars, ok := cache.Get(tt.requested)
+			require.Equal(t, tt.hit, ok)
+			if tt.hit {
+				require.Equal(t, "block", blk)
+				require.Equal(t, 3, sidecars)
+			}
+		})
+	}
+}
+
+func TestProposalCacheEvictsThePreviousProposal(t *testing.T) {
+	var (
+		cache  validator.ProposalCache[string, int]
+		first  = newSlotData(10, "proposer", 100, 1024)
+		second = newSlotData(11, "proposer", 102, 1024)
+	)
+	cache.Put(first, "first", 1)
+	cache.Put(second, "second", 2)
+
+	_, _, ok := cache.Get(first)
+	require.False(t, ok)
+	blk, sidecars, ok := cache.Get(second)
+	require.True(t, ok)
+	require.Equal(t, "second", blk)
+	require.Equal(t, 2, sidecars)
+}
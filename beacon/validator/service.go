@@ -24,10 +24,12 @@ import (
 	"context"
 
 	asynctypes "github.com/berachain/beacon-kit/async/types"
+	"github.com/berachain/beacon-kit/beacon/validator/fallback"
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/primitives/async"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/primitives/transition"
 )
 
@@ -38,16 +40,16 @@ type Service[
 	BeaconBlockBodyT BeaconBlockBody[
 		AttestationDataT, DepositT, Eth1DataT, ExecutionPayloadT, SlashingInfoT,
 	],
-	BeaconStateT BeaconState[ExecutionPayloadHeaderT],
+	BeaconStateT BeaconState[BeaconStateT, ExecutionPayloadHeaderT],
 	BlobSidecarsT any,
 	DepositT any,
 	DepositStoreT DepositStore[DepositT],
 	Eth1DataT Eth1Data[Eth1DataT],
-	ExecutionPayloadT any,
+	ExecutionPayloadT ExecutionPayload,
 	ExecutionPayloadHeaderT ExecutionPayloadHeader,
 	ForkDataT ForkData[ForkDataT],
 	SlashingInfoT any,
-	SlotDataT SlotData[AttestationDataT, SlashingInfoT],
+	SlotDataT SlotData[SlotDataT, AttestationDataT, SlashingInfoT],
 ] struct {
 	// cfg is the validator config.
 	cfg *Config
@@ -68,6 +70,7 @@ type Service[
 		BeaconBlockT,
 		BeaconStateT,
 		*transition.Context,
+		Eth1DataT,
 		ExecutionPayloadHeaderT,
 	]
 	// localPayloadBuilder represents the local block builder, this builder
@@ -78,8 +81,24 @@ type Service[
 	// remotePayloadBuilders represents a list of remote block builders, these
 	// builders are connected to other execution clients via the EngineAPI.
 	remotePayloadBuilders []PayloadBuilder[BeaconStateT, ExecutionPayloadT]
+	// executionSyncer reports the sync status of the execution client. A
+	// nil syncer disables the sync check before proposing.
+	executionSyncer ExecutionSyncer
+	// lease is acquired before every proposal. A nil lease disables the
+	// check.
+	lease ProposalLease
+	// operations supplies the application-defined operations of the
+	// proposals. A nil pool proposes no operations.
+	operations OperationPool
 	// metrics is a metrics collector.
 	metrics *validatorMetrics
+	// proposals holds the most recently built proposal, served again if the
+	// same proposal is requested in a later round.
+	proposals proposalCache[BeaconBlockT, BlobSidecarsT]
+	// fallback tracks the missed payload deadlines of the proposer.
+	fallback *fallback.Tracker
+	// external holds the blocks produced for the external proposer.
+	external *externalProposals[BeaconBlockT, BlobSidecarsT]
 	// subNewSlot is a channel to hold NewSlot events.
 	subNewSlot chan async.Event[SlotDataT]
 }
@@ -91,16 +110,16 @@ func NewService[
 	BeaconBlockBodyT BeaconBlockBody[
 		AttestationDataT, DepositT, Eth1DataT, ExecutionPayloadT, SlashingInfoT,
 	],
-	BeaconStateT BeaconState[ExecutionPayloadHeaderT],
+	BeaconStateT BeaconState[BeaconStateT, ExecutionPayloadHeaderT],
 	BlobSidecarsT any,
 	DepositT any,
 	DepositStoreT DepositStore[DepositT],
 	Eth1DataT Eth1Data[Eth1DataT],
-	ExecutionPayloadT any,
+	ExecutionPayloadT ExecutionPayload,
 	ExecutionPayloadHeaderT ExecutionPayloadHeader,
 	ForkDataT ForkData[ForkDataT],
 	SlashingInfoT any,
-	SlotDataT SlotData[AttestationDataT, SlashingInfoT],
+	SlotDataT SlotData[SlotDataT, AttestationDataT, SlashingInfoT],
 ](
 	cfg *Config,
 	logger log.Logger,
@@ -110,12 +129,16 @@ func NewService[
 		BeaconBlockT,
 		BeaconStateT,
 		*transition.Context,
+		Eth1DataT,
 		ExecutionPayloadHeaderT,
 	],
 	signer crypto.BLSSigner,
 	blobFactory BlobFactory[BeaconBlockT, BlobSidecarsT],
 	localPayloadBuilder PayloadBuilder[BeaconStateT, ExecutionPayloadT],
 	remotePayloadBuilders []PayloadBuilder[BeaconStateT, ExecutionPayloadT],
+	executionSyncer ExecutionSyncer,
+	lease ProposalLease,
+	operations OperationPool,
 	ts TelemetrySink,
 	dispatcher asynctypes.EventDispatcher,
 ) *Service[
@@ -138,9 +161,16 @@ func NewService[
 		blobFactory:           blobFactory,
 		localPayloadBuilder:   localPayloadBuilder,
 		remotePayloadBuilders: remotePayloadBuilders,
+		executionSyncer:       executionSyncer,
+		lease:                 lease,
+		operations:            operations,
 		metrics:               newValidatorMetrics(ts),
-		dispatcher:            dispatcher,
-		subNewSlot:            make(chan async.Event[SlotDataT]),
+		fallback: fallback.NewTracker(
+			cfg.FallbackAfterMissedDeadlines,
+		),
+		external:   newExternalProposals[BeaconBlockT, BlobSidecarsT](),
+		dispatcher: dispatcher,
+		subNewSlot: make(chan async.Event[SlotDataT]),
 	}
 }
 
@@ -200,6 +230,7 @@ func (s *Service[
 	if err != nil {
 		s.logger.Error("failed to build block", "err", err)
 	}
+	s.publishProposal(req.Context(), req.Data().GetSlot(), blk, err)
 
 	// emit a built block event with the built block and the error
 	if bbErr := s.dispatcher.Publish(
@@ -215,3 +246,24 @@ func (s *Service[
 		s.logger.Error("failed to dispatch built sidecars", "err", err)
 	}
 }
+
+// publishProposal emits a ProposalBuilt event with the outcome of building
+// the block for the given slot.
+func (s *Service[
+	_, BeaconBlockT, _, _, _, _, _, _, _, _, _, _, _,
+]) publishProposal(
+	ctx context.Context,
+	slot math.Slot,
+	blk BeaconBlockT,
+	err error,
+) {
+	proposal := async.Proposal{Slot: slot}
+	if err == nil {
+		proposal.BlockRoot = blk.HashTreeRoot()
+	}
+	if pErr := s.dispatcher.Publish(
+		async.NewEvent(ctx, async.ProposalBuilt, proposal, err),
+	); pErr != nil {
+		s.logger.Error("failed to dispatch proposal built", "err", pErr)
+	}
+}
@@ -38,7 +38,7 @@ type BeaconBlock[
 	T any,
 	BeaconBlockBodyT any,
 ] interface {
-	constraints.SSZMarshallable
+	constraints.SSZMarshallableRootable
 	// NewWithVersion creates a new beacon block with the given parameters.
 	NewWithVersion(
 		slot math.Slot,
@@ -70,6 +70,9 @@ type BeaconBlockBody[
 	SetEth1Data(Eth1DataT)
 	// SetDeposits sets the deposits of the beacon block body.
 	SetDeposits([]DepositT)
+	// GetExecutionPayload returns the execution data of the beacon block
+	// body.
+	GetExecutionPayload() ExecutionPayloadT
 	// SetExecutionPayload sets the execution data of the beacon block body.
 	SetExecutionPayload(ExecutionPayloadT)
 	// SetGraffiti sets the graffiti of the beacon block body.
@@ -81,10 +84,15 @@ type BeaconBlockBody[
 	// SetBlobKzgCommitments sets the blob KZG commitments of the beacon block
 	// body.
 	SetBlobKzgCommitments(eip4844.KZGCommitments[common.ExecutionHash])
+	// SetOperations sets the application-defined operations of the beacon
+	// block body.
+	SetOperations([]*transition.Operation)
 }
 
 // BeaconState represents a beacon state interface.
-type BeaconState[ExecutionPayloadHeaderT any] interface {
+type BeaconState[T, ExecutionPayloadHeaderT any] interface {
+	// Copy returns a copy of the beacon state.
+	Copy() T
 	// GetBlockRootAtIndex returns the block root at the given index.
 	GetBlockRootAtIndex(uint64) (common.Root, error)
 	// GetLatestExecutionPayloadHeader returns the latest execution payload
@@ -115,6 +123,9 @@ type BlobFactory[
 		blk BeaconBlockT,
 		blobs engineprimitives.BlobsBundle,
 	) (BlobSidecarsT, error)
+	// SidecarsSize returns the size of the encoded sidecars built for the
+	// given blobs bundle.
+	SidecarsSize(blobs engineprimitives.BlobsBundle) uint64
 }
 
 // DepositStore defines the interface for deposit storage.
@@ -136,16 +147,52 @@ type Eth1Data[T any] interface {
 	) T
 }
 
+// ExecutionPayload represents the execution payload interface.
+type ExecutionPayload interface {
+	// GetTransactions returns the transactions of the execution payload.
+	GetTransactions() engineprimitives.Transactions
+}
+
 // ExecutionPayloadHeader represents the execution payload header interface.
 type ExecutionPayloadHeader interface {
 	// GetTimestamp returns the timestamp of the execution payload header.
 	GetTimestamp() math.U64
 	// GetBlockHash returns the block hash of the execution payload header.
 	GetBlockHash() common.ExecutionHash
+	// GetNumber returns the block number of the execution payload header.
+	GetNumber() math.U64
 	// GetParentHash returns the parent hash of the execution payload header.
 	GetParentHash() common.ExecutionHash
 }
 
+// ExecutionSyncer reports the sync status of the execution client.
+type ExecutionSyncer interface {
+	// IsSyncing returns true if the execution client is still syncing.
+	IsSyncing(ctx context.Context) (bool, error)
+	// LatestExecutionHead returns the number and hash of the execution
+	// client's head block.
+	LatestExecutionHead(
+		ctx context.Context,
+	) (math.U64, common.ExecutionHash, error)
+}
+
+// OperationPool supplies the application-defined operations to include in
+// the proposed blocks.
+type OperationPool interface {
+	// PendingOperations returns the operations to include in the block
+	// proposed for the given slot.
+	PendingOperations(
+		ctx context.Context, slot math.Slot,
+	) ([]*transition.Operation, error)
+}
+
+// ProposalLease guards proposals against redundant nodes running the same
+// validator keys.
+type ProposalLease interface {
+	// Acquire acquires or renews the lease to propose the given slot.
+	Acquire(slot math.Slot) error
+}
+
 // ForkData represents the fork data interface.
 type ForkData[T any] interface {
 	// New creates a new fork data with the given parameters.
@@ -153,6 +200,8 @@ type ForkData[T any] interface {
 		common.Version,
 		common.Root,
 	) T
+	// ComputeDomain computes the signing domain of the given domain type.
+	ComputeDomain(common.DomainType) common.Domain
 	// ComputeRandaoSigningRoot computes the Randao signing root.
 	ComputeRandaoSigningRoot(
 		common.DomainType,
@@ -180,10 +229,29 @@ type PayloadBuilder[BeaconStateT, ExecutionPayloadT any] interface {
 		headEth1BlockHash common.ExecutionHash,
 		finalEth1BlockHash common.ExecutionHash,
 	) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error)
+	// RequestPayloadNow requests a payload for the given slot and fetches
+	// it without waiting for it to be filled.
+	RequestPayloadNow(
+		ctx context.Context,
+		st BeaconStateT,
+		slot math.Slot,
+		timestamp uint64,
+		parentBlockRoot common.Root,
+		headEth1BlockHash common.ExecutionHash,
+		finalEth1BlockHash common.ExecutionHash,
+	) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error)
 }
 
 // SlotData represents the slot data interface.
-type SlotData[AttestationDataT, SlashingInfoT any] interface {
+type SlotData[T, AttestationDataT, SlashingInfoT any] interface {
+	// New creates a new slot data with the given parameters.
+	New(
+		slot math.Slot,
+		attestationData []AttestationDataT,
+		slashingInfo []SlashingInfoT,
+		proposerAddress []byte,
+		consensusTime time.Time,
+	) T
 	// GetSlot returns the slot of the incoming slot.
 	GetSlot() math.Slot
 	// GetAttestationData returns the attestation data of the incoming slot.
@@ -196,6 +264,9 @@ type SlotData[AttestationDataT, SlashingInfoT any] interface {
 	// GetConsensusTime returns the timestamp of current consensus request.
 	// It is used to build next payload and to validate currentpayload.
 	GetConsensusTime() math.U64
+	// GetMaxBytes returns the number of bytes the encoded beacon block and
+	// blob sidecars may occupy in the proposal. Zero means unbounded.
+	GetMaxBytes() uint64
 }
 
 // StateProcessor defines the interface for processing the state.
@@ -203,8 +274,12 @@ type StateProcessor[
 	BeaconBlockT any,
 	BeaconStateT any,
 	ContextT any,
+	Eth1DataT any,
 	ExecutionPayloadHeaderT any,
 ] interface {
+	// Eth1Data returns the Eth1Data the block must carry on top of the
+	// state.
+	Eth1Data(st BeaconStateT, blk BeaconBlockT) (Eth1DataT, error)
 	// ProcessSlot processes the slot.
 	ProcessSlots(
 		st BeaconStateT, slot math.Slot,
@@ -20,6 +20,13 @@
 
 package chain
 
+import (
+	"time"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/bytes"
+)
+
 // Spec defines an interface for accessing chain-specific parameters.
 type Spec[
 	DomainTypeT ~[4]byte,
@@ -41,6 +48,10 @@ type Spec[
 	// EjectionBalance returns the balance below which a validator is ejected.
 	EjectionBalance() uint64
 
+	// MinActivationBalance returns the minimum effective balance of a
+	// validator joining the registry.
+	MinActivationBalance() uint64
+
 	// EffectiveBalanceIncrement returns the increment of balance used in reward
 	// calculations.
 	EffectiveBalanceIncrement() uint64
@@ -70,6 +81,17 @@ type Spec[
 	// an inactivity penalty is applied.
 	MinEpochsToInactivityPenalty() uint64
 
+	// GenesisTime returns the Unix time, in seconds, at which the first slot
+	// starts, or zero if it is taken from the CometBFT genesis.
+	GenesisTime() uint64
+
+	// SlotDuration returns the duration of a slot.
+	SlotDuration() time.Duration
+
+	// PayloadTimestampTolerance returns the number of seconds the timestamp
+	// of an execution payload may deviate from the start of its slot.
+	PayloadTimestampTolerance() uint64
+
 	// Signature Domains
 
 	// DomainTypeProposer returns the domain for proposer signatures.
@@ -108,6 +130,10 @@ type Spec[
 	// DepositEth1ChainID returns the chain ID of the deposit contract.
 	DepositEth1ChainID() uint64
 
+	// DepositContractCodeHash returns the keccak256 hash of the runtime code
+	// of the deposit contract, or zero if it is not pinned.
+	DepositContractCodeHash() bytes.B32
+
 	// Eth1FollowDistance returns the distance between the eth1 chain and the
 	// beacon chain for eth1 data.
 	Eth1FollowDistance() uint64
@@ -121,6 +147,18 @@ type Spec[
 	// ElectraForkEpoch returns the epoch at which the Electra fork takes
 	// effect.
 	ElectraForkEpoch() EpochT
+	// Eth1DataForkEpoch returns the epoch from which blocks must carry the
+	// Eth1Data following from their deposits.
+	Eth1DataForkEpoch() EpochT
+	// EjectionForkEpoch returns the epoch from which validators below the
+	// ejection or the min activation balance are made withdrawable.
+	EjectionForkEpoch() EpochT
+	// VoteExtensionsForkEpoch returns the epoch from which proposals may
+	// carry the extended commit of the previous block.
+	VoteExtensionsForkEpoch() EpochT
+	// SlotTimestampForkEpoch returns the epoch from which the timestamp of
+	// an execution payload must match the start of its slot.
+	SlotTimestampForkEpoch() EpochT
 
 	// State list lengths
 
@@ -190,6 +228,10 @@ type Spec[
 	// epoch.
 	ActiveForkVersionForEpoch(epoch EpochT) uint32
 
+	// NextForkForEpoch returns the version and epoch of the first fork
+	// scheduled after the given epoch.
+	NextForkForEpoch(epoch EpochT) (uint32, EpochT)
+
 	// SlotToEpoch converts a slot number to an epoch number.
 	SlotToEpoch(slot SlotT) EpochT
 
@@ -215,6 +257,15 @@ type Spec[
 	// EVMInflationPerBlock returns the amount of native EVM balance (in Gwei)
 	// to be minted to the EVMInflationAddress via a withdrawal every block.
 	EVMInflationPerBlock() uint64
+
+	// IncompatibleExecutionClients returns the execution clients known to be
+	// incompatible with the chain, as "<name>/<version prefix>" entries.
+	IncompatibleExecutionClients() []string
+
+	// Data returns a copy of the underlying chain-specific parameter values.
+	Data() SpecData[
+		DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+	]
 }
 
 // chainSpec is a concrete implementation of the ChainSpec interface, holding
@@ -226,8 +277,8 @@ type chainSpec[
 	SlotT ~uint64,
 	CometBFTConfigT any,
 ] struct {
-	// Data contains the actual chain-specific parameter values.
-	Data SpecData[DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT]
+	// data contains the actual chain-specific parameter values.
+	data SpecData[DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT]
 }
 
 // NewChainSpec creates a new instance of a ChainSpec with the provided data.
@@ -245,7 +296,7 @@ func NewChainSpec[
 	c := &chainSpec[
 		DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
 	]{
-		Data: data,
+		data: data,
 	}
 	return c, c.validate()
 }
@@ -262,70 +313,141 @@ func (c *chainSpec[
 		return ErrInvalidValidatorSetCap
 	}
 
+	// SlotsPerEpoch is used as a divisor when converting slots to epochs.
+	if c.SlotsPerEpoch() == 0 {
+		return errors.Wrap(ErrZeroValue, "slots-per-epoch")
+	}
+
+	// SlotDuration is used as a divisor when converting time to slots.
+	if c.SlotDuration() <= 0 {
+		return errors.Wrap(ErrZeroValue, "slot-duration-ms")
+	}
+
+	if c.EjectionBalance() > c.MaxEffectiveBalance() {
+		return ErrInvalidEjectionBalance
+	}
+
+	if c.MinActivationBalance() < c.EjectionBalance() ||
+		c.MinActivationBalance() > c.MaxEffectiveBalance() {
+		return ErrInvalidMinActivationBalance
+	}
+
+	if c.MaxBlobsPerBlock() > c.MaxBlobCommitmentsPerBlock() {
+		return ErrInvalidMaxBlobsPerBlock
+	}
+
+	if c.DenebPlusForkEpoch() > c.ElectraForkEpoch() {
+		return ErrInvalidForkOrder
+	}
+
+	// A signature valid in one domain must never be valid in another.
+	if err := validateDomainTypes(
+		c.DomainTypeProposer(),
+		c.DomainTypeAttester(),
+		c.DomainTypeRandao(),
+		c.DomainTypeDeposit(),
+		c.DomainTypeVoluntaryExit(),
+		c.DomainTypeSelectionProof(),
+		c.DomainTypeAggregateAndProof(),
+	); err != nil {
+		return err
+	}
+
 	// EVM Inflation values can be zero or non-zero, no validation needed.
+	return nil
+}
 
-	// TODO: Add more validation rules here.
+// validateDomainTypes ensures that the given domain types are distinct.
+func validateDomainTypes[DomainTypeT ~[4]byte](
+	domainTypes ...DomainTypeT,
+) error {
+	seen := make(map[DomainTypeT]struct{}, len(domainTypes))
+	for _, domainType := range domainTypes {
+		if _, ok := seen[domainType]; ok {
+			return errors.Wrapf(
+				ErrDuplicateDomainType, "domain type %x", domainType[:],
+			)
+		}
+		seen[domainType] = struct{}{}
+	}
 	return nil
 }
 
+// Data returns a copy of the underlying chain-specific parameter values.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) Data() SpecData[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+] {
+	return c.data
+}
+
 // MinDepositAmount returns the minimum deposit amount required.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
 ]) MinDepositAmount() uint64 {
-	return c.Data.MinDepositAmount
+	return c.data.MinDepositAmount
 }
 
 // MaxEffectiveBalance returns the maximum effective balance.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
 ]) MaxEffectiveBalance() uint64 {
-	return c.Data.MaxEffectiveBalance
+	return c.data.MaxEffectiveBalance
 }
 
 // EjectionBalance returns the balance below which a validator is ejected.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
 ]) EjectionBalance() uint64 {
-	return c.Data.EjectionBalance
+	return c.data.EjectionBalance
+}
+
+// MinActivationBalance returns the minimum effective balance of a validator
+// joining the registry.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) MinActivationBalance() uint64 {
+	return c.data.MinActivationBalance
 }
 
 // EffectiveBalanceIncrement returns the increment of effective balance.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
 ]) EffectiveBalanceIncrement() uint64 {
-	return c.Data.EffectiveBalanceIncrement
+	return c.data.EffectiveBalanceIncrement
 }
 
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
 ]) HysteresisQuotient() uint64 {
-	return c.Data.HysteresisQuotient
+	return c.data.HysteresisQuotient
 }
 
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
 ]) HysteresisDownwardMultiplier() uint64 {
-	return c.Data.HysteresisDownwardMultiplier
+	return c.data.HysteresisDownwardMultiplier
 }
 
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
 ]) HysteresisUpwardMultiplier() uint64 {
-	return c.Data.HysteresisUpwardMultiplier
+	return c.data.HysteresisUpwardMultiplier
 }
 
 // SlotsPerEpoch returns the number of slots per epoch.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
 ]) SlotsPerEpoch() uint64 {
-	return c.Data.SlotsPerEpoch
+	return c.data.SlotsPerEpoch
 }
 
 // SlotsPerHistoricalRoot returns the number of slots per historical root.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
 ]) SlotsPerHistoricalRoot() uint64 {
-	return c.Data.SlotsPerHistoricalRoot
+	return c.data.SlotsPerHistoricalRoot
 }
 
 // MinEpochsToInactivityPenalty returns the minimum number of epochs before an
@@ -333,49 +455,73 @@ func (c chainSpec[
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
 ]) MinEpochsToInactivityPenalty() uint64 {
-	return c.Data.MinEpochsToInactivityPenalty
+	return c.data.MinEpochsToInactivityPenalty
+}
+
+// GenesisTime returns the Unix time, in seconds, at which the first slot
+// starts, or zero if it is taken from the CometBFT genesis.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) GenesisTime() uint64 {
+	return c.data.GenesisTime
+}
+
+// SlotDuration returns the duration of a slot.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) SlotDuration() time.Duration {
+	//#nosec:G701 // slot durations fit in a duration.
+	return time.Duration(c.data.SlotDurationMs) * time.Millisecond
+}
+
+// PayloadTimestampTolerance returns the number of seconds the timestamp of an
+// execution payload may deviate from the start of its slot.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) PayloadTimestampTolerance() uint64 {
+	return c.data.PayloadTimestampTolerance
 }
 
 // DomainTypeProposer returns the domain for beacon proposer signatures.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
 ]) DomainTypeProposer() DomainTypeT {
-	return c.Data.DomainTypeProposer
+	return c.data.DomainTypeProposer
 }
 
 // DomainTypeAttester returns the domain for beacon attester signatures.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
 ]) DomainTypeAttester() DomainTypeT {
-	return c.Data.DomainTypeAttester
+	return c.data.DomainTypeAttester
 }
 
 // DomainTypeRandao returns the domain for RANDAO reveal signatures.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
 ]) DomainTypeRandao() DomainTypeT {
-	return c.Data.DomainTypeRandao
+	return c.data.DomainTypeRandao
 }
 
 // DomainTypeDeposit returns the domain for deposit contract signatures.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
 ]) DomainTypeDeposit() DomainTypeT {
-	return c.Data.DomainTypeDeposit
+	return c.data.DomainTypeDeposit
 }
 
 // DomainTypeVoluntaryExit returns the domain for voluntary exit signatures.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
 ]) DomainTypeVoluntaryExit() DomainTypeT {
-	return c.Data.DomainTypeVoluntaryExit
+	return c.data.DomainTypeVoluntaryExit
 }
 
 // DomainTypeSelectionProof returns the domain for selection proof signatures.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
 ]) DomainTypeSelectionProof() DomainTypeT {
-	return c.Data.DomainTypeSelectionProof
+	return c.data.DomainTypeSelectionProof
 }
 
 // DomainTypeAggregateAndProof returns the domain for aggregate and proof
@@ -383,35 +529,43 @@ func (c chainSpec[
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
 ]) DomainTypeAggregateAndProof() DomainTypeT {
-	return c.Data.DomainTypeAggregateAndProof
+	return c.data.DomainTypeAggregateAndProof
 }
 
 // DomainTypeApplicationMask returns the domain for the application mask.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
 ]) DomainTypeApplicationMask() DomainTypeT {
-	return c.Data.DomainTypeApplicationMask
+	return c.data.DomainTypeApplicationMask
 }
 
 // DepositContractAddress returns the address of the deposit contract.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
 ]) DepositContractAddress() ExecutionAddressT {
-	return c.Data.DepositContractAddress
+	return c.data.DepositContractAddress
 }
 
 // MaxDepositsPerBlock returns the maximum number of deposits per block.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
 ]) MaxDepositsPerBlock() uint64 {
-	return c.Data.MaxDepositsPerBlock
+	return c.data.MaxDepositsPerBlock
 }
 
 // DepositEth1ChainID returns the chain ID of the execution chain.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
 ]) DepositEth1ChainID() uint64 {
-	return c.Data.DepositEth1ChainID
+	return c.data.DepositEth1ChainID
+}
+
+// DepositContractCodeHash returns the keccak256 hash of the runtime code of
+// the deposit contract, or zero if it is not pinned.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) DepositContractCodeHash() bytes.B32 {
+	return c.data.DepositContractCodeHash
 }
 
 // Eth1FollowDistance returns the distance between the eth1 chain and the beacon
@@ -419,70 +573,98 @@ func (c chainSpec[
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
 ]) Eth1FollowDistance() uint64 {
-	return c.Data.Eth1FollowDistance
+	return c.data.Eth1FollowDistance
 }
 
 // TargetSecondsPerEth1Block returns the target time between eth1 blocks.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
 ]) TargetSecondsPerEth1Block() uint64 {
-	return c.Data.TargetSecondsPerEth1Block
+	return c.data.TargetSecondsPerEth1Block
 }
 
 // DenebPlusForEpoch returns the epoch of the Deneb+ fork.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
 ]) DenebPlusForkEpoch() EpochT {
-	return c.Data.DenebPlusForkEpoch
+	return c.data.DenebPlusForkEpoch
 }
 
 // ElectraForkEpoch returns the epoch of the Electra fork.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
 ]) ElectraForkEpoch() EpochT {
-	return c.Data.ElectraForkEpoch
+	return c.data.ElectraForkEpoch
+}
+
+// Eth1DataForkEpoch returns the epoch of the Eth1Data fork.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) Eth1DataForkEpoch() EpochT {
+	return c.data.Eth1DataForkEpoch
+}
+
+// EjectionForkEpoch returns the epoch of the ejection fork.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) EjectionForkEpoch() EpochT {
+	return c.data.EjectionForkEpoch
+}
+
+// VoteExtensionsForkEpoch returns the epoch of the vote extensions fork.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) VoteExtensionsForkEpoch() EpochT {
+	return c.data.VoteExtensionsForkEpoch
+}
+
+// SlotTimestampForkEpoch returns the epoch of the slot timestamp fork.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) SlotTimestampForkEpoch() EpochT {
+	return c.data.SlotTimestampForkEpoch
 }
 
 // EpochsPerHistoricalVector returns the number of epochs per historical vector.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
 ]) EpochsPerHistoricalVector() uint64 {
-	return c.Data.EpochsPerHistoricalVector
+	return c.data.EpochsPerHistoricalVector
 }
 
 // EpochsPerSlashingsVector returns the number of epochs per slashings vector.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
 ]) EpochsPerSlashingsVector() uint64 {
-	return c.Data.EpochsPerSlashingsVector
+	return c.data.EpochsPerSlashingsVector
 }
 
 // HistoricalRootsLimit returns the limit of historical roots.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
 ]) HistoricalRootsLimit() uint64 {
-	return c.Data.HistoricalRootsLimit
+	return c.data.HistoricalRootsLimit
 }
 
 // ValidatorRegistryLimit returns the limit of the validator registry.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
 ]) ValidatorRegistryLimit() uint64 {
-	return c.Data.ValidatorRegistryLimit
+	return c.data.ValidatorRegistryLimit
 }
 
 // InactivityPenaltyQuotient returns the inactivity penalty quotient.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
 ]) InactivityPenaltyQuotient() uint64 {
-	return c.Data.InactivityPenaltyQuotient
+	return c.data.InactivityPenaltyQuotient
 }
 
 // ProportionalSlashingMultiplier returns the proportional slashing multiplier.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
 ]) ProportionalSlashingMultiplier() uint64 {
-	return c.Data.ProportionalSlashingMultiplier
+	return c.data.ProportionalSlashingMultiplier
 }
 
 // MaxWithdrawalsPerPayload returns the maximum number of withdrawals per
@@ -490,7 +672,7 @@ func (c chainSpec[
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
 ]) MaxWithdrawalsPerPayload() uint64 {
-	return c.Data.MaxWithdrawalsPerPayload
+	return c.data.MaxWithdrawalsPerPayload
 }
 
 // MaxValidatorsPerWithdrawalsSweep returns the maximum number of validators per
@@ -502,10 +684,10 @@ func (c chainSpec[
 	chainID uint64, slot SlotT,
 ) uint64 {
 	if isPostUpgrade(chainID, slot) {
-		return c.Data.MaxValidatorsPerWithdrawalsSweepPostUpgrade
+		return c.data.MaxValidatorsPerWithdrawalsSweepPostUpgrade
 	}
 
-	return c.Data.MaxValidatorsPerWithdrawalsSweepPreUpgrade
+	return c.data.MaxValidatorsPerWithdrawalsSweepPreUpgrade
 }
 
 // MinEpochsForBlobsSidecarsRequest returns the minimum number of epochs for
@@ -513,7 +695,7 @@ func (c chainSpec[
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
 ]) MinEpochsForBlobsSidecarsRequest() uint64 {
-	return c.Data.MinEpochsForBlobsSidecarsRequest
+	return c.data.MinEpochsForBlobsSidecarsRequest
 }
 
 // MaxBlobCommitmentsPerBlock returns the maximum number of blob commitments per
@@ -521,28 +703,28 @@ func (c chainSpec[
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
 ]) MaxBlobCommitmentsPerBlock() uint64 {
-	return c.Data.MaxBlobCommitmentsPerBlock
+	return c.data.MaxBlobCommitmentsPerBlock
 }
 
 // MaxBlobsPerBlock returns the maximum number of blobs per block.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
 ]) MaxBlobsPerBlock() uint64 {
-	return c.Data.MaxBlobsPerBlock
+	return c.data.MaxBlobsPerBlock
 }
 
 // FieldElementsPerBlob returns the number of field elements per blob.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
 ]) FieldElementsPerBlob() uint64 {
-	return c.Data.FieldElementsPerBlob
+	return c.data.FieldElementsPerBlob
 }
 
 // BytesPerBlob returns the number of bytes per blob.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
 ]) BytesPerBlob() uint64 {
-	return c.Data.BytesPerBlob
+	return c.data.BytesPerBlob
 }
 
 // GetCometBFTConfigForSlot returns the CometBFT configuration for the given
@@ -550,7 +732,7 @@ func (c chainSpec[
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
 ]) GetCometBFTConfigForSlot(_ SlotT) CometBFTConfigT {
-	return c.Data.CometValues
+	return c.data.CometValues
 }
 
 // ValidatorSetCap retrieves the maximum number of
@@ -558,7 +740,7 @@ func (c chainSpec[
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
 ]) ValidatorSetCap() uint64 {
-	return c.Data.ValidatorSetCap
+	return c.data.ValidatorSetCap
 }
 
 // EVMInflationAddress returns the address on the EVM which will receive the
@@ -566,7 +748,7 @@ func (c chainSpec[
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
 ]) EVMInflationAddress() ExecutionAddressT {
-	return c.Data.EVMInflationAddress
+	return c.data.EVMInflationAddress
 }
 
 // EVMInflationPerBlock returns the amount of native EVM balance (in Gwei) to
@@ -574,5 +756,13 @@ func (c chainSpec[
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
 ]) EVMInflationPerBlock() uint64 {
-	return c.Data.EVMInflationPerBlock
+	return c.data.EVMInflationPerBlock
+}
+
+// IncompatibleExecutionClients returns the execution clients known to be
+// incompatible with the chain, as "<name>/<version prefix>" entries.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) IncompatibleExecutionClients() []string {
+	return c.data.IncompatibleExecutionClients
 }
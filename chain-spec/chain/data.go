@@ -20,6 +20,8 @@
 
 package chain
 
+import "github.com/berachain/beacon-kit/primitives/bytes"
+
 // SpecData is the underlying data structure for chain-specific parameters.
 //
 //nolint:lll // struct tags may create long lines.
@@ -40,6 +42,10 @@ type SpecData[
 	MaxEffectiveBalance uint64 `mapstructure:"max-effective-balance"`
 	// EjectionBalance is the balance at which a validator is ejected.
 	EjectionBalance uint64 `mapstructure:"ejection-balance"`
+	// MinActivationBalance is the minimum effective balance of a validator
+	// joining the registry. Smaller validators are marked withdrawable and
+	// their deposits returned.
+	MinActivationBalance uint64 `mapstructure:"min-activation-balance"`
 	// EffectiveBalanceIncrement is the effective balance increment.
 	EffectiveBalanceIncrement uint64 `mapstructure:"effective-balance-increment"`
 
@@ -61,6 +67,16 @@ type SpecData[
 	// MinEpochsToInactivityPenalty is the minimum number of epochs before a
 	// validator is penalized for inactivity.
 	MinEpochsToInactivityPenalty uint64 `mapstructure:"min-epochs-to-inactivity-penalty"`
+	// GenesisTime is the Unix time, in seconds, at which the first slot
+	// starts. If zero, the genesis time of the CometBFT genesis is used.
+	GenesisTime uint64 `mapstructure:"genesis-time"`
+	// SlotDurationMs is the duration of a slot, in milliseconds. It should
+	// match the block time produced by the CometBFT consensus timeouts.
+	SlotDurationMs uint64 `mapstructure:"slot-duration-ms"`
+	// PayloadTimestampTolerance is the number of seconds the timestamp of an
+	// execution payload may deviate from the start of its slot. It is only
+	// enforced from SlotTimestampForkEpoch.
+	PayloadTimestampTolerance uint64 `mapstructure:"payload-timestamp-tolerance"`
 
 	// Signature domains.
 	//
@@ -92,6 +108,10 @@ type SpecData[
 	MaxDepositsPerBlock uint64 `mapstructure:"max-deposits-per-block"`
 	// DepositEth1ChainID is the chain ID of the execution client.
 	DepositEth1ChainID uint64 `mapstructure:"deposit-eth1-chain-id"`
+	// DepositContractCodeHash is the keccak256 hash of the runtime code of
+	// the deposit contract. If zero, the deposit contract is only required to
+	// have code.
+	DepositContractCodeHash bytes.B32 `mapstructure:"deposit-contract-code-hash"`
 	// Eth1FollowDistance is the distance between the eth1 chain and the beacon
 	// chain with respect to reading deposits.
 	Eth1FollowDistance uint64 `mapstructure:"eth1-follow-distance"`
@@ -104,6 +124,20 @@ type SpecData[
 	DenebPlusForkEpoch EpochT `mapstructure:"deneb-plus-fork-epoch"`
 	// ElectraForkEpoch is the epoch at which the Electra fork is activated.
 	ElectraForkEpoch EpochT `mapstructure:"electra-fork-epoch"`
+	// Eth1DataForkEpoch is the epoch from which blocks must carry the
+	// Eth1Data following from their deposits.
+	Eth1DataForkEpoch EpochT `mapstructure:"eth1-data-fork-epoch"`
+	// EjectionForkEpoch is the epoch from which validators below the
+	// ejection or the min activation balance are made withdrawable.
+	EjectionForkEpoch EpochT `mapstructure:"ejection-fork-epoch"`
+	// VoteExtensionsForkEpoch is the epoch from which proposals may carry
+	// the extended commit of the previous block.
+	VoteExtensionsForkEpoch EpochT `mapstructure:"vote-extensions-fork-epoch"`
+	// SlotTimestampForkEpoch is the epoch from which the timestamp of an
+	// execution payload must match the start of its slot. It has no effect
+	// without a GenesisTime, or with slots lasting a fraction of a second,
+	// whose timestamps could not strictly increase.
+	SlotTimestampForkEpoch EpochT `mapstructure:"slot-timestamp-fork-epoch"`
 
 	// State list lengths
 	//
@@ -173,4 +207,7 @@ type SpecData[
 	// EVMInflationPerBlock is the amount of native EVM balance (in Gwei) to be
 	// minted to the EVMInflationAddress via a withdrawal every block.
 	EVMInflationPerBlock uint64 `mapstructure:"evm-inflation-per-block"`
+	// IncompatibleExecutionClients lists the execution clients known to be
+	// incompatible with the chain, as "<name>/<version prefix>" entries.
+	IncompatibleExecutionClients []string `mapstructure:"incompatible-execution-clients"`
 }
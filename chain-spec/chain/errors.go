@@ -35,4 +35,39 @@ var (
 	ErrInvalidValidatorSetCap = errors.New(
 		"validator set cap must be less than the validator registry limit",
 	)
+
+	// ErrZeroValue is returned when a parameter that must be strictly
+	// positive is set to zero.
+	ErrZeroValue = errors.New("chain spec value must be greater than zero")
+
+	// ErrInvalidEjectionBalance is returned when the ejection balance is
+	// greater than the max effective balance.
+	ErrInvalidEjectionBalance = errors.New(
+		"ejection balance must not exceed the max effective balance",
+	)
+
+	// ErrInvalidMinActivationBalance is returned when the min activation
+	// balance is not between the ejection and the max effective balance.
+	ErrInvalidMinActivationBalance = errors.New(
+		"min activation balance must be between the ejection balance " +
+			"and the max effective balance",
+	)
+
+	// ErrInvalidMaxBlobsPerBlock is returned when the max blobs per block is
+	// greater than the max blob commitments per block.
+	ErrInvalidMaxBlobsPerBlock = errors.New(
+		"max blobs per block must not exceed max blob commitments per block",
+	)
+
+	// ErrInvalidForkOrder is returned when the fork epochs are not scheduled
+	// in ascending order.
+	ErrInvalidForkOrder = errors.New(
+		"fork epochs must be scheduled in ascending order",
+	)
+
+	// ErrDuplicateDomainType is returned when two signature domains are
+	// configured with the same domain type.
+	ErrDuplicateDomainType = errors.New(
+		"signature domains must have distinct domain types",
+	)
 )
@@ -21,6 +21,7 @@
 package chain
 
 import (
+	"github.com/berachain/beacon-kit/primitives/constants"
 	"github.com/berachain/beacon-kit/primitives/version"
 )
 
@@ -39,15 +40,36 @@ func (c chainSpec[
 ]) ActiveForkVersionForEpoch(
 	epoch EpochT,
 ) uint32 {
-	if epoch >= c.Data.ElectraForkEpoch {
+	if epoch >= c.data.ElectraForkEpoch {
 		return version.Electra
-	} else if epoch >= c.Data.DenebPlusForkEpoch {
+	} else if epoch >= c.data.DenebPlusForkEpoch {
 		return version.DenebPlus
 	}
 
 	return version.Deneb
 }
 
+// NextForkForEpoch returns the version and epoch of the first fork scheduled
+// after the given epoch. If no fork is scheduled, it returns the version
+// active at the given epoch and the far future epoch, as the ENRForkID of the
+// networking specification does.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) NextForkForEpoch(
+	epoch EpochT,
+) (uint32, EpochT) {
+	// The forks are ordered, so the first one after the epoch is next. If
+	// forks share an epoch the version active at that epoch is the latest.
+	for _, forkEpoch := range []EpochT{
+		c.data.DenebPlusForkEpoch, c.data.ElectraForkEpoch,
+	} {
+		if forkEpoch > epoch {
+			return c.ActiveForkVersionForEpoch(forkEpoch), forkEpoch
+		}
+	}
+	return c.ActiveForkVersionForEpoch(epoch), EpochT(constants.FarFutureEpoch)
+}
+
 // SlotToEpoch converts a slot to an epoch.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
@@ -24,6 +24,7 @@ import (
 	"testing"
 
 	"github.com/berachain/beacon-kit/chain-spec/chain"
+	"github.com/berachain/beacon-kit/primitives/constants"
 	"github.com/berachain/beacon-kit/primitives/version"
 	"github.com/stretchr/testify/require"
 )
@@ -74,6 +75,43 @@ func TestActiveForkVersionForEpoch(t *testing.T) {
 	}
 }
 
+// TestNextForkForEpoch tests the NextForkForEpoch method.
+func TestNextForkForEpoch(t *testing.T) {
+	tests := []struct {
+		name            string
+		epoch           epoch
+		expectedVersion uint32
+		expectedEpoch   epoch
+	}{
+		{
+			name:            "Before Deneb+ Fork",
+			epoch:           0,
+			expectedVersion: version.DenebPlus,
+			expectedEpoch:   9,
+		},
+		{
+			name:            "At Deneb+ Fork",
+			epoch:           9,
+			expectedVersion: version.Electra,
+			expectedEpoch:   10,
+		},
+		{
+			name:            "At Electra Fork",
+			epoch:           10,
+			expectedVersion: version.Electra,
+			expectedEpoch:   epoch(constants.FarFutureEpoch),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, e := spec.NextForkForEpoch(tt.epoch)
+			require.Equal(t, tt.expectedVersion, v)
+			require.Equal(t, tt.expectedEpoch, e)
+		})
+	}
+}
+
 // TestSlotToEpoch tests the SlotToEpoch method.
 func TestSlotToEpoch(t *testing.T) {
 	// Define test cases
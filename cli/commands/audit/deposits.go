@@ -0,0 +1,258 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package audit
+
+import (
+	"github.com/berachain/beacon-kit/cli/commands/deposit"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/node-core/components/signer"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// flagDepositRoot is the flag for the deposit root the export is expected
+	// to match.
+	flagDepositRoot = "deposit-root"
+	// flagGenesisValidatorsRoot is the flag for the genesis validators root
+	// deposits after genesis are signed over.
+	flagGenesisValidatorsRoot = "genesis-validators-root"
+)
+
+// ErrInvalidDepositSignatures is returned when deposits that would create a
+// validator fail the signature check.
+var ErrInvalidDepositSignatures = errors.New("invalid deposit signatures")
+
+// DepositsReport is the outcome of auditing a deposit history.
+type DepositsReport struct {
+	// Valid is the number of deposits with a valid signature.
+	Valid int
+	// InvalidCreations are the indices of the deposits that would create a
+	// validator but fail the signature check, which the chain ignores.
+	InvalidCreations []uint64
+	// InvalidTopUps are the indices of the deposits topping up a validator
+	// that fail the signature check, which the chain does not require.
+	InvalidTopUps []uint64
+}
+
+// NewDepositsCommand creates a new command re-verifying an exported deposit
+// history offline.
+func NewDepositsCommand(chainSpec common.ChainSpec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposits [export-dir]",
+		Short: "Re-verifies an exported deposit history offline",
+		Long: `Recomputes the deposit root of a deposit history written by
+the deposit export command, checks it against its manifest and, if given,
+against the expected deposit root, then verifies the signature of every
+deposit. Genesis deposits are verified over the genesis fork version and an
+empty root, later ones over any fork version of the chain spec and the
+genesis validators root, computed from the genesis deposits if not given.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			deposits, manifest, err := deposit.ReadExport(args[0])
+			if err != nil {
+				return err
+			}
+			if err = checkDepositRoot(cmd, manifest); err != nil {
+				return err
+			}
+			gvr, err := genesisValidatorsRoot(
+				cmd, chainSpec, deposits[:manifest.GenesisCount],
+			)
+			if err != nil {
+				return err
+			}
+
+			report := AuditDeposits(
+				chainSpec, deposits, manifest.GenesisCount, gvr,
+			)
+			for _, index := range report.InvalidCreations {
+				cmd.Printf("deposit %d: invalid signature, ignored\n", index)
+			}
+			for _, index := range report.InvalidTopUps {
+				cmd.Printf(
+					"deposit %d: invalid signature on a top-up\n", index,
+				)
+			}
+			cmd.Printf(
+				"audited %d deposits with root %s: %d valid signatures\n",
+				manifest.Count, manifest.DepositRoot, report.Valid,
+			)
+			if len(report.InvalidCreations) > 0 {
+				return errors.Wrapf(
+					ErrInvalidDepositSignatures,
+					"%d deposits creating a validator",
+					len(report.InvalidCreations),
+				)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().String(
+		flagDepositRoot, "",
+		"deposit root the export must match, such as Eth1Data.DepositRoot",
+	)
+	cmd.Flags().String(
+		flagGenesisValidatorsRoot, "",
+		"genesis validators root, computed from the genesis deposits if empty",
+	)
+
+	return cmd
+}
+
+// AuditDeposits verifies the signature of every deposit of the history, the
+// first genesisCount of which were included at genesis.
+func AuditDeposits(
+	chainSpec common.ChainSpec,
+	deposits []*types.Deposit,
+	genesisCount uint64,
+	genesisValidatorsRoot common.Root,
+) *DepositsReport {
+	var (
+		report  = &DepositsReport{}
+		seen    = make(map[crypto.BLSPubkey]struct{}, len(deposits))
+		genesis = []*types.ForkData{types.NewForkData(
+			version.FromUint32[common.Version](
+				chainSpec.ActiveForkVersionForEpoch(0),
+			),
+			common.Root{},
+		)}
+		later = laterForkData(chainSpec, genesisValidatorsRoot)
+	)
+	for _, dep := range deposits {
+		candidates := later
+		if dep.Index < genesisCount {
+			candidates = genesis
+		}
+		_, topUp := seen[dep.Pubkey]
+		seen[dep.Pubkey] = struct{}{}
+
+		switch {
+		case verifyDeposit(chainSpec, dep, candidates):
+			report.Valid++
+		case topUp:
+			report.InvalidTopUps = append(report.InvalidTopUps, dep.Index)
+		default:
+			report.InvalidCreations = append(
+				report.InvalidCreations, dep.Index,
+			)
+		}
+	}
+	return report
+}
+
+// verifyDeposit reports whether the deposit is signed over any of the fork
+// data.
+func verifyDeposit(
+	chainSpec common.ChainSpec,
+	dep *types.Deposit,
+	candidates []*types.ForkData,
+) bool {
+	for _, forkData := range candidates {
+		if dep.VerifySignature(
+			forkData,
+			chainSpec.DomainTypeDeposit(),
+			signer.BLSSigner{}.VerifySignature,
+		) == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// laterForkData returns the fork data deposits after genesis may be signed
+// over, one per fork version of the chain spec.
+func laterForkData(
+	chainSpec common.ChainSpec, genesisValidatorsRoot common.Root,
+) []*types.ForkData {
+	var (
+		forkData []*types.ForkData
+		seen     = make(map[uint32]struct{})
+	)
+	for _, epoch := range []math.Epoch{
+		0, chainSpec.DenebPlusForkEpoch(), chainSpec.ElectraForkEpoch(),
+	} {
+		v := chainSpec.ActiveForkVersionForEpoch(epoch)
+		if _, ok := seen[v]; ok {
+			continue
+		}
+		seen[v] = struct{}{}
+		forkData = append(forkData, types.NewForkData(
+			version.FromUint32[common.Version](v), genesisValidatorsRoot,
+		))
+	}
+	return forkData
+}
+
+// checkDepositRoot checks the manifest against the expected deposit root, if
+// one is given.
+func checkDepositRoot(
+	cmd *cobra.Command, manifest *deposit.ExportManifest,
+) error {
+	expected, err := cmd.Flags().GetString(flagDepositRoot)
+	if err != nil || expected == "" {
+		return err
+	}
+	root, err := common.NewRootFromHex(expected)
+	if err != nil {
+		return err
+	}
+	if root != manifest.DepositRoot {
+		return errors.Wrapf(
+			deposit.ErrDepositRootMismatch,
+			"expected %s, got %s", root, manifest.DepositRoot,
+		)
+	}
+	return nil
+}
+
+// genesisValidatorsRoot returns the genesis validators root given as a flag,
+// or computes it from the genesis deposits as the genesis command does.
+func genesisValidatorsRoot(
+	cmd *cobra.Command,
+	chainSpec common.ChainSpec,
+	genesisDeposits []*types.Deposit,
+) (common.Root, error) {
+	given, err := cmd.Flags().GetString(flagGenesisValidatorsRoot)
+	if err != nil {
+		return common.Root{}, err
+	}
+	if given != "" {
+		return common.NewRootFromHex(given)
+	}
+	validators := make(types.Validators, len(genesisDeposits))
+	for i, dep := range genesisDeposits {
+		var val *types.Validator
+		validators[i] = val.New(
+			dep.Pubkey,
+			dep.Credentials,
+			dep.Amount,
+			math.Gwei(chainSpec.EffectiveBalanceIncrement()),
+			math.Gwei(chainSpec.MaxEffectiveBalance()),
+		)
+	}
+	return validators.HashTreeRoot(), nil
+}
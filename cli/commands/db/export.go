@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package db
+
+import (
+	"github.com/berachain/beacon-kit/cli/context"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	storagedb "github.com/berachain/beacon-kit/storage/db"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// flagSlot is the flag for the slot of the state to export.
+	flagSlot = "slot"
+	// flagOutput is the flag for the snapshot output directory.
+	flagOutput = "output"
+
+	// defaultOutput is the default snapshot output directory.
+	defaultOutput = "state-export"
+)
+
+// NewExportStateCommand creates a new command that exports the beacon state.
+func NewExportStateCommand(chainSpec common.ChainSpec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-state",
+		Short: "Exports the beacon state at a slot to an SSZ snapshot",
+		Long: `Exports the full beacon state at the given slot from the
+application DB into a directory containing the SSZ encoded state and a manifest
+with its fork, state root, chain spec hash and deposit tree branch. The latest state is exported if
+no slot is given. The node must be stopped.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			slot, err := cmd.Flags().GetUint64(flagSlot)
+			if err != nil {
+				return err
+			}
+			output, err := cmd.Flags().GetString(flagOutput)
+			if err != nil {
+				return err
+			}
+
+			cfg := context.GetConfigFromCmd(cmd)
+			appDB, err := storagedb.OpenDB(cfg.RootDir, dbm.PebbleDBBackend)
+			if err != nil {
+				return err
+			}
+			defer appDB.Close()
+
+			st, branch, err := ExportState(
+				appDB, chainSpec, math.Slot(slot),
+			)
+			if err != nil {
+				return err
+			}
+			manifest, err := WriteSnapshot(output, chainSpec, st, branch)
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf(
+				"exported %s state at slot %d with root %s to %s\n",
+				manifest.Fork, manifest.Slot, manifest.StateRoot, output,
+			)
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(flagSlot, 0, "slot of the state to export")
+	cmd.Flags().String(
+		flagOutput, defaultOutput, "directory to write the snapshot to",
+	)
+
+	return cmd
+}
@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package db
+
+import (
+	"github.com/berachain/beacon-kit/cli/context"
+	"github.com/berachain/beacon-kit/primitives/common"
+	storagedb "github.com/berachain/beacon-kit/storage/db"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cobra"
+)
+
+// NewImportStateCommand creates a new command that imports a beacon state.
+func NewImportStateCommand(chainSpec common.ChainSpec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "import-state [snapshot-dir]",
+		Short: "Imports a beacon state snapshot into an empty node",
+		Long: `Imports a snapshot written by export-state into the empty
+application DB of this node, committed at the height of the state slot. The
+snapshot must have been exported with the same chain spec. Once imported, the
+CometBFT state must be bootstrapped at the same height with
+'comet bootstrap-state --height <slot>' before starting the node.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			st, manifest, err := ReadSnapshot(args[0], chainSpec)
+			if err != nil {
+				return err
+			}
+
+			cfg := context.GetConfigFromCmd(cmd)
+			appDB, err := storagedb.OpenDB(cfg.RootDir, dbm.PebbleDBBackend)
+			if err != nil {
+				return err
+			}
+			defer appDB.Close()
+
+			if err = ImportState(
+				appDB, chainSpec, st, manifest.DepositBranch,
+			); err != nil {
+				return err
+			}
+
+			cmd.Printf(
+				"imported %s state at slot %d with root %s\n",
+				manifest.Fork, manifest.Slot, manifest.StateRoot,
+			)
+			return nil
+		},
+	}
+}
@@ -0,0 +1,466 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"os"
+	"path/filepath"
+
+	corestore "cosmossdk.io/core/store"
+	sdklog "cosmossdk.io/log"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/config/spec"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/node-core/components"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+	"github.com/berachain/beacon-kit/storage/beacondb"
+	"github.com/berachain/beacon-kit/storage/encoding"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// StateFileName is the name of the SSZ encoded state in a snapshot.
+	StateFileName = "state.ssz"
+	// ManifestFileName is the name of the manifest in a snapshot.
+	ManifestFileName = "manifest.json"
+)
+
+var (
+	// ErrStateRootMismatch is returned when the snapshot state does not match
+	// the root recorded in its manifest.
+	ErrStateRootMismatch = errors.New("state root does not match manifest")
+	// ErrSpecHashMismatch is returned when the snapshot was exported with a
+	// different chain spec than the one the node runs with.
+	ErrSpecHashMismatch = errors.New("chain spec hash does not match manifest")
+	// ErrAppDBNotEmpty is returned when importing into a node that already
+	// has application state.
+	ErrAppDBNotEmpty = errors.New("application db is not empty")
+)
+
+type (
+	// BeaconState is the beacon state as encoded in SSZ.
+	BeaconState = types.BeaconState[
+		*types.BeaconBlockHeader,
+		*types.Eth1Data,
+		*types.ExecutionPayloadHeader,
+		*types.Fork,
+		*types.Validator,
+		types.BeaconBlockHeader,
+		types.Eth1Data,
+		types.ExecutionPayloadHeader,
+		types.Fork,
+		types.Validator,
+	]
+
+	// KVStore is the beacon store of the application DB.
+	KVStore = beacondb.KVStore[
+		*types.BeaconBlockHeader,
+		*types.Eth1Data,
+		*types.ExecutionPayloadHeader,
+		*types.Fork,
+		*types.Validator,
+		types.Validators,
+	]
+
+	// StateDB is the beacon state backed by the application DB.
+	StateDB = statedb.StateDB[
+		*types.BeaconBlockHeader,
+		*BeaconState,
+		*types.Eth1Data,
+		*types.ExecutionPayloadHeader,
+		*types.Fork,
+		*KVStore,
+		*types.Validator,
+		types.Validators,
+		*engineprimitives.Withdrawal,
+		types.WithdrawalCredentials,
+	]
+)
+
+// Manifest describes an exported beacon state.
+type Manifest struct {
+	// Slot is the slot of the exported state.
+	Slot math.Slot `json:"slot"`
+	// Fork is the name of the fork the state belongs to.
+	Fork string `json:"fork"`
+	// ForkVersion is the current fork version of the state.
+	ForkVersion common.Version `json:"fork_version"`
+	// StateRoot is the hash tree root of the state.
+	StateRoot common.Root `json:"state_root"`
+	// SpecHash is the hash of the chain spec the state was exported with.
+	SpecHash common.Root `json:"spec_hash"`
+	// DepositBranch is the deposit tree branch of the state.
+	DepositBranch DepositBranch `json:"deposit_branch,omitempty"`
+}
+
+// DepositBranch holds the nodes of the deposit tree branch by height. They
+// are kept in the application DB next to the beacon state but are not part of
+// its SSZ encoding, so they travel alongside it.
+type DepositBranch map[uint64]common.Root
+
+// NewManifest returns the manifest of the given state and deposit branch.
+func NewManifest(
+	cs common.ChainSpec,
+	st *BeaconState,
+	branch DepositBranch,
+) (*Manifest, error) {
+	specHash, err := SpecHash(cs)
+	if err != nil {
+		return nil, err
+	}
+	return &Manifest{
+		Slot:          st.Slot,
+		Fork:          version.Name(version.ToUint32(st.Fork.CurrentVersion)),
+		ForkVersion:   st.Fork.CurrentVersion,
+		StateRoot:     st.HashTreeRoot(),
+		SpecHash:      specHash,
+		DepositBranch: branch,
+	}, nil
+}
+
+// SpecHash returns the sha256 hash of the TOML encoded chain spec.
+func SpecHash(cs common.ChainSpec) (common.Root, error) {
+	var buf bytes.Buffer
+	if err := spec.WriteTOML(&buf, cs.Data()); err != nil {
+		return common.Root{}, err
+	}
+	return sha256.Sum256(buf.Bytes()), nil
+}
+
+// WriteSnapshot writes the state and its manifest, which holds the deposit
+// branch, to the given directory.
+func WriteSnapshot(
+	dir string,
+	cs common.ChainSpec,
+	st *BeaconState,
+	branch DepositBranch,
+) (*Manifest, error) {
+	manifest, err := NewManifest(cs, st, branch)
+	if err != nil {
+		return nil, err
+	}
+	bz, err := st.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	manifestBz, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	//#nosec:G301 // snapshots are meant to be shared.
+	if err = os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	//#nosec:G306 // snapshots are meant to be shared.
+	if err = os.WriteFile(
+		filepath.Join(dir, StateFileName), bz, 0o644,
+	); err != nil {
+		return nil, err
+	}
+	//#nosec:G306 // snapshots are meant to be shared.
+	return manifest, os.WriteFile(
+		filepath.Join(dir, ManifestFileName), manifestBz, 0o644,
+	)
+}
+
+// ReadSnapshot reads the state and its manifest from the given directory and
+// checks them against each other and against the given chain spec.
+func ReadSnapshot(dir string, cs common.ChainSpec) (
+	*BeaconState, *Manifest, error,
+) {
+	manifestBz, err := os.ReadFile(filepath.Join(dir, ManifestFileName))
+	if err != nil {
+		return nil, nil, err
+	}
+	manifest := &Manifest{}
+	if err = json.Unmarshal(manifestBz, manifest); err != nil {
+		return nil, nil, err
+	}
+
+	bz, err := os.ReadFile(filepath.Join(dir, StateFileName))
+	if err != nil {
+		return nil, nil, err
+	}
+	st := &BeaconState{}
+	if err = st.UnmarshalSSZ(bz); err != nil {
+		return nil, nil, errors.Wrap(err, "failed to decode beacon state")
+	}
+
+	if root := st.HashTreeRoot(); root != manifest.StateRoot {
+		return nil, nil, errors.Wrapf(
+			ErrStateRootMismatch,
+			"expected %s, got %s", manifest.StateRoot, root,
+		)
+	}
+	specHash, err := SpecHash(cs)
+	if err != nil {
+		return nil, nil, err
+	}
+	if specHash != manifest.SpecHash {
+		return nil, nil, errors.Wrapf(
+			ErrSpecHashMismatch,
+			"expected %s, got %s", manifest.SpecHash, specHash,
+		)
+	}
+	return st, manifest, nil
+}
+
+// ExportState reads the beacon state and its deposit branch at the given slot
+// from the application DB. A zero slot exports the latest state.
+func ExportState(
+	appDB dbm.DB,
+	cs common.ChainSpec,
+	slot math.Slot,
+) (*BeaconState, DepositBranch, error) {
+	stDB, err := LoadState(appDB, cs, slot)
+	if err != nil {
+		return nil, nil, err
+	}
+	st, err := stDB.GetMarshallable()
+	if err != nil {
+		return nil, nil, err
+	}
+	branch, err := ReadDepositBranch(stDB)
+	if err != nil {
+		return nil, nil, err
+	}
+	return st, branch, nil
+}
+
+// ReadDepositBranch reads the deposit branch of the given state. Only the
+// heights holding a node for the deposit count of the state are read.
+func ReadDepositBranch(stDB *StateDB) (DepositBranch, error) {
+	eth1Data, err := stDB.GetEth1Data()
+	if err != nil {
+		return nil, err
+	}
+	branch := make(DepositBranch)
+	count := eth1Data.DepositCount.Unwrap()
+	for height := uint64(0); count>>height != 0; height++ {
+		if (count>>height)&1 == 0 {
+			continue
+		}
+		if branch[height], err = stDB.GetDepositBranchAtIndex(
+			height,
+		); err != nil {
+			return nil, errors.Wrapf(
+				err, "failed to read deposit branch at height %d", height,
+			)
+		}
+	}
+	return branch, nil
+}
+
+// LoadState returns the beacon state at the given slot from the application
+// DB. A zero slot loads the latest state. Changes made to the returned state
+// are kept in memory and never written back to the DB.
+func LoadState(
+	appDB dbm.DB,
+	cs common.ChainSpec,
+	slot math.Slot,
+) (*StateDB, error) {
+	cms, err := openAppStore(appDB)
+	if err != nil {
+		return nil, err
+	}
+
+	height := cms.LatestVersion()
+	if slot != 0 {
+		height = int64(slot.Unwrap()) //#nosec:G115 // slots fit in int64.
+	}
+	cacheMS, err := cms.CacheMultiStoreWithVersion(height)
+	if err != nil {
+		return nil, errors.Wrapf(
+			err, "failed to load state at height %d", height,
+		)
+	}
+
+	return newStateDB(
+		cs, sdk.NewContext(cacheMS, false, sdklog.NewNopLogger()),
+	), nil
+}
+
+// ImportState writes the given beacon state and deposit branch to the empty
+// application DB, committed at the height of the state slot.
+func ImportState(
+	appDB dbm.DB,
+	cs common.ChainSpec,
+	st *BeaconState,
+	branch DepositBranch,
+) error {
+	cms, err := openAppStore(appDB)
+	if err != nil {
+		return err
+	}
+
+	if cms.LatestVersion() != 0 {
+		return errors.Wrapf(
+			ErrAppDBNotEmpty, "latest height %d", cms.LatestVersion(),
+		)
+	}
+	if st.Slot != 0 {
+		//#nosec:G115 // slots fit in int64.
+		if err = cms.SetInitialVersion(int64(st.Slot.Unwrap())); err != nil {
+			return err
+		}
+	}
+
+	cacheMS := cms.CacheMultiStore()
+	stDB := newStateDB(
+		cs, sdk.NewContext(cacheMS, false, sdklog.NewNopLogger()),
+	)
+	if err = writeState(stDB, st); err != nil {
+		return err
+	}
+	for height, node := range branch {
+		if err = stDB.UpdateDepositBranchAtIndex(height, node); err != nil {
+			return err
+		}
+	}
+	cacheMS.Write()
+	cms.Commit()
+	return nil
+}
+
+// writeState writes every field of the given state to the state DB.
+//
+//nolint:gocognit // one branch per field.
+func writeState(stDB *StateDB, st *BeaconState) error {
+	if err := stDB.SetGenesisValidatorsRoot(
+		st.GenesisValidatorsRoot,
+	); err != nil {
+		return err
+	}
+	if err := stDB.SetSlot(st.Slot); err != nil {
+		return err
+	}
+	if err := stDB.SetFork(st.Fork); err != nil {
+		return err
+	}
+	if err := stDB.SetLatestBlockHeader(st.LatestBlockHeader); err != nil {
+		return err
+	}
+	for i, root := range st.BlockRoots {
+		if err := stDB.UpdateBlockRootAtIndex(uint64(i), root); err != nil {
+			return err
+		}
+	}
+	for i, root := range st.StateRoots {
+		if err := stDB.UpdateStateRootAtIndex(uint64(i), root); err != nil {
+			return err
+		}
+	}
+	if err := stDB.SetEth1Data(st.Eth1Data); err != nil {
+		return err
+	}
+	if err := stDB.SetEth1DepositIndex(st.Eth1DepositIndex); err != nil {
+		return err
+	}
+	if err := stDB.SetLatestExecutionPayloadHeader(
+		st.LatestExecutionPayloadHeader,
+	); err != nil {
+		return err
+	}
+	for i, val := range st.Validators {
+		if err := stDB.AddValidator(val); err != nil {
+			return err
+		}
+		if err := stDB.SetBalance(
+			math.ValidatorIndex(i), math.Gwei(st.Balances[i]),
+		); err != nil {
+			return err
+		}
+	}
+	for i, mix := range st.RandaoMixes {
+		if err := stDB.UpdateRandaoMixAtIndex(uint64(i), mix); err != nil {
+			return err
+		}
+	}
+	if err := stDB.SetNextWithdrawalIndex(st.NextWithdrawalIndex); err != nil {
+		return err
+	}
+	if err := stDB.SetNextWithdrawalValidatorIndex(
+		st.NextWithdrawalValidatorIndex,
+	); err != nil {
+		return err
+	}
+	for i, amount := range st.Slashings {
+		if err := stDB.SetSlashingAtIndex(uint64(i), amount); err != nil {
+			return err
+		}
+	}
+	return stDB.SetTotalSlashing(st.TotalSlashing)
+}
+
+// openAppStore opens the beacon store of the application DB.
+func openAppStore(appDB dbm.DB) (storetypes.CommitMultiStore, error) {
+	cms := store.NewCommitMultiStore(
+		appDB, sdklog.NewNopLogger(), metrics.NewNoOpMetrics(),
+	)
+	cms.MountStoreWithDB(
+		components.ProvideKVStoreKey(), storetypes.StoreTypeIAVL, nil,
+	)
+	if err := cms.LoadLatestVersion(); err != nil {
+		return nil, err
+	}
+	return cms, nil
+}
+
+// newStateDB returns a state DB over the beacon store of the given context.
+func newStateDB(cs common.ChainSpec, ctx sdk.Context) *StateDB {
+	kvs := beacondb.New[
+		*types.BeaconBlockHeader,
+		*types.Eth1Data,
+		*types.ExecutionPayloadHeader,
+		*types.Fork,
+		*types.Validator,
+		types.Validators,
+	](
+		&ctxKVStoreService{ctx: ctx},
+		&encoding.SSZInterfaceCodec[*types.ExecutionPayloadHeader]{},
+	)
+	return new(StateDB).NewFromDB(kvs, cs)
+}
+
+// ctxKVStoreService opens the beacon store of a fixed context.
+type ctxKVStoreService struct {
+	ctx sdk.Context
+}
+
+// OpenKVStore returns the beacon store of the fixed context.
+func (kvs *ctxKVStoreService) OpenKVStore(context.Context) corestore.KVStore {
+	return components.NewKVStore(
+		kvs.ctx.KVStore(components.ProvideKVStoreKey()),
+	)
+}
@@ -0,0 +1,113 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package db_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/cli/commands/db"
+	"github.com/berachain/beacon-kit/config/spec"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStateExportImport(t *testing.T) {
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+	st := testState(cs, 5)
+	// Three deposits are held by the nodes at heights 0 and 1.
+	branch := db.DepositBranch{0: {0x05}, 1: {0x06}}
+
+	// Import into an empty node and read the state back.
+	appDB, err := dbm.NewDB("application", dbm.MemDBBackend, "")
+	require.NoError(t, err)
+	require.NoError(t, db.ImportState(appDB, cs, st, branch))
+	require.ErrorIs(
+		t, db.ImportState(appDB, cs, st, branch), db.ErrAppDBNotEmpty,
+	)
+
+	exported, exportedBranch, err := db.ExportState(appDB, cs, st.Slot)
+	require.NoError(t, err)
+	require.Equal(t, st.HashTreeRoot(), exported.HashTreeRoot())
+	require.Equal(t, branch, exportedBranch)
+
+	// Round trip the state through a snapshot.
+	dir := t.TempDir()
+	manifest, err := db.WriteSnapshot(dir, cs, exported, exportedBranch)
+	require.NoError(t, err)
+	require.Equal(t, st.Slot, manifest.Slot)
+	require.Equal(t, version.Name(version.Deneb), manifest.Fork)
+	require.Equal(t, branch, manifest.DepositBranch)
+
+	read, readManifest, err := db.ReadSnapshot(dir, cs)
+	require.NoError(t, err)
+	require.Equal(t, manifest, readManifest)
+	require.Equal(t, st.HashTreeRoot(), read.HashTreeRoot())
+}
+
+func TestReadSnapshotSpecMismatch(t *testing.T) {
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+	otherCS, err := spec.TestnetChainSpec()
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	_, err = db.WriteSnapshot(dir, cs, testState(cs, 1), nil)
+	require.NoError(t, err)
+
+	_, _, err = db.ReadSnapshot(dir, otherCS)
+	require.ErrorIs(t, err, db.ErrSpecHashMismatch)
+}
+
+// testState returns a beacon state with a single validator at the given slot.
+func testState(cs common.ChainSpec, slot math.Slot) *db.BeaconState {
+	historicalRoots := cs.SlotsPerHistoricalRoot()
+	st := &db.BeaconState{
+		GenesisValidatorsRoot: common.Root{0x01},
+		Slot:                  slot,
+		Fork: (&types.Fork{}).New(
+			version.FromUint32[common.Version](version.Deneb),
+			version.FromUint32[common.Version](version.Deneb),
+			0,
+		),
+		LatestBlockHeader: &types.BeaconBlockHeader{
+			Slot: slot - 1, ParentBlockRoot: common.Root{0x02},
+		},
+		BlockRoots:                   make([]common.Root, historicalRoots),
+		StateRoots:                   make([]common.Root, historicalRoots),
+		Eth1Data:                     &types.Eth1Data{DepositCount: 3},
+		Eth1DepositIndex:             3,
+		LatestExecutionPayloadHeader: &types.ExecutionPayloadHeader{},
+		Validators: []*types.Validator{{
+			EffectiveBalance: math.Gwei(cs.MaxEffectiveBalance()),
+		}},
+		Balances:    []uint64{cs.MaxEffectiveBalance()},
+		RandaoMixes: make([]common.Bytes32, cs.EpochsPerHistoricalVector()),
+		Slashings:   []math.Gwei{},
+	}
+	st.BlockRoots[0] = common.Root{0x03}
+	st.RandaoMixes[0] = common.Bytes32{0x04}
+	return st
+}
@@ -0,0 +1,274 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package debug
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/berachain/beacon-kit/cli/commands/db"
+	"github.com/berachain/beacon-kit/config/spec"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/errors"
+	pbytes "github.com/berachain/beacon-kit/primitives/bytes"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+const (
+	// BundleManifestFileName is the name of the manifest in a bundle.
+	BundleManifestFileName = "manifest.json"
+	// BundleSpecFileName is the name of the chain spec in a bundle.
+	BundleSpecFileName = "spec.toml"
+	// bundlePreStateFileName is the name of the gzipped SSZ encoded state
+	// the blocks of a bundle are replayed on top of.
+	bundlePreStateFileName = "pre_state.ssz.gz"
+	// bundleBlockFileFormat is the format of the name of the gzipped SSZ
+	// encoded blocks of a bundle.
+	bundleBlockFileFormat = "block_%d.ssz.gz"
+)
+
+// ErrInvalidBundle is returned when a replay bundle is inconsistent with
+// its manifest.
+var ErrInvalidBundle = errors.New("invalid replay bundle")
+
+// BundleBlock is the manifest entry of a block of a replay bundle. Next to
+// the roots the block is checked against, it records what the block was
+// finalized with that is not part of the block itself.
+type BundleBlock struct {
+	// Slot is the slot of the block.
+	Slot math.Slot `json:"slot"`
+	// BlockRoot is the hash tree root of the block.
+	BlockRoot common.Root `json:"block_root"`
+	// StateRoot is the state root the block commits to.
+	StateRoot common.Root `json:"state_root"`
+	// ProposerAddress is the consensus address of the block proposer.
+	ProposerAddress pbytes.Bytes `json:"proposer_address"`
+	// ConsensusTime is the consensus time of the block, in seconds.
+	ConsensusTime math.U64 `json:"consensus_time"`
+	// PayloadStatus is the status the execution client returned for the
+	// execution payload of the block.
+	PayloadStatus engineprimitives.PayloadStatusStr `json:"payload_status"`
+}
+
+// BundleManifest describes the content of a replay bundle.
+type BundleManifest struct {
+	// PreStateSlot is the slot of the state the blocks are replayed on.
+	PreStateSlot math.Slot `json:"pre_state_slot"`
+	// PreStateRoot is the hash tree root of the state the blocks are
+	// replayed on.
+	PreStateRoot common.Root `json:"pre_state_root"`
+	// DepositBranch is the deposit tree branch of the state the blocks are
+	// replayed on.
+	DepositBranch db.DepositBranch `json:"deposit_branch,omitempty"`
+	// Blocks are the blocks of the bundle, in replay order.
+	Blocks []*BundleBlock `json:"blocks"`
+}
+
+// Bundle is a compact recording of a range of finalized blocks, with the
+// state preceding them, the chain spec they were produced with and the
+// execution client responses needed to replay them offline.
+type Bundle struct {
+	Manifest  *BundleManifest
+	ChainSpec common.ChainSpec
+	PreState  *db.BeaconState
+	Blocks    []*types.BeaconBlock
+}
+
+// NewBundle creates an empty bundle on top of the given state and its
+// deposit branch.
+func NewBundle(
+	cs common.ChainSpec,
+	preState *db.BeaconState,
+	preStateBranch db.DepositBranch,
+) *Bundle {
+	return &Bundle{
+		Manifest: &BundleManifest{
+			PreStateSlot:  preState.Slot,
+			PreStateRoot:  preState.HashTreeRoot(),
+			DepositBranch: preStateBranch,
+			Blocks:        []*BundleBlock{},
+		},
+		ChainSpec: cs,
+		PreState:  preState,
+		Blocks:    []*types.BeaconBlock{},
+	}
+}
+
+// AddBlock appends the given block to the bundle, together with the
+// consensus data it was finalized with and the status of its payload.
+func (b *Bundle) AddBlock(
+	blk *types.BeaconBlock,
+	proposerAddress []byte,
+	consensusTime math.U64,
+	payloadStatus engineprimitives.PayloadStatusStr,
+) {
+	b.Blocks = append(b.Blocks, blk)
+	b.Manifest.Blocks = append(b.Manifest.Blocks, &BundleBlock{
+		Slot:            blk.GetSlot(),
+		BlockRoot:       blk.HashTreeRoot(),
+		StateRoot:       blk.GetStateRoot(),
+		ProposerAddress: proposerAddress,
+		ConsensusTime:   consensusTime,
+		PayloadStatus:   payloadStatus,
+	})
+}
+
+// WriteBundle writes the bundle to the given directory.
+func WriteBundle(dir string, b *Bundle) error {
+	//#nosec:G301 // bundles are meant to be shared.
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	var specBuf bytes.Buffer
+	if err := spec.WriteTOML(&specBuf, b.ChainSpec.Data()); err != nil {
+		return err
+	}
+	if err := writeBundleFile(
+		dir, BundleSpecFileName, specBuf.Bytes(), false,
+	); err != nil {
+		return err
+	}
+
+	bz, err := b.PreState.MarshalSSZ()
+	if err != nil {
+		return err
+	}
+	if err = writeBundleFile(
+		dir, bundlePreStateFileName, bz, true,
+	); err != nil {
+		return err
+	}
+
+	for i, blk := range b.Blocks {
+		if bz, err = blk.MarshalSSZ(); err != nil {
+			return err
+		}
+		if err = writeBundleFile(
+			dir, fmt.Sprintf(bundleBlockFileFormat, i), bz, true,
+		); err != nil {
+			return err
+		}
+	}
+
+	manifestBz, err := json.MarshalIndent(b.Manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	return writeBundleFile(dir, BundleManifestFileName, manifestBz, false)
+}
+
+// ReadBundle reads the bundle from the given directory and checks its
+// content against its manifest.
+func ReadBundle(dir string) (*Bundle, error) {
+	manifestBz, err := readBundleFile(dir, BundleManifestFileName, false)
+	if err != nil {
+		return nil, err
+	}
+	b := &Bundle{Manifest: &BundleManifest{}}
+	if err = json.Unmarshal(manifestBz, b.Manifest); err != nil {
+		return nil, err
+	}
+
+	if b.ChainSpec, err = spec.LoadFile(
+		filepath.Join(dir, BundleSpecFileName),
+	); err != nil {
+		return nil, err
+	}
+
+	bz, err := readBundleFile(dir, bundlePreStateFileName, true)
+	if err != nil {
+		return nil, err
+	}
+	b.PreState = &db.BeaconState{}
+	if err = b.PreState.UnmarshalSSZ(bz); err != nil {
+		return nil, errors.Wrap(err, "failed to decode pre state")
+	}
+	if root := b.PreState.HashTreeRoot(); root != b.Manifest.PreStateRoot {
+		return nil, errors.Wrapf(
+			ErrInvalidBundle, "pre state root: expected %s, got %s",
+			b.Manifest.PreStateRoot, root,
+		)
+	}
+
+	b.Blocks = make([]*types.BeaconBlock, len(b.Manifest.Blocks))
+	for i, entry := range b.Manifest.Blocks {
+		if bz, err = readBundleFile(
+			dir, fmt.Sprintf(bundleBlockFileFormat, i), true,
+		); err != nil {
+			return nil, err
+		}
+		var blk *types.BeaconBlock
+		if blk, err = decodeBlock(b.ChainSpec, entry.Slot, bz); err != nil {
+			return nil, errors.Wrapf(
+				err, "failed to decode block of slot %d", entry.Slot,
+			)
+		}
+		if root := blk.HashTreeRoot(); root != entry.BlockRoot {
+			return nil, errors.Wrapf(
+				ErrInvalidBundle, "block of slot %d: expected %s, got %s",
+				entry.Slot, entry.BlockRoot, root,
+			)
+		}
+		b.Blocks[i] = blk
+	}
+	return b, nil
+}
+
+// writeBundleFile writes the given bytes to the named file of the bundle
+// directory, gzipped if requested.
+func writeBundleFile(dir, name string, bz []byte, compress bool) error {
+	if compress {
+		var buf bytes.Buffer
+		zw := gzip.NewWriter(&buf)
+		if _, err := zw.Write(bz); err != nil {
+			return err
+		}
+		if err := zw.Close(); err != nil {
+			return err
+		}
+		bz = buf.Bytes()
+	}
+	//#nosec:G306 // bundles are meant to be shared.
+	return os.WriteFile(filepath.Join(dir, name), bz, 0o644)
+}
+
+// readBundleFile reads the named file of the bundle directory, gunzipping
+// it if requested.
+func readBundleFile(dir, name string, compressed bool) ([]byte, error) {
+	bz, err := os.ReadFile(filepath.Join(dir, name))
+	if err != nil || !compressed {
+		return bz, err
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(bz))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read %s", name)
+	}
+	defer zr.Close()
+	return io.ReadAll(zr)
+}
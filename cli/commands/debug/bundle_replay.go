@@ -0,0 +1,196 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package debug
+
+import (
+	"context"
+
+	sdklog "cosmossdk.io/log"
+	storev2 "cosmossdk.io/store/v2/db"
+	"github.com/berachain/beacon-kit/cli/commands/db"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	engineerrors "github.com/berachain/beacon-kit/engine-primitives/errors"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/execution/engine"
+	"github.com/berachain/beacon-kit/node-core/components/storage"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/transition"
+	depositstore "github.com/berachain/beacon-kit/storage/deposit"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cobra"
+)
+
+// ErrMissingPayloadStatus is returned when a replayed payload has no status
+// recorded in the bundle.
+var ErrMissingPayloadStatus = errors.New("no payload status recorded")
+
+// NewReplayBundleCommand creates a new command that replays replay bundles.
+func NewReplayBundleCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "replay-bundle [dir]...",
+		Short: "Replays recorded bundles through the state transition",
+		Long: `Replays the blocks of every given replay bundle, as written by
+'debug capture', on top of the state recorded with them, checking the
+resulting state root of every block against the one it commits to. The
+execution client is replaced by the payload statuses recorded in the bundle,
+so no node or execution client is needed.`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			for _, dir := range args {
+				b, err := ReadBundle(dir)
+				if err != nil {
+					return errors.Wrapf(err, "bundle %s", dir)
+				}
+				if err = ReplayBundle(cmd.Context(), b); err != nil {
+					return errors.Wrapf(err, "bundle %s", dir)
+				}
+				cmd.Printf(
+					"bundle %s: replayed %d blocks on top of slot %d\n",
+					dir, len(b.Blocks), b.Manifest.PreStateSlot,
+				)
+			}
+			return nil
+		},
+	}
+}
+
+// ReplayBundle replays the blocks of the bundle on top of its pre state,
+// entirely in memory, and checks the state root of every block. Blocks are
+// finalized as by a node, with the execution client answering with the
+// recorded payload statuses.
+func ReplayBundle(ctx context.Context, b *Bundle) error {
+	appDB, err := dbm.NewDB("application", dbm.MemDBBackend, "")
+	if err != nil {
+		return err
+	}
+	defer appDB.Close()
+	if err = db.ImportState(
+		appDB, b.ChainSpec, b.PreState, b.Manifest.DepositBranch,
+	); err != nil {
+		return err
+	}
+	st, err := db.LoadState(appDB, b.ChainSpec, 0)
+	if err != nil {
+		return err
+	}
+	if root := st.HashTreeRoot(); root != b.Manifest.PreStateRoot {
+		return errors.Wrapf(
+			ErrInvalidBundle, "imported pre state root: expected %s, got %s",
+			b.Manifest.PreStateRoot, root,
+		)
+	}
+
+	depositStore := depositstore.NewStore[*types.Deposit](
+		storage.NewKVStoreProvider(storev2.NewMemDB()),
+		sdklog.NewNopLogger(),
+	)
+	statuses := make(
+		map[common.ExecutionHash]engineprimitives.PayloadStatusStr,
+		len(b.Blocks),
+	)
+	for i, blk := range b.Blocks {
+		payload := blk.GetBody().GetExecutionPayload()
+		statuses[payload.GetBlockHash()] = b.Manifest.Blocks[i].PayloadStatus
+	}
+	sp, err := newStateProcessor(
+		b.ChainSpec, &recordedEngine{statuses: statuses}, depositStore,
+	)
+	if err != nil {
+		return err
+	}
+
+	for i, blk := range b.Blocks {
+		entry := b.Manifest.Blocks[i]
+		// The deposits of a block were in the deposit store of the node
+		// when it was finalized.
+		if err = depositStore.EnqueueDeposits(
+			blk.GetBody().GetDeposits(),
+		); err != nil {
+			return err
+		}
+
+		if _, err = sp.ProcessSlots(st, blk.GetSlot()); err != nil {
+			return errors.Wrapf(err, "slot %d", entry.Slot)
+		}
+		if err = sp.ProcessBlock(&transition.Context{
+			Context: ctx,
+			// Mirrors the context blocks are finalized with.
+			OptimisticEngine: true,
+			// The state root is checked below to report the divergence.
+			SkipValidateResult: true,
+			ProposerAddress:    entry.ProposerAddress,
+			ConsensusTime:      entry.ConsensusTime,
+		}, st, blk); err != nil {
+			return errors.Wrapf(err, "slot %d", entry.Slot)
+		}
+
+		if stateRoot := st.HashTreeRoot(); stateRoot != entry.StateRoot {
+			return errors.Wrapf(
+				ErrReplayDivergence, "slot %d: expected %s, got %s",
+				entry.Slot, entry.StateRoot, stateRoot,
+			)
+		}
+	}
+	return nil
+}
+
+// recordedEngine answers new payload requests with the payload statuses
+// recorded in a bundle, handling them as the execution engine does.
+type recordedEngine struct {
+	statuses map[common.ExecutionHash]engineprimitives.PayloadStatusStr
+}
+
+// VerifyAndNotifyNewPayload verifies the payload hashes and returns the
+// outcome of the recorded payload status.
+func (e *recordedEngine) VerifyAndNotifyNewPayload(
+	_ context.Context,
+	req *engineprimitives.NewPayloadRequest[
+		*types.ExecutionPayload, engineprimitives.Withdrawals,
+	],
+) error {
+	if err := req.HasValidVersionedAndBlockHashes(); err != nil {
+		return err
+	}
+
+	blockHash := req.ExecutionPayload.GetBlockHash()
+	status, ok := e.statuses[blockHash]
+	if !ok {
+		return errors.Wrapf(ErrMissingPayloadStatus, "payload %s", blockHash)
+	}
+
+	var err error
+	switch status {
+	case engineprimitives.PayloadStatusValid:
+	case engineprimitives.PayloadStatusAccepted:
+		err = engineerrors.ErrAcceptedPayloadStatus
+	case engineprimitives.PayloadStatusSyncing:
+		err = engineerrors.ErrSyncingPayloadStatus
+	case engineprimitives.PayloadStatusInvalid:
+		return engine.ErrBadBlockProduced
+	default:
+		err = engineerrors.ErrUnknownPayloadStatus
+	}
+	if req.Optimistic {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,371 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+//go:build bls12381
+
+package debug_test
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	sdklog "cosmossdk.io/log"
+	storev2 "cosmossdk.io/store/v2/db"
+	"github.com/berachain/beacon-kit/cli/commands/db"
+	"github.com/berachain/beacon-kit/cli/commands/debug"
+	"github.com/berachain/beacon-kit/cli/commands/devnet"
+	"github.com/berachain/beacon-kit/config/spec"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/execution/engine"
+	"github.com/berachain/beacon-kit/node-core/components/signer"
+	"github.com/berachain/beacon-kit/node-core/components/storage"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/eip4844"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/transition"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/berachain/beacon-kit/state-transition/core"
+	depositstore "github.com/berachain/beacon-kit/storage/deposit"
+	"github.com/berachain/beacon-kit/testing/simulated"
+	"github.com/cometbft/cometbft/crypto/bls12381"
+	"github.com/cometbft/cometbft/privval"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+)
+
+//nolint:gochecknoglobals // test flag.
+var updateBundles = flag.Bool(
+	"update-bundles", false,
+	"regenerate the synthetic replay bundle under testdata/bundles",
+)
+
+const (
+	// bundlesDir is the directory of the bundles replayed by
+	// TestReplayBundles, one per subdirectory.
+	bundlesDir = "testdata/bundles"
+	// syntheticBundle is the name of the bundle generated by this test.
+	syntheticBundle = "synthetic"
+	// bundleSeed is the seed the key of the synthetic validator is derived
+	// from.
+	bundleSeed = "replay-bundle"
+	// bundleBlocks is the number of blocks of the synthetic bundle.
+	bundleBlocks = 10
+	// genesisTime is the time of the synthetic genesis payload.
+	genesisTime = 1_700_000_000
+)
+
+// TestReplayBundles replays every recorded bundle, failing if a change to
+// the state transition alters the root of any historical block.
+func TestReplayBundles(t *testing.T) {
+	if *updateBundles {
+		dir := filepath.Join(bundlesDir, syntheticBundle)
+		require.NoError(t, os.RemoveAll(dir))
+		require.NoError(t, debug.WriteBundle(dir, buildBundle(t)))
+	}
+
+	manifests, err := filepath.Glob(
+		filepath.Join(bundlesDir, "*", debug.BundleManifestFileName),
+	)
+	require.NoError(t, err)
+	require.NotEmpty(t, manifests)
+	for _, manifest := range manifests {
+		dir := filepath.Dir(manifest)
+		t.Run(filepath.Base(dir), func(t *testing.T) {
+			b, readErr := debug.ReadBundle(dir)
+			require.NoError(t, readErr)
+			require.NoError(t, debug.ReplayBundle(context.Background(), b))
+		})
+	}
+}
+
+func TestReplayBundle(t *testing.T) {
+	b := buildBundle(t)
+	require.NoError(t, debug.ReplayBundle(context.Background(), b))
+
+	dir := t.TempDir()
+	require.NoError(t, debug.WriteBundle(dir, b))
+	out := new(bytes.Buffer)
+	cmd := debug.NewReplayBundleCommand()
+	cmd.SetOut(out)
+	cmd.SetArgs([]string{dir})
+	require.NoError(t, cmd.Execute())
+	require.Contains(t, out.String(), "replayed 10 blocks on top of slot 0")
+}
+
+func TestReplayBundleDivergence(t *testing.T) {
+	b := buildBundle(t)
+	b.Manifest.Blocks[5].StateRoot = common.Root{0xff}
+
+	err := debug.ReplayBundle(context.Background(), b)
+	require.ErrorIs(t, err, debug.ErrReplayDivergence)
+	require.ErrorContains(t, err, "slot 6")
+}
+
+func TestReplayBundlePayloadStatus(t *testing.T) {
+	b := buildBundle(t)
+	b.Manifest.Blocks[2].PayloadStatus = engineprimitives.PayloadStatusInvalid
+	err := debug.ReplayBundle(context.Background(), b)
+	require.ErrorIs(t, err, engine.ErrBadBlockProduced)
+	require.ErrorContains(t, err, "slot 3")
+
+	// Syncing payloads are accepted, as blocks are finalized optimistically.
+	b = buildBundle(t)
+	b.Manifest.Blocks[2].PayloadStatus = engineprimitives.PayloadStatusSyncing
+	require.NoError(t, debug.ReplayBundle(context.Background(), b))
+}
+
+type testStateProcessor = core.StateProcessor[
+	*db.StateDB, *transition.Context, *db.KVStore,
+]
+
+// validEngine accepts every payload with valid hashes.
+type validEngine struct{}
+
+func (validEngine) VerifyAndNotifyNewPayload(
+	_ context.Context,
+	req *engineprimitives.NewPayloadRequest[
+		*types.ExecutionPayload, engineprimitives.Withdrawals,
+	],
+) error {
+	return req.HasValidVersionedAndBlockHashes()
+}
+
+// chainBuilder produces a chain of valid blocks proposed by a single
+// validator, recording them in a bundle.
+type chainBuilder struct {
+	t               *testing.T
+	cs              common.ChainSpec
+	signer          signer.BLSSigner
+	proposerAddress []byte
+	st              *db.StateDB
+	sp              *testStateProcessor
+	ds              *depositstore.KVStore[*types.Deposit]
+	bundle          *debug.Bundle
+}
+
+// buildBundle returns a bundle of a synthetic chain crossing a few epochs,
+// with a deposit and the withdrawal it triggers.
+func buildBundle(t *testing.T) *debug.Bundle {
+	t.Helper()
+	c := newChainBuilder(t)
+	for i := range bundleBlocks {
+		var deposits []*types.Deposit
+		if i == 2 {
+			deposits = []*types.Deposit{{
+				Pubkey:      c.signer.PublicKey(),
+				Credentials: withdrawalCredentials(),
+				Amount:      math.Gwei(c.cs.EffectiveBalanceIncrement()),
+				Index:       1,
+			}}
+		}
+		c.next(deposits)
+	}
+	return c.bundle
+}
+
+// newChainBuilder returns a builder on top of a genesis state with a single
+// validator.
+func newChainBuilder(t *testing.T) *chainBuilder {
+	t.Helper()
+	cs, err := spec.FastDevnetChainSpec()
+	require.NoError(t, err)
+
+	secret := devnet.ValidatorSecret(bundleSeed, 0)
+	privKey, err := bls12381.NewPrivateKeyFromBytes(secret[:])
+	require.NoError(t, err)
+	c := &chainBuilder{
+		t:  t,
+		cs: cs,
+		signer: signer.BLSSigner{
+			PrivValidator: privval.NewFilePV(privKey, "", ""),
+		},
+	}
+	c.proposerAddress, err = crypto.GetAddressFromPubKey(c.signer.PublicKey())
+	require.NoError(t, err)
+
+	// The pre state is exported back from the DB it is imported into, so
+	// that it is encoded exactly as the state of a node.
+	appDB, err := dbm.NewDB("application", dbm.MemDBBackend, "")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = appDB.Close() })
+	genesisBranch := db.DepositBranch{0: common.Root{0x05}}
+	require.NoError(t, db.ImportState(
+		appDB, cs, c.genesisState(), genesisBranch,
+	))
+	pre, preBranch, err := db.ExportState(appDB, cs, 0)
+	require.NoError(t, err)
+	require.Equal(t, genesisBranch, preBranch)
+	c.st, err = db.LoadState(appDB, cs, 0)
+	require.NoError(t, err)
+
+	c.ds = depositstore.NewStore[*types.Deposit](
+		storage.NewKVStoreProvider(storev2.NewMemDB()),
+		sdklog.NewNopLogger(),
+	)
+	c.sp, err = core.NewStateProcessor[
+		*db.StateDB, *transition.Context, *db.KVStore,
+	](
+		core.WithForkSchedule(cs),
+		core.WithExecutionEngine(validEngine{}),
+		core.WithDepositStore(c.ds),
+		core.WithSigner(c.signer),
+	)
+	require.NoError(t, err)
+	c.bundle = debug.NewBundle(cs, pre, preBranch)
+	return c
+}
+
+// genesisState returns a state at slot 0 with a single active validator.
+func (c *chainBuilder) genesisState() *db.BeaconState {
+	genesisPayload := &types.ExecutionPayload{
+		GasLimit:      30_000_000,
+		Timestamp:     genesisTime,
+		BaseFeePerGas: math.NewU256(1),
+		Transactions:  engineprimitives.Transactions{},
+		Withdrawals:   []*engineprimitives.Withdrawal{},
+	}
+	genesisPayload.BlockHash = simulated.BlockHash(
+		genesisPayload, common.Root{},
+	)
+	header, err := genesisPayload.ToHeader()
+	require.NoError(c.t, err)
+
+	maxBalance := math.Gwei(c.cs.MaxEffectiveBalance())
+	validator := types.NewValidatorFromDeposit(
+		c.signer.PublicKey(),
+		withdrawalCredentials(),
+		maxBalance,
+		math.Gwei(c.cs.EffectiveBalanceIncrement()),
+		maxBalance,
+	)
+	validator.ActivationEligibilityEpoch = 0
+	validator.ActivationEpoch = 0
+
+	deneb := version.FromUint32[common.Version](version.Deneb)
+	return &db.BeaconState{
+		GenesisValidatorsRoot: common.Root{0x01},
+		Fork:                  (&types.Fork{}).New(deneb, deneb, 0),
+		LatestBlockHeader:     &types.BeaconBlockHeader{},
+		BlockRoots: make(
+			[]common.Root, c.cs.SlotsPerHistoricalRoot(),
+		),
+		StateRoots: make(
+			[]common.Root, c.cs.SlotsPerHistoricalRoot(),
+		),
+		Eth1Data:                     &types.Eth1Data{DepositCount: 1},
+		LatestExecutionPayloadHeader: header,
+		Validators:                   []*types.Validator{validator},
+		Balances:                     []uint64{maxBalance.Unwrap()},
+		RandaoMixes: make(
+			[]common.Bytes32, c.cs.EpochsPerHistoricalVector(),
+		),
+		Slashings: make([]math.Gwei, c.cs.EpochsPerSlashingsVector()),
+	}
+}
+
+// next builds, processes and records the block of the next slot, including
+// the given deposits.
+func (c *chainBuilder) next(deposits []*types.Deposit) {
+	slot, err := c.st.GetSlot()
+	require.NoError(c.t, err)
+	slot++
+	_, err = c.sp.ProcessSlots(c.st, slot)
+	require.NoError(c.t, err)
+
+	lbh, err := c.st.GetLatestBlockHeader()
+	require.NoError(c.t, err)
+	parentBlockRoot := lbh.HashTreeRoot()
+	lph, err := c.st.GetLatestExecutionPayloadHeader()
+	require.NoError(c.t, err)
+	epoch := c.cs.SlotToEpoch(slot)
+	mix, err := c.st.GetRandaoMixAtIndex(
+		epoch.Unwrap() % c.cs.EpochsPerHistoricalVector(),
+	)
+	require.NoError(c.t, err)
+	withdrawals, err := c.st.ExpectedWithdrawals()
+	require.NoError(c.t, err)
+
+	consensusTime := math.U64(genesisTime + 2*slot.Unwrap())
+	payload := &types.ExecutionPayload{
+		ParentHash:    lph.BlockHash,
+		Random:        mix,
+		Number:        lph.Number + 1,
+		GasLimit:      lph.GasLimit,
+		Timestamp:     consensusTime,
+		BaseFeePerGas: math.NewU256(1),
+		Transactions:  engineprimitives.Transactions{},
+		Withdrawals:   withdrawals,
+	}
+	payload.BlockHash = simulated.BlockHash(payload, parentBlockRoot)
+
+	genesisValidatorsRoot, err := c.st.GetGenesisValidatorsRoot()
+	require.NoError(c.t, err)
+	signingRoot := types.NewForkData(
+		version.FromUint32[common.Version](
+			c.cs.ActiveForkVersionForEpoch(epoch),
+		), genesisValidatorsRoot,
+	).ComputeRandaoSigningRoot(c.cs.DomainTypeRandao(), epoch)
+	reveal, err := c.signer.Sign(signingRoot[:])
+	require.NoError(c.t, err)
+
+	if deposits == nil {
+		deposits = []*types.Deposit{}
+	}
+	blk := &types.BeaconBlock{
+		Slot:       slot,
+		ParentRoot: parentBlockRoot,
+		Body: &types.BeaconBlockBody{
+			RandaoReveal:       reveal,
+			Eth1Data:           &types.Eth1Data{},
+			Deposits:           deposits,
+			ExecutionPayload:   payload,
+			BlobKzgCommitments: []eip4844.KZGCommitment{},
+		},
+	}
+	blk.Body.Eth1Data, err = c.sp.Eth1Data(c.st, blk)
+	require.NoError(c.t, err)
+
+	require.NoError(c.t, c.ds.EnqueueDeposits(deposits))
+	require.NoError(c.t, c.sp.ProcessBlock(&transition.Context{
+		Context:            context.Background(),
+		OptimisticEngine:   true,
+		SkipValidateResult: true,
+		ProposerAddress:    c.proposerAddress,
+		ConsensusTime:      consensusTime,
+	}, c.st, blk))
+	blk.StateRoot = c.st.HashTreeRoot()
+	c.bundle.AddBlock(
+		blk, c.proposerAddress, consensusTime,
+		engineprimitives.PayloadStatusValid,
+	)
+}
+
+// withdrawalCredentials returns the credentials of the synthetic validator.
+func withdrawalCredentials() types.WithdrawalCredentials {
+	return types.NewCredentialsFromExecutionAddress(
+		common.ExecutionAddress{0x01},
+	)
+}
@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package debug_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/berachain/beacon-kit/cli/commands/db"
+	"github.com/berachain/beacon-kit/cli/commands/debug"
+	"github.com/berachain/beacon-kit/config/spec"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBundleRoundTrip(t *testing.T) {
+	b := testBundle(t)
+	dir := t.TempDir()
+	require.NoError(t, debug.WriteBundle(dir, b))
+
+	read, err := debug.ReadBundle(dir)
+	require.NoError(t, err)
+	require.Equal(t, b.Manifest, read.Manifest)
+	require.Equal(t, b.PreState.HashTreeRoot(), read.PreState.HashTreeRoot())
+	require.Len(t, read.Blocks, len(b.Blocks))
+	for i, blk := range b.Blocks {
+		require.Equal(t, blk.HashTreeRoot(), read.Blocks[i].HashTreeRoot())
+	}
+
+	expectedSpecHash, err := db.SpecHash(b.ChainSpec)
+	require.NoError(t, err)
+	specHash, err := db.SpecHash(read.ChainSpec)
+	require.NoError(t, err)
+	require.Equal(t, expectedSpecHash, specHash)
+}
+
+func TestReadBundleInvalid(t *testing.T) {
+	for name, tamper := range map[string]func(*debug.BundleManifest){
+		"pre state root": func(m *debug.BundleManifest) {
+			m.PreStateRoot = common.Root{0xff}
+		},
+		"block root": func(m *debug.BundleManifest) {
+			m.Blocks[1].BlockRoot = common.Root{0xff}
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			b := testBundle(t)
+			dir := t.TempDir()
+			require.NoError(t, debug.WriteBundle(dir, b))
+
+			tamper(b.Manifest)
+			bz, err := json.Marshal(b.Manifest)
+			require.NoError(t, err)
+			require.NoError(t, os.WriteFile(
+				filepath.Join(dir, debug.BundleManifestFileName), bz, 0o600,
+			))
+
+			_, err = debug.ReadBundle(dir)
+			require.ErrorIs(t, err, debug.ErrInvalidBundle)
+		})
+	}
+}
+
+// testBundle returns a bundle of two empty blocks on top of a minimal state.
+func testBundle(t *testing.T) *debug.Bundle {
+	t.Helper()
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+
+	pre := &db.BeaconState{
+		Slot: 4,
+		Fork: (&types.Fork{}).New(
+			version.FromUint32[common.Version](version.Deneb),
+			version.FromUint32[common.Version](version.Deneb),
+			0,
+		),
+		LatestBlockHeader:            &types.BeaconBlockHeader{Slot: 4},
+		BlockRoots:                   []common.Root{},
+		StateRoots:                   []common.Root{},
+		Eth1Data:                     &types.Eth1Data{},
+		LatestExecutionPayloadHeader: &types.ExecutionPayloadHeader{},
+		Validators:                   []*types.Validator{},
+		Balances:                     []uint64{},
+		RandaoMixes:                  []common.Bytes32{},
+		Slashings:                    []math.Gwei{},
+	}
+	b := debug.NewBundle(cs, pre, nil)
+	for _, slot := range []math.Slot{5, 6} {
+		blk, blkErr := (&types.BeaconBlock{}).NewWithVersion(
+			slot, 0, common.Root{0x01}, version.Deneb,
+		)
+		require.NoError(t, blkErr)
+		blk.StateRoot = common.Root{0x02}
+		blk.Body.ExecutionPayload = &types.ExecutionPayload{}
+		b.AddBlock(
+			blk, []byte{0x03}, slot,
+			engineprimitives.PayloadStatusValid,
+		)
+	}
+	return b
+}
@@ -0,0 +1,249 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package debug
+
+import (
+	"math/big"
+
+	"github.com/berachain/beacon-kit/cli/commands/db"
+	"github.com/berachain/beacon-kit/cli/context"
+	"github.com/berachain/beacon-kit/config"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	engineerrors "github.com/berachain/beacon-kit/engine-primitives/errors"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/execution/client"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/node-core/components"
+	nodemetrics "github.com/berachain/beacon-kit/node-core/components/metrics"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	storagedb "github.com/berachain/beacon-kit/storage/db"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// flagOut is the flag for the directory the bundle is written to.
+	flagOut = "out"
+	// flagAssumeValid is the flag for recording every payload as valid
+	// instead of querying the execution client.
+	flagAssumeValid = "assume-valid"
+)
+
+// captureEngineClient is the execution client payload statuses are
+// captured from.
+type captureEngineClient = client.EngineClient[
+	*types.ExecutionPayload,
+	*engineprimitives.PayloadAttributes[*engineprimitives.Withdrawal],
+]
+
+// NewCaptureCommand creates a new command that captures a replay bundle.
+func NewCaptureCommand(chainSpec common.ChainSpec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "capture",
+		Short: "Captures a range of stored blocks as a replay bundle",
+		Long: `Writes the beacon state preceding the given range, the stored
+blocks of the range and the chain spec to a compact replay bundle that can
+be replayed offline with 'debug replay-bundle'. The status of every
+execution payload is queried from the configured execution client, which
+must have the payloads of the range, unless --assume-valid is set. The node
+must be stopped and must not have pruned the state preceding the range.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			from, err := cmd.Flags().GetUint64(flagFrom)
+			if err != nil {
+				return err
+			}
+			to, err := cmd.Flags().GetUint64(flagTo)
+			if err != nil {
+				return err
+			}
+			if from < minReplaySlot || from > to {
+				return errors.Wrapf(
+					ErrInvalidReplayRange,
+					"from %d to %d, from must be at least %d and at most to",
+					from, to, minReplaySlot,
+				)
+			}
+			out, err := cmd.Flags().GetString(flagOut)
+			if err != nil {
+				return err
+			}
+			assumeValid, err := cmd.Flags().GetBool(flagAssumeValid)
+			if err != nil {
+				return err
+			}
+
+			var ec *captureEngineClient
+			if !assumeValid {
+				if ec, err = newCaptureEngineClient(cmd, chainSpec); err != nil {
+					return err
+				}
+			}
+			b, err := captureBundle(
+				cmd, chainSpec, ec, math.Slot(from), math.Slot(to),
+			)
+			if err != nil {
+				return err
+			}
+			if err = WriteBundle(out, b); err != nil {
+				return err
+			}
+
+			cmd.Printf(
+				"captured %d blocks on top of slot %d to %s\n",
+				len(b.Blocks), b.Manifest.PreStateSlot, out,
+			)
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(flagFrom, 0, "first slot to capture")
+	cmd.Flags().Uint64(flagTo, 0, "last slot to capture")
+	cmd.Flags().String(flagOut, "", "directory the bundle is written to")
+	cmd.Flags().Bool(
+		flagAssumeValid, false,
+		"record every payload as valid instead of querying the "+
+			"execution client",
+	)
+	_ = cmd.MarkFlagRequired(flagFrom)
+	_ = cmd.MarkFlagRequired(flagTo)
+	_ = cmd.MarkFlagRequired(flagOut)
+
+	return cmd
+}
+
+// newCaptureEngineClient connects to the execution client configured for
+// the node, waiting for it to be reachable.
+func newCaptureEngineClient(
+	cmd *cobra.Command,
+	chainSpec common.ChainSpec,
+) (*captureEngineClient, error) {
+	cfg, err := config.ReadConfigFromAppOpts(context.GetViperFromCmd(cmd))
+	if err != nil {
+		return nil, err
+	}
+	secret, err := components.LoadJWTFromFile(cfg.Engine.JWTSecretPath)
+	if err != nil {
+		return nil, err
+	}
+
+	ec := client.New[
+		*types.ExecutionPayload,
+		*engineprimitives.PayloadAttributes[*engineprimitives.Withdrawal],
+	](
+		cfg.GetEngine(),
+		noop.NewLogger[log.Logger](),
+		secret,
+		nodemetrics.NewNoOpTelemetrySink(),
+		new(big.Int).SetUint64(chainSpec.DepositEth1ChainID()),
+		nil,
+	)
+	if err = ec.Start(cmd.Context()); err != nil {
+		return nil, err
+	}
+	return ec, nil
+}
+
+// captureBundle reads the state preceding the given slot range and the
+// blocks of the range from the node databases. The payload statuses are
+// queried from the given execution client, or assumed valid if it is nil.
+func captureBundle(
+	cmd *cobra.Command,
+	chainSpec common.ChainSpec,
+	ec *captureEngineClient,
+	from, to math.Slot,
+) (*Bundle, error) {
+	cfg := context.GetConfigFromCmd(cmd)
+	appDB, err := storagedb.OpenDB(cfg.RootDir, dbm.PebbleDBBackend)
+	if err != nil {
+		return nil, err
+	}
+	defer appDB.Close()
+	preState, preStateBranch, err := db.ExportState(
+		appDB, chainSpec, from-1,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	blockStore, err := openBlockStore(cmd)
+	if err != nil {
+		return nil, err
+	}
+	defer blockStore.Close()
+
+	b := NewBundle(chainSpec, preState, preStateBranch)
+	for slot := from; slot <= to; slot++ {
+		//#nosec:G115 // slots fit in int64.
+		cmtBlk, bz, loadErr := loadBlock(blockStore, int64(slot.Unwrap()))
+		if loadErr != nil {
+			return nil, loadErr
+		}
+		var blk *types.BeaconBlock
+		if blk, err = decodeBlock(chainSpec, slot, bz); err != nil {
+			return nil, errors.Wrapf(
+				err, "failed to decode beacon block at height %d", slot,
+			)
+		}
+
+		status := engineprimitives.PayloadStatusValid
+		if ec != nil {
+			if status, err = payloadStatus(cmd, ec, blk); err != nil {
+				return nil, errors.Wrapf(err, "slot %d", slot)
+			}
+		}
+		b.AddBlock(
+			blk,
+			cmtBlk.ProposerAddress,
+			//#nosec:G115 // block times are after the epoch.
+			math.U64(cmtBlk.Time.Unix()),
+			status,
+		)
+	}
+	return b, nil
+}
+
+// payloadStatus sends the payload of the block to the execution client and
+// returns the status it responds with.
+func payloadStatus(
+	cmd *cobra.Command,
+	ec *captureEngineClient,
+	blk *types.BeaconBlock,
+) (engineprimitives.PayloadStatusStr, error) {
+	body := blk.GetBody()
+	parentBlockRoot := blk.GetParentBlockRoot()
+	status, err := ec.Client.NewPayload(
+		cmd.Context(),
+		body.GetExecutionPayload(),
+		body.GetBlobKzgCommitments().ToVersionedHashes(),
+		&parentBlockRoot,
+	)
+	if err != nil {
+		return "", err
+	}
+	if status == nil {
+		return "", engineerrors.ErrNilPayloadStatus
+	}
+	return status.Status, nil
+}
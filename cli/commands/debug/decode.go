@@ -0,0 +1,319 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package debug
+
+import (
+	"encoding/binary"
+	"os"
+
+	"github.com/berachain/beacon-kit/cli/commands/db"
+	"github.com/berachain/beacon-kit/cli/context"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/consensus/cometbft/service/encoding"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	cmtstore "github.com/cometbft/cometbft/store"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// flagHeight is the flag for reading a block from the consensus DB.
+	flagHeight = "height"
+	// flagJSON is the flag for printing the full object as JSON.
+	flagJSON = "json"
+
+	// blockStoreDBName is the name of the CometBFT block store DB.
+	blockStoreDBName = "blockstore"
+	// beaconBlockTxIndex is the index of the beacon block in the block txs.
+	beaconBlockTxIndex = 0
+	// blockSlotSize is the size of the slot, the first field of an SSZ
+	// encoded beacon block.
+	blockSlotSize = 8
+)
+
+var (
+	// ErrBlockNotFound is returned when the consensus DB has no block at
+	// the requested height.
+	ErrBlockNotFound = errors.New("block not found")
+	// ErrNoBeaconBlock is returned when a consensus block does not carry a
+	// beacon block.
+	ErrNoBeaconBlock = errors.New("no beacon block in block")
+)
+
+// DecodedBlock is a decoded beacon block together with its roots.
+type DecodedBlock struct {
+	Version   string             `json:"version"`
+	BlockRoot common.Root        `json:"block_root"`
+	BodyRoot  common.Root        `json:"body_root"`
+	Block     *types.BeaconBlock `json:"block"`
+}
+
+// DecodedState is a decoded beacon state together with its root.
+type DecodedState struct {
+	Version   string          `json:"version"`
+	StateRoot common.Root     `json:"state_root"`
+	State     *db.BeaconState `json:"state"`
+}
+
+// NewDecodeBlockCommand creates a new command that decodes a beacon block.
+func NewDecodeBlockCommand(chainSpec common.ChainSpec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "decode-block [block.ssz]",
+		Short: "Decodes and prints an SSZ encoded beacon block",
+		Long: `Decodes an SSZ encoded beacon block read from the given file,
+or from the consensus DB if the height flag is set, and prints it with its
+fork version and roots.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			bz, err := readBlockBytes(cmd, args)
+			if err != nil {
+				return err
+			}
+
+			decoded, err := DecodeBlock(chainSpec, bz)
+			if err != nil {
+				return err
+			}
+			return printDecoded(cmd, decoded, func() {
+				blk := decoded.Block
+				cmd.Printf("version:        %s\n", decoded.Version)
+				cmd.Printf("slot:           %d\n", blk.GetSlot())
+				cmd.Printf("proposer index: %d\n", blk.GetProposerIndex())
+				cmd.Printf("block root:     %s\n", decoded.BlockRoot)
+				cmd.Printf("parent root:    %s\n", blk.GetParentBlockRoot())
+				cmd.Printf("state root:     %s\n", blk.GetStateRoot())
+				cmd.Printf("body root:      %s\n", decoded.BodyRoot)
+				cmd.Printf(
+					"deposits:       %d\n", len(blk.GetBody().GetDeposits()),
+				)
+				payload := blk.GetBody().GetExecutionPayload()
+				cmd.Printf("payload number: %d\n", payload.GetNumber())
+				cmd.Printf("payload hash:   %s\n", payload.GetBlockHash())
+			})
+		},
+	}
+
+	cmd.Flags().Int64(
+		flagHeight, 0, "read the block at this height from the consensus DB",
+	)
+	cmd.Flags().Bool(flagJSON, false, "print the full block as JSON")
+
+	return cmd
+}
+
+// NewDecodeStateCommand creates a new command that decodes a beacon state.
+func NewDecodeStateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "decode-state [state.ssz]",
+		Short: "Decodes and prints an SSZ encoded beacon state",
+		Long: `Decodes an SSZ encoded beacon state read from the given file and
+prints it with its fork version and root.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			decoded, err := DecodeState(bz)
+			if err != nil {
+				return err
+			}
+			return printDecoded(cmd, decoded, func() {
+				st := decoded.State
+				cmd.Printf("version:                 %s\n", decoded.Version)
+				cmd.Printf("slot:                    %d\n", st.Slot)
+				cmd.Printf("state root:              %s\n", decoded.StateRoot)
+				cmd.Printf(
+					"genesis validators root: %s\n", st.GenesisValidatorsRoot,
+				)
+				cmd.Printf(
+					"latest block root:       %s\n",
+					st.LatestBlockHeader.HashTreeRoot(),
+				)
+				cmd.Printf("validators:              %d\n", len(st.Validators))
+				cmd.Printf("eth1 deposit index:      %d\n", st.Eth1DepositIndex)
+				cmd.Printf(
+					"latest payload hash:     %s\n",
+					st.LatestExecutionPayloadHeader.GetBlockHash(),
+				)
+			})
+		},
+	}
+
+	cmd.Flags().Bool(flagJSON, false, "print the full state as JSON")
+
+	return cmd
+}
+
+// DecodeBlock decodes the SSZ encoded beacon block. The fork version is
+// detected from the block slot, its first field.
+func DecodeBlock(
+	chainSpec common.ChainSpec,
+	bz []byte,
+) (*DecodedBlock, error) {
+	var slot math.Slot
+	if len(bz) >= blockSlotSize {
+		slot = math.Slot(binary.LittleEndian.Uint64(bz))
+	}
+	blk, err := decodeBlock(chainSpec, slot, bz)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode beacon block")
+	}
+
+	return &DecodedBlock{
+		Version: version.Name(
+			chainSpec.ActiveForkVersionForSlot(blk.GetSlot()),
+		),
+		BlockRoot: blk.HashTreeRoot(),
+		BodyRoot:  blk.GetBody().HashTreeRoot(),
+		Block:     blk,
+	}, nil
+}
+
+// decodeBlock decodes the SSZ encoded beacon block of the given slot, in the
+// layout of the fork active at the slot.
+func decodeBlock(
+	chainSpec common.ChainSpec,
+	slot math.Slot,
+	bz []byte,
+) (*types.BeaconBlock, error) {
+	return (&types.BeaconBlock{}).NewFromSSZ(
+		bz, chainSpec.ActiveForkVersionForSlot(slot),
+	)
+}
+
+// DecodeState decodes the SSZ encoded beacon state. The fork version is
+// read from the state fork.
+func DecodeState(bz []byte) (*DecodedState, error) {
+	st := &db.BeaconState{}
+	if err := st.UnmarshalSSZ(bz); err != nil {
+		return nil, errors.Wrap(err, "failed to decode beacon state")
+	}
+
+	return &DecodedState{
+		Version: version.Name(
+			version.ToUint32(st.Fork.CurrentVersion),
+		),
+		StateRoot: st.HashTreeRoot(),
+		State:     st,
+	}, nil
+}
+
+// readBlockBytes reads the SSZ encoded block from the file argument or,
+// if the height flag is set, from the consensus DB, stripping its wire
+// envelope if any.
+func readBlockBytes(cmd *cobra.Command, args []string) ([]byte, error) {
+	height, err := cmd.Flags().GetInt64(flagHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case height > 0 && len(args) == 0:
+		return readBlockFromDB(cmd, height)
+	case height == 0 && len(args) == 1:
+		bz, rErr := os.ReadFile(args[0])
+		if rErr != nil {
+			return nil, rErr
+		}
+		_, body, rErr := encoding.Unwrap(bz)
+		return body, rErr
+	default:
+		return nil, errors.New("expected either a block file or a height")
+	}
+}
+
+// readBlockFromDB reads the beacon block at the given height from the
+// CometBFT block store.
+func readBlockFromDB(cmd *cobra.Command, height int64) ([]byte, error) {
+	blockStore, err := openBlockStore(cmd)
+	if err != nil {
+		return nil, err
+	}
+	defer blockStore.Close()
+
+	_, bz, err := loadBlock(blockStore, height)
+	return bz, err
+}
+
+// openBlockStore opens the CometBFT block store of the node.
+func openBlockStore(cmd *cobra.Command) (*cmtstore.BlockStore, error) {
+	cfg := context.GetConfigFromCmd(cmd)
+	blockDB, err := cmtcfg.DefaultDBProvider(
+		&cmtcfg.DBContext{ID: blockStoreDBName, Config: cfg},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return cmtstore.NewBlockStore(blockDB), nil
+}
+
+// loadBlock loads the CometBFT block at the given height together with the
+// SSZ encoded beacon block it carries, stripped of its wire envelope.
+func loadBlock(
+	blockStore *cmtstore.BlockStore,
+	height int64,
+) (*cmttypes.Block, []byte, error) {
+	blk, _ := blockStore.LoadBlock(height)
+	if blk == nil {
+		return nil, nil, errors.Wrapf(ErrBlockNotFound, "height %d", height)
+	}
+	if len(blk.Txs) <= beaconBlockTxIndex {
+		return nil, nil, errors.Wrapf(
+			ErrNoBeaconBlock, "height %d", height,
+		)
+	}
+	_, body, err := encoding.Unwrap(blk.Txs[beaconBlockTxIndex])
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "height %d", height)
+	}
+	return blk, body, nil
+}
+
+// printDecoded prints the decoded object as JSON if the JSON flag is set,
+// and as a summary otherwise.
+func printDecoded(
+	cmd *cobra.Command,
+	decoded any,
+	printSummary func(),
+) error {
+	asJSON, err := cmd.Flags().GetBool(flagJSON)
+	if err != nil {
+		return err
+	}
+	if !asJSON {
+		printSummary()
+		return nil
+	}
+
+	bz, err := json.MarshalIndent(decoded, "", "  ")
+	if err != nil {
+		return err
+	}
+	cmd.Printf("%s\n", bz)
+	return nil
+}
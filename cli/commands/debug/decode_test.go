@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package debug_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/cli/commands/debug"
+	"github.com/berachain/beacon-kit/config/spec"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeBlockCommand(t *testing.T) {
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+
+	blk, err := (&types.BeaconBlock{}).NewWithVersion(
+		7, 3, common.Root{0x01}, version.Deneb,
+	)
+	require.NoError(t, err)
+	blk.Body.ExecutionPayload = &types.ExecutionPayload{}
+	bz, err := blk.MarshalSSZ()
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "block.ssz")
+	require.NoError(t, os.WriteFile(path, bz, 0o600))
+
+	out := new(bytes.Buffer)
+	cmd := debug.NewDecodeBlockCommand(cs)
+	cmd.SetOut(out)
+	cmd.SetArgs([]string{path, "--json"})
+	require.NoError(t, cmd.Execute())
+
+	var decoded debug.DecodedBlock
+	require.NoError(t, json.Unmarshal(out.Bytes(), &decoded))
+	require.Equal(t, version.Name(version.Deneb), decoded.Version)
+	require.Equal(t, blk.HashTreeRoot(), decoded.BlockRoot)
+	require.Equal(t, blk.GetSlot(), decoded.Block.GetSlot())
+	require.Equal(
+		t, blk.GetParentBlockRoot(), decoded.Block.GetParentBlockRoot(),
+	)
+}
+
+func TestDecodeStateInvalid(t *testing.T) {
+	_, err := debug.DecodeState([]byte{0x01, 0x02})
+	require.Error(t, err)
+}
+
+func TestReplayInvalidRange(t *testing.T) {
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+
+	for _, args := range [][]string{
+		{"--from", "1", "--to", "5"},
+		{"--from", "6", "--to", "5"},
+	} {
+		cmd := debug.NewReplayCommand(cs)
+		cmd.SetOut(new(bytes.Buffer))
+		cmd.SetArgs(args)
+		require.ErrorIs(t, cmd.Execute(), debug.ErrInvalidReplayRange)
+	}
+}
+
+func TestReplayTimings(t *testing.T) {
+	total := debug.ReplayTimings{Load: time.Second}
+	total.Add(debug.ReplayTimings{
+		Load: time.Second, Slots: 2 * time.Second, StateRoot: time.Minute,
+	})
+	require.Equal(t, debug.ReplayTimings{
+		Load: 2 * time.Second, Slots: 2 * time.Second, StateRoot: time.Minute,
+	}, total)
+	require.Equal(t, "load=2s slots=2s block=0s root=1m0s", total.String())
+}
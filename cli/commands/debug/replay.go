@@ -0,0 +1,431 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package debug
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	sdklog "cosmossdk.io/log"
+	storev2 "cosmossdk.io/store/v2/db"
+	"github.com/berachain/beacon-kit/cli/commands/db"
+	"github.com/berachain/beacon-kit/cli/context"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/node-core/components/signer"
+	"github.com/berachain/beacon-kit/node-core/components/storage"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/transition"
+	"github.com/berachain/beacon-kit/state-transition/core"
+	storagedb "github.com/berachain/beacon-kit/storage/db"
+	depositstore "github.com/berachain/beacon-kit/storage/deposit"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// flagFrom is the flag for the first slot to replay.
+	flagFrom = "from"
+	// flagTo is the flag for the last slot to replay.
+	flagTo = "to"
+	// flagStopOnDivergence is the flag for stopping at the first divergence.
+	flagStopOnDivergence = "stop-on-divergence"
+	// flagDumpDir is the flag for the directory divergences are dumped to.
+	flagDumpDir = "dump-dir"
+
+	// defaultDumpDir is the default directory divergences are dumped to.
+	defaultDumpDir = "replay-divergence"
+	// depositsDBName is the name of the deposit store DB.
+	depositsDBName = "deposits"
+	// blockFileName is the name of the diverging block in a dump.
+	blockFileName = "block.ssz"
+	// minReplaySlot is the first slot that can be replayed, as the app DB
+	// does not store the genesis state on its own.
+	minReplaySlot = 2
+)
+
+var (
+	// ErrInvalidReplayRange is returned when the replay range is invalid.
+	ErrInvalidReplayRange = errors.New("invalid replay range")
+	// ErrReplayDivergence is returned when a replayed state root does not
+	// match the state root of the stored block.
+	ErrReplayDivergence = errors.New("replayed state diverged from chain")
+)
+
+type replayStateProcessor = core.StateProcessor[
+	*db.StateDB, *transition.Context, *db.KVStore,
+]
+
+// ReplayTimings are the durations of the phases of replaying a block.
+type ReplayTimings struct {
+	// Load is the time spent reading and decoding the block.
+	Load time.Duration
+	// Slots is the time spent processing slots up to the block slot.
+	Slots time.Duration
+	// Block is the time spent processing the block.
+	Block time.Duration
+	// StateRoot is the time spent computing the resulting state root.
+	StateRoot time.Duration
+}
+
+// Add adds the given timings to the timings.
+func (t *ReplayTimings) Add(other ReplayTimings) {
+	t.Load += other.Load
+	t.Slots += other.Slots
+	t.Block += other.Block
+	t.StateRoot += other.StateRoot
+}
+
+// String returns the timings in a human readable form.
+func (t ReplayTimings) String() string {
+	return "load=" + t.Load.String() +
+		" slots=" + t.Slots.String() +
+		" block=" + t.Block.String() +
+		" root=" + t.StateRoot.String()
+}
+
+// NewReplayCommand creates a new command that replays stored blocks.
+func NewReplayCommand(chainSpec common.ChainSpec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay",
+		Short: "Replays a range of stored blocks through the state transition",
+		Long: `Loads the beacon state preceding the given range from the
+application DB and replays the stored blocks of the range through a fresh
+state processor, checking the resulting state root of every block against
+the one it commits to and timing every phase of the transition. Execution
+payloads are not sent to the execution client. Nothing is written back to
+the node databases, but the node must be stopped and must not have pruned
+the state preceding the range.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			from, err := cmd.Flags().GetUint64(flagFrom)
+			if err != nil {
+				return err
+			}
+			to, err := cmd.Flags().GetUint64(flagTo)
+			if err != nil {
+				return err
+			}
+			if from < minReplaySlot || from > to {
+				return errors.Wrapf(
+					ErrInvalidReplayRange,
+					"from %d to %d, from must be at least %d and at most to",
+					from, to, minReplaySlot,
+				)
+			}
+			stopOnDivergence, err := cmd.Flags().GetBool(
+				flagStopOnDivergence,
+			)
+			if err != nil {
+				return err
+			}
+			dumpDir, err := cmd.Flags().GetString(flagDumpDir)
+			if err != nil {
+				return err
+			}
+
+			r, err := newReplayer(cmd, chainSpec, math.Slot(from-1))
+			if err != nil {
+				return err
+			}
+			defer r.close()
+
+			r.stopOnDivergence, r.dumpDir = stopOnDivergence, dumpDir
+			return r.replay(cmd, math.Slot(from), math.Slot(to))
+		},
+	}
+
+	cmd.Flags().Uint64(flagFrom, 0, "first slot to replay")
+	cmd.Flags().Uint64(flagTo, 0, "last slot to replay")
+	cmd.Flags().Bool(
+		flagStopOnDivergence, false,
+		"stop at the first divergence and dump the diverged state",
+	)
+	cmd.Flags().String(
+		flagDumpDir, defaultDumpDir,
+		"directory the diverged state and block are dumped to",
+	)
+	_ = cmd.MarkFlagRequired(flagFrom)
+	_ = cmd.MarkFlagRequired(flagTo)
+
+	return cmd
+}
+
+// replayer replays stored blocks on top of a state loaded from the node.
+type replayer struct {
+	chainSpec common.ChainSpec
+	st        *db.StateDB
+	sp        *replayStateProcessor
+	deposits  *depositstore.KVStore[*types.Deposit]
+	loadBlock func(height int64) (*types.BeaconBlock, *transition.Context,
+		[]byte, error)
+	closers []func() error
+
+	// stopOnDivergence stops the replay at the first divergence and dumps
+	// the diverged state and block to dumpDir.
+	stopOnDivergence bool
+	dumpDir          string
+}
+
+// newReplayer opens the node databases and loads the state at the given
+// slot.
+func newReplayer(
+	cmd *cobra.Command,
+	chainSpec common.ChainSpec,
+	slot math.Slot,
+) (*replayer, error) {
+	r := &replayer{chainSpec: chainSpec}
+	cfg := context.GetConfigFromCmd(cmd)
+
+	appDB, err := storagedb.OpenDB(cfg.RootDir, dbm.PebbleDBBackend)
+	if err != nil {
+		return nil, err
+	}
+	r.closers = append(r.closers, appDB.Close)
+	if r.st, err = db.LoadState(appDB, chainSpec, slot); err != nil {
+		r.close()
+		return nil, err
+	}
+
+	if r.deposits, err = loadIncludedDeposits(cfg.RootDir, r.st); err != nil {
+		r.close()
+		return nil, err
+	}
+
+	blockStore, err := openBlockStore(cmd)
+	if err != nil {
+		r.close()
+		return nil, err
+	}
+	r.closers = append(r.closers, blockStore.Close)
+	r.loadBlock = func(height int64) (
+		*types.BeaconBlock, *transition.Context, []byte, error,
+	) {
+		cmtBlk, bz, loadErr := loadBlock(blockStore, height)
+		if loadErr != nil {
+			return nil, nil, nil, loadErr
+		}
+		//#nosec:G115 // heights are positive.
+		blk, loadErr := decodeBlock(chainSpec, math.Slot(height), bz)
+		if loadErr != nil {
+			return nil, nil, nil, errors.Wrapf(
+				loadErr, "failed to decode beacon block at height %d", height,
+			)
+		}
+		return blk, &transition.Context{
+			Context: cmd.Context(),
+			// Payloads are not sent to the execution client.
+			OptimisticEngine:        true,
+			SkipPayloadVerification: true,
+			// The state root is checked by the replayer itself.
+			SkipValidateResult: true,
+			ProposerAddress:    cmtBlk.ProposerAddress,
+			//#nosec:G115 // block times are after the epoch.
+			ConsensusTime: math.U64(cmtBlk.Time.Unix()),
+		}, bz, nil
+	}
+
+	r.sp, err = newStateProcessor(chainSpec, nil, r.deposits)
+	if err != nil {
+		r.close()
+		return nil, err
+	}
+	return r, nil
+}
+
+// loadIncludedDeposits returns an in-memory deposit store holding the
+// deposits of the node deposit store that the given state already includes.
+// The node deposit store also holds the deposits included after the state,
+// which the blocks preceding their inclusion would otherwise have to carry.
+func loadIncludedDeposits(
+	rootDir string,
+	st *db.StateDB,
+) (*depositstore.KVStore[*types.Deposit], error) {
+	depositIndex, err := st.GetEth1DepositIndex()
+	if err != nil {
+		return nil, err
+	}
+
+	depositsDB, err := storev2.NewDB(
+		storev2.DBTypePebbleDB, depositsDBName,
+		filepath.Join(rootDir, "data"), nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer depositsDB.Close()
+	included, err := depositstore.NewStore[*types.Deposit](
+		storage.NewKVStoreProvider(depositsDB), sdklog.NewNopLogger(),
+	).GetDepositsByIndex(0, depositIndex+1)
+	if err != nil {
+		return nil, err
+	}
+
+	deposits := depositstore.NewStore[*types.Deposit](
+		storage.NewKVStoreProvider(storev2.NewMemDB()),
+		sdklog.NewNopLogger(),
+	)
+	if err = deposits.EnqueueDeposits(included); err != nil {
+		return nil, err
+	}
+	return deposits, nil
+}
+
+// newStateProcessor returns a state processor replaying blocks with the
+// given execution engine and deposit store. A nil engine may only be used
+// when payload verification is skipped.
+func newStateProcessor(
+	chainSpec common.ChainSpec,
+	executionEngine core.ExecutionEngine[
+		*types.ExecutionPayload,
+		*types.ExecutionPayloadHeader,
+		engineprimitives.Withdrawals,
+	],
+	depositStore *depositstore.KVStore[*types.Deposit],
+) (*replayStateProcessor, error) {
+	return core.NewStateProcessor[
+		*db.StateDB, *transition.Context, *db.KVStore,
+	](
+		core.WithForkSchedule(chainSpec),
+		core.WithExecutionEngine(executionEngine),
+		core.WithDepositStore(depositStore),
+		core.WithSigner(signer.BLSSigner{}),
+	)
+}
+
+// replay replays the blocks of the given slot range, printing the outcome
+// and timings of every block. A block that fails to process always stops
+// the replay.
+func (r *replayer) replay(cmd *cobra.Command, from, to math.Slot) error {
+	var (
+		total       ReplayTimings
+		divergences int
+	)
+	for slot := from; slot <= to; slot++ {
+		var timings ReplayTimings
+		start := time.Now()
+		//#nosec:G115 // slots fit in int64.
+		blk, ctx, bz, err := r.loadBlock(int64(slot.Unwrap()))
+		if err != nil {
+			return err
+		}
+		timings.Load = time.Since(start)
+
+		// The deposits of a block were in the deposit store of the node
+		// when it was finalized.
+		if err = r.deposits.EnqueueDeposits(
+			blk.GetBody().GetDeposits(),
+		); err != nil {
+			return err
+		}
+
+		start = time.Now()
+		if _, err = r.sp.ProcessSlots(r.st, blk.GetSlot()); err != nil {
+			return r.diverged(cmd, slot, bz, err)
+		}
+		timings.Slots = time.Since(start)
+
+		start = time.Now()
+		if err = r.sp.ProcessBlock(ctx, r.st, blk); err != nil {
+			return r.diverged(cmd, slot, bz, err)
+		}
+		timings.Block = time.Since(start)
+
+		start = time.Now()
+		stateRoot := r.st.HashTreeRoot()
+		timings.StateRoot = time.Since(start)
+		total.Add(timings)
+
+		if stateRoot == blk.GetStateRoot() {
+			cmd.Printf("slot %d: ok root=%s %s\n", slot, stateRoot, timings)
+			continue
+		}
+
+		divergences++
+		err = errors.Wrapf(
+			ErrReplayDivergence, "slot %d: expected %s, got %s",
+			slot, blk.GetStateRoot(), stateRoot,
+		)
+		if r.stopOnDivergence {
+			return r.diverged(cmd, slot, bz, err)
+		}
+		cmd.Printf("slot %d: %v %s\n", slot, err, timings)
+	}
+
+	cmd.Printf(
+		"replayed %d blocks with %d divergences: %s\n",
+		to-from+1, divergences, total,
+	)
+	if divergences > 0 {
+		return errors.Wrapf(
+			ErrReplayDivergence, "%d divergences", divergences,
+		)
+	}
+	return nil
+}
+
+// diverged reports the divergence at the given slot and, if configured,
+// dumps the diverged state and the block that caused it. It returns the
+// cause of the divergence.
+func (r *replayer) diverged(
+	cmd *cobra.Command,
+	slot math.Slot,
+	blockBz []byte,
+	cause error,
+) error {
+	cmd.Printf("slot %d: %v\n", slot, cause)
+	if !r.stopOnDivergence {
+		return cause
+	}
+
+	st, err := r.st.GetMarshallable()
+	if err != nil {
+		return errors.Join(cause, err)
+	}
+	branch, err := db.ReadDepositBranch(r.st)
+	if err != nil {
+		return errors.Join(cause, err)
+	}
+	if _, err = db.WriteSnapshot(
+		r.dumpDir, r.chainSpec, st, branch,
+	); err != nil {
+		return errors.Join(cause, err)
+	}
+	//#nosec:G306 // dumps are meant to be shared.
+	if err = os.WriteFile(
+		filepath.Join(r.dumpDir, blockFileName), blockBz, 0o644,
+	); err != nil {
+		return errors.Join(cause, err)
+	}
+
+	cmd.Printf("dumped diverged state and block to %s\n", r.dumpDir)
+	return cause
+}
+
+// close closes the node databases opened by the replayer.
+func (r *replayer) close() {
+	for _, closeFn := range r.closers {
+		_ = closeFn()
+	}
+}
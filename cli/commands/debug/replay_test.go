@@ -0,0 +1,227 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+//go:build bls12381 && pebbledb
+
+package debug_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	sdklog "cosmossdk.io/log"
+	storev2 "cosmossdk.io/store/v2/db"
+	"github.com/berachain/beacon-kit/cli/commands/db"
+	"github.com/berachain/beacon-kit/cli/commands/debug"
+	clicontext "github.com/berachain/beacon-kit/cli/context"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/node-core/components/storage"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	storagedb "github.com/berachain/beacon-kit/storage/db"
+	depositstore "github.com/berachain/beacon-kit/storage/deposit"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	sm "github.com/cometbft/cometbft/state"
+	cmtstore "github.com/cometbft/cometbft/store"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+// replayBlocks is the number of blocks of the chain stored in the node
+// replayed by the replay command tests.
+const replayBlocks = 6
+
+// buildReplayChain builds a chain of replayBlocks blocks, with a deposit in
+// its third block. It returns the builder, the state after the first block
+// and its deposit branch, as the oldest state kept by a node.
+func buildReplayChain(t *testing.T) (
+	*chainBuilder, *db.BeaconState, db.DepositBranch,
+) {
+	t.Helper()
+	c := newChainBuilder(t)
+	c.next(nil)
+	st, err := c.st.GetMarshallable()
+	require.NoError(t, err)
+	branch, err := db.ReadDepositBranch(c.st)
+	require.NoError(t, err)
+
+	for i := 2; i <= replayBlocks; i++ {
+		var deposits []*types.Deposit
+		if i == 3 {
+			deposits = []*types.Deposit{{
+				Pubkey:      c.signer.PublicKey(),
+				Credentials: withdrawalCredentials(),
+				Amount:      math.Gwei(c.cs.EffectiveBalanceIncrement()),
+				Index:       1,
+			}}
+		}
+		c.next(deposits)
+	}
+	return c, st, branch
+}
+
+// newReplayHome writes the node databases of a home directory holding the
+// given blocks of the chain built by c, the deposits they include and the
+// given state in the application DB. It returns the home directory.
+func newReplayHome(
+	t *testing.T,
+	c *chainBuilder,
+	blocks []*types.BeaconBlock,
+	st *db.BeaconState,
+	branch db.DepositBranch,
+) string {
+	t.Helper()
+	home := t.TempDir()
+	cfg := cmtcfg.DefaultConfig().SetRoot(home)
+
+	appDB, err := storagedb.OpenDB(home, dbm.PebbleDBBackend)
+	require.NoError(t, err)
+	require.NoError(t, db.ImportState(appDB, c.cs, st, branch))
+	require.NoError(t, appDB.Close())
+
+	depositsDB, err := storev2.NewDB(
+		storev2.DBTypePebbleDB, "deposits", cfg.DBDir(), nil,
+	)
+	require.NoError(t, err)
+	ds := depositstore.NewStore[*types.Deposit](
+		storage.NewKVStoreProvider(depositsDB), sdklog.NewNopLogger(),
+	)
+	for _, blk := range blocks {
+		require.NoError(t, ds.EnqueueDeposits(blk.GetBody().GetDeposits()))
+	}
+	require.NoError(t, depositsDB.Close())
+
+	blockDB, err := cmtcfg.DefaultDBProvider(
+		&cmtcfg.DBContext{ID: "blockstore", Config: cfg},
+	)
+	require.NoError(t, err)
+	blockStore := cmtstore.NewBlockStore(blockDB)
+	defer blockStore.Close()
+
+	var lastBlockID cmttypes.BlockID
+	for i, blk := range blocks {
+		tx, marshalErr := blk.MarshalSSZ()
+		require.NoError(t, marshalErr)
+		//#nosec:G115 // slots and times of the test chain fit in int64.
+		height, consensusTime := int64(blk.GetSlot().Unwrap()),
+			int64(c.bundle.Manifest.Blocks[i].ConsensusTime.Unwrap())
+		block := cmttypes.MakeBlock(
+			height, cmttypes.Txs{tx}, newCommit(height-1, lastBlockID), nil,
+		)
+		block.Header.Populate(
+			sm.InitStateVersion.Consensus, "replay-test",
+			time.Unix(consensusTime, 0),
+			lastBlockID, nil, nil, nil, nil, nil, c.proposerAddress,
+		)
+		partSet, partErr := block.MakePartSet(cmttypes.BlockPartSizeBytes)
+		require.NoError(t, partErr)
+		lastBlockID = cmttypes.BlockID{
+			Hash: block.Hash(), PartSetHeader: partSet.Header(),
+		}
+		blockStore.SaveBlock(block, partSet, newCommit(height, lastBlockID))
+	}
+	return home
+}
+
+// newCommit returns a commit for the given block without signatures.
+func newCommit(height int64, blockID cmttypes.BlockID) *cmttypes.Commit {
+	return &cmttypes.Commit{
+		Height:  height,
+		BlockID: blockID,
+		Signatures: []cmttypes.CommitSig{
+			{BlockIDFlag: cmttypes.BlockIDFlagAbsent},
+		},
+	}
+}
+
+// runReplay runs the replay command on the node of the given home directory.
+func runReplay(
+	t *testing.T, cs common.ChainSpec, home string, args ...string,
+) (string, error) {
+	t.Helper()
+	v := viper.New()
+	v.Set(flags.FlagHome, home)
+	cmd := debug.NewReplayCommand(cs)
+	out := new(bytes.Buffer)
+	cmd.SetOut(out)
+	cmd.SetErr(new(bytes.Buffer))
+	cmd.SetContext(context.WithValue(
+		context.Background(), clicontext.ViperContextKey, v,
+	))
+	cmd.SetArgs(args)
+	err := cmd.Execute()
+	return out.String(), err
+}
+
+func TestReplayStoredBlocks(t *testing.T) {
+	c, st, branch := buildReplayChain(t)
+	home := newReplayHome(t, c, c.bundle.Blocks, st, branch)
+
+	out, err := runReplay(t, c.cs, home,
+		"--from", "2", "--to", fmt.Sprint(replayBlocks),
+	)
+	require.NoError(t, err)
+	// Every replayed block results in the state root it commits to.
+	for _, blk := range c.bundle.Blocks[1:] {
+		require.Contains(t, out, fmt.Sprintf(
+			"slot %d: ok root=%s", blk.GetSlot(), blk.GetStateRoot(),
+		))
+	}
+	require.Contains(t, out, fmt.Sprintf(
+		"replayed %d blocks with 0 divergences", replayBlocks-1,
+	))
+}
+
+func TestReplayStoredBlocksDivergence(t *testing.T) {
+	c, st, branch := buildReplayChain(t)
+
+	// The block of slot 4 commits to a wrong state root.
+	blocks := c.bundle.Blocks
+	expectedRoot := blocks[3].GetStateRoot()
+	blocks[3].StateRoot = common.Root{0xff}
+	home := newReplayHome(t, c, blocks, st, branch)
+
+	dumpDir := filepath.Join(t.TempDir(), "dump")
+	out, err := runReplay(t, c.cs, home,
+		"--from", "2", "--to", fmt.Sprint(replayBlocks),
+		"--stop-on-divergence", "--dump-dir", dumpDir,
+	)
+	require.ErrorIs(t, err, debug.ErrReplayDivergence)
+	require.ErrorContains(t, err, "slot 4")
+	require.NotContains(t, out, "slot 5")
+
+	// The dump holds the block and the state it actually results in.
+	blockBz, err := os.ReadFile(filepath.Join(dumpDir, "block.ssz"))
+	require.NoError(t, err)
+	tx, err := blocks[3].MarshalSSZ()
+	require.NoError(t, err)
+	require.Equal(t, tx, blockBz)
+	dumped, _, err := db.ReadSnapshot(dumpDir, c.cs)
+	require.NoError(t, err)
+	require.Equal(t, expectedRoot, dumped.HashTreeRoot())
+}
@@ -0,0 +1,295 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"math/big"
+	"os"
+
+	"github.com/berachain/beacon-kit/cli/utils/parser"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	gethprimitives "github.com/berachain/beacon-kit/geth-primitives"
+	"github.com/berachain/beacon-kit/geth-primitives/bind"
+	gethcrypto "github.com/berachain/beacon-kit/geth-primitives/crypto"
+	"github.com/berachain/beacon-kit/geth-primitives/deposit"
+	"github.com/berachain/beacon-kit/geth-primitives/ethclient"
+	"github.com/berachain/beacon-kit/node-core/components/signer"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/encoding/hex"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+	"github.com/spf13/cobra"
+)
+
+// depositDataFilePerm is the permission of written deposit data files.
+const depositDataFilePerm = 0o600
+
+// NewCreateDeposit creates a new command to create and sign a deposit.
+func NewCreateDeposit(chainSpec common.ChainSpec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Creates and signs a deposit",
+		Long: `Creates and signs a deposit for the validator key and outputs
+		the calldata for the deposit contract. The arguments are expected in
+		the order of withdrawal credentials, deposit amount, current version,
+		and genesis validator root. If the output flag is set, the deposit
+		data is written to the given file. If the broadcast flag is set, the
+		deposit is sent through the execution client and a private key must
+		be provided to sign the transaction.`,
+		Args: cobra.ExactArgs(4), //nolint:mnd // The number of arguments.
+		RunE: createDepositCmd(chainSpec),
+	}
+
+	cmd.Flags().String(privateKey, defaultPrivateKey, privateKeyMsg)
+	cmd.Flags().BoolP(
+		overrideNodeKey, overrideNodeKeyShorthand,
+		defaultOverrideNodeKey, overrideNodeKeyMsg,
+	)
+	cmd.Flags().
+		String(valPrivateKey, defaultValidatorPrivateKey, valPrivateKeyMsg)
+	cmd.Flags().String(operator, defaultOperator, operatorMsg)
+	cmd.Flags().String(output, defaultOutput, outputMsg)
+	cmd.Flags().Bool(broadcast, defaultBroadcast, broadcastMsg)
+	cmd.Flags().String(rpcURL, defaultRPCURL, rpcURLMsg)
+
+	return cmd
+}
+
+// createDepositCmd returns a command that creates and signs a deposit.
+func createDepositCmd(
+	chainSpec common.ChainSpec,
+) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		blsSigner, err := getBLSSigner(cmd)
+		if err != nil {
+			return err
+		}
+
+		credentials, err := parser.ConvertWithdrawalCredentials(args[0])
+		if err != nil {
+			return err
+		}
+
+		amount, err := parser.ConvertAmount(args[1])
+		if err != nil {
+			return err
+		}
+
+		currentVersion, err := parser.ConvertVersion(args[2])
+		if err != nil {
+			return err
+		}
+
+		genesisValidatorRoot, err := parser.ConvertGenesisValidatorRoot(args[3])
+		if err != nil {
+			return err
+		}
+
+		operatorAddr, err := getOperator(cmd)
+		if err != nil {
+			return err
+		}
+
+		forkData := types.NewForkData(currentVersion, genesisValidatorRoot)
+		depositMsg, signature, err := types.CreateAndSignDepositMessage(
+			forkData,
+			chainSpec.DomainTypeDeposit(),
+			blsSigner,
+			credentials,
+			amount,
+		)
+		if err != nil {
+			return err
+		}
+
+		if err = depositMsg.VerifyCreateValidator(
+			forkData,
+			signature,
+			chainSpec.DomainTypeDeposit(),
+			signer.BLSSigner{}.VerifySignature,
+		); err != nil {
+			return err
+		}
+
+		depositData := types.NewDeposit(
+			depositMsg.Pubkey, depositMsg.Credentials, depositMsg.Amount,
+			signature, 0,
+		)
+		calldata, err := depositCalldata(depositData, operatorAddr)
+		if err != nil {
+			return err
+		}
+
+		cmd.Printf("deposit contract: %s\n", chainSpec.DepositContractAddress())
+		cmd.Printf("value (wei): %s\n", depositData.Amount.ToWei().ToBig())
+		cmd.Printf("calldata: %s\n", hex.EncodeBytes(calldata))
+
+		if err = writeDepositData(cmd, depositData); err != nil {
+			return err
+		}
+
+		return broadcastDeposit(
+			cmd, chainSpec, depositData, operatorAddr,
+		)
+	}
+}
+
+// getOperator returns the operator address from the operator flag.
+func getOperator(cmd *cobra.Command) (common.ExecutionAddress, error) {
+	operatorStr, err := cmd.Flags().GetString(operator)
+	if err != nil || operatorStr == "" {
+		return common.ExecutionAddress{}, err
+	}
+	return parser.ConvertWithdrawalAddress(operatorStr)
+}
+
+// depositCalldata returns the calldata of the deposit contract call for the
+// given deposit.
+func depositCalldata(
+	depositData *types.Deposit,
+	operatorAddr common.ExecutionAddress,
+) ([]byte, error) {
+	contractABI, err := deposit.DepositContractMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return contractABI.Pack(
+		"deposit",
+		depositData.Pubkey[:],
+		depositData.Credentials[:],
+		depositData.Signature[:],
+		gethprimitives.ExecutionAddress(operatorAddr),
+	)
+}
+
+// writeDepositData writes the deposit data to the file given by the output
+// flag, if any.
+func writeDepositData(cmd *cobra.Command, depositData *types.Deposit) error {
+	outputPath, err := cmd.Flags().GetString(output)
+	if err != nil || outputPath == "" {
+		return err
+	}
+
+	bz, err := json.MarshalIndent(depositData, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err = os.WriteFile(outputPath, bz, depositDataFilePerm); err != nil {
+		return errors.Wrap(err, "failed to write deposit data")
+	}
+
+	cmd.Printf("deposit data written to %s\n", outputPath)
+	return nil
+}
+
+// broadcastDeposit sends the deposit to the deposit contract through the
+// execution client if the broadcast flag is set.
+func broadcastDeposit(
+	cmd *cobra.Command,
+	chainSpec common.ChainSpec,
+	depositData *types.Deposit,
+	operatorAddr common.ExecutionAddress,
+) error {
+	shouldBroadcast, err := cmd.Flags().GetBool(broadcast)
+	if err != nil || !shouldBroadcast {
+		return err
+	}
+
+	privKeyStr, err := cmd.Flags().GetString(privateKey)
+	if err != nil {
+		return err
+	}
+	if privKeyStr == "" {
+		return ErrPrivateKeyRequired
+	}
+	privKey, err := gethcrypto.HexToECDSA(privKeyStr)
+	if err != nil {
+		return err
+	}
+
+	url, err := cmd.Flags().GetString(rpcURL)
+	if err != nil {
+		return err
+	}
+	client, err := ethclient.Dial(url)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	// The transaction is signed for the deposit chain of the chain spec, so
+	// refuse to broadcast it to an execution client of another chain.
+	ctx := cmd.Context()
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		return err
+	}
+	expectedChainID := new(big.Int).SetUint64(chainSpec.DepositEth1ChainID())
+	if chainID.Cmp(expectedChainID) != 0 {
+		return errors.Wrapf(
+			ErrChainIDMismatch, "execution client is on chain %s, expected %s",
+			chainID, expectedChainID,
+		)
+	}
+
+	opts, err := bind.NewKeyedTransactorWithChainID(privKey, expectedChainID)
+	if err != nil {
+		return err
+	}
+	opts.Context = ctx
+	opts.Value = depositData.Amount.ToWei().ToBig()
+
+	contract, err := deposit.NewDepositContractTransactor(
+		gethprimitives.ExecutionAddress(chainSpec.DepositContractAddress()),
+		client,
+	)
+	if err != nil {
+		return err
+	}
+
+	tx, err := contract.Deposit(
+		opts,
+		depositData.Pubkey[:],
+		depositData.Credentials[:],
+		depositData.Signature[:],
+		gethprimitives.ExecutionAddress(operatorAddr),
+	)
+	if err != nil {
+		return err
+	}
+
+	receipt, err := bind.WaitMined(ctx, client, tx)
+	if err != nil {
+		return err
+	}
+	if receipt == nil {
+		return ErrDepositReceiptEmpty
+	}
+	if receipt.Status != gethprimitives.ReceiptStatusSuccessful {
+		return errors.Wrapf(ErrDepositReverted, "tx %s", tx.Hash())
+	}
+
+	cmd.Printf(
+		"deposit included in block %s by tx %s\n",
+		receipt.BlockNumber, tx.Hash(),
+	)
+	return nil
+}
@@ -0,0 +1,176 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/berachain/beacon-kit/cli/commands/deposit"
+	"github.com/berachain/beacon-kit/config/spec"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+	"github.com/berachain/beacon-kit/primitives/math"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	coretypes "github.com/ethereum/go-ethereum/core/types"
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+// depositRPC serves the JSON-RPC API of an execution client on the given
+// chain, which mines every transaction sent to it in a receipt with the given
+// status. It returns the server and the number of transactions sent to it.
+func depositRPC(
+	t *testing.T, chainID uint64, status uint64,
+) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	sent := new(atomic.Int32)
+	var txHash atomic.Value
+
+	srv := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			body, readErr := io.ReadAll(r.Body)
+			require.NoError(t, readErr)
+			var req struct {
+				ID     json.RawMessage   `json:"id"`
+				Method string            `json:"method"`
+				Params []json.RawMessage `json:"params"`
+			}
+			require.NoError(t, json.Unmarshal(body, &req))
+
+			var result any
+			switch req.Method {
+			case "eth_chainId":
+				result = hexutil.Uint64(chainID)
+			case "eth_getBlockByNumber":
+				result = &coretypes.Header{
+					Number:     big.NewInt(6),
+					Difficulty: big.NewInt(0),
+					BaseFee:    big.NewInt(7),
+				}
+			case "eth_maxPriorityFeePerGas", "eth_gasPrice":
+				result = hexutil.Uint64(1)
+			case "eth_getTransactionCount":
+				result = hexutil.Uint64(0)
+			case "eth_getCode":
+				result = hexutil.Bytes{0x60}
+			case "eth_estimateGas":
+				result = hexutil.Uint64(100_000)
+			case "eth_sendRawTransaction":
+				var raw hexutil.Bytes
+				require.NoError(t, json.Unmarshal(req.Params[0], &raw))
+				tx := new(coretypes.Transaction)
+				require.NoError(t, tx.UnmarshalBinary(raw))
+				require.Equal(t, chainID, tx.ChainId().Uint64())
+				sent.Add(1)
+				txHash.Store(tx.Hash())
+				result = tx.Hash()
+			case "eth_getTransactionReceipt":
+				receipt := &coretypes.Receipt{
+					Type:              coretypes.DynamicFeeTxType,
+					Status:            status,
+					CumulativeGasUsed: 100_000,
+					GasUsed:           100_000,
+					Logs:              []*coretypes.Log{},
+					BlockNumber:       big.NewInt(7),
+					BlockHash:         [32]byte{7},
+				}
+				receipt.TxHash, _ = txHash.Load().(gethcommon.Hash)
+				result = receipt
+			default:
+				t.Errorf("unexpected method %s", req.Method)
+			}
+			bz, marshalErr := json.Marshal(map[string]any{
+				"jsonrpc": "2.0", "id": req.ID, "result": result,
+			})
+			require.NoError(t, marshalErr)
+			w.Header().Set("Content-Type", "application/json")
+			_, writeErr := w.Write(bz)
+			require.NoError(t, writeErr)
+		},
+	))
+	t.Cleanup(srv.Close)
+	return srv, sent
+}
+
+// runBroadcast broadcasts a deposit through the execution client at the
+// given URL on the devnet.
+func runBroadcast(t *testing.T, url string) (string, error) {
+	t.Helper()
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+	key, err := gethcrypto.GenerateKey()
+	require.NoError(t, err)
+
+	cmd := deposit.NewCreateDeposit(cs)
+	out := new(bytes.Buffer)
+	cmd.SetOut(out)
+	cmd.SetContext(context.Background())
+	require.NoError(t, cmd.Flags().Set("broadcast", "true"))
+	require.NoError(t, cmd.Flags().Set(
+		"private-key", hex.EncodeToString(gethcrypto.FromECDSA(key)),
+	))
+	require.NoError(t, cmd.Flags().Set("rpc-url", url))
+
+	depositData := types.NewDeposit(
+		crypto.BLSPubkey{0x01},
+		types.WithdrawalCredentials{0x01},
+		math.Gwei(32e9),
+		crypto.BLSSignature{0x01},
+		0,
+	)
+	err = deposit.BroadcastDeposit(
+		cmd, cs, depositData, common.ExecutionAddress{0x02},
+	)
+	return out.String(), err
+}
+
+func TestBroadcastDeposit(t *testing.T) {
+	rpc, sent := depositRPC(t, spec.DevnetEth1ChainID, 1)
+	out, err := runBroadcast(t, rpc.URL)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), sent.Load())
+	require.Contains(t, out, "deposit included in block 7")
+}
+
+func TestBroadcastDepositReverted(t *testing.T) {
+	rpc, sent := depositRPC(t, spec.DevnetEth1ChainID, 0)
+	_, err := runBroadcast(t, rpc.URL)
+	require.ErrorIs(t, err, deposit.ErrDepositReverted)
+	require.Equal(t, int32(1), sent.Load())
+}
+
+func TestBroadcastDepositChainIDMismatch(t *testing.T) {
+	rpc, sent := depositRPC(t, spec.DevnetEth1ChainID+1, 1)
+	_, err := runBroadcast(t, rpc.URL)
+	require.ErrorIs(t, err, deposit.ErrChainIDMismatch)
+	require.Zero(t, sent.Load())
+}
@@ -45,6 +45,10 @@ func Commands[ExecutionPayloadT constraints.EngineType[ExecutionPayloadT]](
 	cmd.AddCommand(
 		NewValidateDeposit(chainSpec),
 		NewCreateValidator[ExecutionPayloadT](chainSpec),
+		NewCreateDeposit(chainSpec),
+		NewVerifyDeposit(chainSpec),
+		NewStatusCommand(chainSpec),
+		NewExportCommand(chainSpec),
 	)
 
 	return cmd
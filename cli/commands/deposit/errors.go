@@ -39,7 +39,23 @@ var (
 	ErrDepositReceiptEmpty = errors.New(
 		"deposit receipt is nil")
 
+	// ErrChainIDMismatch is returned when the chain ID of the execution
+	// client differs from the deposit chain ID of the chain spec.
+	ErrChainIDMismatch = errors.New("chain ID mismatch")
+
+	// ErrDepositReverted is returned when the deposit transaction is mined
+	// but reverted.
+	ErrDepositReverted = errors.New("deposit transaction reverted")
+
 	// ErrPrivateKeyEmpty is returned when the private key is empty.
 	ErrPrivateKeyEmpty = errors.New(
 		"private key is empty")
+
+	// ErrDepositNotFound is returned when no deposit of the tracked validator
+	// is found on the execution layer nor on the node.
+	ErrDepositNotFound = errors.New("no deposit found")
+
+	// ErrUnexpectedStatusCode is returned when the node API responds with a
+	// non-200 status code.
+	ErrUnexpectedStatusCode = errors.New("unexpected status code")
 )
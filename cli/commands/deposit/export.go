@@ -0,0 +1,433 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"encoding/csv"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	gethprimitives "github.com/berachain/beacon-kit/geth-primitives"
+	"github.com/berachain/beacon-kit/geth-primitives/bind"
+	"github.com/berachain/beacon-kit/geth-primitives/deposit"
+	"github.com/berachain/beacon-kit/geth-primitives/ethclient"
+	"github.com/berachain/beacon-kit/primitives/bytes"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto/sha256"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/merkle"
+	"github.com/berachain/beacon-kit/primitives/merkle/zero"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// DepositsFileName is the name of the SSZ encoded deposits in an export.
+	DepositsFileName = "deposits.ssz"
+	// DepositsCSVFileName is the name of the CSV encoded deposits in an
+	// export.
+	DepositsCSVFileName = "deposits.csv"
+	// ExportManifestFileName is the name of the manifest in an export.
+	ExportManifestFileName = "manifest.json"
+
+	// DepositTreeDepth is the depth of the deposit tree, as in the deposit
+	// contract of the Ethereum consensus specs.
+	DepositTreeDepth = 32
+)
+
+var (
+	// ErrDepositRootMismatch is returned when the exported deposits do not
+	// match the root recorded in the manifest.
+	ErrDepositRootMismatch = errors.New("deposit root does not match manifest")
+	// ErrDepositIndexGap is returned when the deposits are not indexed
+	// contiguously from zero.
+	ErrDepositIndexGap = errors.New("deposit indices are not contiguous")
+	// ErrDepositCountMismatch is returned when the deposit history does not
+	// add up to the deposit count it is expected to hold.
+	ErrDepositCountMismatch = errors.New("deposit count mismatch")
+)
+
+// ExportManifest describes an exported deposit history.
+type ExportManifest struct {
+	// ReferenceBlock is the execution block the history was read up to.
+	ReferenceBlock uint64 `json:"reference_block"`
+	// Count is the number of deposits in the history.
+	Count uint64 `json:"count"`
+	// GenesisCount is the number of deposits included at genesis, which
+	// come first in the history.
+	GenesisCount uint64 `json:"genesis_count"`
+	// DepositRoot is the root of the deposit tree holding the deposits. It
+	// addresses the content of the export.
+	DepositRoot common.Root `json:"deposit_root"`
+}
+
+// DepositRoot returns the root of the deposit tree holding the given
+// deposits, with their count mixed in, as Eth1Data.DepositRoot commits to.
+func DepositRoot(deposits []*types.Deposit) (common.Root, error) {
+	if len(deposits) == 0 {
+		return merkle.NewHasher[common.Root](sha256.Hash).MixIn(
+			zero.Hashes[DepositTreeDepth], 0,
+		), nil
+	}
+	leaves := make([]common.Root, len(deposits))
+	for i, dep := range deposits {
+		leaves[i] = dep.DataRoot()
+	}
+	tree, err := merkle.NewTreeFromLeavesWithDepth(leaves, DepositTreeDepth)
+	if err != nil {
+		return common.Root{}, err
+	}
+	return tree.HashTreeRoot(), nil
+}
+
+// WriteExport writes the deposit history and its manifest to the given
+// directory.
+func WriteExport(
+	dir string, referenceBlock, genesisCount uint64, deposits []*types.Deposit,
+) (*ExportManifest, error) {
+	if err := checkDepositIndices(deposits); err != nil {
+		return nil, err
+	}
+	root, err := DepositRoot(deposits)
+	if err != nil {
+		return nil, err
+	}
+	manifest := &ExportManifest{
+		ReferenceBlock: referenceBlock,
+		Count:          uint64(len(deposits)),
+		GenesisCount:   genesisCount,
+		DepositRoot:    root,
+	}
+	manifestBz, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	var bz []byte
+	for _, dep := range deposits {
+		if bz, err = dep.MarshalSSZTo(bz); err != nil {
+			return nil, err
+		}
+	}
+
+	//#nosec:G301 // exports are meant to be shared.
+	if err = os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	//#nosec:G306 // exports are meant to be shared.
+	if err = os.WriteFile(
+		filepath.Join(dir, DepositsFileName), bz, 0o644,
+	); err != nil {
+		return nil, err
+	}
+	if err = writeDepositsCSV(
+		filepath.Join(dir, DepositsCSVFileName), deposits,
+	); err != nil {
+		return nil, err
+	}
+	//#nosec:G306 // exports are meant to be shared.
+	return manifest, os.WriteFile(
+		filepath.Join(dir, ExportManifestFileName), manifestBz, 0o644,
+	)
+}
+
+// ReadExport reads the deposit history and its manifest from the given
+// directory and checks them against each other. The CSV encoding is derived
+// from the SSZ one and is not read.
+func ReadExport(dir string) ([]*types.Deposit, *ExportManifest, error) {
+	manifestBz, err := os.ReadFile(filepath.Join(dir, ExportManifestFileName))
+	if err != nil {
+		return nil, nil, err
+	}
+	manifest := &ExportManifest{}
+	if err = json.Unmarshal(manifestBz, manifest); err != nil {
+		return nil, nil, err
+	}
+
+	bz, err := os.ReadFile(filepath.Join(dir, DepositsFileName))
+	if err != nil {
+		return nil, nil, err
+	}
+	if manifest.GenesisCount > manifest.Count {
+		return nil, nil, errors.Wrapf(
+			ErrDepositCountMismatch, "%d genesis deposits out of %d",
+			manifest.GenesisCount, manifest.Count,
+		)
+	}
+	if uint64(len(bz)) != manifest.Count*types.DepositSize {
+		return nil, nil, errors.Wrapf(
+			ErrDepositCountMismatch,
+			"%d bytes of deposits for %d deposits", len(bz), manifest.Count,
+		)
+	}
+	deposits := make([]*types.Deposit, 0, manifest.Count)
+	for start := uint64(0); start < uint64(len(bz)); start += types.DepositSize {
+		dep := new(types.Deposit)
+		if err = dep.UnmarshalSSZ(
+			bz[start : start+types.DepositSize],
+		); err != nil {
+			return nil, nil, errors.Wrapf(
+				err, "failed to decode deposit %d", len(deposits),
+			)
+		}
+		deposits = append(deposits, dep)
+	}
+
+	if err = checkDepositIndices(deposits); err != nil {
+		return nil, nil, err
+	}
+	root, err := DepositRoot(deposits)
+	if err != nil {
+		return nil, nil, err
+	}
+	if root != manifest.DepositRoot {
+		return nil, nil, errors.Wrapf(
+			ErrDepositRootMismatch,
+			"expected %s, got %s", manifest.DepositRoot, root,
+		)
+	}
+	return deposits, manifest, nil
+}
+
+// checkDepositIndices checks that the deposits are indexed in order from
+// zero, without gaps.
+func checkDepositIndices(deposits []*types.Deposit) error {
+	for i, dep := range deposits {
+		//#nosec:G115 // the index is not negative.
+		if dep.Index != uint64(i) {
+			return errors.Wrapf(
+				ErrDepositIndexGap, "expected index %d, got %d", i, dep.Index,
+			)
+		}
+	}
+	return nil
+}
+
+// writeDepositsCSV writes the deposits to a CSV file, one per row.
+func writeDepositsCSV(path string, deposits []*types.Deposit) error {
+	//#nosec:G304 // the path is chosen by the operator.
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	w := csv.NewWriter(f)
+	if err = w.Write([]string{
+		"index", "pubkey", "credentials", "amount", "signature",
+	}); err != nil {
+		return err
+	}
+	for _, dep := range deposits {
+		if err = w.Write([]string{
+			strconv.FormatUint(dep.Index, 10),
+			dep.Pubkey.String(),
+			common.Bytes32(dep.Credentials).String(),
+			dep.Amount.Base10(),
+			dep.Signature.String(),
+		}); err != nil {
+			return err
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
+
+// NewExportCommand creates a new command exporting the deposit history.
+func NewExportCommand(chainSpec common.ChainSpec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export [beacond/genesis.json]",
+		Short: "Exports the deposit history for audits",
+		Long: `Exports every deposit of the chain, the genesis deposits
+		followed by those emitted by the deposit contract up to a reference
+		execution block, as SSZ and CSV files along with a manifest holding
+		the root of their deposit tree. The history is checked to be complete
+		against the deposit count of the contract at the reference block.`,
+		Args: cobra.ExactArgs(1),
+		RunE: exportDepositsCmd(chainSpec),
+	}
+
+	cmd.Flags().String(rpcURL, defaultRPCURL, exportRPCURLMsg)
+	cmd.Flags().Uint64(fromBlock, defaultFromBlock, fromBlockMsg)
+	cmd.Flags().Uint64(toBlock, defaultToBlock, toBlockMsg)
+	cmd.Flags().String(output, defaultExportOutput, exportOutputMsg)
+
+	return cmd
+}
+
+// exportDepositsCmd returns a command that exports the deposit history.
+func exportDepositsCmd(
+	chainSpec common.ChainSpec,
+) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		url, err := cmd.Flags().GetString(rpcURL)
+		if err != nil {
+			return err
+		}
+		from, err := cmd.Flags().GetUint64(fromBlock)
+		if err != nil {
+			return err
+		}
+		to, err := cmd.Flags().GetUint64(toBlock)
+		if err != nil {
+			return err
+		}
+		dir, err := cmd.Flags().GetString(output)
+		if err != nil {
+			return err
+		}
+
+		deposits, err := readGenesisDeposits(args[0])
+		if err != nil {
+			return err
+		}
+		genesisCount := uint64(len(deposits))
+		deposits, to, err = readContractDeposits(
+			cmd, chainSpec.DepositContractAddress(), url, from, to, deposits,
+		)
+		if err != nil {
+			return err
+		}
+
+		manifest, err := WriteExport(dir, to, genesisCount, deposits)
+		if err != nil {
+			return err
+		}
+		cmd.Printf(
+			"exported %d deposits up to block %d with root %s to %s\n",
+			manifest.Count, manifest.ReferenceBlock, manifest.DepositRoot, dir,
+		)
+		return nil
+	}
+}
+
+// readGenesisDeposits reads the deposits included at genesis from the
+// genesis file.
+func readGenesisDeposits(path string) ([]*types.Deposit, error) {
+	appGenesis, err := genutiltypes.AppGenesisFromFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read genesis doc from file")
+	}
+	var appState map[string]json.RawMessage
+	if err = json.Unmarshal(appGenesis.AppState, &appState); err != nil {
+		return nil, err
+	}
+	var genesis struct {
+		Deposits []*types.Deposit `json:"deposits"`
+	}
+	if err = json.Unmarshal(appState["beacon"], &genesis); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal beacon genesis")
+	}
+	return genesis.Deposits, nil
+}
+
+// readContractDeposits appends the deposits emitted by the deposit contract
+// up to the given execution block, or the latest one if zero, and checks the
+// history against the deposit count of the contract at that block. It
+// returns the history along with the block it was read up to.
+func readContractDeposits(
+	cmd *cobra.Command,
+	contract common.ExecutionAddress,
+	url string,
+	from, to uint64,
+	deposits []*types.Deposit,
+) ([]*types.Deposit, uint64, error) {
+	ctx := cmd.Context()
+	client, err := ethclient.Dial(url)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer client.Close()
+
+	if to == 0 {
+		if to, err = client.BlockNumber(ctx); err != nil {
+			return nil, 0, errors.Wrap(err, "failed to query latest block")
+		}
+	}
+	address := gethprimitives.ExecutionAddress(contract)
+	filterer, err := deposit.NewDepositContractFilterer(address, client)
+	if err != nil {
+		return nil, 0, err
+	}
+	err = filterDeposits(
+		ctx, filterer, from, to,
+		func(event *deposit.DepositContractDeposit) error {
+			dep, convErr := depositFromEvent(event)
+			if convErr != nil {
+				return convErr
+			}
+			deposits = append(deposits, dep)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	caller, err := deposit.NewDepositContractCaller(address, client)
+	if err != nil {
+		return nil, 0, err
+	}
+	count, err := caller.DepositCount(&bind.CallOpts{
+		Context:     ctx,
+		BlockNumber: new(big.Int).SetUint64(to),
+	})
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "failed to query deposit count")
+	}
+	if count != uint64(len(deposits)) {
+		return nil, 0, errors.Wrapf(
+			ErrDepositCountMismatch,
+			"contract has %d deposits at block %d, read %d",
+			count, to, len(deposits),
+		)
+	}
+	return deposits, to, nil
+}
+
+// depositFromEvent converts a deposit event of the deposit contract.
+func depositFromEvent(
+	event *deposit.DepositContractDeposit,
+) (*types.Deposit, error) {
+	pubkey, err := bytes.ToBytes48(event.Pubkey)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed reading pub key")
+	}
+	credentials, err := bytes.ToBytes32(event.Credentials)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed reading credentials")
+	}
+	signature, err := bytes.ToBytes96(event.Signature)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed reading signature")
+	}
+	return types.NewDeposit(
+		pubkey,
+		types.WithdrawalCredentials(credentials),
+		math.Gwei(event.Amount),
+		signature,
+		event.Index,
+	), nil
+}
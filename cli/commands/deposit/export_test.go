@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/berachain/beacon-kit/cli/commands/deposit"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/stretchr/testify/require"
+)
+
+func testDeposits(n int) []*types.Deposit {
+	deposits := make([]*types.Deposit, n)
+	for i := range deposits {
+		deposits[i] = types.NewDeposit(
+			crypto.BLSPubkey{byte(i + 1)},
+			types.WithdrawalCredentials{0x01},
+			math.Gwei(32e9),
+			crypto.BLSSignature{byte(i + 1)},
+			uint64(i),
+		)
+	}
+	return deposits
+}
+
+func TestExportRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	deposits := testDeposits(3)
+	manifest, err := deposit.WriteExport(dir, 100, 1, deposits)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), manifest.Count)
+
+	read, readManifest, err := deposit.ReadExport(dir)
+	require.NoError(t, err)
+	require.Equal(t, manifest, readManifest)
+	require.Equal(t, deposits, read)
+
+	csv, err := os.ReadFile(filepath.Join(dir, deposit.DepositsCSVFileName))
+	require.NoError(t, err)
+	require.Len(t, strings.Split(strings.TrimSpace(string(csv)), "\n"), 4)
+
+	// The root commits to every deposit.
+	other, err := deposit.DepositRoot(testDeposits(2))
+	require.NoError(t, err)
+	require.NotEqual(t, manifest.DepositRoot, other)
+}
+
+func TestExportRejectsTampering(t *testing.T) {
+	dir := t.TempDir()
+	_, err := deposit.WriteExport(dir, 100, 0, testDeposits(2))
+	require.NoError(t, err)
+
+	path := filepath.Join(dir, deposit.DepositsFileName)
+	bz, err := os.ReadFile(path)
+	require.NoError(t, err)
+	// Bump the amount of the first deposit.
+	bz[48+32]++
+	require.NoError(t, os.WriteFile(path, bz, 0o600))
+
+	_, _, err = deposit.ReadExport(dir)
+	require.ErrorIs(t, err, deposit.ErrDepositRootMismatch)
+}
+
+func TestExportRejectsIndexGaps(t *testing.T) {
+	deposits := testDeposits(3)
+	deposits[2].Index = 3
+	_, err := deposit.WriteExport(t.TempDir(), 100, 0, deposits)
+	require.ErrorIs(t, err, deposit.ErrDepositIndexGap)
+}
@@ -29,6 +29,32 @@ const (
 
 	// validatorPrivateKey is the flag for the validator private key.
 	valPrivateKey = "validator-private-key"
+
+	// operator is the flag for the operator address of the validator.
+	operator = "operator"
+
+	// output is the flag for the deposit data output file.
+	output = "output"
+
+	// broadcast is the flag for broadcasting the deposit transaction.
+	broadcast = "broadcast"
+
+	// rpcURL is the flag for the execution client RPC URL.
+	rpcURL = "rpc-url"
+
+	// pubkey is the flag for the public key of the tracked validator.
+	pubkey = "pubkey"
+
+	// nodeAPI is the flag for the node API address.
+	nodeAPI = "node-api"
+
+	// fromBlock is the flag for the first execution block scanned for deposit
+	// events.
+	fromBlock = "from-block"
+
+	// toBlock is the flag for the last execution block scanned for deposit
+	// events.
+	toBlock = "to-block"
 )
 
 const (
@@ -47,6 +73,31 @@ const (
 	// defaultValidatorPrivateKey is the default value for the
 	// validatorPrivateKey flag.
 	defaultValidatorPrivateKey = ""
+
+	// defaultOperator is the default value for the operator flag.
+	defaultOperator = ""
+
+	// defaultOutput is the default value for the output flag.
+	defaultOutput = ""
+
+	// defaultBroadcast is the default value for the broadcast flag.
+	defaultBroadcast = false
+
+	// defaultRPCURL is the default value for the rpcURL flag.
+	defaultRPCURL = "http://localhost:8545"
+
+	// defaultNodeAPI is the default value for the nodeAPI flag.
+	defaultNodeAPI = "http://127.0.0.1:3500"
+
+	// defaultFromBlock is the default value for the fromBlock flag.
+	defaultFromBlock = 0
+
+	// defaultToBlock is the default value for the toBlock flag.
+	defaultToBlock = 0
+
+	// defaultExportOutput is the default value for the output flag of the
+	// export command.
+	defaultExportOutput = "deposit-export"
 )
 
 const (
@@ -62,4 +113,43 @@ const (
 	// valPrivateKey flag.
 	valPrivateKeyMsg = `validator private key. This is required if the 
 	override-node-key flag is set.`
+
+	// operatorMsg is the usage description for the operator flag.
+	operatorMsg = `operator address of the validator. This is required for the
+	first deposit of a validator.`
+
+	// outputMsg is the usage description for the output flag.
+	outputMsg = "file to write the deposit data to"
+
+	// broadcastMsg is the usage description for the broadcast flag.
+	broadcastMsg = "broadcast the deposit transaction to the execution client"
+
+	// rpcURLMsg is the usage description for the rpcURL flag.
+	rpcURLMsg = "execution client RPC URL used to broadcast the deposit"
+
+	// statusRPCURLMsg is the usage description for the rpcURL flag of the
+	// status command.
+	statusRPCURLMsg = `execution client RPC URL used to read the deposit
+	events. The events are not read if empty.`
+
+	// pubkeyMsg is the usage description for the pubkey flag.
+	pubkeyMsg = "public key of the validator whose deposits are tracked"
+
+	// nodeAPIMsg is the usage description for the nodeAPI flag.
+	nodeAPIMsg = "node API address"
+
+	// fromBlockMsg is the usage description for the fromBlock flag.
+	fromBlockMsg = "first execution block scanned for deposit events"
+
+	// toBlockMsg is the usage description for the toBlock flag.
+	toBlockMsg = `reference execution block the deposit history is exported
+	up to. The latest block is used if zero.`
+
+	// exportRPCURLMsg is the usage description for the rpcURL flag of the
+	// export command.
+	exportRPCURLMsg = "execution client RPC URL used to read the deposit events"
+
+	// exportOutputMsg is the usage description for the output flag of the
+	// export command.
+	exportOutputMsg = "directory to write the deposit export to"
 )
@@ -0,0 +1,271 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package deposit
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	"github.com/berachain/beacon-kit/cli/utils/parser"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/spf13/cobra"
+)
+
+// depositStage is the stage of the deposit flow a deposit has reached.
+type depositStage string
+
+const (
+	// stageAwaitingNode is the stage of a deposit emitted on the execution
+	// layer but not yet read into the deposit store of the node.
+	stageAwaitingNode depositStage = "awaiting node"
+	// stagePendingInclusion is the stage of a deposit in the deposit store
+	// of the node but not yet included in a block.
+	stagePendingInclusion depositStage = "pending inclusion"
+	// stageRejected is the stage of a deposit processed by the beacon chain
+	// that did not result in a validator.
+	stageRejected depositStage = "rejected"
+	// stageProcessed is the stage of a deposit processed by the beacon chain
+	// and credited to the validator.
+	stageProcessed depositStage = "processed"
+)
+
+// trackedDeposit is a deposit of the tracked validator along with the stage
+// of the deposit flow it has reached.
+type trackedDeposit struct {
+	index  uint64
+	amount math.Gwei
+	// event is the deposit event emitted on the execution layer, nil if it
+	// was not read.
+	event *depositEvent
+	stage depositStage
+}
+
+// NewStatusCommand creates a new command reporting the stage of the deposit
+// flow every deposit of a validator has reached.
+func NewStatusCommand(chainSpec common.ChainSpec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Reports the stage of the deposits of a validator",
+		Long: `Correlates the deposit events of a validator emitted on the
+		execution layer with the deposit store of the node, the index of the
+		last deposit processed by the beacon chain and the resulting validator
+		record, and reports the stage at which a pending stake is stuck.`,
+		Args: cobra.NoArgs,
+		RunE: depositStatusCmd(chainSpec),
+	}
+
+	cmd.Flags().String(pubkey, "", pubkeyMsg)
+	cmd.Flags().String(nodeAPI, defaultNodeAPI, nodeAPIMsg)
+	cmd.Flags().String(rpcURL, defaultRPCURL, statusRPCURLMsg)
+	cmd.Flags().Uint64(fromBlock, defaultFromBlock, fromBlockMsg)
+	if err := cmd.MarkFlagRequired(pubkey); err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
+
+// depositStatusCmd returns a command that reports the stage of the deposits
+// of a validator.
+func depositStatusCmd(
+	chainSpec common.ChainSpec,
+) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, _ []string) error {
+		pubkeyStr, err := cmd.Flags().GetString(pubkey)
+		if err != nil {
+			return err
+		}
+		key, err := parser.ConvertPubkey(pubkeyStr)
+		if err != nil {
+			return err
+		}
+		addr, err := cmd.Flags().GetString(nodeAPI)
+		if err != nil {
+			return err
+		}
+		url, err := cmd.Flags().GetString(rpcURL)
+		if err != nil {
+			return err
+		}
+		from, err := cmd.Flags().GetUint64(fromBlock)
+		if err != nil {
+			return err
+		}
+
+		ctx := cmd.Context()
+		var events []*depositEvent
+		if url != "" {
+			events, err = readDepositEvents(
+				ctx, url, chainSpec.DepositContractAddress(), key, from,
+			)
+			if err != nil {
+				return err
+			}
+		}
+		status, err := newNodeClient(addr).depositStatus(ctx, key)
+		if err != nil {
+			return err
+		}
+
+		deposits := trackDeposits(events, status)
+		if len(deposits) == 0 && status.Validator == nil {
+			return fmt.Errorf("%w for %s", ErrDepositNotFound, key)
+		}
+		printDepositStatus(cmd, chainSpec, key, status, deposits)
+		return nil
+	}
+}
+
+// trackDeposits merges the deposit events read from the execution layer with
+// the deposits pending on the node, and assigns each its stage.
+func trackDeposits(
+	events []*depositEvent,
+	status *nodeDepositStatus,
+) []*trackedDeposit {
+	byIndex := make(map[uint64]*trackedDeposit)
+	for _, event := range events {
+		byIndex[event.index] = &trackedDeposit{
+			index:  event.index,
+			amount: event.amount,
+			event:  event,
+		}
+	}
+	pending := make(map[uint64]struct{}, len(status.PendingDeposits))
+	for _, deposit := range status.PendingDeposits {
+		pending[deposit.Index] = struct{}{}
+		if _, ok := byIndex[deposit.Index]; !ok {
+			byIndex[deposit.Index] = &trackedDeposit{
+				index:  deposit.Index,
+				amount: math.Gwei(deposit.Amount),
+			}
+		}
+	}
+
+	deposits := make([]*trackedDeposit, 0, len(byIndex))
+	for _, deposit := range byIndex {
+		_, isPending := pending[deposit.index]
+		switch {
+		case deposit.index <= status.Eth1DepositIndex &&
+			status.Validator == nil:
+			deposit.stage = stageRejected
+		case deposit.index <= status.Eth1DepositIndex:
+			deposit.stage = stageProcessed
+		case isPending:
+			deposit.stage = stagePendingInclusion
+		default:
+			deposit.stage = stageAwaitingNode
+		}
+		deposits = append(deposits, deposit)
+	}
+	slices.SortFunc(deposits, func(a, b *trackedDeposit) int {
+		return cmp.Compare(a.index, b.index)
+	})
+	return deposits
+}
+
+// stuckAt returns a description of the stage at which the stake of the
+// validator is stuck, or of its current state if all its deposits went
+// through.
+func stuckAt(
+	chainSpec common.ChainSpec,
+	status *nodeDepositStatus,
+	deposits []*trackedDeposit,
+) string {
+	// Deposits are processed in order, the first one that did not go through
+	// holds back all the later ones.
+	for _, deposit := range deposits {
+		switch deposit.stage {
+		case stageAwaitingNode:
+			return fmt.Sprintf(
+				"deposit %d is on the execution layer but not yet read "+
+					"by the node, which follows the execution chain at a "+
+					"distance", deposit.index,
+			)
+		case stagePendingInclusion:
+			return fmt.Sprintf(
+				"deposit %d is read by the node but not yet included in "+
+					"a block", deposit.index,
+			)
+		case stageRejected, stageProcessed:
+		}
+	}
+	if status.Validator == nil {
+		return "the deposits were processed without creating a " +
+			"validator, the signature of the first deposit is likely invalid"
+	}
+
+	val := status.Validator.Validator
+	epoch := chainSpec.SlotToEpoch(math.Slot(status.Slot))
+	switch {
+	case val.ExitEpoch != math.Epoch(constants.FarFutureEpoch) &&
+		val.ExitEpoch <= epoch:
+		return fmt.Sprintf("the validator exited at epoch %d", val.ExitEpoch)
+	case val.ExitEpoch != math.Epoch(constants.FarFutureEpoch):
+		return fmt.Sprintf("the validator exits at epoch %d", val.ExitEpoch)
+	case val.EffectiveBalance == 0:
+		return "the validator is registered without effective balance, " +
+			"its balance is below the effective balance increment"
+	default:
+		return fmt.Sprintf(
+			"the validator is registered with an effective balance of "+
+				"%d gwei", val.EffectiveBalance,
+		)
+	}
+}
+
+// printDepositStatus writes the stage of every deposit of the validator to
+// the command output.
+func printDepositStatus(
+	cmd *cobra.Command,
+	chainSpec common.ChainSpec,
+	key crypto.BLSPubkey,
+	status *nodeDepositStatus,
+	deposits []*trackedDeposit,
+) {
+	cmd.Printf("pubkey:              %s\n", key)
+	cmd.Printf("head slot:           %d\n", status.Slot)
+	cmd.Printf("last deposit index:  %d\n", status.Eth1DepositIndex)
+	for _, deposit := range deposits {
+		cmd.Printf(
+			"%-21s%s, %d gwei", fmt.Sprintf("deposit %d:", deposit.index),
+			deposit.stage, deposit.amount,
+		)
+		if deposit.event != nil {
+			cmd.Printf(
+				", block %d, tx %s",
+				deposit.event.blockNumber, deposit.event.txHash,
+			)
+		}
+		cmd.Printf("\n")
+	}
+	if status.Validator != nil {
+		cmd.Printf(
+			"validator:           %d, balance %d gwei\n",
+			status.Validator.Index, status.Validator.Balance,
+		)
+	} else {
+		cmd.Printf("validator:           none\n")
+	}
+	cmd.Printf("stage:               %s\n", stuckAt(chainSpec, status, deposits))
+}
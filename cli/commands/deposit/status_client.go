@@ -0,0 +1,213 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package deposit
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	gethprimitives "github.com/berachain/beacon-kit/geth-primitives"
+	"github.com/berachain/beacon-kit/geth-primitives/bind"
+	"github.com/berachain/beacon-kit/geth-primitives/deposit"
+	"github.com/berachain/beacon-kit/geth-primitives/ethclient"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+const (
+	// nodeAPITimeout is the timeout of every node API request.
+	nodeAPITimeout = 10 * time.Second
+	// logsBatchSize is the number of execution blocks scanned for deposit
+	// events at once, to stay within the range limits of the RPC providers.
+	logsBatchSize = 10_000
+)
+
+// depositEvent is a deposit event emitted by the deposit contract.
+type depositEvent struct {
+	index       uint64
+	amount      math.Gwei
+	blockNumber uint64
+	txHash      common.ExecutionHash
+}
+
+// readDepositEvents returns the deposit events of the given pubkey emitted by
+// the deposit contract since the given execution block.
+func readDepositEvents(
+	ctx context.Context,
+	url string,
+	contract common.ExecutionAddress,
+	key crypto.BLSPubkey,
+	from uint64,
+) ([]*depositEvent, error) {
+	client, err := ethclient.Dial(url)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+
+	latest, err := client.BlockNumber(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query latest block")
+	}
+	filterer, err := deposit.NewDepositContractFilterer(
+		gethprimitives.ExecutionAddress(contract), client,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []*depositEvent
+	err = filterDeposits(
+		ctx, filterer, from, latest,
+		func(event *deposit.DepositContractDeposit) error {
+			if !bytes.Equal(event.Pubkey, key[:]) {
+				return nil
+			}
+			events = append(events, &depositEvent{
+				index:       event.Index,
+				amount:      math.Gwei(event.Amount),
+				blockNumber: event.Raw.BlockNumber,
+				txHash:      common.ExecutionHash(event.Raw.TxHash),
+			})
+			return nil
+		},
+	)
+	return events, err
+}
+
+// filterDeposits calls fn with every deposit event emitted by the deposit
+// contract between the given execution blocks, inclusive, in order.
+func filterDeposits(
+	ctx context.Context,
+	filterer *deposit.DepositContractFilterer,
+	from, to uint64,
+	fn func(*deposit.DepositContractDeposit) error,
+) error {
+	for start := from; start <= to; start += logsBatchSize {
+		end := min(start+logsBatchSize-1, to)
+		logs, err := filterer.FilterDeposit(&bind.FilterOpts{
+			Context: ctx,
+			Start:   start,
+			End:     &end,
+		})
+		if err != nil {
+			return errors.Wrapf(
+				err, "failed to read deposits of blocks %d to %d", start, end,
+			)
+		}
+		for err == nil && logs.Next() {
+			err = fn(logs.Event)
+		}
+		if err == nil {
+			err = logs.Error()
+		}
+		logs.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// nodeDepositStatus is the status of the deposits of a validator, as served
+// by the node API.
+type nodeDepositStatus struct {
+	Slot             uint64 `json:"slot,string"`
+	Eth1DepositIndex uint64 `json:"eth1_deposit_index,string"`
+	PendingDeposits  []struct {
+		Index  uint64 `json:"index,string"`
+		Amount uint64 `json:"amount,string"`
+	} `json:"pending_deposits"`
+	Validator *struct {
+		Index     uint64           `json:"index,string"`
+		Balance   uint64           `json:"balance,string"`
+		Validator *types.Validator `json:"validator"`
+	} `json:"validator"`
+}
+
+// nodeClient queries the deposits API of the node.
+type nodeClient struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+// newNodeClient creates a new node API client for the given address.
+func newNodeClient(addr string) *nodeClient {
+	if !strings.Contains(addr, "://") {
+		addr = "http://" + addr
+	}
+	return &nodeClient{
+		baseURL:    strings.TrimSuffix(addr, "/"),
+		httpClient: &http.Client{Timeout: nodeAPITimeout},
+	}
+}
+
+// depositStatus queries the node API for the status of the deposits of the
+// validator with the given pubkey.
+func (c *nodeClient) depositStatus(
+	ctx context.Context,
+	key crypto.BLSPubkey,
+) (*nodeDepositStatus, error) {
+	var resp struct {
+		Data *nodeDepositStatus `json:"data"`
+	}
+	path := "/bkit/v1/deposits/status/" + key.String()
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodGet, c.baseURL+path, http.NoBody,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	httpResp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to query %s", path)
+	}
+	defer httpResp.Body.Close()
+
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, errors.Wrapf(
+			ErrUnexpectedStatusCode, "%s: %d %s",
+			path, httpResp.StatusCode, strings.TrimSpace(string(body)),
+		)
+	}
+	if err = json.Unmarshal(body, &resp); err != nil {
+		return nil, err
+	}
+	if resp.Data == nil {
+		return nil, errors.New("missing deposit status")
+	}
+	if resp.Data.Validator != nil && resp.Data.Validator.Validator == nil {
+		return nil, errors.New("missing validator record")
+	}
+	return resp.Data, nil
+}
@@ -0,0 +1,201 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package deposit_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/berachain/beacon-kit/cli/commands/deposit"
+	"github.com/berachain/beacon-kit/config/spec"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	gethdeposit "github.com/berachain/beacon-kit/geth-primitives/deposit"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+	"github.com/berachain/beacon-kit/primitives/math"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	coretypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+var trackedPubkey = crypto.BLSPubkey{0x01}
+
+// nodeAPI serves the given deposit status on the deposits API.
+func nodeAPI(t *testing.T, status map[string]any) *httptest.Server {
+	t.Helper()
+	path := "/bkit/v1/deposits/status/" + trackedPubkey.String()
+	srv := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != path {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			bz, err := json.Marshal(map[string]any{"data": status})
+			require.NoError(t, err)
+			_, err = w.Write(bz)
+			require.NoError(t, err)
+		},
+	))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+// executionRPC serves the given deposit events on the JSON-RPC API of an
+// execution client.
+func executionRPC(t *testing.T, events ...[2]any) *httptest.Server {
+	t.Helper()
+	contract, err := gethdeposit.DepositContractMetaData.GetAbi()
+	require.NoError(t, err)
+	event := contract.Events["Deposit"]
+
+	logs := make([]*coretypes.Log, 0, len(events))
+	for i, e := range events {
+		pubkey, _ := e[0].(crypto.BLSPubkey)
+		index, _ := e[1].(uint64)
+		data, packErr := event.Inputs.Pack(
+			pubkey[:], make([]byte, 32), uint64(32e9), make([]byte, 96), index,
+		)
+		require.NoError(t, packErr)
+		logs = append(logs, &coretypes.Log{
+			Topics:      []gethcommon.Hash{event.ID},
+			Data:        data,
+			BlockNumber: uint64(10 + i),
+			TxHash:      gethcommon.Hash{byte(i + 1)},
+		})
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			body, readErr := io.ReadAll(r.Body)
+			require.NoError(t, readErr)
+			var req struct {
+				ID     json.RawMessage `json:"id"`
+				Method string          `json:"method"`
+			}
+			require.NoError(t, json.Unmarshal(body, &req))
+
+			var result any
+			switch req.Method {
+			case "eth_blockNumber":
+				result = "0x64"
+			case "eth_getLogs":
+				result = logs
+			default:
+				t.Errorf("unexpected method %s", req.Method)
+			}
+			bz, marshalErr := json.Marshal(map[string]any{
+				"jsonrpc": "2.0", "id": req.ID, "result": result,
+			})
+			require.NoError(t, marshalErr)
+			w.Header().Set("Content-Type", "application/json")
+			_, writeErr := w.Write(bz)
+			require.NoError(t, writeErr)
+		},
+	))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func runStatus(t *testing.T, args ...string) (string, error) {
+	t.Helper()
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+	cmd := deposit.NewStatusCommand(cs)
+	out := new(bytes.Buffer)
+	cmd.SetOut(out)
+	cmd.SetErr(new(bytes.Buffer))
+	cmd.SetArgs(append([]string{"--pubkey", trackedPubkey.String()}, args...))
+	err = cmd.Execute()
+	return out.String(), err
+}
+
+func TestDepositStatusPendingInclusion(t *testing.T) {
+	node := nodeAPI(t, map[string]any{
+		"pubkey":             trackedPubkey.String(),
+		"slot":               "42",
+		"eth1_deposit_index": "5",
+		"pending_deposits": []any{
+			map[string]any{"index": "7", "amount": "32000000000"},
+		},
+		"validator": nil,
+	})
+	el := executionRPC(t,
+		[2]any{trackedPubkey, uint64(3)},
+		[2]any{crypto.BLSPubkey{0x02}, uint64(4)},
+		[2]any{trackedPubkey, uint64(9)},
+	)
+
+	out, err := runStatus(t, "--node-api", node.URL, "--rpc-url", el.URL)
+	require.NoError(t, err)
+	require.Contains(t, out, "deposit 3:           rejected")
+	require.Contains(t, out, "deposit 7:           pending inclusion")
+	require.Contains(t, out, "deposit 9:           awaiting node")
+	require.NotContains(t, out, "deposit 4:")
+	require.Contains(
+		t, out, "stage:               deposit 7 is read by the node but not "+
+			"yet included in a block",
+	)
+}
+
+func TestDepositStatusValidator(t *testing.T) {
+	validator := &types.Validator{
+		Pubkey:           trackedPubkey,
+		EffectiveBalance: math.Gwei(32e9),
+		ExitEpoch:        math.Epoch(constants.FarFutureEpoch),
+	}
+	node := nodeAPI(t, map[string]any{
+		"pubkey":             trackedPubkey.String(),
+		"slot":               "42",
+		"eth1_deposit_index": "5",
+		"pending_deposits":   []any{},
+		"validator": map[string]any{
+			"index":     "2",
+			"balance":   "32000000000",
+			"validator": validator,
+		},
+	})
+	el := executionRPC(t, [2]any{trackedPubkey, uint64(3)})
+
+	out, err := runStatus(t, "--node-api", node.URL, "--rpc-url", el.URL)
+	require.NoError(t, err)
+	require.Contains(t, out, "deposit 3:           processed")
+	require.Contains(t, out, "validator:           2, balance 32000000000")
+	require.Contains(
+		t, out, "the validator is registered with an effective balance "+
+			"of 32000000000 gwei",
+	)
+}
+
+func TestDepositStatusNotFound(t *testing.T) {
+	node := nodeAPI(t, map[string]any{
+		"pubkey":             trackedPubkey.String(),
+		"slot":               "42",
+		"eth1_deposit_index": "5",
+		"pending_deposits":   []any{},
+		"validator":          nil,
+	})
+
+	_, err := runStatus(t, "--node-api", node.URL, "--rpc-url", "")
+	require.ErrorIs(t, err, deposit.ErrDepositNotFound)
+}
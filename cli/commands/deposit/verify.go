@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"os"
+
+	"github.com/berachain/beacon-kit/cli/utils/parser"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/node-core/components/signer"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+	"github.com/spf13/cobra"
+)
+
+// NewVerifyDeposit creates a new command for verifying deposit data files.
+func NewVerifyDeposit(chainSpec common.ChainSpec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify [current-version] [genesis-validator-root] [files...]",
+		Short: "Verifies deposit data files",
+		Long: `Verifies the signatures of the given deposit data files, as
+		written by the create command or collected as premined deposits,
+		against the current version and genesis validator root.`,
+		Args: cobra.MinimumNArgs(3), //nolint:mnd // The number of arguments.
+		RunE: verifyDepositCmd(chainSpec),
+	}
+
+	return cmd
+}
+
+// verifyDepositCmd returns a command that verifies deposit data files.
+func verifyDepositCmd(
+	chainSpec common.ChainSpec,
+) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		currentVersion, err := parser.ConvertVersion(args[0])
+		if err != nil {
+			return err
+		}
+
+		genesisValidatorRoot, err := parser.ConvertGenesisValidatorRoot(args[1])
+		if err != nil {
+			return err
+		}
+
+		forkData := types.NewForkData(currentVersion, genesisValidatorRoot)
+		var errs []error
+		for _, path := range args[2:] {
+			if err = verifyDepositFile(chainSpec, forkData, path); err != nil {
+				cmd.Printf("%s: invalid: %v\n", path, err)
+				errs = append(errs, errors.Wrap(err, path))
+				continue
+			}
+			cmd.Printf("%s: valid\n", path)
+		}
+
+		return errors.Join(errs...)
+	}
+}
+
+// verifyDepositFile verifies the signature of the deposit in the given file.
+func verifyDepositFile(
+	chainSpec common.ChainSpec,
+	forkData *types.ForkData,
+	path string,
+) error {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	depositData := &types.Deposit{}
+	if err = json.Unmarshal(bz, depositData); err != nil {
+		return err
+	}
+
+	return depositData.VerifySignature(
+		forkData,
+		chainSpec.DomainTypeDeposit(),
+		signer.BLSSigner{}.VerifySignature,
+	)
+}
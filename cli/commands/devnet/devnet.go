@@ -0,0 +1,306 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package devnet
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+
+	"github.com/berachain/beacon-kit/cli/flags"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/node-core/components"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagValidators        = "validators"
+	flagDir               = "dir"
+	flagChainID           = "chain-id"
+	flagChainSpec         = "chain-spec"
+	flagBasePort          = "base-port"
+	flagSeed              = "seed"
+	flagEL                = "el"
+	flagELBinary          = "el-binary"
+	flagETHGenesis        = "eth-genesis"
+	flagDepositAmount     = "deposit-amount"
+	flagWithdrawalAddress = "withdrawal-address"
+	flagOverwrite         = "overwrite"
+
+	defaultValidators        = 4
+	defaultDir               = "./.tmp/devnet"
+	defaultChainID           = "beacond-2061"
+	defaultBasePort          = 30000
+	defaultSeed              = "beacon-kit devnet"
+	defaultETHGenesis        = "./testing/files/eth-genesis.json"
+	defaultDepositAmount     = "32000000000"
+	defaultWithdrawalAddress = "0x20f33ce90a13a4b5e7697e3544c3083b8f8a51d4"
+
+	// elGeth runs a geth node next to every beacond node.
+	elGeth = "geth"
+	// elNone expects the user to run the execution clients.
+	elNone = "none"
+)
+
+// ErrDirNotEmpty is returned when the devnet directory already holds files
+// and overwriting it was not requested.
+var ErrDirNotEmpty = errors.New("devnet directory is not empty")
+
+// Commands creates the devnet command.
+func Commands() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "devnet",
+		Short:                      "Local devnet subcommands",
+		SuggestionsMinimumDistance: 2, //nolint:mnd // from sdk.
+		RunE:                       client.ValidateCmd,
+	}
+	cmd.AddCommand(NewUpCommand())
+	return cmd
+}
+
+// NewUpCommand creates a command that generates and runs a local devnet.
+func NewUpCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "up",
+		Short: "Generates and runs a local devnet",
+		Long: `Generates the keys, genesis, chain spec selection and configs of a
+local devnet with the given number of validators, then runs a beacond node and
+an execution client for every validator until interrupted.
+
+Keys are derived from --seed, so the same seed always produces the same
+validators and node ids. Every node reserves 10 consecutive ports starting at
+--base-port. With --el none no execution clients are started and each node
+expects one with its engine API on the port printed at startup.`,
+		Args: cobra.NoArgs,
+		RunE: runUp,
+	}
+
+	cmd.Flags().Int(
+		flagValidators, defaultValidators, "number of validator nodes",
+	)
+	cmd.Flags().String(flagDir, defaultDir, "directory of the devnet files")
+	cmd.Flags().String(flagChainID, defaultChainID, "chain id of the devnet")
+	cmd.Flags().String(
+		flagChainSpec, components.DevnetChainSpecType,
+		"chain spec preset of the devnet",
+	)
+	cmd.Flags().Int(flagBasePort, defaultBasePort, "first port of the devnet")
+	cmd.Flags().String(flagSeed, defaultSeed, "seed of the devnet keys")
+	cmd.Flags().String(
+		flagEL, elGeth, "execution client to run ("+elGeth+"|"+elNone+")",
+	)
+	cmd.Flags().String(
+		flagELBinary, elGeth, "path of the execution client binary",
+	)
+	cmd.Flags().String(
+		flagETHGenesis, defaultETHGenesis, "execution genesis file",
+	)
+	cmd.Flags().String(
+		flagDepositAmount, defaultDepositAmount,
+		"premined deposit of every validator in Gwei",
+	)
+	cmd.Flags().String(
+		flagWithdrawalAddress, defaultWithdrawalAddress,
+		"withdrawal address of every validator",
+	)
+	cmd.Flags().Bool(
+		flagOverwrite, false, "remove an existing devnet directory first",
+	)
+
+	return cmd
+}
+
+// runUp generates the devnet described by the command flags and runs it
+// until the command is interrupted.
+//
+//nolint:funlen // flag parsing and orchestration.
+func runUp(cmd *cobra.Command, _ []string) error {
+	fs := cmd.Flags()
+	validators, err := fs.GetInt(flagValidators)
+	if err != nil {
+		return err
+	}
+	basePort, err := fs.GetInt(flagBasePort)
+	if err != nil {
+		return err
+	}
+	overwrite, err := fs.GetBool(flagOverwrite)
+	if err != nil {
+		return err
+	}
+	strs := make(map[string]string)
+	for _, name := range []string{
+		flagDir, flagChainID, flagChainSpec, flagSeed, flagEL, flagELBinary,
+		flagETHGenesis, flagDepositAmount, flagWithdrawalAddress,
+	} {
+		if strs[name], err = fs.GetString(name); err != nil {
+			return err
+		}
+	}
+	if el := strs[flagEL]; el != elGeth && el != elNone {
+		return fmt.Errorf("unknown execution client %q", el)
+	}
+
+	plan, err := NewPlan(Config{
+		Validators: validators,
+		Dir:        strs[flagDir],
+		ChainID:    strs[flagChainID],
+		BasePort:   basePort,
+		Seed:       strs[flagSeed],
+	})
+	if err != nil {
+		return err
+	}
+	if err = prepareDir(plan.Dir, overwrite); err != nil {
+		return err
+	}
+
+	self, err := os.Executable()
+	if err != nil {
+		return err
+	}
+	beacond := &executable{
+		path: self,
+		env: append(
+			os.Environ(),
+			components.ChainSpecTypeEnvVar+"="+strs[flagChainSpec],
+		),
+	}
+	el := &executable{path: strs[flagELBinary], env: os.Environ()}
+
+	ctx, stop := signal.NotifyContext(
+		cmd.Context(), os.Interrupt, syscall.SIGTERM,
+	)
+	defer stop()
+
+	cmd.Printf("generating devnet of %d validators in %s\n",
+		validators, plan.Dir)
+	if err = setup(ctx, plan, beacond, genesisOptions{
+		ethGenesis:        strs[flagETHGenesis],
+		depositAmount:     strs[flagDepositAmount],
+		withdrawalAddress: strs[flagWithdrawalAddress],
+	}); err != nil {
+		return err
+	}
+
+	sup := newSupervisor()
+	defer sup.stop()
+	for _, node := range plan.Nodes {
+		if strs[flagEL] == elGeth {
+			if err = startGeth(ctx, sup, plan, node, el); err != nil {
+				return err
+			}
+		}
+		if err = startBeacond(sup, plan, node, beacond); err != nil {
+			return err
+		}
+		printNode(cmd, node)
+	}
+
+	cmd.Println("devnet is running, press Ctrl+C to stop")
+	return sup.wait(
+		ctx, localAddr("http://", plan.Nodes[0].RPCPort()),
+		func(height int64) { cmd.Printf("block height %d\n", height) },
+	)
+}
+
+// prepareDir makes sure the devnet directory is empty, removing it first if
+// overwrite is set.
+func prepareDir(dir string, overwrite bool) error {
+	entries, err := os.ReadDir(dir)
+	switch {
+	case os.IsNotExist(err):
+		return nil
+	case err != nil:
+		return err
+	case len(entries) == 0:
+		return nil
+	case !overwrite:
+		return errors.Wrapf(
+			ErrDirNotEmpty, "%s, pass --%s to replace it", dir, flagOverwrite,
+		)
+	default:
+		return os.RemoveAll(dir)
+	}
+}
+
+// startGeth initializes the data directory of the execution client of the
+// node and starts it.
+func startGeth(
+	ctx context.Context,
+	sup *supervisor,
+	plan *Plan,
+	node *Node,
+	geth *executable,
+) error {
+	if err := geth.run(
+		ctx, "init", "--datadir", node.ELDataDir, plan.ETHGenesisFile(),
+	); err != nil {
+		return err
+	}
+	return sup.start(
+		fmt.Sprintf("geth-%d", node.Index), node.LogFile(elGeth), geth,
+		"--datadir", node.ELDataDir,
+		"--port", fmt.Sprint(node.ELP2PPort()),
+		"--nodiscover",
+		"--syncmode", "full",
+		"--authrpc.addr", "127.0.0.1",
+		"--authrpc.port", fmt.Sprint(node.AuthRPCPort()),
+		"--authrpc.jwtsecret", plan.JWTSecretFile(),
+		"--http",
+		"--http.addr", "127.0.0.1",
+		"--http.port", fmt.Sprint(node.ELHTTPPort()),
+		"--http.api", "eth,net,web3,txpool",
+	)
+}
+
+// startBeacond starts the beacond node.
+func startBeacond(
+	sup *supervisor,
+	plan *Plan,
+	node *Node,
+	beacond *executable,
+) error {
+	return sup.start(
+		fmt.Sprintf("beacond-%d", node.Index), node.LogFile("beacond"),
+		beacond,
+		"start", "--home", node.Home,
+		"--address", localAddr("tcp://", node.ABCIPort()),
+		"--"+flags.JWTSecretPath, plan.JWTSecretFile(),
+		"--"+flags.RPCDialURL, localAddr("http://", node.AuthRPCPort()),
+		"--"+flags.NodeAPIEnabled,
+		"--"+flags.NodeAPIAddress, localAddr("", node.NodeAPIPort()),
+	)
+}
+
+// printNode prints the endpoints of the node.
+func printNode(cmd *cobra.Command, node *Node) {
+	cmd.Printf("%s (%s)\n", node.Moniker, node.ID)
+	cmd.Printf("  comet rpc:   %s\n", localAddr("http://", node.RPCPort()))
+	cmd.Printf("  node api:    %s\n", localAddr("http://", node.NodeAPIPort()))
+	cmd.Printf("  engine api:  %s\n", localAddr("http://", node.AuthRPCPort()))
+	cmd.Printf("  eth rpc:     %s\n", localAddr("http://", node.ELHTTPPort()))
+	cmd.Printf("  logs:        %s\n", filepath.Dir(node.LogFile("")))
+}
@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package devnet_test
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/berachain/beacon-kit/cli/commands/devnet"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPlan(t *testing.T) {
+	cfg := devnet.Config{
+		Validators: 3,
+		Dir:        t.TempDir(),
+		ChainID:    "beacond-2061",
+		BasePort:   30000,
+		Seed:       "seed",
+	}
+	plan, err := devnet.NewPlan(cfg)
+	require.NoError(t, err)
+	require.Len(t, plan.Nodes, 3)
+
+	ports := make(map[int]bool)
+	for i, node := range plan.Nodes {
+		require.Equal(t, i, node.Index)
+		for _, port := range []int{
+			node.P2PPort(), node.RPCPort(), node.ABCIPort(),
+			node.NodeAPIPort(), node.PrometheusPort(), node.AuthRPCPort(),
+			node.ELHTTPPort(), node.ELP2PPort(),
+		} {
+			require.False(t, ports[port], "port %d is reused", port)
+			ports[port] = true
+		}
+
+		peers := plan.PersistentPeers(node)
+		require.Len(t, strings.Split(peers, ","), 2)
+		require.NotContains(t, peers, node.ID)
+	}
+
+	// The plan, including node ids, only depends on the config.
+	again, err := devnet.NewPlan(cfg)
+	require.NoError(t, err)
+	require.Equal(t, plan, again)
+}
+
+func TestNewPlanErrors(t *testing.T) {
+	_, err := devnet.NewPlan(devnet.Config{BasePort: 30000})
+	require.ErrorIs(t, err, devnet.ErrNoValidators)
+
+	_, err = devnet.NewPlan(devnet.Config{Validators: 10, BasePort: 65500})
+	require.ErrorIs(t, err, devnet.ErrPortRange)
+}
+
+func TestDeterministicKeys(t *testing.T) {
+	// r is the order of the BLS12-381 scalar field.
+	r, ok := new(big.Int).SetString(
+		"73eda753299d7d483339d80809a1d80553bda402fffe5bfeffffffff00000001",
+		16,
+	)
+	require.True(t, ok)
+
+	seen := make(map[[32]byte]bool)
+	for i := range 16 {
+		secret := devnet.ValidatorSecret("seed", i)
+		require.Equal(t, secret, devnet.ValidatorSecret("seed", i))
+		require.False(t, seen[secret], "validator %d reuses a key", i)
+		seen[secret] = true
+
+		scalar := new(big.Int).SetBytes(secret[:])
+		require.Positive(t, scalar.Sign())
+		require.Negative(t, scalar.Cmp(r))
+	}
+	require.NotEqual(
+		t, devnet.ValidatorSecret("seed", 0), devnet.ValidatorSecret("other", 0),
+	)
+
+	require.Equal(
+		t, devnet.NodeKey("seed", 1).ID(), devnet.NodeKey("seed", 1).ID(),
+	)
+	require.NotEqual(
+		t, devnet.NodeKey("seed", 0).ID(), devnet.NodeKey("seed", 1).ID(),
+	)
+	require.Equal(t, devnet.JWTSecret("seed"), devnet.JWTSecret("seed"))
+	require.NotEqual(t, devnet.JWTSecret("seed"), devnet.JWTSecret("other"))
+}
+
+func TestUpCommandRejectsUnknownEL(t *testing.T) {
+	cmd := devnet.NewUpCommand()
+	cmd.SetArgs([]string{"--el", "anvil", "--dir", t.TempDir()})
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+	require.ErrorContains(t, cmd.Execute(), "unknown execution client")
+}
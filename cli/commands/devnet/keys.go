@@ -0,0 +1,112 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package devnet
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"math/big"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/net/jwt"
+	"github.com/cometbft/cometbft/crypto/bls12381"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/privval"
+)
+
+const (
+	// domainValidator separates validator key derivation from other keys.
+	domainValidator = "validator"
+	// domainNode separates p2p node key derivation from other keys.
+	domainNode = "node"
+	// domainJWT separates JWT secret derivation from other keys.
+	domainJWT = "jwt"
+)
+
+// blsCurveOrder is the order r of the BLS12-381 scalar field. Validator
+// secrets are reduced modulo r so that every derived secret is a valid key.
+//
+//nolint:gochecknoglobals // constant big.Int.
+var blsCurveOrder, _ = new(big.Int).SetString(
+	"73eda753299d7d483339d80809a1d80553bda402fffe5bfeffffffff00000001", 16,
+)
+
+// deriveSecret derives a 32 byte secret for the given domain and index from
+// the devnet seed.
+func deriveSecret(seed, domain string, index int) [32]byte {
+	h := sha256.New()
+	h.Write([]byte(seed))
+	h.Write([]byte{0})
+	h.Write([]byte(domain))
+	h.Write([]byte{0})
+	//#nosec:G115 // index is never negative.
+	h.Write(binary.BigEndian.AppendUint64(nil, uint64(index)))
+	var out [32]byte
+	copy(out[:], h.Sum(nil))
+	return out
+}
+
+// ValidatorSecret returns the BLS secret key of the validator at the given
+// index. The same seed and index always yield the same key.
+func ValidatorSecret(seed string, index int) [32]byte {
+	digest := deriveSecret(seed, domainValidator, index)
+	scalar := new(big.Int).SetBytes(digest[:])
+	scalar.Mod(scalar, blsCurveOrder)
+	if scalar.Sign() == 0 {
+		// Astronomically unlikely, but zero is not a valid secret key.
+		scalar.SetUint64(1)
+	}
+	var out [32]byte
+	scalar.FillBytes(out[:])
+	return out
+}
+
+// NodeKey returns the p2p node key of the node at the given index.
+func NodeKey(seed string, index int) *p2p.NodeKey {
+	secret := deriveSecret(seed, domainNode, index)
+	return &p2p.NodeKey{PrivKey: ed25519.GenPrivKeyFromSecret(secret[:])}
+}
+
+// JWTSecret returns the JWT secret shared between every consensus and
+// execution client of the devnet.
+func JWTSecret(seed string) *jwt.Secret {
+	secret := jwt.Secret(deriveSecret(seed, domainJWT, 0))
+	return &secret
+}
+
+// writeKeys writes the validator and node keys of the given node to its home
+// directory, where `beacond init` picks them up instead of generating new
+// ones.
+func writeKeys(seed string, node *Node) error {
+	secret := ValidatorSecret(seed, node.Index)
+	privKey, err := bls12381.NewPrivateKeyFromBytes(secret[:])
+	if err != nil {
+		return errors.Wrap(
+			err, "failed to create validator key, is beacond built with "+
+				"the bls12381 tag?",
+		)
+	}
+	privval.NewFilePV(
+		privKey, node.PrivValidatorKeyFile(), node.PrivValidatorStateFile(),
+	).Save()
+	return NodeKey(seed, node.Index).SaveAs(node.NodeKeyFile())
+}
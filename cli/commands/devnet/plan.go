@@ -0,0 +1,209 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package devnet
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/berachain/beacon-kit/errors"
+)
+
+// Port offsets of the services of a node, relative to the first port of the
+// node. Every node reserves portsPerNode consecutive ports.
+const (
+	offsetP2P = iota
+	offsetRPC
+	offsetABCI
+	offsetNodeAPI
+	offsetPrometheus
+	offsetAuthRPC
+	offsetELHTTP
+	offsetELP2P
+
+	portsPerNode = 10
+)
+
+const maxPort = 65535
+
+var (
+	// ErrNoValidators is returned when a devnet without validators is
+	// requested.
+	ErrNoValidators = errors.New("devnet needs at least one validator")
+	// ErrPortRange is returned when the ports of the devnet do not fit in
+	// the valid port range.
+	ErrPortRange = errors.New("devnet ports exceed the valid port range")
+)
+
+// Config is the configuration of a local devnet.
+type Config struct {
+	// Validators is the number of validator nodes.
+	Validators int
+	// Dir is the directory holding the files of every node.
+	Dir string
+	// ChainID is the chain id of the devnet.
+	ChainID string
+	// BasePort is the first port used by the devnet.
+	BasePort int
+	// Seed determines the keys of every node.
+	Seed string
+}
+
+// Node describes a single node of a local devnet.
+type Node struct {
+	// Index is the position of the node in the devnet.
+	Index int
+	// Moniker is the name of the node.
+	Moniker string
+	// Home is the home directory of beacond.
+	Home string
+	// ELDataDir is the data directory of the execution client.
+	ELDataDir string
+	// ID is the p2p node id derived from the node key.
+	ID string
+	// BasePort is the first port reserved by the node.
+	BasePort int
+}
+
+// Plan lays out the files, keys and ports of every node of a devnet. It is
+// fully determined by its Config.
+type Plan struct {
+	Config
+	// Nodes are the nodes of the devnet.
+	Nodes []*Node
+}
+
+// NewPlan creates the plan of the devnet described by cfg.
+func NewPlan(cfg Config) (*Plan, error) {
+	if cfg.Validators < 1 {
+		return nil, ErrNoValidators
+	}
+	if cfg.BasePort < 1 ||
+		cfg.BasePort+cfg.Validators*portsPerNode-1 > maxPort {
+		return nil, errors.Wrapf(
+			ErrPortRange, "base port %d with %d validators",
+			cfg.BasePort, cfg.Validators,
+		)
+	}
+
+	p := &Plan{Config: cfg, Nodes: make([]*Node, cfg.Validators)}
+	for i := range p.Nodes {
+		nodeDir := filepath.Join(cfg.Dir, fmt.Sprintf("node%d", i))
+		p.Nodes[i] = &Node{
+			Index:     i,
+			Moniker:   fmt.Sprintf("devnet-%d", i),
+			Home:      filepath.Join(nodeDir, "beacond"),
+			ELDataDir: filepath.Join(nodeDir, "geth"),
+			ID:        string(NodeKey(cfg.Seed, i).ID()),
+			BasePort:  cfg.BasePort + i*portsPerNode,
+		}
+	}
+	return p, nil
+}
+
+// JWTSecretFile is the path of the JWT secret shared by every node.
+func (p *Plan) JWTSecretFile() string {
+	return filepath.Join(p.Dir, "jwt.hex")
+}
+
+// ETHGenesisFile is the path of the execution genesis of the devnet.
+func (p *Plan) ETHGenesisFile() string {
+	return filepath.Join(p.Dir, "eth-genesis.json")
+}
+
+// PersistentPeers returns the peers the given node dials on startup, which
+// are all the other nodes of the devnet.
+func (p *Plan) PersistentPeers(node *Node) string {
+	peers := make([]string, 0, len(p.Nodes)-1)
+	for _, peer := range p.Nodes {
+		if peer.Index == node.Index {
+			continue
+		}
+		peers = append(
+			peers, fmt.Sprintf("%s@127.0.0.1:%d", peer.ID, peer.P2PPort()),
+		)
+	}
+	return strings.Join(peers, ",")
+}
+
+// P2PPort is the CometBFT p2p port of the node.
+func (n *Node) P2PPort() int { return n.BasePort + offsetP2P }
+
+// RPCPort is the CometBFT RPC port of the node.
+func (n *Node) RPCPort() int { return n.BasePort + offsetRPC }
+
+// ABCIPort is the ABCI listen port of the node.
+func (n *Node) ABCIPort() int { return n.BasePort + offsetABCI }
+
+// NodeAPIPort is the node API port of the node.
+func (n *Node) NodeAPIPort() int { return n.BasePort + offsetNodeAPI }
+
+// PrometheusPort is the metrics port of the node.
+func (n *Node) PrometheusPort() int { return n.BasePort + offsetPrometheus }
+
+// AuthRPCPort is the engine API port of the execution client of the node.
+func (n *Node) AuthRPCPort() int { return n.BasePort + offsetAuthRPC }
+
+// ELHTTPPort is the JSON-RPC port of the execution client of the node.
+func (n *Node) ELHTTPPort() int { return n.BasePort + offsetELHTTP }
+
+// ELP2PPort is the p2p port of the execution client of the node.
+func (n *Node) ELP2PPort() int { return n.BasePort + offsetELP2P }
+
+// ConfigDir is the directory of the beacond configuration files.
+func (n *Node) ConfigDir() string { return filepath.Join(n.Home, "config") }
+
+// GenesisFile is the path of the consensus genesis of the node.
+func (n *Node) GenesisFile() string {
+	return filepath.Join(n.ConfigDir(), "genesis.json")
+}
+
+// CometConfigFile is the path of the CometBFT config of the node.
+func (n *Node) CometConfigFile() string {
+	return filepath.Join(n.ConfigDir(), "config.toml")
+}
+
+// DepositsDir is the directory of the premined deposits of the node.
+func (n *Node) DepositsDir() string {
+	return filepath.Join(n.ConfigDir(), "premined-deposits")
+}
+
+// PrivValidatorKeyFile is the path of the validator key of the node.
+func (n *Node) PrivValidatorKeyFile() string {
+	return filepath.Join(n.ConfigDir(), "priv_validator_key.json")
+}
+
+// PrivValidatorStateFile is the path of the validator signing state of the
+// node.
+func (n *Node) PrivValidatorStateFile() string {
+	return filepath.Join(n.Home, "data", "priv_validator_state.json")
+}
+
+// NodeKeyFile is the path of the p2p node key of the node.
+func (n *Node) NodeKeyFile() string {
+	return filepath.Join(n.ConfigDir(), "node_key.json")
+}
+
+// LogFile is the path of the log file of the given process of the node.
+func (n *Node) LogFile(process string) string {
+	return filepath.Join(filepath.Dir(n.Home), process+".log")
+}
@@ -0,0 +1,196 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package devnet
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"strconv"
+	"time"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+)
+
+const (
+	// stopTimeout is how long a process may take to shut down after it was
+	// interrupted before it is killed.
+	stopTimeout = 15 * time.Second
+	// heightPollInterval is the interval at which the block height of the
+	// devnet is polled.
+	heightPollInterval = 2 * time.Second
+)
+
+// ErrProcessExited is returned when a devnet process exits while the devnet
+// is running.
+var ErrProcessExited = errors.New("devnet process exited")
+
+// process is a long running process of the devnet.
+type process struct {
+	name string
+	cmd  *exec.Cmd
+	log  *os.File
+	done chan struct{}
+	err  error
+}
+
+// supervisor starts the processes of the devnet and stops them together.
+type supervisor struct {
+	procs  []*process
+	exited chan *process
+}
+
+// newSupervisor creates a supervisor without any processes.
+func newSupervisor() *supervisor {
+	return &supervisor{exited: make(chan *process, 1)}
+}
+
+// start starts the binary with the given arguments and writes its output to
+// logPath.
+func (s *supervisor) start(
+	name, logPath string,
+	bin *executable,
+	args ...string,
+) error {
+	log, err := os.Create(logPath)
+	if err != nil {
+		return err
+	}
+	//#nosec:G204 // the binary and its arguments are built by the devnet.
+	cmd := exec.Command(bin.path, args...)
+	cmd.Env = bin.env
+	cmd.Stdout = log
+	cmd.Stderr = log
+	if err = cmd.Start(); err != nil {
+		log.Close()
+		return errors.Wrapf(err, "failed to start %s", name)
+	}
+
+	p := &process{name: name, cmd: cmd, log: log, done: make(chan struct{})}
+	s.procs = append(s.procs, p)
+	go func() {
+		p.err = cmd.Wait()
+		close(p.done)
+		select {
+		case s.exited <- p:
+		default:
+		}
+	}()
+	return nil
+}
+
+// stop interrupts every process in reverse start order and kills those that
+// do not exit within stopTimeout.
+func (s *supervisor) stop() {
+	for i := len(s.procs) - 1; i >= 0; i-- {
+		p := s.procs[i]
+		select {
+		case <-p.done:
+		default:
+			_ = p.cmd.Process.Signal(os.Interrupt)
+		}
+	}
+	deadline := time.After(stopTimeout)
+	for _, p := range s.procs {
+		select {
+		case <-p.done:
+		case <-deadline:
+			_ = p.cmd.Process.Kill()
+			<-p.done
+		}
+		p.log.Close()
+	}
+}
+
+// wait blocks until the context is done or a process exits, reporting every
+// new block height of the devnet through report.
+func (s *supervisor) wait(
+	ctx context.Context,
+	rpcURL string,
+	report func(height int64),
+) error {
+	ticker := time.NewTicker(heightPollInterval)
+	defer ticker.Stop()
+
+	client := &http.Client{Timeout: heightPollInterval}
+	var last int64
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case p := <-s.exited:
+			return errors.Wrapf(
+				ErrProcessExited, "%s: %v, see %s", p.name, p.err, p.log.Name(),
+			)
+		case <-ticker.C:
+			height, err := latestHeight(ctx, client, rpcURL)
+			if err != nil || height <= last {
+				// The node may still be starting up.
+				continue
+			}
+			last = height
+			report(height)
+		}
+	}
+}
+
+// latestHeight queries the CometBFT RPC at rpcURL for the latest block
+// height.
+func latestHeight(
+	ctx context.Context,
+	client *http.Client,
+	rpcURL string,
+) (int64, error) {
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodGet, rpcURL+"/status", http.NoBody,
+	)
+	if err != nil {
+		return 0, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	var status struct {
+		Result struct {
+			SyncInfo struct {
+				LatestBlockHeight string `json:"latest_block_height"`
+			} `json:"sync_info"`
+		} `json:"result"`
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, err
+	}
+	if err = json.Unmarshal(body, &status); err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(status.Result.SyncInfo.LatestBlockHeight, 10, 64)
+}
@@ -0,0 +1,234 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package devnet
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/berachain/beacon-kit/errors"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	"github.com/spf13/viper"
+)
+
+// consensusKeyAlgo is the consensus key algorithm of every devnet validator.
+const consensusKeyAlgo = "bls12_381"
+
+// executable runs a helper binary to completion with a fixed environment.
+type executable struct {
+	path string
+	env  []string
+}
+
+// run runs the executable with the given arguments and returns its combined
+// output as part of the error if it fails.
+func (b *executable) run(ctx context.Context, args ...string) error {
+	//#nosec:G204 // the binary and its arguments are built by the devnet.
+	cmd := exec.CommandContext(ctx, b.path, args...)
+	cmd.Env = b.env
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return errors.Wrapf(
+			err, "%s %s: %s", filepath.Base(b.path),
+			strings.Join(args, " "), strings.TrimSpace(string(out)),
+		)
+	}
+	return nil
+}
+
+// genesisOptions configures the genesis of a devnet.
+type genesisOptions struct {
+	// ethGenesis is the execution genesis the devnet starts from.
+	ethGenesis string
+	// depositAmount is the premined deposit of every validator in Gwei.
+	depositAmount string
+	// withdrawalAddress receives the withdrawals of every validator.
+	withdrawalAddress string
+}
+
+// setup writes the keys, configuration and genesis of every node of the
+// plan. The first node collects the premined deposits of all validators and
+// its genesis is then shared with the other nodes.
+func setup(
+	ctx context.Context,
+	p *Plan,
+	beacond *executable,
+	opts genesisOptions,
+) error {
+	if err := os.MkdirAll(p.Dir, 0o700); err != nil {
+		return err
+	}
+	if err := copyFile(opts.ethGenesis, p.ETHGenesisFile()); err != nil {
+		return err
+	}
+	if err := os.WriteFile(
+		p.JWTSecretFile(), []byte(JWTSecret(p.Seed).Hex()), 0o600,
+	); err != nil {
+		return err
+	}
+
+	for _, node := range p.Nodes {
+		if err := initNode(ctx, p, node, beacond, opts); err != nil {
+			return errors.Wrapf(err, "failed to set up %s", node.Moniker)
+		}
+	}
+
+	first := p.Nodes[0]
+	for _, node := range p.Nodes[1:] {
+		if err := copyDir(node.DepositsDir(), first.DepositsDir()); err != nil {
+			return err
+		}
+	}
+	if err := beacond.run(
+		ctx, "genesis", "collect-premined-deposits", "--home", first.Home,
+	); err != nil {
+		return err
+	}
+	if err := beacond.run(
+		ctx, "genesis", "execution-payload", p.ETHGenesisFile(),
+		"--home", first.Home,
+	); err != nil {
+		return err
+	}
+	for _, node := range p.Nodes[1:] {
+		if err := copyFile(first.GenesisFile(), node.GenesisFile()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// initNode initializes the home directory of a single node and creates its
+// premined deposit.
+func initNode(
+	ctx context.Context,
+	p *Plan,
+	node *Node,
+	beacond *executable,
+	opts genesisOptions,
+) error {
+	for _, dir := range []string{
+		node.ConfigDir(), filepath.Dir(node.PrivValidatorStateFile()),
+	} {
+		if err := os.MkdirAll(dir, 0o700); err != nil {
+			return err
+		}
+	}
+	if err := writeKeys(p.Seed, node); err != nil {
+		return err
+	}
+	if err := beacond.run(
+		ctx, "init", node.Moniker, "--chain-id", p.ChainID,
+		"--home", node.Home, "--consensus-key-algo", consensusKeyAlgo,
+	); err != nil {
+		return err
+	}
+	if err := configureComet(p, node); err != nil {
+		return err
+	}
+	return beacond.run(
+		ctx, "genesis", "add-premined-deposit", "--home", node.Home,
+		opts.depositAmount, opts.withdrawalAddress,
+	)
+}
+
+// configureComet rewrites the CometBFT config of the node so that it listens
+// on its own ports and dials every other node of the devnet.
+func configureComet(p *Plan, node *Node) error {
+	v := viper.New()
+	v.SetConfigFile(node.CometConfigFile())
+	if err := v.ReadInConfig(); err != nil {
+		return errors.Wrapf(err, "failed to read %s", node.CometConfigFile())
+	}
+	cfg := cmtcfg.DefaultConfig()
+	if err := v.Unmarshal(cfg); err != nil {
+		return err
+	}
+	cfg.SetRoot(node.Home)
+
+	cfg.Moniker = node.Moniker
+	cfg.ProxyApp = localAddr("tcp://", node.ABCIPort())
+	cfg.RPC.ListenAddress = localAddr("tcp://", node.RPCPort())
+	cfg.RPC.PprofListenAddress = ""
+	cfg.P2P.ListenAddress = localAddr("tcp://", node.P2PPort())
+	cfg.P2P.PersistentPeers = p.PersistentPeers(node)
+	// Every node of the devnet runs on the loopback interface.
+	cfg.P2P.AllowDuplicateIP = true
+	cfg.P2P.AddrBookStrict = false
+	cfg.Instrumentation.PrometheusListenAddr = localAddr(
+		"", node.PrometheusPort(),
+	)
+
+	cmtcfg.WriteConfigFile(node.CometConfigFile(), cfg)
+	return nil
+}
+
+// localAddr returns the loopback address of the given port.
+func localAddr(scheme string, port int) string {
+	return fmt.Sprintf("%s127.0.0.1:%d", scheme, port)
+}
+
+// copyFile copies the file at src to dst.
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	if _, err = io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+// copyDir copies the regular files of the src directory into dst.
+func copyDir(src, dst string) error {
+	entries, err := os.ReadDir(src)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(dst, 0o700); err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() {
+			continue
+		}
+		if err = copyFile(
+			filepath.Join(src, entry.Name()),
+			filepath.Join(dst, entry.Name()),
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
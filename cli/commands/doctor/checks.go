@@ -0,0 +1,279 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package doctor
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/berachain/beacon-kit/config"
+	"github.com/berachain/beacon-kit/config/features"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/node-core/components"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/net/jwt"
+	"github.com/spf13/viper"
+)
+
+const (
+	// MinFreeDiskSpace is the free disk space below which a warning is
+	// reported.
+	MinFreeDiskSpace = 50 << 30
+	// MaxClockSkew is the clock skew with the execution client above which
+	// a warning is reported.
+	MaxClockSkew = 2 * time.Second
+
+	// elRequestTimeout is the timeout of requests to the execution client.
+	elRequestTimeout = 5 * time.Second
+)
+
+// ErrUnexpectedResponse is returned when the execution client does not
+// answer eth_chainId as expected.
+var ErrUnexpectedResponse = errors.New("unexpected execution client response")
+
+// CheckConfigFiles checks that the node configuration files exist under the
+// given home directory.
+func CheckConfigFiles(home string) Finding {
+	f := Finding{Check: "config files"}
+	for _, name := range []string{"app.toml", "config.toml", "genesis.json"} {
+		path := filepath.Join(home, "config", name)
+		if _, err := os.Stat(path); err != nil {
+			f.Severity = SeverityError
+			f.Message = fmt.Sprintf("cannot read %s: %v", path, err)
+			f.Hint = "run 'beacond init' or point --home at the node directory"
+			return f
+		}
+	}
+	f.Severity = SeverityOK
+	f.Message = "app.toml, config.toml and genesis.json found"
+	return f
+}
+
+// CheckConfig checks that the beacon-kit configuration can be loaded from
+// the given viper instance and returns it.
+func CheckConfig(v *viper.Viper) (*config.Config, Finding) {
+	f := Finding{Check: "beacon-kit config"}
+	cfg, err := config.ReadConfigFromAppOpts(v)
+	if err != nil {
+		f.Severity = SeverityError
+		f.Message = err.Error()
+		f.Hint = "fix the [beacon-kit] section of app.toml"
+		return nil, f
+	}
+	if _, err = features.NewSet(cfg.Features); err != nil {
+		f.Severity = SeverityError
+		f.Message = err.Error()
+		f.Hint = "fix the [beacon-kit.features] section of app.toml"
+		return nil, f
+	}
+	if cfg.Engine.RPCDialURL == nil {
+		f.Severity = SeverityError
+		f.Message = "no execution client RPC URL configured"
+		f.Hint = "set beacon-kit.engine.rpc-dial-url in app.toml"
+		return nil, f
+	}
+	f.Severity = SeverityOK
+	f.Message = "configuration loaded"
+	return cfg, f
+}
+
+// CheckJWTSecret checks that the JWT secret at the given path is valid and
+// returns it.
+func CheckJWTSecret(path string) (*jwt.Secret, Finding) {
+	f := Finding{Check: "jwt secret"}
+	secret, err := components.LoadJWTFromFile(path)
+	if err != nil {
+		f.Severity = SeverityError
+		f.Message = fmt.Sprintf("invalid JWT secret at %s: %v", path, err)
+		f.Hint = "generate one with 'beacond jwt generate' and share it with " +
+			"the execution client"
+		return nil, f
+	}
+	f.Severity = SeverityOK
+	f.Message = "loaded from " + path
+	return secret, f
+}
+
+// CheckExecutionClient checks that the execution client at the given URL is
+// reachable with the JWT secret, that it runs the chain of the chain spec and
+// that its clock is in sync with the local one.
+func CheckExecutionClient(
+	ctx context.Context,
+	url string,
+	secret *jwt.Secret,
+	chainSpec common.ChainSpec,
+) []Finding {
+	connectivity := Finding{Check: "execution client"}
+	var chainID math.U64
+	date, err := callExecutionClient(ctx, url, secret, &chainID)
+	if err != nil {
+		connectivity.Severity = SeverityError
+		connectivity.Message = fmt.Sprintf("cannot reach %s: %v", url, err)
+		connectivity.Hint = "check the execution client is running, that " +
+			"rpc-dial-url points at its authenticated engine port and that " +
+			"both share the same JWT secret"
+		return []Finding{connectivity}
+	}
+	connectivity.Severity = SeverityOK
+	connectivity.Message = "reachable at " + url
+
+	network := Finding{Check: "network"}
+	if chainID.Unwrap() != chainSpec.DepositEth1ChainID() {
+		network.Severity = SeverityError
+		network.Message = fmt.Sprintf(
+			"execution client chain ID %d does not match chain spec %d",
+			chainID, chainSpec.DepositEth1ChainID(),
+		)
+		network.Hint = "run the execution client with the genesis of the " +
+			"network this node belongs to, or fix --network or CHAIN_SPEC"
+	} else {
+		network.Severity = SeverityOK
+		network.Message = fmt.Sprintf("chain ID %d", chainID)
+	}
+
+	return []Finding{connectivity, network, checkClockSkew(date)}
+}
+
+// checkClockSkew checks the difference between the local clock and the
+// given time reported by the execution client.
+func checkClockSkew(remote time.Time) Finding {
+	f := Finding{Check: "clock skew"}
+	if remote.IsZero() {
+		f.Severity = SeverityWarn
+		f.Message = "execution client did not report its time"
+		return f
+	}
+
+	skew := time.Since(remote).Truncate(time.Second)
+	if skew < 0 {
+		skew = -skew
+	}
+	if skew > MaxClockSkew {
+		f.Severity = SeverityWarn
+		f.Message = fmt.Sprintf(
+			"local clock is %s apart from the execution client", skew,
+		)
+		f.Hint = "synchronize the clocks of both hosts with NTP"
+		return f
+	}
+	f.Severity = SeverityOK
+	f.Message = "in sync with the execution client"
+	return f
+}
+
+// CheckDiskSpace checks the free disk space under the given directory.
+func CheckDiskSpace(dir string) Finding {
+	f := Finding{Check: "disk space"}
+	free, err := freeDiskSpace(dir)
+	if err != nil {
+		f.Severity = SeverityWarn
+		f.Message = fmt.Sprintf("cannot read free disk space: %v", err)
+		return f
+	}
+	if free < MinFreeDiskSpace {
+		f.Severity = SeverityWarn
+		f.Message = fmt.Sprintf("only %d GiB free under %s", free>>30, dir)
+		f.Hint = "free up space or enable pruning before the disk fills up"
+		return f
+	}
+	f.Severity = SeverityOK
+	f.Message = fmt.Sprintf("%d GiB free under %s", free>>30, dir)
+	return f
+}
+
+// callExecutionClient calls eth_chainId on the execution client and returns
+// the time reported by its HTTP server.
+func callExecutionClient(
+	ctx context.Context,
+	url string,
+	secret *jwt.Secret,
+	chainID *math.U64,
+) (time.Time, error) {
+	token, err := secret.BuildSignedToken()
+	if err != nil {
+		return time.Time{}, err
+	}
+	body, err := json.Marshal(map[string]any{
+		"jsonrpc": "2.0", "id": 1, "method": "eth_chainId", "params": []any{},
+	})
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, elRequestTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodPost, url, bytes.NewReader(body),
+	)
+	if err != nil {
+		return time.Time{}, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return time.Time{}, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return time.Time{}, errors.Wrapf(
+			ErrUnexpectedResponse, "status %s", resp.Status,
+		)
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return time.Time{}, err
+	}
+	var result struct {
+		Result *math.U64 `json:"result"`
+		Error  *struct {
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	if err = json.Unmarshal(respBody, &result); err != nil {
+		return time.Time{}, err
+	}
+	if result.Error != nil {
+		return time.Time{}, errors.Wrapf(
+			ErrUnexpectedResponse, "rpc error %q", result.Error.Message,
+		)
+	}
+	if result.Result == nil {
+		return time.Time{}, errors.Wrap(
+			ErrUnexpectedResponse, "empty eth_chainId result",
+		)
+	}
+	*chainID = *result.Result
+
+	// A missing or malformed date only disables the clock skew check.
+	date, _ := http.ParseTime(resp.Header.Get("Date"))
+	return date, nil
+}
@@ -0,0 +1,108 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package doctor
+
+import (
+	"github.com/berachain/beacon-kit/cli/context"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/spf13/cobra"
+)
+
+// ErrChecksFailed is returned when at least one check fails.
+var ErrChecksFailed = errors.New("doctor checks failed")
+
+// Severity is the severity of a finding.
+type Severity string
+
+const (
+	// SeverityOK marks a passing check.
+	SeverityOK Severity = "ok"
+	// SeverityWarn marks a check that passed with a caveat.
+	SeverityWarn Severity = "warn"
+	// SeverityError marks a failing check.
+	SeverityError Severity = "error"
+)
+
+// Finding is the outcome of a single check.
+type Finding struct {
+	// Check is the name of the check.
+	Check string `json:"check"`
+	// Severity is the severity of the finding.
+	Severity Severity `json:"severity"`
+	// Message describes the outcome of the check.
+	Message string `json:"message"`
+	// Hint is the action to take to address the finding, if any.
+	Hint string `json:"hint,omitempty"`
+}
+
+// NewDoctorCommand creates a new command that diagnoses the node setup.
+func NewDoctorCommand(chainSpec common.ChainSpec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "doctor",
+		Short: "Diagnoses common node misconfigurations",
+		Long: `This command validates the node configuration files and JWT
+secret, checks that the execution client is reachable and runs the same chain
+as the chain spec, and verifies free disk space and clock skew. Every finding
+is printed together with the action to take to address it.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cmtCfg := context.GetConfigFromCmd(cmd)
+			v := context.GetViperFromCmd(cmd)
+
+			findings := []Finding{CheckConfigFiles(cmtCfg.RootDir)}
+			cfg, finding := CheckConfig(v)
+			findings = append(findings, finding)
+			if cfg != nil {
+				secret, jwtFinding := CheckJWTSecret(cfg.Engine.JWTSecretPath)
+				findings = append(findings, jwtFinding)
+				if secret != nil {
+					findings = append(findings, CheckExecutionClient(
+						cmd.Context(), cfg.Engine.RPCDialURL.String(),
+						secret, chainSpec,
+					)...)
+				}
+			}
+			findings = append(findings, CheckDiskSpace(cmtCfg.RootDir))
+
+			return printFindings(cmd, findings)
+		},
+	}
+}
+
+// printFindings prints the findings and returns an error if any of them is
+// an error.
+func printFindings(cmd *cobra.Command, findings []Finding) error {
+	var failed int
+	for _, f := range findings {
+		cmd.Printf("[%-5s] %s: %s\n", f.Severity, f.Check, f.Message)
+		if f.Hint != "" {
+			cmd.Printf("        -> %s\n", f.Hint)
+		}
+		if f.Severity == SeverityError {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return errors.Wrapf(ErrChecksFailed, "%d of %d", failed, len(findings))
+	}
+	return nil
+}
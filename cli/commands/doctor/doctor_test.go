@@ -0,0 +1,135 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package doctor_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/cli/commands/doctor"
+	"github.com/berachain/beacon-kit/config/spec"
+	"github.com/berachain/beacon-kit/primitives/net/jwt"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckExecutionClient(t *testing.T) {
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+	secret, err := jwt.NewRandom()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		chainID    string
+		date       time.Time
+		severities []doctor.Severity
+	}{
+		{
+			name:    "healthy",
+			chainID: fmt.Sprintf("%#x", cs.DepositEth1ChainID()),
+			date:    time.Now(),
+			severities: []doctor.Severity{
+				doctor.SeverityOK, doctor.SeverityOK, doctor.SeverityOK,
+			},
+		},
+		{
+			name:    "wrong network and clock skew",
+			chainID: "0x1",
+			date:    time.Now().Add(-time.Minute),
+			severities: []doctor.Severity{
+				doctor.SeverityOK, doctor.SeverityError, doctor.SeverityWarn,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(
+				func(w http.ResponseWriter, r *http.Request) {
+					if !strings.HasPrefix(
+						r.Header.Get("Authorization"), "Bearer ",
+					) {
+						w.WriteHeader(http.StatusUnauthorized)
+						return
+					}
+					w.Header().Set("Date", tt.date.UTC().Format(http.TimeFormat))
+					_, _ = w.Write([]byte(
+						`{"jsonrpc":"2.0","id":1,"result":"` + tt.chainID + `"}`,
+					))
+				},
+			))
+			defer srv.Close()
+
+			findings := doctor.CheckExecutionClient(
+				context.Background(), srv.URL, secret, cs,
+			)
+			require.Len(t, findings, len(tt.severities))
+			for i, f := range findings {
+				require.Equal(t, tt.severities[i], f.Severity, f.Message)
+			}
+		})
+	}
+}
+
+func TestCheckExecutionClientUnreachable(t *testing.T) {
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+	secret, err := jwt.NewRandom()
+	require.NoError(t, err)
+
+	srv := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+		},
+	))
+	defer srv.Close()
+
+	findings := doctor.CheckExecutionClient(
+		context.Background(), srv.URL, secret, cs,
+	)
+	require.Len(t, findings, 1)
+	require.Equal(t, doctor.SeverityError, findings[0].Severity)
+	require.NotEmpty(t, findings[0].Hint)
+}
+
+func TestCheckJWTSecret(t *testing.T) {
+	dir := t.TempDir()
+	secret, err := jwt.NewRandom()
+	require.NoError(t, err)
+
+	valid := filepath.Join(dir, "jwt.hex")
+	require.NoError(t, os.WriteFile(valid, []byte(secret.Hex()), 0o600))
+	loaded, f := doctor.CheckJWTSecret(valid)
+	require.Equal(t, doctor.SeverityOK, f.Severity)
+	require.Equal(t, secret.Bytes(), loaded.Bytes())
+
+	invalid := filepath.Join(dir, "invalid.hex")
+	require.NoError(t, os.WriteFile(invalid, []byte("0x1234"), 0o600))
+	loaded, f = doctor.CheckJWTSecret(invalid)
+	require.Nil(t, loaded)
+	require.Equal(t, doctor.SeverityError, f.Severity)
+}
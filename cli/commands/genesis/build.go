@@ -0,0 +1,218 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package genesis
+
+import (
+	"path/filepath"
+
+	"github.com/berachain/beacon-kit/cli/context"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// depositsDirFlag is the flag for the premined deposits directory.
+	depositsDirFlag = "deposits-dir"
+	// beaconGenesisKey is the key of the beacon genesis in the app state.
+	beaconGenesisKey = "beacon"
+)
+
+// ErrNoGenesisValidators is returned when a genesis has no validators.
+var ErrNoGenesisValidators = errors.New("genesis has no validators")
+
+// BuildGenesisCmd returns the command that builds the beacon genesis from
+// the premined deposits and the eth1 genesis.
+func BuildGenesisCmd(chainSpec common.ChainSpec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "build [eth/genesis/file.json]",
+		Short: "builds and validates the beacon genesis",
+		Long: `Builds the beacon genesis from the premined deposits and the
+		genesis block of the given eth1 genesis file, runs the genesis state
+		transition over it and writes it to the genesis file. The resulting
+		validators, balances, Eth1Data and genesis validators root are
+		printed.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config := context.GetConfigFromCmd(cmd)
+
+			depositsDir, err := cmd.Flags().GetString(depositsDirFlag)
+			if err != nil {
+				return err
+			}
+			if depositsDir == "" {
+				depositsDir = filepath.Join(
+					config.RootDir, "config", "premined-deposits",
+				)
+			}
+
+			appGenesis, err := genutiltypes.AppGenesisFromFile(
+				config.GenesisFile(),
+			)
+			if err != nil {
+				return errors.Wrap(err, "failed to read genesis doc from file")
+			}
+
+			appGenesisState, err := genutiltypes.GenesisStateFromAppGenesis(
+				appGenesis,
+			)
+			if err != nil {
+				return err
+			}
+
+			genesisInfo := &BeaconGenesis{}
+			if err = json.Unmarshal(
+				appGenesisState[beaconGenesisKey], genesisInfo,
+			); err != nil {
+				return errors.Wrap(err, "failed to unmarshal beacon genesis")
+			}
+
+			deposits, err := CollectValidatorJSONFiles(depositsDir, appGenesis)
+			if err != nil {
+				return errors.Wrap(
+					err, "failed to collect validator json files",
+				)
+			}
+			for i, deposit := range deposits {
+				//#nosec:G701 // won't realistically overflow.
+				deposit.Index = uint64(i)
+			}
+			genesisInfo.Deposits = deposits
+
+			genesisInfo.ExecutionPayloadHeader, err =
+				executionPayloadHeaderFromFile(
+					chainSpec, args[0], genesisInfo.ForkVersion,
+				)
+			if err != nil {
+				return err
+			}
+
+			summary, err := verifyGenesis(chainSpec, genesisInfo)
+			if err != nil {
+				return err
+			}
+
+			appGenesisState[beaconGenesisKey], err = json.Marshal(genesisInfo)
+			if err != nil {
+				return errors.Wrap(err, "failed to marshal beacon genesis")
+			}
+
+			if appGenesis.AppState, err = json.MarshalIndent(
+				appGenesisState, "", "  ",
+			); err != nil {
+				return err
+			}
+
+			if err = genutil.ExportGenesisFile(
+				appGenesis, config.GenesisFile(),
+			); err != nil {
+				return err
+			}
+
+			return printSummary(cmd, summary)
+		},
+	}
+
+	cmd.Flags().String(
+		depositsDirFlag, "",
+		"directory of the premined deposits "+
+			"(defaults to <home>/config/premined-deposits)",
+	)
+
+	return cmd
+}
+
+// VerifyGenesisCmd returns the command that runs the genesis state
+// transition over the beacon genesis in the genesis file.
+func VerifyGenesisCmd(chainSpec common.ChainSpec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify",
+		Short: "verifies the beacon genesis in the genesis file",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			config := context.GetConfigFromCmd(cmd)
+
+			appGenesis, err := genutiltypes.AppGenesisFromFile(
+				config.GenesisFile(),
+			)
+			if err != nil {
+				return errors.Wrap(err, "failed to read genesis doc from file")
+			}
+
+			appGenesisState, err := genutiltypes.GenesisStateFromAppGenesis(
+				appGenesis,
+			)
+			if err != nil {
+				return err
+			}
+
+			genesisInfo := &BeaconGenesis{}
+			if err = json.Unmarshal(
+				appGenesisState[beaconGenesisKey], genesisInfo,
+			); err != nil {
+				return errors.Wrap(err, "failed to unmarshal beacon genesis")
+			}
+
+			summary, err := verifyGenesis(chainSpec, genesisInfo)
+			if err != nil {
+				return err
+			}
+
+			return printSummary(cmd, summary)
+		},
+	}
+
+	return cmd
+}
+
+// verifyGenesis processes the beacon genesis and ensures that it results in
+// a non-empty validator set.
+func verifyGenesis(
+	chainSpec common.ChainSpec,
+	genesisInfo *BeaconGenesis,
+) (*Summary, error) {
+	if genesisInfo.ExecutionPayloadHeader == nil {
+		return nil, errors.New("genesis has no execution payload header")
+	}
+
+	summary, err := ProcessGenesis(chainSpec, genesisInfo)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to process genesis")
+	}
+
+	if len(summary.Validators) == 0 {
+		return nil, ErrNoGenesisValidators
+	}
+	return summary, nil
+}
+
+// printSummary writes the genesis summary to the command output.
+func printSummary(cmd *cobra.Command, summary *Summary) error {
+	bz, err := json.MarshalIndent(summary, "", "  ")
+	if err != nil {
+		return err
+	}
+	cmd.Printf("%s\n", bz)
+	return nil
+}
@@ -90,7 +90,10 @@ func AddGenesisDepositCmd(cs common.ChainSpec) *cobra.Command {
 			)
 
 			// Get the withdrawal address.
-			withdrawalAddress := common.NewExecutionAddressFromHex(args[1])
+			withdrawalAddress, err := parser.ConvertWithdrawalAddress(args[1])
+			if err != nil {
+				return err
+			}
 
 			depositMsg, signature, err := types.CreateAndSignDepositMessage(
 				types.NewForkData(currentVersion, common.Root{}),
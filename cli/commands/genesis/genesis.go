@@ -46,6 +46,8 @@ func Commands(
 		CollectGenesisDepositsCmd(),
 		AddExecutionPayloadCmd(cs),
 		GetGenesisValidatorRootCmd(cs),
+		BuildGenesisCmd(cs),
+		VerifyGenesisCmd(cs),
 	)
 
 	// Add additional commands
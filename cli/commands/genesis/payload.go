@@ -46,26 +46,6 @@ func AddExecutionPayloadCmd(chainSpec common.ChainSpec) *cobra.Command {
 		Short: "adds the eth1 genesis execution payload to the genesis file",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			// Read the genesis file.
-			genesisBz, err := afero.ReadFile(afero.NewOsFs(), args[0])
-			if err != nil {
-				return errors.Wrap(err, "failed to read eth1 genesis file")
-			}
-
-			// Unmarshal the genesis file.
-			ethGenesis := &gethprimitives.Genesis{}
-			if err = ethGenesis.UnmarshalJSON(genesisBz); err != nil {
-				return errors.Wrap(err, "failed to unmarshal eth1 genesis")
-			}
-			genesisBlock := ethGenesis.ToBlock()
-
-			// Create the execution payload.
-			payload := gethprimitives.BlockToExecutableData(
-				genesisBlock,
-				nil,
-				nil,
-			).ExecutionPayload
-
 			config := context.GetConfigFromCmd(cmd)
 
 			appGenesis, err := genutiltypes.AppGenesisFromFile(
@@ -95,13 +75,11 @@ func AddExecutionPayloadCmd(chainSpec common.ChainSpec) *cobra.Command {
 
 			// Inject the execution payload.
 			genesisInfo.ExecutionPayloadHeader, err =
-				executableDataToExecutionPayloadHeader(
-					version.ToUint32(genesisInfo.ForkVersion),
-					payload,
-					chainSpec.MaxWithdrawalsPerPayload(),
+				executionPayloadHeaderFromFile(
+					chainSpec, args[0], genesisInfo.ForkVersion,
 				)
 			if err != nil {
-				return errors.Wrap(err, "failed to unmarshal beacon state")
+				return err
 			}
 
 			appGenesisState["beacon"], err = json.Marshal(genesisInfo)
@@ -122,6 +100,44 @@ func AddExecutionPayloadCmd(chainSpec common.ChainSpec) *cobra.Command {
 	return cmd
 }
 
+// executionPayloadHeaderFromFile reads the eth1 genesis file at the given
+// path and returns the execution payload header of its genesis block.
+func executionPayloadHeaderFromFile(
+	chainSpec common.ChainSpec,
+	path string,
+	forkVersion common.Version,
+) (*types.ExecutionPayloadHeader, error) {
+	// Read the genesis file.
+	genesisBz, err := afero.ReadFile(afero.NewOsFs(), path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read eth1 genesis file")
+	}
+
+	// Unmarshal the genesis file.
+	ethGenesis := &gethprimitives.Genesis{}
+	if err = ethGenesis.UnmarshalJSON(genesisBz); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal eth1 genesis")
+	}
+	genesisBlock := ethGenesis.ToBlock()
+
+	// Create the execution payload.
+	payload := gethprimitives.BlockToExecutableData(
+		genesisBlock,
+		nil,
+		nil,
+	).ExecutionPayload
+
+	header, err := executableDataToExecutionPayloadHeader(
+		version.ToUint32(forkVersion),
+		payload,
+		chainSpec.MaxWithdrawalsPerPayload(),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal beacon state")
+	}
+	return header, nil
+}
+
 // Converts the eth executable data type to the beacon execution payload header
 // interface.
 func executableDataToExecutionPayloadHeader(
@@ -0,0 +1,229 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package genesis
+
+import (
+	"context"
+
+	corestore "cosmossdk.io/core/store"
+	sdklog "cosmossdk.io/log"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/node-core/components"
+	"github.com/berachain/beacon-kit/node-core/components/signer"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/transition"
+	"github.com/berachain/beacon-kit/state-transition/core"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+	"github.com/berachain/beacon-kit/storage/beacondb"
+	"github.com/berachain/beacon-kit/storage/db"
+	depositstore "github.com/berachain/beacon-kit/storage/deposit"
+	"github.com/berachain/beacon-kit/storage/encoding"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type (
+	// BeaconGenesis is the beacon genesis stored in the app genesis.
+	BeaconGenesis = types.Genesis[
+		*types.Deposit, *types.ExecutionPayloadHeader,
+	]
+
+	genesisKVStore = beacondb.KVStore[
+		*types.BeaconBlockHeader,
+		*types.Eth1Data,
+		*types.ExecutionPayloadHeader,
+		*types.Fork,
+		*types.Validator,
+		types.Validators,
+	]
+
+	genesisBeaconState = statedb.StateDB[
+		*types.BeaconBlockHeader,
+		*types.BeaconState[
+			*types.BeaconBlockHeader,
+			*types.Eth1Data,
+			*types.ExecutionPayloadHeader,
+			*types.Fork,
+			*types.Validator,
+			types.BeaconBlockHeader,
+			types.Eth1Data,
+			types.ExecutionPayloadHeader,
+			types.Fork,
+			types.Validator,
+		],
+		*types.Eth1Data,
+		*types.ExecutionPayloadHeader,
+		*types.Fork,
+		*genesisKVStore,
+		*types.Validator,
+		types.Validators,
+		*engineprimitives.Withdrawal,
+		types.WithdrawalCredentials,
+	]
+)
+
+// Summary is the beacon state resulting from processing a genesis.
+type Summary struct {
+	// GenesisValidatorsRoot is the root of the genesis validator set.
+	GenesisValidatorsRoot common.Root `json:"genesis_validators_root"`
+	// Eth1Data is the genesis Eth1Data.
+	Eth1Data *types.Eth1Data `json:"eth1_data"`
+	// Validators are the genesis validators and their balances.
+	Validators []ValidatorSummary `json:"validators"`
+}
+
+// ValidatorSummary is a genesis validator together with its balance.
+type ValidatorSummary struct {
+	Pubkey           crypto.BLSPubkey `json:"pubkey"`
+	EffectiveBalance math.Gwei        `json:"effective_balance"`
+	Balance          math.Gwei        `json:"balance"`
+}
+
+// genesisStoreKey is the store key of the in-memory genesis state.
+//
+//nolint:gochecknoglobals // store key is a singleton.
+var genesisStoreKey = storetypes.NewKVStoreKey("genesis")
+
+// genesisKVStoreService opens the in-memory genesis store.
+type genesisKVStoreService struct {
+	ctx sdk.Context
+}
+
+// OpenKVStore returns the in-memory genesis store.
+func (kvs *genesisKVStoreService) OpenKVStore(
+	context.Context,
+) corestore.KVStore {
+	return components.NewKVStore(kvs.ctx.KVStore(genesisStoreKey))
+}
+
+// ProcessGenesis runs the genesis state transition over the given beacon
+// genesis on an in-memory state and returns the resulting state summary.
+func ProcessGenesis(
+	cs common.ChainSpec,
+	genesis *BeaconGenesis,
+) (*Summary, error) {
+	kvsp, err := newGenesisKVStoreService()
+	if err != nil {
+		return nil, err
+	}
+
+	kvStore := beacondb.New[
+		*types.BeaconBlockHeader,
+		*types.Eth1Data,
+		*types.ExecutionPayloadHeader,
+		*types.Fork,
+		*types.Validator,
+		types.Validators,
+	](kvsp, &encoding.SSZInterfaceCodec[*types.ExecutionPayloadHeader]{})
+	st := new(genesisBeaconState).NewFromDB(kvStore, cs)
+
+	sp, err := core.NewStateProcessor[
+		*genesisBeaconState, *transition.Context, *genesisKVStore,
+	](
+		core.WithForkSchedule(cs),
+		core.WithDepositStore(
+			depositstore.NewStore[*types.Deposit](
+				kvsp, sdklog.NewNopLogger(),
+			),
+		),
+		core.WithSigner(signer.BLSSigner{}),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = sp.InitializePreminedBeaconStateFromEth1(
+		st,
+		genesis.GetDeposits(),
+		genesis.GetExecutionPayloadHeader(),
+		genesis.GetForkVersion(),
+	); err != nil {
+		return nil, err
+	}
+
+	return summarize(st)
+}
+
+// newGenesisKVStoreService returns a store service backed by a memory db.
+func newGenesisKVStoreService() (*genesisKVStoreService, error) {
+	memDB, err := db.OpenDB("", dbm.MemDBBackend)
+	if err != nil {
+		return nil, err
+	}
+
+	nopLog := sdklog.NewNopLogger()
+	cms := store.NewCommitMultiStore(memDB, nopLog, metrics.NewNoOpMetrics())
+	cms.MountStoreWithDB(genesisStoreKey, storetypes.StoreTypeIAVL, nil)
+	if err = cms.LoadLatestVersion(); err != nil {
+		return nil, err
+	}
+
+	return &genesisKVStoreService{ctx: sdk.NewContext(cms, true, nopLog)}, nil
+}
+
+// summarize collects the genesis summary from the given state.
+func summarize(st *genesisBeaconState) (*Summary, error) {
+	root, err := st.GetGenesisValidatorsRoot()
+	if err != nil {
+		return nil, err
+	}
+
+	eth1Data, err := st.GetEth1Data()
+	if err != nil {
+		return nil, err
+	}
+
+	validators, err := st.GetValidators()
+	if err != nil {
+		return nil, err
+	}
+
+	balances, err := st.GetBalances()
+	if err != nil {
+		return nil, err
+	}
+
+	registry, err := types.NewValidatorRegistry(validators, balances)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &Summary{
+		GenesisValidatorsRoot: root,
+		Eth1Data:              eth1Data,
+		Validators:            make([]ValidatorSummary, registry.Len()),
+	}
+	for i := range summary.Validators {
+		idx := math.ValidatorIndex(i)
+		summary.Validators[i] = ValidatorSummary{
+			Pubkey:           registry.Pubkey(idx),
+			EffectiveBalance: registry.EffectiveBalance(idx),
+			Balance:          registry.Balance(idx),
+		}
+	}
+	return summary, nil
+}
@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+//go:build bls12381
+
+package genesis_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/cli/commands/genesis"
+	"github.com/berachain/beacon-kit/config/spec"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/node-core/components/signer"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessGenesis(t *testing.T) {
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+
+	genesisVersion := version.FromUint32[common.Version](version.Deneb)
+	amount := math.Gwei(cs.MaxEffectiveBalance())
+
+	var key signer.LegacyKey
+	key[len(key)-1] = 1
+	blsSigner, err := signer.NewLegacySigner(key)
+	require.NoError(t, err)
+
+	msg, sig, err := types.CreateAndSignDepositMessage(
+		types.NewForkData(genesisVersion, common.Root{}),
+		cs.DomainTypeDeposit(),
+		blsSigner,
+		types.WithdrawalCredentials{},
+		amount,
+	)
+	require.NoError(t, err)
+
+	blockHash := common.ExecutionHash{0x01}
+	summary, err := genesis.ProcessGenesis(cs, &genesis.BeaconGenesis{
+		ForkVersion: genesisVersion,
+		Deposits: []*types.Deposit{{
+			Pubkey:      msg.Pubkey,
+			Credentials: msg.Credentials,
+			Amount:      msg.Amount,
+			Signature:   sig,
+		}},
+		ExecutionPayloadHeader: &types.ExecutionPayloadHeader{
+			BlockHash: blockHash,
+		},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, summary.Validators, 1)
+	require.Equal(t, blsSigner.PublicKey(), summary.Validators[0].Pubkey)
+	require.Equal(t, amount, summary.Validators[0].Balance)
+	require.Equal(t, amount, summary.Validators[0].EffectiveBalance)
+	require.Equal(t, blockHash, summary.Eth1Data.BlockHash)
+	require.NotEqual(t, common.Root{}, summary.GenesisValidatorsRoot)
+}
@@ -28,6 +28,7 @@ import (
 	types "github.com/berachain/beacon-kit/cli/commands/server/types"
 	clicontext "github.com/berachain/beacon-kit/cli/context"
 	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/storage/db"
 	cmtcmd "github.com/cometbft/cometbft/cmd/cometbft/commands"
 	dbm "github.com/cosmos/cosmos-db"
@@ -35,7 +36,7 @@ import (
 )
 
 // NewRollbackCmd creates a command to rollback CometBFT and multistore state by
-// one height.
+// one height, or to a given height.
 func NewRollbackCmd[
 	T interface {
 		Start(context.Context) error
@@ -44,13 +45,17 @@ func NewRollbackCmd[
 	LoggerT log.AdvancedLogger[LoggerT],
 ](
 	appCreator types.AppCreator[T, LoggerT],
+	chainSpec common.ChainSpec,
 ) *cobra.Command {
-	var removeBlock bool
+	var (
+		removeBlock bool
+		height      int64
+	)
 
 	//nolint:lll // its okay.
 	cmd := &cobra.Command{
 		Use:   "rollback",
-		Short: "rollback Cosmos SDK and CometBFT state by one height",
+		Short: "rollback Cosmos SDK and CometBFT state by one height, or to --height",
 		Long: `
 A state rollback is performed to recover from an incorrect application state transition,
 when CometBFT has persisted an incorrect app hash and is thus unable to make
@@ -58,6 +63,13 @@ progress. Rollback overwrites a state at height n with the state at height n - 1
 The application also rolls back to height n - 1. No blocks are removed, so upon
 restarting CometBFT the transactions in block n will be re-executed against the
 application.
+
+With --height, the state is rolled back to the given height instead, together
+with the beacon stores: the deposits of the removed blocks are stored again
+and the payload headers finalized after the height are removed. The blocks
+after the height are removed, except the next one unless --hard is set, and
+are synced again from peers. The application state is rolled back last; if
+the rollback is interrupted, run it again with the same height to finish it.
 `,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			v := clicontext.GetViperFromCmd(cmd)
@@ -69,6 +81,22 @@ application.
 				return err
 			}
 			app := appCreator(logger, db, nil, cfg, v)
+			if height > 0 {
+				hash, err := rollbackToHeight(
+					cfg, chainSpec, logger, app.CommitMultiStore(),
+					height, removeBlock,
+				)
+				if err != nil {
+					return err
+				}
+				logger.Info(
+					"Rolled back state to height %d and hash %X\n",
+					height,
+					hash,
+				)
+				return nil
+			}
+
 			// rollback CometBFT state
 			height, hash, err := cmtcmd.RollbackState(cfg, removeBlock)
 			if err != nil {
@@ -91,5 +119,8 @@ application.
 
 	cmd.Flags().
 		BoolVar(&removeBlock, "hard", false, "remove last block as well as state")
+	cmd.Flags().Int64Var(
+		&height, "height", 0, "height to rollback to, instead of by one",
+	)
 	return cmd
 }
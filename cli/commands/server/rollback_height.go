@@ -0,0 +1,217 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package server
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"cosmossdk.io/store"
+	storev2 "cosmossdk.io/store/v2/db"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/consensus/cometbft/service/encoding"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/node-core/components/storage"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	depositstore "github.com/berachain/beacon-kit/storage/deposit"
+	"github.com/berachain/beacon-kit/storage/payloadheader"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	sm "github.com/cometbft/cometbft/state"
+	cmtstore "github.com/cometbft/cometbft/store"
+)
+
+const (
+	// depositsDBName is the name of the deposit store DB.
+	depositsDBName = "deposits"
+	// payloadHeadersDBName is the name of the payload header store DB.
+	payloadHeadersDBName = "payload_headers"
+	// beaconBlockTxIndex is the index of the beacon block in the block txs.
+	beaconBlockTxIndex = 0
+)
+
+// ErrInvalidRollbackHeight is returned when the node cannot be rolled back
+// to the requested height.
+var ErrInvalidRollbackHeight = errors.New("invalid rollback height")
+
+// rollbackToHeight rolls the beacon stores, CometBFT and the multistore
+// back to the given height. The blocks after height are removed, except the
+// one at height + 1 unless removeBlock is set, so that it is re-executed on
+// restart. The deposits of the removed blocks are stored again, as the
+// deposit store prunes deposits once they are included in a block.
+//
+// Every step can be repeated, and the multistore is rolled back last: a
+// rollback that is interrupted leaves the multistore ahead of CometBFT, and
+// running it again with the same height finishes it.
+func rollbackToHeight(
+	cfg *cmtcfg.Config,
+	chainSpec common.ChainSpec,
+	logger log.Logger,
+	cms store.CommitMultiStore,
+	height int64,
+	removeBlock bool,
+) ([]byte, error) {
+	blockDB, err := cmtcfg.DefaultDBProvider(
+		&cmtcfg.DBContext{ID: "blockstore", Config: cfg},
+	)
+	if err != nil {
+		return nil, err
+	}
+	blockStore := cmtstore.NewBlockStore(
+		blockDB, cmtstore.WithDBKeyLayout(cfg.Storage.ExperimentalKeyLayout),
+	)
+	defer blockStore.Close()
+
+	stateDB, err := cmtcfg.DefaultDBProvider(
+		&cmtcfg.DBContext{ID: "state", Config: cfg},
+	)
+	if err != nil {
+		return nil, err
+	}
+	stateStore := sm.NewStore(stateDB, sm.StoreOptions{
+		DiscardABCIResponses: cfg.Storage.DiscardABCIResponses,
+		DBKeyLayout:          cfg.Storage.ExperimentalKeyLayout,
+	})
+	defer stateStore.Close()
+
+	state, err := stateStore.Load()
+	if err != nil {
+		return nil, err
+	}
+	// Rolling back to a height needs the block following it, unless
+	// CometBFT was already rolled back by an interrupted rollback.
+	if height < 1 || height > state.LastBlockHeight ||
+		height < blockStore.Base() {
+		return nil, fmt.Errorf(
+			"%w: %d, CometBFT at height %d with blocks from %d",
+			ErrInvalidRollbackHeight,
+			height, state.LastBlockHeight, blockStore.Base(),
+		)
+	}
+
+	deposits, err := loadDeposits(
+		chainSpec, blockStore, height+1, blockStore.Height(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	dataDir := filepath.Join(cfg.RootDir, "data")
+	if err = restoreDeposits(dataDir, logger, deposits); err != nil {
+		return nil, fmt.Errorf("failed to restore deposits: %w", err)
+	}
+	//#nosec:G115 // height is positive.
+	if err = rollbackPayloadHeaders(dataDir, math.Slot(height)); err != nil {
+		return nil, fmt.Errorf("failed to rollback payload headers: %w", err)
+	}
+
+	// Every step rolls the state back by one height, or removes the block
+	// CometBFT stored without committing it.
+	hash := state.AppHash
+	for current := state.LastBlockHeight; current > height ||
+		(removeBlock && blockStore.Height() > height); {
+		remove := removeBlock || blockStore.Height() > height+1
+		if current, hash, err = sm.Rollback(
+			blockStore, stateStore, remove,
+		); err != nil {
+			return nil, fmt.Errorf(
+				"failed to rollback CometBFT state: %w", err,
+			)
+		}
+	}
+
+	if err = cms.RollbackToVersion(height); err != nil {
+		return nil, fmt.Errorf("failed to rollback to version: %w", err)
+	}
+	return hash, nil
+}
+
+// loadDeposits returns the deposits of the beacon blocks in the given range
+// of heights.
+func loadDeposits(
+	chainSpec common.ChainSpec,
+	blockStore *cmtstore.BlockStore,
+	from, to int64,
+) ([]*types.Deposit, error) {
+	var deposits []*types.Deposit
+	for height := from; height <= to; height++ {
+		blk, _ := blockStore.LoadBlock(height)
+		if blk == nil || len(blk.Txs) <= beaconBlockTxIndex {
+			continue
+		}
+		_, bz, err := encoding.Unwrap(blk.Txs[beaconBlockTxIndex])
+		if err != nil {
+			return nil, errors.Wrapf(err, "height %d", height)
+		}
+		//#nosec:G115 // height is positive.
+		beaconBlk, err := (&types.BeaconBlock{}).NewFromSSZ(
+			bz, chainSpec.ActiveForkVersionForSlot(math.Slot(height)),
+		)
+		if err != nil {
+			return nil, errors.Wrapf(
+				err, "failed to decode beacon block at height %d", height,
+			)
+		}
+		deposits = append(deposits, beaconBlk.GetBody().GetDeposits()...)
+	}
+	return deposits, nil
+}
+
+// restoreDeposits stores the given deposits again in the deposit store.
+func restoreDeposits(
+	dataDir string,
+	logger log.Logger,
+	deposits []*types.Deposit,
+) error {
+	if len(deposits) == 0 {
+		return nil
+	}
+	db, err := storev2.NewDB(
+		storev2.DBTypePebbleDB, depositsDBName, dataDir, nil,
+	)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	return depositstore.NewStore[*types.Deposit](
+		storage.NewKVStoreProvider(db), logger,
+	).EnqueueDeposits(deposits)
+}
+
+// rollbackPayloadHeaders removes the payload headers finalized after the
+// given slot, if the payload header store exists.
+func rollbackPayloadHeaders(dataDir string, slot math.Slot) error {
+	if _, err := os.Stat(
+		filepath.Join(dataDir, payloadHeadersDBName+storev2.DBFileSuffix),
+	); os.IsNotExist(err) {
+		return nil
+	}
+	db, err := storev2.NewDB(
+		storev2.DBTypePebbleDB, payloadHeadersDBName, dataDir, nil,
+	)
+	if err != nil {
+		return err
+	}
+	headers := payloadheader.NewStore[*types.ExecutionPayloadHeader](db)
+	defer headers.Close()
+	return headers.Rollback(slot)
+}
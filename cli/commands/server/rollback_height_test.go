@@ -0,0 +1,317 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package server_test
+
+import (
+	"errors"
+	"testing"
+
+	sdklog "cosmossdk.io/log"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	storev2 "cosmossdk.io/store/v2/db"
+	"github.com/berachain/beacon-kit/cli/commands/server"
+	"github.com/berachain/beacon-kit/config/spec"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/node-core/components/storage"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/storage/db"
+	depositstore "github.com/berachain/beacon-kit/storage/deposit"
+	"github.com/berachain/beacon-kit/storage/payloadheader"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	sm "github.com/cometbft/cometbft/state"
+	cmtstore "github.com/cometbft/cometbft/store"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+)
+
+const chainHeight = 5
+
+var (
+	errInterrupted = errors.New("interrupted")
+	testStoreKey   = storetypes.NewKVStoreKey("rollback-tests")
+)
+
+// interruptedStore fails to roll back, as if the rollback was interrupted
+// before the multistore.
+type interruptedStore struct {
+	store.CommitMultiStore
+}
+
+func (interruptedStore) RollbackToVersion(int64) error {
+	return errInterrupted
+}
+
+// appHash returns the app hash of the given height.
+func appHash(height int64) []byte {
+	return []byte{byte(height)}
+}
+
+// newCommit returns a commit of the given block that no validator signed.
+func newCommit(height int64, blockID cmttypes.BlockID) *cmttypes.Commit {
+	return &cmttypes.Commit{
+		Height:  height,
+		BlockID: blockID,
+		Signatures: []cmttypes.CommitSig{
+			{BlockIDFlag: cmttypes.BlockIDFlagAbsent},
+		},
+	}
+}
+
+// newChain stores chainHeight blocks, each carrying one deposit, together
+// with the CometBFT state, the multistore versions and the payload headers
+// of every height.
+func newChain(t *testing.T, cfg *cmtcfg.Config) store.CommitMultiStore {
+	t.Helper()
+	chainSpec, err := spec.BetnetChainSpec()
+	require.NoError(t, err)
+
+	blockDB, err := cmtcfg.DefaultDBProvider(
+		&cmtcfg.DBContext{ID: "blockstore", Config: cfg},
+	)
+	require.NoError(t, err)
+	blockStore := cmtstore.NewBlockStore(blockDB)
+	defer blockStore.Close()
+	stateDB, err := cmtcfg.DefaultDBProvider(
+		&cmtcfg.DBContext{ID: "state", Config: cfg},
+	)
+	require.NoError(t, err)
+	stateStore := sm.NewStore(stateDB, sm.StoreOptions{})
+	defer stateStore.Close()
+
+	headersDB, err := storev2.NewDB(
+		storev2.DBTypePebbleDB, "payload_headers", cfg.DBDir(), nil,
+	)
+	require.NoError(t, err)
+	headers := payloadheader.NewStore[*types.ExecutionPayloadHeader](
+		headersDB,
+	)
+	defer headers.Close()
+
+	memDB, err := db.OpenDB("", dbm.MemDBBackend)
+	require.NoError(t, err)
+	cms := store.NewCommitMultiStore(
+		memDB, sdklog.NewNopLogger(), metrics.NewNoOpMetrics(),
+	)
+	cms.MountStoreWithDB(testStoreKey, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+
+	valSet, _ := cmttypes.RandValidatorSet(1, 10)
+	state := sm.State{
+		Version:                          sm.InitStateVersion,
+		ChainID:                          "rollback-test",
+		InitialHeight:                    1,
+		Validators:                       valSet,
+		NextValidators:                   valSet,
+		LastValidators:                   cmttypes.NewValidatorSet(nil),
+		LastHeightValidatorsChanged:      1,
+		ConsensusParams:                  *cmttypes.DefaultConsensusParams(),
+		LastHeightConsensusParamsChanged: 1,
+		AppHash:                          appHash(0),
+	}
+	require.NoError(t, stateStore.Save(state))
+
+	for height := int64(1); height <= chainHeight; height++ {
+		//#nosec:G115 // height is positive.
+		slot := math.Slot(height)
+		forkVersion := chainSpec.ActiveForkVersionForSlot(slot)
+		beaconBlk, err := (&types.BeaconBlock{}).NewWithVersion(
+			slot, 0, common.Root{}, forkVersion,
+		)
+		require.NoError(t, err)
+		beaconBlk.Body = beaconBlk.Body.Empty(forkVersion)
+		beaconBlk.Body.ExecutionPayload.BaseFeePerGas = math.NewU256(0)
+		beaconBlk.Body.Deposits = []*types.Deposit{types.NewDeposit(
+			crypto.BLSPubkey{byte(height)},
+			types.WithdrawalCredentials{0x01},
+			math.Gwei(32e9),
+			crypto.BLSSignature{},
+			uint64(height-1),
+		)}
+		tx, err := beaconBlk.MarshalSSZ()
+		require.NoError(t, err)
+
+		block := state.MakeBlock(
+			height, cmttypes.Txs{tx},
+			newCommit(height-1, state.LastBlockID), nil,
+			valSet.Proposer.Address,
+		)
+		partSet, err := block.MakePartSet(cmttypes.BlockPartSizeBytes)
+		require.NoError(t, err)
+		state.LastBlockHeight = height
+		state.LastBlockID = cmttypes.BlockID{
+			Hash: block.Hash(), PartSetHeader: partSet.Header(),
+		}
+		blockStore.SaveBlock(
+			block, partSet, newCommit(height, state.LastBlockID),
+		)
+		state.LastBlockTime = block.Time
+		state.LastValidators = valSet
+		state.AppHash = appHash(height)
+		require.NoError(t, stateStore.Save(state))
+
+		cms.GetCommitKVStore(testStoreKey).Set([]byte{byte(height)}, tx)
+		cms.Commit()
+
+		header := new(types.ExecutionPayloadHeader).Empty()
+		header.Number = math.U64(height)
+		require.NoError(t, headers.Set(slot, header))
+	}
+	return cms
+}
+
+// requireRolledBack checks that the node was rolled back to the given height.
+func requireRolledBack(
+	t *testing.T,
+	cfg *cmtcfg.Config,
+	cms store.CommitMultiStore,
+	height int64,
+	blockHeight int64,
+) {
+	t.Helper()
+	require.Equal(t, height, cms.LastCommitID().Version)
+
+	blockDB, err := cmtcfg.DefaultDBProvider(
+		&cmtcfg.DBContext{ID: "blockstore", Config: cfg},
+	)
+	require.NoError(t, err)
+	blockStore := cmtstore.NewBlockStore(blockDB)
+	defer blockStore.Close()
+	require.Equal(t, blockHeight, blockStore.Height())
+	stateDB, err := cmtcfg.DefaultDBProvider(
+		&cmtcfg.DBContext{ID: "state", Config: cfg},
+	)
+	require.NoError(t, err)
+	stateStore := sm.NewStore(stateDB, sm.StoreOptions{})
+	defer stateStore.Close()
+	state, err := stateStore.Load()
+	require.NoError(t, err)
+	require.Equal(t, height, state.LastBlockHeight)
+	require.Equal(t, appHash(height), []byte(state.AppHash))
+
+	// The deposits of the blocks after height are stored again.
+	depositsDB, err := storev2.NewDB(
+		storev2.DBTypePebbleDB, "deposits", cfg.DBDir(), nil,
+	)
+	require.NoError(t, err)
+	defer depositsDB.Close()
+	//#nosec:G115 // height is positive.
+	deposits, err := depositstore.NewStore[*types.Deposit](
+		storage.NewKVStoreProvider(depositsDB), noop.NewLogger[any](),
+	).GetDepositsByIndex(uint64(height), chainHeight)
+	require.NoError(t, err)
+	require.Len(t, deposits, chainHeight-int(height))
+	for i, deposit := range deposits {
+		//#nosec:G115 // i is positive.
+		require.Equal(t, uint64(height)+uint64(i), deposit.GetIndex().Unwrap())
+	}
+
+	headersDB, err := storev2.NewDB(
+		storev2.DBTypePebbleDB, "payload_headers", cfg.DBDir(), nil,
+	)
+	require.NoError(t, err)
+	headers := payloadheader.NewStore[*types.ExecutionPayloadHeader](
+		headersDB,
+	)
+	defer headers.Close()
+	//#nosec:G115 // height is positive.
+	_, err = headers.HeaderAtSlot(math.Slot(height))
+	require.NoError(t, err)
+	//#nosec:G115 // height is positive.
+	_, err = headers.HeaderAtSlot(math.Slot(height + 1))
+	require.ErrorIs(t, err, payloadheader.ErrHeaderNotFound)
+}
+
+func TestRollbackToHeight(t *testing.T) {
+	chainSpec, err := spec.BetnetChainSpec()
+	require.NoError(t, err)
+	tests := []struct {
+		name        string
+		removeBlock bool
+		blockHeight int64
+	}{
+		{name: "keep next block", blockHeight: 3},
+		{name: "remove next block", removeBlock: true, blockHeight: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := cmtcfg.DefaultConfig().SetRoot(t.TempDir())
+			cms := newChain(t, cfg)
+
+			hash, err := server.RollbackToHeight(
+				cfg, chainSpec, noop.NewLogger[any](), cms, 2, tt.removeBlock,
+			)
+			require.NoError(t, err)
+			require.Equal(t, appHash(2), hash)
+			requireRolledBack(t, cfg, cms, 2, tt.blockHeight)
+		})
+	}
+}
+
+func TestRollbackToHeightResumes(t *testing.T) {
+	chainSpec, err := spec.BetnetChainSpec()
+	require.NoError(t, err)
+	cfg := cmtcfg.DefaultConfig().SetRoot(t.TempDir())
+	cms := newChain(t, cfg)
+
+	// The multistore is rolled back last, so an interrupted rollback leaves
+	// it ahead of CometBFT.
+	_, err = server.RollbackToHeight(
+		cfg, chainSpec, noop.NewLogger[any](), interruptedStore{cms}, 2, true,
+	)
+	require.ErrorIs(t, err, errInterrupted)
+	require.Equal(t, int64(chainHeight), cms.LastCommitID().Version)
+
+	// Running the rollback again with the same height finishes it.
+	hash, err := server.RollbackToHeight(
+		cfg, chainSpec, noop.NewLogger[any](), cms, 2, true,
+	)
+	require.NoError(t, err)
+	require.Equal(t, appHash(2), hash)
+	requireRolledBack(t, cfg, cms, 2, 2)
+
+	// Rolling back again is a no-op.
+	_, err = server.RollbackToHeight(
+		cfg, chainSpec, noop.NewLogger[any](), cms, 2, true,
+	)
+	require.NoError(t, err)
+	requireRolledBack(t, cfg, cms, 2, 2)
+}
+
+func TestRollbackToHeightInvalid(t *testing.T) {
+	chainSpec, err := spec.BetnetChainSpec()
+	require.NoError(t, err)
+	cfg := cmtcfg.DefaultConfig().SetRoot(t.TempDir())
+	cms := newChain(t, cfg)
+
+	for _, height := range []int64{0, chainHeight + 1} {
+		_, err = server.RollbackToHeight(
+			cfg, chainSpec, noop.NewLogger[any](), cms, height, false,
+		)
+		require.ErrorIs(t, err, server.ErrInvalidRollbackHeight)
+	}
+	require.Equal(t, int64(chainHeight), cms.LastCommitID().Version)
+}
@@ -39,6 +39,7 @@ const (
 	flagAddress         = "address"
 	flagTransport       = "transport"
 	FlagHaltHeight      = "halt-height"
+	FlagHaltEpoch       = "halt-epoch"
 	FlagHaltTime        = "halt-time"
 	FlagInterBlockCache = "inter-block-cache"
 
@@ -48,6 +49,10 @@ const (
 	FlagMinRetainBlocks     = "min-retain-blocks"
 	FlagIAVLCacheSize       = "iavl-cache-size"
 	FlagDisableIAVLFastNode = "iavl-disable-fastnode"
+
+	// State sync flags.
+	FlagStateSyncSnapshotInterval   = "state-sync.snapshot-interval"
+	FlagStateSyncSnapshotKeepRecent = "state-sync.snapshot-keep-recent"
 )
 
 // StartCmdOptions defines options that can be customized in
@@ -151,6 +156,10 @@ func addStartNodeFlags[
 		Uint64(
 			FlagHaltHeight,
 			0, "Block height at which to gracefully halt the chain and shutdown the node")
+	cmd.Flags().
+		Uint64(
+			FlagHaltEpoch,
+			0, "Epoch before which to gracefully halt the chain and shutdown the node")
 	cmd.Flags().
 		Uint64(
 			FlagHaltTime,
@@ -181,6 +190,16 @@ func addStartNodeFlags[
 			"Minimum block height offset during ABCI commit to prune CometBFT blocks")
 	cmd.Flags().
 		Bool(FlagDisableIAVLFastNode, false, "Disable fast node for IAVL tree")
+	cmd.Flags().
+		Uint64(
+			FlagStateSyncSnapshotInterval,
+			0,
+			"State sync snapshot interval (0 to disable)")
+	cmd.Flags().
+		Uint32(
+			FlagStateSyncSnapshotKeepRecent,
+			2,
+			"State sync snapshot to keep (0 to keep all)")
 
 	// add support for all CometBFT-specific command line options
 	cmtcmd.AddNodeFlags(cmd)
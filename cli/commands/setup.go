@@ -21,11 +21,18 @@
 package commands
 
 import (
+	"github.com/berachain/beacon-kit/cli/commands/audit"
+	"github.com/berachain/beacon-kit/cli/commands/db"
+	"github.com/berachain/beacon-kit/cli/commands/debug"
 	"github.com/berachain/beacon-kit/cli/commands/deposit"
+	"github.com/berachain/beacon-kit/cli/commands/devnet"
+	"github.com/berachain/beacon-kit/cli/commands/doctor"
 	"github.com/berachain/beacon-kit/cli/commands/genesis"
 	"github.com/berachain/beacon-kit/cli/commands/jwt"
 	"github.com/berachain/beacon-kit/cli/commands/server"
 	servertypes "github.com/berachain/beacon-kit/cli/commands/server/types"
+	"github.com/berachain/beacon-kit/cli/commands/spec"
+	"github.com/berachain/beacon-kit/cli/commands/status"
 	"github.com/berachain/beacon-kit/cli/flags"
 	cmtcli "github.com/berachain/beacon-kit/consensus/cometbft/cli"
 	cometbft "github.com/berachain/beacon-kit/consensus/cometbft/service"
@@ -48,26 +55,41 @@ func DefaultRootCommandSetup[
 	appCreator servertypes.AppCreator[T, LoggerT],
 	chainSpec common.ChainSpec,
 ) {
+	// Add the flags shared by all the commands.
+	flags.AddNetworkFlag(root.cmd)
+
 	// Add all the commands to the root command.
 	root.cmd.AddCommand(
+		// `audit`
+		audit.Commands(chainSpec),
 		// `comet`
 		cmtcli.Commands(appCreator),
 		// `init`
 		genutilcli.InitCmd(mm),
+		// `devnet`
+		devnet.Commands(),
+		// `doctor`
+		doctor.NewDoctorCommand(chainSpec),
 		// `genesis`
 		genesis.Commands(chainSpec),
+		// `db`
+		db.Commands(chainSpec),
+		// `debug`
+		debug.Commands(chainSpec),
 		// `deposit`
 		deposit.Commands[ExecutionPayloadT](chainSpec),
 		// `jwt`
 		jwt.Commands(),
 		// `rollback`
-		server.NewRollbackCmd(appCreator),
+		server.NewRollbackCmd(appCreator, chainSpec),
+		// `spec`
+		spec.Commands(chainSpec),
 		// `start`
 		server.StartCmdWithOptions(appCreator, server.StartCmdOptions[T]{
 			AddFlags: flags.AddBeaconKitFlags,
 		}),
 		// `status`
-		cmtcli.StatusCommand(),
+		status.NewStatusCommand(),
 		// `version`
 		version.NewVersionCommand(),
 	)
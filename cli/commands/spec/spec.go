@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package spec
+
+import (
+	"github.com/berachain/beacon-kit/config/spec"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagSlotsPerEpoch          = "slots-per-epoch"
+	flagHistoricalVectorLength = "historical-vector-length"
+	flagMinEpochsForBlobs      = "min-epochs-for-blobs"
+)
+
+// Commands creates a new command for inspecting the chain spec.
+func Commands(chainSpec common.ChainSpec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "spec",
+		Short:                      "Chain spec subcommands",
+		DisableFlagParsing:         false,
+		SuggestionsMinimumDistance: 2, //nolint:mnd // from sdk.
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewDumpCommand(chainSpec),
+		NewGenerateDevnetCommand(),
+	)
+
+	return cmd
+}
+
+// NewDumpCommand creates a new command that prints the effective chain spec.
+func NewDumpCommand(chainSpec common.ChainSpec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "dump",
+		Short: "Prints the effective chain spec as TOML",
+		Long: `This command prints every parameter of the chain spec the node
+would run with. The output can be saved and passed back to the node through
+the CHAIN_SPEC_FILE environment variable to run a custom network.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return spec.WriteTOML(cmd.OutOrStdout(), chainSpec.Data())
+		},
+	}
+}
+
+// NewGenerateDevnetCommand creates a new command that prints a devnet chain
+// spec with configurable epoch and state vector sizes.
+func NewGenerateDevnetCommand() *cobra.Command {
+	defaults := spec.FastDevnetOptions()
+	cmd := &cobra.Command{
+		Use:   "generate-devnet",
+		Short: "Generates a fast-epoch devnet chain spec as TOML",
+		Long: `This command prints a devnet chain spec with short epochs and
+small historical vectors, so that local networks and integration tests cross
+epoch boundaries within seconds. The output can be passed to the node through
+the CHAIN_SPEC_FILE environment variable.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			var (
+				opts spec.DevnetOptions
+				err  error
+			)
+			if opts.SlotsPerEpoch, err = cmd.Flags().GetUint64(
+				flagSlotsPerEpoch,
+			); err != nil {
+				return err
+			}
+			if opts.HistoricalVectorLength, err = cmd.Flags().GetUint64(
+				flagHistoricalVectorLength,
+			); err != nil {
+				return err
+			}
+			if opts.MinEpochsForBlobsSidecarsRequest, err = cmd.Flags().
+				GetUint64(flagMinEpochsForBlobs); err != nil {
+				return err
+			}
+
+			data, err := spec.GenerateDevnetSpecData(opts)
+			if err != nil {
+				return err
+			}
+			return spec.WriteTOML(cmd.OutOrStdout(), data)
+		},
+	}
+
+	cmd.Flags().Uint64(
+		flagSlotsPerEpoch, defaults.SlotsPerEpoch, "number of slots per epoch",
+	)
+	cmd.Flags().Uint64(
+		flagHistoricalVectorLength, defaults.HistoricalVectorLength,
+		"length of the historical roots, RANDAO and slashings vectors",
+	)
+	cmd.Flags().Uint64(
+		flagMinEpochsForBlobs, defaults.MinEpochsForBlobsSidecarsRequest,
+		"number of epochs blob sidecars are retained for",
+	)
+	return cmd
+}
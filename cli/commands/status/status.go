@@ -0,0 +1,248 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package status
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// flagNodeAPI is the flag for the node API address.
+	flagNodeAPI = "node-api"
+	// flagOutput is the flag for the output format.
+	flagOutput = "output"
+
+	// defaultNodeAPI is the default address of the node API.
+	defaultNodeAPI = "http://127.0.0.1:3500"
+	// defaultTimeout is the timeout of every node API request.
+	defaultTimeout = 5 * time.Second
+
+	outputText = "text"
+	outputJSON = "json"
+)
+
+// ErrUnexpectedStatusCode is returned when the node API responds with a
+// non-200 status code.
+var ErrUnexpectedStatusCode = errors.New("unexpected status code")
+
+// Status is the status of a running node.
+type Status struct {
+	// CurrentSlot is the slot of the head block.
+	CurrentSlot math.Slot `json:"current_slot"`
+	// HeadRoot is the root of the head block.
+	HeadRoot common.Root `json:"head_root"`
+	// FinalizedSlot is the slot of the finalized block.
+	FinalizedSlot math.Slot `json:"finalized_slot"`
+	// IsSyncing is true if the node is syncing.
+	IsSyncing bool `json:"is_syncing"`
+	// ELOffline is true if the execution client is offline.
+	ELOffline bool `json:"el_offline"`
+	// ValidatorCount is the number of validators in the head state.
+	ValidatorCount int `json:"validator_count"`
+}
+
+// NewStatusCommand creates a new command that prints the status of the
+// running node.
+func NewStatusCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Prints the status of the running node",
+		Long: `Queries the node API of a running node and prints the current
+slot, head root, finalized slot, execution client sync status and validator
+count. The node API must be enabled on the queried node.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			addr, err := cmd.Flags().GetString(flagNodeAPI)
+			if err != nil {
+				return err
+			}
+			output, err := cmd.Flags().GetString(flagOutput)
+			if err != nil {
+				return err
+			}
+
+			status, err := newClient(addr).status(cmd.Context())
+			if err != nil {
+				return err
+			}
+			return printStatus(cmd, status, output)
+		},
+	}
+
+	cmd.Flags().String(flagNodeAPI, defaultNodeAPI, "node API address")
+	cmd.Flags().StringP(
+		flagOutput, "o", outputText, "output format (text|json)",
+	)
+
+	return cmd
+}
+
+// printStatus writes the status to the command output in the given format.
+func printStatus(cmd *cobra.Command, status *Status, output string) error {
+	switch output {
+	case outputJSON:
+		bz, err := json.MarshalIndent(status, "", "  ")
+		if err != nil {
+			return err
+		}
+		cmd.Printf("%s\n", bz)
+	case outputText:
+		cmd.Printf("current slot:      %d\n", status.CurrentSlot)
+		cmd.Printf("head root:         %s\n", status.HeadRoot)
+		cmd.Printf("finalized slot:    %d\n", status.FinalizedSlot)
+		cmd.Printf("syncing:           %t\n", status.IsSyncing)
+		cmd.Printf("execution client:  %s\n", onlineString(!status.ELOffline))
+		cmd.Printf("validators:        %d\n", status.ValidatorCount)
+	default:
+		return fmt.Errorf("unknown output format %q", output)
+	}
+	return nil
+}
+
+// onlineString returns a human readable string for the online flag.
+func onlineString(online bool) string {
+	if online {
+		return "online"
+	}
+	return "offline"
+}
+
+// client queries the node API.
+type client struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+// newClient creates a new node API client for the given address.
+func newClient(addr string) *client {
+	if !strings.Contains(addr, "://") {
+		addr = "http://" + addr
+	}
+	return &client{
+		baseURL:    strings.TrimSuffix(addr, "/"),
+		httpClient: &http.Client{Timeout: defaultTimeout},
+	}
+}
+
+// status queries the node API for the status of the node.
+func (c *client) status(ctx context.Context) (*Status, error) {
+	status := &Status{}
+	head, err := c.header(ctx, "head")
+	if err != nil {
+		return nil, err
+	}
+	status.CurrentSlot = head.GetSlot()
+	status.HeadRoot = head.HashTreeRoot()
+
+	finalized, err := c.header(ctx, "finalized")
+	if err != nil {
+		return nil, err
+	}
+	status.FinalizedSlot = finalized.GetSlot()
+
+	var syncing struct {
+		Data struct {
+			IsSyncing bool `json:"is_syncing"`
+			ELOffline bool `json:"el_offline"`
+		} `json:"data"`
+	}
+	if err = c.get(ctx, "/eth/v1/node/syncing", &syncing); err != nil {
+		return nil, err
+	}
+	status.IsSyncing = syncing.Data.IsSyncing
+	status.ELOffline = syncing.Data.ELOffline
+
+	var validators struct {
+		Data []json.RawMessage `json:"data"`
+	}
+	if err = c.get(
+		ctx, "/eth/v1/beacon/states/head/validators", &validators,
+	); err != nil {
+		return nil, err
+	}
+	status.ValidatorCount = len(validators.Data)
+
+	return status, nil
+}
+
+// header queries the node API for the block header with the given id.
+func (c *client) header(
+	ctx context.Context,
+	blockID string,
+) (*types.BeaconBlockHeader, error) {
+	var resp struct {
+		Data struct {
+			Header struct {
+				Message *types.BeaconBlockHeader `json:"message"`
+			} `json:"header"`
+		} `json:"data"`
+	}
+	if err := c.get(
+		ctx, "/eth/v1/beacon/headers/"+blockID, &resp,
+	); err != nil {
+		return nil, err
+	}
+	if resp.Data.Header.Message == nil {
+		return nil, fmt.Errorf("missing %s block header", blockID)
+	}
+	return resp.Data.Header.Message, nil
+}
+
+// get performs a GET request against the node API and decodes the JSON
+// response into out.
+func (c *client) get(ctx context.Context, path string, out any) error {
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodGet, c.baseURL+path, http.NoBody,
+	)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "failed to query %s", path)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return errors.Wrapf(
+			ErrUnexpectedStatusCode, "%s: %d %s",
+			path, resp.StatusCode, strings.TrimSpace(string(body)),
+		)
+	}
+	return json.Unmarshal(body, out)
+}
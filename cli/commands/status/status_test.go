@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package status_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/berachain/beacon-kit/cli/commands/status"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatusCommand(t *testing.T) {
+	head := &types.BeaconBlockHeader{Slot: math.Slot(42)}
+	headerResp := map[string]any{
+		"data": map[string]any{
+			"header": map[string]any{"message": head},
+		},
+	}
+	responses := map[string]any{
+		"/eth/v1/beacon/headers/head":      headerResp,
+		"/eth/v1/beacon/headers/finalized": headerResp,
+		"/eth/v1/node/syncing": map[string]any{
+			"data": map[string]any{"is_syncing": false, "el_offline": true},
+		},
+		"/eth/v1/beacon/states/head/validators": map[string]any{
+			"data": []any{map[string]any{}, map[string]any{}},
+		},
+	}
+	srv := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			resp, ok := responses[r.URL.Path]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			bz, err := json.Marshal(resp)
+			require.NoError(t, err)
+			_, err = w.Write(bz)
+			require.NoError(t, err)
+		},
+	))
+	defer srv.Close()
+
+	cmd := status.NewStatusCommand()
+	out := new(bytes.Buffer)
+	cmd.SetOut(out)
+	cmd.SetArgs([]string{"--node-api", srv.URL, "--output", "json"})
+	require.NoError(t, cmd.Execute())
+
+	var got status.Status
+	require.NoError(t, json.Unmarshal(out.Bytes(), &got))
+	require.Equal(t, math.Slot(42), got.CurrentSlot)
+	require.Equal(t, math.Slot(42), got.FinalizedSlot)
+	require.Equal(t, head.HashTreeRoot(), got.HeadRoot)
+	require.True(t, got.ELOffline)
+	require.Equal(t, 2, got.ValidatorCount)
+}
+
+func TestStatusCommandNodeAPIError(t *testing.T) {
+	srv := httptest.NewServer(http.NotFoundHandler())
+	defer srv.Close()
+
+	cmd := status.NewStatusCommand()
+	cmd.SetOut(new(bytes.Buffer))
+	cmd.SetErr(new(bytes.Buffer))
+	cmd.SetArgs([]string{"--node-api", srv.URL})
+	require.ErrorIs(t, cmd.Execute(), status.ErrUnexpectedStatusCode)
+}
@@ -29,6 +29,8 @@ import (
 	"strings"
 
 	clicontext "github.com/berachain/beacon-kit/cli/context"
+	beaconflags "github.com/berachain/beacon-kit/cli/flags"
+	"github.com/berachain/beacon-kit/config/network"
 	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/log"
 	cmtcfg "github.com/cometbft/cometbft/config"
@@ -57,6 +59,12 @@ func SetupCommand[
 		return err
 	}
 
+	if err := applyNetworkBootstrap(
+		cmtConfig, beaconflags.SelectedNetwork(os.Args[1:]),
+	); err != nil {
+		return err
+	}
+
 	if err := handleConfigs(
 		clicontext.GetViperFromCmd(cmd),
 		appTemplate, appConfig, cmtConfig,
@@ -157,6 +165,26 @@ func bindFlags(
 
 // handleConfigs writes a new comet config file and app config file, and
 // merges them into the provided viper instance.
+// applyNetworkBootstrap sets the seeds and persistent peers of the network
+// with the given name, if any, in the default CometBFT config. They are only
+// written to new config files.
+func applyNetworkBootstrap(cmtConfig *cmtcfg.Config, name string) error {
+	if name == "" {
+		return nil
+	}
+	n, err := network.Get(name)
+	if err != nil {
+		return err
+	}
+	if cmtConfig.P2P.Seeds == "" {
+		cmtConfig.P2P.Seeds = strings.Join(n.Seeds, ",")
+	}
+	if cmtConfig.P2P.PersistentPeers == "" {
+		cmtConfig.P2P.PersistentPeers = strings.Join(n.PersistentPeers, ",")
+	}
+	return nil
+}
+
 func handleConfigs(
 	viper *viper.Viper,
 	customAppTemplate string,
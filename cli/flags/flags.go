@@ -21,11 +21,23 @@
 package flags
 
 import (
+	"io"
+	"os"
+	"strings"
+
 	"github.com/berachain/beacon-kit/config"
+	"github.com/berachain/beacon-kit/config/network"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 )
 
 const (
+	// Network selects one of the registered networks.
+	Network = "network"
+	// NetworkEnvVar selects one of the registered networks by name, unless
+	// the network flag is set.
+	NetworkEnvVar = "NETWORK"
+
 	// Beacon Kit Root Flag.
 	beaconKitRoot      = "beacon-kit."
 	BeaconKitAcceptTos = beaconKitRoot + "accept-tos"
@@ -66,14 +78,46 @@ const (
 	BlockStoreServiceEnabled            = blockStoreServiceRoot + "enabled"
 	BlockStoreServiceAvailabilityWindow = blockStoreServiceRoot +
 		"availability-window"
+	BlockStoreServiceBackfillURL = blockStoreServiceRoot + "backfill-url"
 
 	// Node API Config.
 	nodeAPIRoot    = beaconKitRoot + "node-api."
 	NodeAPIEnabled = nodeAPIRoot + "enabled"
 	NodeAPIAddress = nodeAPIRoot + "address"
 	NodeAPILogging = nodeAPIRoot + "logging"
+
+	// Chaos Config.
+	chaosRoot         = beaconKitRoot + "chaos."
+	ChaosScenarioFile = chaosRoot + "scenario-file"
 )
 
+// AddNetworkFlag adds the flag selecting the network to the given command and
+// all of its subcommands.
+func AddNetworkFlag(cmd *cobra.Command) {
+	cmd.PersistentFlags().String(
+		Network,
+		"",
+		"network to run, one of: "+strings.Join(network.Names(), ", "),
+	)
+}
+
+// SelectedNetwork returns the network selected by the network flag among the
+// given command line arguments or, if unset, by NetworkEnvVar. The chain spec
+// is resolved before the command line is parsed, so the flag is looked up
+// ahead of cobra.
+func SelectedNetwork(args []string) string {
+	fs := pflag.NewFlagSet(Network, pflag.ContinueOnError)
+	fs.ParseErrorsWhitelist.UnknownFlags = true
+	fs.SetOutput(io.Discard)
+	name := fs.String(Network, "", "")
+	// Errors only stem from flags other than the network flag.
+	_ = fs.Parse(args)
+	if *name != "" {
+		return *name
+	}
+	return os.Getenv(NetworkEnvVar)
+}
+
 // AddBeaconKitFlags implements servertypes.ModuleInitFlags interface.
 func AddBeaconKitFlags(startCmd *cobra.Command) {
 	defaultCfg := config.DefaultConfig()
@@ -141,6 +185,11 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 		defaultCfg.BlockStoreService.AvailabilityWindow,
 		"block service availability window",
 	)
+	startCmd.Flags().String(
+		BlockStoreServiceBackfillURL,
+		defaultCfg.BlockStoreService.BackfillURL,
+		"block service backfill url",
+	)
 	startCmd.Flags().Bool(
 		NodeAPIEnabled,
 		defaultCfg.NodeAPI.Enabled,
@@ -156,4 +205,9 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 		defaultCfg.NodeAPI.Logging,
 		"node api logging",
 	)
+	startCmd.Flags().String(
+		ChaosScenarioFile,
+		defaultCfg.Chaos.ScenarioFile,
+		"fault injection scenario file, requires the chaos build tag",
+	)
 }
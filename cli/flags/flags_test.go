@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package flags_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/cli/flags"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelectedNetwork(t *testing.T) {
+	t.Setenv(flags.NetworkEnvVar, "")
+	require.Empty(t, flags.SelectedNetwork([]string{"start"}))
+	require.Equal(t, "bepolia", flags.SelectedNetwork([]string{
+		"start", "--home", "/tmp/node", "--network", "bepolia", "-v",
+	}))
+	require.Equal(t, "mainnet", flags.SelectedNetwork([]string{
+		"--network=mainnet", "spec", "dump",
+	}))
+
+	// The flag takes precedence over the environment.
+	t.Setenv(flags.NetworkEnvVar, "devnet")
+	require.Equal(t, "devnet", flags.SelectedNetwork([]string{"start"}))
+	require.Equal(t, "mainnet", flags.SelectedNetwork([]string{
+		"start", "--network", "mainnet",
+	}))
+}
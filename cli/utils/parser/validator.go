@@ -48,20 +48,13 @@ func ConvertPubkey(pubkey string) (crypto.BLSPubkey, error) {
 
 // ConvertWithdrawalAddress converts a string to a withdrawal address.
 func ConvertWithdrawalAddress(address string) (common.ExecutionAddress, error) {
-	// Wrap the call in a recover to handle potential panics from invalid
-	// addresses.
-	var (
-		addr common.ExecutionAddress
-		err  error
-	)
-	defer func() {
-		if r := recover(); r != nil {
-			err = fmt.Errorf("invalid withdrawal address: %v", r)
-		}
-	}()
-
-	addr = common.NewExecutionAddressFromHex(address)
-	return addr, err
+	addr, err := common.ParseExecutionAddress(address)
+	if err != nil {
+		return common.ExecutionAddress{}, fmt.Errorf(
+			"invalid withdrawal address: %w", err,
+		)
+	}
+	return addr, nil
 }
 
 // ConvertWithdrawalCredentials converts a string to a withdrawal credentials.
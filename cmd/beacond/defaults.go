@@ -32,6 +32,7 @@ func DefaultComponents() []any {
 			*BlobSidecar, *BlobSidecars, *Deposit, *ExecutionPayloadHeader,
 			*Genesis, *Logger,
 		],
+		components.ProvideAlertManager[*Logger],
 		components.ProvideAttributesFactory[
 			*BeaconBlockHeader, *BeaconState, *BeaconStateMarshallable,
 			*ExecutionPayloadHeader, *KVStore, *Logger,
@@ -51,6 +52,7 @@ func DefaultComponents() []any {
 			*BeaconBlock, *BeaconBlockBody, *BeaconBlockHeader,
 			*BlockStore, *Logger,
 		],
+		components.ProvideBlockProfiler[*Logger],
 		components.ProvideBlsSigner,
 		components.ProvideBlobProcessor[
 			*AvailabilityStore, *BeaconBlockBody, *BeaconBlockHeader,
@@ -67,6 +69,7 @@ func DefaultComponents() []any {
 		],
 		components.ProvideNode,
 		components.ProvideChainSpec,
+		components.ProvideChaosInjector[*Logger],
 		components.ProvideConfig,
 		components.ProvideServerConfig,
 		// components.ProvideConsensusEngine[
@@ -87,6 +90,8 @@ func DefaultComponents() []any {
 			*DepositContract, *DepositStore, *ExecutionPayload,
 			*ExecutionPayloadHeader, *Logger,
 		],
+		components.ProvideDepositPreVerifier[*Logger],
+		components.ProvideDepositSignatures,
 		components.ProvideDepositStore[*Deposit, *Logger],
 		components.ProvideDispatcher[
 			*ConsensusBlock, *BeaconBlock,
@@ -96,18 +101,29 @@ func DefaultComponents() []any {
 		components.ProvideEngineClient[
 			*ExecutionPayload, *ExecutionPayloadHeader, *Logger,
 		],
+		components.ProvideEventStream[*Logger],
 		components.ProvideExecutionEngine[
 			*ExecutionPayload, *ExecutionPayloadHeader, *Logger,
 		],
+		components.ProvideFeatureFlags,
+		components.ProvideForkRehearsal[
+			*Logger, *BeaconState, *BeaconStateMarshallable, *KVStore,
+		],
+		components.ProvideHaltController[*Logger],
+		components.ProvideIndexerService[
+			*BeaconBlock, *BeaconBlockBody, *BeaconBlockHeader, *BeaconState,
+			*Deposit, *ExecutionPayload, *ExecutionPayloadHeader, *Logger,
+		],
 		components.ProvideJWTSecret,
 		components.ProvideLocalBuilder[
 			*BeaconBlockHeader, *BeaconState, *BeaconStateMarshallable,
 			*ExecutionPayload, *ExecutionPayloadHeader, *KVStore, *Logger,
 		],
+		components.ProvideProfilingService[*Logger],
 		components.ProvideReportingService[
 			*ExecutionPayload, *PayloadAttributes, *Logger,
 		],
-		components.ProvideCometBFTService[*Logger],
+		components.ProvideCometBFTService[*DepositStore, *Logger],
 		components.ProvideServiceRegistry[
 			*AvailabilityStore,
 			*ConsensusBlock, *BeaconBlock, *BeaconBlockBody,
@@ -121,16 +137,25 @@ func DefaultComponents() []any {
 		components.ProvideSidecarFactory[
 			*BeaconBlock, *BeaconBlockBody, *BeaconBlockHeader,
 		],
+		components.ProvideSigningLease,
+		components.ProvideSlotClock,
+		components.ProvideSlotClockService[
+			*BeaconBlock, *BeaconBlockBody, *BeaconBlockHeader, *Logger,
+		],
 		components.ProvideStateProcessor[
-			*Logger, *BeaconBlock, *BeaconBlockBody, *BeaconBlockHeader,
-			*BeaconState, *BeaconStateMarshallable, *Deposit, *DepositStore,
-			*ExecutionPayload, *ExecutionPayloadHeader, *KVStore,
+			*Logger, *BeaconState, *BeaconStateMarshallable, *KVStore,
 		],
 		components.ProvideKVStore[*BeaconBlockHeader, *ExecutionPayloadHeader],
 		components.ProvideStorageBackend[
 			*AvailabilityStore, *BlockStore, *BeaconState,
 			*KVStore, *DepositStore,
 		],
+		components.ProvideOTLPService[*Logger],
+		components.ProvideOTLPSink,
+		components.ProvidePrometheusService[*Logger],
+		components.ProvidePrometheusSink,
+		components.ProvideStatsDService[*Logger],
+		components.ProvideStatsDSink,
 		components.ProvideTelemetrySink,
 		components.ProvideTelemetryService,
 		components.ProvideTrustedSetup,
@@ -138,16 +163,32 @@ func DefaultComponents() []any {
 			*AvailabilityStore, *BeaconBlock, *BeaconBlockBody,
 			*BeaconBlockHeader, *BeaconState, *BeaconStateMarshallable,
 			*BlockStore, *BlobSidecars, *Deposit, *DepositStore,
-			*ExecutionPayload, *ExecutionPayloadHeader, *KVStore, *Logger,
+			*EngineClient, *ExecutionPayload, *ExecutionPayloadHeader, *KVStore, *Logger,
 			*StorageBackend,
 		],
+		components.ProvideValidatorSetExporter[*Logger],
+		components.ProvideVoteExtensionHandler[
+			*EngineClient, *KVStore, *Logger,
+		],
+		components.ProvideWithdrawalStore,
+		components.ProvideWithdrawalStoreService[
+			*BeaconBlock, *BeaconBlockBody, *BeaconBlockHeader, *Deposit,
+			*ExecutionPayload, *ExecutionPayloadHeader, *Logger,
+		],
+		components.ProvidePayloadHeaderStore[*ExecutionPayloadHeader],
+		components.ProvidePayloadHeaderStoreService[
+			*BeaconBlock, *BeaconBlockBody, *BeaconBlockHeader, *Deposit,
+			*ExecutionPayload, *ExecutionPayloadHeader, *Logger,
+		],
 		// TODO Hacks
 		components.ProvideKVStoreService,
 		components.ProvideKVStoreKey,
 	}
 	c = append(c,
 		components.ProvideNodeAPIServer[*Logger, NodeAPIContext],
-		components.ProvideNodeAPIEngine,
+		components.ProvideNodeAPIEngine[
+			*BeaconBlockHeader, *BeaconState, *CometBFTService,
+		],
 		components.ProvideNodeAPIBackend[
 			*AvailabilityStore, *BeaconBlock, *BeaconBlockBody,
 			*BeaconBlockHeader, *BlockStore, *BeaconState,
@@ -157,9 +198,11 @@ func DefaultComponents() []any {
 	)
 
 	c = append(c,
+		components.ProvideNodeIdentity,
 		components.ProvideNodeAPIHandlers[
-			*BeaconBlockHeader, *BeaconState, *BeaconStateMarshallable,
-			*ExecutionPayloadHeader, *KVStore, NodeAPIContext,
+			*BeaconBlock, *BeaconBlockHeader, *BeaconState, *BeaconStateMarshallable,
+			*BlindedBeaconBlock, *ExecutionPayload, *ExecutionPayloadHeader,
+			*KVStore, NodeAPIContext,
 		],
 		components.ProvideNodeAPIBeaconHandler[
 			*BeaconBlockHeader, *BeaconState, *CometBFTService, NodeAPIContext,
@@ -167,12 +210,21 @@ func DefaultComponents() []any {
 		components.ProvideNodeAPIBuilderHandler[NodeAPIContext],
 		components.ProvideNodeAPIConfigHandler[NodeAPIContext],
 		components.ProvideNodeAPIDebugHandler[NodeAPIContext],
+		components.ProvideNodeAPIDepositsHandler[
+			*BeaconBlockHeader, *BeaconState, *CometBFTService, NodeAPIContext,
+		],
 		components.ProvideNodeAPIEventsHandler[NodeAPIContext],
-		components.ProvideNodeAPINodeHandler[NodeAPIContext],
+		components.ProvideNodeAPINodeHandler[
+			NodeAPIContext, *BeaconBlock, *BeaconBlockBody, *BeaconBlockHeader,
+		],
 		components.ProvideNodeAPIProofHandler[
 			*BeaconBlockHeader, *BeaconState, *BeaconStateMarshallable,
 			*ExecutionPayloadHeader, *KVStore, *CometBFTService, NodeAPIContext,
 		],
+		components.ProvideNodeAPIValidatorHandler[
+			*BeaconBlock, *BlindedBeaconBlock, *ExecutionPayload, NodeAPIContext,
+		],
+		components.ProvideNodeAPIWithdrawalsHandler[NodeAPIContext],
 	)
 
 	return c
@@ -39,7 +39,9 @@ import (
 	"github.com/berachain/beacon-kit/node-api/backend"
 	blockstore "github.com/berachain/beacon-kit/node-api/block_store"
 	"github.com/berachain/beacon-kit/node-api/engines/echo"
+	"github.com/berachain/beacon-kit/node-api/indexer"
 	"github.com/berachain/beacon-kit/node-api/server"
+	withdrawalstore "github.com/berachain/beacon-kit/node-api/withdrawal_store"
 	"github.com/berachain/beacon-kit/node-core/components/signer"
 	"github.com/berachain/beacon-kit/node-core/components/storage"
 	"github.com/berachain/beacon-kit/node-core/services/version"
@@ -53,6 +55,7 @@ import (
 	depositdb "github.com/berachain/beacon-kit/storage/deposit"
 	"github.com/berachain/beacon-kit/storage/filedb"
 	"github.com/berachain/beacon-kit/storage/manager"
+	"github.com/berachain/beacon-kit/storage/payloadheader"
 	"github.com/berachain/beacon-kit/storage/pruner"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -132,6 +135,41 @@ type (
 		engineprimitives.Withdrawals,
 	]
 
+	// IndexerService is a type alias for the block explorer indexer service.
+	IndexerService = indexer.Service[
+		*BeaconBlock,
+		*BeaconBlockBody,
+		*BeaconState,
+		*Deposit,
+		*ExecutionPayload,
+		*Withdrawal,
+		Withdrawals,
+		WithdrawalCredentials,
+	]
+
+	// WithdrawalStoreService is a type alias for the withdrawal receipts
+	// service.
+	WithdrawalStoreService = withdrawalstore.Service[
+		*BeaconBlock,
+		*BeaconBlockBody,
+		*ExecutionPayload,
+		*Withdrawal,
+		Withdrawals,
+	]
+
+	// PayloadHeaderStore is a type alias for the execution payload header
+	// store.
+	PayloadHeaderStore = payloadheader.Store[*ExecutionPayloadHeader]
+
+	// PayloadHeaderStoreService is a type alias for the execution payload
+	// header service.
+	PayloadHeaderStoreService = payloadheader.Service[
+		*BeaconBlock,
+		*BeaconBlockBody,
+		*ExecutionPayload,
+		*ExecutionPayloadHeader,
+	]
+
 	// IndexDB is a type alias for the range DB.
 	IndexDB = filedb.RangeDB
 
@@ -176,23 +214,7 @@ type (
 
 	// StateProcessor is the type alias for the state processor interface.
 	StateProcessor = core.StateProcessor[
-		*BeaconBlock,
-		*BeaconBlockBody,
-		*BeaconBlockHeader,
-		*BeaconState,
-		*Context,
-		*Deposit,
-		*Eth1Data,
-		*ExecutionPayload,
-		*ExecutionPayloadHeader,
-		*Fork,
-		*ForkData,
-		*KVStore,
-		*Validator,
-		Validators,
-		*Withdrawal,
-		Withdrawals,
-		WithdrawalCredentials,
+		*BeaconState, *Context, *KVStore,
 	]
 
 	// StorageBackend is the type alias for the storage backend interface.
@@ -239,6 +261,9 @@ type (
 	BeaconBlockBody   = types.BeaconBlockBody
 	BeaconBlockHeader = types.BeaconBlockHeader
 
+	// BlindedBeaconBlock is a type alias for the blinded beacon block.
+	BlindedBeaconBlock = types.BlindedBeaconBlock
+
 	// BeaconState is a type alias for the BeaconState.
 	BeaconState = statedb.StateDB[
 		*BeaconBlockHeader,
@@ -21,16 +21,31 @@
 package config
 
 import (
+	"github.com/berachain/beacon-kit/beacon/blockchain"
+	"github.com/berachain/beacon-kit/beacon/clock"
 	"github.com/berachain/beacon-kit/beacon/validator"
+	"github.com/berachain/beacon-kit/config/features"
 	"github.com/berachain/beacon-kit/config/template"
 	viperlib "github.com/berachain/beacon-kit/config/viper"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/consensus/cometbft/service/voteext"
 	"github.com/berachain/beacon-kit/da/kzg"
 	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/execution/chaos"
 	engineclient "github.com/berachain/beacon-kit/execution/client"
 	log "github.com/berachain/beacon-kit/log/phuslu"
 	blockstore "github.com/berachain/beacon-kit/node-api/block_store"
+	"github.com/berachain/beacon-kit/node-api/indexer"
 	"github.com/berachain/beacon-kit/node-api/server"
+	"github.com/berachain/beacon-kit/node-api/valset"
+	withdrawalstore "github.com/berachain/beacon-kit/node-api/withdrawal_store"
+	"github.com/berachain/beacon-kit/observability/alerts"
+	"github.com/berachain/beacon-kit/observability/otlp"
+	"github.com/berachain/beacon-kit/observability/profiling"
+	"github.com/berachain/beacon-kit/observability/prometheus"
+	"github.com/berachain/beacon-kit/observability/statsd"
 	"github.com/berachain/beacon-kit/payload/builder"
+	"github.com/berachain/beacon-kit/storage/payloadheader"
 	"github.com/mitchellh/mapstructure"
 	"github.com/spf13/viper"
 )
@@ -43,13 +58,28 @@ type AppOptions interface {
 // DefaultConfig returns the default configuration for a BeaconKit chain.
 func DefaultConfig() *Config {
 	return &Config{
-		Engine:            engineclient.DefaultConfig(),
-		Logger:            log.DefaultConfig(),
-		KZG:               kzg.DefaultConfig(),
-		PayloadBuilder:    builder.DefaultConfig(),
-		Validator:         validator.DefaultConfig(),
-		BlockStoreService: blockstore.DefaultConfig(),
-		NodeAPI:           server.DefaultConfig(),
+		Engine:             engineclient.DefaultConfig(),
+		Logger:             log.DefaultConfig(),
+		KZG:                kzg.DefaultConfig(),
+		PayloadBuilder:     builder.DefaultConfig(),
+		Validator:          validator.DefaultConfig(),
+		BlockStoreService:  blockstore.DefaultConfig(),
+		Indexer:            indexer.DefaultConfig(),
+		ValidatorSetExport: valset.DefaultConfig(),
+		WithdrawalStore:    withdrawalstore.DefaultConfig(),
+		PayloadHeaderStore: payloadheader.DefaultConfig(),
+		NodeAPI:            server.DefaultConfig(),
+		ForkRehearsal:      blockchain.DefaultRehearsalConfig(),
+		Features:           features.DefaultConfig(),
+		Prometheus:         prometheus.DefaultConfig(),
+		StatsD:             statsd.DefaultConfig(),
+		OTLP:               otlp.DefaultConfig(),
+		Alerts:             alerts.DefaultConfig(),
+		SlotClock:          clock.DefaultConfig(),
+		Profiling:          profiling.DefaultConfig(),
+		VoteExtensions:     voteext.DefaultConfig(),
+		StateHash:          types.DefaultStateHashConfig(),
+		Chaos:              chaos.DefaultConfig(),
 	}
 }
 
@@ -67,8 +97,44 @@ type Config struct {
 	Validator validator.Config `mapstructure:"validator"`
 	// BlockStoreService is the configuration for the block store service.
 	BlockStoreService blockstore.Config `mapstructure:"block-store-service"`
+	// Indexer is the configuration for the block explorer indexer.
+	Indexer indexer.Config `mapstructure:"indexer"`
+	// ValidatorSetExport is the configuration for the export of the
+	// validator set updates.
+	ValidatorSetExport valset.Config `mapstructure:"validator-set-export"`
+	// WithdrawalStore is the configuration for the withdrawal receipts index.
+	WithdrawalStore withdrawalstore.Config `mapstructure:"withdrawal-store"`
+	// PayloadHeaderStore is the configuration for the store of the execution
+	// payload headers of the finalized blocks.
+	PayloadHeaderStore payloadheader.Config `mapstructure:"payload-header-store"`
 	// NodeAPI is the configuration for the node API.
 	NodeAPI server.Config `mapstructure:"node-api"`
+	// ForkRehearsal is the configuration for the fork upgrade rehearsal mode.
+	ForkRehearsal blockchain.RehearsalConfig `mapstructure:"fork-rehearsal"`
+	// Features is the configuration for the runtime feature flags.
+	Features features.Config `mapstructure:"features"`
+	// Prometheus is the configuration for the Prometheus metrics endpoint.
+	Prometheus prometheus.Config `mapstructure:"prometheus"`
+	// StatsD is the configuration for pushing metrics to a StatsD agent.
+	StatsD statsd.Config `mapstructure:"statsd"`
+	// OTLP is the configuration for exporting metrics to an OTLP collector.
+	OTLP otlp.Config `mapstructure:"otlp"`
+	// Alerts is the configuration for the alerting webhooks.
+	Alerts alerts.Config `mapstructure:"alerts"`
+	// SlotClock is the configuration for the slot clock.
+	SlotClock clock.Config `mapstructure:"slot-clock"`
+	// Profiling is the configuration for the pprof endpoints and the
+	// profiling of slow blocks.
+	Profiling profiling.Config `mapstructure:"profiling"`
+	// VoteExtensions is the configuration for the vote extensions carrying
+	// execution observations.
+	VoteExtensions voteext.Config `mapstructure:"vote-extensions"`
+	// StateHash is the configuration for the merkleization of the beacon
+	// state.
+	StateHash types.StateHashConfig `mapstructure:"state-hash"`
+	// Chaos is the configuration for the fault injection used in resilience
+	// tests.
+	Chaos chaos.Config `mapstructure:"chaos"`
 }
 
 // GetEngine returns the execution client configuration.
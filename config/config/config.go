@@ -41,6 +41,11 @@ type BaseConfig struct {
 	// Note: Commitment of state will be attempted on the corresponding block.
 	HaltHeight uint64 `mapstructure:"halt-height"`
 
+	// HaltEpoch contains a non-zero epoch at which a node will gracefully
+	// halt and shutdown, after committing the last block of the previous
+	// epoch.
+	HaltEpoch uint64 `mapstructure:"halt-epoch"`
+
 	// HaltTime contains a non-zero minimum block time (in Unix seconds) at
 	// which
 	// a node will gracefully halt and shutdown that can be used to assist
@@ -77,10 +82,25 @@ type BaseConfig struct {
 	IAVLDisableFastNode bool `mapstructure:"iavl-disable-fastnode"`
 }
 
+// StateSyncConfig defines the state sync snapshots a node takes and offers
+// to nodes joining the network.
+type StateSyncConfig struct {
+	// SnapshotInterval sets the block interval at which state sync snapshots
+	// are taken. A value of 0 disables snapshots.
+	SnapshotInterval uint64 `mapstructure:"snapshot-interval"`
+
+	// SnapshotKeepRecent sets the number of recent snapshots to keep. A
+	// value of 0 keeps all snapshots.
+	SnapshotKeepRecent uint32 `mapstructure:"snapshot-keep-recent"`
+}
+
 // Config defines the server's top level configuration.
 type Config struct {
 	BaseConfig `mapstructure:",squash"`
 
+	// StateSync defines the state sync snapshot configuration.
+	StateSync StateSyncConfig `mapstructure:"state-sync"`
+
 	// Telemetry defines the application telemetry configuration
 	Telemetry telemetry.Config `mapstructure:"telemetry"`
 }
@@ -98,6 +118,11 @@ func DefaultConfig() *Config {
 			IAVLCacheSize:       5000,
 			IAVLDisableFastNode: false,
 		},
+		StateSync: StateSyncConfig{
+			SnapshotInterval: 0,
+			//nolint:mnd // its a bet.
+			SnapshotKeepRecent: 2,
+		},
 		Telemetry: telemetry.Config{
 			Enabled:      false,
 			GlobalLabels: [][]string{},
@@ -114,16 +139,17 @@ func GetConfig(v *viper.Viper) (Config, error) {
 	return *conf, nil
 }
 
-// ValidateBasic returns an error if min-gas-prices field is empty in
-// BaseConfig. Otherwise, it returns nil.
+// ValidateBasic returns an error if state sync snapshots are enabled with a
+// pruning setting that discards the heights they are taken at. Otherwise, it
+// returns nil.
 func (c Config) ValidateBasic() error {
-	// if c.Pruning == pruningtypes.PruningOptionEverything &&
-	// c.StateSync.SnapshotInterval > 0 {
-	// 	return sdkerrors.ErrAppConfig.Wrapf(
-	// 		"cannot enable state sync snapshots with '%s' pruning setting",
-	// pruningtypes.PruningOptionEverything,
-	// 	)
-	// }
+	if c.Pruning == pruningtypes.PruningOptionEverything &&
+		c.StateSync.SnapshotInterval > 0 {
+		return fmt.Errorf(
+			"cannot enable state sync snapshots with '%s' pruning setting",
+			pruningtypes.PruningOptionEverything,
+		)
+	}
 
 	return nil
 }
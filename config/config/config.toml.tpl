@@ -21,6 +21,11 @@ pruning-interval = "{{ .BaseConfig.PruningInterval }}"
 # Note: Commitment of state will be attempted on the corresponding block.
 halt-height = {{ .BaseConfig.HaltHeight }}
 
+# HaltEpoch contains a non-zero epoch at which a node will gracefully halt and
+# shutdown, after committing the last block of the previous epoch. If both
+# halt-height and halt-epoch are set, the node halts at the lowest height.
+halt-epoch = {{ .BaseConfig.HaltEpoch }}
+
 # HaltTime contains a non-zero minimum block time (in Unix seconds) at which
 # a node will gracefully halt and shutdown that can be used to assist upgrades
 # and testing.
@@ -55,6 +60,23 @@ iavl-cache-size = {{ .BaseConfig.IAVLCacheSize }}
 iavl-disable-fastnode = {{ .BaseConfig.IAVLDisableFastNode }}
 
 
+###############################################################################
+###                        State Sync Configuration                         ###
+###############################################################################
+
+# State sync snapshots allow other nodes to rapidly join the network without
+# replaying historical blocks, instead downloading and applying a snapshot of
+# the beacon state at a given height.
+[state-sync]
+
+# snapshot-interval specifies the block interval at which local state sync
+# snapshots are taken (0 to disable).
+snapshot-interval = {{ .StateSync.SnapshotInterval }}
+
+# snapshot-keep-recent specifies the number of recent snapshots to keep and
+# serve (0 to keep all).
+snapshot-keep-recent = {{ .StateSync.SnapshotKeepRecent }}
+
 ###############################################################################
 ###                         Telemetry Configuration                         ###
 ###############################################################################
@@ -0,0 +1,131 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package features
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+const (
+	// flagSeparator separates flags in the configuration string.
+	flagSeparator = ","
+	// heightSeparator separates a flag name from its activation height.
+	heightSeparator = "@"
+)
+
+var (
+	// ErrInvalidFlag is returned when a flag cannot be parsed.
+	ErrInvalidFlag = errors.New("invalid feature flag")
+	// ErrDuplicateFlag is returned when a flag is configured twice.
+	ErrDuplicateFlag = errors.New("duplicate feature flag")
+)
+
+// Config is the feature flag configuration.
+type Config struct {
+	// Flags is a comma separated list of feature flags. Each entry is
+	// either a flag name, which is active immediately, or a flag name
+	// followed by "@<height>", which activates the flag at that height.
+	Flags string `mapstructure:"flags"`
+}
+
+// DefaultConfig returns the default feature flag configuration.
+func DefaultConfig() Config {
+	return Config{
+		Flags: "",
+	}
+}
+
+// Flag is a feature flag together with the height at which it activates.
+// Feature flags gate non-consensus behaviour only and are not tied to
+// forks.
+type Flag struct {
+	// Name is the name of the flag.
+	Name string `json:"name"`
+	// ActivationHeight is the height from which the flag is active.
+	ActivationHeight math.Slot `json:"activation_height"`
+}
+
+// Set holds the configured feature flags.
+type Set struct {
+	flags map[string]math.Slot
+}
+
+// NewSet parses the given configuration into a set of feature flags.
+func NewSet(cfg Config) (*Set, error) {
+	s := &Set{flags: make(map[string]math.Slot)}
+	for _, entry := range strings.Split(cfg.Flags, flagSeparator) {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		name, height, err := parseFlag(entry)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := s.flags[name]; ok {
+			return nil, errors.Wrap(ErrDuplicateFlag, name)
+		}
+		s.flags[name] = height
+	}
+	return s, nil
+}
+
+// IsEnabled returns true if the given flag is active at the given height.
+func (s *Set) IsEnabled(name string, height math.Slot) bool {
+	activation, ok := s.flags[name]
+	return ok && height >= activation
+}
+
+// Flags returns the configured feature flags, sorted by name.
+func (s *Set) Flags() []Flag {
+	flags := make([]Flag, 0, len(s.flags))
+	for name, height := range s.flags {
+		flags = append(flags, Flag{Name: name, ActivationHeight: height})
+	}
+	slices.SortFunc(flags, func(a, b Flag) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return flags
+}
+
+// parseFlag parses a single "name" or "name@height" entry.
+func parseFlag(entry string) (string, math.Slot, error) {
+	name, heightStr, hasHeight := strings.Cut(entry, heightSeparator)
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", 0, errors.Wrap(ErrInvalidFlag, entry)
+	}
+	if !hasHeight {
+		return name, 0, nil
+	}
+
+	height, err := strconv.ParseUint(strings.TrimSpace(heightStr), 10, 64)
+	if err != nil {
+		return "", 0, errors.Wrapf(ErrInvalidFlag, "%s: %v", entry, err)
+	}
+	return name, math.Slot(height), nil
+}
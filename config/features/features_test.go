@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package features_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/config/features"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSet(t *testing.T) {
+	set, err := features.NewSet(features.Config{
+		Flags: "state-cache, fast-verify@100",
+	})
+	require.NoError(t, err)
+
+	require.True(t, set.IsEnabled("state-cache", 0))
+	require.False(t, set.IsEnabled("fast-verify", 99))
+	require.True(t, set.IsEnabled("fast-verify", 100))
+	require.False(t, set.IsEnabled("unknown", 100))
+	require.Equal(t, []features.Flag{
+		{Name: "fast-verify", ActivationHeight: math.Slot(100)},
+		{Name: "state-cache", ActivationHeight: 0},
+	}, set.Flags())
+}
+
+func TestNewSetEmpty(t *testing.T) {
+	set, err := features.NewSet(features.DefaultConfig())
+	require.NoError(t, err)
+	require.Empty(t, set.Flags())
+}
+
+func TestNewSetErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		flags string
+		err   error
+	}{
+		{name: "missing name", flags: "@10", err: features.ErrInvalidFlag},
+		{name: "bad height", flags: "a@ten", err: features.ErrInvalidFlag},
+		{name: "duplicate", flags: "a,a@5", err: features.ErrDuplicateFlag},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := features.NewSet(features.Config{Flags: tt.flags})
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+}
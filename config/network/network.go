@@ -0,0 +1,146 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package network
+
+import (
+	"bytes"
+	"embed"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/berachain/beacon-kit/chain-spec/chain"
+	"github.com/berachain/beacon-kit/config/spec"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/mitchellh/mapstructure"
+	"github.com/spf13/viper"
+)
+
+const (
+	// Devnet is the name of the local development network.
+	Devnet = "devnet"
+
+	// networksDir is the directory holding one TOML file per network.
+	networksDir = "networks"
+)
+
+// ErrUnknownNetwork is returned when a network is not registered.
+var ErrUnknownNetwork = errors.New("unknown network")
+
+// networkFiles holds the definitions of the registered networks. A network is
+// added by adding its file, named after the network, once its chain spec,
+// deposit contract, genesis validators root and peers are known.
+//
+//go:embed networks/*.toml
+var networkFiles embed.FS
+
+// Network bundles everything a node needs to join a network.
+type Network struct {
+	// Name is the name of the network.
+	Name string
+	// ChainSpec is the chain spec of the network.
+	ChainSpec common.ChainSpec
+	// GenesisValidatorsRoot is the genesis validators root of the network,
+	// or zero if it is not pinned.
+	GenesisValidatorsRoot common.Root
+	// Seeds are the CometBFT seed nodes of the network.
+	Seeds []string
+	// PersistentPeers are the CometBFT persistent peers of the network.
+	PersistentPeers []string
+}
+
+// DepositContractAddress returns the address of the deposit contract of the
+// network.
+func (n *Network) DepositContractAddress() common.ExecutionAddress {
+	return n.ChainSpec.DepositContractAddress()
+}
+
+// networkFile is the format of a network file.
+type networkFile struct {
+	GenesisValidatorsRoot string         `mapstructure:"genesis-validators-root"`
+	Seeds                 []string       `mapstructure:"seeds"`
+	PersistentPeers       []string       `mapstructure:"persistent-peers"`
+	ChainSpec             map[string]any `mapstructure:"chain-spec"`
+}
+
+// Get returns the network registered under the given name.
+func Get(name string) (*Network, error) {
+	bz, err := networkFiles.ReadFile(path.Join(networksDir, name+".toml"))
+	if err != nil {
+		return nil, errors.Wrapf(ErrUnknownNetwork, "%q", name)
+	}
+
+	v := viper.New()
+	v.SetConfigType("toml")
+	if err = v.ReadConfig(bytes.NewReader(bz)); err != nil {
+		return nil, errors.Wrapf(err, "failed to read network %s", name)
+	}
+	var f networkFile
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		ErrorUnused: true,
+		Result:      &f,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err = decoder.Decode(v.AllSettings()); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode network %s", name)
+	}
+
+	n := &Network{
+		Name:            name,
+		Seeds:           f.Seeds,
+		PersistentPeers: f.PersistentPeers,
+	}
+	if f.GenesisValidatorsRoot != "" {
+		if n.GenesisValidatorsRoot, err = common.NewRootFromHex(
+			f.GenesisValidatorsRoot,
+		); err != nil {
+			return nil, errors.Wrapf(
+				err, "invalid genesis validators root of network %s", name,
+			)
+		}
+	}
+
+	data, err := spec.FromSettings(f.ChainSpec)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid chain spec of network %s", name)
+	}
+	if n.ChainSpec, err = chain.NewChainSpec(data); err != nil {
+		return nil, errors.Wrapf(err, "invalid chain spec of network %s", name)
+	}
+	return n, nil
+}
+
+// Names returns the sorted names of all registered networks.
+func Names() []string {
+	entries, err := networkFiles.ReadDir(networksDir)
+	if err != nil {
+		return nil
+	}
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		names = append(names, strings.TrimSuffix(entry.Name(), ".toml"))
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package network_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/config/network"
+	"github.com/berachain/beacon-kit/config/spec"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNetworks(t *testing.T) {
+	require.Equal(t, []string{network.Devnet}, network.Names())
+
+	chainIDs := map[string]uint64{
+		network.Devnet: spec.DevnetEth1ChainID,
+	}
+	for _, name := range network.Names() {
+		n, err := network.Get(name)
+		require.NoError(t, err, name)
+		require.Equal(t, name, n.Name)
+		require.Equal(t, chainIDs[name], n.ChainSpec.DepositEth1ChainID())
+		require.Equal(t,
+			common.NewExecutionAddressFromHex(
+				spec.DefaultDepositContractAddress,
+			),
+			n.DepositContractAddress(),
+		)
+	}
+}
+
+func TestGet_Unknown(t *testing.T) {
+	_, err := network.Get("mainnet")
+	require.ErrorIs(t, err, network.ErrUnknownNetwork)
+}
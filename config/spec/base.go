@@ -25,6 +25,7 @@ import (
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/signing"
 	cmttypes "github.com/cometbft/cometbft/types"
 )
 
@@ -46,6 +47,7 @@ func BaseSpec() chain.SpecData[
 ] {
 	cmtConsensusParams := cmttypes.DefaultConsensusParams()
 	cmtConsensusParams.Validator.PubKeyTypes = []string{crypto.CometBLSType}
+	domainTypes := signing.DefaultDomainTypes()
 
 	return chain.SpecData[
 		common.DomainType,
@@ -58,6 +60,7 @@ func BaseSpec() chain.SpecData[
 		MinDepositAmount:          1e9,
 		MaxEffectiveBalance:       32e9,
 		EjectionBalance:           16e9,
+		MinActivationBalance:      17e9,
 		EffectiveBalanceIncrement: 1e9,
 
 		HysteresisQuotient:           4,
@@ -68,32 +71,17 @@ func BaseSpec() chain.SpecData[
 		SlotsPerEpoch:                32,
 		MinEpochsToInactivityPenalty: 4,
 		SlotsPerHistoricalRoot:       8,
+		SlotDurationMs:               2000,
 
 		// Signature domains.
-		DomainTypeProposer: common.DomainType{
-			0x00, 0x00, 0x00, 0x00,
-		},
-		DomainTypeAttester: common.DomainType{
-			0x01, 0x00, 0x00, 0x00,
-		},
-		DomainTypeRandao: common.DomainType{
-			0x02, 0x00, 0x00, 0x00,
-		},
-		DomainTypeDeposit: common.DomainType{
-			0x03, 0x00, 0x00, 0x00,
-		},
-		DomainTypeVoluntaryExit: common.DomainType{
-			0x04, 0x00, 0x00, 0x00,
-		},
-		DomainTypeSelectionProof: common.DomainType{
-			0x05, 0x00, 0x00, 0x00,
-		},
-		DomainTypeAggregateAndProof: common.DomainType{
-			0x06, 0x00, 0x00, 0x00,
-		},
-		DomainTypeApplicationMask: common.DomainType{
-			0x00, 0x00, 0x00, 0x01,
-		},
+		DomainTypeProposer:          domainTypes.Proposer,
+		DomainTypeAttester:          domainTypes.Attester,
+		DomainTypeRandao:            domainTypes.Randao,
+		DomainTypeDeposit:           domainTypes.Deposit,
+		DomainTypeVoluntaryExit:     domainTypes.VoluntaryExit,
+		DomainTypeSelectionProof:    domainTypes.SelectionProof,
+		DomainTypeAggregateAndProof: domainTypes.AggregateAndProof,
+		DomainTypeApplicationMask:   domainTypes.ApplicationMask,
 
 		// Eth1-related values.
 		DepositContractAddress: common.NewExecutionAddressFromHex(
@@ -104,8 +92,12 @@ func BaseSpec() chain.SpecData[
 		TargetSecondsPerEth1Block: 3,
 
 		// Fork-related values.
-		DenebPlusForkEpoch: 9999999999999998,
-		ElectraForkEpoch:   9999999999999999,
+		DenebPlusForkEpoch:      9999999999999998,
+		ElectraForkEpoch:        9999999999999999,
+		Eth1DataForkEpoch:       9999999999999999,
+		EjectionForkEpoch:       9999999999999999,
+		VoteExtensionsForkEpoch: 9999999999999999,
+		SlotTimestampForkEpoch:  9999999999999999,
 
 		// State list length constants.
 		EpochsPerHistoricalVector: 8,
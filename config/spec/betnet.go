@@ -36,5 +36,8 @@ func BetnetChainSpec() (chain.Spec[
 ], error) {
 	testnetSpec := BaseSpec()
 	testnetSpec.DepositEth1ChainID = BetnetEth1ChainID
+	testnetSpec.Eth1DataForkEpoch = 0
+	testnetSpec.EjectionForkEpoch = 0
+	testnetSpec.VoteExtensionsForkEpoch = 0
 	return chain.NewChainSpec(testnetSpec)
 }
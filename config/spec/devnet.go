@@ -22,6 +22,7 @@ package spec
 
 import (
 	"github.com/berachain/beacon-kit/chain-spec/chain"
+	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/math"
 )
@@ -35,6 +36,28 @@ const (
 	DevnetEVMInflationPerBlock = 10e9
 )
 
+// DevnetOptions configures the devnet chain spec generator.
+type DevnetOptions struct {
+	// SlotsPerEpoch is the number of slots in an epoch.
+	SlotsPerEpoch uint64
+	// HistoricalVectorLength is the length of the block roots, state roots,
+	// RANDAO mixes and slashings vectors kept in the beacon state.
+	HistoricalVectorLength uint64
+	// MinEpochsForBlobsSidecarsRequest is the number of epochs blobs are
+	// retained for.
+	MinEpochsForBlobsSidecarsRequest uint64
+}
+
+// FastDevnetOptions returns the generator options used by the fast devnet
+// preset, tuned so that epochs complete within seconds.
+func FastDevnetOptions() DevnetOptions {
+	return DevnetOptions{
+		SlotsPerEpoch:                    4,
+		HistoricalVectorLength:           4,
+		MinEpochsForBlobsSidecarsRequest: 8,
+	}
+}
+
 // DevnetChainSpec is the ChainSpec for the localnet. Also used for e2e tests
 // in the kurtosis network.
 func DevnetChainSpec() (chain.Spec[
@@ -44,11 +67,61 @@ func DevnetChainSpec() (chain.Spec[
 	math.Slot,
 	any,
 ], error) {
+	return chain.NewChainSpec(devnetSpecData())
+}
+
+// FastDevnetChainSpec is the ChainSpec for local networks and integration
+// tests that need to cross many epoch boundaries quickly.
+func FastDevnetChainSpec() (chain.Spec[
+	common.DomainType,
+	math.Epoch,
+	common.ExecutionAddress,
+	math.Slot,
+	any,
+], error) {
+	data, err := GenerateDevnetSpecData(FastDevnetOptions())
+	if err != nil {
+		return nil, err
+	}
+	return chain.NewChainSpec(data)
+}
+
+// GenerateDevnetSpecData returns the devnet chain spec data resized according
+// to the given options. Validators are activated as soon as their deposit is
+// processed, so no activation churn needs to be configured. The historical
+// roots limit is kept equal to the number of slots per historical root, so
+// that the roots primed at genesis match the ones rotated every slot.
+func GenerateDevnetSpecData(opts DevnetOptions) (SpecData, error) {
+	switch {
+	case opts.SlotsPerEpoch == 0:
+		return SpecData{}, errors.Wrap(chain.ErrZeroValue, "slots-per-epoch")
+	case opts.HistoricalVectorLength == 0:
+		return SpecData{}, errors.Wrap(
+			chain.ErrZeroValue, "historical-vector-length",
+		)
+	}
+
+	data := devnetSpecData()
+	data.SlotsPerEpoch = opts.SlotsPerEpoch
+	data.SlotsPerHistoricalRoot = opts.HistoricalVectorLength
+	data.HistoricalRootsLimit = opts.HistoricalVectorLength
+	data.EpochsPerHistoricalVector = opts.HistoricalVectorLength
+	data.EpochsPerSlashingsVector = opts.HistoricalVectorLength
+	data.MinEpochsForBlobsSidecarsRequest =
+		opts.MinEpochsForBlobsSidecarsRequest
+	return data, nil
+}
+
+// devnetSpecData returns the chain spec data of the localnet.
+func devnetSpecData() SpecData {
 	devnetSpec := BaseSpec()
 	devnetSpec.DepositEth1ChainID = DevnetEth1ChainID
 	devnetSpec.EVMInflationAddress = common.NewExecutionAddressFromHex(
 		DevnetEVMInflationAddress,
 	)
 	devnetSpec.EVMInflationPerBlock = DevnetEVMInflationPerBlock
-	return chain.NewChainSpec(devnetSpec)
+	devnetSpec.Eth1DataForkEpoch = 0
+	devnetSpec.EjectionForkEpoch = 0
+	devnetSpec.VoteExtensionsForkEpoch = 0
+	return devnetSpec
 }
@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package spec_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/chain-spec/chain"
+	"github.com/berachain/beacon-kit/config/spec"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateDevnetSpecData(t *testing.T) {
+	opts := spec.DevnetOptions{
+		SlotsPerEpoch:                    2,
+		HistoricalVectorLength:           16,
+		MinEpochsForBlobsSidecarsRequest: 1,
+	}
+	data, err := spec.GenerateDevnetSpecData(opts)
+	require.NoError(t, err)
+
+	require.Equal(t, spec.DevnetEth1ChainID, data.DepositEth1ChainID)
+	require.Equal(t, uint64(2), data.SlotsPerEpoch)
+	require.Equal(t, uint64(1), data.MinEpochsForBlobsSidecarsRequest)
+	for _, length := range []uint64{
+		data.SlotsPerHistoricalRoot,
+		data.HistoricalRootsLimit,
+		data.EpochsPerHistoricalVector,
+		data.EpochsPerSlashingsVector,
+	} {
+		require.Equal(t, opts.HistoricalVectorLength, length)
+	}
+
+	cs, err := chain.NewChainSpec(data)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), uint64(cs.SlotToEpoch(7)))
+}
+
+func TestGenerateDevnetSpecData_RejectsZero(t *testing.T) {
+	opts := spec.FastDevnetOptions()
+	opts.SlotsPerEpoch = 0
+	_, err := spec.GenerateDevnetSpecData(opts)
+	require.ErrorIs(t, err, chain.ErrZeroValue)
+
+	opts = spec.FastDevnetOptions()
+	opts.HistoricalVectorLength = 0
+	_, err = spec.GenerateDevnetSpecData(opts)
+	require.ErrorIs(t, err, chain.ErrZeroValue)
+}
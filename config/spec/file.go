@@ -0,0 +1,204 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package spec
+
+import (
+	"encoding"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/berachain/beacon-kit/chain-spec/chain"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/mitchellh/mapstructure"
+	"github.com/spf13/viper"
+)
+
+const (
+	// PresetKey is the key of a chain spec file selecting the preset that the
+	// values of the file are layered on top of. If omitted, the testnet preset
+	// is used.
+	PresetKey = "preset"
+
+	// cometBFTConfigKey is the key of the CometBFT consensus params, which are
+	// always taken from the preset and cannot be set through a file.
+	cometBFTConfigKey = "comet-bft-config"
+)
+
+var (
+	// ErrCometBFTConfigInFile is returned when a chain spec file attempts to
+	// override the CometBFT consensus params.
+	ErrCometBFTConfigInFile = errors.New(
+		"comet-bft-config cannot be set from a chain spec file",
+	)
+)
+
+// SpecData is the chain spec data used by all presets.
+type SpecData = chain.SpecData[
+	common.DomainType,
+	math.Epoch,
+	common.ExecutionAddress,
+	math.Slot,
+	any,
+]
+
+// LoadFile reads, layers and validates the chain spec defined by the TOML or
+// YAML file at the given path.
+func LoadFile(path string) (common.ChainSpec, error) {
+	data, err := ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	cs, err := chain.NewChainSpec(data)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid chain spec file %s", path)
+	}
+	return cs, nil
+}
+
+// ReadFile reads the chain spec file at the given path and returns its values
+// layered on top of the preset selected by the file. Unknown keys and values
+// of the wrong type are rejected. The format is inferred from the extension.
+func ReadFile(path string) (SpecData, error) {
+	v := viper.New()
+	v.SetConfigFile(path)
+	if err := v.ReadInConfig(); err != nil {
+		return SpecData{}, errors.Wrapf(
+			err, "failed to read chain spec file %s", path,
+		)
+	}
+
+	data, err := FromSettings(v.AllSettings())
+	if err != nil {
+		return SpecData{}, errors.Wrapf(
+			err, "failed to decode chain spec file %s", path,
+		)
+	}
+	return data, nil
+}
+
+// FromSettings returns the given chain spec values layered on top of the
+// preset they select, as read from a chain spec file. Unknown keys and values
+// of the wrong type are rejected.
+func FromSettings(settings map[string]any) (SpecData, error) {
+	presetName := TestnetPreset
+	if preset, ok := settings[PresetKey]; ok {
+		presetName = fmt.Sprint(preset)
+	}
+	base, err := FromPreset(presetName)
+	if err != nil {
+		return SpecData{}, err
+	}
+
+	if _, ok := settings[cometBFTConfigKey]; ok {
+		return SpecData{}, ErrCometBFTConfigInFile
+	}
+
+	overrides := make(map[string]any, len(settings))
+	for key, value := range settings {
+		if key != PresetKey {
+			overrides[key] = value
+		}
+	}
+
+	data := base.Data()
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		DecodeHook:  mapstructure.TextUnmarshallerHookFunc(),
+		ErrorUnused: true,
+		Result:      &data,
+	})
+	if err != nil {
+		return SpecData{}, err
+	}
+	if err = decoder.Decode(overrides); err != nil {
+		return SpecData{}, err
+	}
+	return data, validateFileData(data)
+}
+
+// validateFileData ensures that the values used as divisors or as state
+// vector lengths are not zeroed out by a chain spec file.
+func validateFileData(data SpecData) error {
+	for _, param := range []struct {
+		name  string
+		value uint64
+	}{
+		{"slots-per-historical-root", data.SlotsPerHistoricalRoot},
+		{"epochs-per-historical-vector", data.EpochsPerHistoricalVector},
+		{"epochs-per-slashings-vector", data.EpochsPerSlashingsVector},
+		{"effective-balance-increment", data.EffectiveBalanceIncrement},
+		{"hysteresis-quotient", data.HysteresisQuotient},
+	} {
+		if param.value == 0 {
+			return errors.Wrap(chain.ErrZeroValue, param.name)
+		}
+	}
+	return nil
+}
+
+// WriteTOML writes every value of the given chain spec data as TOML, in a
+// format that can be read back by ReadFile. The CometBFT consensus params are
+// omitted as they cannot be set through a file.
+func WriteTOML(w io.Writer, data SpecData) error {
+	value := reflect.ValueOf(data)
+	for i := range value.NumField() {
+		key := value.Type().Field(i).Tag.Get("mapstructure")
+		if key == "" || key == cometBFTConfigKey {
+			continue
+		}
+
+		field := value.Field(i)
+		var err error
+		switch marshaler, ok := field.Interface().(encoding.TextMarshaler); {
+		case field.Kind() == reflect.Uint64:
+			_, err = fmt.Fprintf(w, "%s = %d\n", key, field.Uint())
+		case field.Kind() == reflect.Slice &&
+			field.Type().Elem().Kind() == reflect.String:
+			// Empty lists are omitted so that they read back as unset.
+			if field.Len() == 0 {
+				continue
+			}
+			quoted := make([]string, field.Len())
+			for j := range field.Len() {
+				quoted[j] = strconv.Quote(field.Index(j).String())
+			}
+			_, err = fmt.Fprintf(
+				w, "%s = [%s]\n", key, strings.Join(quoted, ", "),
+			)
+		case ok:
+			var text []byte
+			if text, err = marshaler.MarshalText(); err != nil {
+				return err
+			}
+			_, err = fmt.Fprintf(w, "%s = %q\n", key, text)
+		default:
+			return fmt.Errorf("unsupported chain spec field %s", key)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,179 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package spec_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/chain-spec/chain"
+	"github.com/berachain/beacon-kit/config/spec"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/stretchr/testify/require"
+)
+
+func writeFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestWriteTOML_RoundTrip(t *testing.T) {
+	for _, name := range spec.PresetNames() {
+		t.Run(name, func(t *testing.T) {
+			cs, err := spec.FromPreset(name)
+			require.NoError(t, err)
+
+			var buf bytes.Buffer
+			require.NoError(t, spec.WriteTOML(&buf, cs.Data()))
+
+			path := writeFile(t, "spec.toml", buf.String())
+			loaded, err := spec.LoadFile(path)
+			require.NoError(t, err)
+			require.Equal(t, cs.Data(), loaded.Data())
+		})
+	}
+}
+
+func TestWriteTOML_IncompatibleExecutionClients(t *testing.T) {
+	cs, err := spec.FromPreset(spec.DevnetPreset)
+	require.NoError(t, err)
+	data := cs.Data()
+	data.IncompatibleExecutionClients = []string{"Geth/v1.14.0", "reth/v1.0"}
+
+	var buf bytes.Buffer
+	require.NoError(t, spec.WriteTOML(&buf, data))
+	require.Contains(t, buf.String(),
+		`incompatible-execution-clients = ["Geth/v1.14.0", "reth/v1.0"]`,
+	)
+
+	loaded, err := spec.LoadFile(writeFile(t, "spec.toml", buf.String()))
+	require.NoError(t, err)
+	require.Equal(t, data, loaded.Data())
+}
+
+func TestReadFile_Layering(t *testing.T) {
+	path := writeFile(t, "spec.yaml", `
+preset: devnet
+slots-per-epoch: 4
+domain-type-randao: "0x02000001"
+deposit-contract-address: "0x00000000219ab540356cBB839Cbe05303d7705Fa"
+electra-fork-epoch: 12
+`)
+	data, err := spec.ReadFile(path)
+	require.NoError(t, err)
+
+	devnet, err := spec.FromPreset(spec.DevnetPreset)
+	require.NoError(t, err)
+	expected := devnet.Data()
+	expected.SlotsPerEpoch = 4
+	expected.DomainTypeRandao = common.DomainType{0x02, 0x00, 0x00, 0x01}
+	expected.DepositContractAddress = common.NewExecutionAddressFromHex(
+		"0x00000000219ab540356cBB839Cbe05303d7705Fa",
+	)
+	expected.ElectraForkEpoch = math.Epoch(12)
+	require.Equal(t, expected, data)
+}
+
+func TestReadFile_Rejects(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		err     error
+	}{
+		{
+			name:    "unknown preset",
+			content: "preset = \"nonexistent\"\n",
+			err:     spec.ErrUnknownPreset,
+		},
+		{
+			name:    "comet params",
+			content: "[comet-bft-config]\nfoo = 1\n",
+			err:     spec.ErrCometBFTConfigInFile,
+		},
+		{
+			name:    "unknown key",
+			content: "slots-per-epoc = 4\n",
+		},
+		{
+			name:    "wrong type",
+			content: "slots-per-epoch = \"many\"\n",
+		},
+		{
+			name:    "negative value",
+			content: "slots-per-epoch = -1\n",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := spec.ReadFile(writeFile(t, "spec.toml", tc.content))
+			require.Error(t, err)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			}
+		})
+	}
+}
+
+func TestLoadFile_Validates(t *testing.T) {
+	_, err := spec.LoadFile(writeFile(t, "spec.toml", "slots-per-epoch = 0\n"))
+	require.ErrorIs(t, err, chain.ErrZeroValue)
+
+	_, err = spec.LoadFile(writeFile(t, "spec.toml",
+		"epochs-per-slashings-vector = 0\n",
+	))
+	require.ErrorIs(t, err, chain.ErrZeroValue)
+
+	_, err = spec.LoadFile(writeFile(t, "spec.toml", "slot-duration-ms = 0\n"))
+	require.ErrorIs(t, err, chain.ErrZeroValue)
+
+	_, err = spec.LoadFile(writeFile(t, "spec.toml",
+		"deneb-plus-fork-epoch = 10\nelectra-fork-epoch = 5\n",
+	))
+	require.ErrorIs(t, err, chain.ErrInvalidForkOrder)
+
+	_, err = spec.LoadFile(writeFile(t, "spec.toml",
+		"domain-type-randao = \"0x00000000\"\n",
+	))
+	require.ErrorIs(t, err, chain.ErrDuplicateDomainType)
+}
+
+func TestLoadFile_SubSecondSlots(t *testing.T) {
+	cs, err := spec.LoadFile(writeFile(t, "spec.toml",
+		"genesis-time = 1700000000\nslot-duration-ms = 250\n",
+	))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1700000000), cs.GenesisTime())
+	require.Equal(t, 250*time.Millisecond, cs.SlotDuration())
+}
+
+func TestLoadFile_SlotTimestampFork(t *testing.T) {
+	cs, err := spec.LoadFile(writeFile(t, "spec.toml",
+		"genesis-time = 1700000000\nslot-timestamp-fork-epoch = 10\n",
+	))
+	require.NoError(t, err)
+	require.Equal(t, math.Epoch(10), cs.SlotTimestampForkEpoch())
+}
@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package spec
+
+import (
+	"sort"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/common"
+)
+
+const (
+	// DevnetPreset is the name of the devnet chain spec preset.
+	DevnetPreset = "devnet"
+	// FastDevnetPreset is the name of the fast-epoch devnet chain spec preset.
+	FastDevnetPreset = "devnet-fast"
+	// BetnetPreset is the name of the betnet chain spec preset.
+	BetnetPreset = "betnet"
+	// BoonetPreset is the name of the boonet chain spec preset.
+	BoonetPreset = "boonet"
+	// TestnetPreset is the name of the testnet chain spec preset.
+	TestnetPreset = "testnet"
+)
+
+// ErrUnknownPreset is returned when a chain spec preset is not registered.
+var ErrUnknownPreset = errors.New("unknown chain spec preset")
+
+// presets maps the name of every known network to its chain spec constructor.
+func presets() map[string]func() (common.ChainSpec, error) {
+	return map[string]func() (common.ChainSpec, error){
+		DevnetPreset:     DevnetChainSpec,
+		FastDevnetPreset: FastDevnetChainSpec,
+		BetnetPreset:     BetnetChainSpec,
+		BoonetPreset:     BoonetChainSpec,
+		TestnetPreset:    TestnetChainSpec,
+	}
+}
+
+// FromPreset returns the chain spec registered under the given preset name.
+func FromPreset(name string) (common.ChainSpec, error) {
+	constructor, ok := presets()[name]
+	if !ok {
+		return nil, errors.Wrapf(ErrUnknownPreset, "%q", name)
+	}
+	return constructor()
+}
+
+// PresetNames returns the sorted names of all registered presets.
+func PresetNames() []string {
+	names := make([]string, 0, len(presets()))
+	for name := range presets() {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
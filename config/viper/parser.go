@@ -33,9 +33,7 @@ import (
 // string to a `primitives.ExecutionAddresses` by parsing the string.
 func StringToExecutionAddressFunc() mapstructure.DecodeHookFunc {
 	return StringTo(
-		func(s string) (common.ExecutionAddress, error) {
-			return common.NewExecutionAddressFromHex(s), nil
-		},
+		common.ParseExecutionAddress,
 	)
 }
 
@@ -0,0 +1,256 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Command gen generates the generalized index constants of the gindex
+// package from the schemas of the consensus types.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/encoding/ssz/merkle"
+	"github.com/berachain/beacon-kit/primitives/encoding/ssz/schema"
+)
+
+// lenPart is the path part addressing the length of a list.
+const lenPart = "__len__"
+
+// root is a container whose fields are indexed.
+type root struct {
+	// name prefixes the constants of the root.
+	name string
+	// description describes the root in the doc comments.
+	description string
+	// typ is the schema of the root.
+	typ schema.SSZType
+}
+
+// layout is the set of roots of a fork.
+type layout struct {
+	// fork is the name of the fork.
+	fork string
+	// roots are the roots of the fork.
+	roots []root
+}
+
+// layouts are the layouts of the forks with a distinct SSZ layout. Forks not
+// listed share the layout of the fork preceding them.
+//
+//nolint:gochecknoglobals // generator input.
+var layouts = []layout{
+	{
+		fork: "Deneb",
+		roots: []root{
+			{"State", "BeaconState", types.BeaconStateSchemaDeneb()},
+			{"Block", "BeaconBlock", types.BeaconBlockSchemaDeneb()},
+			{
+				"Header",
+				"BeaconBlockHeader, with the state root expanded into " +
+					"the BeaconState",
+				types.BeaconBlockHeaderSchemaDeneb(),
+			},
+		},
+	},
+}
+
+// constant is a generated constant.
+type constant struct {
+	name  string
+	doc   string
+	value uint64
+}
+
+func main() {
+	out := flag.String("out", "gindex.gen.go", "output file")
+	flag.Parse()
+
+	src, err := generate()
+	if err != nil {
+		log.Fatal(err)
+	}
+	//#nosec:G306 // generated source.
+	if err = os.WriteFile(*out, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// generate renders the constants of every layout.
+func generate() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by gindex/gen. DO NOT EDIT.\n\n")
+	buf.WriteString("package gindex\n")
+	for _, l := range layouts {
+		for _, r := range l.roots {
+			constants, err := walk(r.typ, r.typ, nil)
+			if err != nil {
+				return nil, fmt.Errorf("%s %s: %w", l.fork, r.name, err)
+			}
+			buf.WriteString("\nconst (\n")
+			for i, c := range constants {
+				if i > 0 {
+					buf.WriteString("\n")
+				}
+				name := "GIndex" + l.fork + r.name + c.name
+				fmt.Fprintf(&buf,
+					"\t// %s is %s in the %s of the %s fork.\n",
+					name, c.doc, r.description, l.fork,
+				)
+				fmt.Fprintf(&buf, "\t%s = %d\n", name, c.value)
+			}
+			buf.WriteString(")\n")
+		}
+	}
+	return format.Source(buf.Bytes())
+}
+
+// walk returns the constants of the fields of the container typ at the given
+// path in the root, recursing into nested containers and into the first
+// element of lists and vectors of containers.
+func walk(
+	rootTyp, typ schema.SSZType, path []string,
+) ([]constant, error) {
+	var constants []constant
+	for _, field := range schema.ContainerFields(typ) {
+		fieldPath := append(append([]string{}, path...), field.GetName())
+		c, err := index(rootTyp, fieldPath)
+		if err != nil {
+			return nil, err
+		}
+		constants = append(constants, c)
+
+		value := field.GetValue()
+		switch {
+		case value.ID().IsContainer():
+			nested, err := walk(rootTyp, value, fieldPath)
+			if err != nil {
+				return nil, err
+			}
+			constants = append(constants, nested...)
+		case value.ID().IsEnumerable():
+			nested, err := walkElements(rootTyp, value, fieldPath)
+			if err != nil {
+				return nil, err
+			}
+			constants = append(constants, nested...)
+		}
+	}
+	return constants, nil
+}
+
+// walkElements returns the constants of the list or vector typ at the given
+// path: its length if it is a list and, if its elements are containers, the
+// fields of its first element and the distance between the indices of a
+// field of consecutive elements.
+func walkElements(
+	rootTyp, typ schema.SSZType, path []string,
+) ([]constant, error) {
+	var constants []constant
+	if typ.ID().IsList() {
+		c, err := index(rootTyp, append(path, lenPart))
+		if err != nil {
+			return nil, err
+		}
+		constants = append(constants, c)
+	}
+
+	elem := typ.ElementType("")
+	fields := schema.ContainerFields(elem)
+	if len(fields) == 0 {
+		return constants, nil
+	}
+	first, err := index(rootTyp, append(path, "0"))
+	if err != nil {
+		return nil, err
+	}
+	nested, err := walk(rootTyp, elem, append(path, "0"))
+	if err != nil {
+		return nil, err
+	}
+	constants = append(constants, first)
+	constants = append(constants, nested...)
+
+	// Every direct field of an element is at the same depth, so the
+	// distance is the same for all of them.
+	second, err := index(rootTyp, append(path, "1", fields[0].GetName()))
+	if err != nil {
+		return nil, err
+	}
+	return append(constants, constant{
+		name: identifier(path) + "FieldOffset",
+		doc: "the distance between the generalized indices of a field " +
+			"of consecutive elements of " + describe(path),
+		value: second.value - nested[0].value,
+	}), nil
+}
+
+// index returns the constant of the given path in the root.
+func index(rootTyp schema.SSZType, path []string) (constant, error) {
+	_, gIndex, _, err := merkle.ObjectPath[merkle.GeneralizedIndex, [32]byte](
+		strings.Join(path, "/"),
+	).GetGeneralizedIndex(rootTyp)
+	if err != nil {
+		return constant{}, err
+	}
+	return constant{
+		name:  identifier(path),
+		doc:   "the generalized index of " + describe(path),
+		value: uint64(gIndex),
+	}, nil
+}
+
+// identifier returns the identifier of a path, e.g. Validators0Pubkey for
+// Validators/0/Pubkey.
+func identifier(path []string) string {
+	var b strings.Builder
+	for _, part := range path {
+		if part == lenPart {
+			part = "Len"
+		}
+		b.WriteString(part)
+	}
+	return b.String()
+}
+
+// describe returns the field a path addresses, e.g. Validators[0].Pubkey for
+// Validators/0/Pubkey.
+func describe(path []string) string {
+	var b strings.Builder
+	for i, part := range path {
+		switch {
+		case part == lenPart:
+			b.WriteString(" length")
+		case part != "" && part[0] >= '0' && part[0] <= '9':
+			b.WriteString("[" + part + "]")
+		default:
+			if i > 0 {
+				b.WriteString(".")
+			}
+			b.WriteString(part)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,537 @@
+// Code generated by gindex/gen. DO NOT EDIT.
+
+package gindex
+
+const (
+	// GIndexDenebStateGenesisValidatorsRoot is the generalized index of GenesisValidatorsRoot in the BeaconState of the Deneb fork.
+	GIndexDenebStateGenesisValidatorsRoot = 16
+
+	// GIndexDenebStateSlot is the generalized index of Slot in the BeaconState of the Deneb fork.
+	GIndexDenebStateSlot = 17
+
+	// GIndexDenebStateFork is the generalized index of Fork in the BeaconState of the Deneb fork.
+	GIndexDenebStateFork = 18
+
+	// GIndexDenebStateForkPreviousVersion is the generalized index of Fork.PreviousVersion in the BeaconState of the Deneb fork.
+	GIndexDenebStateForkPreviousVersion = 72
+
+	// GIndexDenebStateForkCurrentVersion is the generalized index of Fork.CurrentVersion in the BeaconState of the Deneb fork.
+	GIndexDenebStateForkCurrentVersion = 73
+
+	// GIndexDenebStateForkEpoch is the generalized index of Fork.Epoch in the BeaconState of the Deneb fork.
+	GIndexDenebStateForkEpoch = 74
+
+	// GIndexDenebStateLatestBlockHeader is the generalized index of LatestBlockHeader in the BeaconState of the Deneb fork.
+	GIndexDenebStateLatestBlockHeader = 19
+
+	// GIndexDenebStateLatestBlockHeaderSlot is the generalized index of LatestBlockHeader.Slot in the BeaconState of the Deneb fork.
+	GIndexDenebStateLatestBlockHeaderSlot = 152
+
+	// GIndexDenebStateLatestBlockHeaderProposerIndex is the generalized index of LatestBlockHeader.ProposerIndex in the BeaconState of the Deneb fork.
+	GIndexDenebStateLatestBlockHeaderProposerIndex = 153
+
+	// GIndexDenebStateLatestBlockHeaderParentBlockRoot is the generalized index of LatestBlockHeader.ParentBlockRoot in the BeaconState of the Deneb fork.
+	GIndexDenebStateLatestBlockHeaderParentBlockRoot = 154
+
+	// GIndexDenebStateLatestBlockHeaderStateRoot is the generalized index of LatestBlockHeader.StateRoot in the BeaconState of the Deneb fork.
+	GIndexDenebStateLatestBlockHeaderStateRoot = 155
+
+	// GIndexDenebStateLatestBlockHeaderBodyRoot is the generalized index of LatestBlockHeader.BodyRoot in the BeaconState of the Deneb fork.
+	GIndexDenebStateLatestBlockHeaderBodyRoot = 156
+
+	// GIndexDenebStateBlockRoots is the generalized index of BlockRoots in the BeaconState of the Deneb fork.
+	GIndexDenebStateBlockRoots = 20
+
+	// GIndexDenebStateBlockRootsLen is the generalized index of BlockRoots length in the BeaconState of the Deneb fork.
+	GIndexDenebStateBlockRootsLen = 41
+
+	// GIndexDenebStateStateRoots is the generalized index of StateRoots in the BeaconState of the Deneb fork.
+	GIndexDenebStateStateRoots = 21
+
+	// GIndexDenebStateStateRootsLen is the generalized index of StateRoots length in the BeaconState of the Deneb fork.
+	GIndexDenebStateStateRootsLen = 43
+
+	// GIndexDenebStateEth1Data is the generalized index of Eth1Data in the BeaconState of the Deneb fork.
+	GIndexDenebStateEth1Data = 22
+
+	// GIndexDenebStateEth1DataDepositRoot is the generalized index of Eth1Data.DepositRoot in the BeaconState of the Deneb fork.
+	GIndexDenebStateEth1DataDepositRoot = 88
+
+	// GIndexDenebStateEth1DataDepositCount is the generalized index of Eth1Data.DepositCount in the BeaconState of the Deneb fork.
+	GIndexDenebStateEth1DataDepositCount = 89
+
+	// GIndexDenebStateEth1DataBlockHash is the generalized index of Eth1Data.BlockHash in the BeaconState of the Deneb fork.
+	GIndexDenebStateEth1DataBlockHash = 90
+
+	// GIndexDenebStateEth1DepositIndex is the generalized index of Eth1DepositIndex in the BeaconState of the Deneb fork.
+	GIndexDenebStateEth1DepositIndex = 23
+
+	// GIndexDenebStateLatestExecutionPayloadHeader is the generalized index of LatestExecutionPayloadHeader in the BeaconState of the Deneb fork.
+	GIndexDenebStateLatestExecutionPayloadHeader = 24
+
+	// GIndexDenebStateLatestExecutionPayloadHeaderParentHash is the generalized index of LatestExecutionPayloadHeader.ParentHash in the BeaconState of the Deneb fork.
+	GIndexDenebStateLatestExecutionPayloadHeaderParentHash = 768
+
+	// GIndexDenebStateLatestExecutionPayloadHeaderFeeRecipient is the generalized index of LatestExecutionPayloadHeader.FeeRecipient in the BeaconState of the Deneb fork.
+	GIndexDenebStateLatestExecutionPayloadHeaderFeeRecipient = 769
+
+	// GIndexDenebStateLatestExecutionPayloadHeaderStateRoot is the generalized index of LatestExecutionPayloadHeader.StateRoot in the BeaconState of the Deneb fork.
+	GIndexDenebStateLatestExecutionPayloadHeaderStateRoot = 770
+
+	// GIndexDenebStateLatestExecutionPayloadHeaderReceiptsRoot is the generalized index of LatestExecutionPayloadHeader.ReceiptsRoot in the BeaconState of the Deneb fork.
+	GIndexDenebStateLatestExecutionPayloadHeaderReceiptsRoot = 771
+
+	// GIndexDenebStateLatestExecutionPayloadHeaderLogsBloom is the generalized index of LatestExecutionPayloadHeader.LogsBloom in the BeaconState of the Deneb fork.
+	GIndexDenebStateLatestExecutionPayloadHeaderLogsBloom = 772
+
+	// GIndexDenebStateLatestExecutionPayloadHeaderRandom is the generalized index of LatestExecutionPayloadHeader.Random in the BeaconState of the Deneb fork.
+	GIndexDenebStateLatestExecutionPayloadHeaderRandom = 773
+
+	// GIndexDenebStateLatestExecutionPayloadHeaderNumber is the generalized index of LatestExecutionPayloadHeader.Number in the BeaconState of the Deneb fork.
+	GIndexDenebStateLatestExecutionPayloadHeaderNumber = 774
+
+	// GIndexDenebStateLatestExecutionPayloadHeaderGasLimit is the generalized index of LatestExecutionPayloadHeader.GasLimit in the BeaconState of the Deneb fork.
+	GIndexDenebStateLatestExecutionPayloadHeaderGasLimit = 775
+
+	// GIndexDenebStateLatestExecutionPayloadHeaderGasUsed is the generalized index of LatestExecutionPayloadHeader.GasUsed in the BeaconState of the Deneb fork.
+	GIndexDenebStateLatestExecutionPayloadHeaderGasUsed = 776
+
+	// GIndexDenebStateLatestExecutionPayloadHeaderTimestamp is the generalized index of LatestExecutionPayloadHeader.Timestamp in the BeaconState of the Deneb fork.
+	GIndexDenebStateLatestExecutionPayloadHeaderTimestamp = 777
+
+	// GIndexDenebStateLatestExecutionPayloadHeaderExtraData is the generalized index of LatestExecutionPayloadHeader.ExtraData in the BeaconState of the Deneb fork.
+	GIndexDenebStateLatestExecutionPayloadHeaderExtraData = 778
+
+	// GIndexDenebStateLatestExecutionPayloadHeaderExtraDataLen is the generalized index of LatestExecutionPayloadHeader.ExtraData length in the BeaconState of the Deneb fork.
+	GIndexDenebStateLatestExecutionPayloadHeaderExtraDataLen = 1557
+
+	// GIndexDenebStateLatestExecutionPayloadHeaderBaseFeePerGas is the generalized index of LatestExecutionPayloadHeader.BaseFeePerGas in the BeaconState of the Deneb fork.
+	GIndexDenebStateLatestExecutionPayloadHeaderBaseFeePerGas = 779
+
+	// GIndexDenebStateLatestExecutionPayloadHeaderBlockHash is the generalized index of LatestExecutionPayloadHeader.BlockHash in the BeaconState of the Deneb fork.
+	GIndexDenebStateLatestExecutionPayloadHeaderBlockHash = 780
+
+	// GIndexDenebStateLatestExecutionPayloadHeaderTransactionsRoot is the generalized index of LatestExecutionPayloadHeader.TransactionsRoot in the BeaconState of the Deneb fork.
+	GIndexDenebStateLatestExecutionPayloadHeaderTransactionsRoot = 781
+
+	// GIndexDenebStateLatestExecutionPayloadHeaderWithdrawalsRoot is the generalized index of LatestExecutionPayloadHeader.WithdrawalsRoot in the BeaconState of the Deneb fork.
+	GIndexDenebStateLatestExecutionPayloadHeaderWithdrawalsRoot = 782
+
+	// GIndexDenebStateLatestExecutionPayloadHeaderBlobGasUsed is the generalized index of LatestExecutionPayloadHeader.BlobGasUsed in the BeaconState of the Deneb fork.
+	GIndexDenebStateLatestExecutionPayloadHeaderBlobGasUsed = 783
+
+	// GIndexDenebStateLatestExecutionPayloadHeaderExcessBlobGas is the generalized index of LatestExecutionPayloadHeader.ExcessBlobGas in the BeaconState of the Deneb fork.
+	GIndexDenebStateLatestExecutionPayloadHeaderExcessBlobGas = 784
+
+	// GIndexDenebStateValidators is the generalized index of Validators in the BeaconState of the Deneb fork.
+	GIndexDenebStateValidators = 25
+
+	// GIndexDenebStateValidatorsLen is the generalized index of Validators length in the BeaconState of the Deneb fork.
+	GIndexDenebStateValidatorsLen = 51
+
+	// GIndexDenebStateValidators0 is the generalized index of Validators[0] in the BeaconState of the Deneb fork.
+	GIndexDenebStateValidators0 = 54975581388800
+
+	// GIndexDenebStateValidators0Pubkey is the generalized index of Validators[0].Pubkey in the BeaconState of the Deneb fork.
+	GIndexDenebStateValidators0Pubkey = 439804651110400
+
+	// GIndexDenebStateValidators0WithdrawalCredentials is the generalized index of Validators[0].WithdrawalCredentials in the BeaconState of the Deneb fork.
+	GIndexDenebStateValidators0WithdrawalCredentials = 439804651110401
+
+	// GIndexDenebStateValidators0EffectiveBalance is the generalized index of Validators[0].EffectiveBalance in the BeaconState of the Deneb fork.
+	GIndexDenebStateValidators0EffectiveBalance = 439804651110402
+
+	// GIndexDenebStateValidators0Slashed is the generalized index of Validators[0].Slashed in the BeaconState of the Deneb fork.
+	GIndexDenebStateValidators0Slashed = 439804651110403
+
+	// GIndexDenebStateValidators0ActivationEligibilityEpoch is the generalized index of Validators[0].ActivationEligibilityEpoch in the BeaconState of the Deneb fork.
+	GIndexDenebStateValidators0ActivationEligibilityEpoch = 439804651110404
+
+	// GIndexDenebStateValidators0ActivationEpoch is the generalized index of Validators[0].ActivationEpoch in the BeaconState of the Deneb fork.
+	GIndexDenebStateValidators0ActivationEpoch = 439804651110405
+
+	// GIndexDenebStateValidators0ExitEpoch is the generalized index of Validators[0].ExitEpoch in the BeaconState of the Deneb fork.
+	GIndexDenebStateValidators0ExitEpoch = 439804651110406
+
+	// GIndexDenebStateValidators0WithdrawableEpoch is the generalized index of Validators[0].WithdrawableEpoch in the BeaconState of the Deneb fork.
+	GIndexDenebStateValidators0WithdrawableEpoch = 439804651110407
+
+	// GIndexDenebStateValidatorsFieldOffset is the distance between the generalized indices of a field of consecutive elements of Validators in the BeaconState of the Deneb fork.
+	GIndexDenebStateValidatorsFieldOffset = 8
+
+	// GIndexDenebStateBalances is the generalized index of Balances in the BeaconState of the Deneb fork.
+	GIndexDenebStateBalances = 26
+
+	// GIndexDenebStateBalancesLen is the generalized index of Balances length in the BeaconState of the Deneb fork.
+	GIndexDenebStateBalancesLen = 53
+
+	// GIndexDenebStateRandaoMixes is the generalized index of RandaoMixes in the BeaconState of the Deneb fork.
+	GIndexDenebStateRandaoMixes = 27
+
+	// GIndexDenebStateRandaoMixesLen is the generalized index of RandaoMixes length in the BeaconState of the Deneb fork.
+	GIndexDenebStateRandaoMixesLen = 55
+
+	// GIndexDenebStateNextWithdrawalIndex is the generalized index of NextWithdrawalIndex in the BeaconState of the Deneb fork.
+	GIndexDenebStateNextWithdrawalIndex = 28
+
+	// GIndexDenebStateNextWithdrawalValidatorIndex is the generalized index of NextWithdrawalValidatorIndex in the BeaconState of the Deneb fork.
+	GIndexDenebStateNextWithdrawalValidatorIndex = 29
+
+	// GIndexDenebStateSlashings is the generalized index of Slashings in the BeaconState of the Deneb fork.
+	GIndexDenebStateSlashings = 30
+
+	// GIndexDenebStateSlashingsLen is the generalized index of Slashings length in the BeaconState of the Deneb fork.
+	GIndexDenebStateSlashingsLen = 61
+
+	// GIndexDenebStateTotalSlashing is the generalized index of TotalSlashing in the BeaconState of the Deneb fork.
+	GIndexDenebStateTotalSlashing = 31
+)
+
+const (
+	// GIndexDenebBlockSlot is the generalized index of Slot in the BeaconBlock of the Deneb fork.
+	GIndexDenebBlockSlot = 8
+
+	// GIndexDenebBlockProposerIndex is the generalized index of ProposerIndex in the BeaconBlock of the Deneb fork.
+	GIndexDenebBlockProposerIndex = 9
+
+	// GIndexDenebBlockParentRoot is the generalized index of ParentRoot in the BeaconBlock of the Deneb fork.
+	GIndexDenebBlockParentRoot = 10
+
+	// GIndexDenebBlockStateRoot is the generalized index of StateRoot in the BeaconBlock of the Deneb fork.
+	GIndexDenebBlockStateRoot = 11
+
+	// GIndexDenebBlockBody is the generalized index of Body in the BeaconBlock of the Deneb fork.
+	GIndexDenebBlockBody = 12
+
+	// GIndexDenebBlockBodyRandaoReveal is the generalized index of Body.RandaoReveal in the BeaconBlock of the Deneb fork.
+	GIndexDenebBlockBodyRandaoReveal = 96
+
+	// GIndexDenebBlockBodyEth1Data is the generalized index of Body.Eth1Data in the BeaconBlock of the Deneb fork.
+	GIndexDenebBlockBodyEth1Data = 97
+
+	// GIndexDenebBlockBodyEth1DataDepositRoot is the generalized index of Body.Eth1Data.DepositRoot in the BeaconBlock of the Deneb fork.
+	GIndexDenebBlockBodyEth1DataDepositRoot = 388
+
+	// GIndexDenebBlockBodyEth1DataDepositCount is the generalized index of Body.Eth1Data.DepositCount in the BeaconBlock of the Deneb fork.
+	GIndexDenebBlockBodyEth1DataDepositCount = 389
+
+	// GIndexDenebBlockBodyEth1DataBlockHash is the generalized index of Body.Eth1Data.BlockHash in the BeaconBlock of the Deneb fork.
+	GIndexDenebBlockBodyEth1DataBlockHash = 390
+
+	// GIndexDenebBlockBodyGraffiti is the generalized index of Body.Graffiti in the BeaconBlock of the Deneb fork.
+	GIndexDenebBlockBodyGraffiti = 98
+
+	// GIndexDenebBlockBodyDeposits is the generalized index of Body.Deposits in the BeaconBlock of the Deneb fork.
+	GIndexDenebBlockBodyDeposits = 99
+
+	// GIndexDenebBlockBodyDepositsLen is the generalized index of Body.Deposits length in the BeaconBlock of the Deneb fork.
+	GIndexDenebBlockBodyDepositsLen = 199
+
+	// GIndexDenebBlockBodyDeposits0 is the generalized index of Body.Deposits[0] in the BeaconBlock of the Deneb fork.
+	GIndexDenebBlockBodyDeposits0 = 3168
+
+	// GIndexDenebBlockBodyDeposits0Pubkey is the generalized index of Body.Deposits[0].Pubkey in the BeaconBlock of the Deneb fork.
+	GIndexDenebBlockBodyDeposits0Pubkey = 25344
+
+	// GIndexDenebBlockBodyDeposits0Credentials is the generalized index of Body.Deposits[0].Credentials in the BeaconBlock of the Deneb fork.
+	GIndexDenebBlockBodyDeposits0Credentials = 25345
+
+	// GIndexDenebBlockBodyDeposits0Amount is the generalized index of Body.Deposits[0].Amount in the BeaconBlock of the Deneb fork.
+	GIndexDenebBlockBodyDeposits0Amount = 25346
+
+	// GIndexDenebBlockBodyDeposits0Signature is the generalized index of Body.Deposits[0].Signature in the BeaconBlock of the Deneb fork.
+	GIndexDenebBlockBodyDeposits0Signature = 25347
+
+	// GIndexDenebBlockBodyDeposits0Index is the generalized index of Body.Deposits[0].Index in the BeaconBlock of the Deneb fork.
+	GIndexDenebBlockBodyDeposits0Index = 25348
+
+	// GIndexDenebBlockBodyDepositsFieldOffset is the distance between the generalized indices of a field of consecutive elements of Body.Deposits in the BeaconBlock of the Deneb fork.
+	GIndexDenebBlockBodyDepositsFieldOffset = 8
+
+	// GIndexDenebBlockBodyExecutionPayload is the generalized index of Body.ExecutionPayload in the BeaconBlock of the Deneb fork.
+	GIndexDenebBlockBodyExecutionPayload = 100
+
+	// GIndexDenebBlockBodyExecutionPayloadParentHash is the generalized index of Body.ExecutionPayload.ParentHash in the BeaconBlock of the Deneb fork.
+	GIndexDenebBlockBodyExecutionPayloadParentHash = 3200
+
+	// GIndexDenebBlockBodyExecutionPayloadFeeRecipient is the generalized index of Body.ExecutionPayload.FeeRecipient in the BeaconBlock of the Deneb fork.
+	GIndexDenebBlockBodyExecutionPayloadFeeRecipient = 3201
+
+	// GIndexDenebBlockBodyExecutionPayloadStateRoot is the generalized index of Body.ExecutionPayload.StateRoot in the BeaconBlock of the Deneb fork.
+	GIndexDenebBlockBodyExecutionPayloadStateRoot = 3202
+
+	// GIndexDenebBlockBodyExecutionPayloadReceiptsRoot is the generalized index of Body.ExecutionPayload.ReceiptsRoot in the BeaconBlock of the Deneb fork.
+	GIndexDenebBlockBodyExecutionPayloadReceiptsRoot = 3203
+
+	// GIndexDenebBlockBodyExecutionPayloadLogsBloom is the generalized index of Body.ExecutionPayload.LogsBloom in the BeaconBlock of the Deneb fork.
+	GIndexDenebBlockBodyExecutionPayloadLogsBloom = 3204
+
+	// GIndexDenebBlockBodyExecutionPayloadRandom is the generalized index of Body.ExecutionPayload.Random in the BeaconBlock of the Deneb fork.
+	GIndexDenebBlockBodyExecutionPayloadRandom = 3205
+
+	// GIndexDenebBlockBodyExecutionPayloadNumber is the generalized index of Body.ExecutionPayload.Number in the BeaconBlock of the Deneb fork.
+	GIndexDenebBlockBodyExecutionPayloadNumber = 3206
+
+	// GIndexDenebBlockBodyExecutionPayloadGasLimit is the generalized index of Body.ExecutionPayload.GasLimit in the BeaconBlock of the Deneb fork.
+	GIndexDenebBlockBodyExecutionPayloadGasLimit = 3207
+
+	// GIndexDenebBlockBodyExecutionPayloadGasUsed is the generalized index of Body.ExecutionPayload.GasUsed in the BeaconBlock of the Deneb fork.
+	GIndexDenebBlockBodyExecutionPayloadGasUsed = 3208
+
+	// GIndexDenebBlockBodyExecutionPayloadTimestamp is the generalized index of Body.ExecutionPayload.Timestamp in the BeaconBlock of the Deneb fork.
+	GIndexDenebBlockBodyExecutionPayloadTimestamp = 3209
+
+	// GIndexDenebBlockBodyExecutionPayloadExtraData is the generalized index of Body.ExecutionPayload.ExtraData in the BeaconBlock of the Deneb fork.
+	GIndexDenebBlockBodyExecutionPayloadExtraData = 3210
+
+	// GIndexDenebBlockBodyExecutionPayloadExtraDataLen is the generalized index of Body.ExecutionPayload.ExtraData length in the BeaconBlock of the Deneb fork.
+	GIndexDenebBlockBodyExecutionPayloadExtraDataLen = 6421
+
+	// GIndexDenebBlockBodyExecutionPayloadBaseFeePerGas is the generalized index of Body.ExecutionPayload.BaseFeePerGas in the BeaconBlock of the Deneb fork.
+	GIndexDenebBlockBodyExecutionPayloadBaseFeePerGas = 3211
+
+	// GIndexDenebBlockBodyExecutionPayloadBlockHash is the generalized index of Body.ExecutionPayload.BlockHash in the BeaconBlock of the Deneb fork.
+	GIndexDenebBlockBodyExecutionPayloadBlockHash = 3212
+
+	// GIndexDenebBlockBodyExecutionPayloadTransactions is the generalized index of Body.ExecutionPayload.Transactions in the BeaconBlock of the Deneb fork.
+	GIndexDenebBlockBodyExecutionPayloadTransactions = 3213
+
+	// GIndexDenebBlockBodyExecutionPayloadTransactionsLen is the generalized index of Body.ExecutionPayload.Transactions length in the BeaconBlock of the Deneb fork.
+	GIndexDenebBlockBodyExecutionPayloadTransactionsLen = 6427
+
+	// GIndexDenebBlockBodyExecutionPayloadWithdrawals is the generalized index of Body.ExecutionPayload.Withdrawals in the BeaconBlock of the Deneb fork.
+	GIndexDenebBlockBodyExecutionPayloadWithdrawals = 3214
+
+	// GIndexDenebBlockBodyExecutionPayloadWithdrawalsLen is the generalized index of Body.ExecutionPayload.Withdrawals length in the BeaconBlock of the Deneb fork.
+	GIndexDenebBlockBodyExecutionPayloadWithdrawalsLen = 6429
+
+	// GIndexDenebBlockBodyExecutionPayloadWithdrawals0 is the generalized index of Body.ExecutionPayload.Withdrawals[0] in the BeaconBlock of the Deneb fork.
+	GIndexDenebBlockBodyExecutionPayloadWithdrawals0 = 102848
+
+	// GIndexDenebBlockBodyExecutionPayloadWithdrawals0Index is the generalized index of Body.ExecutionPayload.Withdrawals[0].Index in the BeaconBlock of the Deneb fork.
+	GIndexDenebBlockBodyExecutionPayloadWithdrawals0Index = 411392
+
+	// GIndexDenebBlockBodyExecutionPayloadWithdrawals0Validator is the generalized index of Body.ExecutionPayload.Withdrawals[0].Validator in the BeaconBlock of the Deneb fork.
+	GIndexDenebBlockBodyExecutionPayloadWithdrawals0Validator = 411393
+
+	// GIndexDenebBlockBodyExecutionPayloadWithdrawals0Address is the generalized index of Body.ExecutionPayload.Withdrawals[0].Address in the BeaconBlock of the Deneb fork.
+	GIndexDenebBlockBodyExecutionPayloadWithdrawals0Address = 411394
+
+	// GIndexDenebBlockBodyExecutionPayloadWithdrawals0Amount is the generalized index of Body.ExecutionPayload.Withdrawals[0].Amount in the BeaconBlock of the Deneb fork.
+	GIndexDenebBlockBodyExecutionPayloadWithdrawals0Amount = 411395
+
+	// GIndexDenebBlockBodyExecutionPayloadWithdrawalsFieldOffset is the distance between the generalized indices of a field of consecutive elements of Body.ExecutionPayload.Withdrawals in the BeaconBlock of the Deneb fork.
+	GIndexDenebBlockBodyExecutionPayloadWithdrawalsFieldOffset = 4
+
+	// GIndexDenebBlockBodyExecutionPayloadBlobGasUsed is the generalized index of Body.ExecutionPayload.BlobGasUsed in the BeaconBlock of the Deneb fork.
+	GIndexDenebBlockBodyExecutionPayloadBlobGasUsed = 3215
+
+	// GIndexDenebBlockBodyExecutionPayloadExcessBlobGas is the generalized index of Body.ExecutionPayload.ExcessBlobGas in the BeaconBlock of the Deneb fork.
+	GIndexDenebBlockBodyExecutionPayloadExcessBlobGas = 3216
+
+	// GIndexDenebBlockBodyBlobKzgCommitments is the generalized index of Body.BlobKzgCommitments in the BeaconBlock of the Deneb fork.
+	GIndexDenebBlockBodyBlobKzgCommitments = 101
+
+	// GIndexDenebBlockBodyBlobKzgCommitmentsLen is the generalized index of Body.BlobKzgCommitments length in the BeaconBlock of the Deneb fork.
+	GIndexDenebBlockBodyBlobKzgCommitmentsLen = 203
+)
+
+const (
+	// GIndexDenebHeaderSlot is the generalized index of Slot in the BeaconBlockHeader, with the state root expanded into the BeaconState of the Deneb fork.
+	GIndexDenebHeaderSlot = 8
+
+	// GIndexDenebHeaderProposerIndex is the generalized index of ProposerIndex in the BeaconBlockHeader, with the state root expanded into the BeaconState of the Deneb fork.
+	GIndexDenebHeaderProposerIndex = 9
+
+	// GIndexDenebHeaderParentBlockRoot is the generalized index of ParentBlockRoot in the BeaconBlockHeader, with the state root expanded into the BeaconState of the Deneb fork.
+	GIndexDenebHeaderParentBlockRoot = 10
+
+	// GIndexDenebHeaderState is the generalized index of State in the BeaconBlockHeader, with the state root expanded into the BeaconState of the Deneb fork.
+	GIndexDenebHeaderState = 11
+
+	// GIndexDenebHeaderStateGenesisValidatorsRoot is the generalized index of State.GenesisValidatorsRoot in the BeaconBlockHeader, with the state root expanded into the BeaconState of the Deneb fork.
+	GIndexDenebHeaderStateGenesisValidatorsRoot = 176
+
+	// GIndexDenebHeaderStateSlot is the generalized index of State.Slot in the BeaconBlockHeader, with the state root expanded into the BeaconState of the Deneb fork.
+	GIndexDenebHeaderStateSlot = 177
+
+	// GIndexDenebHeaderStateFork is the generalized index of State.Fork in the BeaconBlockHeader, with the state root expanded into the BeaconState of the Deneb fork.
+	GIndexDenebHeaderStateFork = 178
+
+	// GIndexDenebHeaderStateForkPreviousVersion is the generalized index of State.Fork.PreviousVersion in the BeaconBlockHeader, with the state root expanded into the BeaconState of the Deneb fork.
+	GIndexDenebHeaderStateForkPreviousVersion = 712
+
+	// GIndexDenebHeaderStateForkCurrentVersion is the generalized index of State.Fork.CurrentVersion in the BeaconBlockHeader, with the state root expanded into the BeaconState of the Deneb fork.
+	GIndexDenebHeaderStateForkCurrentVersion = 713
+
+	// GIndexDenebHeaderStateForkEpoch is the generalized index of State.Fork.Epoch in the BeaconBlockHeader, with the state root expanded into the BeaconState of the Deneb fork.
+	GIndexDenebHeaderStateForkEpoch = 714
+
+	// GIndexDenebHeaderStateLatestBlockHeader is the generalized index of State.LatestBlockHeader in the BeaconBlockHeader, with the state root expanded into the BeaconState of the Deneb fork.
+	GIndexDenebHeaderStateLatestBlockHeader = 179
+
+	// GIndexDenebHeaderStateLatestBlockHeaderSlot is the generalized index of State.LatestBlockHeader.Slot in the BeaconBlockHeader, with the state root expanded into the BeaconState of the Deneb fork.
+	GIndexDenebHeaderStateLatestBlockHeaderSlot = 1432
+
+	// GIndexDenebHeaderStateLatestBlockHeaderProposerIndex is the generalized index of State.LatestBlockHeader.ProposerIndex in the BeaconBlockHeader, with the state root expanded into the BeaconState of the Deneb fork.
+	GIndexDenebHeaderStateLatestBlockHeaderProposerIndex = 1433
+
+	// GIndexDenebHeaderStateLatestBlockHeaderParentBlockRoot is the generalized index of State.LatestBlockHeader.ParentBlockRoot in the BeaconBlockHeader, with the state root expanded into the BeaconState of the Deneb fork.
+	GIndexDenebHeaderStateLatestBlockHeaderParentBlockRoot = 1434
+
+	// GIndexDenebHeaderStateLatestBlockHeaderStateRoot is the generalized index of State.LatestBlockHeader.StateRoot in the BeaconBlockHeader, with the state root expanded into the BeaconState of the Deneb fork.
+	GIndexDenebHeaderStateLatestBlockHeaderStateRoot = 1435
+
+	// GIndexDenebHeaderStateLatestBlockHeaderBodyRoot is the generalized index of State.LatestBlockHeader.BodyRoot in the BeaconBlockHeader, with the state root expanded into the BeaconState of the Deneb fork.
+	GIndexDenebHeaderStateLatestBlockHeaderBodyRoot = 1436
+
+	// GIndexDenebHeaderStateBlockRoots is the generalized index of State.BlockRoots in the BeaconBlockHeader, with the state root expanded into the BeaconState of the Deneb fork.
+	GIndexDenebHeaderStateBlockRoots = 180
+
+	// GIndexDenebHeaderStateBlockRootsLen is the generalized index of State.BlockRoots length in the BeaconBlockHeader, with the state root expanded into the BeaconState of the Deneb fork.
+	GIndexDenebHeaderStateBlockRootsLen = 361
+
+	// GIndexDenebHeaderStateStateRoots is the generalized index of State.StateRoots in the BeaconBlockHeader, with the state root expanded into the BeaconState of the Deneb fork.
+	GIndexDenebHeaderStateStateRoots = 181
+
+	// GIndexDenebHeaderStateStateRootsLen is the generalized index of State.StateRoots length in the BeaconBlockHeader, with the state root expanded into the BeaconState of the Deneb fork.
+	GIndexDenebHeaderStateStateRootsLen = 363
+
+	// GIndexDenebHeaderStateEth1Data is the generalized index of State.Eth1Data in the BeaconBlockHeader, with the state root expanded into the BeaconState of the Deneb fork.
+	GIndexDenebHeaderStateEth1Data = 182
+
+	// GIndexDenebHeaderStateEth1DataDepositRoot is the generalized index of State.Eth1Data.DepositRoot in the BeaconBlockHeader, with the state root expanded into the BeaconState of the Deneb fork.
+	GIndexDenebHeaderStateEth1DataDepositRoot = 728
+
+	// GIndexDenebHeaderStateEth1DataDepositCount is the generalized index of State.Eth1Data.DepositCount in the BeaconBlockHeader, with the state root expanded into the BeaconState of the Deneb fork.
+	GIndexDenebHeaderStateEth1DataDepositCount = 729
+
+	// GIndexDenebHeaderStateEth1DataBlockHash is the generalized index of State.Eth1Data.BlockHash in the BeaconBlockHeader, with the state root expanded into the BeaconState of the Deneb fork.
+	GIndexDenebHeaderStateEth1DataBlockHash = 730
+
+	// GIndexDenebHeaderStateEth1DepositIndex is the generalized index of State.Eth1DepositIndex in the BeaconBlockHeader, with the state root expanded into the BeaconState of the Deneb fork.
+	GIndexDenebHeaderStateEth1DepositIndex = 183
+
+	// GIndexDenebHeaderStateLatestExecutionPayloadHeader is the generalized index of State.LatestExecutionPayloadHeader in the BeaconBlockHeader, with the state root expanded into the BeaconState of the Deneb fork.
+	GIndexDenebHeaderStateLatestExecutionPayloadHeader = 184
+
+	// GIndexDenebHeaderStateLatestExecutionPayloadHeaderParentHash is the generalized index of State.LatestExecutionPayloadHeader.ParentHash in the BeaconBlockHeader, with the state root expanded into the BeaconState of the Deneb fork.
+	GIndexDenebHeaderStateLatestExecutionPayloadHeaderParentHash = 5888
+
+	// GIndexDenebHeaderStateLatestExecutionPayloadHeaderFeeRecipient is the generalized index of State.LatestExecutionPayloadHeader.FeeRecipient in the BeaconBlockHeader, with the state root expanded into the BeaconState of the Deneb fork.
+	GIndexDenebHeaderStateLatestExecutionPayloadHeaderFeeRecipient = 5889
+
+	// GIndexDenebHeaderStateLatestExecutionPayloadHeaderStateRoot is the generalized index of State.LatestExecutionPayloadHeader.StateRoot in the BeaconBlockHeader, with the state root expanded into the BeaconState of the Deneb fork.
+	GIndexDenebHeaderStateLatestExecutionPayloadHeaderStateRoot = 5890
+
+	// GIndexDenebHeaderStateLatestExecutionPayloadHeaderReceiptsRoot is the generalized index of State.LatestExecutionPayloadHeader.ReceiptsRoot in the BeaconBlockHeader, with the state root expanded into the BeaconState of the Deneb fork.
+	GIndexDenebHeaderStateLatestExecutionPayloadHeaderReceiptsRoot = 5891
+
+	// GIndexDenebHeaderStateLatestExecutionPayloadHeaderLogsBloom is the generalized index of State.LatestExecutionPayloadHeader.LogsBloom in the BeaconBlockHeader, with the state root expanded into the BeaconState of the Deneb fork.
+	GIndexDenebHeaderStateLatestExecutionPayloadHeaderLogsBloom = 5892
+
+	// GIndexDenebHeaderStateLatestExecutionPayloadHeaderRandom is the generalized index of State.LatestExecutionPayloadHeader.Random in the BeaconBlockHeader, with the state root expanded into the BeaconState of the Deneb fork.
+	GIndexDenebHeaderStateLatestExecutionPayloadHeaderRandom = 5893
+
+	// GIndexDenebHeaderStateLatestExecutionPayloadHeaderNumber is the generalized index of State.LatestExecutionPayloadHeader.Number in the BeaconBlockHeader, with the state root expanded into the BeaconState of the Deneb fork.
+	GIndexDenebHeaderStateLatestExecutionPayloadHeaderNumber = 5894
+
+	// GIndexDenebHeaderStateLatestExecutionPayloadHeaderGasLimit is the generalized index of State.LatestExecutionPayloadHeader.GasLimit in the BeaconBlockHeader, with the state root expanded into the BeaconState of the Deneb fork.
+	GIndexDenebHeaderStateLatestExecutionPayloadHeaderGasLimit = 5895
+
+	// GIndexDenebHeaderStateLatestExecutionPayloadHeaderGasUsed is the generalized index of State.LatestExecutionPayloadHeader.GasUsed in the BeaconBlockHeader, with the state root expanded into the BeaconState of the Deneb fork.
+	GIndexDenebHeaderStateLatestExecutionPayloadHeaderGasUsed = 5896
+
+	// GIndexDenebHeaderStateLatestExecutionPayloadHeaderTimestamp is the generalized index of State.LatestExecutionPayloadHeader.Timestamp in the BeaconBlockHeader, with the state root expanded into the BeaconState of the Deneb fork.
+	GIndexDenebHeaderStateLatestExecutionPayloadHeaderTimestamp = 5897
+
+	// GIndexDenebHeaderStateLatestExecutionPayloadHeaderExtraData is the generalized index of State.LatestExecutionPayloadHeader.ExtraData in the BeaconBlockHeader, with the state root expanded into the BeaconState of the Deneb fork.
+	GIndexDenebHeaderStateLatestExecutionPayloadHeaderExtraData = 5898
+
+	// GIndexDenebHeaderStateLatestExecutionPayloadHeaderExtraDataLen is the generalized index of State.LatestExecutionPayloadHeader.ExtraData length in the BeaconBlockHeader, with the state root expanded into the BeaconState of the Deneb fork.
+	GIndexDenebHeaderStateLatestExecutionPayloadHeaderExtraDataLen = 11797
+
+	// GIndexDenebHeaderStateLatestExecutionPayloadHeaderBaseFeePerGas is the generalized index of State.LatestExecutionPayloadHeader.BaseFeePerGas in the BeaconBlockHeader, with the state root expanded into the BeaconState of the Deneb fork.
+	GIndexDenebHeaderStateLatestExecutionPayloadHeaderBaseFeePerGas = 5899
+
+	// GIndexDenebHeaderStateLatestExecutionPayloadHeaderBlockHash is the generalized index of State.LatestExecutionPayloadHeader.BlockHash in the BeaconBlockHeader, with the state root expanded into the BeaconState of the Deneb fork.
+	GIndexDenebHeaderStateLatestExecutionPayloadHeaderBlockHash = 5900
+
+	// GIndexDenebHeaderStateLatestExecutionPayloadHeaderTransactionsRoot is the generalized index of State.LatestExecutionPayloadHeader.TransactionsRoot in the BeaconBlockHeader, with the state root expanded into the BeaconState of the Deneb fork.
+	GIndexDenebHeaderStateLatestExecutionPayloadHeaderTransactionsRoot = 5901
+
+	// GIndexDenebHeaderStateLatestExecutionPayloadHeaderWithdrawalsRoot is the generalized index of State.LatestExecutionPayloadHeader.WithdrawalsRoot in the BeaconBlockHeader, with the state root expanded into the BeaconState of the Deneb fork.
+	GIndexDenebHeaderStateLatestExecutionPayloadHeaderWithdrawalsRoot = 5902
+
+	// GIndexDenebHeaderStateLatestExecutionPayloadHeaderBlobGasUsed is the generalized index of State.LatestExecutionPayloadHeader.BlobGasUsed in the BeaconBlockHeader, with the state root expanded into the BeaconState of the Deneb fork.
+	GIndexDenebHeaderStateLatestExecutionPayloadHeaderBlobGasUsed = 5903
+
+	// GIndexDenebHeaderStateLatestExecutionPayloadHeaderExcessBlobGas is the generalized index of State.LatestExecutionPayloadHeader.ExcessBlobGas in the BeaconBlockHeader, with the state root expanded into the BeaconState of the Deneb fork.
+	GIndexDenebHeaderStateLatestExecutionPayloadHeaderExcessBlobGas = 5904
+
+	// GIndexDenebHeaderStateValidators is the generalized index of State.Validators in the BeaconBlockHeader, with the state root expanded into the BeaconState of the Deneb fork.
+	GIndexDenebHeaderStateValidators = 185
+
+	// GIndexDenebHeaderStateValidatorsLen is the generalized index of State.Validators length in the BeaconBlockHeader, with the state root expanded into the BeaconState of the Deneb fork.
+	GIndexDenebHeaderStateValidatorsLen = 371
+
+	// GIndexDenebHeaderStateValidators0 is the generalized index of State.Validators[0] in the BeaconBlockHeader, with the state root expanded into the BeaconState of the Deneb fork.
+	GIndexDenebHeaderStateValidators0 = 406819302277120
+
+	// GIndexDenebHeaderStateValidators0Pubkey is the generalized index of State.Validators[0].Pubkey in the BeaconBlockHeader, with the state root expanded into the BeaconState of the Deneb fork.
+	GIndexDenebHeaderStateValidators0Pubkey = 3254554418216960
+
+	// GIndexDenebHeaderStateValidators0WithdrawalCredentials is the generalized index of State.Validators[0].WithdrawalCredentials in the BeaconBlockHeader, with the state root expanded into the BeaconState of the Deneb fork.
+	GIndexDenebHeaderStateValidators0WithdrawalCredentials = 3254554418216961
+
+	// GIndexDenebHeaderStateValidators0EffectiveBalance is the generalized index of State.Validators[0].EffectiveBalance in the BeaconBlockHeader, with the state root expanded into the BeaconState of the Deneb fork.
+	GIndexDenebHeaderStateValidators0EffectiveBalance = 3254554418216962
+
+	// GIndexDenebHeaderStateValidators0Slashed is the generalized index of State.Validators[0].Slashed in the BeaconBlockHeader, with the state root expanded into the BeaconState of the Deneb fork.
+	GIndexDenebHeaderStateValidators0Slashed = 3254554418216963
+
+	// GIndexDenebHeaderStateValidators0ActivationEligibilityEpoch is the generalized index of State.Validators[0].ActivationEligibilityEpoch in the BeaconBlockHeader, with the state root expanded into the BeaconState of the Deneb fork.
+	GIndexDenebHeaderStateValidators0ActivationEligibilityEpoch = 3254554418216964
+
+	// GIndexDenebHeaderStateValidators0ActivationEpoch is the generalized index of State.Validators[0].ActivationEpoch in the BeaconBlockHeader, with the state root expanded into the BeaconState of the Deneb fork.
+	GIndexDenebHeaderStateValidators0ActivationEpoch = 3254554418216965
+
+	// GIndexDenebHeaderStateValidators0ExitEpoch is the generalized index of State.Validators[0].ExitEpoch in the BeaconBlockHeader, with the state root expanded into the BeaconState of the Deneb fork.
+	GIndexDenebHeaderStateValidators0ExitEpoch = 3254554418216966
+
+	// GIndexDenebHeaderStateValidators0WithdrawableEpoch is the generalized index of State.Validators[0].WithdrawableEpoch in the BeaconBlockHeader, with the state root expanded into the BeaconState of the Deneb fork.
+	GIndexDenebHeaderStateValidators0WithdrawableEpoch = 3254554418216967
+
+	// GIndexDenebHeaderStateValidatorsFieldOffset is the distance between the generalized indices of a field of consecutive elements of State.Validators in the BeaconBlockHeader, with the state root expanded into the BeaconState of the Deneb fork.
+	GIndexDenebHeaderStateValidatorsFieldOffset = 8
+
+	// GIndexDenebHeaderStateBalances is the generalized index of State.Balances in the BeaconBlockHeader, with the state root expanded into the BeaconState of the Deneb fork.
+	GIndexDenebHeaderStateBalances = 186
+
+	// GIndexDenebHeaderStateBalancesLen is the generalized index of State.Balances length in the BeaconBlockHeader, with the state root expanded into the BeaconState of the Deneb fork.
+	GIndexDenebHeaderStateBalancesLen = 373
+
+	// GIndexDenebHeaderStateRandaoMixes is the generalized index of State.RandaoMixes in the BeaconBlockHeader, with the state root expanded into the BeaconState of the Deneb fork.
+	GIndexDenebHeaderStateRandaoMixes = 187
+
+	// GIndexDenebHeaderStateRandaoMixesLen is the generalized index of State.RandaoMixes length in the BeaconBlockHeader, with the state root expanded into the BeaconState of the Deneb fork.
+	GIndexDenebHeaderStateRandaoMixesLen = 375
+
+	// GIndexDenebHeaderStateNextWithdrawalIndex is the generalized index of State.NextWithdrawalIndex in the BeaconBlockHeader, with the state root expanded into the BeaconState of the Deneb fork.
+	GIndexDenebHeaderStateNextWithdrawalIndex = 188
+
+	// GIndexDenebHeaderStateNextWithdrawalValidatorIndex is the generalized index of State.NextWithdrawalValidatorIndex in the BeaconBlockHeader, with the state root expanded into the BeaconState of the Deneb fork.
+	GIndexDenebHeaderStateNextWithdrawalValidatorIndex = 189
+
+	// GIndexDenebHeaderStateSlashings is the generalized index of State.Slashings in the BeaconBlockHeader, with the state root expanded into the BeaconState of the Deneb fork.
+	GIndexDenebHeaderStateSlashings = 190
+
+	// GIndexDenebHeaderStateSlashingsLen is the generalized index of State.Slashings length in the BeaconBlockHeader, with the state root expanded into the BeaconState of the Deneb fork.
+	GIndexDenebHeaderStateSlashingsLen = 381
+
+	// GIndexDenebHeaderStateTotalSlashing is the generalized index of State.TotalSlashing in the BeaconBlockHeader, with the state root expanded into the BeaconState of the Deneb fork.
+	GIndexDenebHeaderStateTotalSlashing = 191
+
+	// GIndexDenebHeaderBodyRoot is the generalized index of BodyRoot in the BeaconBlockHeader, with the state root expanded into the BeaconState of the Deneb fork.
+	GIndexDenebHeaderBodyRoot = 12
+)
@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package gindex_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/consensus-types/gindex"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/encoding/ssz/merkle"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGIndicesDenebBlock(t *testing.T) {
+	block := &types.BeaconBlock{
+		Slot:          7,
+		ProposerIndex: 3,
+		ParentRoot:    common.Root{0x01},
+		StateRoot:     common.Root{0x02},
+		Body: &types.BeaconBlockBody{
+			Eth1Data: &types.Eth1Data{},
+			ExecutionPayload: &types.ExecutionPayload{
+				BaseFeePerGas: math.NewU256(0),
+			},
+		},
+	}
+	root := block.HashTreeRoot()
+
+	leaf, proof, err := merkle.Prove[common.Root](
+		block, gindex.GIndexDenebBlockProposerIndex,
+	)
+	require.NoError(t, err)
+	require.Equal(t, common.Root{0x03}, leaf)
+	ok, err := merkle.VerifyProof(
+		gindex.GIndexDenebBlockProposerIndex, leaf, proof, root,
+	)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	leaf, _, err = merkle.Prove[common.Root](
+		block, gindex.GIndexDenebBlockBody,
+	)
+	require.NoError(t, err)
+	require.Equal(t, block.GetBody().HashTreeRoot(), leaf)
+}
+
+func TestGIndicesDenebBlobKzgCommitments(t *testing.T) {
+	// KZGMerkleIndexDeneb is the index of the data of the commitments in
+	// the body, the left child of the commitments list.
+	depth := uint64(4)
+	require.Equal(t,
+		uint64(gindex.GIndexDenebBlockBodyBlobKzgCommitments)*2,
+		uint64(gindex.GIndexDenebBlockBody)<<depth|
+			(types.KZGMerkleIndexDeneb-1<<depth),
+	)
+}
+
+func TestGIndicesDenebHeader(t *testing.T) {
+	// The indices in the header are the indices in the state concatenated
+	// to the index of the state root.
+	concat := func(state uint64) uint64 {
+		depth := uint64(0)
+		for s := state; s > 1; s >>= 1 {
+			depth++
+		}
+		return gindex.GIndexDenebHeaderState<<depth | (state - 1<<depth)
+	}
+	require.Equal(t,
+		uint64(gindex.GIndexDenebHeaderStateValidators0Pubkey),
+		concat(gindex.GIndexDenebStateValidators0Pubkey),
+	)
+	require.Equal(t,
+		uint64(gindex.GIndexDenebHeaderStateLatestExecutionPayloadHeaderNumber),
+		concat(gindex.GIndexDenebStateLatestExecutionPayloadHeaderNumber),
+	)
+	require.Equal(t, uint64(8), uint64(
+		gindex.GIndexDenebStateValidatorsFieldOffset,
+	))
+}
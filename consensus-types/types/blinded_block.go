@@ -0,0 +1,271 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/eip4844"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/transition"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/karalabe/ssz"
+)
+
+// BlindedBeaconBlock is a beacon block carrying the header of its execution
+// payload in place of the payload. Since the header and the payload have the
+// same hash tree root, so do the blinded and the full block, and a signature
+// over the blinded block signs the full block.
+type BlindedBeaconBlock struct {
+	// Slot represents the position of the block in the chain.
+	Slot math.Slot `json:"slot"`
+	// ProposerIndex is the index of the validator who proposed the block.
+	ProposerIndex math.ValidatorIndex `json:"proposer_index"`
+	// ParentRoot is the hash of the parent block
+	ParentRoot common.Root `json:"parent_root"`
+	// StateRoot is the hash of the state at the block.
+	StateRoot common.Root `json:"state_root"`
+	// Body is the body of the BlindedBeaconBlock.
+	Body *BlindedBeaconBlockBody `json:"body"`
+}
+
+// BlindedBeaconBlockBody is the body of a BlindedBeaconBlock.
+type BlindedBeaconBlockBody struct {
+	// RandaoReveal is the reveal of the RANDAO.
+	RandaoReveal crypto.BLSSignature
+	// Eth1Data is the data from the Eth1 chain.
+	Eth1Data *Eth1Data
+	// Graffiti is for a fun message or meme.
+	Graffiti [32]byte
+	// Deposits is the list of deposits included in the body.
+	Deposits []*Deposit
+	// ExecutionPayloadHeader is the header of the execution payload of the
+	// body.
+	ExecutionPayloadHeader *ExecutionPayloadHeader
+	// BlobKzgCommitments is the list of KZG commitments for the EIP-4844 blobs.
+	BlobKzgCommitments []eip4844.KZGCommitment
+	// Operations is the list of application-defined operations included in
+	// the body, encoded as in the full body.
+	Operations []*transition.Operation
+
+	// forkVersion is the fork version of the body, which selects its layout.
+	// It is left zero for Deneb bodies, see bodyVersion.
+	forkVersion uint32
+}
+
+// Blind returns the block with the header of its execution payload in place
+// of the payload.
+func (b *BeaconBlock) Blind() (*BlindedBeaconBlock, error) {
+	if b.Body == nil {
+		return nil, ErrNilBlockBody
+	}
+	if b.Body.ExecutionPayload == nil {
+		return nil, ErrNilPayload
+	}
+	header, err := b.Body.ExecutionPayload.ToHeader()
+	if err != nil {
+		return nil, err
+	}
+	return &BlindedBeaconBlock{
+		Slot:          b.Slot,
+		ProposerIndex: b.ProposerIndex,
+		ParentRoot:    b.ParentRoot,
+		StateRoot:     b.StateRoot,
+		Body: &BlindedBeaconBlockBody{
+			RandaoReveal:           b.Body.RandaoReveal,
+			Eth1Data:               b.Body.Eth1Data,
+			Graffiti:               b.Body.Graffiti,
+			Deposits:               b.Body.Deposits,
+			ExecutionPayloadHeader: header,
+			BlobKzgCommitments:     b.Body.BlobKzgCommitments,
+			Operations:             b.Body.Operations,
+			forkVersion:            b.Body.forkVersion,
+		},
+	}, nil
+}
+
+// Unblind returns the block with the given execution payload in place of its
+// header. The payload must match the header the block commits to.
+func (b *BlindedBeaconBlock) Unblind(
+	payload *ExecutionPayload,
+) (*BeaconBlock, error) {
+	if b.Body == nil {
+		return nil, ErrNilBlockBody
+	}
+	if b.Body.ExecutionPayloadHeader == nil {
+		return nil, ErrNilPayloadHeader
+	}
+	if payload == nil {
+		return nil, ErrNilPayload
+	}
+	header, err := payload.ToHeader()
+	if err != nil {
+		return nil, err
+	}
+	if committed, revealed := b.Body.ExecutionPayloadHeader.HashTreeRoot(),
+		header.HashTreeRoot(); committed != revealed {
+		return nil, errors.Wrapf(
+			ErrPayloadHeaderMismatch, "committed %s, revealed %s",
+			committed, revealed,
+		)
+	}
+	return &BeaconBlock{
+		Slot:          b.Slot,
+		ProposerIndex: b.ProposerIndex,
+		ParentRoot:    b.ParentRoot,
+		StateRoot:     b.StateRoot,
+		Body: &BeaconBlockBody{
+			RandaoReveal:       b.Body.RandaoReveal,
+			Eth1Data:           b.Body.Eth1Data,
+			Graffiti:           b.Body.Graffiti,
+			Deposits:           b.Body.Deposits,
+			ExecutionPayload:   payload,
+			BlobKzgCommitments: b.Body.BlobKzgCommitments,
+			Operations:         b.Body.Operations,
+			forkVersion:        b.Body.forkVersion,
+		},
+	}, nil
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                     SSZ                                    */
+/* -------------------------------------------------------------------------- */
+
+// SizeSSZ returns the size of the BlindedBeaconBlock in SSZ.
+func (b *BlindedBeaconBlock) SizeSSZ(siz *ssz.Sizer, fixed bool) uint32 {
+	//nolint:mnd // fixed fields of the block.
+	var size = uint32(8 + 8 + 32 + 32 + 4)
+	if fixed {
+		return size
+	}
+	size += ssz.SizeDynamicObject(siz, b.Body)
+	return size
+}
+
+// DefineSSZ defines the SSZ encoding of the BlindedBeaconBlock.
+func (b *BlindedBeaconBlock) DefineSSZ(codec *ssz.Codec) {
+	// Define the static data (fields and dynamic offsets)
+	ssz.DefineUint64(codec, &b.Slot)
+	ssz.DefineUint64(codec, &b.ProposerIndex)
+	ssz.DefineStaticBytes(codec, &b.ParentRoot)
+	ssz.DefineStaticBytes(codec, &b.StateRoot)
+	ssz.DefineDynamicObjectOffset(codec, &b.Body)
+
+	// Define the dynamic data (fields)
+	ssz.DefineDynamicObjectContent(codec, &b.Body)
+}
+
+// MarshalSSZ marshals the BlindedBeaconBlock to SSZ format.
+func (b *BlindedBeaconBlock) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, ssz.Size(b))
+	return buf, ssz.EncodeToBytes(buf, b)
+}
+
+// UnmarshalSSZ unmarshals the BlindedBeaconBlock from SSZ format. A body
+// set beforehand is decoded in the layout of its fork version, any other in
+// the Deneb layout.
+func (b *BlindedBeaconBlock) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, b)
+}
+
+// HashTreeRoot computes the Merkleization of the BlindedBeaconBlock.
+func (b *BlindedBeaconBlock) HashTreeRoot() common.Root {
+	return ssz.HashConcurrent(b)
+}
+
+// SizeSSZ returns the size of the BlindedBeaconBlockBody in SSZ.
+func (b *BlindedBeaconBlockBody) SizeSSZ(siz *ssz.Sizer, fixed bool) uint32 {
+	var size uint32 = 96 + 72 + 32 + 4 + 4 + 4
+	if b.carriesOperations() {
+		size += 4
+	}
+	if fixed {
+		return size
+	}
+
+	size += ssz.SizeSliceOfStaticObjects(siz, b.Deposits)
+	size += ssz.SizeDynamicObject(siz, b.ExecutionPayloadHeader)
+	size += ssz.SizeSliceOfStaticBytes(siz, b.BlobKzgCommitments)
+	if b.carriesOperations() {
+		size += ssz.SizeSliceOfDynamicObjects(siz, b.Operations)
+	}
+	return size
+}
+
+// DefineSSZ defines the SSZ encoding of the BlindedBeaconBlockBody.
+func (b *BlindedBeaconBlockBody) DefineSSZ(codec *ssz.Codec) {
+	// Define the static data (fields and dynamic offsets)
+	ssz.DefineStaticBytes(codec, &b.RandaoReveal)
+	ssz.DefineStaticObject(codec, &b.Eth1Data)
+	ssz.DefineStaticBytes(codec, &b.Graffiti)
+	ssz.DefineSliceOfStaticObjectsOffset(
+		codec, &b.Deposits, constants.MaxDepositsPerBlock,
+	)
+	ssz.DefineDynamicObjectOffset(codec, &b.ExecutionPayloadHeader)
+	ssz.DefineSliceOfStaticBytesOffset(
+		codec, &b.BlobKzgCommitments, constants.MaxBlobCommitmentsPerBlock,
+	)
+	carriesOperations := b.carriesOperations()
+	if carriesOperations {
+		ssz.DefineSliceOfDynamicObjectsOffset(
+			codec, &b.Operations, constants.MaxOperationsPerBlock,
+		)
+	}
+
+	// Define the dynamic data (fields)
+	ssz.DefineSliceOfStaticObjectsContent(
+		codec, &b.Deposits, constants.MaxDepositsPerBlock,
+	)
+	ssz.DefineDynamicObjectContent(codec, &b.ExecutionPayloadHeader)
+	ssz.DefineSliceOfStaticBytesContent(
+		codec, &b.BlobKzgCommitments, constants.MaxBlobCommitmentsPerBlock,
+	)
+	if carriesOperations {
+		ssz.DefineSliceOfDynamicObjectsContent(
+			codec, &b.Operations, constants.MaxOperationsPerBlock,
+		)
+	}
+}
+
+// MarshalSSZ serializes the BlindedBeaconBlockBody to SSZ-encoded bytes.
+func (b *BlindedBeaconBlockBody) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, ssz.Size(b))
+	return buf, ssz.EncodeToBytes(buf, b)
+}
+
+// UnmarshalSSZ deserializes the BlindedBeaconBlockBody from SSZ-encoded
+// bytes, in the layout of the fork version of the body.
+func (b *BlindedBeaconBlockBody) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, b)
+}
+
+// carriesOperations reports whether the layout of the body includes the
+// operations.
+func (b *BlindedBeaconBlockBody) carriesOperations() bool {
+	return b.forkVersion >= version.DenebPlus
+}
+
+// HashTreeRoot returns the SSZ hash tree root of the BlindedBeaconBlockBody.
+func (b *BlindedBeaconBlockBody) HashTreeRoot() common.Root {
+	return ssz.HashConcurrent(b)
+}
@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/transition"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlindedBeaconBlock(t *testing.T) {
+	blk := generateValidBeaconBlock()
+	blinded, err := blk.Blind()
+	require.NoError(t, err)
+
+	// A signature over the blinded block signs the full block.
+	require.Equal(t, blk.HashTreeRoot(), blinded.HashTreeRoot())
+
+	bz, err := blinded.MarshalSSZ()
+	require.NoError(t, err)
+	decoded := new(types.BlindedBeaconBlock)
+	require.NoError(t, decoded.UnmarshalSSZ(bz))
+	require.Equal(t, blinded.HashTreeRoot(), decoded.HashTreeRoot())
+
+	bz, err = json.Marshal(blinded)
+	require.NoError(t, err)
+	decoded = new(types.BlindedBeaconBlock)
+	require.NoError(t, json.Unmarshal(bz, decoded))
+	require.Equal(t, blinded.HashTreeRoot(), decoded.HashTreeRoot())
+
+	unblinded, err := decoded.Unblind(blk.Body.ExecutionPayload)
+	require.NoError(t, err)
+	require.Equal(t, blk.HashTreeRoot(), unblinded.HashTreeRoot())
+}
+
+func TestBlindedBeaconBlockOperations(t *testing.T) {
+	deneb := generateValidBeaconBlock()
+	body := deneb.Body.Empty(version.DenebPlus)
+	body.ExecutionPayload = deneb.Body.ExecutionPayload
+	body.Operations = []*transition.Operation{{TypeID: 1, Data: []byte{1}}}
+	blk := &types.BeaconBlock{Slot: deneb.Slot, Body: body}
+	blinded, err := blk.Blind()
+	require.NoError(t, err)
+	require.Equal(t, blk.HashTreeRoot(), blinded.HashTreeRoot())
+
+	unblinded, err := blinded.Unblind(blk.Body.ExecutionPayload)
+	require.NoError(t, err)
+	require.Equal(t, version.DenebPlus, unblinded.Version())
+	require.Equal(t, blk.Body.Operations, unblinded.Body.GetOperations())
+	require.Equal(t, blk.HashTreeRoot(), unblinded.HashTreeRoot())
+}
+
+func TestBlindedBeaconBlockRejectsOtherPayload(t *testing.T) {
+	blk := generateValidBeaconBlock()
+	blinded, err := blk.Blind()
+	require.NoError(t, err)
+
+	other := generateValidBeaconBlock().Body.ExecutionPayload
+	other.Transactions = other.Transactions[1:]
+	_, err = blinded.Unblind(other)
+	require.ErrorIs(t, err, types.ErrPayloadHeaderMismatch)
+
+	_, err = blinded.Unblind(nil)
+	require.ErrorIs(t, err, types.ErrNilPayload)
+}
@@ -59,13 +59,16 @@ func (b *BeaconBlock) NewWithVersion(
 	parentBlockRoot common.Root,
 	forkVersion uint32,
 ) (*BeaconBlock, error) {
-	if forkVersion == version.Deneb {
+	switch forkVersion {
+	case version.Deneb, version.DenebPlus:
 		return &BeaconBlock{
 			Slot:          slot,
 			ProposerIndex: proposerIndex,
 			ParentRoot:    parentBlockRoot,
 			StateRoot:     common.Root{},
-			Body:          &BeaconBlockBody{},
+			Body: &BeaconBlockBody{
+				forkVersion: bodyVersion(forkVersion),
+			},
 		}, nil
 	}
 
@@ -80,8 +83,13 @@ func (b *BeaconBlock) NewFromSSZ(
 	bz []byte,
 	forkVersion uint32,
 ) (*BeaconBlock, error) {
-	if forkVersion == version.Deneb {
-		block := &BeaconBlock{}
+	switch forkVersion {
+	case version.Deneb, version.DenebPlus:
+		block := &BeaconBlock{
+			Body: &BeaconBlockBody{
+				forkVersion: bodyVersion(forkVersion),
+			},
+		}
 		return block, block.UnmarshalSSZ(bz)
 	}
 
@@ -125,7 +133,9 @@ func (b *BeaconBlock) MarshalSSZ() ([]byte, error) {
 	return buf, ssz.EncodeToBytes(buf, b)
 }
 
-// UnmarshalSSZ unmarshals the BeaconBlock object from SSZ format.
+// UnmarshalSSZ unmarshals the BeaconBlock object from SSZ format. A body set
+// beforehand, as NewFromSSZ does, is decoded in the layout of its fork
+// version, any other in the Deneb layout.
 func (b *BeaconBlock) UnmarshalSSZ(buf []byte) error {
 	return ssz.DecodeFromBytes(buf, b)
 }
@@ -207,7 +217,10 @@ func (b *BeaconBlock) GetStateRoot() common.Root {
 
 // Version identifies the version of the BeaconBlock.
 func (b *BeaconBlock) Version() uint32 {
-	return version.Deneb
+	if b.Body == nil {
+		return version.Deneb
+	}
+	return b.Body.Version()
 }
 
 // SetStateRoot sets the state root of the BeaconBlock.
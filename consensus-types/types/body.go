@@ -27,9 +27,11 @@ package types
 
 import (
 	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constants"
 	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/primitives/eip4844"
 	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/transition"
 	"github.com/berachain/beacon-kit/primitives/version"
 	fastssz "github.com/ferranbt/fastssz"
 	"github.com/karalabe/ssz"
@@ -40,6 +42,10 @@ const (
 	// struct.
 	BodyLengthDeneb uint64 = 6
 
+	// BodyLengthDenebPlus is the number of fields in the BeaconBlockBody
+	// struct from the DenebPlus fork on, which adds the operations.
+	BodyLengthDenebPlus = BodyLengthDeneb + 1
+
 	// KZGPositionDeneb is the position of BlobKzgCommitments in the block body.
 	KZGPositionDeneb = BodyLengthDeneb - 1
 
@@ -55,12 +61,13 @@ const (
 // for the given fork version.
 func (b *BeaconBlockBody) Empty(forkVersion uint32) *BeaconBlockBody {
 	switch forkVersion {
-	case version.Deneb:
+	case version.Deneb, version.DenebPlus:
 		return &BeaconBlockBody{
 			Eth1Data: new(Eth1Data),
 			ExecutionPayload: &ExecutionPayload{
 				ExtraData: make([]byte, ExtraDataSize),
 			},
+			forkVersion: bodyVersion(forkVersion),
 		}
 	default:
 		panic(ErrForkVersionNotSupported)
@@ -75,7 +82,7 @@ func BlockBodyKZGOffset(
 	cs common.ChainSpec,
 ) uint64 {
 	switch cs.ActiveForkVersionForSlot(slot) {
-	case version.Deneb:
+	case version.Deneb, version.DenebPlus:
 		return KZGMerkleIndexDeneb * cs.MaxBlobCommitmentsPerBlock()
 	default:
 		panic(ErrForkVersionNotSupported)
@@ -97,6 +104,13 @@ type BeaconBlockBody struct {
 	ExecutionPayload *ExecutionPayload
 	// BlobKzgCommitments is the list of KZG commitments for the EIP-4844 blobs.
 	BlobKzgCommitments []eip4844.KZGCommitment
+	// Operations is the list of application-defined operations included in
+	// the body. The field is part of the body from the DenebPlus fork on.
+	Operations []*transition.Operation
+
+	// forkVersion is the fork version of the body, which selects its layout.
+	// It is left zero for Deneb bodies, see bodyVersion.
+	forkVersion uint32
 }
 
 /* -------------------------------------------------------------------------- */
@@ -106,6 +120,9 @@ type BeaconBlockBody struct {
 // SizeSSZ returns the size of the BeaconBlockBody in SSZ.
 func (b *BeaconBlockBody) SizeSSZ(siz *ssz.Sizer, fixed bool) uint32 {
 	var size uint32 = 96 + 72 + 32 + 4 + 4 + 4
+	if b.carriesOperations() {
+		size += 4
+	}
 	if fixed {
 		return size
 	}
@@ -113,25 +130,45 @@ func (b *BeaconBlockBody) SizeSSZ(siz *ssz.Sizer, fixed bool) uint32 {
 	size += ssz.SizeSliceOfStaticObjects(siz, b.Deposits)
 	size += ssz.SizeDynamicObject(siz, b.ExecutionPayload)
 	size += ssz.SizeSliceOfStaticBytes(siz, b.BlobKzgCommitments)
+	if b.carriesOperations() {
+		size += ssz.SizeSliceOfDynamicObjects(siz, b.Operations)
+	}
 	return size
 }
 
 // DefineSSZ defines the SSZ serialization of the BeaconBlockBody.
-//
-//nolint:mnd // TODO: chainspec.
 func (b *BeaconBlockBody) DefineSSZ(codec *ssz.Codec) {
 	// Define the static data (fields and dynamic offsets)
 	ssz.DefineStaticBytes(codec, &b.RandaoReveal)
 	ssz.DefineStaticObject(codec, &b.Eth1Data)
 	ssz.DefineStaticBytes(codec, &b.Graffiti)
-	ssz.DefineSliceOfStaticObjectsOffset(codec, &b.Deposits, 16)
+	ssz.DefineSliceOfStaticObjectsOffset(
+		codec, &b.Deposits, constants.MaxDepositsPerBlock,
+	)
 	ssz.DefineDynamicObjectOffset(codec, &b.ExecutionPayload)
-	ssz.DefineSliceOfStaticBytesOffset(codec, &b.BlobKzgCommitments, 16)
+	ssz.DefineSliceOfStaticBytesOffset(
+		codec, &b.BlobKzgCommitments, constants.MaxBlobCommitmentsPerBlock,
+	)
+	carriesOperations := b.carriesOperations()
+	if carriesOperations {
+		ssz.DefineSliceOfDynamicObjectsOffset(
+			codec, &b.Operations, constants.MaxOperationsPerBlock,
+		)
+	}
 
 	// Define the dynamic data (fields)
-	ssz.DefineSliceOfStaticObjectsContent(codec, &b.Deposits, 16)
+	ssz.DefineSliceOfStaticObjectsContent(
+		codec, &b.Deposits, constants.MaxDepositsPerBlock,
+	)
 	ssz.DefineDynamicObjectContent(codec, &b.ExecutionPayload)
-	ssz.DefineSliceOfStaticBytesContent(codec, &b.BlobKzgCommitments, 16)
+	ssz.DefineSliceOfStaticBytesContent(
+		codec, &b.BlobKzgCommitments, constants.MaxBlobCommitmentsPerBlock,
+	)
+	if carriesOperations {
+		ssz.DefineSliceOfDynamicObjectsContent(
+			codec, &b.Operations, constants.MaxOperationsPerBlock,
+		)
+	}
 }
 
 // MarshalSSZ serializes the BeaconBlockBody to SSZ-encoded bytes.
@@ -140,11 +177,28 @@ func (b *BeaconBlockBody) MarshalSSZ() ([]byte, error) {
 	return buf, ssz.EncodeToBytes(buf, b)
 }
 
-// UnmarshalSSZ deserializes the BeaconBlockBody from SSZ-encoded bytes.
+// UnmarshalSSZ deserializes the BeaconBlockBody from SSZ-encoded bytes, in
+// the layout of the fork version of the body.
 func (b *BeaconBlockBody) UnmarshalSSZ(buf []byte) error {
 	return ssz.DecodeFromBytes(buf, b)
 }
 
+// bodyVersion returns the fork version a body records to select its layout.
+// Deneb, the first layout, is recorded as the zero value, so that a body
+// built without a version has the Deneb layout.
+func bodyVersion(forkVersion uint32) uint32 {
+	if forkVersion <= version.Deneb {
+		return 0
+	}
+	return forkVersion
+}
+
+// carriesOperations reports whether the layout of the body includes the
+// operations.
+func (b *BeaconBlockBody) carriesOperations() bool {
+	return b.forkVersion >= version.DenebPlus
+}
+
 // HashTreeRoot returns the SSZ hash tree root of the BeaconBlockBody.
 func (b *BeaconBlockBody) HashTreeRoot() common.Root {
 	return ssz.HashConcurrent(b)
@@ -165,8 +219,6 @@ func (b *BeaconBlockBody) MarshalSSZTo(dst []byte) ([]byte, error) {
 }
 
 // HashTreeRootWith ssz hashes the BeaconBlockBody object with a hasher.
-//
-//nolint:mnd // todo fix.
 func (b *BeaconBlockBody) HashTreeRootWith(hh fastssz.HashWalker) error {
 	indx := hh.Index()
 
@@ -188,7 +240,7 @@ func (b *BeaconBlockBody) HashTreeRootWith(hh fastssz.HashWalker) error {
 	{
 		subIndx := hh.Index()
 		num := uint64(len(b.Deposits))
-		if num > 16 {
+		if num > constants.MaxDepositsPerBlock {
 			return fastssz.ErrIncorrectListSize
 		}
 		for _, elem := range b.Deposits {
@@ -196,7 +248,9 @@ func (b *BeaconBlockBody) HashTreeRootWith(hh fastssz.HashWalker) error {
 				return err
 			}
 		}
-		hh.MerkleizeWithMixin(subIndx, num, 16)
+		hh.MerkleizeWithMixin(
+			subIndx, num, constants.MaxDepositsPerBlock,
+		)
 	}
 
 	// Field (4) 'ExecutionPayload'
@@ -206,25 +260,53 @@ func (b *BeaconBlockBody) HashTreeRootWith(hh fastssz.HashWalker) error {
 
 	// Field (5) 'BlobKzgCommitments'
 	{
-		if size := len(b.BlobKzgCommitments); size > 16 {
+		numItems := uint64(len(b.BlobKzgCommitments))
+		if numItems > constants.MaxBlobCommitmentsPerBlock {
 			return fastssz.ErrListTooBigFn(
 				"BeaconBlockBody.BlobKzgCommitments",
-				size,
-				16,
+				len(b.BlobKzgCommitments),
+				int(constants.MaxBlobCommitmentsPerBlock),
 			)
 		}
 		subIndx := hh.Index()
 		for _, i := range b.BlobKzgCommitments {
 			hh.PutBytes(i[:])
 		}
-		numItems := uint64(len(b.BlobKzgCommitments))
-		hh.MerkleizeWithMixin(subIndx, numItems, 16)
+		hh.MerkleizeWithMixin(
+			subIndx, numItems, constants.MaxBlobCommitmentsPerBlock,
+		)
+	}
+
+	// Field (6) 'Operations'
+	if b.carriesOperations() {
+		if err := hashOperations(hh, b.Operations); err != nil {
+			return err
+		}
 	}
 
 	hh.Merkleize(indx)
 	return nil
 }
 
+// hashOperations ssz hashes a list of operations with a hasher.
+func hashOperations(
+	hh fastssz.HashWalker,
+	ops []*transition.Operation,
+) error {
+	subIndx := hh.Index()
+	num := uint64(len(ops))
+	if num > constants.MaxOperationsPerBlock {
+		return fastssz.ErrIncorrectListSize
+	}
+	for _, elem := range ops {
+		if err := elem.HashTreeRootWith(hh); err != nil {
+			return err
+		}
+	}
+	hh.MerkleizeWithMixin(subIndx, num, constants.MaxOperationsPerBlock)
+	return nil
+}
+
 // GetTree ssz hashes the BeaconBlockBody object.
 func (b *BeaconBlockBody) GetTree() (*fastssz.Node, error) {
 	return fastssz.ProofTree(b)
@@ -291,7 +373,7 @@ func (b *BeaconBlockBody) SetSlashingInfo(_ []*SlashingInfo) {
 
 // GetTopLevelRoots returns the top-level roots of the BeaconBlockBody.
 func (b *BeaconBlockBody) GetTopLevelRoots() []common.Root {
-	return []common.Root{
+	roots := []common.Root{
 		common.Root(b.GetRandaoReveal().HashTreeRoot()),
 		b.Eth1Data.HashTreeRoot(),
 		common.Root(b.GetGraffiti().HashTreeRoot()),
@@ -300,13 +382,43 @@ func (b *BeaconBlockBody) GetTopLevelRoots() []common.Root {
 		// I think this is a bug.
 		common.Root{},
 	}
+	if b.carriesOperations() {
+		roots = append(roots, operationsRoot(b.Operations))
+	}
+	return roots
+}
+
+// operationsRoot returns the hash tree root of a list of operations. As
+// HashTreeRoot does, it leaves a list over its limit, which cannot be
+// encoded, to the encoder to reject.
+func operationsRoot(ops []*transition.Operation) common.Root {
+	hh := fastssz.NewHasher()
+	if err := hashOperations(hh, ops); err != nil {
+		return common.Root{}
+	}
+	root, err := hh.HashRoot()
+	if err != nil {
+		return common.Root{}
+	}
+	return root
 }
 
 // Length returns the number of fields in the BeaconBlockBody struct.
 func (b *BeaconBlockBody) Length() uint64 {
+	if b.carriesOperations() {
+		return BodyLengthDenebPlus
+	}
 	return BodyLengthDeneb
 }
 
+// Version returns the fork version of the BeaconBlockBody.
+func (b *BeaconBlockBody) Version() uint32 {
+	if b.forkVersion == 0 {
+		return version.Deneb
+	}
+	return b.forkVersion
+}
+
 // GetRandaoReveal returns the RandaoReveal of the Body.
 func (b *BeaconBlockBody) GetRandaoReveal() crypto.BLSSignature {
 	return b.RandaoReveal
@@ -341,3 +453,15 @@ func (b *BeaconBlockBody) GetDeposits() []*Deposit {
 func (b *BeaconBlockBody) SetDeposits(deposits []*Deposit) {
 	b.Deposits = deposits
 }
+
+// GetOperations returns the application-defined operations of the
+// BeaconBlockBody.
+func (b *BeaconBlockBody) GetOperations() []*transition.Operation {
+	return b.Operations
+}
+
+// SetOperations sets the application-defined operations of the
+// BeaconBlockBody.
+func (b *BeaconBlockBody) SetOperations(ops []*transition.Operation) {
+	b.Operations = ops
+}
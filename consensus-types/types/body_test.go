@@ -29,6 +29,7 @@ import (
 	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/primitives/eip4844"
 	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/transition"
 	"github.com/berachain/beacon-kit/primitives/version"
 	"github.com/stretchr/testify/require"
 )
@@ -160,3 +161,41 @@ func TestBeaconBlockBody_Empty(t *testing.T) {
 	body := blockBody.Empty(version.Deneb)
 	require.NotNil(t, body)
 }
+
+func TestBeaconBlockBody_Operations(t *testing.T) {
+	// Deneb bodies do not carry operations.
+	deneb := (&types.BeaconBlockBody{}).Empty(version.Deneb)
+	require.Equal(t, version.Deneb, deneb.Version())
+	require.Equal(t, types.BodyLengthDeneb, deneb.Length())
+	denebBz, err := deneb.MarshalSSZ()
+	require.NoError(t, err)
+
+	// From DenebPlus on, bodies carry operations, even when there are none.
+	body := (&types.BeaconBlockBody{}).Empty(version.DenebPlus)
+	require.Equal(t, version.DenebPlus, body.Version())
+	require.Equal(t, types.BodyLengthDenebPlus, body.Length())
+	emptyBz, err := body.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, emptyBz, len(denebBz)+4)
+	require.NotEqual(t, deneb.HashTreeRoot(), body.HashTreeRoot())
+
+	ops := []*transition.Operation{{TypeID: 1, Data: []byte{1, 2, 3}}}
+	body.SetOperations(ops)
+	blk := &types.BeaconBlock{Slot: 1, Body: body}
+	bz, err := blk.MarshalSSZ()
+	require.NoError(t, err)
+
+	// Blocks decode in the layout of the given fork version.
+	decoded, err := (&types.BeaconBlock{}).NewFromSSZ(bz, version.DenebPlus)
+	require.NoError(t, err)
+	require.Equal(t, version.DenebPlus, decoded.Version())
+	require.Equal(t, ops, decoded.GetBody().GetOperations())
+	require.Equal(t, blk.HashTreeRoot(), decoded.HashTreeRoot())
+	_, err = (&types.BeaconBlock{}).NewFromSSZ(bz, version.Deneb)
+	require.Error(t, err)
+
+	// The top level roots commit to the operations.
+	roots := body.GetTopLevelRoots()
+	require.Len(t, roots, int(types.BodyLengthDenebPlus))
+	require.NotEqual(t, common.Root{}, roots[types.BodyLengthDenebPlus-1])
+}
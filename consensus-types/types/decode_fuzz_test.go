@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/config/spec"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/stretchr/testify/require"
+)
+
+func FuzzBeaconBlockUnmarshalSSZ(f *testing.F) {
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(f, err)
+
+	bz, err := generateValidBeaconBlock().MarshalSSZ()
+	require.NoError(f, err)
+	f.Add(bz)
+	f.Add(bz[:len(bz)/2])
+	f.Add([]byte{})
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		blk, err := new(types.BeaconBlock).NewFromSSZ(data, version.Deneb)
+		if err != nil {
+			return
+		}
+
+		// Whatever decodes must be checkable and re-encode to a block that
+		// decodes back to the same bytes.
+		_ = blk.ValidateLimits(cs)
+		reencoded, err := blk.MarshalSSZ()
+		require.NoError(t, err)
+		decoded, err := new(types.BeaconBlock).NewFromSSZ(
+			reencoded, version.Deneb,
+		)
+		require.NoError(t, err)
+		again, err := decoded.MarshalSSZ()
+		require.NoError(t, err)
+		require.Equal(t, reencoded, again)
+	})
+}
+
+func FuzzBeaconBlockBodyUnmarshalSSZ(f *testing.F) {
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(f, err)
+
+	bz, err := generateValidBeaconBlock().Body.MarshalSSZ()
+	require.NoError(f, err)
+	f.Add(bz)
+	f.Add(bz[:len(bz)-1])
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		body := new(types.BeaconBlockBody)
+		if err := body.UnmarshalSSZ(data); err != nil {
+			return
+		}
+		_ = body.ValidateLimits(cs)
+		_, err := body.MarshalSSZ()
+		require.NoError(t, err)
+	})
+}
+
+func FuzzExecutionPayloadUnmarshalSSZ(f *testing.F) {
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(f, err)
+
+	bz, err := generateValidBeaconBlock().Body.ExecutionPayload.MarshalSSZ()
+	require.NoError(f, err)
+	f.Add(bz)
+	f.Add(bz[:len(bz)-1])
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		payload := new(types.ExecutionPayload)
+		if err := payload.UnmarshalSSZ(data); err != nil {
+			return
+		}
+		_ = payload.ValidateLimits(cs)
+		_, err := payload.MarshalSSZ()
+		require.NoError(t, err)
+	})
+}
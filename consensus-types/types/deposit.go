@@ -107,6 +107,40 @@ func (d *Deposit) VerifySignature(
 	)
 }
 
+// DataRoot returns the hash tree root of the deposit data, i.e. the deposit
+// without its index, which is the leaf the deposit contract adds to its
+// deposit tree.
+func (d *Deposit) DataRoot() common.Root {
+	return ssz.HashSequential(&depositData{
+		Pubkey:      d.Pubkey,
+		Credentials: d.Credentials,
+		Amount:      d.Amount,
+		Signature:   d.Signature,
+	})
+}
+
+// depositData is the deposit data signed by the depositor and hashed into
+// the deposit tree.
+type depositData struct {
+	Pubkey      crypto.BLSPubkey
+	Credentials WithdrawalCredentials
+	Amount      math.Gwei
+	Signature   crypto.BLSSignature
+}
+
+// SizeSSZ returns the size of the depositData object in SSZ encoding.
+func (d *depositData) SizeSSZ(*ssz.Sizer) uint32 {
+	return DepositSize - 8 // without the index.
+}
+
+// DefineSSZ defines the SSZ encoding for the depositData object.
+func (d *depositData) DefineSSZ(c *ssz.Codec) {
+	ssz.DefineStaticBytes(c, &d.Pubkey)
+	ssz.DefineStaticBytes(c, &d.Credentials)
+	ssz.DefineUint64(c, &d.Amount)
+	ssz.DefineStaticBytes(c, &d.Signature)
+}
+
 /* -------------------------------------------------------------------------- */
 /*                                     SSZ                                    */
 /* -------------------------------------------------------------------------- */
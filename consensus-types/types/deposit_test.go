@@ -21,6 +21,8 @@
 package types_test
 
 import (
+	"crypto/sha256"
+	"encoding/binary"
 	"io"
 	"testing"
 
@@ -92,6 +94,43 @@ func TestDeposit_HashTreeRoot(t *testing.T) {
 	})
 }
 
+// TestDeposit_DataRoot checks the deposit data root against the Merkle
+// tree of the DepositData container of the deposit contract, hashed by hand.
+func TestDeposit_DataRoot(t *testing.T) {
+	deposit := types.NewDeposit(
+		crypto.BLSPubkey{0x01, 47: 0x02},
+		types.WithdrawalCredentials{0x01, 31: 0x03},
+		math.Gwei(32e9),
+		crypto.BLSSignature{0x04, 95: 0x05},
+		7,
+	)
+
+	hash := func(left, right []byte) []byte {
+		h := sha256.Sum256(append(append([]byte{}, left...), right...))
+		return h[:]
+	}
+	chunk := func(bz []byte) []byte {
+		return append(append([]byte{}, bz...), make([]byte, 32-len(bz))...)
+	}
+	pubkeyRoot := hash(deposit.Pubkey[:32], chunk(deposit.Pubkey[32:]))
+	signatureRoot := hash(
+		hash(deposit.Signature[:32], deposit.Signature[32:64]),
+		hash(deposit.Signature[64:], make([]byte, 32)),
+	)
+	amount := make([]byte, 8)
+	binary.LittleEndian.PutUint64(amount, deposit.Amount.Unwrap())
+	expected := hash(
+		hash(pubkeyRoot, deposit.Credentials[:]),
+		hash(chunk(amount), signatureRoot),
+	)
+	require.Equal(t, common.Root(expected), deposit.DataRoot())
+
+	// The index is not part of the deposit data.
+	deposit.Index = 8
+	require.Equal(t, common.Root(expected), deposit.DataRoot())
+	require.NotEqual(t, deposit.HashTreeRoot(), deposit.DataRoot())
+}
+
 func TestDeposit_SizeSSZ(t *testing.T) {
 	deposit := generateValidDeposit()
 
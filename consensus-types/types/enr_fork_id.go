@@ -0,0 +1,100 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/karalabe/ssz"
+)
+
+// ENRForkIDSize is the size of the ENRForkID object in bytes.
+// 4 bytes for ForkDigest + 4 bytes for NextForkVersion + 8 bytes for
+// NextForkEpoch.
+const ENRForkIDSize = 16
+
+// ENRForkID as defined in the Ethereum 2.0 networking specification:
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/p2p-interface.md#eth2-field
+//
+//nolint:lll
+type ENRForkID struct {
+	// ForkDigest is the fork digest of the current fork.
+	ForkDigest common.ForkDigest `json:"fork_digest"`
+	// NextForkVersion is the version of the next scheduled fork, or the
+	// current version if no fork is scheduled.
+	NextForkVersion common.Version `json:"next_fork_version"`
+	// NextForkEpoch is the epoch of the next scheduled fork, or the far
+	// future epoch if no fork is scheduled.
+	NextForkEpoch math.Epoch `json:"next_fork_epoch"`
+}
+
+// NewENRForkID returns the ENRForkID of the chain at the given epoch.
+func NewENRForkID(
+	cs common.ChainSpec,
+	genesisValidatorsRoot common.Root,
+	epoch math.Epoch,
+) *ENRForkID {
+	currentVersion := version.FromUint32[common.Version](
+		cs.ActiveForkVersionForEpoch(epoch),
+	)
+	nextVersion, nextEpoch := cs.NextForkForEpoch(epoch)
+	return &ENRForkID{
+		ForkDigest: NewForkData(
+			currentVersion, genesisValidatorsRoot,
+		).ComputeForkDigest(),
+		NextForkVersion: version.FromUint32[common.Version](nextVersion),
+		NextForkEpoch:   nextEpoch,
+	}
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                     SSZ                                    */
+/* -------------------------------------------------------------------------- */
+
+// SizeSSZ returns the SSZ encoded size of the ENRForkID object in bytes.
+func (*ENRForkID) SizeSSZ(*ssz.Sizer) uint32 {
+	return ENRForkIDSize
+}
+
+// DefineSSZ defines the SSZ encoding for the ENRForkID object.
+func (e *ENRForkID) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineStaticBytes(codec, &e.ForkDigest)
+	ssz.DefineStaticBytes(codec, &e.NextForkVersion)
+	ssz.DefineUint64(codec, &e.NextForkEpoch)
+}
+
+// MarshalSSZ marshals the ENRForkID object to SSZ format, the value of the
+// eth2 field of an ENR.
+func (e *ENRForkID) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, ssz.Size(e))
+	return buf, ssz.EncodeToBytes(buf, e)
+}
+
+// UnmarshalSSZ unmarshals the ENRForkID object from SSZ format.
+func (e *ENRForkID) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, e)
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the ENRForkID object.
+func (e *ENRForkID) HashTreeRoot() common.Root {
+	return ssz.HashSequential(e)
+}
@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/config/spec"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	karalabessz "github.com/karalabe/ssz"
+	"github.com/stretchr/testify/require"
+)
+
+func TestENRForkID_Serialization(t *testing.T) {
+	original := &types.ENRForkID{
+		ForkDigest:      common.ForkDigest{0x01, 0x02, 0x03, 0x04},
+		NextForkVersion: common.Version{0x05, 0x00, 0x00, 0x00},
+		NextForkEpoch:   math.Epoch(42),
+	}
+
+	data, err := original.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, data, types.ENRForkIDSize)
+	require.Equal(t, uint32(types.ENRForkIDSize), karalabessz.Size(original))
+
+	var unmarshalled types.ENRForkID
+	require.NoError(t, unmarshalled.UnmarshalSSZ(data))
+	require.Equal(t, original, &unmarshalled)
+}
+
+func TestNewENRForkID(t *testing.T) {
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+	genesisValidatorsRoot := common.Root{0x42}
+
+	// The next fork is the Deneb+ fork scheduled by the chain spec.
+	enrForkID := types.NewENRForkID(cs, genesisValidatorsRoot, 0)
+	require.Equal(t,
+		types.NewForkData(
+			version.FromUint32[common.Version](version.Deneb),
+			genesisValidatorsRoot,
+		).ComputeForkDigest(),
+		enrForkID.ForkDigest,
+	)
+	require.Equal(t,
+		version.FromUint32[common.Version](version.DenebPlus),
+		enrForkID.NextForkVersion,
+	)
+	require.Equal(t, cs.DenebPlusForkEpoch(), enrForkID.NextForkEpoch)
+}
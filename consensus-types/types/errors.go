@@ -38,4 +38,45 @@ var (
 
 	// ErrNilPayloadHeader is an error for when the payload header is nil.
 	ErrNilPayloadHeader = errors.New("nil payload header")
+
+	// ErrRegistryLengthMismatch is an error for when the validators and
+	// balances of a registry are not of the same length.
+	ErrRegistryLengthMismatch = errors.New(
+		"validators and balances length mismatch",
+	)
+
+	// ErrNilBlockBody is an error for when the body of a block is nil.
+	ErrNilBlockBody = errors.New("nil block body")
+
+	// ErrNilPayload is an error for when the execution payload of a block
+	// body is nil.
+	ErrNilPayload = errors.New("nil execution payload")
+
+	// ErrTooManyDeposits is an error for when a block carries more deposits
+	// than the chain allows per block.
+	ErrTooManyDeposits = errors.New("too many deposits in block")
+
+	// ErrTooManyBlobCommitments is an error for when a block carries more
+	// blob commitments than the chain allows blobs per block.
+	ErrTooManyBlobCommitments = errors.New(
+		"too many blob commitments in block",
+	)
+
+	// ErrTooManyWithdrawals is an error for when a payload carries more
+	// withdrawals than the chain allows per payload.
+	ErrTooManyWithdrawals = errors.New("too many withdrawals in payload")
+
+	// ErrExtraDataTooLong is an error for when the extra data of a payload
+	// exceeds its maximum length.
+	ErrExtraDataTooLong = errors.New("extra data too long")
+
+	// ErrEmptyTransaction is an error for when a payload carries an empty
+	// transaction.
+	ErrEmptyTransaction = errors.New("empty transaction in payload")
+
+	// ErrPayloadHeaderMismatch is an error for when the execution payload
+	// revealed for a blinded block does not match the header it commits to.
+	ErrPayloadHeaderMismatch = errors.New(
+		"execution payload does not match header",
+	)
 )
@@ -23,6 +23,7 @@ package types
 import (
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/signing"
 	"github.com/karalabe/ssz"
 )
 
@@ -99,11 +100,18 @@ func (fd *ForkData) UnmarshalSSZ(buf []byte) error {
 func (fd *ForkData) ComputeDomain(
 	domainType common.DomainType,
 ) common.Domain {
-	forkDataRoot := fd.HashTreeRoot()
-	return common.Domain(
-		append(
-			domainType[:],
-			forkDataRoot[:28]...),
+	return signing.ComputeDomain(
+		domainType, fd.CurrentVersion, fd.GenesisValidatorsRoot,
+	)
+}
+
+// ComputeForkDigest as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#compute_fork_digest
+//
+//nolint:lll
+func (fd *ForkData) ComputeForkDigest() common.ForkDigest {
+	return signing.ComputeForkDigest(
+		fd.CurrentVersion, fd.GenesisValidatorsRoot,
 	)
 }
 
@@ -112,7 +120,7 @@ func (fd *ForkData) ComputeRandaoSigningRoot(
 	domainType common.DomainType,
 	epoch math.Epoch,
 ) common.Root {
-	return ComputeSigningRootUInt64(
+	return signing.ComputeSigningRootUInt64(
 		epoch.Unwrap(),
 		fd.ComputeDomain(domainType),
 	)
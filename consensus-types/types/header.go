@@ -79,6 +79,15 @@ func (*BeaconBlockHeader) Empty() *BeaconBlockHeader {
 	return &BeaconBlockHeader{}
 }
 
+// Copy returns a copy of the BeaconBlockHeader.
+func (b *BeaconBlockHeader) Copy() *BeaconBlockHeader {
+	if b == nil {
+		return nil
+	}
+	header := *b
+	return &header
+}
+
 // New creates a new BeaconBlockHeader.
 func (b *BeaconBlockHeader) New(
 	slot math.Slot,
@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/common"
+)
+
+// ValidateLimits checks the block against the per-block limits of the chain
+// spec. The SSZ codec only bounds lists by the capacities of the schema, so
+// this is run right after decoding a proposal to reject one that exceeds the
+// chain limits before any state is accessed.
+func (b *BeaconBlock) ValidateLimits(cs common.ChainSpec) error {
+	if b.Body == nil {
+		return ErrNilBlockBody
+	}
+	return b.Body.ValidateLimits(cs)
+}
+
+// ValidateLimits checks the block body against the per-block limits of the
+// chain spec.
+func (b *BeaconBlockBody) ValidateLimits(cs common.ChainSpec) error {
+	if b.ExecutionPayload == nil {
+		return ErrNilPayload
+	}
+	if n := uint64(len(b.Deposits)); n > cs.MaxDepositsPerBlock() {
+		return errors.Wrapf(
+			ErrTooManyDeposits, "%d > %d", n, cs.MaxDepositsPerBlock(),
+		)
+	}
+	if n := uint64(len(b.BlobKzgCommitments)); n > cs.MaxBlobsPerBlock() {
+		return errors.Wrapf(
+			ErrTooManyBlobCommitments, "%d > %d", n, cs.MaxBlobsPerBlock(),
+		)
+	}
+	return b.ExecutionPayload.ValidateLimits(cs)
+}
+
+// ValidateLimits checks the execution payload against the per-payload limits
+// of the chain spec.
+func (p *ExecutionPayload) ValidateLimits(cs common.ChainSpec) error {
+	if n := uint64(len(p.Withdrawals)); n > cs.MaxWithdrawalsPerPayload() {
+		return errors.Wrapf(
+			ErrTooManyWithdrawals, "%d > %d", n, cs.MaxWithdrawalsPerPayload(),
+		)
+	}
+	if n := len(p.ExtraData); n > ExtraDataSize {
+		return errors.Wrapf(ErrExtraDataTooLong, "%d > %d", n, ExtraDataSize)
+	}
+	for i, tx := range p.Transactions {
+		if len(tx) == 0 {
+			return errors.Wrapf(ErrEmptyTransaction, "index %d", i)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/config/spec"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/eip4844"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBeaconBlock_ValidateLimits(t *testing.T) {
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		mutate  func(*types.BeaconBlock)
+		wantErr error
+	}{
+		{
+			name:   "valid",
+			mutate: func(*types.BeaconBlock) {},
+		},
+		{
+			name: "nil body",
+			mutate: func(blk *types.BeaconBlock) {
+				blk.Body = nil
+			},
+			wantErr: types.ErrNilBlockBody,
+		},
+		{
+			name: "nil payload",
+			mutate: func(blk *types.BeaconBlock) {
+				blk.Body.ExecutionPayload = nil
+			},
+			wantErr: types.ErrNilPayload,
+		},
+		{
+			name: "too many deposits",
+			mutate: func(blk *types.BeaconBlock) {
+				blk.Body.Deposits = make(
+					[]*types.Deposit, cs.MaxDepositsPerBlock()+1,
+				)
+			},
+			wantErr: types.ErrTooManyDeposits,
+		},
+		{
+			name: "too many blob commitments",
+			mutate: func(blk *types.BeaconBlock) {
+				blk.Body.BlobKzgCommitments = make(
+					[]eip4844.KZGCommitment, cs.MaxBlobsPerBlock()+1,
+				)
+			},
+			wantErr: types.ErrTooManyBlobCommitments,
+		},
+		{
+			name: "too many withdrawals",
+			mutate: func(blk *types.BeaconBlock) {
+				blk.Body.ExecutionPayload.Withdrawals = make(
+					[]*engineprimitives.Withdrawal,
+					cs.MaxWithdrawalsPerPayload()+1,
+				)
+			},
+			wantErr: types.ErrTooManyWithdrawals,
+		},
+		{
+			name: "extra data too long",
+			mutate: func(blk *types.BeaconBlock) {
+				blk.Body.ExecutionPayload.ExtraData = make(
+					[]byte, types.ExtraDataSize+1,
+				)
+			},
+			wantErr: types.ErrExtraDataTooLong,
+		},
+		{
+			name: "empty transaction",
+			mutate: func(blk *types.BeaconBlock) {
+				blk.Body.ExecutionPayload.Transactions = [][]byte{
+					[]byte("tx1"), {},
+				}
+			},
+			wantErr: types.ErrEmptyTransaction,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			blk := generateValidBeaconBlock()
+			tt.mutate(blk)
+			err = blk.ValidateLimits(cs)
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestBeaconBlock_NewFromSSZRejectsMalformed(t *testing.T) {
+	bz, err := generateValidBeaconBlock().MarshalSSZ()
+	require.NoError(t, err)
+
+	// Truncated bytes must fail to decode.
+	for _, n := range []int{0, 1, len(bz) / 2, len(bz) - 1} {
+		_, err = new(types.BeaconBlock).NewFromSSZ(bz[:n], version.Deneb)
+		require.Error(t, err)
+	}
+
+	// The encoder does not bound lists, but a block with more deposits
+	// than the schema allows must fail to decode.
+	blk := generateValidBeaconBlock()
+	blk.Body.Deposits = make(
+		[]*types.Deposit, constants.MaxDepositsPerBlock+1,
+	)
+	for i := range blk.Body.Deposits {
+		blk.Body.Deposits[i] = &types.Deposit{}
+	}
+	bz, err = blk.MarshalSSZ()
+	require.NoError(t, err)
+	_, err = new(types.BeaconBlock).NewFromSSZ(bz, version.Deneb)
+	require.Error(t, err)
+}
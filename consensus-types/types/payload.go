@@ -59,7 +59,7 @@ type ExecutionPayload struct {
 	// Timestamp is the timestamp of the block.
 	Timestamp math.U64 `json:"timestamp"`
 	// ExtraData is the extra data of the block.
-	ExtraData bytes.Bytes `json:"extraData"`
+	ExtraData bytes.Bounded[bytes.Limit32] `json:"extraData"`
 	// BaseFeePerGas is the base fee per gas.
 	BaseFeePerGas *math.U256 `json:"baseFeePerGas"`
 	// BlockHash is the hash of the block.
@@ -92,8 +92,6 @@ func (p *ExecutionPayload) SizeSSZ(siz *ssz.Sizer, fixed bool) uint32 {
 }
 
 // DefineSSZ defines how an object is encoded/decoded.
-//
-//nolint:mnd // todo fix.
 func (p *ExecutionPayload) DefineSSZ(codec *ssz.Codec) {
 	// Define the static data (fields and dynamic offsets)
 	ssz.DefineStaticBytes(codec, &p.ParentHash)
@@ -106,7 +104,9 @@ func (p *ExecutionPayload) DefineSSZ(codec *ssz.Codec) {
 	ssz.DefineUint64(codec, &p.GasLimit)
 	ssz.DefineUint64(codec, &p.GasUsed)
 	ssz.DefineUint64(codec, &p.Timestamp)
-	ssz.DefineDynamicBytesOffset(codec, (*[]byte)(&p.ExtraData), 32)
+	ssz.DefineDynamicBytesOffset(
+		codec, (*[]byte)(&p.ExtraData), p.ExtraData.MaxLength(),
+	)
 	ssz.DefineUint256(codec, &p.BaseFeePerGas)
 	ssz.DefineStaticBytes(codec, &p.BlockHash)
 	ssz.DefineSliceOfDynamicBytesOffset(
@@ -115,19 +115,25 @@ func (p *ExecutionPayload) DefineSSZ(codec *ssz.Codec) {
 		constants.MaxTxsPerPayload,
 		constants.MaxBytesPerTx,
 	)
-	ssz.DefineSliceOfStaticObjectsOffset(codec, &p.Withdrawals, 16)
+	ssz.DefineSliceOfStaticObjectsOffset(
+		codec, &p.Withdrawals, constants.MaxWithdrawalsPerPayload,
+	)
 	ssz.DefineUint64(codec, &p.BlobGasUsed)
 	ssz.DefineUint64(codec, &p.ExcessBlobGas)
 
 	// Define the dynamic data (fields)
-	ssz.DefineDynamicBytesContent(codec, (*[]byte)(&p.ExtraData), 32)
+	ssz.DefineDynamicBytesContent(
+		codec, (*[]byte)(&p.ExtraData), p.ExtraData.MaxLength(),
+	)
 	ssz.DefineSliceOfDynamicBytesContent(
 		codec,
 		(*[][]byte)(&p.Transactions),
 		constants.MaxTxsPerPayload,
 		constants.MaxBytesPerTx,
 	)
-	ssz.DefineSliceOfStaticObjectsContent(codec, &p.Withdrawals, 16)
+	ssz.DefineSliceOfStaticObjectsContent(
+		codec, &p.Withdrawals, constants.MaxWithdrawalsPerPayload,
+	)
 
 	// Post Shangai an EL explicitly check that Withdrawals are not nil
 	// (instead empty slices are fine). Currently BeaconKit duly builds
@@ -210,7 +216,7 @@ func (p *ExecutionPayload) HashTreeRootWith(hh fastssz.HashWalker) error {
 	{
 		elemIndx := hh.Index()
 		byteLen := uint64(len(p.ExtraData))
-		if byteLen > 32 {
+		if byteLen > p.ExtraData.MaxLength() {
 			return fastssz.ErrIncorrectListSize
 		}
 		hh.Append(p.ExtraData)
@@ -252,7 +258,7 @@ func (p *ExecutionPayload) HashTreeRootWith(hh fastssz.HashWalker) error {
 	{
 		subIndx := hh.Index()
 		num := uint64(len(p.Withdrawals))
-		if num > 16 {
+		if num > constants.MaxWithdrawalsPerPayload {
 			return fastssz.ErrIncorrectListSize
 		}
 		for _, elem := range p.Withdrawals {
@@ -260,7 +266,9 @@ func (p *ExecutionPayload) HashTreeRootWith(hh fastssz.HashWalker) error {
 				return err
 			}
 		}
-		hh.MerkleizeWithMixin(subIndx, num, 16)
+		hh.MerkleizeWithMixin(
+			subIndx, num, constants.MaxWithdrawalsPerPayload,
+		)
 	}
 
 	// Field (15) 'BlobGasUsed'
@@ -286,7 +294,7 @@ func (p *ExecutionPayload) GetTree() (*fastssz.Node, error) {
 func (p ExecutionPayload) MarshalJSON() ([]byte, error) {
 	type ExecutionPayload struct {
 		ParentHash    common.ExecutionHash           `json:"parentHash"`
-		FeeRecipient  common.ExecutionAddress        `json:"feeRecipient"`
+		FeeRecipient  bytes.B20                      `json:"feeRecipient"`
 		StateRoot     bytes.B32                      `json:"stateRoot"`
 		ReceiptsRoot  bytes.B32                      `json:"receiptsRoot"`
 		LogsBloom     bytes.B256                     `json:"logsBloom"`
@@ -295,7 +303,7 @@ func (p ExecutionPayload) MarshalJSON() ([]byte, error) {
 		GasLimit      math.U64                       `json:"gasLimit"`
 		GasUsed       math.U64                       `json:"gasUsed"`
 		Timestamp     math.U64                       `json:"timestamp"`
-		ExtraData     bytes.Bytes                    `json:"extraData"`
+		ExtraData     bytes.Bounded[bytes.Limit32]   `json:"extraData"`
 		BaseFeePerGas *math.U256Hex                  `json:"baseFeePerGas"`
 		BlockHash     common.ExecutionHash           `json:"blockHash"`
 		Transactions  []bytes.Bytes                  `json:"transactions"`
@@ -305,7 +313,8 @@ func (p ExecutionPayload) MarshalJSON() ([]byte, error) {
 	}
 	var enc ExecutionPayload
 	enc.ParentHash = p.ParentHash
-	enc.FeeRecipient = p.FeeRecipient
+	// Addresses are encoded in lowercase hex like go-ethereum does.
+	enc.FeeRecipient = bytes.B20(p.FeeRecipient)
 	enc.StateRoot = p.StateRoot
 	enc.ReceiptsRoot = p.ReceiptsRoot
 	enc.LogsBloom = p.LogsBloom
@@ -321,7 +330,11 @@ func (p ExecutionPayload) MarshalJSON() ([]byte, error) {
 	for k, v := range p.Transactions {
 		enc.Transactions[k] = v
 	}
+	// The Engine API requires withdrawals to be a list since Shanghai.
 	enc.Withdrawals = p.Withdrawals
+	if enc.Withdrawals == nil {
+		enc.Withdrawals = []*engineprimitives.Withdrawal{}
+	}
 	enc.BlobGasUsed = p.BlobGasUsed
 	enc.ExcessBlobGas = p.ExcessBlobGas
 	return json.Marshal(&enc)
@@ -342,7 +355,7 @@ func (p *ExecutionPayload) UnmarshalJSON(input []byte) error {
 		GasLimit      *math.U64                      `json:"gasLimit"`
 		GasUsed       *math.U64                      `json:"gasUsed"`
 		Timestamp     *math.U64                      `json:"timestamp"`
-		ExtraData     *bytes.Bytes                   `json:"extraData"`
+		ExtraData     *bytes.Bounded[bytes.Limit32]  `json:"extraData"`
 		BaseFeePerGas *math.U256Hex                  `json:"baseFeePerGas"`
 		BlockHash     *common.ExecutionHash          `json:"blockHash"`
 		Transactions  []bytes.Bytes                  `json:"transactions"`
@@ -441,15 +454,24 @@ func (p *ExecutionPayload) UnmarshalJSON(input []byte) error {
 	for k, v := range dec.Transactions {
 		p.Transactions[k] = v
 	}
-	if dec.Withdrawals != nil {
-		p.Withdrawals = dec.Withdrawals
+	if dec.Withdrawals == nil {
+		return errors.New(
+			"missing required field 'withdrawals' for ExecutionPayload",
+		)
 	}
-	if dec.BlobGasUsed != nil {
-		p.BlobGasUsed = *dec.BlobGasUsed
+	p.Withdrawals = dec.Withdrawals
+	if dec.BlobGasUsed == nil {
+		return errors.New(
+			"missing required field 'blobGasUsed' for ExecutionPayload",
+		)
 	}
-	if dec.ExcessBlobGas != nil {
-		p.ExcessBlobGas = *dec.ExcessBlobGas
+	p.BlobGasUsed = *dec.BlobGasUsed
+	if dec.ExcessBlobGas == nil {
+		return errors.New(
+			"missing required field 'excessBlobGas' for ExecutionPayload",
+		)
 	}
+	p.ExcessBlobGas = *dec.ExcessBlobGas
 	return nil
 }
 
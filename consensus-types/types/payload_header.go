@@ -28,7 +28,6 @@ import (
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/primitives/version"
 	fastssz "github.com/ferranbt/fastssz"
-	"github.com/holiman/uint256"
 	"github.com/karalabe/ssz"
 )
 
@@ -65,9 +64,9 @@ type ExecutionPayloadHeader struct {
 	// Timestamp is the timestamp of the block.
 	Timestamp math.U64 `json:"timestamp"`
 	// ExtraData is the extra data of the block.
-	ExtraData bytes.Bytes `json:"extraData"`
+	ExtraData bytes.Bounded[bytes.Limit32] `json:"extraData"`
 	// BaseFeePerGas is the base fee per gas.
-	BaseFeePerGas *uint256.Int `json:"baseFeePerGas"`
+	BaseFeePerGas *math.U256 `json:"baseFeePerGas"`
 	// BlockHash is the hash of the block.
 	BlockHash common.ExecutionHash `json:"blockHash"`
 	// TransactionsRoot is the root of the transaction trie.
@@ -83,7 +82,7 @@ type ExecutionPayloadHeader struct {
 // Empty returns an empty ExecutionPayload for the given fork version.
 func (h *ExecutionPayloadHeader) Empty() *ExecutionPayloadHeader {
 	return &ExecutionPayloadHeader{
-		BaseFeePerGas: &uint256.Int{},
+		BaseFeePerGas: &math.U256{},
 	}
 }
 
@@ -133,8 +132,9 @@ func (h *ExecutionPayloadHeader) DefineSSZ(codec *ssz.Codec) {
 	ssz.DefineUint64(codec, &h.GasLimit)
 	ssz.DefineUint64(codec, &h.GasUsed)
 	ssz.DefineUint64(codec, &h.Timestamp)
-	//nolint:mnd // todo fix.
-	ssz.DefineDynamicBytesOffset(codec, (*[]byte)(&h.ExtraData), 32)
+	ssz.DefineDynamicBytesOffset(
+		codec, (*[]byte)(&h.ExtraData), h.ExtraData.MaxLength(),
+	)
 	ssz.DefineUint256(codec, &h.BaseFeePerGas)
 	ssz.DefineStaticBytes(codec, &h.BlockHash)
 	ssz.DefineStaticBytes(codec, &h.TransactionsRoot)
@@ -143,8 +143,9 @@ func (h *ExecutionPayloadHeader) DefineSSZ(codec *ssz.Codec) {
 	ssz.DefineUint64(codec, &h.ExcessBlobGas)
 
 	// Define the dynamic data (fields)
-	//nolint:mnd // todo fix.
-	ssz.DefineDynamicBytesContent(codec, (*[]byte)(&h.ExtraData), 32)
+	ssz.DefineDynamicBytesContent(
+		codec, (*[]byte)(&h.ExtraData), h.ExtraData.MaxLength(),
+	)
 }
 
 // MarshalSSZ serializes the ExecutionPayloadHeader object into a slice of
@@ -228,7 +229,7 @@ func (h *ExecutionPayloadHeader) HashTreeRootWith(hh fastssz.HashWalker) error {
 	{
 		elemIndx := hh.Index()
 		byteLen := uint64(len(h.ExtraData))
-		if byteLen > 32 {
+		if byteLen > h.ExtraData.MaxLength() {
 			return fastssz.ErrIncorrectListSize
 		}
 		hh.Append(h.ExtraData)
@@ -273,23 +274,23 @@ func (h *ExecutionPayloadHeader) GetTree() (*fastssz.Node, error) {
 // MarshalJSON marshals as JSON.
 func (h ExecutionPayloadHeader) MarshalJSON() ([]byte, error) {
 	type ExecutionPayloadHeader struct {
-		ParentHash       common.ExecutionHash    `json:"parentHash"`
-		FeeRecipient     common.ExecutionAddress `json:"feeRecipient"`
-		StateRoot        bytes.B32               `json:"stateRoot"`
-		ReceiptsRoot     bytes.B32               `json:"receiptsRoot"`
-		LogsBloom        bytes.B256              `json:"logsBloom"`
-		Random           bytes.B32               `json:"prevRandao"`
-		Number           math.U64                `json:"blockNumber"`
-		GasLimit         math.U64                `json:"gasLimit"`
-		GasUsed          math.U64                `json:"gasUsed"`
-		Timestamp        math.U64                `json:"timestamp"`
-		ExtraData        bytes.Bytes             `json:"extraData"`
-		BaseFeePerGas    *math.U256              `json:"baseFeePerGas"`
-		BlockHash        common.ExecutionHash    `json:"blockHash"`
-		TransactionsRoot common.Root             `json:"transactionsRoot"`
-		WithdrawalsRoot  common.Root             `json:"withdrawalsRoot"`
-		BlobGasUsed      math.U64                `json:"blobGasUsed"`
-		ExcessBlobGas    math.U64                `json:"excessBlobGas"`
+		ParentHash       common.ExecutionHash         `json:"parentHash"`
+		FeeRecipient     common.ExecutionAddress      `json:"feeRecipient"`
+		StateRoot        bytes.B32                    `json:"stateRoot"`
+		ReceiptsRoot     bytes.B32                    `json:"receiptsRoot"`
+		LogsBloom        bytes.B256                   `json:"logsBloom"`
+		Random           bytes.B32                    `json:"prevRandao"`
+		Number           math.U64                     `json:"blockNumber"`
+		GasLimit         math.U64                     `json:"gasLimit"`
+		GasUsed          math.U64                     `json:"gasUsed"`
+		Timestamp        math.U64                     `json:"timestamp"`
+		ExtraData        bytes.Bounded[bytes.Limit32] `json:"extraData"`
+		BaseFeePerGas    *math.U256                   `json:"baseFeePerGas"`
+		BlockHash        common.ExecutionHash         `json:"blockHash"`
+		TransactionsRoot common.Root                  `json:"transactionsRoot"`
+		WithdrawalsRoot  common.Root                  `json:"withdrawalsRoot"`
+		BlobGasUsed      math.U64                     `json:"blobGasUsed"`
+		ExcessBlobGas    math.U64                     `json:"excessBlobGas"`
 	}
 	var enc ExecutionPayloadHeader
 	enc.ParentHash = h.ParentHash
@@ -317,23 +318,23 @@ func (h ExecutionPayloadHeader) MarshalJSON() ([]byte, error) {
 //nolint:funlen // codegen.
 func (h *ExecutionPayloadHeader) UnmarshalJSON(input []byte) error {
 	type ExecutionPayloadHeader struct {
-		ParentHash       *common.ExecutionHash    `json:"parentHash"`
-		FeeRecipient     *common.ExecutionAddress `json:"feeRecipient"`
-		StateRoot        *bytes.B32               `json:"stateRoot"`
-		ReceiptsRoot     *bytes.B32               `json:"receiptsRoot"`
-		LogsBloom        *bytes.B256              `json:"logsBloom"`
-		Random           *bytes.B32               `json:"prevRandao"`
-		Number           *math.U64                `json:"blockNumber"`
-		GasLimit         *math.U64                `json:"gasLimit"`
-		GasUsed          *math.U64                `json:"gasUsed"`
-		Timestamp        *math.U64                `json:"timestamp"`
-		ExtraData        *bytes.Bytes             `json:"extraData"`
-		BaseFeePerGas    *math.U256               `json:"baseFeePerGas"`
-		BlockHash        *common.ExecutionHash    `json:"blockHash"`
-		TransactionsRoot *common.Root             `json:"transactionsRoot"`
-		WithdrawalsRoot  *common.Root             `json:"withdrawalsRoot"`
-		BlobGasUsed      *math.U64                `json:"blobGasUsed"`
-		ExcessBlobGas    *math.U64                `json:"excessBlobGas"`
+		ParentHash       *common.ExecutionHash         `json:"parentHash"`
+		FeeRecipient     *common.ExecutionAddress      `json:"feeRecipient"`
+		StateRoot        *bytes.B32                    `json:"stateRoot"`
+		ReceiptsRoot     *bytes.B32                    `json:"receiptsRoot"`
+		LogsBloom        *bytes.B256                   `json:"logsBloom"`
+		Random           *bytes.B32                    `json:"prevRandao"`
+		Number           *math.U64                     `json:"blockNumber"`
+		GasLimit         *math.U64                     `json:"gasLimit"`
+		GasUsed          *math.U64                     `json:"gasUsed"`
+		Timestamp        *math.U64                     `json:"timestamp"`
+		ExtraData        *bytes.Bounded[bytes.Limit32] `json:"extraData"`
+		BaseFeePerGas    *math.U256                    `json:"baseFeePerGas"`
+		BlockHash        *common.ExecutionHash         `json:"blockHash"`
+		TransactionsRoot *common.Root                  `json:"transactionsRoot"`
+		WithdrawalsRoot  *common.Root                  `json:"withdrawalsRoot"`
+		BlobGasUsed      *math.U64                     `json:"blobGasUsed"`
+		ExcessBlobGas    *math.U64                     `json:"excessBlobGas"`
 	}
 	var dec ExecutionPayloadHeader
 	if err := json.Unmarshal(input, &dec); err != nil {
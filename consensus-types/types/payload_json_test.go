@@ -0,0 +1,274 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"encoding/json"
+	stdmath "math"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	gethprimitives "github.com/berachain/beacon-kit/geth-primitives"
+	"github.com/berachain/beacon-kit/primitives/bytes"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	coretypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+)
+
+// toExecutableData converts the payload to the go-ethereum representation
+// exchanged over the Engine API.
+func toExecutableData(
+	p *types.ExecutionPayload,
+) *gethprimitives.ExecutableData {
+	withdrawals := make(gethprimitives.Withdrawals, len(p.Withdrawals))
+	for i, w := range p.Withdrawals {
+		withdrawals[i] = &coretypes.Withdrawal{
+			Index:     w.Index.Unwrap(),
+			Validator: w.Validator.Unwrap(),
+			Address:   gethprimitives.ExecutionAddress(w.Address),
+			Amount:    w.Amount.Unwrap(),
+		}
+	}
+	blobGasUsed := p.BlobGasUsed.Unwrap()
+	excessBlobGas := p.ExcessBlobGas.Unwrap()
+	return &gethprimitives.ExecutableData{
+		ParentHash:    gethprimitives.ExecutionHash(p.ParentHash),
+		FeeRecipient:  gethprimitives.ExecutionAddress(p.FeeRecipient),
+		StateRoot:     gethprimitives.ExecutionHash(p.StateRoot),
+		ReceiptsRoot:  gethprimitives.ExecutionHash(p.ReceiptsRoot),
+		LogsBloom:     p.LogsBloom[:],
+		Random:        gethprimitives.ExecutionHash(p.Random),
+		Number:        p.Number.Unwrap(),
+		GasLimit:      p.GasLimit.Unwrap(),
+		GasUsed:       p.GasUsed.Unwrap(),
+		Timestamp:     p.Timestamp.Unwrap(),
+		ExtraData:     p.ExtraData,
+		BaseFeePerGas: p.BaseFeePerGas.ToBig(),
+		BlockHash:     gethprimitives.ExecutionHash(p.BlockHash),
+		Transactions:  p.Transactions,
+		Withdrawals:   withdrawals,
+		BlobGasUsed:   &blobGasUsed,
+		ExcessBlobGas: &excessBlobGas,
+	}
+}
+
+// edgeExecutionPayloads returns payloads exercising the edge encodings of
+// every field kind.
+func edgeExecutionPayloads() map[string]*types.ExecutionPayload {
+	maxU256 := new(uint256.Int).SetAllOne()
+	full := &types.ExecutionPayload{
+		ParentHash:    common.ExecutionHash{0xff, 0x01},
+		FeeRecipient:  common.ExecutionAddress{0xab, 0xcd},
+		StateRoot:     bytes.B32{0x01},
+		ReceiptsRoot:  bytes.B32{0x02},
+		LogsBloom:     bytes.B256{0x03, 255: 0x04},
+		Random:        bytes.B32{0x05},
+		Number:        math.U64(stdmath.MaxUint64),
+		GasLimit:      math.U64(30_000_000),
+		GasUsed:       math.U64(0x10),
+		Timestamp:     math.U64(0x100000000),
+		ExtraData:     []byte(strings.Repeat("x", 32)),
+		BaseFeePerGas: maxU256,
+		BlockHash:     common.ExecutionHash{0x06},
+		Transactions:  [][]byte{{}, {0x00}, {0x02, 0xf8, 0x70}},
+		Withdrawals: []*engineprimitives.Withdrawal{
+			{Index: 0, Validator: 0, Amount: 0},
+			{
+				Index:     math.U64(stdmath.MaxUint64),
+				Validator: math.ValidatorIndex(1),
+				Address:   common.ExecutionAddress{0x07},
+				Amount:    math.Gwei(32_000_000_000),
+			},
+		},
+		BlobGasUsed:   math.U64(0x60000),
+		ExcessBlobGas: math.U64(stdmath.MaxUint64),
+	}
+	return map[string]*types.ExecutionPayload{
+		"zero": {
+			ExtraData:     []byte{},
+			BaseFeePerGas: math.NewU256(0),
+			Transactions:  [][]byte{},
+			Withdrawals:   []*engineprimitives.Withdrawal{},
+		},
+		"small quantities": {
+			Number:        math.U64(1),
+			GasLimit:      math.U64(0xf),
+			GasUsed:       math.U64(0x10),
+			Timestamp:     math.U64(0xff),
+			ExtraData:     []byte{0x00},
+			BaseFeePerGas: math.NewU256(7),
+			Transactions:  [][]byte{{0x01}},
+			Withdrawals:   []*engineprimitives.Withdrawal{{Amount: 1}},
+			BlobGasUsed:   math.U64(1),
+			ExcessBlobGas: math.U64(0x100),
+		},
+		"base fee above 64 bits": {
+			ExtraData:     []byte{},
+			BaseFeePerGas: new(uint256.Int).Lsh(uint256.NewInt(1), 64),
+			Transactions:  [][]byte{},
+			Withdrawals:   []*engineprimitives.Withdrawal{},
+		},
+		"maximum values": full,
+	}
+}
+
+func TestExecutionPayload_JSONMatchesGeth(t *testing.T) {
+	for name, payload := range edgeExecutionPayloads() {
+		t.Run(name, func(t *testing.T) {
+			ours, err := json.Marshal(payload)
+			require.NoError(t, err)
+			geth, err := json.Marshal(toExecutableData(payload))
+			require.NoError(t, err)
+			require.JSONEq(t, string(geth), string(ours))
+
+			var decoded types.ExecutionPayload
+			require.NoError(t, json.Unmarshal(geth, &decoded))
+			require.Equal(t, payload, &decoded)
+
+			var gethDecoded gethprimitives.ExecutableData
+			require.NoError(t, json.Unmarshal(ours, &gethDecoded))
+			require.Equal(t, toExecutableData(payload), &gethDecoded)
+		})
+	}
+}
+
+func TestExecutionPayload_MarshalJSONNilWithdrawals(t *testing.T) {
+	payload := generateExecutionPayload()
+	payload.Withdrawals = nil
+
+	bz, err := json.Marshal(payload)
+	require.NoError(t, err)
+	var fields map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(bz, &fields))
+	require.JSONEq(t, `[]`, string(fields["withdrawals"]))
+}
+
+func TestExecutionPayload_UnmarshalJSONQuantities(t *testing.T) {
+	valid, err := json.Marshal(generateExecutionPayload())
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name  string
+		field string
+		value string
+	}{
+		{"leading zero", "blockNumber", `"0x01"`},
+		{"missing prefix", "blockNumber", `"1"`},
+		{"empty quantity", "blockNumber", `"0x"`},
+		{"json number", "blockNumber", `1`},
+		{"above 64 bits", "blockNumber", `"0x10000000000000000"`},
+		{"invalid digit", "gasUsed", `"0xg"`},
+		{"base fee leading zero", "baseFeePerGas", `"0x01"`},
+		{"base fee decimal", "baseFeePerGas", `"1"`},
+		{"base fee json number", "baseFeePerGas", `1`},
+		{"base fee empty", "baseFeePerGas", `"0x"`},
+		{
+			"base fee above 256 bits", "baseFeePerGas",
+			`"0x1` + strings.Repeat("0", 64) + `"`,
+		},
+		{"blob gas leading zero", "blobGasUsed", `"0x00"`},
+		{"extra data odd length", "extraData", `"0x1"`},
+		{
+			"extra data too long", "extraData",
+			`"0x` + strings.Repeat("00", 33) + `"`,
+		},
+		{"bloom too short", "logsBloom", `"0x00"`},
+		{"hash too short", "blockHash", `"0x00"`},
+		{"transaction missing prefix", "transactions", `["01"]`},
+		{
+			"withdrawal index leading zero", "withdrawals",
+			`[{"index":"0x01","validatorIndex":"0x0","address":"` +
+				`0x0000000000000000000000000000000000000000","amount":"0x0"}]`,
+		},
+	}
+	// go-ethereum decodes these and only rejects them once it validates the
+	// decoded payload.
+	gethAccepts := map[string]bool{
+		"extra data too long": true,
+		"bloom too short":     true,
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var fields map[string]json.RawMessage
+			require.NoError(t, json.Unmarshal(valid, &fields))
+			fields[tc.field] = json.RawMessage(tc.value)
+			malformed, err := json.Marshal(fields)
+			require.NoError(t, err)
+
+			var gethDecoded gethprimitives.ExecutableData
+			gethErr := json.Unmarshal(malformed, &gethDecoded)
+			require.Equal(t, gethAccepts[tc.name], gethErr == nil)
+			var decoded types.ExecutionPayload
+			require.Error(t, json.Unmarshal(malformed, &decoded))
+		})
+	}
+}
+
+func TestExecutionPayload_UnmarshalJSONRequiresDenebFields(t *testing.T) {
+	valid, err := json.Marshal(generateExecutionPayload())
+	require.NoError(t, err)
+
+	// go-ethereum decodes these as absent and rejects the payload in
+	// engine_newPayloadV3, so they are required right away.
+	for _, field := range []string{
+		"withdrawals", "blobGasUsed", "excessBlobGas",
+	} {
+		for _, value := range []json.RawMessage{nil, json.RawMessage(`null`)} {
+			var fields map[string]json.RawMessage
+			require.NoError(t, json.Unmarshal(valid, &fields))
+			if value == nil {
+				delete(fields, field)
+			} else {
+				fields[field] = value
+			}
+			malformed, mErr := json.Marshal(fields)
+			require.NoError(t, mErr)
+
+			var decoded types.ExecutionPayload
+			err = json.Unmarshal(malformed, &decoded)
+			require.ErrorContains(
+				t, err, "missing required field '"+field+"'",
+			)
+		}
+	}
+}
+
+func TestExecutionPayload_BaseFeeMatchesGeth(t *testing.T) {
+	// Quantities round trip through go-ethereum without leading zeros.
+	for _, v := range []*big.Int{
+		big.NewInt(0), big.NewInt(1), big.NewInt(0x10),
+		new(big.Int).Lsh(big.NewInt(1), 255),
+	} {
+		payload := generateExecutionPayload()
+		payload.BaseFeePerGas = uint256.MustFromBig(v)
+		bz, err := json.Marshal(payload)
+		require.NoError(t, err)
+
+		var gethDecoded gethprimitives.ExecutableData
+		require.NoError(t, json.Unmarshal(bz, &gethDecoded))
+		require.Zero(t, v.Cmp(gethDecoded.BaseFeePerGas))
+	}
+}
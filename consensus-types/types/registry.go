@@ -0,0 +1,220 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// ValidatorRegistry holds the validators and balances of a beacon state in
+// flat, index-addressed arrays, one per validator field. Compared to a slice
+// of *Validator it holds no per-validator pointers, which keeps the GC out of
+// the way for large registries and lets epoch processing walk a single field
+// across all validators sequentially.
+//
+// The registry is the working representation of the validator set; use
+// Validators and Balances to convert back into the SSZ layout of the
+// BeaconState.
+type ValidatorRegistry struct {
+	pubkeys                     []crypto.BLSPubkey
+	withdrawalCredentials       []WithdrawalCredentials
+	effectiveBalances           []math.Gwei
+	slashed                     []bool
+	activationEligibilityEpochs []math.Epoch
+	activationEpochs            []math.Epoch
+	exitEpochs                  []math.Epoch
+	withdrawableEpochs          []math.Epoch
+	balances                    []math.Gwei
+}
+
+// NewValidatorRegistry builds a registry from the SSZ layout of the validator
+// set, where validators[i] has balance balances[i].
+func NewValidatorRegistry(
+	validators []*Validator,
+	balances []uint64,
+) (*ValidatorRegistry, error) {
+	if len(validators) != len(balances) {
+		return nil, errors.Wrapf(
+			ErrRegistryLengthMismatch,
+			"%d validators, %d balances",
+			len(validators), len(balances),
+		)
+	}
+
+	r := newValidatorRegistry(len(validators))
+	for i, val := range validators {
+		r.Append(val, math.Gwei(balances[i]))
+	}
+	return r, nil
+}
+
+// newValidatorRegistry returns an empty registry with room for size
+// validators.
+func newValidatorRegistry(size int) *ValidatorRegistry {
+	return &ValidatorRegistry{
+		pubkeys:                     make([]crypto.BLSPubkey, 0, size),
+		withdrawalCredentials:       make([]WithdrawalCredentials, 0, size),
+		effectiveBalances:           make([]math.Gwei, 0, size),
+		slashed:                     make([]bool, 0, size),
+		activationEligibilityEpochs: make([]math.Epoch, 0, size),
+		activationEpochs:            make([]math.Epoch, 0, size),
+		exitEpochs:                  make([]math.Epoch, 0, size),
+		withdrawableEpochs:          make([]math.Epoch, 0, size),
+		balances:                    make([]math.Gwei, 0, size),
+	}
+}
+
+// Len returns the number of validators in the registry.
+func (r *ValidatorRegistry) Len() int {
+	return len(r.pubkeys)
+}
+
+// Append adds a validator with the given balance at the next index.
+func (r *ValidatorRegistry) Append(val *Validator, balance math.Gwei) {
+	r.pubkeys = append(r.pubkeys, val.Pubkey)
+	r.withdrawalCredentials = append(
+		r.withdrawalCredentials, val.WithdrawalCredentials,
+	)
+	r.effectiveBalances = append(r.effectiveBalances, val.EffectiveBalance)
+	r.slashed = append(r.slashed, val.Slashed)
+	r.activationEligibilityEpochs = append(
+		r.activationEligibilityEpochs, val.ActivationEligibilityEpoch,
+	)
+	r.activationEpochs = append(r.activationEpochs, val.ActivationEpoch)
+	r.exitEpochs = append(r.exitEpochs, val.ExitEpoch)
+	r.withdrawableEpochs = append(
+		r.withdrawableEpochs, val.WithdrawableEpoch,
+	)
+	r.balances = append(r.balances, balance)
+}
+
+// Validator materializes the validator at the given index.
+func (r *ValidatorRegistry) Validator(idx math.ValidatorIndex) *Validator {
+	return &Validator{
+		Pubkey:                     r.pubkeys[idx],
+		WithdrawalCredentials:      r.withdrawalCredentials[idx],
+		EffectiveBalance:           r.effectiveBalances[idx],
+		Slashed:                    r.slashed[idx],
+		ActivationEligibilityEpoch: r.activationEligibilityEpochs[idx],
+		ActivationEpoch:            r.activationEpochs[idx],
+		ExitEpoch:                  r.exitEpochs[idx],
+		WithdrawableEpoch:          r.withdrawableEpochs[idx],
+	}
+}
+
+// Validators converts the registry back into the SSZ layout of the validator
+// set. The validators are allocated in a single block.
+func (r *ValidatorRegistry) Validators() Validators {
+	var (
+		backing = make([]Validator, r.Len())
+		vals    = make(Validators, r.Len())
+	)
+	for i := range backing {
+		idx := math.ValidatorIndex(i)
+		backing[i] = *r.Validator(idx)
+		vals[i] = &backing[i]
+	}
+	return vals
+}
+
+// Balances converts the registry balances back into the SSZ layout of the
+// BeaconState.
+func (r *ValidatorRegistry) Balances() []uint64 {
+	balances := make([]uint64, r.Len())
+	for i, balance := range r.balances {
+		balances[i] = balance.Unwrap()
+	}
+	return balances
+}
+
+// Pubkey returns the public key of the validator at the given index.
+func (r *ValidatorRegistry) Pubkey(
+	idx math.ValidatorIndex,
+) crypto.BLSPubkey {
+	return r.pubkeys[idx]
+}
+
+// EffectiveBalance returns the effective balance of the validator at the
+// given index.
+func (r *ValidatorRegistry) EffectiveBalance(
+	idx math.ValidatorIndex,
+) math.Gwei {
+	return r.effectiveBalances[idx]
+}
+
+// SetEffectiveBalance sets the effective balance of the validator at the
+// given index.
+func (r *ValidatorRegistry) SetEffectiveBalance(
+	idx math.ValidatorIndex,
+	balance math.Gwei,
+) {
+	r.effectiveBalances[idx] = balance
+}
+
+// Balance returns the balance of the validator at the given index.
+func (r *ValidatorRegistry) Balance(idx math.ValidatorIndex) math.Gwei {
+	return r.balances[idx]
+}
+
+// SetBalance sets the balance of the validator at the given index.
+func (r *ValidatorRegistry) SetBalance(
+	idx math.ValidatorIndex,
+	balance math.Gwei,
+) {
+	r.balances[idx] = balance
+}
+
+// IsSlashed returns whether the validator at the given index has been
+// slashed.
+func (r *ValidatorRegistry) IsSlashed(idx math.ValidatorIndex) bool {
+	return r.slashed[idx]
+}
+
+// IsActive returns whether the validator at the given index is active in the
+// given epoch.
+func (r *ValidatorRegistry) IsActive(
+	idx math.ValidatorIndex,
+	epoch math.Epoch,
+) bool {
+	return r.activationEpochs[idx] <= epoch && epoch < r.exitEpochs[idx]
+}
+
+// WithdrawableEpoch returns the epoch in which the validator at the given
+// index can withdraw.
+func (r *ValidatorRegistry) WithdrawableEpoch(
+	idx math.ValidatorIndex,
+) math.Epoch {
+	return r.withdrawableEpochs[idx]
+}
+
+// TotalActiveBalance returns the sum of the effective balances of the
+// validators active in the given epoch.
+func (r *ValidatorRegistry) TotalActiveBalance(epoch math.Epoch) math.Gwei {
+	var total math.Gwei
+	for i, effectiveBalance := range r.effectiveBalances {
+		if r.activationEpochs[i] <= epoch && epoch < r.exitEpochs[i] {
+			total += effectiveBalance
+		}
+	}
+	return total
+}
@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+
+package types_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/stretchr/testify/require"
+)
+
+// newRegistryValidators returns n distinct validators with their balances.
+func newRegistryValidators(n int) ([]*types.Validator, []uint64) {
+	var (
+		vals     = make([]*types.Validator, n)
+		balances = make([]uint64, n)
+	)
+	for i := range n {
+		vals[i] = &types.Validator{
+			Pubkey: [48]byte{byte(i), byte(i >> 8)},
+			WithdrawalCredentials: types.
+				NewCredentialsFromExecutionAddress(
+					common.ExecutionAddress{byte(i)},
+				),
+			EffectiveBalance:           math.Gwei(i) * 1e9,
+			Slashed:                    i%3 == 0,
+			ActivationEligibilityEpoch: math.Epoch(i),
+			ActivationEpoch:            math.Epoch(i + 1),
+			ExitEpoch:                  math.Epoch(i + 10),
+			WithdrawableEpoch:          math.Epoch(i + 20),
+		}
+		balances[i] = uint64(i)*1e9 + 1
+	}
+	return vals, balances
+}
+
+func TestValidatorRegistryRoundTrip(t *testing.T) {
+	vals, balances := newRegistryValidators(16)
+
+	registry, err := types.NewValidatorRegistry(vals, balances)
+	require.NoError(t, err)
+	require.Equal(t, len(vals), registry.Len())
+
+	require.Equal(t, types.Validators(vals), registry.Validators())
+	require.Equal(t, balances, registry.Balances())
+	require.Equal(
+		t,
+		types.Validators(vals).HashTreeRoot(),
+		registry.Validators().HashTreeRoot(),
+	)
+
+	for i, val := range vals {
+		idx := math.ValidatorIndex(i)
+		require.Equal(t, val, registry.Validator(idx))
+		require.Equal(t, val.Pubkey, registry.Pubkey(idx))
+		require.Equal(t, val.Slashed, registry.IsSlashed(idx))
+		require.Equal(t, val.IsActive(5), registry.IsActive(idx, 5))
+		require.Equal(
+			t, val.WithdrawableEpoch, registry.WithdrawableEpoch(idx),
+		)
+	}
+}
+
+func TestValidatorRegistryUpdates(t *testing.T) {
+	vals, balances := newRegistryValidators(4)
+	registry, err := types.NewValidatorRegistry(vals, balances)
+	require.NoError(t, err)
+
+	registry.SetEffectiveBalance(2, 7e9)
+	registry.SetBalance(2, 8e9)
+	require.Equal(t, math.Gwei(7e9), registry.EffectiveBalance(2))
+	require.Equal(t, math.Gwei(8e9), registry.Balance(2))
+	require.Equal(t, math.Gwei(7e9), registry.Validator(2).EffectiveBalance)
+	require.Equal(t, uint64(8e9), registry.Balances()[2])
+
+	// The source validators are not aliased by the registry.
+	require.Equal(t, math.Gwei(2e9), vals[2].EffectiveBalance)
+
+	registry.Append(&types.Validator{Pubkey: [48]byte{0xff}}, 1)
+	require.Equal(t, 5, registry.Len())
+	require.Equal(t, [48]byte{0xff}, [48]byte(registry.Pubkey(4)))
+}
+
+func TestValidatorRegistryTotalActiveBalance(t *testing.T) {
+	vals, balances := newRegistryValidators(8)
+	registry, err := types.NewValidatorRegistry(vals, balances)
+	require.NoError(t, err)
+
+	var expected math.Gwei
+	for _, val := range vals {
+		if val.IsActive(5) {
+			expected += val.EffectiveBalance
+		}
+	}
+	require.Equal(t, expected, registry.TotalActiveBalance(5))
+}
+
+func TestValidatorRegistryLengthMismatch(t *testing.T) {
+	vals, balances := newRegistryValidators(3)
+	_, err := types.NewValidatorRegistry(vals, balances[:2])
+	require.ErrorIs(t, err, types.ErrRegistryLengthMismatch)
+}
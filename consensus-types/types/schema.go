@@ -0,0 +1,224 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/encoding/ssz/schema"
+)
+
+// The schemas below describe the merkleization of the consensus types, from
+// which the generalized indices of their fields are derived. They must be
+// kept in sync with the DefineSSZ methods of the types they describe. The
+// Deneb+ and Electra forks share the Deneb layout.
+
+// BeaconStateSchemaDeneb returns the schema of the BeaconState in the Deneb
+// fork.
+//
+//nolint:mnd // list limits of the state.
+func BeaconStateSchemaDeneb() schema.SSZType {
+	return schema.DefineContainer(
+		schema.NewField("GenesisValidatorsRoot", schema.B32()),
+		schema.NewField("Slot", schema.U64()),
+		schema.NewField("Fork", forkSchema()),
+		schema.NewField("LatestBlockHeader", beaconBlockHeaderSchema(
+			schema.NewField("StateRoot", schema.B32()),
+		)),
+		schema.NewField("BlockRoots", schema.DefineList(schema.B32(), 8192)),
+		schema.NewField("StateRoots", schema.DefineList(schema.B32(), 8192)),
+		schema.NewField("Eth1Data", eth1DataSchema()),
+		schema.NewField("Eth1DepositIndex", schema.U64()),
+		schema.NewField(
+			"LatestExecutionPayloadHeader", executionPayloadHeaderSchema(),
+		),
+		schema.NewField(
+			"Validators",
+			schema.DefineList(validatorSchema(), MaxValidators),
+		),
+		schema.NewField(
+			"Balances", schema.DefineList(schema.U64(), MaxValidators),
+		),
+		schema.NewField("RandaoMixes", schema.DefineList(schema.B32(), 65536)),
+		schema.NewField("NextWithdrawalIndex", schema.U64()),
+		schema.NewField("NextWithdrawalValidatorIndex", schema.U64()),
+		schema.NewField(
+			"Slashings", schema.DefineList(schema.U64(), MaxValidators),
+		),
+		schema.NewField("TotalSlashing", schema.U64()),
+	)
+}
+
+// BeaconBlockSchemaDeneb returns the schema of the BeaconBlock in the Deneb
+// fork, with its body expanded.
+func BeaconBlockSchemaDeneb() schema.SSZType {
+	return schema.DefineContainer(
+		schema.NewField("Slot", schema.U64()),
+		schema.NewField("ProposerIndex", schema.U64()),
+		schema.NewField("ParentRoot", schema.B32()),
+		schema.NewField("StateRoot", schema.B32()),
+		schema.NewField("Body", beaconBlockBodySchemaDeneb()),
+	)
+}
+
+// BeaconBlockHeaderSchemaDeneb returns the schema of the BeaconBlockHeader in
+// the Deneb fork, with its state root expanded into the BeaconState. It is the
+// tree proofs against a beacon block root are built from.
+func BeaconBlockHeaderSchemaDeneb() schema.SSZType {
+	return beaconBlockHeaderSchema(
+		schema.NewField("State", BeaconStateSchemaDeneb()),
+	)
+}
+
+// beaconBlockBodySchemaDeneb returns the schema of the BeaconBlockBody in the
+// Deneb fork.
+func beaconBlockBodySchemaDeneb() schema.SSZType {
+	return schema.DefineContainer(
+		schema.NewField("RandaoReveal", schema.B96()),
+		schema.NewField("Eth1Data", eth1DataSchema()),
+		schema.NewField("Graffiti", schema.B32()),
+		schema.NewField("Deposits", schema.DefineList(
+			depositSchema(), constants.MaxDepositsPerBlock,
+		)),
+		schema.NewField("ExecutionPayload", executionPayloadSchema()),
+		schema.NewField("BlobKzgCommitments", schema.DefineList(
+			schema.B48(), constants.MaxBlobCommitmentsPerBlock,
+		)),
+	)
+}
+
+// beaconBlockHeaderSchema returns the schema of the BeaconBlockHeader with
+// the given state field.
+func beaconBlockHeaderSchema(
+	state *schema.Field[schema.SSZType],
+) schema.SSZType {
+	return schema.DefineContainer(
+		schema.NewField("Slot", schema.U64()),
+		schema.NewField("ProposerIndex", schema.U64()),
+		schema.NewField("ParentBlockRoot", schema.B32()),
+		state,
+		schema.NewField("BodyRoot", schema.B32()),
+	)
+}
+
+// forkSchema returns the schema of the Fork.
+func forkSchema() schema.SSZType {
+	return schema.DefineContainer(
+		schema.NewField("PreviousVersion", schema.B4()),
+		schema.NewField("CurrentVersion", schema.B4()),
+		schema.NewField("Epoch", schema.U64()),
+	)
+}
+
+// eth1DataSchema returns the schema of the Eth1Data.
+func eth1DataSchema() schema.SSZType {
+	return schema.DefineContainer(
+		schema.NewField("DepositRoot", schema.B32()),
+		schema.NewField("DepositCount", schema.U64()),
+		schema.NewField("BlockHash", schema.B32()),
+	)
+}
+
+// validatorSchema returns the schema of the Validator.
+func validatorSchema() schema.SSZType {
+	return schema.DefineContainer(
+		schema.NewField("Pubkey", schema.B48()),
+		schema.NewField("WithdrawalCredentials", schema.B32()),
+		schema.NewField("EffectiveBalance", schema.U64()),
+		schema.NewField("Slashed", schema.Bool()),
+		schema.NewField("ActivationEligibilityEpoch", schema.U64()),
+		schema.NewField("ActivationEpoch", schema.U64()),
+		schema.NewField("ExitEpoch", schema.U64()),
+		schema.NewField("WithdrawableEpoch", schema.U64()),
+	)
+}
+
+// depositSchema returns the schema of the Deposit.
+func depositSchema() schema.SSZType {
+	return schema.DefineContainer(
+		schema.NewField("Pubkey", schema.B48()),
+		schema.NewField("Credentials", schema.B32()),
+		schema.NewField("Amount", schema.U64()),
+		schema.NewField("Signature", schema.B96()),
+		schema.NewField("Index", schema.U64()),
+	)
+}
+
+// withdrawalSchema returns the schema of the Withdrawal.
+func withdrawalSchema() schema.SSZType {
+	return schema.DefineContainer(
+		schema.NewField("Index", schema.U64()),
+		schema.NewField("Validator", schema.U64()),
+		schema.NewField("Address", schema.B20()),
+		schema.NewField("Amount", schema.U64()),
+	)
+}
+
+// executionPayloadSchema returns the schema of the ExecutionPayload.
+func executionPayloadSchema() schema.SSZType {
+	return schema.DefineContainer(
+		schema.NewField("ParentHash", schema.B32()),
+		schema.NewField("FeeRecipient", schema.B20()),
+		schema.NewField("StateRoot", schema.B32()),
+		schema.NewField("ReceiptsRoot", schema.B32()),
+		schema.NewField("LogsBloom", schema.B256()),
+		schema.NewField("Random", schema.B32()),
+		schema.NewField("Number", schema.U64()),
+		schema.NewField("GasLimit", schema.U64()),
+		schema.NewField("GasUsed", schema.U64()),
+		schema.NewField("Timestamp", schema.U64()),
+		schema.NewField("ExtraData", schema.DefineByteList(ExtraDataSize)),
+		schema.NewField("BaseFeePerGas", schema.U256()),
+		schema.NewField("BlockHash", schema.B32()),
+		schema.NewField("Transactions", schema.DefineList(
+			schema.DefineByteList(constants.MaxBytesPerTx),
+			constants.MaxTxsPerPayload,
+		)),
+		schema.NewField("Withdrawals", schema.DefineList(
+			withdrawalSchema(), constants.MaxWithdrawalsPerPayload,
+		)),
+		schema.NewField("BlobGasUsed", schema.U64()),
+		schema.NewField("ExcessBlobGas", schema.U64()),
+	)
+}
+
+// executionPayloadHeaderSchema returns the schema of the
+// ExecutionPayloadHeader.
+func executionPayloadHeaderSchema() schema.SSZType {
+	return schema.DefineContainer(
+		schema.NewField("ParentHash", schema.B32()),
+		schema.NewField("FeeRecipient", schema.B20()),
+		schema.NewField("StateRoot", schema.B32()),
+		schema.NewField("ReceiptsRoot", schema.B32()),
+		schema.NewField("LogsBloom", schema.B256()),
+		schema.NewField("Random", schema.B32()),
+		schema.NewField("Number", schema.U64()),
+		schema.NewField("GasLimit", schema.U64()),
+		schema.NewField("GasUsed", schema.U64()),
+		schema.NewField("Timestamp", schema.U64()),
+		schema.NewField("ExtraData", schema.DefineByteList(ExtraDataSize)),
+		schema.NewField("BaseFeePerGas", schema.U256()),
+		schema.NewField("BlockHash", schema.B32()),
+		schema.NewField("TransactionsRoot", schema.B32()),
+		schema.NewField("WithdrawalsRoot", schema.B32()),
+		schema.NewField("BlobGasUsed", schema.U64()),
+		schema.NewField("ExcessBlobGas", schema.U64()),
+	)
+}
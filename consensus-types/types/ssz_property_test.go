@@ -0,0 +1,627 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/eip4844"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/transition"
+	"github.com/berachain/beacon-kit/primitives/version"
+	fastssz "github.com/ferranbt/fastssz"
+	karalabessz "github.com/karalabe/ssz"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+)
+
+// The properties below run with rapid's default budget of 100 cases per
+// property. Use -rapid.checks to search longer locally.
+
+// sszObject is the set of methods every SSZ container in this package
+// exposes.
+type sszObject interface {
+	karalabessz.Object
+	MarshalSSZ() ([]byte, error)
+	UnmarshalSSZ([]byte) error
+	HashTreeRoot() common.Root
+}
+
+// fastSSZObject is implemented by the containers that also carry a
+// handwritten fastssz merkleization.
+type fastSSZObject interface {
+	HashTreeRootWith(hh fastssz.HashWalker) error
+}
+
+func TestSSZProperties(t *testing.T) {
+	t.Run("AttestationData", func(t *testing.T) {
+		checkSSZProperties(t, genAttestationData())
+	})
+	t.Run("BeaconBlock", func(t *testing.T) {
+		checkSSZProperties(t, genBeaconBlock(version.Deneb))
+	})
+	t.Run("BeaconBlockDenebPlus", func(t *testing.T) {
+		checkSSZPropertiesWith(
+			t, genBeaconBlock(version.DenebPlus),
+			func() *types.BeaconBlock {
+				return &types.BeaconBlock{
+					Body: emptyBody(version.DenebPlus),
+				}
+			},
+		)
+	})
+	t.Run("BeaconBlockBody", func(t *testing.T) {
+		checkSSZProperties(t, genBeaconBlockBody(version.Deneb))
+	})
+	t.Run("BeaconBlockBodyDenebPlus", func(t *testing.T) {
+		checkSSZPropertiesWith(
+			t, genBeaconBlockBody(version.DenebPlus),
+			func() *types.BeaconBlockBody {
+				return emptyBody(version.DenebPlus)
+			},
+		)
+	})
+	t.Run("BeaconBlockHeader", func(t *testing.T) {
+		checkSSZProperties(t, genBeaconBlockHeader())
+	})
+	t.Run("BeaconState", func(t *testing.T) {
+		checkSSZProperties(t, genBeaconState())
+	})
+	t.Run("Deposit", func(t *testing.T) {
+		checkSSZProperties(t, genDeposit())
+	})
+	t.Run("DepositMessage", func(t *testing.T) {
+		checkSSZProperties(t, genDepositMessage())
+	})
+	t.Run("Eth1Data", func(t *testing.T) {
+		checkSSZProperties(t, genEth1Data())
+	})
+	t.Run("ExecutionPayload", func(t *testing.T) {
+		checkSSZProperties(t, genExecutionPayload())
+	})
+	t.Run("ExecutionPayloadHeader", func(t *testing.T) {
+		checkSSZProperties(t, genPayloadHeader())
+	})
+	t.Run("Fork", func(t *testing.T) {
+		checkSSZProperties(t, genFork())
+	})
+	t.Run("ForkData", func(t *testing.T) {
+		checkSSZProperties(t, genForkData())
+	})
+	t.Run("Operation", func(t *testing.T) {
+		checkSSZProperties(t, genOperation())
+	})
+	t.Run("SigningData", func(t *testing.T) {
+		checkSSZProperties(t, genSigningData())
+	})
+	t.Run("SlashingInfo", func(t *testing.T) {
+		checkSSZProperties(t, genSlashingInfo())
+	})
+	t.Run("Validator", func(t *testing.T) {
+		checkSSZProperties(t, genValidator())
+	})
+}
+
+// checkSSZProperties checks that values drawn from gen survive an SSZ
+// round trip byte for byte, that their hash tree root is stable across the
+// round trip and agrees with every merkleization path, and that any
+// mutated encoding the decoder accepts is canonical.
+func checkSSZProperties[V any, P interface {
+	*V
+	sszObject
+}](t *testing.T, gen *rapid.Generator[P]) {
+	t.Helper()
+	checkSSZPropertiesWith(t, gen, func() P { return new(V) })
+}
+
+// checkSSZPropertiesWith checks the properties of checkSSZProperties,
+// decoding into the values returned by fresh.
+func checkSSZPropertiesWith[P sszObject](
+	t *testing.T,
+	gen *rapid.Generator[P],
+	fresh func() P,
+) {
+	t.Helper()
+	t.Run("RoundTrip", func(t *testing.T) {
+		rapid.Check(t, func(t *rapid.T) {
+			requireRoundTrip(t, gen.Draw(t, "obj"), fresh)
+		})
+	})
+	t.Run("HashTreeRoot", func(t *testing.T) {
+		rapid.Check(t, func(t *rapid.T) {
+			requireStableRoot(t, gen.Draw(t, "obj"))
+		})
+	})
+	t.Run("Mutation", func(t *testing.T) {
+		rapid.Check(t, func(t *rapid.T) {
+			bz, err := gen.Draw(t, "obj").MarshalSSZ()
+			require.NoError(t, err)
+			bz = mutate(t, bz)
+
+			decoded := fresh()
+			if err = decoded.UnmarshalSSZ(bz); err != nil {
+				return
+			}
+			reencoded, err := decoded.MarshalSSZ()
+			require.NoError(t, err)
+			require.Equal(t, bz, reencoded)
+		})
+	})
+}
+
+func requireRoundTrip[P sszObject](t *rapid.T, obj P, fresh func() P) {
+	bz, err := obj.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, bz, int(karalabessz.Size(obj)))
+
+	decoded := fresh()
+	require.NoError(t, decoded.UnmarshalSSZ(bz))
+	reencoded, err := decoded.MarshalSSZ()
+	require.NoError(t, err)
+	require.Equal(t, bz, reencoded)
+	require.Equal(t, obj.HashTreeRoot(), decoded.HashTreeRoot())
+}
+
+func requireStableRoot[P sszObject](t *rapid.T, obj P) {
+	root := obj.HashTreeRoot()
+	require.Equal(t, root, obj.HashTreeRoot())
+	require.Equal(t, root, common.Root(karalabessz.HashSequential(obj)))
+	require.Equal(t, root, common.Root(karalabessz.HashConcurrent(obj)))
+
+	if fobj, ok := any(obj).(fastSSZObject); ok {
+		hh := fastssz.NewHasher()
+		require.NoError(t, fobj.HashTreeRootWith(hh))
+		fastRoot, err := hh.HashRoot()
+		require.NoError(t, err)
+		require.Equal(t, root, common.Root(fastRoot))
+	}
+}
+
+// mutate flips, truncates or extends a valid encoding.
+func mutate(t *rapid.T, bz []byte) []byte {
+	out := append([]byte(nil), bz...)
+	switch rapid.IntRange(0, 2).Draw(t, "mutation") {
+	case 0:
+		if len(out) > 0 {
+			i := rapid.IntRange(0, len(out)-1).Draw(t, "flipIndex")
+			out[i] ^= rapid.ByteRange(1, 0xff).Draw(t, "flipMask")
+		}
+	case 1:
+		out = out[:rapid.IntRange(0, len(out)).Draw(t, "truncateTo")]
+	default:
+		out = append(out, rapid.SliceOfN(
+			rapid.Byte(), 1, 64).Draw(t, "extension")...)
+	}
+	return out
+}
+
+func TestExecutionPayloadHeaderRootProperty(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		payload := genExecutionPayload().Draw(t, "payload")
+		header, err := payload.ToHeader()
+		require.NoError(t, err)
+		require.Equal(t, payload.HashTreeRoot(), header.HashTreeRoot())
+	})
+}
+
+func TestBeaconBlockHeaderRootProperty(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		block := genBeaconBlock(version.DenebPlus).Draw(t, "block")
+		require.Equal(t, block.HashTreeRoot(), block.GetHeader().HashTreeRoot())
+	})
+}
+
+func TestDepositsRootProperty(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		n := drawLen(t, int(constants.MaxDepositsPerBlock), "deposits")
+		deposits := rapid.SliceOfN(genDeposit(), n, n).Draw(t, "deposits")
+
+		hh := fastssz.NewHasher()
+		indx := hh.Index()
+		for _, d := range deposits {
+			require.NoError(t, d.HashTreeRootWith(hh))
+		}
+		hh.MerkleizeWithMixin(
+			indx, uint64(len(deposits)), constants.MaxDepositsPerBlock,
+		)
+		want, err := hh.HashRoot()
+		require.NoError(t, err)
+		require.Equal(
+			t, common.Root(want), types.Deposits(deposits).HashTreeRoot(),
+		)
+	})
+}
+
+func TestValidatorsRootProperty(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		validators := rapid.SliceOfN(genValidator(), 0, 32).
+			Draw(t, "validators")
+
+		hh := fastssz.NewHasher()
+		indx := hh.Index()
+		for _, v := range validators {
+			require.NoError(t, v.HashTreeRootWith(hh))
+		}
+		hh.MerkleizeWithMixin(
+			indx, uint64(len(validators)), types.MaxValidators,
+		)
+		want, err := hh.HashRoot()
+		require.NoError(t, err)
+		require.Equal(
+			t, common.Root(want), types.Validators(validators).HashTreeRoot(),
+		)
+	})
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                 Generators                                 */
+/* -------------------------------------------------------------------------- */
+
+// drawBytes draws n bytes, biased towards the all-zero and all-ones
+// patterns alongside arbitrary content.
+func drawBytes(t *rapid.T, n int, label string) []byte {
+	out := make([]byte, n)
+	switch rapid.IntRange(0, 3).Draw(t, label+"Pattern") {
+	case 0:
+	case 1:
+		for i := range out {
+			out[i] = 0xff
+		}
+	default:
+		copy(out, rapid.SliceOfN(rapid.Byte(), n, n).Draw(t, label))
+	}
+	return out
+}
+
+// drawLen draws a list length in [0, maxLen], biased towards both bounds.
+func drawLen(t *rapid.T, maxLen int, label string) int {
+	switch rapid.IntRange(0, 3).Draw(t, label+"Bound") {
+	case 0:
+		return 0
+	case 1:
+		return maxLen
+	default:
+		return rapid.IntRange(0, maxLen).Draw(t, label+"Len")
+	}
+}
+
+// drawU64 draws a uint64, biased towards zero and the maximum value.
+func drawU64[T ~uint64](t *rapid.T, label string) T {
+	return T(rapid.OneOf(
+		rapid.Just(uint64(0)),
+		rapid.Just(^uint64(0)),
+		rapid.Uint64(),
+	).Draw(t, label))
+}
+
+func drawU256(t *rapid.T, label string) *math.U256 {
+	return new(math.U256).SetBytes(drawBytes(t, 32, label))
+}
+
+func genFork() *rapid.Generator[*types.Fork] {
+	return rapid.Custom(func(t *rapid.T) *types.Fork {
+		f := &types.Fork{Epoch: drawU64[math.Epoch](t, "epoch")}
+		copy(f.PreviousVersion[:], drawBytes(t, 4, "previousVersion"))
+		copy(f.CurrentVersion[:], drawBytes(t, 4, "currentVersion"))
+		return f
+	})
+}
+
+func genForkData() *rapid.Generator[*types.ForkData] {
+	return rapid.Custom(func(t *rapid.T) *types.ForkData {
+		fd := &types.ForkData{}
+		copy(fd.CurrentVersion[:], drawBytes(t, 4, "currentVersion"))
+		copy(fd.GenesisValidatorsRoot[:], drawBytes(t, 32, "root"))
+		return fd
+	})
+}
+
+func genSigningData() *rapid.Generator[*types.SigningData] {
+	return rapid.Custom(func(t *rapid.T) *types.SigningData {
+		sd := &types.SigningData{}
+		copy(sd.ObjectRoot[:], drawBytes(t, 32, "objectRoot"))
+		copy(sd.Domain[:], drawBytes(t, 32, "domain"))
+		return sd
+	})
+}
+
+func genSlashingInfo() *rapid.Generator[*types.SlashingInfo] {
+	return rapid.Custom(func(t *rapid.T) *types.SlashingInfo {
+		return &types.SlashingInfo{
+			Slot:  drawU64[math.Slot](t, "slot"),
+			Index: drawU64[math.U64](t, "index"),
+		}
+	})
+}
+
+func genAttestationData() *rapid.Generator[*types.AttestationData] {
+	return rapid.Custom(func(t *rapid.T) *types.AttestationData {
+		a := &types.AttestationData{
+			Slot:  drawU64[math.U64](t, "slot"),
+			Index: drawU64[math.U64](t, "index"),
+		}
+		copy(a.BeaconBlockRoot[:], drawBytes(t, 32, "beaconBlockRoot"))
+		return a
+	})
+}
+
+func genEth1Data() *rapid.Generator[*types.Eth1Data] {
+	return rapid.Custom(func(t *rapid.T) *types.Eth1Data {
+		e := &types.Eth1Data{
+			DepositCount: drawU64[math.U64](t, "depositCount"),
+		}
+		copy(e.DepositRoot[:], drawBytes(t, 32, "depositRoot"))
+		copy(e.BlockHash[:], drawBytes(t, 32, "blockHash"))
+		return e
+	})
+}
+
+func genBeaconBlockHeader() *rapid.Generator[*types.BeaconBlockHeader] {
+	return rapid.Custom(func(t *rapid.T) *types.BeaconBlockHeader {
+		h := &types.BeaconBlockHeader{
+			Slot:          drawU64[math.Slot](t, "slot"),
+			ProposerIndex: drawU64[math.ValidatorIndex](t, "proposerIndex"),
+		}
+		copy(h.ParentBlockRoot[:], drawBytes(t, 32, "parentBlockRoot"))
+		copy(h.StateRoot[:], drawBytes(t, 32, "stateRoot"))
+		copy(h.BodyRoot[:], drawBytes(t, 32, "bodyRoot"))
+		return h
+	})
+}
+
+func genDeposit() *rapid.Generator[*types.Deposit] {
+	return rapid.Custom(func(t *rapid.T) *types.Deposit {
+		d := &types.Deposit{
+			Amount: drawU64[math.Gwei](t, "amount"),
+			Index:  drawU64[uint64](t, "index"),
+		}
+		copy(d.Pubkey[:], drawBytes(t, 48, "pubkey"))
+		copy(d.Credentials[:], drawBytes(t, 32, "credentials"))
+		copy(d.Signature[:], drawBytes(t, 96, "signature"))
+		return d
+	})
+}
+
+func genDepositMessage() *rapid.Generator[*types.DepositMessage] {
+	return rapid.Custom(func(t *rapid.T) *types.DepositMessage {
+		dm := &types.DepositMessage{Amount: drawU64[math.Gwei](t, "amount")}
+		copy(dm.Pubkey[:], drawBytes(t, 48, "pubkey"))
+		copy(dm.Credentials[:], drawBytes(t, 32, "credentials"))
+		return dm
+	})
+}
+
+func genValidator() *rapid.Generator[*types.Validator] {
+	return rapid.Custom(func(t *rapid.T) *types.Validator {
+		v := &types.Validator{
+			EffectiveBalance: drawU64[math.Gwei](t, "effectiveBalance"),
+			Slashed:          rapid.Bool().Draw(t, "slashed"),
+			ActivationEligibilityEpoch: drawU64[math.Epoch](
+				t, "activationEligibilityEpoch",
+			),
+			ActivationEpoch:   drawU64[math.Epoch](t, "activationEpoch"),
+			ExitEpoch:         drawU64[math.Epoch](t, "exitEpoch"),
+			WithdrawableEpoch: drawU64[math.Epoch](t, "withdrawableEpoch"),
+		}
+		copy(v.Pubkey[:], drawBytes(t, 48, "pubkey"))
+		copy(v.WithdrawalCredentials[:], drawBytes(t, 32, "credentials"))
+		return v
+	})
+}
+
+func genWithdrawal() *rapid.Generator[*engineprimitives.Withdrawal] {
+	return rapid.Custom(func(t *rapid.T) *engineprimitives.Withdrawal {
+		w := &engineprimitives.Withdrawal{
+			Index:     drawU64[math.U64](t, "index"),
+			Validator: drawU64[math.ValidatorIndex](t, "validator"),
+			Amount:    drawU64[math.Gwei](t, "amount"),
+		}
+		copy(w.Address[:], drawBytes(t, 20, "address"))
+		return w
+	})
+}
+
+// genTransactions keeps the list and each transaction small, as the real
+// limits are far beyond what a test budget can reach.
+func genTransactions() *rapid.Generator[engineprimitives.Transactions] {
+	return rapid.Custom(func(t *rapid.T) engineprimitives.Transactions {
+		txs := make(engineprimitives.Transactions, drawLen(t, 8, "txs"))
+		for i := range txs {
+			txs[i] = drawBytes(t, drawLen(t, 64, "txLen"), "tx")
+		}
+		return txs
+	})
+}
+
+func genExecutionPayload() *rapid.Generator[*types.ExecutionPayload] {
+	return rapid.Custom(func(t *rapid.T) *types.ExecutionPayload {
+		p := &types.ExecutionPayload{
+			Number:        drawU64[math.U64](t, "number"),
+			GasLimit:      drawU64[math.U64](t, "gasLimit"),
+			GasUsed:       drawU64[math.U64](t, "gasUsed"),
+			Timestamp:     drawU64[math.U64](t, "timestamp"),
+			ExtraData:     drawBytes(t, drawLen(t, 32, "extra"), "extraData"),
+			BaseFeePerGas: drawU256(t, "baseFeePerGas"),
+			Transactions:  genTransactions().Draw(t, "transactions"),
+			Withdrawals: rapid.SliceOfN(
+				genWithdrawal(), 0, int(constants.MaxWithdrawalsPerPayload),
+			).Draw(t, "withdrawals"),
+			BlobGasUsed:   drawU64[math.U64](t, "blobGasUsed"),
+			ExcessBlobGas: drawU64[math.U64](t, "excessBlobGas"),
+		}
+		copy(p.ParentHash[:], drawBytes(t, 32, "parentHash"))
+		copy(p.FeeRecipient[:], drawBytes(t, 20, "feeRecipient"))
+		copy(p.StateRoot[:], drawBytes(t, 32, "stateRoot"))
+		copy(p.ReceiptsRoot[:], drawBytes(t, 32, "receiptsRoot"))
+		copy(p.LogsBloom[:], drawBytes(t, 256, "logsBloom"))
+		copy(p.Random[:], drawBytes(t, 32, "random"))
+		copy(p.BlockHash[:], drawBytes(t, 32, "blockHash"))
+		return p
+	})
+}
+
+func genPayloadHeader() *rapid.Generator[*types.ExecutionPayloadHeader] {
+	return rapid.Custom(func(t *rapid.T) *types.ExecutionPayloadHeader {
+		h := &types.ExecutionPayloadHeader{
+			Number:        drawU64[math.U64](t, "number"),
+			GasLimit:      drawU64[math.U64](t, "gasLimit"),
+			GasUsed:       drawU64[math.U64](t, "gasUsed"),
+			Timestamp:     drawU64[math.U64](t, "timestamp"),
+			ExtraData:     drawBytes(t, drawLen(t, 32, "extra"), "extraData"),
+			BaseFeePerGas: drawU256(t, "baseFeePerGas"),
+			BlobGasUsed:   drawU64[math.U64](t, "blobGasUsed"),
+			ExcessBlobGas: drawU64[math.U64](t, "excessBlobGas"),
+		}
+		copy(h.ParentHash[:], drawBytes(t, 32, "parentHash"))
+		copy(h.FeeRecipient[:], drawBytes(t, 20, "feeRecipient"))
+		copy(h.StateRoot[:], drawBytes(t, 32, "stateRoot"))
+		copy(h.ReceiptsRoot[:], drawBytes(t, 32, "receiptsRoot"))
+		copy(h.LogsBloom[:], drawBytes(t, 256, "logsBloom"))
+		copy(h.Random[:], drawBytes(t, 32, "random"))
+		copy(h.BlockHash[:], drawBytes(t, 32, "blockHash"))
+		copy(h.TransactionsRoot[:], drawBytes(t, 32, "transactionsRoot"))
+		copy(h.WithdrawalsRoot[:], drawBytes(t, 32, "withdrawalsRoot"))
+		return h
+	})
+}
+
+// emptyBody returns an empty body in the layout of the given fork version.
+func emptyBody(forkVersion uint32) *types.BeaconBlockBody {
+	return (&types.BeaconBlockBody{}).Empty(forkVersion)
+}
+
+func genBeaconBlockBody(
+	forkVersion uint32,
+) *rapid.Generator[*types.BeaconBlockBody] {
+	return rapid.Custom(func(t *rapid.T) *types.BeaconBlockBody {
+		maxDeposits := int(constants.MaxDepositsPerBlock)
+		n := drawLen(t, maxDeposits, "deposits")
+		b := emptyBody(forkVersion)
+		b.Eth1Data = genEth1Data().Draw(t, "eth1Data")
+		b.Deposits = rapid.SliceOfN(genDeposit(), n, n).Draw(t, "deposits")
+		b.ExecutionPayload = genExecutionPayload().Draw(t, "payload")
+		copy(b.RandaoReveal[:], drawBytes(t, 96, "randaoReveal"))
+		copy(b.Graffiti[:], drawBytes(t, 32, "graffiti"))
+
+		b.BlobKzgCommitments = make(
+			[]eip4844.KZGCommitment, drawLen(t, 16, "commitments"),
+		)
+		for i := range b.BlobKzgCommitments {
+			copy(b.BlobKzgCommitments[i][:], drawBytes(t, 48, "commitment"))
+		}
+		if forkVersion >= version.DenebPlus {
+			n = drawLen(
+				t, int(constants.MaxOperationsPerBlock), "operations",
+			)
+			b.Operations = rapid.SliceOfN(genOperation(), n, n).
+				Draw(t, "operations")
+		}
+		return b
+	})
+}
+
+func genOperation() *rapid.Generator[*transition.Operation] {
+	return rapid.Custom(func(t *rapid.T) *transition.Operation {
+		n := drawLen(t, int(constants.MaxBytesPerOperation), "data")
+		return &transition.Operation{
+			TypeID: transition.OperationTypeID(
+				rapid.Uint16().Draw(t, "typeID"),
+			),
+			Data: drawBytes(t, n, "data"),
+		}
+	})
+}
+
+func genBeaconBlock(
+	forkVersion uint32,
+) *rapid.Generator[*types.BeaconBlock] {
+	return rapid.Custom(func(t *rapid.T) *types.BeaconBlock {
+		b := &types.BeaconBlock{
+			Slot:          drawU64[math.Slot](t, "slot"),
+			ProposerIndex: drawU64[math.ValidatorIndex](t, "proposerIndex"),
+			Body:          genBeaconBlockBody(forkVersion).Draw(t, "body"),
+		}
+		copy(b.ParentRoot[:], drawBytes(t, 32, "parentRoot"))
+		copy(b.StateRoot[:], drawBytes(t, 32, "stateRoot"))
+		return b
+	})
+}
+
+type beaconState = types.BeaconState[
+	*types.BeaconBlockHeader,
+	*types.Eth1Data,
+	*types.ExecutionPayloadHeader,
+	*types.Fork,
+	*types.Validator,
+	types.BeaconBlockHeader,
+	types.Eth1Data,
+	types.ExecutionPayloadHeader,
+	types.Fork,
+	types.Validator,
+]
+
+// genBeaconState draws states with short lists; the registry and history
+// limits are too large to reach within a test budget.
+func genBeaconState() *rapid.Generator[*beaconState] {
+	roots := func(t *rapid.T, label string) []common.Root {
+		out := make([]common.Root, drawLen(t, 8, label))
+		for i := range out {
+			copy(out[i][:], drawBytes(t, 32, label+"Elem"))
+		}
+		return out
+	}
+	u64s := func(t *rapid.T, label string) []uint64 {
+		return rapid.SliceOfN(rapid.Uint64(), 0, 8).Draw(t, label)
+	}
+	return rapid.Custom(func(t *rapid.T) *beaconState {
+		st := &beaconState{
+			Slot:              drawU64[math.Slot](t, "slot"),
+			Fork:              genFork().Draw(t, "fork"),
+			LatestBlockHeader: genBeaconBlockHeader().Draw(t, "header"),
+			BlockRoots:        roots(t, "blockRoots"),
+			StateRoots:        roots(t, "stateRoots"),
+			Eth1Data:          genEth1Data().Draw(t, "eth1Data"),
+			Eth1DepositIndex:  drawU64[uint64](t, "eth1DepositIndex"),
+			LatestExecutionPayloadHeader: genPayloadHeader().
+				Draw(t, "payloadHeader"),
+			Validators: rapid.SliceOfN(genValidator(), 0, 8).
+				Draw(t, "validators"),
+			Balances:            u64s(t, "balances"),
+			NextWithdrawalIndex: drawU64[uint64](t, "nextWithdrawalIndex"),
+			NextWithdrawalValidatorIndex: drawU64[math.ValidatorIndex](
+				t, "nextWithdrawalValidatorIndex",
+			),
+			TotalSlashing: drawU64[math.Gwei](t, "totalSlashing"),
+		}
+		copy(st.GenesisValidatorsRoot[:], drawBytes(t, 32, "genesisRoot"))
+		for _, mix := range roots(t, "randaoMixes") {
+			st.RandaoMixes = append(st.RandaoMixes, common.Bytes32(mix))
+		}
+		for _, s := range u64s(t, "slashings") {
+			st.Slashings = append(st.Slashings, math.Gwei(s))
+		}
+		return st
+	})
+}
@@ -0,0 +1,169 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"math/bits"
+	"reflect"
+
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/merkle"
+	fastssz "github.com/ferranbt/fastssz"
+	"github.com/karalabe/ssz"
+)
+
+// StateHashConfig is the configuration for the merkleization of the
+// BeaconState.
+type StateHashConfig struct {
+	// Workers is the number of workers used to merkleize the validator
+	// registry. Zero uses one worker per available CPU.
+	Workers int `mapstructure:"workers"`
+}
+
+// DefaultStateHashConfig returns the default state hashing configuration.
+func DefaultStateHashConfig() StateHashConfig {
+	return StateHashConfig{
+		Workers: 0,
+	}
+}
+
+// BeaconStateFieldNames returns the names of the BeaconState fields, in the
+// order they are merkleized.
+func BeaconStateFieldNames() []string {
+	t := reflect.TypeFor[BeaconState[
+		*BeaconBlockHeader, *Eth1Data, *ExecutionPayloadHeader, *Fork,
+		*Validator, BeaconBlockHeader, Eth1Data, ExecutionPayloadHeader,
+		Fork, Validator,
+	]]()
+	names := make([]string, t.NumField())
+	for i := range names {
+		names[i] = t.Field(i).Name
+	}
+	return names
+}
+
+// FieldRoots returns the hash tree roots of the BeaconState fields, in the
+// order of BeaconStateFieldNames.
+func (st *BeaconState[
+	_, _, _, _, _, _, _, _, _, _,
+]) FieldRoots() ([]common.Root, error) {
+	tree, err := st.GetTree()
+	if err != nil {
+		return nil, err
+	}
+	// The fields are the leftmost nodes of the first layer of the tree
+	// wide enough to hold all of them.
+	roots := make([]common.Root, reflect.TypeOf(st).Elem().NumField())
+	first := 1 << bits.Len(uint(len(roots)-1))
+	for i := range roots {
+		node, err := tree.Get(first + i)
+		if err != nil {
+			return nil, err
+		}
+		roots[i] = common.Root(node.Hash())
+	}
+	return roots, nil
+}
+
+// ParallelHashTreeRoot computes the Merkleization of the BeaconState like
+// HashTreeRoot, hashing the validator registry and balances across the
+// workers of the given hasher.
+func (st *BeaconState[
+	BeaconBlockHeaderT,
+	Eth1DataT,
+	ExecutionPayloadHeaderT,
+	ForkT,
+	ValidatorT,
+	B, E, P, F, V,
+]) ParallelHashTreeRoot(p *merkle.ParallelHasher) (common.Root, error) {
+	// The validators are hashed up front, the generated code then hashes
+	// the rest of the state with their roots in place of the registry.
+	leaves := p.Leaves(len(st.Validators), func(i int) [32]byte {
+		return ssz.HashSequential(st.Validators[i])
+	})
+	var (
+		registryLeaves = make([]registryLeaf, len(leaves))
+		registry       = make([]*registryLeaf, len(leaves))
+	)
+	for i := range leaves {
+		registryLeaves[i].root = leaves[i]
+		registry[i] = &registryLeaves[i]
+	}
+	hashed := &BeaconState[
+		BeaconBlockHeaderT,
+		Eth1DataT,
+		ExecutionPayloadHeaderT,
+		ForkT,
+		*registryLeaf,
+		B, E, P, F, registryLeaf,
+	]{
+		GenesisValidatorsRoot:        st.GenesisValidatorsRoot,
+		Slot:                         st.Slot,
+		Fork:                         st.Fork,
+		LatestBlockHeader:            st.LatestBlockHeader,
+		BlockRoots:                   st.BlockRoots,
+		StateRoots:                   st.StateRoots,
+		Eth1Data:                     st.Eth1Data,
+		Eth1DepositIndex:             st.Eth1DepositIndex,
+		LatestExecutionPayloadHeader: st.LatestExecutionPayloadHeader,
+		Validators:                   registry,
+		Balances:                     st.Balances,
+		RandaoMixes:                  st.RandaoMixes,
+		NextWithdrawalIndex:          st.NextWithdrawalIndex,
+		NextWithdrawalValidatorIndex: st.NextWithdrawalValidatorIndex,
+		Slashings:                    st.Slashings,
+		TotalSlashing:                st.TotalSlashing,
+	}
+
+	// Every layer of the large lists is hashed across the workers.
+	hh := fastssz.NewHasherWithHashFn(p.Hash)
+	if err := hashed.HashTreeRootWith(hh); err != nil {
+		return common.Root{}, err
+	}
+	return hh.HashRoot()
+}
+
+// registryLeaf stands for a validator whose hash tree root was already
+// computed.
+type registryLeaf struct {
+	root common.Root
+}
+
+// Empty returns an empty registryLeaf.
+func (*registryLeaf) Empty() *registryLeaf {
+	return &registryLeaf{}
+}
+
+// SizeSSZ returns the size of the root.
+func (*registryLeaf) SizeSSZ(*ssz.Sizer) uint32 {
+	return uint32(len(common.Root{}))
+}
+
+// DefineSSZ defines the SSZ encoding of the root.
+func (l *registryLeaf) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineStaticBytes(codec, &l.root)
+}
+
+// HashTreeRootWith appends the root of the validator to the hasher.
+func (l *registryLeaf) HashTreeRootWith(hh fastssz.HashWalker) error {
+	hh.Append(l.root[:])
+	return nil
+}
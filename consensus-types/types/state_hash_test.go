@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/merkle"
+	karalabessz "github.com/karalabe/ssz"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBeaconStateHashTreeRootDeterminism(t *testing.T) {
+	for _, numValidators := range []int{0, 1, 3, 5000, 20001} {
+		vals, balances := newRegistryValidators(numValidators)
+		st := generateValidBeaconState()
+		st.Validators = vals
+		st.Balances = balances
+		st.Slashings = make([]math.Gwei, numValidators%7)
+		st.BlockRoots = make([]common.Root, numValidators%5)
+
+		expected := karalabessz.HashSequential(st)
+		for _, workers := range []int{1, 2, 3, 8} {
+			t.Run(
+				fmt.Sprintf("%d validators/%d workers", numValidators, workers),
+				func(t *testing.T) {
+					root, err := st.ParallelHashTreeRoot(
+						merkle.NewParallelHasher(workers),
+					)
+					require.NoError(t, err)
+					require.Equal(t, common.Root(expected), root)
+				},
+			)
+		}
+	}
+}
+
+func TestBeaconStateParallelHashTreeRootListTooBig(t *testing.T) {
+	st := generateValidBeaconState()
+	st.BlockRoots = make([]common.Root, 8193)
+	_, err := st.ParallelHashTreeRoot(merkle.NewParallelHasher(1))
+	require.Error(t, err)
+}
+
+func TestBeaconStateFieldRoots(t *testing.T) {
+	st := generateValidBeaconState()
+	roots, err := st.FieldRoots()
+	require.NoError(t, err)
+	require.Len(t, roots, len(types.BeaconStateFieldNames()))
+
+	// Each root is the hash tree root of the named field.
+	require.Equal(t, st.GenesisValidatorsRoot, roots[0])
+	require.Equal(t, common.Root(st.Fork.HashTreeRoot()), roots[2])
+	require.Equal(
+		t, common.Root(karalabessz.HashSequential(st.Eth1Data)), roots[6],
+	)
+
+	st.Balances[0]++
+	changed, err := st.FieldRoots()
+	require.NoError(t, err)
+	for i, name := range types.BeaconStateFieldNames() {
+		if name == "Balances" {
+			require.NotEqual(t, roots[i], changed[i])
+		} else {
+			require.Equal(t, roots[i], changed[i], name)
+		}
+	}
+}
+
+// Benchmark the hash tree root of a BeaconState with 1M validators. The
+// registry is merkleized across one worker per CPU.
+func BenchmarkBeaconStateHashTreeRoot(b *testing.B) {
+	const numValidators = 1_000_000
+	vals, balances := newRegistryValidators(numValidators)
+	st := generateValidBeaconState()
+	st.Validators = vals
+	st.Balances = balances
+	p := merkle.NewParallelHasher(0)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for range b.N {
+		_, _ = st.ParallelHashTreeRoot(p)
+	}
+}
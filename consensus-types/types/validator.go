@@ -131,6 +131,15 @@ func (v *Validator) New(
 	)
 }
 
+// Copy returns a copy of the Validator.
+func (v *Validator) Copy() *Validator {
+	if v == nil {
+		return nil
+	}
+	val := *v
+	return &val
+}
+
 /* -------------------------------------------------------------------------- */
 /*                                     SSZ                                    */
 /* -------------------------------------------------------------------------- */
@@ -330,6 +339,22 @@ func (v *Validator) SetWithdrawableEpoch(e math.Epoch) {
 	v.WithdrawableEpoch = e
 }
 
+// GetActivationEligibilityEpoch returns the epoch when the validator became
+// eligible for activation.
+func (v Validator) GetActivationEligibilityEpoch() math.Epoch {
+	return v.ActivationEligibilityEpoch
+}
+
+// GetActivationEpoch returns the epoch when the validator is activated.
+func (v Validator) GetActivationEpoch() math.Epoch {
+	return v.ActivationEpoch
+}
+
+// GetExitEpoch returns the epoch when the validator exits.
+func (v Validator) GetExitEpoch() math.Epoch {
+	return v.ExitEpoch
+}
+
 // GetWithdrawableEpoch returns the epoch when the validator can withdraw.
 func (v Validator) GetWithdrawableEpoch() math.Epoch {
 	return v.WithdrawableEpoch
@@ -339,3 +364,10 @@ func (v Validator) GetWithdrawableEpoch() math.Epoch {
 func (v Validator) GetWithdrawalCredentials() WithdrawalCredentials {
 	return v.WithdrawalCredentials
 }
+
+// GetWithdrawalAddress returns the execution address the withdrawals of the
+// validator are paid to. It errors if the validator does not have eth1
+// withdrawal credentials.
+func (v Validator) GetWithdrawalAddress() (common.ExecutionAddress, error) {
+	return v.WithdrawalCredentials.ToExecutionAddress()
+}
@@ -66,6 +66,7 @@ func Commands[
 		ShowValidatorCmd(),
 		ShowAddressCmd(),
 		VersionCmd(),
+		StatusCommand(),
 		cmtcmd.ResetAllCmd,
 		cmtcmd.ResetStateCmd,
 		BootstrapStateCmd[T](appCreator),
@@ -30,6 +30,7 @@ import (
 
 	"cosmossdk.io/store/rootmulti"
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/consensus/cometbft/service/budget"
 	servercmtlog "github.com/berachain/beacon-kit/consensus/cometbft/service/log"
 	"github.com/berachain/beacon-kit/consensus/types"
 	errorsmod "github.com/berachain/beacon-kit/errors"
@@ -45,6 +46,10 @@ import (
 var (
 	errInvalidHeight         = errors.New("invalid height")
 	errNilFinalizeBlockState = errors.New("finalizeBlockState is nil")
+	errUnrecoverableState    = errors.New(
+		"application state cannot be recovered, restore it from a snapshot",
+	)
+	errUnexpectedTxs = errors.New("unexpected transactions in proposal")
 )
 
 //nolint:gocognit // this is fine.
@@ -134,11 +139,25 @@ func (s *Service[LoggerT]) InitChain(
 		}
 	}
 
+	// The consensus params are derived from the chain spec, overriding the
+	// ones of the genesis file if they disagree.
+	consensusParams, err := s.paramStore.Get(s.initialHeight)
+	if err != nil {
+		return nil, err
+	}
+	if req.ConsensusParams != nil && !req.ConsensusParams.Equal(
+		consensusParams,
+	) {
+		s.logger.Warn(
+			"Genesis consensus params differ from the chain spec; overriding",
+		)
+	}
+
 	// NOTE: We don't commit, but FinalizeBlock for block InitialHeight starts
 	// from
 	// this FinalizeBlockState.
 	return &cmtabci.InitChainResponse{
-		ConsensusParams: req.ConsensusParams,
+		ConsensusParams: consensusParams,
 		Validators:      resValidators,
 		AppHash:         s.sm.CommitMultiStore().LastCommitID().Hash,
 	}, nil
@@ -193,7 +212,7 @@ func (s *Service[LoggerT]) Info(
 // PrepareProposal implements the PrepareProposal ABCI method and returns a
 // ResponsePrepareProposal object to the client.
 func (s *Service[LoggerT]) PrepareProposal(
-	_ context.Context,
+	ctx context.Context,
 	req *cmtabci.PrepareProposalRequest,
 ) (*cmtabci.PrepareProposalResponse, error) {
 	// CometBFT must never call PrepareProposal with a height of 0.
@@ -215,6 +234,23 @@ func (s *Service[LoggerT]) PrepareProposal(
 		),
 	)
 
+	commitCtx, commitBz, err := s.prepareExtendedCommit(
+		s.prepareProposalState.Context(),
+		req,
+	)
+	if err != nil {
+		// The proposal remains valid without the extended commit.
+		s.logger.Error(
+			"failed to prepare extended commit",
+			"height",
+			req.Height,
+			"err",
+			err,
+		)
+	} else {
+		s.prepareProposalState.SetContext(commitCtx)
+	}
+
 	var slotData *types.SlotData[
 		*ctypes.AttestationData,
 		*ctypes.SlashingInfo,
@@ -226,8 +262,22 @@ func (s *Service[LoggerT]) PrepareProposal(
 		req.GetProposerAddress(),
 		req.GetTime(),
 	)
+	maxBytes := budget.ProposalMaxBytes(req.MaxTxBytes, commitBz)
+	if maxBytes == 0 && commitBz != nil {
+		// The extended commit leaves no room for the beacon block.
+		commitBz = nil
+		maxBytes = budget.ProposalMaxBytes(req.MaxTxBytes)
+	}
+	slotData.SetMaxBytes(maxBytes)
+
+	// The proposal must be built before the validators give up on it.
+	proposalCtx, cancel := withDeadline(
+		s.prepareProposalState.Context(), ctx,
+		s.cmtCfg.Consensus.TimeoutPropose,
+	)
+	defer cancel()
 	blkBz, sidecarsBz, err := s.Middleware.PrepareProposal(
-		s.prepareProposalState.Context(),
+		proposalCtx,
 		slotData,
 	)
 	if err != nil {
@@ -243,15 +293,29 @@ func (s *Service[LoggerT]) PrepareProposal(
 		return &cmtabci.PrepareProposalResponse{Txs: req.Txs}, nil
 	}
 
-	return &cmtabci.PrepareProposalResponse{
-		Txs: [][]byte{blkBz, sidecarsBz},
-	}, nil
+	txs := [][]byte{blkBz, sidecarsBz}
+	if commitBz != nil {
+		txs = append(txs, commitBz)
+	}
+	if size := budget.TxsSize(txs); size > req.MaxTxBytes {
+		s.logger.Error(
+			"prepared proposal exceeds max tx bytes",
+			"height",
+			req.Height,
+			"size",
+			size,
+			"max_tx_bytes",
+			req.MaxTxBytes,
+		)
+		return &cmtabci.PrepareProposalResponse{Txs: req.Txs}, nil
+	}
+	return &cmtabci.PrepareProposalResponse{Txs: txs}, nil
 }
 
 // ProcessProposal implements the ProcessProposal ABCI method and returns a
 // ResponseProcessProposal object to the client.
 func (s *Service[LoggerT]) ProcessProposal(
-	_ context.Context,
+	ctx context.Context,
 	req *cmtabci.ProcessProposalRequest,
 ) (*cmtabci.ProcessProposalResponse, error) {
 	// CometBFT must never call ProcessProposal with a height of 0.
@@ -263,6 +327,21 @@ func (s *Service[LoggerT]) ProcessProposal(
 		)
 	}
 
+	// A block accepted in an earlier round of this height is accepted again
+	// without being verified a second time.
+	if s.acceptedProposals.contains(req.Height, req.Hash) {
+		s.logger.Info(
+			"Proposal already accepted in an earlier round",
+			"height",
+			req.Height,
+			"hash",
+			fmt.Sprintf("%X", req.Hash),
+		)
+		return &cmtabci.ProcessProposalResponse{
+			Status: cmtabci.PROCESS_PROPOSAL_STATUS_ACCEPT,
+		}, nil
+	}
+
 	// Since the application can get access to FinalizeBlock state and write to
 	// it, we must be sure to reset it in case ProcessProposal timeouts and is
 	// called
@@ -282,10 +361,36 @@ func (s *Service[LoggerT]) ProcessProposal(
 		),
 	)
 
-	resp, err := s.Middleware.ProcessProposal(
+	commitCtx, err := s.processExtendedCommit(
 		s.processProposalState.Context(),
 		req,
 	)
+	if err != nil {
+		s.logger.Error(
+			"rejecting proposal with invalid extended commit",
+			"height",
+			req.Height,
+			"hash",
+			fmt.Sprintf("%X", req.Hash),
+			"err",
+			err,
+		)
+		return &cmtabci.ProcessProposalResponse{
+			Status: cmtabci.PROCESS_PROPOSAL_STATUS_REJECT,
+		}, nil
+	}
+	s.processProposalState.SetContext(commitCtx)
+
+	// The proposal must be verified before the round moves on without it.
+	proposalCtx, cancel := withDeadline(
+		s.processProposalState.Context(), ctx,
+		s.cmtCfg.Consensus.TimeoutPropose,
+	)
+	defer cancel()
+	resp, err := s.Middleware.ProcessProposal(
+		proposalCtx,
+		req,
+	)
 	if err != nil {
 		s.logger.Error(
 			"failed to process proposal",
@@ -303,15 +408,25 @@ func (s *Service[LoggerT]) ProcessProposal(
 		}, nil
 	}
 
+	if resp.Status == cmtabci.PROCESS_PROPOSAL_STATUS_ACCEPT {
+		s.acceptedProposals.add(req.Height, req.Hash)
+	}
 	return resp, nil
 }
 
 func (s *Service[LoggerT]) internalFinalizeBlock(
+	ctx context.Context,
 	req *cmtabci.FinalizeBlockRequest,
 ) (*cmtabci.FinalizeBlockResponse, error) {
 	if err := s.validateFinalizeBlockHeight(req); err != nil {
 		return nil, err
 	}
+	if s.halt != nil {
+		//#nosec:G701 // validated above to be positive.
+		if err := s.halt.CheckFinalize(uint64(req.Height)); err != nil {
+			return nil, err
+		}
+	}
 
 	// finalizeBlockState should be set on InitChain or ProcessProposal. If it
 	// is nil, it means we are replaying this block and we need to set the state
@@ -338,8 +453,14 @@ func (s *Service[LoggerT]) internalFinalizeBlock(
 		}
 	}
 
+	// The block must be finalized before the next height starts.
+	finalizeCtx, cancel := withDeadline(
+		s.finalizeBlockState.Context(), ctx,
+		s.cmtCfg.Consensus.TimeoutCommit,
+	)
+	defer cancel()
 	finalizeBlock, err := s.Middleware.FinalizeBlock(
-		s.finalizeBlockState.Context(),
+		finalizeCtx,
 		req,
 	)
 	if err != nil {
@@ -354,10 +475,17 @@ func (s *Service[LoggerT]) internalFinalizeBlock(
 		return nil, err
 	}
 
+	// Emit the params of the next height, so that CometBFT picks up any
+	// change of the chain spec at fork heights.
+	consensusParams, err := s.paramStore.Get(req.Height + 1)
+	if err != nil {
+		return nil, err
+	}
+
 	return &cmtabci.FinalizeBlockResponse{
 		TxResults:             txResults,
 		ValidatorUpdates:      valUpdates,
-		ConsensusParamUpdates: s.paramStore.Get(),
+		ConsensusParamUpdates: consensusParams,
 	}, nil
 }
 
@@ -402,10 +530,10 @@ func (s *Service[_]) validateFinalizeBlockHeight(
 }
 
 func (s *Service[_]) FinalizeBlock(
-	_ context.Context,
+	ctx context.Context,
 	req *cmtabci.FinalizeBlockRequest,
 ) (*cmtabci.FinalizeBlockResponse, error) {
-	res, err := s.internalFinalizeBlock(req)
+	res, err := s.internalFinalizeBlock(ctx, req)
 	if res != nil {
 		res.AppHash = s.workingHash()
 	}
@@ -416,10 +544,9 @@ func (s *Service[_]) FinalizeBlock(
 // Commit implements the ABCI interface. It will commit all state that exists in
 // the deliver state's multi-store and includes the resulting commit ID in the
 // returned cmtabci.ResponseCommit. Commit will set the check state based on the
-// latest header and reset the deliver state. Also, if a non-zero halt height is
-// defined in config, Commit will execute a deferred function call to check
-// against that height and gracefully halt if it matches the latest committed
-// height.
+// latest header and reset the deliver state. Also, if the halt controller is
+// armed, Commit gracefully shuts the node down once the halt height is
+// committed.
 func (s *Service[LoggerT]) Commit(
 	context.Context, *cmtabci.CommitRequest,
 ) (*cmtabci.CommitResponse, error) {
@@ -439,6 +566,16 @@ func (s *Service[LoggerT]) Commit(
 
 	s.finalizeBlockState = nil
 
+	// The snapshot is taken asynchronously, from the state just committed.
+	if s.snapshotManager != nil {
+		s.snapshotManager.SnapshotIfApplicable(header.Height)
+	}
+
+	//#nosec:G701 // block heights are never negative.
+	if s.halt != nil && s.halt.Committed(uint64(header.Height)) {
+		s.shutdown()
+	}
+
 	return &cmtabci.CommitResponse{
 		RetainHeight: retainHeight,
 	}, nil
@@ -612,11 +749,26 @@ func (s *Service[_]) GetBlockRetentionHeight(commitHeight int64) int64 {
 	if s.finalizeBlockState == nil {
 		return 0
 	}
-	cp := s.paramStore.Get()
+	cp, err := s.paramStore.Get(commitHeight)
+	if err != nil {
+		return 0
+	}
 	if cp.Evidence != nil && cp.Evidence.MaxAgeNumBlocks > 0 {
 		retentionHeight = commitHeight - cp.Evidence.MaxAgeNumBlocks
 	}
 
+	// Blocks since the oldest available snapshot must be kept, so that
+	// nodes restoring it can replay up to the tip.
+	if s.snapshotManager != nil {
+		snapshotRetentionHeights := s.snapshotManager.
+			GetSnapshotBlockRetentionHeights()
+		if snapshotRetentionHeights > 0 {
+			retentionHeight = minNonZero(
+				retentionHeight, commitHeight-snapshotRetentionHeights,
+			)
+		}
+	}
+
 	//#nosec:G701 // bet.
 	v := commitHeight - int64(s.minRetainBlocks)
 	retentionHeight = minNonZero(retentionHeight, v)
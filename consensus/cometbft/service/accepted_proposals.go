@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+// acceptedProposals remembers the proposals accepted at the current height.
+// CometBFT may present the same block again in a later round of a height,
+// e.g. when the proposer re-proposes its valid block, and verifying it again
+// would yield the same result. Only acceptances are remembered, since a
+// rejection may stem from a transient failure such as an unavailable
+// execution client.
+type acceptedProposals struct {
+	height int64
+	hashes map[string]struct{}
+}
+
+// contains returns whether the block with the given hash was accepted at
+// the given height.
+func (a *acceptedProposals) contains(height int64, hash []byte) bool {
+	if a.height != height {
+		return false
+	}
+	_, ok := a.hashes[string(hash)]
+	return ok
+}
+
+// add records that the block with the given hash was accepted at the given
+// height, forgetting the blocks accepted at earlier heights.
+func (a *acceptedProposals) add(height int64, hash []byte) {
+	if a.height != height || a.hashes == nil {
+		a.height = height
+		a.hashes = make(map[string]struct{})
+	}
+	a.hashes[string(hash)] = struct{}{}
+}
@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft_test
+
+import (
+	"testing"
+
+	cometbft "github.com/berachain/beacon-kit/consensus/cometbft/service"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAcceptedProposals(t *testing.T) {
+	var (
+		accepted cometbft.AcceptedProposals
+		first    = []byte("first")
+		second   = []byte("second")
+	)
+	require.False(t, accepted.Contains(5, first))
+
+	// A block accepted in a round is known in the later rounds of the
+	// height, along with the other blocks accepted at the height.
+	accepted.Add(5, first)
+	accepted.Add(5, second)
+	require.True(t, accepted.Contains(5, first))
+	require.True(t, accepted.Contains(5, second))
+	require.False(t, accepted.Contains(5, []byte("unknown")))
+	require.False(t, accepted.Contains(4, first))
+	require.False(t, accepted.Contains(6, first))
+
+	// Accepting a block at the next height forgets the earlier ones.
+	accepted.Add(6, second)
+	require.False(t, accepted.Contains(5, first))
+	require.False(t, accepted.Contains(5, second))
+	require.True(t, accepted.Contains(6, second))
+	require.False(t, accepted.Contains(6, first))
+}
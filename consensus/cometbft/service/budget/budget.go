@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package budget
+
+import "github.com/berachain/beacon-kit/consensus/cometbft/service/encoding"
+
+// numBeaconTxs is the number of transactions carrying the beacon block and
+// its blob sidecars in every proposal.
+const numBeaconTxs = 2
+
+// ProposalMaxBytes returns the number of bytes the encoded beacon block and
+// blob sidecars may occupy in a proposal limited to maxTxBytes, once the
+// auxiliary transactions, the protobuf framing of every transaction and the
+// wire envelope of the beacon transactions are accounted for. It returns zero
+// if nothing is left for the beacon block.
+func ProposalMaxBytes(maxTxBytes int64, auxTxs ...[]byte) uint64 {
+	// The framing of any transaction is at most the framing of a
+	// transaction taking up the whole budget.
+	framing := TxFramingSize(maxTxBytes)
+	budget := maxTxBytes -
+		numBeaconTxs*(framing+encoding.EnvelopeHeaderSize)
+	for _, tx := range auxTxs {
+		budget -= framing + int64(len(tx))
+	}
+	if budget <= 0 {
+		return 0
+	}
+	return uint64(budget)
+}
+
+// TxsSize returns the number of bytes the transactions occupy in the data of
+// a CometBFT block, including their protobuf framing.
+func TxsSize(txs [][]byte) int64 {
+	var size int64
+	for _, tx := range txs {
+		size += TxFramingSize(int64(len(tx))) + int64(len(tx))
+	}
+	return size
+}
+
+// TxFramingSize returns the size of the protobuf field tag and length prefix
+// of a transaction of the given length.
+func TxFramingSize(length int64) int64 {
+	size := int64(2) // field tag and the last byte of the length varint.
+	//#nosec:G701 // lengths are never negative.
+	for v := uint64(length); v >= 0x80; v >>= 7 {
+		size++
+	}
+	return size
+}
@@ -0,0 +1,106 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package budget_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/berachain/beacon-kit/consensus/cometbft/service/budget"
+	"github.com/berachain/beacon-kit/consensus/cometbft/service/encoding"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxsSize_MatchesProtobufEncoding(t *testing.T) {
+	for _, lengths := range [][]int{
+		nil,
+		{0},
+		{1, 127},
+		{128, 16383},
+		{16384, 1 << 21, 3},
+	} {
+		txs := make([][]byte, len(lengths))
+		for i, length := range lengths {
+			txs[i] = bytes.Repeat([]byte{0xab}, length)
+		}
+		data := &cmtproto.Data{Txs: txs}
+		require.Equal(t, int64(data.Size()), budget.TxsSize(txs), lengths)
+	}
+}
+
+func TestProposalMaxBytes(t *testing.T) {
+	const maxTxBytes = 1 << 20
+	framing := budget.TxFramingSize(maxTxBytes)
+	beaconOverhead := 2 * (framing + encoding.EnvelopeHeaderSize)
+
+	tests := []struct {
+		name   string
+		auxTxs [][]byte
+		want   uint64
+	}{
+		{
+			name: "beacon txs only",
+			want: uint64(maxTxBytes - beaconOverhead),
+		},
+		{
+			name:   "aux txs take their framing and length",
+			auxTxs: [][]byte{make([]byte, 1000), make([]byte, 24)},
+			want:   uint64(maxTxBytes - beaconOverhead - 2*framing - 1024),
+		},
+		{
+			name:   "aux txs take the whole budget",
+			auxTxs: [][]byte{make([]byte, maxTxBytes)},
+			want:   0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(
+				t, tt.want, budget.ProposalMaxBytes(maxTxBytes, tt.auxTxs...),
+			)
+		})
+	}
+}
+
+func TestProposalMaxBytes_FitsMaxTxBytes(t *testing.T) {
+	// A beacon block and sidecars taking up the whole budget, in their
+	// envelopes, fit the proposal along with the aux txs.
+	const maxTxBytes = 4 << 20
+	aux := make([]byte, 300)
+	maxBytes := budget.ProposalMaxBytes(maxTxBytes, aux)
+	blkLen := maxBytes / 3
+	sidecarsLen := maxBytes - blkLen
+	txs := [][]byte{
+		make([]byte, blkLen+encoding.EnvelopeHeaderSize),
+		make([]byte, sidecarsLen+encoding.EnvelopeHeaderSize),
+		aux,
+	}
+	require.LessOrEqual(t, budget.TxsSize(txs), int64(maxTxBytes))
+}
+
+func TestTxFramingSize(t *testing.T) {
+	require.Equal(t, int64(2), budget.TxFramingSize(0))
+	require.Equal(t, int64(2), budget.TxFramingSize(127))
+	require.Equal(t, int64(3), budget.TxFramingSize(128))
+	require.Equal(t, int64(3), budget.TxFramingSize(16383))
+	require.Equal(t, int64(4), budget.TxFramingSize(16384))
+}
@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"context"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// withDeadline returns a copy of ctx cancelled with the ABCI call, or once
+// timeout has elapsed. Handlers of the call, down to the execution client,
+// are then bound by the consensus timeout the call must complete within,
+// while the values carried by ctx, like the aggregate of the vote
+// extensions, reach them. The returned function must be called once the
+// call returns, to cancel any work left over. A non-positive timeout sets no
+// deadline.
+func withDeadline(
+	ctx sdk.Context,
+	abciCtx context.Context,
+	timeout time.Duration,
+) (sdk.Context, context.CancelFunc) {
+	var (
+		dctx   context.Context
+		cancel context.CancelFunc
+	)
+	if timeout > 0 {
+		dctx, cancel = context.WithTimeout(ctx.Context(), timeout)
+	} else {
+		dctx, cancel = context.WithCancel(ctx.Context())
+	}
+	stop := context.AfterFunc(abciCtx, cancel)
+	return ctx.WithContext(dctx), func() {
+		stop()
+		cancel()
+	}
+}
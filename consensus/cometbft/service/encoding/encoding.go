@@ -59,6 +59,7 @@ func ExtractBlobsAndBlockFromRequest[
 	blobs, err = UnmarshalBlobSidecarsFromABCIRequest[BlobSidecarsT](
 		req,
 		blobSidecarsIndex,
+		forkVersion,
 	)
 	if err != nil {
 		return blk, blobs, err
@@ -99,7 +100,21 @@ func UnmarshalBeaconBlockFromABCIRequest[
 		return blk, ErrNilBeaconBlockInRequest
 	}
 
-	return blk.NewFromSSZ(blkBz, forkVersion)
+	return UnmarshalBeaconBlock[BeaconBlockT](blkBz, forkVersion)
+}
+
+// UnmarshalBeaconBlock decodes a beacon block from the bytes of a
+// transaction, in any wire format.
+func UnmarshalBeaconBlock[BeaconBlockT BeaconBlock[BeaconBlockT]](
+	bz []byte,
+	forkVersion uint32,
+) (BeaconBlockT, error) {
+	var blk BeaconBlockT
+	body, err := Decode(bz, forkVersion)
+	if err != nil {
+		return blk, err
+	}
+	return blk.NewFromSSZ(body, forkVersion)
 }
 
 // UnmarshalBlobSidecarsFromABCIRequest extracts blob sidecars from an ABCI
@@ -112,6 +127,7 @@ func UnmarshalBlobSidecarsFromABCIRequest[
 ](
 	req ABCIRequest,
 	bzIndex uint,
+	forkVersion uint32,
 ) (BlobSidecarsT, error) {
 	var sidecars BlobSidecarsT
 	if req == nil {
@@ -128,8 +144,13 @@ func UnmarshalBlobSidecarsFromABCIRequest[
 		return sidecars, ErrNilBeaconBlockInRequest
 	}
 
+	body, err := Decode(sidecarBz, forkVersion)
+	if err != nil {
+		return sidecars, err
+	}
+
 	// TODO: Do some research to figure out how to make this more
 	// elegant.
 	sidecars = sidecars.Empty()
-	return sidecars, sidecars.UnmarshalSSZ(sidecarBz)
+	return sidecars, sidecars.UnmarshalSSZ(body)
 }
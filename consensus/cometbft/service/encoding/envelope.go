@@ -0,0 +1,186 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package encoding
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/encoding/compress"
+	"github.com/berachain/beacon-kit/primitives/version"
+)
+
+// Format is the wire format of a beacon block or blob sidecars carried in the
+// transactions of a proposal.
+type Format uint8
+
+const (
+	// FormatLegacy is the bare SSZ encoding of the payload, as produced
+	// before envelopes were introduced.
+	FormatLegacy Format = iota
+	// FormatSSZ is the SSZ encoding of the payload wrapped in an envelope.
+	FormatSSZ
+	// FormatSSZSnappy is the snappy compressed SSZ encoding of the payload
+	// wrapped in an envelope.
+	FormatSSZSnappy
+)
+
+const (
+	// EnvelopeHeaderSize is the number of bytes an envelope adds to the
+	// payload: its magic, format and fork version.
+	EnvelopeHeaderSize = envelopeMagicSize + 1 + 4
+
+	// envelopeMagicSize is the size of the magic prefixing envelopes.
+	envelopeMagicSize = 7
+)
+
+// envelopeMagic prefixes every envelope. A legacy beacon block starts with
+// its slot, so that its eighth byte, which an envelope sets to its non zero
+// format, is zero for any slot below 2^56. Legacy blob sidecars start with
+// the offset of their list, which is 4. Neither can thus be mistaken for an
+// envelope.
+//
+//nolint:gochecknoglobals // constant byte array.
+var envelopeMagic = [envelopeMagicSize]byte{0xbe, 'b', 'k', 'w', 'i', 'r', 'e'}
+
+// Envelope describes how a payload is encoded on the wire.
+type Envelope struct {
+	// Format is the format of the payload.
+	Format Format
+	// ForkVersion is the fork version the payload was encoded for. It is
+	// zero for legacy payloads.
+	ForkVersion uint32
+}
+
+// String returns the name of the format.
+func (f Format) String() string {
+	switch f {
+	case FormatLegacy:
+		return "legacy"
+	case FormatSSZ:
+		return "ssz"
+	case FormatSSZSnappy:
+		return "ssz-snappy"
+	default:
+		return "unknown"
+	}
+}
+
+// ParseFormat returns the format with the given name.
+func ParseFormat(s string) (Format, error) {
+	switch strings.ToLower(s) {
+	case "", FormatLegacy.String():
+		return FormatLegacy, nil
+	case FormatSSZ.String():
+		return FormatSSZ, nil
+	case FormatSSZSnappy.String():
+		return FormatSSZSnappy, nil
+	default:
+		return 0, errors.Wrapf(ErrUnknownFormat, "%q", s)
+	}
+}
+
+// Encode encodes the SSZ body for the given fork version in the given format.
+func Encode(format Format, forkVersion uint32, body []byte) ([]byte, error) {
+	switch format {
+	case FormatLegacy:
+		return body, nil
+	case FormatSSZ:
+	case FormatSSZSnappy:
+		compressed, err := compress.Compress(body)
+		if err != nil {
+			return nil, err
+		}
+		// Incompressible payloads are sent as is, so that an envelope never
+		// grows a payload by more than its header.
+		if len(compressed) < len(body) {
+			body = compressed
+		} else {
+			format = FormatSSZ
+		}
+	default:
+		return nil, errors.Wrapf(ErrUnknownFormat, "%d", format)
+	}
+
+	bz := make([]byte, 0, EnvelopeHeaderSize+len(body))
+	bz = append(bz, envelopeMagic[:]...)
+	bz = append(bz, byte(format))
+	forkVersionBz := version.FromUint32[common.Version](forkVersion)
+	bz = append(bz, forkVersionBz[:]...)
+	return append(bz, body...), nil
+}
+
+// Unwrap splits the bytes into their envelope and SSZ body, decompressing
+// the body if needed. Bytes without an envelope are returned as a legacy
+// body.
+func Unwrap(bz []byte) (Envelope, []byte, error) {
+	if len(bz) < EnvelopeHeaderSize || !bytes.HasPrefix(bz, envelopeMagic[:]) {
+		return Envelope{Format: FormatLegacy}, bz, nil
+	}
+
+	env := Envelope{
+		Format: Format(bz[envelopeMagicSize]),
+		ForkVersion: version.ToUint32(
+			common.Version(bz[envelopeMagicSize+1 : EnvelopeHeaderSize]),
+		),
+	}
+	body := bz[EnvelopeHeaderSize:]
+	switch env.Format {
+	case FormatSSZ:
+		return env, body, nil
+	case FormatSSZSnappy:
+		if !compress.IsCompressed(body) {
+			return env, nil, ErrMalformedEnvelope
+		}
+		body, err := compress.Decompress(body)
+		if err != nil {
+			return env, nil, errors.Join(ErrMalformedEnvelope, err)
+		}
+		return env, body, nil
+	default:
+		// The payload was encoded by a newer node.
+		return env, nil, errors.Wrapf(
+			ErrUnknownFormat, "%d, a newer version of beacond is required",
+			env.Format,
+		)
+	}
+}
+
+// Decode unwraps the bytes and checks that the fork version of their
+// envelope, if any, is the expected one.
+func Decode(bz []byte, forkVersion uint32) ([]byte, error) {
+	env, body, err := Unwrap(bz)
+	if err != nil {
+		return nil, err
+	}
+	if env.Format == FormatLegacy {
+		return body, nil
+	}
+	if env.ForkVersion != forkVersion {
+		return nil, errors.Wrapf(
+			ErrForkVersionMismatch, "expected %s, got %s",
+			version.Name(forkVersion), version.Name(env.ForkVersion),
+		)
+	}
+	return body, nil
+}
@@ -0,0 +1,148 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package encoding_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/binary"
+	"testing"
+
+	"github.com/berachain/beacon-kit/consensus/cometbft/service/encoding"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvelopeRoundTrip(t *testing.T) {
+	body := []byte{0x01, 0x02, 0x03}
+
+	bz, err := encoding.Encode(encoding.FormatSSZ, version.Deneb, body)
+	require.NoError(t, err)
+	require.Len(t, bz, encoding.EnvelopeHeaderSize+len(body))
+
+	env, got, err := encoding.Unwrap(bz)
+	require.NoError(t, err)
+	require.Equal(t, encoding.Envelope{
+		Format:      encoding.FormatSSZ,
+		ForkVersion: version.Deneb,
+	}, env)
+	require.Equal(t, body, got)
+
+	got, err = encoding.Decode(bz, version.Deneb)
+	require.NoError(t, err)
+	require.Equal(t, body, got)
+
+	_, err = encoding.Decode(bz, version.Electra)
+	require.ErrorIs(t, err, encoding.ErrForkVersionMismatch)
+}
+
+func TestEnvelopeSnappy(t *testing.T) {
+	body := bytes.Repeat([]byte{0xab, 0xcd}, 1<<16)
+
+	bz, err := encoding.Encode(encoding.FormatSSZSnappy, version.Deneb, body)
+	require.NoError(t, err)
+	require.Less(t, len(bz), len(body))
+
+	env, got, err := encoding.Unwrap(bz)
+	require.NoError(t, err)
+	require.Equal(t, encoding.FormatSSZSnappy, env.Format)
+	require.Equal(t, body, got)
+
+	got, err = encoding.Decode(bz, version.Deneb)
+	require.NoError(t, err)
+	require.Equal(t, body, got)
+
+	// A body that is not compressed.
+	bz[encoding.EnvelopeHeaderSize] ^= 0xff
+	_, _, err = encoding.Unwrap(bz)
+	require.ErrorIs(t, err, encoding.ErrMalformedEnvelope)
+}
+
+func TestEnvelopeSnappyIncompressible(t *testing.T) {
+	body := make([]byte, 1024)
+	_, err := rand.Read(body)
+	require.NoError(t, err)
+
+	// Incompressible bodies are sent as is.
+	bz, err := encoding.Encode(encoding.FormatSSZSnappy, version.Deneb, body)
+	require.NoError(t, err)
+	require.Len(t, bz, encoding.EnvelopeHeaderSize+len(body))
+
+	env, got, err := encoding.Unwrap(bz)
+	require.NoError(t, err)
+	require.Equal(t, encoding.FormatSSZ, env.Format)
+	require.Equal(t, body, got)
+}
+
+func TestEnvelopeLegacy(t *testing.T) {
+	// A legacy beacon block starts with its slot, a legacy blob sidecars
+	// list with the offset of its elements.
+	block := binary.LittleEndian.AppendUint64(nil, 1<<40)
+	block = append(block, make([]byte, 16)...)
+	sidecars := []byte{0x04, 0x00, 0x00, 0x00}
+
+	for _, body := range [][]byte{block, sidecars, nil} {
+		bz, err := encoding.Encode(encoding.FormatLegacy, version.Deneb, body)
+		require.NoError(t, err)
+		require.Equal(t, body, bz)
+
+		env, got, err := encoding.Unwrap(bz)
+		require.NoError(t, err)
+		require.Equal(t, encoding.FormatLegacy, env.Format)
+		require.Equal(t, body, got)
+
+		// Legacy payloads carry no fork version to check.
+		got, err = encoding.Decode(bz, version.Electra)
+		require.NoError(t, err)
+		require.Equal(t, body, got)
+	}
+}
+
+func TestEnvelopeUnknownFormat(t *testing.T) {
+	_, err := encoding.Encode(encoding.Format(7), version.Deneb, nil)
+	require.ErrorIs(t, err, encoding.ErrUnknownFormat)
+
+	// An envelope in a format introduced after this one.
+	bz, err := encoding.Encode(encoding.FormatSSZ, version.Deneb, []byte{1})
+	require.NoError(t, err)
+	bz[7] = 0xff
+	_, _, err = encoding.Unwrap(bz)
+	require.ErrorIs(t, err, encoding.ErrUnknownFormat)
+	_, err = encoding.Decode(bz, version.Deneb)
+	require.ErrorIs(t, err, encoding.ErrUnknownFormat)
+}
+
+func TestParseFormat(t *testing.T) {
+	for _, f := range []encoding.Format{
+		encoding.FormatLegacy, encoding.FormatSSZ, encoding.FormatSSZSnappy,
+	} {
+		got, err := encoding.ParseFormat(f.String())
+		require.NoError(t, err)
+		require.Equal(t, f, got)
+	}
+
+	got, err := encoding.ParseFormat("")
+	require.NoError(t, err)
+	require.Equal(t, encoding.FormatLegacy, got)
+
+	_, err = encoding.ParseFormat("protobuf")
+	require.ErrorIs(t, err, encoding.ErrUnknownFormat)
+}
@@ -41,4 +41,16 @@ var (
 
 	// ErrInvalidType is an error for when the type is invalid.
 	ErrInvalidType = errors.New("invalid type")
+
+	// ErrUnknownFormat is an error for when a payload is encoded in a wire
+	// format this node does not understand.
+	ErrUnknownFormat = errors.New("unknown wire format")
+
+	// ErrForkVersionMismatch is an error for when the fork version of an
+	// envelope does not match the one expected for its height.
+	ErrForkVersionMismatch = errors.New("envelope fork version mismatch")
+
+	// ErrMalformedEnvelope is an error for when the body of an envelope
+	// cannot be decoded in its format.
+	ErrMalformedEnvelope = errors.New("malformed envelope")
 )
@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+// Exported for the tests of the external test package.
+type CometHeights = cometHeights
+
+var (
+	ErrUnrecoverableState = errUnrecoverableState
+	RecoveryTarget        = recoveryTarget
+)
+
+func NewCometHeights(
+	state int64, appHash []byte, base, store int64,
+) CometHeights {
+	return cometHeights{
+		state: state, appHash: appHash, base: base, store: store,
+	}
+}
+
+type AcceptedProposals = acceptedProposals
+
+func (a *acceptedProposals) Contains(height int64, hash []byte) bool {
+	return a.contains(height, hash)
+}
+
+func (a *acceptedProposals) Add(height int64, hash []byte) {
+	a.add(height, hash)
+}
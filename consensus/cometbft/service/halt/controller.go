@@ -0,0 +1,175 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package halt
+
+import (
+	"sync"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log"
+)
+
+// warnBlocks is the number of blocks before the halt height from which
+// every committed block is logged as a warning.
+const warnBlocks = 10
+
+// Controller tracks the height at which the node stops finalizing blocks,
+// so that operators can coordinate upgrades without racing to stop their
+// binaries. The halt can be given as a height or as an epoch, in which
+// case the node halts after the last block of the previous epoch so that
+// the upgraded binary processes the epoch transition. If both are set, the
+// lowest halt height wins.
+type Controller struct {
+	logger        log.Logger
+	slotsPerEpoch uint64
+
+	mu        sync.RWMutex
+	height    uint64
+	epoch     uint64
+	committed uint64
+}
+
+// NewController creates a new halt controller, armed at the given height
+// or epoch if any is non-zero.
+func NewController(
+	logger log.Logger,
+	slotsPerEpoch uint64,
+	height uint64,
+	epoch uint64,
+) *Controller {
+	c := &Controller{
+		logger:        logger,
+		slotsPerEpoch: slotsPerEpoch,
+		height:        height,
+		epoch:         epoch,
+	}
+	if haltHeight := c.haltHeight(); haltHeight > 0 {
+		c.logger.Info(
+			"Halt armed", "height", height, "epoch", epoch,
+			"halt_height", haltHeight,
+		)
+	}
+	return c
+}
+
+// Arm sets the height or epoch at which the node halts, replacing any
+// previous target.
+func (c *Controller) Arm(height uint64, epoch uint64) error {
+	if height == 0 && epoch == 0 {
+		return ErrNoTarget
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	prevHeight, prevEpoch := c.height, c.epoch
+	c.height, c.epoch = height, epoch
+	haltHeight := c.haltHeight()
+	if haltHeight <= c.committed {
+		c.height, c.epoch = prevHeight, prevEpoch
+		return ErrTargetPassed
+	}
+
+	c.logger.Info(
+		"Halt armed", "height", height, "epoch", epoch,
+		"halt_height", haltHeight,
+	)
+	return nil
+}
+
+// Disarm clears the halt target.
+func (c *Controller) Disarm() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.haltHeight() > 0 {
+		c.logger.Info("Halt disarmed")
+	}
+	c.height, c.epoch = 0, 0
+}
+
+// Status returns the configured halt height and epoch, and the resulting
+// height at which the node halts, zero if the controller is disarmed.
+func (c *Controller) Status() (uint64, uint64, uint64) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.height, c.epoch, c.haltHeight()
+}
+
+// CheckFinalize returns ErrHalted if a block at the given height must not
+// be finalized.
+func (c *Controller) CheckFinalize(height uint64) error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if haltHeight := c.haltHeight(); haltHeight > 0 && height > haltHeight {
+		return errors.Wrapf(ErrHalted, "halt height %d", haltHeight)
+	}
+	return nil
+}
+
+// Committed records that the block at the given height was committed,
+// logging as the halt approaches, and returns true if the node must halt.
+func (c *Controller) Committed(height uint64) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.committed = height
+
+	haltHeight := c.haltHeight()
+	if haltHeight == 0 {
+		return false
+	}
+	if height >= haltHeight {
+		c.logger.Warn(
+			"Reached halt height, shutting down", "halt_height", haltHeight,
+		)
+		return true
+	}
+
+	blocksLeft := haltHeight - height
+	switch {
+	case blocksLeft <= warnBlocks:
+		c.logger.Warn(
+			"Approaching halt height", "halt_height", haltHeight,
+			"blocks_left", blocksLeft,
+		)
+	case blocksLeft%c.slotsPerEpoch == 0:
+		c.logger.Info(
+			"Approaching halt height", "halt_height", haltHeight,
+			"blocks_left", blocksLeft,
+		)
+	}
+	return false
+}
+
+// haltHeight returns the height at which the node halts, zero if the
+// controller is disarmed.
+func (c *Controller) haltHeight() uint64 {
+	var fromEpoch uint64
+	if c.epoch > 0 {
+		fromEpoch = c.epoch*c.slotsPerEpoch - 1
+	}
+	switch {
+	case c.height == 0:
+		return fromEpoch
+	case fromEpoch == 0:
+		return c.height
+	default:
+		return min(c.height, fromEpoch)
+	}
+}
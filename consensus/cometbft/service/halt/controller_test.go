@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package halt_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/consensus/cometbft/service/halt"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/stretchr/testify/require"
+)
+
+const slotsPerEpoch = 32
+
+func TestController_Disarmed(t *testing.T) {
+	t.Parallel()
+	c := halt.NewController(noop.NewLogger[any](), slotsPerEpoch, 0, 0)
+
+	_, _, haltHeight := c.Status()
+	require.Zero(t, haltHeight)
+	require.NoError(t, c.CheckFinalize(1_000_000))
+	require.False(t, c.Committed(1_000_000))
+}
+
+func TestController_HaltHeight(t *testing.T) {
+	t.Parallel()
+	c := halt.NewController(noop.NewLogger[any](), slotsPerEpoch, 100, 0)
+
+	require.NoError(t, c.CheckFinalize(100))
+	require.False(t, c.Committed(99))
+	require.True(t, c.Committed(100))
+	require.ErrorIs(t, c.CheckFinalize(101), halt.ErrHalted)
+}
+
+func TestController_HaltEpoch(t *testing.T) {
+	t.Parallel()
+	c := halt.NewController(noop.NewLogger[any](), slotsPerEpoch, 0, 2)
+
+	// The node halts after the last block of the previous epoch.
+	_, _, haltHeight := c.Status()
+	require.Equal(t, uint64(2*slotsPerEpoch-1), haltHeight)
+
+	// The lowest of height and epoch wins.
+	require.NoError(t, c.Arm(40, 2))
+	_, _, haltHeight = c.Status()
+	require.Equal(t, uint64(40), haltHeight)
+	require.NoError(t, c.Arm(1000, 2))
+	_, _, haltHeight = c.Status()
+	require.Equal(t, uint64(2*slotsPerEpoch-1), haltHeight)
+}
+
+func TestController_ArmDisarm(t *testing.T) {
+	t.Parallel()
+	c := halt.NewController(noop.NewLogger[any](), slotsPerEpoch, 0, 0)
+
+	require.ErrorIs(t, c.Arm(0, 0), halt.ErrNoTarget)
+
+	require.False(t, c.Committed(50))
+	require.ErrorIs(t, c.Arm(50, 0), halt.ErrTargetPassed)
+	require.ErrorIs(t, c.Arm(0, 1), halt.ErrTargetPassed)
+	_, _, haltHeight := c.Status()
+	require.Zero(t, haltHeight)
+
+	require.NoError(t, c.Arm(60, 0))
+	require.ErrorIs(t, c.CheckFinalize(61), halt.ErrHalted)
+	c.Disarm()
+	require.NoError(t, c.CheckFinalize(61))
+	require.False(t, c.Committed(60))
+}
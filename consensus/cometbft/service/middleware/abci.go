@@ -27,6 +27,7 @@ import (
 	"github.com/berachain/beacon-kit/consensus/cometbft/service/encoding"
 	"github.com/berachain/beacon-kit/consensus/types"
 	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/observability/proposal"
 	"github.com/berachain/beacon-kit/primitives/async"
 	"github.com/berachain/beacon-kit/primitives/encoding/json"
 	"github.com/berachain/beacon-kit/primitives/math"
@@ -87,10 +88,15 @@ func (h *ABCIMiddleware[
 	var (
 		startTime        = time.Now()
 		awaitCtx, cancel = context.WithTimeout(ctx, AwaitTimeout)
+		report           = proposal.NewReport()
 	)
 
 	defer cancel()
 	defer h.metrics.measurePrepareProposalDuration(startTime)
+
+	// Carry the report along the proposal path, so that the validator and
+	// the payload builder record their stages.
+	ctx = ctx.WithContext(proposal.WithReport(ctx.Context(), report))
 	// flush the channels to ensure that we are not handling old data.
 	if numMsgs := async.ClearChan(h.subBuiltBeaconBlock); numMsgs > 0 {
 		h.logger.Error(
@@ -123,7 +129,25 @@ func (h *ABCIMiddleware[
 		return nil, nil, err
 	}
 
-	return h.handleBuiltBeaconBlockAndSidecars(builtBeaconBlock, builtSidecars)
+	encodeStart := time.Now()
+	bbBz, scBz, err := h.handleBuiltBeaconBlockAndSidecars(
+		builtBeaconBlock, builtSidecars,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	report.Observe(proposal.StageEncode, encodeStart)
+	report.Observe(proposal.StageHandOff, startTime)
+
+	h.metrics.reportProposal(report)
+	h.logger.Info(
+		"Proposal report",
+		append(
+			[]any{"slot", builtBeaconBlock.GetSlot().Base10()},
+			report.Fields()...,
+		)...,
+	)
+	return bbBz, scBz, nil
 }
 
 // waitForBuiltBeaconBlock waits for the built beacon block to be received.
@@ -170,6 +194,18 @@ func (h *ABCIMiddleware[
 	if scErr != nil {
 		return nil, nil, scErr
 	}
+
+	forkVersion := h.chainSpec.ActiveForkVersionForSlot(bb.GetSlot())
+	if bbBz, bbErr = encoding.Encode(
+		h.wireFormat, forkVersion, bbBz,
+	); bbErr != nil {
+		return nil, nil, bbErr
+	}
+	if scBz, scErr = encoding.Encode(
+		h.wireFormat, forkVersion, scBz,
+	); scErr != nil {
+		return nil, nil, scErr
+	}
 	return bbBz, scBz, nil
 }
 
@@ -205,16 +241,22 @@ func (h *ABCIMiddleware[
 	defer h.metrics.measureProcessProposalDuration(startTime)
 
 	// Decode the beacon block.
+	forkVersion := h.chainSpec.ActiveForkVersionForSlot(math.U64(req.Height))
 	blk, err := encoding.
 		UnmarshalBeaconBlockFromABCIRequest[BeaconBlockT](
 		req,
 		BeaconBlockTxIndex,
-		h.chainSpec.ActiveForkVersionForSlot(math.U64(req.Height)),
+		forkVersion,
 	)
 	if err != nil {
 		return h.createProcessProposalResponse(errors.WrapNonFatal(err))
 	}
 
+	// Reject a block exceeding the chain limits before any state access.
+	if err = blk.ValidateLimits(h.chainSpec); err != nil {
+		return h.createProcessProposalResponse(errors.WrapNonFatal(err))
+	}
+
 	// notify that the beacon block has been received.
 	var consensusBlk *types.ConsensusBlock[BeaconBlockT]
 	consensusBlk = consensusBlk.New(
@@ -232,6 +274,7 @@ func (h *ABCIMiddleware[
 		UnmarshalBlobSidecarsFromABCIRequest[BlobSidecarsT](
 		req,
 		BlobSidecarsTxIndex,
+		forkVersion,
 	)
 	if err != nil {
 		return h.createProcessProposalResponse(errors.WrapNonFatal(err))
@@ -318,7 +361,12 @@ func (h *ABCIMiddleware[
 	ctx sdk.Context,
 	req *cmtabci.FinalizeBlockRequest,
 ) (transition.ValidatorUpdates, error) {
-	awaitCtx, cancel := context.WithTimeout(ctx, AwaitTimeout)
+	// Unlike a proposal, a finalized block cannot be given up on once its
+	// deadline expires: the execution client calls are bound by it and
+	// tolerate its expiry, so the validator updates are awaited past it.
+	awaitCtx, cancel := context.WithTimeout(
+		context.WithoutCancel(ctx), untilDeadline(ctx)+AwaitTimeout,
+	)
 	defer cancel()
 	// flush the channel to ensure that we are not handling old data.
 	if numMsgs := async.ClearChan(h.subFinalValidatorUpdates); numMsgs > 0 {
@@ -367,6 +415,16 @@ func (h *ABCIMiddleware[
 	return h.waitForFinalValidatorUpdates(awaitCtx)
 }
 
+// untilDeadline returns the time left until the deadline of ctx, or zero if
+// it has none.
+func untilDeadline(ctx context.Context) time.Duration {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return 0
+	}
+	return max(time.Until(deadline), 0)
+}
+
 // waitForFinalValidatorUpdates waits for the final validator updates to be
 // received.
 func (h *ABCIMiddleware[
@@ -29,6 +29,10 @@ const (
 	// BlobSidecarsTxIndex represents the index of the blob sidecar transaction.
 	// It follows the beacon block transaction in the tx list.
 	BlobSidecarsTxIndex
+	// ExtendedCommitTxIndex represents the index of the optional transaction
+	// carrying the previous block's extended commit. It follows the blob
+	// sidecar transaction in the tx list.
+	ExtendedCommitTxIndex
 	// AwaitTimeout is the timeout for awaiting events.
 	AwaitTimeout = 2 * time.Second
 )
@@ -22,6 +22,9 @@ package middleware
 
 import (
 	"time"
+
+	"github.com/berachain/beacon-kit/observability/proposal"
+	"github.com/berachain/beacon-kit/primitives/math"
 )
 
 // ABCIMiddlewareMetrics is a struct that contains metrics for the chain.
@@ -56,3 +59,36 @@ func (cm *ABCIMiddlewareMetrics) measureProcessProposalDuration(
 		"beacon_kit.runtime.process_proposal_duration", start,
 	)
 }
+
+// reportProposal records the stages and contents of a proposed block.
+func (cm *ABCIMiddlewareMetrics) reportProposal(report *proposal.Report) {
+	// The stages have already ended, so their durations are recorded as
+	// elapsed since a start time shifted back by as much.
+	now := time.Now()
+	for _, stage := range proposal.Stages {
+		if d, ok := report.Duration(stage); ok {
+			cm.sink.MeasureSince(
+				"beacon_kit.runtime.proposal_stage_duration",
+				now.Add(-d),
+				"stage", string(stage),
+			)
+		}
+	}
+
+	if value := report.PayloadValue(); value != nil {
+		if gwei, err := math.GweiFromWei(value.ToBig()); err == nil {
+			cm.sink.SetGauge(
+				"beacon_kit.runtime.proposal_payload_value_gwei",
+				int64(gwei), //#nosec:G701 // block rewards fit in an int64.
+			)
+		}
+	}
+	cm.sink.SetGauge(
+		"beacon_kit.runtime.proposal_blob_count",
+		int64(report.BlobCount()), //#nosec:G701 // bounded by the spec.
+	)
+	cm.sink.SetGauge(
+		"beacon_kit.runtime.proposal_deposit_count",
+		int64(report.DepositCount()), //#nosec:G701 // bounded by the spec.
+	)
+}
@@ -24,6 +24,7 @@ import (
 	"context"
 
 	"github.com/berachain/beacon-kit/async/types"
+	"github.com/berachain/beacon-kit/consensus/cometbft/service/encoding"
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/primitives/async"
 	"github.com/berachain/beacon-kit/primitives/common"
@@ -46,6 +47,9 @@ type ABCIMiddleware[
 	metrics *ABCIMiddlewareMetrics
 	// logger is the logger for the middleware.
 	logger log.Logger
+	// wireFormat is the format proposed beacon blocks and blob sidecars are
+	// encoded in.
+	wireFormat encoding.Format
 	// subGenDataProcessed is the channel to hold GenesisDataProcessed events.
 	subGenDataProcessed chan async.Event[validatorUpdates]
 	// subBuiltBeaconBlock is the channel to hold BuiltBeaconBlock events.
@@ -73,6 +77,7 @@ func NewABCIMiddleware[
 	dispatcher types.EventDispatcher,
 	logger log.Logger,
 	telemetrySink TelemetrySink,
+	wireFormat encoding.Format,
 ) *ABCIMiddleware[
 	BeaconBlockT, BeaconBlockHeaderT, BlobSidecarsT, GenesisT, SlotDataT,
 ] {
@@ -82,6 +87,7 @@ func NewABCIMiddleware[
 		chainSpec:                chainSpec,
 		dispatcher:               dispatcher,
 		logger:                   logger,
+		wireFormat:               wireFormat,
 		metrics:                  newABCIMiddlewareMetrics(telemetrySink),
 		subGenDataProcessed:      make(chan async.Event[validatorUpdates]),
 		subBuiltBeaconBlock:      make(chan async.Event[BeaconBlockT]),
@@ -23,7 +23,9 @@ package middleware
 import (
 	"time"
 
+	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/constraints"
+	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/primitives/transition"
 )
 
@@ -35,12 +37,16 @@ type BeaconBlock[BeaconBlockT any, BeaconBlockHeaderT any] interface {
 	NewFromSSZ([]byte, uint32) (BeaconBlockT, error)
 
 	GetHeader() BeaconBlockHeaderT
+	GetSlot() math.Slot
+	ValidateLimits(common.ChainSpec) error
 }
 
 // TelemetrySink is an interface for sending metrics to a telemetry backend.
 type TelemetrySink interface {
 	// MeasureSince measures the time since the given time.
 	MeasureSince(key string, start time.Time, args ...string)
+	// SetGauge sets a gauge metric to the given value.
+	SetGauge(key string, value int64, args ...string)
 }
 
 type BlobSidecars[T any] interface {
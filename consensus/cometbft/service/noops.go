@@ -33,48 +33,6 @@ func (Service[_]) Query(
 	return &abci.QueryResponse{}, nil
 }
 
-func (Service[_]) ListSnapshots(
-	context.Context,
-	*abci.ListSnapshotsRequest,
-) (*abci.ListSnapshotsResponse, error) {
-	return &abci.ListSnapshotsResponse{}, nil
-}
-
-func (Service[_]) LoadSnapshotChunk(
-	context.Context,
-	*abci.LoadSnapshotChunkRequest,
-) (*abci.LoadSnapshotChunkResponse, error) {
-	return &abci.LoadSnapshotChunkResponse{}, nil
-}
-
-func (Service[_]) OfferSnapshot(
-	context.Context,
-	*abci.OfferSnapshotRequest,
-) (*abci.OfferSnapshotResponse, error) {
-	return &abci.OfferSnapshotResponse{}, nil
-}
-
-func (Service[_]) ApplySnapshotChunk(
-	context.Context,
-	*abci.ApplySnapshotChunkRequest,
-) (*abci.ApplySnapshotChunkResponse, error) {
-	return &abci.ApplySnapshotChunkResponse{}, nil
-}
-
-func (Service[_]) ExtendVote(
-	context.Context,
-	*abci.ExtendVoteRequest,
-) (*abci.ExtendVoteResponse, error) {
-	return &abci.ExtendVoteResponse{}, nil
-}
-
-func (Service[_]) VerifyVoteExtension(
-	context.Context,
-	*abci.VerifyVoteExtensionRequest,
-) (*abci.VerifyVoteExtensionResponse, error) {
-	return &abci.VerifyVoteExtensionResponse{}, nil
-}
-
 func (*Service[_]) CheckTx(
 	context.Context,
 	*abci.CheckTxRequest,
@@ -22,8 +22,14 @@ package cometbft
 
 import (
 	pruningtypes "cosmossdk.io/store/pruning/types"
+	"cosmossdk.io/store/snapshots"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
 	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/consensus/cometbft/service/halt"
+	"github.com/berachain/beacon-kit/consensus/cometbft/service/voteext"
+	servercmtlog "github.com/berachain/beacon-kit/consensus/cometbft/service/log"
 	"github.com/berachain/beacon-kit/log"
+	cmttypes "github.com/cometbft/cometbft/types"
 )
 
 // File for storing in-package cometbft optional functions,
@@ -81,3 +87,66 @@ func SetChainID[
 ](chainID string) func(*Service[LoggerT]) {
 	return func(s *Service[LoggerT]) { s.chainID = chainID }
 }
+
+// SetVoteExtensionHandler sets the handler producing and verifying vote
+// extensions.
+func SetVoteExtensionHandler[
+	LoggerT log.AdvancedLogger[LoggerT],
+](handler *voteext.Handler) func(*Service[LoggerT]) {
+	return func(s *Service[LoggerT]) { s.voteExtensions = handler }
+}
+
+// SetPrivValidator sets the private validator signing the votes and
+// proposals of the node, instead of the one loaded from the CometBFT config.
+func SetPrivValidator[
+	LoggerT log.AdvancedLogger[LoggerT],
+](pv cmttypes.PrivValidator) func(*Service[LoggerT]) {
+	return func(s *Service[LoggerT]) { s.privValidator = pv }
+}
+
+// SetHaltController sets the controller deciding the height at which the
+// node stops finalizing blocks.
+func SetHaltController[
+	LoggerT log.AdvancedLogger[LoggerT],
+](controller *halt.Controller) func(*Service[LoggerT]) {
+	return func(s *Service[LoggerT]) { s.halt = controller }
+}
+
+// SetSnapshot sets the store of state sync snapshots and the interval at
+// which they are taken. A nil store disables state sync.
+func SetSnapshot[
+	LoggerT log.AdvancedLogger[LoggerT],
+](
+	snapshotStore *snapshots.Store,
+	opts snapshottypes.SnapshotOptions,
+) func(*Service[LoggerT]) {
+	return func(s *Service[LoggerT]) {
+		if snapshotStore == nil {
+			s.snapshotManager = nil
+			return
+		}
+		s.sm.CommitMultiStore().SetSnapshotInterval(opts.Interval)
+		s.snapshotManager = snapshots.NewManager(
+			snapshotStore, opts, s.sm.CommitMultiStore(), nil,
+			servercmtlog.WrapSDKLogger(s.logger),
+		)
+	}
+}
+
+// SetSnapshotExtensions registers state kept outside of the multistore with
+// the snapshot manager, so that it is carried by state sync snapshots. It
+// must be applied after SetSnapshot.
+func SetSnapshotExtensions[
+	LoggerT log.AdvancedLogger[LoggerT],
+](extensions ...snapshottypes.ExtensionSnapshotter) func(*Service[LoggerT]) {
+	return func(s *Service[LoggerT]) {
+		if s.snapshotManager == nil {
+			return
+		}
+		if err := s.snapshotManager.RegisterExtensions(
+			extensions...,
+		); err != nil {
+			panic(err)
+		}
+	}
+}
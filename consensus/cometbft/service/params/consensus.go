@@ -21,15 +21,34 @@
 package params
 
 import (
+	datypes "github.com/berachain/beacon-kit/da/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/crypto"
 	math "github.com/berachain/beacon-kit/primitives/math"
 	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
 	cmttypes "github.com/cometbft/cometbft/types"
 )
 
+// minBeaconBlockBytes is the room a CometBFT block keeps for the beacon block
+// on top of the blob sidecars of a block carrying the maximum number of
+// blobs.
+const minBeaconBlockBytes = 1 << 20
+
+// ErrInvalidCometBFTConfig is returned when the CometBFT config of the chain
+// spec is not a set of consensus params.
+var ErrInvalidCometBFTConfig = errors.New("invalid CometBFT config")
+
 type ChainSpec interface {
 	// GetCometBFTConfigForSlot returns the CometBFT configuration for the given
 	// slot.
 	GetCometBFTConfigForSlot(math.Slot) any
+	// MaxBlobsPerBlock returns the maximum number of blobs per block.
+	MaxBlobsPerBlock() uint64
+	// EpochsPerSlashingsVector returns the number of epochs slashings are
+	// accounted for.
+	EpochsPerSlashingsVector() uint64
+	// SlotsPerEpoch returns the number of slots per epoch.
+	SlotsPerEpoch() uint64
 }
 
 // ConsensusParamsStore is a store for consensus parameters.
@@ -44,12 +63,45 @@ func NewConsensusParamsStore(cs ChainSpec) *ConsensusParamsStore {
 	}
 }
 
-// Get retrieves the consensus parameters from the store.
-// It returns the consensus parameters and an error, if any.
-func (s *ConsensusParamsStore) Get() *cmtproto.ConsensusParams {
-	//nolint:errcheck // TODO (fridrik): Is this safe?
-	p := s.cs.
-		GetCometBFTConfigForSlot(0).(*cmttypes.ConsensusParams).
-		ToProto()
-	return &p
+// Get derives the consensus parameters in force at the given height from
+// the chain spec. The CometBFT config of the chain spec is constrained by
+// the beacon chain parameters CometBFT must agree with, so that the two
+// layers cannot drift apart.
+func (s *ConsensusParamsStore) Get(
+	height int64,
+) (*cmtproto.ConsensusParams, error) {
+	//#nosec:G701 // heights are never negative.
+	slot := math.Slot(height)
+	base, ok := s.cs.GetCometBFTConfigForSlot(slot).(*cmttypes.ConsensusParams)
+	if !ok || base == nil {
+		return nil, errors.Wrapf(
+			ErrInvalidCometBFTConfig, "unexpected type %T",
+			s.cs.GetCometBFTConfigForSlot(slot),
+		)
+	}
+
+	// Copy the config, since it is shared by every slot.
+	params := *base
+	params.Validator.PubKeyTypes = []string{crypto.CometBLSType}
+
+	// A block must fit the sidecars of the maximum number of blobs.
+	//#nosec:G701 // the size of the sidecars fits in an int64.
+	minBlockBytes := int64(datypes.BlobSidecarsSize(
+		int(s.cs.MaxBlobsPerBlock()),
+	)) + minBeaconBlockBytes
+	if params.Block.MaxBytes != -1 {
+		params.Block.MaxBytes = max(params.Block.MaxBytes, minBlockBytes)
+	}
+
+	// Evidence must remain admissible while slashings are accounted for.
+	//#nosec:G701 // the slashings window fits in an int64.
+	slashingsWindow := int64(
+		s.cs.EpochsPerSlashingsVector() * s.cs.SlotsPerEpoch(),
+	)
+	params.Evidence.MaxAgeNumBlocks = max(
+		params.Evidence.MaxAgeNumBlocks, slashingsWindow,
+	)
+
+	p := params.ToProto()
+	return &p, nil
 }
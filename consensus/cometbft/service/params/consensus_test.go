@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package params_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/config/spec"
+	"github.com/berachain/beacon-kit/consensus/cometbft/service/params"
+	datypes "github.com/berachain/beacon-kit/da/types"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+)
+
+type testSpec struct {
+	cometConfig    any
+	maxBlobs       uint64
+	slashingEpochs uint64
+	slotsPerEpoch  uint64
+}
+
+func (s testSpec) GetCometBFTConfigForSlot(math.Slot) any {
+	return s.cometConfig
+}
+
+func (s testSpec) MaxBlobsPerBlock() uint64 { return s.maxBlobs }
+
+func (s testSpec) EpochsPerSlashingsVector() uint64 {
+	return s.slashingEpochs
+}
+
+func (s testSpec) SlotsPerEpoch() uint64 { return s.slotsPerEpoch }
+
+func TestGet_PresetsUnchanged(t *testing.T) {
+	t.Parallel()
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+
+	cp, err := params.NewConsensusParamsStore(cs).Get(1)
+	require.NoError(t, err)
+
+	base, ok := cs.GetCometBFTConfigForSlot(1).(*cmttypes.ConsensusParams)
+	require.True(t, ok)
+	expected := base.ToProto()
+	require.True(t, expected.Equal(cp))
+}
+
+func TestGet_EnforcesChainSpecFloors(t *testing.T) {
+	t.Parallel()
+	base := cmttypes.DefaultConsensusParams()
+	base.Block.MaxBytes = 1024
+	base.Evidence.MaxAgeNumBlocks = 10
+	base.Validator.PubKeyTypes = []string{cmttypes.ABCIPubKeyTypeEd25519}
+
+	store := params.NewConsensusParamsStore(testSpec{
+		cometConfig:    base,
+		maxBlobs:       6,
+		slashingEpochs: 8,
+		slotsPerEpoch:  32,
+	})
+	cp, err := store.Get(1)
+	require.NoError(t, err)
+
+	//#nosec:G701 // test values.
+	require.Equal(t,
+		int64(datypes.BlobSidecarsSize(6))+1<<20, cp.Block.MaxBytes,
+	)
+	require.Equal(t, int64(8*32), cp.Evidence.MaxAgeNumBlocks)
+	require.Equal(t, []string{crypto.CometBLSType}, cp.Validator.PubKeyTypes)
+
+	// The chain spec config must not be mutated.
+	require.Equal(t, int64(1024), base.Block.MaxBytes)
+	require.Equal(t,
+		[]string{cmttypes.ABCIPubKeyTypeEd25519}, base.Validator.PubKeyTypes,
+	)
+}
+
+func TestGet_RejectsUnexpectedConfig(t *testing.T) {
+	t.Parallel()
+	store := params.NewConsensusParamsStore(testSpec{
+		cometConfig: "not consensus params",
+	})
+	_, err := store.Get(1)
+	require.ErrorIs(t, err, params.ErrInvalidCometBFTConfig)
+}
@@ -0,0 +1,160 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"bytes"
+	"fmt"
+
+	cmtcfg "github.com/cometbft/cometbft/config"
+	sm "github.com/cometbft/cometbft/state"
+	cmtstore "github.com/cometbft/cometbft/store"
+)
+
+// cometHeights are the heights CometBFT persisted, as seen on startup.
+type cometHeights struct {
+	// state is the height of the last block CometBFT committed.
+	state int64
+	// appHash is the application hash CometBFT recorded for state.
+	appHash []byte
+	// base and store are the lowest and highest heights of the blocks in
+	// the block store.
+	base, store int64
+}
+
+// loadCometHeights reads the heights CometBFT persisted. The databases are
+// closed before returning, so that the node can open them.
+func loadCometHeights(cfg *cmtcfg.Config) (cometHeights, error) {
+	var heights cometHeights
+
+	blockDB, err := cmtcfg.DefaultDBProvider(
+		&cmtcfg.DBContext{ID: "blockstore", Config: cfg},
+	)
+	if err != nil {
+		return heights, err
+	}
+	blockStore := cmtstore.NewBlockStore(
+		blockDB, cmtstore.WithDBKeyLayout(cfg.Storage.ExperimentalKeyLayout),
+	)
+	heights.base, heights.store = blockStore.Base(), blockStore.Height()
+	if err = blockStore.Close(); err != nil {
+		return heights, err
+	}
+
+	stateDB, err := cmtcfg.DefaultDBProvider(
+		&cmtcfg.DBContext{ID: "state", Config: cfg},
+	)
+	if err != nil {
+		return heights, err
+	}
+	stateStore := sm.NewStore(stateDB, sm.StoreOptions{
+		DiscardABCIResponses: cfg.Storage.DiscardABCIResponses,
+		DBKeyLayout:          cfg.Storage.ExperimentalKeyLayout,
+	})
+	state, err := stateStore.Load()
+	if err != nil {
+		_ = stateStore.Close()
+		return heights, err
+	}
+	heights.state, heights.appHash = state.LastBlockHeight, state.AppHash
+	return heights, stateStore.Close()
+}
+
+// recoverConsistency reconciles the committed application state with the
+// state CometBFT committed, before the node starts. A crash in the middle of
+// a commit can leave the application ahead of CometBFT, or at its height with
+// a diverging hash, which CometBFT refuses to start from. The application is
+// then rolled back to a height CometBFT replays the missing blocks from,
+// through the application. An application behind CometBFT is left to the
+// replay of the handshake, unless the blocks it misses were pruned.
+func (s *Service[_]) recoverConsistency() error {
+	comet, err := loadCometHeights(s.cmtCfg)
+	if err != nil {
+		return err
+	}
+	target, err := recoveryTarget(
+		s.LastBlockHeight(), s.sm.CommitMultiStore().LastCommitID().Hash,
+		comet,
+	)
+	if err != nil || target == s.LastBlockHeight() {
+		return err
+	}
+
+	s.logger.Warn(
+		"Application state inconsistent with CometBFT, rolling back",
+		"app_height", s.LastBlockHeight(),
+		"cometbft_height", comet.state,
+		"target_height", target,
+	)
+	if err = s.sm.CommitMultiStore().RollbackToVersion(target); err != nil {
+		return fmt.Errorf("failed to roll back to height %d: %w", target, err)
+	}
+	return nil
+}
+
+// recoveryTarget returns the height the application at appHeight, with the
+// given hash, must be rolled back to for CometBFT to start from it. It
+// returns appHeight if no rollback is needed.
+func recoveryTarget(
+	appHeight int64,
+	appHash []byte,
+	comet cometHeights,
+) (int64, error) {
+	switch {
+	case appHeight == 0:
+		// InitChain runs on startup.
+		return appHeight, nil
+
+	case comet.state == 0:
+		return 0, fmt.Errorf(
+			"%w: application at height %d, CometBFT has no state",
+			errUnrecoverableState, appHeight,
+		)
+
+	case appHeight < comet.state:
+		// The handshake replays the missing blocks, if still stored.
+		if appHeight < comet.base-1 {
+			return 0, fmt.Errorf(
+				"%w: application at height %d, blocks stored from %d",
+				errUnrecoverableState, appHeight, comet.base,
+			)
+		}
+		return appHeight, nil
+
+	case appHeight == comet.state:
+		if bytes.Equal(appHash, comet.appHash) {
+			return appHeight, nil
+		}
+		// The last block is replayed on top of the previous state.
+		if appHeight-1 < 1 || appHeight < comet.base {
+			return 0, fmt.Errorf(
+				"%w: application hash %X at height %d, CometBFT has %X",
+				errUnrecoverableState, appHash, appHeight, comet.appHash,
+			)
+		}
+		return appHeight - 1, nil
+
+	default:
+		// The application committed blocks CometBFT did not, which are
+		// replayed if stored.
+		return comet.state, nil
+	}
+}
@@ -0,0 +1,126 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft_test
+
+import (
+	"testing"
+
+	cometbft "github.com/berachain/beacon-kit/consensus/cometbft/service"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecoveryTarget(t *testing.T) {
+	var (
+		appHash   = []byte{0x01}
+		otherHash = []byte{0x02}
+	)
+	tests := []struct {
+		name      string
+		appHeight int64
+		appHash   []byte
+		comet     cometbft.CometHeights
+		target    int64
+		err       error
+	}{
+		{
+			name:      "fresh application",
+			appHeight: 0,
+			comet:     cometbft.CometHeights{},
+			target:    0,
+		},
+		{
+			name:      "application without CometBFT state",
+			appHeight: 5,
+			appHash:   appHash,
+			comet:     cometbft.CometHeights{},
+			err:       cometbft.ErrUnrecoverableState,
+		},
+		{
+			name:      "equal heights and hashes",
+			appHeight: 10,
+			appHash:   appHash,
+			comet:     cometbft.NewCometHeights(10, appHash, 1, 10),
+			target:    10,
+		},
+		{
+			name:      "equal heights, diverging hashes",
+			appHeight: 10,
+			appHash:   appHash,
+			comet:     cometbft.NewCometHeights(10, otherHash, 1, 10),
+			target:    9,
+		},
+		{
+			name:      "equal heights, diverging hashes, block pruned",
+			appHeight: 10,
+			appHash:   appHash,
+			comet:     cometbft.NewCometHeights(10, otherHash, 11, 10),
+			err:       cometbft.ErrUnrecoverableState,
+		},
+		{
+			name:      "equal heights, diverging hashes at the first block",
+			appHeight: 1,
+			appHash:   appHash,
+			comet:     cometbft.NewCometHeights(1, otherHash, 1, 1),
+			err:       cometbft.ErrUnrecoverableState,
+		},
+		{
+			name:      "application ahead",
+			appHeight: 12,
+			appHash:   appHash,
+			comet:     cometbft.NewCometHeights(10, otherHash, 1, 11),
+			target:    10,
+		},
+		{
+			name:      "store ahead",
+			appHeight: 8,
+			appHash:   appHash,
+			comet:     cometbft.NewCometHeights(10, otherHash, 1, 10),
+			target:    8,
+		},
+		{
+			name:      "store ahead, next block stored",
+			appHeight: 8,
+			appHash:   appHash,
+			comet:     cometbft.NewCometHeights(10, otherHash, 9, 10),
+			target:    8,
+		},
+		{
+			name:      "store ahead, next block pruned",
+			appHeight: 8,
+			appHash:   appHash,
+			comet:     cometbft.NewCometHeights(10, otherHash, 10, 10),
+			err:       cometbft.ErrUnrecoverableState,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target, err := cometbft.RecoveryTarget(
+				tt.appHeight, tt.appHash, tt.comet,
+			)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.target, target)
+		})
+	}
+}
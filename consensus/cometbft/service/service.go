@@ -24,10 +24,13 @@ import (
 	"context"
 	"errors"
 
+	"cosmossdk.io/store/snapshots"
 	storetypes "cosmossdk.io/store/types"
 	servercmtlog "github.com/berachain/beacon-kit/consensus/cometbft/service/log"
+	"github.com/berachain/beacon-kit/consensus/cometbft/service/halt"
 	"github.com/berachain/beacon-kit/consensus/cometbft/service/params"
 	statem "github.com/berachain/beacon-kit/consensus/cometbft/service/state"
+	"github.com/berachain/beacon-kit/consensus/cometbft/service/voteext"
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/crypto"
@@ -38,6 +41,7 @@ import (
 	"github.com/cometbft/cometbft/p2p"
 	pvm "github.com/cometbft/cometbft/privval"
 	"github.com/cometbft/cometbft/proxy"
+	cmttypes "github.com/cometbft/cometbft/types"
 	dbm "github.com/cosmos/cosmos-db"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -56,6 +60,7 @@ type Service[
 	logger     LoggerT
 	sm         *statem.Manager
 	Middleware MiddlewareI
+	chainSpec  common.ChainSpec
 
 	// prepareProposalState is used for PrepareProposal, which is set based on
 	// the previous block's state. This state is never committed. In case of
@@ -78,6 +83,28 @@ type Service[
 	interBlockCache storetypes.MultiStorePersistentCache
 	paramStore      *params.ConsensusParamsStore
 
+	// acceptedProposals remembers the proposals accepted at the current
+	// height, so that a block presented again in a later round is not
+	// verified twice.
+	acceptedProposals acceptedProposals
+
+	// voteExtensions produces and verifies the vote extensions carrying
+	// execution observations. It is nil if vote extensions are not wired.
+	voteExtensions *voteext.Handler
+
+	// snapshotManager takes the state sync snapshots offered to other nodes
+	// and restores the ones offered by them. It is nil if state sync is not
+	// wired.
+	snapshotManager *snapshots.Manager
+
+	// halt decides the height at which the node stops finalizing blocks and
+	// shuts down. It is nil if halting is not wired.
+	halt *halt.Controller
+
+	// privValidator signs the votes and proposals of the node. It is loaded
+	// from the CometBFT config if nil.
+	privValidator cmttypes.PrivValidator
+
 	// initialHeight is the initial height at which we start the node
 	initialHeight   int64
 	minRetainBlocks uint64
@@ -103,6 +130,7 @@ func NewService[
 			servercmtlog.WrapSDKLogger(logger),
 		),
 		Middleware: middleware,
+		chainSpec:  cs,
 		cmtCfg:     cmtCfg,
 		paramStore: params.NewConsensusParamsStore(cs),
 	}
@@ -130,18 +158,26 @@ func (s *Service[_]) Start(
 	ctx context.Context,
 ) error {
 	cfg := s.cmtCfg
+	if err := s.recoverConsistency(); err != nil {
+		return err
+	}
+
 	nodeKey, err := p2p.LoadOrGenNodeKey(cfg.NodeKeyFile())
 	if err != nil {
 		return err
 	}
 
+	privValidator := s.privValidator
+	if privValidator == nil {
+		privValidator = pvm.LoadOrGenFilePV(
+			cfg.PrivValidatorKeyFile(),
+			cfg.PrivValidatorStateFile(),
+		)
+	}
 	s.node, err = node.NewNode(
 		ctx,
 		cfg,
-		pvm.LoadOrGenFilePV(
-			cfg.PrivValidatorKeyFile(),
-			cfg.PrivValidatorStateFile(),
-		),
+		privValidator,
 		nodeKey,
 		proxy.NewLocalClientCreator(s),
 		GetGenDocProvider(cfg),
@@ -166,6 +202,13 @@ func (s *Service[_]) Close() error {
 		_ = s.node.Stop()
 	}
 
+	if s.snapshotManager != nil {
+		s.logger.Info("Closing snapshots/metadata.db")
+		if err := s.snapshotManager.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
 	s.logger.Info("Closing application.db")
 	if err := s.sm.Close(); err != nil {
 		errs = append(errs, err)
@@ -189,7 +232,10 @@ func (s *Service[_]) AppVersion(_ context.Context) (uint64, error) {
 }
 
 func (s *Service[_]) appVersion() (uint64, error) {
-	cp := s.paramStore.Get()
+	cp, err := s.paramStore.Get(s.LastBlockHeight() + 1)
+	if err != nil {
+		return 0, err
+	}
 	return cp.Version.App, nil
 }
 
@@ -29,10 +29,6 @@ import (
 	"github.com/berachain/beacon-kit/primitives/common"
 )
 
-// maxExtraDataSize defines the maximum allowed size in bytes for the ExtraData
-// field in the execution payload header.
-const maxExtraDataSize = 32
-
 // validateExecutionHeader validates the provided execution payload header
 // for the genesis block.
 func validateExecutionHeader(header *types.ExecutionPayloadHeader) error {
@@ -110,11 +106,8 @@ func validateExecutionHeader(header *types.ExecutionPayloadHeader) error {
 	// all zeros in a genesis block or in blocks with no logs
 
 	// Extra data length check (max 32 bytes)
-	if len(header.ExtraData) > maxExtraDataSize {
-		return fmt.Errorf(
-			"extra data too long: got %d bytes, max 32 bytes",
-			len(header.ExtraData),
-		)
+	if err := header.ExtraData.Validate(); err != nil {
+		return fmt.Errorf("invalid extra data: %w", err)
 	}
 
 	return nil
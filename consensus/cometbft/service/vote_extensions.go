@@ -0,0 +1,139 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/consensus/cometbft/service/middleware"
+	"github.com/berachain/beacon-kit/consensus/cometbft/service/voteext"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/math"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ExtendVote implements the ExtendVote ABCI method.
+func (s *Service[_]) ExtendVote(
+	ctx context.Context,
+	req *cmtabci.ExtendVoteRequest,
+) (*cmtabci.ExtendVoteResponse, error) {
+	if s.voteExtensions == nil {
+		return &cmtabci.ExtendVoteResponse{}, nil
+	}
+	return s.voteExtensions.ExtendVote(ctx, req)
+}
+
+// VerifyVoteExtension implements the VerifyVoteExtension ABCI method.
+func (s *Service[_]) VerifyVoteExtension(
+	ctx context.Context,
+	req *cmtabci.VerifyVoteExtensionRequest,
+) (*cmtabci.VerifyVoteExtensionResponse, error) {
+	if s.voteExtensions == nil {
+		return &cmtabci.VerifyVoteExtensionResponse{
+			Status: cmtabci.VERIFY_VOTE_EXTENSION_STATUS_ACCEPT,
+		}, nil
+	}
+	return s.voteExtensions.VerifyVoteExtension(ctx, req)
+}
+
+// prepareExtendedCommit aggregates the vote extensions of the previous
+// block's commit into the proposal context and returns the encoded extended
+// commit to append to the proposal. It returns no commit before the vote
+// extensions fork, if vote extensions are disabled locally or if the previous
+// commit carries none.
+func (s *Service[_]) prepareExtendedCommit(
+	ctx sdk.Context,
+	req *cmtabci.PrepareProposalRequest,
+) (sdk.Context, []byte, error) {
+	if !s.extendedCommitAllowed(req.Height) || s.voteExtensions == nil ||
+		!s.voteExtensions.Enabled() || !hasExtensions(req.LocalLastCommit) {
+		return ctx, nil, nil
+	}
+
+	commitBz, err := req.LocalLastCommit.Marshal()
+	if err != nil {
+		return ctx, nil, err
+	}
+
+	agg := voteext.AggregateVotes(req.Height-1, req.LocalLastCommit.Votes)
+	return voteext.ContextWithAggregate(ctx, agg), commitBz, nil
+}
+
+// processExtendedCommit validates the extended commit injected into the
+// proposal, if any, and aggregates its vote extensions into the proposal
+// context. A proposal may only carry an extended commit from the vote
+// extensions fork of the chain spec, and nothing past it, regardless of
+// whether vote extensions are enabled locally.
+func (s *Service[_]) processExtendedCommit(
+	ctx sdk.Context,
+	req *cmtabci.ProcessProposalRequest,
+) (sdk.Context, error) {
+	numTxs := uint(len(req.Txs))
+	switch {
+	case numTxs <= middleware.ExtendedCommitTxIndex:
+		return ctx, nil
+	case numTxs > middleware.ExtendedCommitTxIndex+1:
+		return ctx, errors.Wrapf(errUnexpectedTxs, "%d txs", numTxs)
+	case !s.extendedCommitAllowed(req.Height):
+		return ctx, errors.Wrap(
+			errUnexpectedTxs, "extended commit before the vote extensions fork",
+		)
+	case s.voteExtensions == nil:
+		return ctx, errors.Wrap(
+			errUnexpectedTxs, "extended commit without a vote extension handler",
+		)
+	}
+
+	var extCommit cmtabci.ExtendedCommitInfo
+	if err := extCommit.Unmarshal(
+		req.Txs[middleware.ExtendedCommitTxIndex],
+	); err != nil {
+		return ctx, err
+	}
+	if err := s.voteExtensions.ValidateCommit(
+		ctx, s.chainID, req.Height, &extCommit, req.ProposedLastCommit,
+	); err != nil {
+		return ctx, err
+	}
+
+	agg := voteext.AggregateVotes(req.Height-1, extCommit.Votes)
+	return voteext.ContextWithAggregate(ctx, agg), nil
+}
+
+// extendedCommitAllowed returns whether a proposal for the given height may
+// carry the extended commit of the previous block.
+func (s *Service[_]) extendedCommitAllowed(height int64) bool {
+	//#nosec:G701 // heights are never negative.
+	epoch := s.chainSpec.SlotToEpoch(math.Slot(height))
+	return epoch >= s.chainSpec.VoteExtensionsForkEpoch()
+}
+
+// hasExtensions returns whether any vote of the commit carries a signed vote
+// extension, which is the case once CometBFT has enabled vote extensions.
+func hasExtensions(commit cmtabci.ExtendedCommitInfo) bool {
+	for _, vote := range commit.Votes {
+		if len(vote.ExtensionSignature) > 0 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,103 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package voteext
+
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Aggregate is the execution head attested to by more than two thirds of
+// the voting power of a commit.
+type Aggregate struct {
+	// Slot is the slot of the committed block the votes were cast for.
+	Slot math.Slot
+	// ELBlockNumber is the number of the attested execution head.
+	ELBlockNumber math.U64
+	// ELBlockHash is the hash of the attested execution head.
+	ELBlockHash common.ExecutionHash
+	// Power is the voting power that attested to the execution head.
+	Power int64
+	// TotalPower is the total voting power of the commit.
+	TotalPower int64
+}
+
+// AggregateVotes tallies the vote extensions of a commit for the given
+// height by voting power. It returns the observation backed by more than two
+// thirds of the total voting power, or nil if validators did not converge.
+// Votes whose extension fails to decode count towards the total only.
+func AggregateVotes(
+	height int64,
+	votes []cmtabci.ExtendedVoteInfo,
+) *Aggregate {
+	var (
+		totalPower int64
+		tally      = make(map[Extension]int64)
+	)
+	for _, vote := range votes {
+		totalPower += vote.Validator.Power
+		if vote.BlockIdFlag != cmtproto.BlockIDFlagCommit {
+			continue
+		}
+		ext, err := DecodeExtension(vote.VoteExtension, height)
+		if err != nil || ext == nil {
+			continue
+		}
+		tally[*ext] += vote.Validator.Power
+	}
+
+	// At most one observation can exceed the two thirds threshold, so the
+	// map iteration order does not affect the result.
+	for ext, power := range tally {
+		//nolint:mnd // supermajority.
+		if 3*power > 2*totalPower {
+			return &Aggregate{
+				Slot:          ext.Slot,
+				ELBlockNumber: ext.ELBlockNumber,
+				ELBlockHash:   ext.ELBlockHash,
+				Power:         power,
+				TotalPower:    totalPower,
+			}
+		}
+	}
+	return nil
+}
+
+// aggregateKey is the context key under which the aggregate is stored.
+type aggregateKey struct{}
+
+// ContextWithAggregate returns a copy of the context carrying the aggregate
+// of the previous block's vote extensions.
+func ContextWithAggregate(ctx sdk.Context, agg *Aggregate) sdk.Context {
+	return ctx.WithValue(aggregateKey{}, agg)
+}
+
+// AggregateFromContext returns the aggregate of the previous block's vote
+// extensions, if the proposal being built or processed carries one.
+func AggregateFromContext(ctx context.Context) (*Aggregate, bool) {
+	agg, ok := ctx.Value(aggregateKey{}).(*Aggregate)
+	return agg, ok && agg != nil
+}
@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package voteext
+
+import "github.com/berachain/beacon-kit/errors"
+
+//nolint:gochecknoglobals // errors
+var (
+	// ErrSlotMismatch is returned when a vote extension was produced for a
+	// different slot than the vote it is attached to.
+	ErrSlotMismatch = errors.New("vote extension slot mismatch")
+
+	// ErrCommitMismatch is returned when the extended commit injected by the
+	// proposer does not match the commit recorded by CometBFT.
+	ErrCommitMismatch = errors.New("extended commit does not match last commit")
+
+	// ErrUnexpectedExtension is returned when a vote that did not commit to
+	// the block carries a vote extension.
+	ErrUnexpectedExtension = errors.New("unexpected vote extension")
+
+	// ErrInvalidExtensionSignature is returned when the signature over a vote
+	// extension does not verify against the validator's public key.
+	ErrInvalidExtensionSignature = errors.New(
+		"invalid vote extension signature",
+	)
+
+	// ErrUnknownValidator is returned when no public key is known for a
+	// validator address.
+	ErrUnknownValidator = errors.New("unknown validator")
+)
@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package voteext
+
+import (
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/karalabe/ssz"
+)
+
+// ExtensionSize is the size of the Extension object in bytes.
+// 8 bytes for Slot + 8 bytes for ELBlockNumber + 32 bytes for ELBlockHash.
+const ExtensionSize = 48
+
+var _ ssz.StaticObject = (*Extension)(nil)
+
+// Extension is the auxiliary data a validator attaches to its precommit.
+// It records the execution head observed by the validator's execution
+// client at the time it voted.
+type Extension struct {
+	// Slot is the slot of the block the precommit votes for.
+	Slot math.Slot
+	// ELBlockNumber is the number of the observed execution head.
+	ELBlockNumber math.U64
+	// ELBlockHash is the hash of the observed execution head.
+	ELBlockHash common.ExecutionHash
+}
+
+// DecodeExtension decodes a vote extension produced for the given height.
+// An empty extension is valid and decodes to nil, since a validator whose
+// execution client is unavailable must still be able to precommit.
+func DecodeExtension(bz []byte, height int64) (*Extension, error) {
+	if len(bz) == 0 {
+		return nil, nil //nolint:nilnil // an empty extension is valid.
+	}
+
+	ext := new(Extension)
+	if err := ext.UnmarshalSSZ(bz); err != nil {
+		return nil, err
+	}
+
+	//#nosec:G701 // heights are never negative.
+	if ext.Slot != math.Slot(height) {
+		return nil, ErrSlotMismatch
+	}
+	return ext, nil
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                     SSZ                                    */
+/* -------------------------------------------------------------------------- */
+
+// SizeSSZ returns the SSZ encoded size of the Extension object in bytes.
+func (e *Extension) SizeSSZ(*ssz.Sizer) uint32 {
+	return ExtensionSize
+}
+
+// DefineSSZ defines the SSZ encoding for the Extension object.
+func (e *Extension) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineUint64(codec, &e.Slot)
+	ssz.DefineUint64(codec, &e.ELBlockNumber)
+	ssz.DefineStaticBytes(codec, &e.ELBlockHash)
+}
+
+// MarshalSSZ marshals the Extension object to SSZ format.
+func (e *Extension) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, ssz.Size(e))
+	return buf, ssz.EncodeToBytes(buf, e)
+}
+
+// UnmarshalSSZ unmarshals the Extension object from SSZ format.
+func (e *Extension) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, e)
+}
@@ -0,0 +1,226 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package voteext
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	cmtcrypto "github.com/cometbft/cometbft/crypto"
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+// Observer reports the local execution client's view of the chain.
+type Observer interface {
+	// LatestExecutionHead returns the number and hash of the latest block
+	// known to the execution client.
+	LatestExecutionHead(
+		ctx context.Context,
+	) (math.U64, common.ExecutionHash, error)
+}
+
+// ValidatorStore resolves CometBFT validator addresses to the public keys
+// that sign their votes.
+type ValidatorStore interface {
+	// PubKeysByAddress returns the public keys of the validators in the
+	// state carried by the context, keyed by their CometBFT address.
+	PubKeysByAddress(
+		ctx context.Context,
+	) (map[string]cmtcrypto.PubKey, error)
+}
+
+// Handler produces, verifies and validates vote extensions. Extensions of
+// other validators are always verified, so that every node agrees on the
+// validity of proposals regardless of whether it produces extensions itself.
+type Handler struct {
+	cfg        Config
+	logger     log.Logger
+	observer   Observer
+	validators ValidatorStore
+}
+
+// NewHandler creates a new vote extension handler.
+func NewHandler(
+	cfg Config,
+	logger log.Logger,
+	observer Observer,
+	validators ValidatorStore,
+) *Handler {
+	return &Handler{
+		cfg:        cfg,
+		logger:     logger,
+		observer:   observer,
+		validators: validators,
+	}
+}
+
+// Enabled returns whether the node produces and aggregates vote extensions.
+func (h *Handler) Enabled() bool {
+	return h.cfg.Enabled
+}
+
+// ExtendVote attaches the execution head observed by the local execution
+// client to the precommit. If the execution client cannot be reached the
+// extension is left empty rather than failing the vote.
+func (h *Handler) ExtendVote(
+	ctx context.Context,
+	req *cmtabci.ExtendVoteRequest,
+) (*cmtabci.ExtendVoteResponse, error) {
+	if !h.cfg.Enabled {
+		return &cmtabci.ExtendVoteResponse{}, nil
+	}
+
+	number, hash, err := h.observer.LatestExecutionHead(ctx)
+	if err != nil {
+		h.logger.Warn(
+			"Failed to observe execution head for vote extension",
+			"height", req.Height,
+			"error", err,
+		)
+		return &cmtabci.ExtendVoteResponse{}, nil
+	}
+
+	ext := &Extension{
+		//#nosec:G701 // heights are never negative.
+		Slot:          math.Slot(req.Height),
+		ELBlockNumber: number,
+		ELBlockHash:   hash,
+	}
+	bz, err := ext.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	return &cmtabci.ExtendVoteResponse{VoteExtension: bz}, nil
+}
+
+// VerifyVoteExtension checks that a vote extension received from a peer is
+// well formed and was produced for the height being voted on. The
+// observation itself is not checked, since execution clients may lag one
+// another.
+func (h *Handler) VerifyVoteExtension(
+	_ context.Context,
+	req *cmtabci.VerifyVoteExtensionRequest,
+) (*cmtabci.VerifyVoteExtensionResponse, error) {
+	if _, err := DecodeExtension(req.VoteExtension, req.Height); err != nil {
+		h.logger.Warn(
+			"Rejecting vote extension",
+			"height", req.Height,
+			"validator", fmt.Sprintf("%X", req.ValidatorAddress),
+			"error", err,
+		)
+		return &cmtabci.VerifyVoteExtensionResponse{
+			Status: cmtabci.VERIFY_VOTE_EXTENSION_STATUS_REJECT,
+		}, nil
+	}
+	return &cmtabci.VerifyVoteExtensionResponse{
+		Status: cmtabci.VERIFY_VOTE_EXTENSION_STATUS_ACCEPT,
+	}, nil
+}
+
+// ValidateCommit checks an extended commit injected into the proposal for
+// the given height against the last commit recorded by CometBFT, and
+// verifies the signature of every vote extension it carries. Only then can
+// the extensions be trusted, since the proposer alone chose them.
+func (h *Handler) ValidateCommit(
+	ctx context.Context,
+	chainID string,
+	height int64,
+	extCommit *cmtabci.ExtendedCommitInfo,
+	lastCommit cmtabci.CommitInfo,
+) error {
+	if extCommit.Round != lastCommit.Round {
+		return errors.Wrapf(
+			ErrCommitMismatch, "round %d, expected %d",
+			extCommit.Round, lastCommit.Round,
+		)
+	}
+	if len(extCommit.Votes) != len(lastCommit.Votes) {
+		return errors.Wrapf(
+			ErrCommitMismatch, "%d votes, expected %d",
+			len(extCommit.Votes), len(lastCommit.Votes),
+		)
+	}
+
+	// Resolve the keys of the validators once for the whole commit.
+	pubKeys, err := h.validators.PubKeysByAddress(ctx)
+	if err != nil {
+		return err
+	}
+	for i, vote := range extCommit.Votes {
+		want := lastCommit.Votes[i]
+		if !bytes.Equal(vote.Validator.Address, want.Validator.Address) ||
+			vote.Validator.Power != want.Validator.Power ||
+			vote.BlockIdFlag != want.BlockIdFlag {
+			return errors.Wrapf(ErrCommitMismatch, "vote %d", i)
+		}
+
+		if vote.BlockIdFlag != cmtproto.BlockIDFlagCommit {
+			if len(vote.VoteExtension) > 0 ||
+				len(vote.ExtensionSignature) > 0 {
+				return errors.Wrapf(ErrUnexpectedExtension, "vote %d", i)
+			}
+			continue
+		}
+
+		if err = verifyVote(
+			pubKeys, chainID, height-1, extCommit.Round, vote,
+		); err != nil {
+			return errors.Wrapf(err, "vote %d", i)
+		}
+	}
+	return nil
+}
+
+// verifyVote verifies the extension of a vote cast at the given height and
+// round against the public key of its validator.
+func verifyVote(
+	pubKeys map[string]cmtcrypto.PubKey,
+	chainID string,
+	height int64,
+	round int32,
+	vote cmtabci.ExtendedVoteInfo,
+) error {
+	if _, err := DecodeExtension(vote.VoteExtension, height); err != nil {
+		return err
+	}
+
+	pubKey, ok := pubKeys[string(vote.Validator.Address)]
+	if !ok {
+		return ErrUnknownValidator
+	}
+
+	signBytes := cmttypes.VoteExtensionSignBytes(chainID, &cmtproto.Vote{
+		Extension: vote.VoteExtension,
+		Height:    height,
+		Round:     round,
+	})
+	if !pubKey.VerifySignature(signBytes, vote.ExtensionSignature) {
+		return ErrInvalidExtensionSignature
+	}
+	return nil
+}
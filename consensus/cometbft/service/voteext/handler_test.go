@@ -0,0 +1,383 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package voteext_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/berachain/beacon-kit/consensus/cometbft/service/voteext"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	cmtcrypto "github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	cmttypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testChainID = "beacond-test"
+	testHeight  = int64(10)
+	testRound   = int32(1)
+)
+
+var testHash = common.ExecutionHash{0x01, 0x02, 0x03}
+
+type mockObserver struct {
+	number math.U64
+	hash   common.ExecutionHash
+	err    error
+}
+
+func (o *mockObserver) LatestExecutionHead(
+	context.Context,
+) (math.U64, common.ExecutionHash, error) {
+	return o.number, o.hash, o.err
+}
+
+type mockValidatorStore map[string]cmtcrypto.PubKey
+
+func (s mockValidatorStore) PubKeysByAddress(
+	context.Context,
+) (map[string]cmtcrypto.PubKey, error) {
+	return s, nil
+}
+
+// countingValidatorStore counts the lookups of the validator keys.
+type countingValidatorStore struct {
+	mockValidatorStore
+	calls int
+}
+
+func (s *countingValidatorStore) PubKeysByAddress(
+	ctx context.Context,
+) (map[string]cmtcrypto.PubKey, error) {
+	s.calls++
+	return s.mockValidatorStore.PubKeysByAddress(ctx)
+}
+
+func encodeExtension(t *testing.T, ext *voteext.Extension) []byte {
+	t.Helper()
+	bz, err := ext.MarshalSSZ()
+	require.NoError(t, err)
+	return bz
+}
+
+// signedCommit builds the extended commit of testHeight signed by the given
+// keys, along with the matching commit recorded by CometBFT.
+func signedCommit(
+	t *testing.T,
+	keys []ed25519.PrivKey,
+	ext []byte,
+) (*cmtabci.ExtendedCommitInfo, cmtabci.CommitInfo) {
+	t.Helper()
+	signBytes := cmttypes.VoteExtensionSignBytes(testChainID, &cmtproto.Vote{
+		Extension: ext,
+		Height:    testHeight,
+		Round:     testRound,
+	})
+
+	extCommit := &cmtabci.ExtendedCommitInfo{Round: testRound}
+	lastCommit := cmtabci.CommitInfo{Round: testRound}
+	for _, key := range keys {
+		sig, err := key.Sign(signBytes)
+		require.NoError(t, err)
+		validator := cmtabci.Validator{
+			Address: key.PubKey().Address(),
+			Power:   10,
+		}
+		extCommit.Votes = append(extCommit.Votes, cmtabci.ExtendedVoteInfo{
+			Validator:          validator,
+			VoteExtension:      ext,
+			ExtensionSignature: sig,
+			BlockIdFlag:        cmtproto.BlockIDFlagCommit,
+		})
+		lastCommit.Votes = append(lastCommit.Votes, cmtabci.VoteInfo{
+			Validator:   validator,
+			BlockIdFlag: cmtproto.BlockIDFlagCommit,
+		})
+	}
+	return extCommit, lastCommit
+}
+
+func newHandler(
+	t *testing.T,
+	keys []ed25519.PrivKey,
+) *voteext.Handler {
+	t.Helper()
+	store := make(mockValidatorStore)
+	for _, key := range keys {
+		store[string(key.PubKey().Address())] = key.PubKey()
+	}
+	return voteext.NewHandler(
+		voteext.Config{Enabled: true},
+		noop.NewLogger[any](),
+		&mockObserver{number: 42, hash: testHash},
+		store,
+	)
+}
+
+func TestExtension_RoundTrip(t *testing.T) {
+	ext := &voteext.Extension{
+		Slot:          math.Slot(testHeight),
+		ELBlockNumber: 42,
+		ELBlockHash:   testHash,
+	}
+	bz := encodeExtension(t, ext)
+	require.Len(t, bz, voteext.ExtensionSize)
+
+	decoded, err := voteext.DecodeExtension(bz, testHeight)
+	require.NoError(t, err)
+	require.Equal(t, ext, decoded)
+
+	_, err = voteext.DecodeExtension(bz, testHeight+1)
+	require.ErrorIs(t, err, voteext.ErrSlotMismatch)
+
+	_, err = voteext.DecodeExtension(bz[:voteext.ExtensionSize-1], testHeight)
+	require.Error(t, err)
+
+	decoded, err = voteext.DecodeExtension(nil, testHeight)
+	require.NoError(t, err)
+	require.Nil(t, decoded)
+}
+
+func TestHandler_ExtendVote(t *testing.T) {
+	h := newHandler(t, nil)
+	res, err := h.ExtendVote(
+		context.Background(),
+		&cmtabci.ExtendVoteRequest{Height: testHeight},
+	)
+	require.NoError(t, err)
+
+	ext, err := voteext.DecodeExtension(res.VoteExtension, testHeight)
+	require.NoError(t, err)
+	require.Equal(t, math.U64(42), ext.ELBlockNumber)
+	require.Equal(t, testHash, ext.ELBlockHash)
+
+	verify, err := h.VerifyVoteExtension(
+		context.Background(),
+		&cmtabci.VerifyVoteExtensionRequest{
+			Height:        testHeight,
+			VoteExtension: res.VoteExtension,
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(
+		t, cmtabci.VERIFY_VOTE_EXTENSION_STATUS_ACCEPT, verify.Status,
+	)
+
+	verify, err = h.VerifyVoteExtension(
+		context.Background(),
+		&cmtabci.VerifyVoteExtensionRequest{
+			Height:        testHeight + 1,
+			VoteExtension: res.VoteExtension,
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(
+		t, cmtabci.VERIFY_VOTE_EXTENSION_STATUS_REJECT, verify.Status,
+	)
+}
+
+func TestHandler_ExtendVoteWithoutExecutionClient(t *testing.T) {
+	h := voteext.NewHandler(
+		voteext.Config{Enabled: true},
+		noop.NewLogger[any](),
+		&mockObserver{err: errors.New("connection refused")},
+		make(mockValidatorStore),
+	)
+	res, err := h.ExtendVote(
+		context.Background(),
+		&cmtabci.ExtendVoteRequest{Height: testHeight},
+	)
+	require.NoError(t, err)
+	require.Empty(t, res.VoteExtension)
+}
+
+func TestHandler_ValidateCommit(t *testing.T) {
+	keys := []ed25519.PrivKey{
+		ed25519.GenPrivKey(), ed25519.GenPrivKey(), ed25519.GenPrivKey(),
+	}
+	h := newHandler(t, keys)
+	ext := encodeExtension(t, &voteext.Extension{
+		Slot:          math.Slot(testHeight),
+		ELBlockNumber: 42,
+		ELBlockHash:   testHash,
+	})
+
+	t.Run("valid", func(t *testing.T) {
+		extCommit, lastCommit := signedCommit(t, keys, ext)
+		require.NoError(t, h.ValidateCommit(
+			context.Background(), testChainID, testHeight+1,
+			extCommit, lastCommit,
+		))
+	})
+
+	t.Run("tampered extension", func(t *testing.T) {
+		extCommit, lastCommit := signedCommit(t, keys, ext)
+		tampered := encodeExtension(t, &voteext.Extension{
+			Slot:          math.Slot(testHeight),
+			ELBlockNumber: 43,
+			ELBlockHash:   testHash,
+		})
+		extCommit.Votes[1].VoteExtension = tampered
+		err := h.ValidateCommit(
+			context.Background(), testChainID, testHeight+1,
+			extCommit, lastCommit,
+		)
+		require.ErrorIs(t, err, voteext.ErrInvalidExtensionSignature)
+	})
+
+	t.Run("wrong chain", func(t *testing.T) {
+		extCommit, lastCommit := signedCommit(t, keys, ext)
+		err := h.ValidateCommit(
+			context.Background(), "other-chain", testHeight+1,
+			extCommit, lastCommit,
+		)
+		require.ErrorIs(t, err, voteext.ErrInvalidExtensionSignature)
+	})
+
+	t.Run("unknown validator", func(t *testing.T) {
+		outsider := ed25519.GenPrivKey()
+		extCommit, lastCommit := signedCommit(
+			t, append(keys[:2:2], outsider), ext,
+		)
+		err := h.ValidateCommit(
+			context.Background(), testChainID, testHeight+1,
+			extCommit, lastCommit,
+		)
+		require.ErrorIs(t, err, voteext.ErrUnknownValidator)
+	})
+
+	t.Run("vote omitted", func(t *testing.T) {
+		extCommit, lastCommit := signedCommit(t, keys, ext)
+		extCommit.Votes = extCommit.Votes[:2]
+		err := h.ValidateCommit(
+			context.Background(), testChainID, testHeight+1,
+			extCommit, lastCommit,
+		)
+		require.ErrorIs(t, err, voteext.ErrCommitMismatch)
+	})
+
+	t.Run("power inflated", func(t *testing.T) {
+		extCommit, lastCommit := signedCommit(t, keys, ext)
+		extCommit.Votes[0].Validator.Power = 100
+		err := h.ValidateCommit(
+			context.Background(), testChainID, testHeight+1,
+			extCommit, lastCommit,
+		)
+		require.ErrorIs(t, err, voteext.ErrCommitMismatch)
+	})
+
+	t.Run("extension on absent vote", func(t *testing.T) {
+		extCommit, lastCommit := signedCommit(t, keys, ext)
+		extCommit.Votes[2].BlockIdFlag = cmtproto.BlockIDFlagAbsent
+		lastCommit.Votes[2].BlockIdFlag = cmtproto.BlockIDFlagAbsent
+		err := h.ValidateCommit(
+			context.Background(), testChainID, testHeight+1,
+			extCommit, lastCommit,
+		)
+		require.ErrorIs(t, err, voteext.ErrUnexpectedExtension)
+	})
+}
+
+func TestHandler_ValidateCommitResolvesKeysOnce(t *testing.T) {
+	keys := []ed25519.PrivKey{
+		ed25519.GenPrivKey(), ed25519.GenPrivKey(),
+		ed25519.GenPrivKey(), ed25519.GenPrivKey(),
+	}
+	store := &countingValidatorStore{mockValidatorStore: make(mockValidatorStore)}
+	for _, key := range keys {
+		store.mockValidatorStore[string(key.PubKey().Address())] = key.PubKey()
+	}
+	h := voteext.NewHandler(
+		voteext.Config{Enabled: true},
+		noop.NewLogger[any](),
+		&mockObserver{number: 42, hash: testHash},
+		store,
+	)
+
+	ext := encodeExtension(t, &voteext.Extension{
+		Slot:          math.Slot(testHeight),
+		ELBlockNumber: 42,
+		ELBlockHash:   testHash,
+	})
+	extCommit, lastCommit := signedCommit(t, keys, ext)
+	require.NoError(t, h.ValidateCommit(
+		context.Background(), testChainID, testHeight+1,
+		extCommit, lastCommit,
+	))
+	require.Equal(t, 1, store.calls)
+}
+
+func TestAggregateVotes(t *testing.T) {
+	keys := []ed25519.PrivKey{
+		ed25519.GenPrivKey(), ed25519.GenPrivKey(), ed25519.GenPrivKey(),
+	}
+	ext := encodeExtension(t, &voteext.Extension{
+		Slot:          math.Slot(testHeight),
+		ELBlockNumber: 42,
+		ELBlockHash:   testHash,
+	})
+	other := encodeExtension(t, &voteext.Extension{
+		Slot:          math.Slot(testHeight),
+		ELBlockNumber: 41,
+	})
+
+	extCommit, _ := signedCommit(t, keys, ext)
+	agg := voteext.AggregateVotes(testHeight, extCommit.Votes)
+	require.NotNil(t, agg)
+	require.Equal(t, math.U64(42), agg.ELBlockNumber)
+	require.Equal(t, testHash, agg.ELBlockHash)
+	require.Equal(t, int64(30), agg.Power)
+	require.Equal(t, int64(30), agg.TotalPower)
+
+	// Two thirds of the voting power is not a supermajority.
+	extCommit.Votes[2].VoteExtension = other
+	require.Nil(t, voteext.AggregateVotes(testHeight, extCommit.Votes))
+
+	// Extensions for another height are not counted.
+	extCommit, _ = signedCommit(t, keys, ext)
+	require.Nil(t, voteext.AggregateVotes(testHeight+1, extCommit.Votes))
+}
+
+func TestAggregateFromContext(t *testing.T) {
+	ctx := sdk.Context{}.WithContext(context.Background())
+	_, ok := voteext.AggregateFromContext(ctx)
+	require.False(t, ok)
+
+	_, ok = voteext.AggregateFromContext(
+		voteext.ContextWithAggregate(ctx, nil),
+	)
+	require.False(t, ok)
+
+	want := &voteext.Aggregate{Slot: 1, ELBlockNumber: 42}
+	got, ok := voteext.AggregateFromContext(
+		voteext.ContextWithAggregate(ctx, want),
+	)
+	require.True(t, ok)
+	require.Equal(t, want, got)
+}
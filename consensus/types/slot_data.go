@@ -34,6 +34,9 @@ type SlotData[AttestationDataT, SlashingInfoT any] struct {
 	attestationData []AttestationDataT
 	// slashingInfo is the slashing info of the incoming slot.
 	slashingInfo []SlashingInfoT
+	// maxBytes is the number of bytes the encoded beacon block and blob
+	// sidecars may occupy in the proposal. Zero means unbounded.
+	maxBytes uint64
 
 	// some consensus data useful to build and verify the block
 	*commonConsensusData
@@ -93,3 +96,17 @@ func (b *SlotData[AttestationDataT, SlashingInfoT]) SetSlashingInfo(
 ) {
 	b.slashingInfo = slashingInfo
 }
+
+// GetMaxBytes retrieves the number of bytes the encoded beacon block and blob
+// sidecars may occupy in the proposal. Zero means unbounded.
+func (b *SlotData[AttestationDataT, SlashingInfoT]) GetMaxBytes() uint64 {
+	return b.maxBytes
+}
+
+// SetMaxBytes sets the number of bytes the encoded beacon block and blob
+// sidecars may occupy in the proposal.
+func (b *SlotData[AttestationDataT, SlashingInfoT]) SetMaxBytes(
+	maxBytes uint64,
+) {
+	b.maxBytes = maxBytes
+}
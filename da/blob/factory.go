@@ -111,6 +111,14 @@ func (f *SidecarFactory[BeaconBlockT, _, _]) BuildSidecars(
 	return &types.BlobSidecars{Sidecars: sidecars}, g.Wait()
 }
 
+// SidecarsSize returns the size of the SSZ encoding of the sidecars built for
+// the given blobs bundle.
+func (f *SidecarFactory[_, _, _]) SidecarsSize(
+	bundle engineprimitives.BlobsBundle,
+) uint64 {
+	return types.BlobSidecarsSize(len(bundle.GetBlobs()))
+}
+
 // BuildKZGInclusionProof builds a KZG inclusion proof.
 func (f *SidecarFactory[_, BeaconBlockBodyT, _]) BuildKZGInclusionProof(
 	body BeaconBlockBodyT,
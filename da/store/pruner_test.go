@@ -30,6 +30,7 @@ import (
 	"github.com/berachain/beacon-kit/primitives/bytes"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/signing"
 	"github.com/stretchr/testify/require"
 )
 
@@ -105,6 +106,7 @@ func TestBuildPruneRangeFn(t *testing.T) {
 			expectedEnd:   45,
 		},
 	}
+	dt := signing.DefaultDomainTypes()
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			cs, err := chain.NewChainSpec(
@@ -114,6 +116,14 @@ func TestBuildPruneRangeFn(t *testing.T) {
 					SlotsPerEpoch:                    tt.slotsPerEpoch,
 					MinEpochsForBlobsSidecarsRequest: tt.minEpochs,
 					MaxWithdrawalsPerPayload:         2,
+					SlotDurationMs:                   2000,
+					DomainTypeProposer:               dt.Proposer,
+					DomainTypeAttester:               dt.Attester,
+					DomainTypeRandao:                 dt.Randao,
+					DomainTypeDeposit:                dt.Deposit,
+					DomainTypeVoluntaryExit:          dt.VoluntaryExit,
+					DomainTypeSelectionProof:         dt.SelectionProof,
+					DomainTypeAggregateAndProof:      dt.AggregateAndProof,
 				},
 			)
 			require.NoError(t, err)
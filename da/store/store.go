@@ -27,6 +27,8 @@ import (
 	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/eip4844"
+	"github.com/berachain/beacon-kit/primitives/encoding/compress"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/sourcegraph/conc/iter"
 )
@@ -71,6 +73,24 @@ func (s *Store[BeaconBlockBodyT]) IsDataAvailable(
 	return true
 }
 
+// Sidecar returns the blob sidecar stored for the given slot and commitment.
+// Sidecars are stored snappy compressed, those stored before compression was
+// introduced are read as is.
+func (s *Store[BeaconBlockT]) Sidecar(
+	slot math.Slot,
+	commitment eip4844.KZGCommitment,
+) (*types.BlobSidecar, error) {
+	bz, err := s.Get(slot.Unwrap(), commitment[:])
+	if err != nil {
+		return nil, err
+	}
+	if bz, err = compress.Decompress(bz); err != nil {
+		return nil, err
+	}
+	sc := new(types.BlobSidecar)
+	return sc, sc.UnmarshalSSZ(bz)
+}
+
 // Persist ensures the sidecar data remains accessible, utilizing parallel
 // processing for efficiency.
 func (s *Store[BeaconBlockT]) Persist(
@@ -106,6 +126,9 @@ func (s *Store[BeaconBlockT]) Persist(
 			if err != nil {
 				return err
 			}
+			if bz, err = compress.Compress(bz); err != nil {
+				return err
+			}
 			return s.Set(slot.Unwrap(), sc.KzgCommitment[:], bz)
 		},
 	)...); err != nil {
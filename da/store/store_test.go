@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package store_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/config/spec"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/da/store"
+	"github.com/berachain/beacon-kit/da/types"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/eip4844"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/storage/filedb"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStoreCompressesSidecars(t *testing.T) {
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+	rdb := filedb.NewRangeDB(filedb.NewDB(
+		filedb.WithRootDirectory("/blobs"),
+		filedb.WithFileExtension("ssz"),
+		filedb.WithDirectoryPermissions(0700),
+		filedb.WithLogger(log.NewNopLogger()),
+		filedb.WithAferoFS(afero.NewMemMapFs()),
+	))
+	s := store.New[*ctypes.BeaconBlockBody](
+		rdb, noop.NewLogger[any](), cs,
+	)
+
+	slot := math.Slot(3)
+	sidecars := make([]*types.BlobSidecar, 2)
+	for i := range sidecars {
+		sidecars[i] = types.BuildBlobSidecar(
+			math.U64(i),
+			&ctypes.BeaconBlockHeader{Slot: slot},
+			&eip4844.Blob{byte(i)},
+			eip4844.KZGCommitment{byte(i)},
+			eip4844.KZGProof{},
+			make([]common.Root, 8),
+		)
+	}
+	require.NoError(t, s.Persist(
+		slot, &types.BlobSidecars{Sidecars: sidecars},
+	))
+
+	for _, sc := range sidecars {
+		bz, rErr := rdb.Get(slot.Unwrap(), sc.KzgCommitment[:])
+		require.NoError(t, rErr)
+		require.Less(t, len(bz), types.BlobSidecarSize)
+
+		got, rErr := s.Sidecar(slot, sc.KzgCommitment)
+		require.NoError(t, rErr)
+		require.Equal(t, sc, got)
+	}
+
+	// Sidecars stored before compression was introduced are still read.
+	legacy := sidecars[0]
+	legacy.KzgCommitment = eip4844.KZGCommitment{0xff}
+	bz, err := legacy.MarshalSSZ()
+	require.NoError(t, err)
+	require.NoError(t, rdb.Set(slot.Unwrap(), legacy.KzgCommitment[:], bz))
+
+	got, err := s.Sidecar(slot, legacy.KzgCommitment)
+	require.NoError(t, err)
+	require.Equal(t, legacy, got)
+}
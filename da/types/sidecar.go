@@ -29,6 +29,14 @@ import (
 	"github.com/karalabe/ssz"
 )
 
+// BlobSidecarSize is the size of the SSZ encoding of a BlobSidecar.
+const BlobSidecarSize = 8 + // Index
+	131072 + // Blob
+	48 + // KzgCommitment
+	48 + // KzgProof
+	112 + // BeaconBlockHeader
+	8*32 // InclusionProof
+
 // BlobSidecar as per the Ethereum 2.0 specification:
 // https://github.com/ethereum/consensus-specs/blob/dev/specs/deneb/p2p-interface.md?ref=bankless.ghost.io#blobsidecar
 //
@@ -120,12 +128,7 @@ func (b *BlobSidecar) DefineSSZ(codec *ssz.Codec) {
 
 // SizeSSZ returns the size of the BlobSidecar object in SSZ encoding.
 func (b *BlobSidecar) SizeSSZ(*ssz.Sizer) uint32 {
-	return 8 + // Index
-		131072 + // Blob
-		48 + // KzgCommitment
-		48 + // KzgProof
-		112 + // BeaconBlockHeader
-		8*32 // InclusionProof
+	return BlobSidecarSize
 }
 
 // MarshalSSZ marshals the BlobSidecar object to SSZ format.
@@ -27,6 +27,13 @@ import (
 	"github.com/sourcegraph/conc/iter"
 )
 
+// BlobSidecarsSize returns the size of the SSZ encoding of BlobSidecars
+// holding the given number of sidecars.
+func BlobSidecarsSize(numSidecars int) uint64 {
+	//#nosec:G701 // lengths are never negative.
+	return 4 + uint64(numSidecars)*BlobSidecarSize
+}
+
 // BlobSidecars is a slice of blob side cars to be included in the block.
 type BlobSidecars struct {
 	// Sidecars is a slice of blob side cars to be included in the block.
@@ -143,3 +143,29 @@ func TestValidateBlockRoots(t *testing.T) {
 		"Validating sidecar with invalid roots should produce an error",
 	)
 }
+
+func TestBlobSidecarsSize(t *testing.T) {
+	inclusionProof := make([]common.Root, 8)
+	sidecar := types.BuildBlobSidecar(
+		math.U64(0),
+		&ctypes.BeaconBlockHeader{},
+		&eip4844.Blob{},
+		eip4844.KZGCommitment{},
+		eip4844.KZGProof{},
+		inclusionProof,
+	)
+
+	for _, numSidecars := range []int{0, 1, 6} {
+		sidecars := &types.BlobSidecars{
+			Sidecars: make([]*types.BlobSidecar, numSidecars),
+		}
+		for i := range numSidecars {
+			sidecars.Sidecars[i] = sidecar
+		}
+		bz, err := sidecars.MarshalSSZ()
+		require.NoError(t, err)
+		require.Equal(
+			t, uint64(len(bz)), types.BlobSidecarsSize(numSidecars),
+		)
+	}
+}
@@ -23,7 +23,6 @@ package engineprimitives
 import (
 	stdbytes "bytes"
 	"math/big"
-	"unsafe"
 
 	"github.com/berachain/beacon-kit/errors"
 	gethprimitives "github.com/berachain/beacon-kit/geth-primitives"
@@ -132,10 +131,6 @@ func (n *NewPayloadRequest[ExecutionPayloadT, WithdrawalsT]) HasValidVersionedAn
 	var (
 		blobHashes = make([]gethprimitives.ExecutionHash, 0)
 		payload    = n.ExecutionPayload
-		txs        = make(
-			[]*gethprimitives.Transaction,
-			len(payload.GetTransactions()),
-		)
 	)
 
 	// Extracts and validates the blob hashes from the transactions in the
@@ -146,7 +141,6 @@ func (n *NewPayloadRequest[ExecutionPayloadT, WithdrawalsT]) HasValidVersionedAn
 			return errors.Wrapf(err, "invalid transaction %d", i)
 		}
 		blobHashes = append(blobHashes, tx.BlobHashes()...)
-		txs[i] = &tx
 	}
 
 	// Check if the number of blob hashes matches the number of versioned
@@ -173,42 +167,37 @@ func (n *NewPayloadRequest[ExecutionPayloadT, WithdrawalsT]) HasValidVersionedAn
 		}
 	}
 
-	wds := payload.GetWithdrawals()
-	withdrawalsHash := gethprimitives.DeriveSha(
-		wds,
-		gethprimitives.NewStackTrie(nil),
+	// The transactions and withdrawals roots are derived from their encoded
+	// form, the block hash only commits to the header.
+	withdrawalsHash := gethprimitives.ExecutionHash(
+		DeriveTrieRoot(payload.GetWithdrawals()),
 	)
 
 	// Verify that the payload is telling the truth about it's block hash.
-	//#nosec:G103 // its okay.
-	if block := gethprimitives.NewBlockWithHeader(
-		&gethprimitives.Header{
-			ParentHash:       gethprimitives.ExecutionHash(payload.GetParentHash()),
-			UncleHash:        gethprimitives.EmptyUncleHash,
-			Coinbase:         gethprimitives.ExecutionAddress(payload.GetFeeRecipient()),
-			Root:             gethprimitives.ExecutionHash(payload.GetStateRoot()),
-			TxHash:           gethprimitives.DeriveSha(gethprimitives.Transactions(txs), gethprimitives.NewStackTrie(nil)),
-			ReceiptHash:      gethprimitives.ExecutionHash(payload.GetReceiptsRoot()),
-			Bloom:            gethprimitives.LogsBloom(payload.GetLogsBloom()),
-			Difficulty:       big.NewInt(0),
-			Number:           new(big.Int).SetUint64(payload.GetNumber().Unwrap()),
-			GasLimit:         payload.GetGasLimit().Unwrap(),
-			GasUsed:          payload.GetGasUsed().Unwrap(),
-			Time:             payload.GetTimestamp().Unwrap(),
-			BaseFee:          payload.GetBaseFeePerGas().ToBig(),
-			Extra:            payload.GetExtraData(),
-			MixDigest:        gethprimitives.ExecutionHash(payload.GetPrevRandao()),
-			WithdrawalsHash:  &withdrawalsHash,
-			ExcessBlobGas:    payload.GetExcessBlobGas().UnwrapPtr(),
-			BlobGasUsed:      payload.GetBlobGasUsed().UnwrapPtr(),
-			ParentBeaconRoot: (*gethprimitives.ExecutionHash)(n.ParentBeaconBlockRoot),
-		},
-	).WithBody(gethprimitives.Body{
-		Transactions: txs, Uncles: nil, Withdrawals: *(*gethprimitives.Withdrawals)(unsafe.Pointer(&wds)),
-	}); common.ExecutionHash(block.Hash()) != payload.GetBlockHash() {
+	if blockHash := (&gethprimitives.Header{
+		ParentHash:       gethprimitives.ExecutionHash(payload.GetParentHash()),
+		UncleHash:        gethprimitives.EmptyUncleHash,
+		Coinbase:         gethprimitives.ExecutionAddress(payload.GetFeeRecipient()),
+		Root:             gethprimitives.ExecutionHash(payload.GetStateRoot()),
+		TxHash:           gethprimitives.ExecutionHash(payload.GetTransactions().TrieRoot()),
+		ReceiptHash:      gethprimitives.ExecutionHash(payload.GetReceiptsRoot()),
+		Bloom:            gethprimitives.LogsBloom(payload.GetLogsBloom()),
+		Difficulty:       big.NewInt(0),
+		Number:           new(big.Int).SetUint64(payload.GetNumber().Unwrap()),
+		GasLimit:         payload.GetGasLimit().Unwrap(),
+		GasUsed:          payload.GetGasUsed().Unwrap(),
+		Time:             payload.GetTimestamp().Unwrap(),
+		BaseFee:          payload.GetBaseFeePerGas().ToBig(),
+		Extra:            payload.GetExtraData(),
+		MixDigest:        gethprimitives.ExecutionHash(payload.GetPrevRandao()),
+		WithdrawalsHash:  &withdrawalsHash,
+		ExcessBlobGas:    payload.GetExcessBlobGas().UnwrapPtr(),
+		BlobGasUsed:      payload.GetBlobGasUsed().UnwrapPtr(),
+		ParentBeaconRoot: (*gethprimitives.ExecutionHash)(n.ParentBeaconBlockRoot),
+	}).Hash(); common.ExecutionHash(blockHash) != payload.GetBlockHash() {
 		return errors.Wrapf(ErrPayloadBlockHashMismatch,
 			"%x, got %x",
-			payload.GetBlockHash(), block.Hash(),
+			payload.GetBlockHash(), blockHash,
 		)
 	}
 	return nil
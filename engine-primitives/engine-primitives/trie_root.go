@@ -0,0 +1,100 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package engineprimitives
+
+import (
+	"bytes"
+
+	gethprimitives "github.com/berachain/beacon-kit/geth-primitives"
+	"github.com/berachain/beacon-kit/primitives/common"
+)
+
+// rlpIndexBoundary is the first index whose RLP encoding sorts after the
+// encoding of index 0.
+const rlpIndexBoundary = 0x80
+
+// IndexEncodable is a list whose elements can be RLP encoded by index.
+type IndexEncodable interface {
+	Len() int
+	EncodeIndex(int, *bytes.Buffer)
+}
+
+// TrieRoot returns the Merkle Patricia trie root of the transactions, as
+// committed to by the execution block header. The transactions are inserted
+// in their encoded form, without decoding or copying them.
+func (txs Transactions) TrieRoot() common.ExecutionHash {
+	return deriveTrieRoot(len(txs), func(i int) []byte {
+		return txs[i]
+	})
+}
+
+// TrieRoot returns the Merkle Patricia trie root of the withdrawals, as
+// committed to by the execution block header.
+func (w Withdrawals) TrieRoot() common.ExecutionHash {
+	return DeriveTrieRoot(w)
+}
+
+// DeriveTrieRoot returns the Merkle Patricia trie root of the given list, as
+// computed by geth's DeriveSha. All elements are encoded into a single
+// buffer, rather than being encoded and copied one at a time.
+func DeriveTrieRoot(list IndexEncodable) common.ExecutionHash {
+	var buf bytes.Buffer
+	return deriveTrieRoot(list.Len(), func(i int) []byte {
+		// Earlier values keep referencing their bytes if the buffer grows,
+		// as written bytes are never modified.
+		start := buf.Len()
+		list.EncodeIndex(i, &buf)
+		bz := buf.Bytes()
+		return bz[start:len(bz):len(bz)]
+	})
+}
+
+// deriveTrieRoot computes the Merkle Patricia trie root of n values keyed by
+// their RLP encoded index. The stack trie retains the values until the root
+// is computed, so they must not be modified in the meantime.
+func deriveTrieRoot(
+	n int,
+	value func(i int) []byte,
+) common.ExecutionHash {
+	var (
+		hasher = gethprimitives.NewStackTrie(nil)
+		key    []byte
+		update = func(i int) {
+			key = gethprimitives.RLPAppendUint64(key[:0], uint64(i))
+			// The error is omitted as the trie produces an incorrect root
+			// if any error occurs, mirroring geth.
+			_ = hasher.Update(key, value(i))
+		}
+	)
+
+	// The stack trie requires keys in increasing order, which for RLP
+	// encoded indices is 1..127, 0, 128...
+	for i := 1; i < n && i < rlpIndexBoundary; i++ {
+		update(i)
+	}
+	if n > 0 {
+		update(0)
+	}
+	for i := rlpIndexBoundary; i < n; i++ {
+		update(i)
+	}
+	return common.ExecutionHash(hasher.Hash())
+}
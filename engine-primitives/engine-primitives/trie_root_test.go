@@ -0,0 +1,132 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package engineprimitives_test
+
+import (
+	"math/big"
+	"testing"
+
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	gethprimitives "github.com/berachain/beacon-kit/geth-primitives"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	coretypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+// transferGas is the gas used by a plain value transfer.
+const transferGas = 21_000
+
+// newTransactions returns n encoded transactions alternating between legacy
+// and dynamic fee transactions.
+func newTransactions(tb testing.TB, n int) engineprimitives.Transactions {
+	tb.Helper()
+	txs := make(engineprimitives.Transactions, n)
+	for i := range n {
+		to := gethprimitives.ExecutionAddress{byte(i), byte(i >> 8)}
+		var inner coretypes.TxData = &coretypes.LegacyTx{
+			Nonce:    uint64(i),
+			GasPrice: big.NewInt(1e9),
+			Gas:      transferGas,
+			To:       &to,
+			Value:    big.NewInt(int64(i)),
+		}
+		if i%2 == 1 {
+			inner = &coretypes.DynamicFeeTx{
+				ChainID:   big.NewInt(80084),
+				Nonce:     uint64(i),
+				GasTipCap: big.NewInt(1),
+				GasFeeCap: big.NewInt(1e9),
+				Gas:       transferGas,
+				To:        &to,
+				Value:     big.NewInt(int64(i)),
+			}
+		}
+		bz, err := coretypes.NewTx(inner).MarshalBinary()
+		require.NoError(tb, err)
+		txs[i] = bz
+	}
+	return txs
+}
+
+// decodeTransactions decodes the given encoded transactions.
+func decodeTransactions(
+	tb testing.TB,
+	txs engineprimitives.Transactions,
+) gethprimitives.Transactions {
+	tb.Helper()
+	decoded := make(gethprimitives.Transactions, len(txs))
+	for i, bz := range txs {
+		decoded[i] = new(coretypes.Transaction)
+		require.NoError(tb, decoded[i].UnmarshalBinary(bz))
+	}
+	return decoded
+}
+
+func TestTransactionsTrieRoot(t *testing.T) {
+	for _, n := range []int{0, 1, 2, 127, 128, 129, 300} {
+		txs := newTransactions(t, n)
+		expected := gethprimitives.DeriveSha(
+			decodeTransactions(t, txs), gethprimitives.NewStackTrie(nil),
+		)
+		require.Equal(t, common.ExecutionHash(expected), txs.TrieRoot())
+	}
+}
+
+func TestWithdrawalsTrieRoot(t *testing.T) {
+	for _, n := range []int{0, 1, 16, 128, 200} {
+		wds := make(engineprimitives.Withdrawals, n)
+		for i := range wds {
+			wds[i] = &engineprimitives.Withdrawal{
+				Index:     math.U64(i),
+				Validator: math.ValidatorIndex(i * 3),
+				Address:   common.ExecutionAddress{byte(i)},
+				Amount:    math.Gwei(i) * 1e9,
+			}
+		}
+		expected := gethprimitives.DeriveSha(
+			wds, gethprimitives.NewStackTrie(nil),
+		)
+		require.Equal(t, common.ExecutionHash(expected), wds.TrieRoot())
+	}
+}
+
+// Benchmarks the transactions root of a full 30M gas block of transfers,
+// comparing the geth derivation over decoded transactions to the root over
+// the encoded transactions.
+func BenchmarkTransactionsTrieRoot(b *testing.B) {
+	txs := newTransactions(b, 30_000_000/transferGas)
+
+	b.Run("DeriveSha", func(b *testing.B) {
+		b.ReportAllocs()
+		for range b.N {
+			_ = gethprimitives.DeriveSha(
+				decodeTransactions(b, txs), gethprimitives.NewStackTrie(nil),
+			)
+		}
+	})
+	b.Run("TrieRoot", func(b *testing.B) {
+		b.ReportAllocs()
+		for range b.N {
+			_ = txs.TrieRoot()
+		}
+	})
+}
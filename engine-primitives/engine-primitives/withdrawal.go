@@ -23,8 +23,10 @@ package engineprimitives
 import (
 	"io"
 
+	"github.com/berachain/beacon-kit/primitives/bytes"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/constraints"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
 	"github.com/berachain/beacon-kit/primitives/math"
 	fastssz "github.com/ferranbt/fastssz"
 	"github.com/karalabe/ssz"
@@ -159,6 +161,27 @@ func (w Withdrawal) EncodeRLP(_w io.Writer) error {
 	return err
 }
 
+/* -------------------------------------------------------------------------- */
+/*                                    JSON                                    */
+/* -------------------------------------------------------------------------- */
+
+// MarshalJSON marshals the Withdrawal as in the Engine API, with its address
+// in lowercase hex like go-ethereum.
+func (w Withdrawal) MarshalJSON() ([]byte, error) {
+	type Withdrawal struct {
+		Index     math.U64            `json:"index"`
+		Validator math.ValidatorIndex `json:"validatorIndex"`
+		Address   bytes.B20           `json:"address"`
+		Amount    math.Gwei           `json:"amount"`
+	}
+	return json.Marshal(&Withdrawal{
+		Index:     w.Index,
+		Validator: w.Validator,
+		Address:   bytes.B20(w.Address),
+		Amount:    w.Amount,
+	})
+}
+
 /* -------------------------------------------------------------------------- */
 /*                             Getters and Setters                            */
 /* -------------------------------------------------------------------------- */
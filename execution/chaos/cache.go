@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package chaos
+
+// PayloadCache is the payload ID cache of the payload builder.
+type PayloadCache[PayloadIDT, RootT, SlotT any] interface {
+	Get(slot SlotT, stateRoot RootT) (PayloadIDT, bool)
+	Has(slot SlotT, stateRoot RootT) bool
+	Set(slot SlotT, stateRoot RootT, pid PayloadIDT)
+	Delete(slot SlotT, stateRoot RootT)
+	UnsafePrunePrior(slot SlotT)
+}
+
+// faultyPayloadCache injects the payload cache faults of a scenario into the
+// lookups of a payload ID cache.
+type faultyPayloadCache[PayloadIDT ~[8]byte, RootT, SlotT any] struct {
+	PayloadCache[PayloadIDT, RootT, SlotT]
+	injector *Injector
+}
+
+// WrapPayloadCache returns a payload ID cache whose lookups are faulted by
+// the injector.
+func WrapPayloadCache[PayloadIDT ~[8]byte, RootT, SlotT any](
+	cache PayloadCache[PayloadIDT, RootT, SlotT],
+	injector *Injector,
+) PayloadCache[PayloadIDT, RootT, SlotT] {
+	return &faultyPayloadCache[PayloadIDT, RootT, SlotT]{
+		PayloadCache: cache,
+		injector:     injector,
+	}
+}
+
+// Get returns the cached payload ID, which is corrupted or missing if a
+// fault is injected.
+func (c *faultyPayloadCache[PayloadIDT, RootT, SlotT]) Get(
+	slot SlotT,
+	stateRoot RootT,
+) (PayloadIDT, bool) {
+	pid, ok := c.PayloadCache.Get(slot, stateRoot)
+	if !ok {
+		return pid, ok
+	}
+	f := c.injector.fire(TargetPayloadCache, "")
+	if f == nil {
+		return pid, ok
+	}
+
+	switch f.Action {
+	case ActionCorrupt:
+		for i := range pid {
+			pid[i] ^= 0xff
+		}
+		return pid, true
+	case ActionDrop:
+		return PayloadIDT{}, false
+	default:
+		return pid, ok
+	}
+}
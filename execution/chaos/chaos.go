@@ -0,0 +1,176 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package chaos
+
+import (
+	"slices"
+	"time"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/mitchellh/mapstructure"
+	"github.com/spf13/viper"
+)
+
+// Targets of a fault.
+const (
+	// TargetEngine faults the calls to the execution client, except for the
+	// deposit log reads.
+	TargetEngine = "engine"
+	// TargetDeposits faults the reads of deposit contract logs.
+	TargetDeposits = "deposits"
+	// TargetPayloadCache faults the lookups of the payload ID cache.
+	TargetPayloadCache = "payload-cache"
+)
+
+// Actions of a fault.
+const (
+	// ActionDelay holds a call back for the delay of the fault.
+	ActionDelay = "delay"
+	// ActionFail fails a call without performing it.
+	ActionFail = "fail"
+	// ActionDrop drops the deposit events read from the execution client, or
+	// makes a payload ID cache lookup miss.
+	ActionDrop = "drop"
+	// ActionCorrupt returns a corrupted payload ID from the cache.
+	ActionCorrupt = "corrupt"
+)
+
+var (
+	// ErrDisabled is returned when a scenario is configured on a binary
+	// built without the chaos build tag.
+	ErrDisabled = errors.New(
+		"fault injection requires a binary built with the chaos tag",
+	)
+	// ErrInvalidFault is returned when a fault of a scenario is invalid.
+	ErrInvalidFault = errors.New("invalid fault")
+	// ErrInjected is the error returned by calls failed by a fault.
+	ErrInjected = errors.New("injected fault")
+)
+
+// actions are the actions supported by every target.
+//
+//nolint:gochecknoglobals // lookup table.
+var actions = map[string][]string{
+	TargetEngine:       {ActionDelay, ActionFail},
+	TargetDeposits:     {ActionDelay, ActionFail, ActionDrop},
+	TargetPayloadCache: {ActionCorrupt, ActionDrop},
+}
+
+// Config is the configuration of the fault injection.
+type Config struct {
+	// ScenarioFile is the path of the TOML, YAML or JSON scenario to inject.
+	// Fault injection is disabled if empty.
+	ScenarioFile string `mapstructure:"scenario-file"`
+}
+
+// DefaultConfig returns the default configuration of the fault injection.
+func DefaultConfig() Config {
+	return Config{
+		ScenarioFile: "",
+	}
+}
+
+// Scenario is a set of faults injected into a node.
+type Scenario struct {
+	// Seed seeds the randomness of probabilistic faults, so that a scenario
+	// injects the same faults on every run.
+	Seed int64 `mapstructure:"seed"`
+	// Faults are the faults of the scenario. When several faults match a
+	// call, the first one that fires is injected.
+	Faults []Fault `mapstructure:"faults"`
+}
+
+// Fault describes a fault and the calls it is injected into.
+type Fault struct {
+	// Target is the component the fault is injected into.
+	Target string `mapstructure:"target"`
+	// Action is what the fault does to a matching call.
+	Action string `mapstructure:"action"`
+	// Methods restricts an engine fault to the given JSON-RPC methods. Every
+	// method matches if empty.
+	Methods []string `mapstructure:"methods"`
+	// Delay is how long a delay fault holds a call back.
+	Delay time.Duration `mapstructure:"delay"`
+	// Probability is the chance in (0, 1] that a matching call is faulted.
+	// Zero is treated as one.
+	Probability float64 `mapstructure:"probability"`
+	// Skip is the number of matching calls let through before the fault is
+	// first injected.
+	Skip uint64 `mapstructure:"skip"`
+	// Count is the maximum number of times the fault is injected, unlimited
+	// if zero.
+	Count uint64 `mapstructure:"count"`
+}
+
+// LoadScenario reads the scenario file at the given path. The format is
+// inferred from the extension and unknown keys are rejected.
+func LoadScenario(path string) (Scenario, error) {
+	v := viper.New()
+	v.SetConfigFile(path)
+	if err := v.ReadInConfig(); err != nil {
+		return Scenario{}, errors.Wrapf(
+			err, "failed to read chaos scenario %s", path,
+		)
+	}
+
+	var s Scenario
+	if err := v.Unmarshal(&s, func(c *mapstructure.DecoderConfig) {
+		c.ErrorUnused = true
+	}); err != nil {
+		return Scenario{}, errors.Wrapf(
+			err, "failed to decode chaos scenario %s", path,
+		)
+	}
+	return s, s.Validate()
+}
+
+// Validate returns an error if a fault of the scenario has an unknown target
+// or an action its target does not support.
+func (s Scenario) Validate() error {
+	for i, f := range s.Faults {
+		supported, ok := actions[f.Target]
+		switch {
+		case !ok:
+			return errors.Wrapf(
+				ErrInvalidFault, "fault %d: unknown target %q", i, f.Target,
+			)
+		case !slices.Contains(supported, f.Action):
+			return errors.Wrapf(
+				ErrInvalidFault, "fault %d: %s does not support action %q",
+				i, f.Target, f.Action,
+			)
+		case f.Action == ActionDelay && f.Delay <= 0:
+			return errors.Wrapf(
+				ErrInvalidFault, "fault %d: delay must be positive", i,
+			)
+		case f.Probability < 0 || f.Probability > 1:
+			return errors.Wrapf(
+				ErrInvalidFault, "fault %d: probability must be in [0, 1]", i,
+			)
+		case len(f.Methods) > 0 && f.Target != TargetEngine:
+			return errors.Wrapf(
+				ErrInvalidFault, "fault %d: only engine faults select methods",
+				i,
+			)
+		}
+	}
+	return nil
+}
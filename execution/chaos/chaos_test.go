@@ -0,0 +1,258 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package chaos_test
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/execution/chaos"
+	"github.com/berachain/beacon-kit/execution/client/ethclient/rpc"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/payload/cache"
+	"github.com/berachain/beacon-kit/testing/simulated"
+	"github.com/stretchr/testify/require"
+)
+
+func newInjector(t *testing.T, faults ...chaos.Fault) *chaos.Injector {
+	t.Helper()
+	inj, err := chaos.New(
+		noop.NewLogger[any](), chaos.Scenario{Seed: 1, Faults: faults},
+	)
+	require.NoError(t, err)
+	return inj
+}
+
+func TestLoadScenario(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "scenario.toml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+seed = 42
+
+[[faults]]
+target = "engine"
+action = "delay"
+methods = ["engine_newPayloadV3"]
+delay = "2s"
+probability = 0.5
+
+[[faults]]
+target = "deposits"
+action = "drop"
+skip = 3
+count = 1
+`), 0o600))
+
+	s, err := chaos.LoadScenario(path)
+	require.NoError(t, err)
+	require.Equal(t, int64(42), s.Seed)
+	require.Equal(t, []chaos.Fault{
+		{
+			Target:      chaos.TargetEngine,
+			Action:      chaos.ActionDelay,
+			Methods:     []string{"engine_newPayloadV3"},
+			Delay:       2 * time.Second,
+			Probability: 0.5,
+		},
+		{
+			Target: chaos.TargetDeposits,
+			Action: chaos.ActionDrop,
+			Skip:   3,
+			Count:  1,
+		},
+	}, s.Faults)
+
+	require.NoError(t, os.WriteFile(
+		path, []byte("[[faults]]\ntarget = \"engine\"\nbogus = 1\n"), 0o600,
+	))
+	_, err = chaos.LoadScenario(path)
+	require.Error(t, err)
+}
+
+func TestScenarioValidate(t *testing.T) {
+	for name, f := range map[string]chaos.Fault{
+		"unknown target": {Target: "network", Action: chaos.ActionFail},
+		"unsupported action": {
+			Target: chaos.TargetEngine, Action: chaos.ActionDrop,
+		},
+		"delay without duration": {
+			Target: chaos.TargetEngine, Action: chaos.ActionDelay,
+		},
+		"probability above one": {
+			Target: chaos.TargetEngine, Action: chaos.ActionFail,
+			Probability: 1.5,
+		},
+		"methods on cache": {
+			Target: chaos.TargetPayloadCache, Action: chaos.ActionCorrupt,
+			Methods: []string{"eth_chainId"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			err := chaos.Scenario{Faults: []chaos.Fault{f}}.Validate()
+			require.ErrorIs(t, err, chaos.ErrInvalidFault)
+		})
+	}
+}
+
+func TestInterceptEngine(t *testing.T) {
+	engine := simulated.NewEngine(80087)
+	defer engine.Close()
+
+	inj := newInjector(t,
+		chaos.Fault{
+			Target:  chaos.TargetEngine,
+			Action:  chaos.ActionFail,
+			Methods: []string{"eth_chainId"},
+			Skip:    1,
+			Count:   2,
+		},
+		chaos.Fault{
+			Target:  chaos.TargetEngine,
+			Action:  chaos.ActionDelay,
+			Methods: []string{"eth_syncing"},
+			Delay:   50 * time.Millisecond,
+		},
+	)
+	c := rpc.NewClient(engine.URL(), rpc.WithInterceptor(inj.Intercept))
+	ctx := context.Background()
+
+	// The first call is skipped, the next two fail without reaching the
+	// execution client and the client then recovers.
+	results := make([]error, 4)
+	for i := range results {
+		_, results[i] = c.CallRaw(ctx, "eth_chainId")
+	}
+	require.NoError(t, results[0])
+	require.ErrorIs(t, results[1], chaos.ErrInjected)
+	require.ErrorIs(t, results[2], chaos.ErrInjected)
+	require.NoError(t, results[3])
+	require.Equal(t, 2, engine.Calls("eth_chainId"))
+
+	// Other methods are delayed.
+	start := time.Now()
+	_, err := c.CallRaw(ctx, "eth_syncing")
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+
+	// A delayed call gives up when its context is done.
+	inj = newInjector(t, chaos.Fault{
+		Target: chaos.TargetEngine, Action: chaos.ActionDelay, Delay: time.Hour,
+	})
+	c = rpc.NewClient(engine.URL(), rpc.WithInterceptor(inj.Intercept))
+	cctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	_, err = c.CallRaw(cctx, "eth_syncing")
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestInterceptDeposits(t *testing.T) {
+	engine := simulated.NewEngine(80087)
+	defer engine.Close()
+	engine.Handle("eth_getLogs", func([]json.RawMessage) (any, error) {
+		return []map[string]any{{"logIndex": "0x0"}}, nil
+	})
+
+	inj := newInjector(t, chaos.Fault{
+		Target: chaos.TargetDeposits, Action: chaos.ActionDrop, Count: 1,
+	})
+	c := rpc.NewClient(engine.URL(), rpc.WithInterceptor(inj.Intercept))
+
+	var logs []json.RawMessage
+	require.NoError(t, c.Call(context.Background(), &logs, "eth_getLogs"))
+	require.Empty(t, logs)
+	require.Equal(t, 1, engine.Calls("eth_getLogs"))
+
+	require.NoError(t, c.Call(context.Background(), &logs, "eth_getLogs"))
+	require.Len(t, logs, 1)
+
+	// Engine faults do not apply to deposit log reads.
+	inj = newInjector(t, chaos.Fault{
+		Target: chaos.TargetEngine, Action: chaos.ActionFail,
+	})
+	c = rpc.NewClient(engine.URL(), rpc.WithInterceptor(inj.Intercept))
+	require.NoError(t, c.Call(context.Background(), &logs, "eth_getLogs"))
+}
+
+func TestPayloadCache(t *testing.T) {
+	inner := cache.NewPayloadIDCache[[8]byte, [32]byte, uint64]()
+	pid := [8]byte{1, 2, 3, 4, 5, 6, 7, 8}
+	inner.Set(1, [32]byte{1}, pid)
+
+	pc := chaos.WrapPayloadCache[[8]byte, [32]byte, uint64](
+		inner,
+		newInjector(t,
+			chaos.Fault{
+				Target: chaos.TargetPayloadCache,
+				Action: chaos.ActionCorrupt,
+				Count:  1,
+			},
+			chaos.Fault{
+				Target: chaos.TargetPayloadCache,
+				Action: chaos.ActionDrop,
+				Count:  1,
+			},
+		),
+	)
+
+	got, ok := pc.Get(1, [32]byte{1})
+	require.True(t, ok)
+	require.NotEqual(t, pid, got)
+
+	_, ok = pc.Get(1, [32]byte{1})
+	require.False(t, ok)
+	require.True(t, pc.Has(1, [32]byte{1}))
+
+	got, ok = pc.Get(1, [32]byte{1})
+	require.True(t, ok)
+	require.Equal(t, pid, got)
+
+	// Misses are never faulted.
+	_, ok = pc.Get(2, [32]byte{1})
+	require.False(t, ok)
+}
+
+func TestProbabilityIsReproducible(t *testing.T) {
+	fault := chaos.Fault{
+		Target: chaos.TargetEngine, Action: chaos.ActionFail, Probability: 0.5,
+	}
+	run := func() []bool {
+		inj := newInjector(t, fault)
+		out := make([]bool, 32)
+		for i := range out {
+			_, err := inj.Intercept(
+				context.Background(), "eth_chainId",
+				func(context.Context) (json.RawMessage, error) {
+					return nil, nil
+				},
+			)
+			out[i] = err != nil
+		}
+		return out
+	}
+
+	first := run()
+	require.Equal(t, first, run())
+	require.Contains(t, first, true)
+	require.Contains(t, first, false)
+}
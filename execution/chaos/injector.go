@@ -0,0 +1,162 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package chaos
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+)
+
+// getLogsMethod is the JSON-RPC method deposit logs are read with.
+const getLogsMethod = "eth_getLogs"
+
+// emptyLogs is the result of a deposit log read whose events were dropped.
+//
+//nolint:gochecknoglobals // constant result.
+var emptyLogs = json.RawMessage("[]")
+
+// Injector injects the faults of a scenario into the calls it intercepts.
+// It is safe for concurrent use.
+type Injector struct {
+	logger log.Logger
+
+	// mu protects the fields below.
+	mu     sync.Mutex
+	rng    *rand.Rand
+	faults []*fault
+}
+
+// fault is a fault of a scenario together with its injection counters.
+type fault struct {
+	Fault
+	// seen is the number of calls matched by the fault.
+	seen uint64
+	// injected is the number of times the fault was injected.
+	injected uint64
+}
+
+// New creates an injector for the given scenario.
+func New(logger log.Logger, s Scenario) (*Injector, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	faults := make([]*fault, len(s.Faults))
+	for i, f := range s.Faults {
+		faults[i] = &fault{Fault: f}
+	}
+	return &Injector{
+		logger: logger,
+		//#nosec:G404 // faults are reproducible on purpose.
+		rng:    rand.New(rand.NewSource(s.Seed)),
+		faults: faults,
+	}, nil
+}
+
+// Intercept injects the faults targeting the execution client into a call of
+// the given method. It satisfies the interceptor of the execution client RPC
+// client.
+func (i *Injector) Intercept(
+	ctx context.Context,
+	method string,
+	call func(context.Context) (json.RawMessage, error),
+) (json.RawMessage, error) {
+	target := TargetEngine
+	if method == getLogsMethod {
+		target = TargetDeposits
+	}
+	f := i.fire(target, method)
+	if f == nil {
+		return call(ctx)
+	}
+
+	switch f.Action {
+	case ActionDelay:
+		timer := time.NewTimer(f.Delay)
+		defer timer.Stop()
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+		return call(ctx)
+	case ActionFail:
+		return nil, errors.Wrap(ErrInjected, method)
+	case ActionDrop:
+		if _, err := call(ctx); err != nil {
+			return nil, err
+		}
+		return emptyLogs, nil
+	default:
+		return call(ctx)
+	}
+}
+
+// fire returns the first fault matching the target and method that is
+// injected into the current call, or nil if the call is not faulted.
+func (i *Injector) fire(target, method string) *Fault {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	for _, f := range i.faults {
+		if !f.matches(target, method) {
+			continue
+		}
+		f.seen++
+		if f.seen <= f.Skip || (f.Count > 0 && f.injected >= f.Count) {
+			continue
+		}
+		if f.Probability > 0 && i.rng.Float64() >= f.Probability {
+			continue
+		}
+		f.injected++
+		i.logger.Warn(
+			"Injecting fault",
+			"target", f.Target,
+			"action", f.Action,
+			"method", method,
+			"injected", f.injected,
+		)
+		return &f.Fault
+	}
+	return nil
+}
+
+// matches returns true if the fault targets the given target and method.
+func (f *fault) matches(target, method string) bool {
+	if f.Target != target {
+		return false
+	}
+	if len(f.Methods) == 0 {
+		return true
+	}
+	for _, m := range f.Methods {
+		if m == method {
+			return true
+		}
+	}
+	return false
+}
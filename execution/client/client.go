@@ -31,9 +31,11 @@ import (
 	ethclient "github.com/berachain/beacon-kit/execution/client/ethclient"
 	ethclientrpc "github.com/berachain/beacon-kit/execution/client/ethclient/rpc"
 	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/constraints"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/primitives/net/jwt"
+	"golang.org/x/crypto/sha3"
 )
 
 // EngineClient is a struct that holds a pointer to an Eth1Client.
@@ -48,6 +50,9 @@ type EngineClient[
 	logger log.Logger
 	// eth1ChainID is the chain ID of the execution client.
 	eth1ChainID *big.Int
+	// depositContract is the deposit contract the execution client must
+	// host, or nil if it is not verified.
+	depositContract *DepositContract
 	// clientMetrics is the metrics for the engine client.
 	metrics *clientMetrics
 	// capabilities is a map of capabilities that the execution client has.
@@ -60,7 +65,9 @@ type EngineClient[
 
 // New creates a new engine client EngineClient.
 // It takes an Eth1Client as an argument and returns a pointer  to an
-// EngineClient.
+// EngineClient. If depositContract is not nil, the execution client is
+// required to host it when connecting. Additional options are applied to the
+// underlying RPC client.
 func New[
 	ExecutionPayloadT constraints.EngineType[ExecutionPayloadT],
 	PayloadAttributesT PayloadAttributes,
@@ -70,24 +77,26 @@ func New[
 	jwtSecret *jwt.Secret,
 	telemetrySink TelemetrySink,
 	eth1ChainID *big.Int,
+	depositContract *DepositContract,
+	rpcOpts ...func(*ethclientrpc.Client),
 ) *EngineClient[
 	ExecutionPayloadT, PayloadAttributesT,
 ] {
+	rpcOpts = append([]func(*ethclientrpc.Client){
+		ethclientrpc.WithJWTSecret(jwtSecret),
+		ethclientrpc.WithJWTRefreshInterval(cfg.RPCJWTRefreshInterval),
+	}, rpcOpts...)
 	return &EngineClient[ExecutionPayloadT, PayloadAttributesT]{
 		cfg:    cfg,
 		logger: logger,
 		Client: ethclient.New[ExecutionPayloadT](
-			ethclientrpc.NewClient(
-				cfg.RPCDialURL.String(),
-				ethclientrpc.WithJWTSecret(jwtSecret),
-				ethclientrpc.WithJWTRefreshInterval(
-					cfg.RPCJWTRefreshInterval,
-				),
-			)),
-		capabilities: make(map[string]struct{}),
-		eth1ChainID:  eth1ChainID,
-		metrics:      newClientMetrics(telemetrySink, logger),
-		connected:    false,
+			ethclientrpc.NewClient(cfg.RPCDialURL.String(), rpcOpts...),
+		),
+		capabilities:    make(map[string]struct{}),
+		eth1ChainID:     eth1ChainID,
+		depositContract: depositContract,
+		metrics:         newClientMetrics(telemetrySink, logger),
+		connected:       false,
 	}
 }
 
@@ -113,9 +122,15 @@ func (s *EngineClient[
 	)
 
 	// If the connection connection succeeds, we can skip the
-	// connection initialization loop.
-	if err := s.verifyChainIDAndConnection(ctx); err == nil {
+	// connection initialization loop. If the execution client is following
+	// another network, we refuse to start rather than silently tracking the
+	// wrong deposits.
+	err := s.verifyChainIDAndConnection(ctx)
+	switch {
+	case err == nil:
 		return nil
+	case isNetworkMismatch(err):
+		return err
 	}
 
 	// Attempt to initialize the connection to the execution client.
@@ -130,9 +145,9 @@ func (s *EngineClient[
 				"Waiting for execution client to start... 🍺🕔",
 				"dial_url", s.cfg.RPCDialURL,
 			)
-			if err := s.verifyChainIDAndConnection(ctx); err != nil {
-				if errors.Is(err, ErrMismatchedEth1ChainID) {
-					s.logger.Error(err.Error())
+			if err = s.verifyChainIDAndConnection(ctx); err != nil {
+				if isNetworkMismatch(err) {
+					return err
 				}
 				continue
 			}
@@ -217,6 +232,10 @@ func (s *EngineClient[
 		s.eth1ChainID,
 	)
 
+	if err = s.verifyDepositContract(ctx); err != nil {
+		return err
+	}
+
 	// Exchange capabilities with the execution client.
 	if _, err = s.ExchangeCapabilities(ctx); err != nil {
 		s.logger.Error("failed to exchange capabilities", "err", err)
@@ -224,3 +243,51 @@ func (s *EngineClient[
 	}
 	return nil
 }
+
+// verifyDepositContract ensures that the execution client hosts the deposit
+// contract expected by the chain spec, if any.
+func (s *EngineClient[
+	_, _,
+]) verifyDepositContract(
+	ctx context.Context,
+) error {
+	if s.depositContract == nil {
+		return nil
+	}
+
+	code, err := s.Client.CodeAt(ctx, s.depositContract.Address, nil)
+	if err != nil {
+		return err
+	}
+	if len(code) == 0 {
+		return errors.Wrapf(
+			ErrMismatchedDepositContract,
+			"no code at deposit contract address %s",
+			s.depositContract.Address,
+		)
+	}
+
+	if s.depositContract.CodeHash == (common.ExecutionHash{}) {
+		return nil
+	}
+	hasher := sha3.NewLegacyKeccak256()
+	hasher.Write(code)
+	codeHash := common.ExecutionHash(hasher.Sum(nil))
+	if codeHash != s.depositContract.CodeHash {
+		return errors.Wrapf(
+			ErrMismatchedDepositContract,
+			"wanted code hash %s at %s, got %s",
+			s.depositContract.CodeHash,
+			s.depositContract.Address,
+			codeHash,
+		)
+	}
+	return nil
+}
+
+// isNetworkMismatch returns true if the given error reports that the
+// execution client is following another network.
+func isNetworkMismatch(err error) bool {
+	return errors.Is(err, ErrMismatchedEth1ChainID) ||
+		errors.Is(err, ErrMismatchedDepositContract)
+}
@@ -0,0 +1,174 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/execution/client"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/net/jwt"
+	beaconurl "github.com/berachain/beacon-kit/primitives/net/url"
+	"github.com/stretchr/testify/require"
+)
+
+const testChainID = 80087
+
+var (
+	depositAddress = common.NewExecutionAddressFromHex(
+		"0x4242424242424242424242424242424242424242",
+	)
+	// depositCode is the code served for the deposit contract, whose
+	// keccak256 hash is depositCodeHash.
+	depositCode     = "0x6000"
+	depositCodeHash = common.NewExecutionHashFromHex(
+		"0x07ad118d6cc8642c86c03827f276d8b791a65e5c99a3845faf186be720a1455d",
+	)
+)
+
+// fakeEL is an execution client on the given chain, hosting code at the
+// deposit contract address.
+type fakeEL struct {
+	chainID uint64
+	code    string
+}
+
+func (el *fakeEL) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		ID     json.RawMessage `json:"id"`
+		Method string          `json:"method"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	var result any
+	switch req.Method {
+	case "eth_chainId":
+		result = fmt.Sprintf("%#x", el.chainID)
+	case "eth_getCode":
+		result = el.code
+	case "engine_exchangeCapabilities":
+		result = []string{}
+	}
+	//nolint:errcheck // the test fails on a malformed response.
+	json.NewEncoder(w).Encode(map[string]any{
+		"jsonrpc": "2.0", "id": req.ID, "result": result,
+	})
+}
+
+func startClient(
+	t *testing.T,
+	el *fakeEL,
+	depositContract *client.DepositContract,
+) error {
+	t.Helper()
+	srv := httptest.NewServer(el)
+	t.Cleanup(srv.Close)
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	secret, err := jwt.NewRandom()
+	require.NoError(t, err)
+
+	cfg := client.DefaultConfig()
+	cfg.RPCDialURL = beaconurl.NewDialURL(u)
+	ec := client.New[
+		*ctypes.ExecutionPayload,
+		*engineprimitives.PayloadAttributes[*engineprimitives.Withdrawal],
+	](
+		&cfg, noop.NewLogger[any](), secret,
+		metrics.NewNoOpTelemetrySink(), big.NewInt(testChainID),
+		depositContract,
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	return ec.Start(ctx)
+}
+
+func TestStart_VerifiesNetwork(t *testing.T) {
+	pinned := &client.DepositContract{
+		Address:  depositAddress,
+		CodeHash: depositCodeHash,
+	}
+	for _, tc := range []struct {
+		name            string
+		el              *fakeEL
+		depositContract *client.DepositContract
+		wantErr         error
+	}{
+		{
+			name:            "matching network",
+			el:              &fakeEL{chainID: testChainID, code: depositCode},
+			depositContract: pinned,
+		},
+		{
+			name: "unpinned code hash",
+			el:   &fakeEL{chainID: testChainID, code: "0x60016000"},
+			depositContract: &client.DepositContract{
+				Address: depositAddress,
+			},
+		},
+		{
+			name: "deposit contract not verified",
+			el:   &fakeEL{chainID: testChainID, code: "0x"},
+		},
+		{
+			name:            "wrong chain ID",
+			el:              &fakeEL{chainID: 1, code: depositCode},
+			depositContract: pinned,
+			wantErr:         client.ErrMismatchedEth1ChainID,
+		},
+		{
+			name:            "missing deposit contract",
+			el:              &fakeEL{chainID: testChainID, code: "0x"},
+			depositContract: pinned,
+			wantErr:         client.ErrMismatchedDepositContract,
+		},
+		{
+			name:            "wrong deposit contract code",
+			el:              &fakeEL{chainID: testChainID, code: "0x60016000"},
+			depositContract: pinned,
+			wantErr:         client.ErrMismatchedDepositContract,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := startClient(t, tc.el, tc.depositContract)
+			if tc.wantErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
@@ -53,6 +53,10 @@ var (
 	// ErrMismatchedEth1ChainID is returned when the chainID does not
 	// match the expected chain ID.
 	ErrMismatchedEth1ChainID = errors.New("mismatched chain ID")
+
+	// ErrMismatchedDepositContract is returned when the code of the deposit
+	// contract does not match the one expected by the chain spec.
+	ErrMismatchedDepositContract = errors.New("mismatched deposit contract")
 )
 
 // Handles errors received from the RPC server according to the specification.
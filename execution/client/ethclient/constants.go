@@ -43,6 +43,10 @@ const (
 	BlockByHashMethod = "eth_getBlockByHash"
 	// BlockByNumberMethod for retrieving a block by its number.
 	BlockByNumberMethod = "eth_getBlockByNumber"
+	// SyncingMethod for retrieving the sync progress of the client.
+	SyncingMethod = "eth_syncing"
+	// GetCodeMethod for retrieving the code of an account.
+	GetCodeMethod = "eth_getCode"
 	// ExchangeCapabilities for exchanging capabilities with the peer.
 	ExchangeCapabilities = "engine_exchangeCapabilities"
 	// GetClientVersionV1 for retrieving the capabilities of the peer.
@@ -22,11 +22,13 @@ package ethclient
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"math/big"
 
 	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/geth-primitives/rpc"
+	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -44,6 +46,69 @@ func (ec *Client[ExecutionPayloadT]) ChainID(
 	return result, nil
 }
 
+// HeaderByNumber returns the header of the block with the given number, or
+// of the latest block if number is nil.
+func (ec *Client[ExecutionPayloadT]) HeaderByNumber(
+	ctx context.Context,
+	number *big.Int,
+) (*types.Header, error) {
+	var header *types.Header
+	if err := ec.Call(
+		ctx, &header, BlockByNumberMethod, toBlockNumArg(number), false,
+	); err != nil {
+		return nil, err
+	}
+	if header == nil {
+		return nil, ethereum.NotFound
+	}
+	return header, nil
+}
+
+// CodeAt returns the code of the given account at the block with the given
+// number, or at the latest block if number is nil.
+func (ec *Client[ExecutionPayloadT]) CodeAt(
+	ctx context.Context,
+	account common.ExecutionAddress,
+	number *big.Int,
+) ([]byte, error) {
+	var result hexutil.Bytes
+	if err := ec.Call(
+		ctx, &result, GetCodeMethod, account, toBlockNumArg(number),
+	); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// SyncProgress retrieves the current sync progress of the execution client,
+// or nil if the client is not syncing.
+func (ec *Client[ExecutionPayloadT]) SyncProgress(
+	ctx context.Context,
+) (*ethereum.SyncProgress, error) {
+	var raw json.RawMessage
+	if err := ec.Call(ctx, &raw, SyncingMethod); err != nil {
+		return nil, err
+	}
+	// eth_syncing returns false when the client is not syncing.
+	var syncing bool
+	if err := json.Unmarshal(raw, &syncing); err == nil {
+		return nil, nil //nolint:nilnil // not syncing.
+	}
+	var progress struct {
+		StartingBlock hexutil.Uint64 `json:"startingBlock"`
+		CurrentBlock  hexutil.Uint64 `json:"currentBlock"`
+		HighestBlock  hexutil.Uint64 `json:"highestBlock"`
+	}
+	if err := json.Unmarshal(raw, &progress); err != nil {
+		return nil, err
+	}
+	return &ethereum.SyncProgress{
+		StartingBlock: uint64(progress.StartingBlock),
+		CurrentBlock:  uint64(progress.CurrentBlock),
+		HighestBlock:  uint64(progress.HighestBlock),
+	}, nil
+}
+
 // TODO: Figure out how to unhood all this.
 
 // FilterLogs executes a filter query.
@@ -52,6 +52,9 @@ type Client struct {
 
 	// header is the HTTP header used for RPC requests.
 	header http.Header
+
+	// interceptor, if set, wraps every RPC call.
+	interceptor Interceptor
 }
 
 // New create new rpc client with given url.
@@ -123,6 +126,20 @@ func (rpc *Client) Call(
 // Call returns raw response of method call.
 func (rpc *Client) CallRaw(
 	ctx context.Context, method string, params ...any,
+) (json.RawMessage, error) {
+	if rpc.interceptor == nil {
+		return rpc.callRaw(ctx, method, params...)
+	}
+	return rpc.interceptor(
+		ctx, method, func(ctx context.Context) (json.RawMessage, error) {
+			return rpc.callRaw(ctx, method, params...)
+		},
+	)
+}
+
+// callRaw performs the RPC request and returns the raw result.
+func (rpc *Client) callRaw(
+	ctx context.Context, method string, params ...any,
 ) (json.RawMessage, error) {
 	// Pull a request from the pool, we know that it already has the correct
 	// JSONRPC version and ID set.
@@ -21,8 +21,10 @@
 package rpc
 
 import (
+	"context"
 	"time"
 
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
 	"github.com/berachain/beacon-kit/primitives/net/jwt"
 )
 
@@ -39,3 +41,18 @@ func WithJWTRefreshInterval(interval time.Duration) func(rpc *Client) {
 		rpc.jwtRefreshInterval = interval
 	}
 }
+
+// Interceptor wraps an RPC call of the given method. It performs the call by
+// invoking call and may delay it, fail it or alter its result.
+type Interceptor func(
+	ctx context.Context,
+	method string,
+	call func(context.Context) (json.RawMessage, error),
+) (json.RawMessage, error)
+
+// WithInterceptor sets an interceptor wrapping every call of the RPC client.
+func WithInterceptor(interceptor Interceptor) func(rpc *Client) {
+	return func(rpc *Client) {
+		rpc.interceptor = interceptor
+	}
+}
@@ -33,6 +33,16 @@ type PayloadAttributes interface {
 	GetSuggestedFeeRecipient() common.ExecutionAddress
 }
 
+// DepositContract is the deposit contract that the execution client is
+// expected to host, as configured in the chain spec.
+type DepositContract struct {
+	// Address is the address of the deposit contract.
+	Address common.ExecutionAddress
+	// CodeHash is the keccak256 hash of the runtime code of the deposit
+	// contract. If zero, the contract is only required to have code.
+	CodeHash common.ExecutionHash
+}
+
 // TelemetrySink is an interface for sending metrics to a telemetry backend.
 type TelemetrySink interface {
 	// IncrementCounter increments a counter metric identified by the provided
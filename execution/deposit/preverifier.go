@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"context"
+
+	asynctypes "github.com/berachain/beacon-kit/async/types"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/primitives/async"
+)
+
+// PreVerifier pre-verifies the signatures of the deposits read from the
+// deposit contract, off the path of block processing, so that processing
+// them later skips the verification.
+type PreVerifier[DepositT any] struct {
+	// logger is used for logging information and errors.
+	logger log.Logger
+	// ds is the deposit store the deposits are read from.
+	ds Reader[DepositT]
+	// verifier pre-verifies the deposits.
+	verifier SignatureVerifier[DepositT]
+	// dispatcher is the dispatcher for the service.
+	dispatcher asynctypes.EventDispatcher
+	// subDeposits is the channel holding DepositObserved events.
+	subDeposits chan async.Event[async.Deposits]
+}
+
+// NewPreVerifier creates a new deposit pre-verifier.
+func NewPreVerifier[DepositT any](
+	logger log.Logger,
+	ds Reader[DepositT],
+	verifier SignatureVerifier[DepositT],
+	dispatcher asynctypes.EventDispatcher,
+) *PreVerifier[DepositT] {
+	return &PreVerifier[DepositT]{
+		logger:      logger,
+		ds:          ds,
+		verifier:    verifier,
+		dispatcher:  dispatcher,
+		subDeposits: make(chan async.Event[async.Deposits]),
+	}
+}
+
+// Name returns the name of the service.
+func (p *PreVerifier[_]) Name() string {
+	return "deposit-pre-verifier"
+}
+
+// Start subscribes the pre-verifier to DepositObserved events and starts
+// pre-verifying the deposits they announce.
+func (p *PreVerifier[_]) Start(ctx context.Context) error {
+	if err := p.dispatcher.Subscribe(
+		async.DepositObserved, p.subDeposits,
+	); err != nil {
+		return err
+	}
+	go p.eventLoop(ctx)
+	return nil
+}
+
+// eventLoop pre-verifies the deposits of each DepositObserved event.
+func (p *PreVerifier[_]) eventLoop(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event := <-p.subDeposits:
+			deposits := event.Data()
+			stored, err := p.ds.GetDepositsByIndex(
+				deposits.FirstIndex.Unwrap(), deposits.Count,
+			)
+			if err != nil {
+				p.logger.Error(
+					"Failed to read deposits to pre-verify",
+					"first_index", deposits.FirstIndex, "error", err,
+				)
+				continue
+			}
+			p.verifier.PreVerify(stored)
+		}
+	}
+}
@@ -35,6 +35,11 @@ func BuildPruneRangeFn[
 	WithdrawalCredentialsT any,
 ](cs common.ChainSpec) func(async.Event[BeaconBlockT]) (uint64, uint64) {
 	return func(event async.Event[BeaconBlockT]) (uint64, uint64) {
+		// Deposits are kept until the Eth1Data fork, which fills the deposit
+		// tree in with all of them.
+		if cs.SlotToEpoch(event.Data().GetSlot()) < cs.Eth1DataForkEpoch() {
+			return 0, 0
+		}
 		deposits := event.Data().GetBody().GetDeposits()
 		if len(deposits) == 0 || cs.MaxDepositsPerBlock() == 0 {
 			return 0, 0
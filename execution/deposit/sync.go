@@ -104,4 +104,17 @@ func (s *Service[
 	}
 
 	s.clearFailedBlock(blockNum)
+
+	if len(deposits) == 0 {
+		return
+	}
+	if err = s.dispatcher.Publish(
+		async.NewEvent(ctx, async.DepositObserved, async.Deposits{
+			ExecutionBlock: blockNum,
+			FirstIndex:     deposits[0].GetIndex(),
+			Count:          uint64(len(deposits)),
+		}),
+	); err != nil {
+		s.logger.Error("Failed to publish deposit event", "error", err)
+	}
 }
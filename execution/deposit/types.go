@@ -91,6 +91,20 @@ type Store[DepositT any] interface {
 	EnqueueDeposits(deposits []DepositT) error
 }
 
+// Reader defines the interface for reading deposits from the store.
+type Reader[DepositT any] interface {
+	// GetDepositsByIndex returns the deposits of the given range.
+	GetDepositsByIndex(startIndex uint64, numView uint64) ([]DepositT, error)
+}
+
+// SignatureVerifier verifies the signatures of deposits ahead of their
+// processing.
+type SignatureVerifier[DepositT any] interface {
+	// PreVerify verifies the signatures of the given deposits, remembering
+	// the valid ones.
+	PreVerify(deposits []DepositT)
+}
+
 // TelemetrySink is an interface for sending metrics to a telemetry backend.
 type TelemetrySink interface {
 	// IncrementCounter increments a counter metric identified by the provided
@@ -23,6 +23,7 @@ package engine
 import (
 	"bytes"
 	"context"
+	"sync"
 
 	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
 	engineerrors "github.com/berachain/beacon-kit/engine-primitives/errors"
@@ -51,6 +52,17 @@ type Engine[
 	logger log.Logger
 	// metrics is the metrics for the engine.
 	metrics *engineMetrics
+	// verdicts caches the newPayload verdicts, so that a payload verified
+	// in ProcessProposal is not sent again in FinalizeBlock.
+	verdicts *verdictCache
+	// hooksMu guards the hooks below.
+	hooksMu sync.RWMutex
+	// resetHooks are called whenever the execution client may have lost its
+	// in-memory state.
+	resetHooks []func(reason string)
+	// newPayloadHooks are called with the outcome of every newPayload call
+	// sent to the execution client.
+	newPayloadHooks []func(err error)
 }
 
 // New creates a new Engine.
@@ -74,9 +86,10 @@ func New[
 		ExecutionPayloadT, PayloadAttributesT, PayloadIDT,
 		WithdrawalsT,
 	]{
-		ec:      engineClient,
-		logger:  logger,
-		metrics: newEngineMetrics(telemtrySink, logger),
+		ec:       engineClient,
+		logger:   logger,
+		metrics:  newEngineMetrics(telemtrySink, logger),
+		verdicts: newVerdictCache(),
 	}
 }
 
@@ -134,6 +147,7 @@ func (ee *Engine[
 		engineerrors.ErrSyncingPayloadStatus,
 	):
 		ee.metrics.markForkchoiceUpdateAcceptedSyncing(req.State, err)
+		ee.reset("execution client is syncing")
 		return payloadID, nil, nil
 
 	// If we get invalid payload status, we will need to find a valid
@@ -157,6 +171,7 @@ func (ee *Engine[
 	// All other errors are handled as undefined errors.
 	case err != nil:
 		ee.metrics.markForkchoiceUpdateUndefinedError(err)
+		ee.reset("execution client is unreachable")
 		return nil, nil, err
 	default:
 		ee.metrics.markForkchoiceUpdateValid(
@@ -204,6 +219,19 @@ func (ee *Engine[
 		return err
 	}
 
+	// If the execution client already ruled on the payload, e.g. during
+	// ProcessProposal, we reuse its verdict.
+	blockHash := req.ExecutionPayload.GetBlockHash()
+	if verdict, ok := ee.verdicts.Get(blockHash); ok {
+		ee.metrics.markNewPayloadCachedVerdict(
+			blockHash, verdict.valid, req.Optimistic,
+		)
+		if !verdict.valid {
+			return ErrBadBlockProduced
+		}
+		return nil
+	}
+
 	// Otherwise we will send the payload to the execution client.
 	lastValidHash, err := ee.ec.NewPayload(
 		ctx,
@@ -229,6 +257,7 @@ func (ee *Engine[
 			req.ExecutionPayload.GetParentHash(),
 			req.Optimistic,
 		)
+		ee.notifyNewPayload(nil)
 
 	// These two cases are semantically the same:
 	// https://github.com/ethereum/execution-apis/issues/270
@@ -241,6 +270,12 @@ func (ee *Engine[
 			req.ExecutionPayload.GetBlockHash(),
 			req.Optimistic,
 		)
+		parentHash := req.ExecutionPayload.GetParentHash()
+		ee.verdicts.Add(blockHash, payloadVerdict{
+			valid: false, parentHash: parentHash,
+		})
+		ee.invalidateDescendants(parentHash, lastValidHash)
+		ee.notifyNewPayload(nil)
 
 		// We want to return bad block irrespective of
 		// if we are running in optimistic mode or not.
@@ -262,18 +297,26 @@ func (ee *Engine[
 		)
 
 		err = errors.Join(err, engineerrors.ErrPreDefinedJSONRPC)
+		ee.notifyNewPayload(err)
 	case err != nil:
 		ee.metrics.markNewPayloadUndefinedError(
 			req.ExecutionPayload.GetBlockHash(),
 			req.Optimistic,
 			err,
 		)
+		ee.reset("execution client is unreachable")
+		ee.notifyNewPayload(err)
 	default:
 		ee.metrics.markNewPayloadValid(
 			req.ExecutionPayload.GetBlockHash(),
 			req.ExecutionPayload.GetParentHash(),
 			req.Optimistic,
 		)
+		ee.verdicts.Add(blockHash, payloadVerdict{
+			valid:      true,
+			parentHash: req.ExecutionPayload.GetParentHash(),
+		})
+		ee.notifyNewPayload(nil)
 	}
 
 	// Under the optimistic condition, we are fine ignoring the error. This
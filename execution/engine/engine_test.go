@@ -0,0 +1,377 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package engine_test
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/execution/client"
+	"github.com/berachain/beacon-kit/execution/engine"
+	gethprimitives "github.com/berachain/beacon-kit/geth-primitives"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/net/jwt"
+	beaconurl "github.com/berachain/beacon-kit/primitives/net/url"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	attributes = *engineprimitives.PayloadAttributes[*engineprimitives.Withdrawal]
+	testEngine = engine.Engine[
+		*ctypes.ExecutionPayload,
+		attributes,
+		engineprimitives.PayloadID,
+		engineprimitives.Withdrawals,
+	]
+)
+
+// fakeEL is an execution client answering newPayload and forkchoiceUpdated
+// with the configured status and latest valid hash, after the configured
+// delay.
+type fakeEL struct {
+	mu          sync.Mutex
+	status      string
+	latestValid *common.ExecutionHash
+	delay       time.Duration
+	newPayloads int
+}
+
+func (el *fakeEL) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		ID     json.RawMessage `json:"id"`
+		Method string          `json:"method"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	el.mu.Lock()
+	delay := el.delay
+	el.mu.Unlock()
+	select {
+	case <-time.After(delay):
+	case <-r.Context().Done():
+		return
+	}
+
+	el.mu.Lock()
+	defer el.mu.Unlock()
+	status := map[string]any{"status": el.status}
+	if el.latestValid != nil {
+		status["latestValidHash"] = el.latestValid
+	}
+	var result any = status
+	switch req.Method {
+	case "engine_newPayloadV3":
+		el.newPayloads++
+	case "engine_forkchoiceUpdatedV3":
+		result = map[string]any{"payloadStatus": status}
+	case "eth_syncing":
+		result = false
+		if el.status == "SYNCING" {
+			result = map[string]any{
+				"startingBlock": "0x0",
+				"currentBlock":  "0x1",
+				"highestBlock":  "0x2",
+			}
+		}
+	}
+	//nolint:errcheck // the test fails on a malformed response.
+	json.NewEncoder(w).Encode(map[string]any{
+		"jsonrpc": "2.0", "id": req.ID, "result": result,
+	})
+}
+
+func (el *fakeEL) set(status string) {
+	el.mu.Lock()
+	defer el.mu.Unlock()
+	el.status = status
+}
+
+func (el *fakeEL) calls() int {
+	el.mu.Lock()
+	defer el.mu.Unlock()
+	return el.newPayloads
+}
+
+func newEngine(t *testing.T, el *fakeEL) *testEngine {
+	t.Helper()
+	srv := httptest.NewServer(el)
+	t.Cleanup(srv.Close)
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	secret, err := jwt.NewRandom()
+	require.NoError(t, err)
+
+	cfg := client.DefaultConfig()
+	cfg.RPCDialURL = beaconurl.NewDialURL(u)
+	ec := client.New[
+		*ctypes.ExecutionPayload,
+		attributes,
+	](
+		&cfg, noop.NewLogger[any](), secret,
+		metrics.NewNoOpTelemetrySink(), big.NewInt(80087), nil,
+	)
+	return engine.New[
+		*ctypes.ExecutionPayload,
+		attributes,
+		engineprimitives.PayloadID,
+		engineprimitives.Withdrawals,
+	](ec, noop.NewLogger[any](), metrics.NewNoOpTelemetrySink())
+}
+
+// newPayloadRequest returns a request for an empty payload at the given
+// number, with a consistent block hash.
+func newPayloadRequest(number math.U64) *engineprimitives.NewPayloadRequest[
+	*ctypes.ExecutionPayload, engineprimitives.Withdrawals,
+] {
+	return newChildPayloadRequest(number, common.ExecutionHash{})
+}
+
+// newChildPayloadRequest returns a request for an empty payload at the given
+// number building on the given parent, with a consistent block hash.
+func newChildPayloadRequest(
+	number math.U64, parentHash common.ExecutionHash,
+) *engineprimitives.NewPayloadRequest[
+	*ctypes.ExecutionPayload, engineprimitives.Withdrawals,
+] {
+	parentRoot := common.Root{1}
+	payload := &ctypes.ExecutionPayload{
+		ParentHash:    parentHash,
+		Number:        number,
+		GasLimit:      30_000_000,
+		BaseFeePerGas: math.NewU256(7),
+		Withdrawals:   []*engineprimitives.Withdrawal{},
+	}
+	withdrawalsHash := gethprimitives.ExecutionHash(
+		engineprimitives.DeriveTrieRoot(payload.GetWithdrawals()),
+	)
+	payload.BlockHash = common.ExecutionHash((&gethprimitives.Header{
+		ParentHash: gethprimitives.ExecutionHash(parentHash),
+		UncleHash:  gethprimitives.EmptyUncleHash,
+		TxHash: gethprimitives.ExecutionHash(
+			payload.GetTransactions().TrieRoot(),
+		),
+		Difficulty:       big.NewInt(0),
+		Number:           new(big.Int).SetUint64(number.Unwrap()),
+		GasLimit:         payload.GasLimit.Unwrap(),
+		BaseFee:          payload.BaseFeePerGas.ToBig(),
+		WithdrawalsHash:  &withdrawalsHash,
+		ExcessBlobGas:    payload.ExcessBlobGas.UnwrapPtr(),
+		BlobGasUsed:      payload.BlobGasUsed.UnwrapPtr(),
+		ParentBeaconRoot: (*gethprimitives.ExecutionHash)(&parentRoot),
+	}).Hash())
+	return &engineprimitives.NewPayloadRequest[
+		*ctypes.ExecutionPayload, engineprimitives.Withdrawals,
+	]{
+		ExecutionPayload:      payload,
+		VersionedHashes:       []common.ExecutionHash{},
+		ParentBeaconBlockRoot: &parentRoot,
+	}
+}
+
+func TestVerifyAndNotifyNewPayloadCachesVerdicts(t *testing.T) {
+	el := &fakeEL{status: "VALID"}
+	ee := newEngine(t, el)
+	ctx := context.Background()
+
+	// A valid payload is sent once, whether verified or finalized.
+	valid := newPayloadRequest(1)
+	require.NoError(t, valid.HasValidVersionedAndBlockHashes())
+	require.NoError(t, ee.VerifyAndNotifyNewPayload(ctx, valid))
+	valid.Optimistic = true
+	require.NoError(t, ee.VerifyAndNotifyNewPayload(ctx, valid))
+	require.Equal(t, 1, el.calls())
+
+	// An invalid payload stays invalid without asking again.
+	el.set("INVALID")
+	invalid := newPayloadRequest(2)
+	require.ErrorIs(t,
+		ee.VerifyAndNotifyNewPayload(ctx, invalid),
+		engine.ErrBadBlockProduced,
+	)
+	require.ErrorIs(t,
+		ee.VerifyAndNotifyNewPayload(ctx, invalid),
+		engine.ErrBadBlockProduced,
+	)
+	require.Equal(t, 2, el.calls())
+
+	// Syncing statuses are not cached.
+	el.set("SYNCING")
+	syncing := newPayloadRequest(3)
+	syncing.Optimistic = true
+	require.NoError(t, ee.VerifyAndNotifyNewPayload(ctx, syncing))
+	require.NoError(t, ee.VerifyAndNotifyNewPayload(ctx, syncing))
+	require.Equal(t, 4, el.calls())
+}
+
+func TestInvalidVerdictInvalidatesDescendants(t *testing.T) {
+	el := &fakeEL{status: "VALID"}
+	ee := newEngine(t, el)
+	ctx := context.Background()
+
+	// Build a chain of valid payloads on top of a base block.
+	base := newPayloadRequest(1)
+	chain := []*engineprimitives.NewPayloadRequest[
+		*ctypes.ExecutionPayload, engineprimitives.Withdrawals,
+	]{base}
+	for number := math.U64(2); number <= 3; number++ {
+		parent := chain[len(chain)-1].ExecutionPayload.GetBlockHash()
+		chain = append(chain, newChildPayloadRequest(number, parent))
+	}
+	for _, req := range chain {
+		require.NoError(t, ee.VerifyAndNotifyNewPayload(ctx, req))
+	}
+	require.Equal(t, 3, el.calls())
+
+	// The child of the tip is invalid, and the execution client reports
+	// the base block as the latest valid ancestor.
+	baseHash := base.ExecutionPayload.GetBlockHash()
+	el.mu.Lock()
+	el.status, el.latestValid = "INVALID", &baseHash
+	el.mu.Unlock()
+	tip := chain[len(chain)-1].ExecutionPayload.GetBlockHash()
+	require.ErrorIs(t,
+		ee.VerifyAndNotifyNewPayload(ctx, newChildPayloadRequest(4, tip)),
+		engine.ErrBadBlockProduced,
+	)
+	require.Equal(t, 4, el.calls())
+
+	// The cached descendants of the base block are now invalid, while the
+	// base block itself stays valid, all without asking again.
+	require.NoError(t, ee.VerifyAndNotifyNewPayload(ctx, base))
+	for _, req := range chain[1:] {
+		require.ErrorIs(t,
+			ee.VerifyAndNotifyNewPayload(ctx, req),
+			engine.ErrBadBlockProduced,
+		)
+	}
+	require.Equal(t, 4, el.calls())
+}
+
+func TestVerdictsInvalidatedOnSyncingForkchoice(t *testing.T) {
+	el := &fakeEL{status: "VALID"}
+	ee := newEngine(t, el)
+	ctx := context.Background()
+
+	var resets []string
+	ee.OnReset(func(reason string) { resets = append(resets, reason) })
+
+	req := newPayloadRequest(1)
+	require.NoError(t, ee.VerifyAndNotifyNewPayload(ctx, req))
+	require.Equal(t, 1, el.calls())
+	require.Empty(t, resets)
+
+	// The execution client restarted and lost the block: it reports
+	// syncing on the next forkchoice update, so the payload is sent again.
+	el.set("SYNCING")
+	_, _, err := ee.NotifyForkchoiceUpdate(ctx,
+		engineprimitives.BuildForkchoiceUpdateRequestNoAttrs[attributes](
+			&engineprimitives.ForkchoiceStateV1{
+				HeadBlockHash: req.ExecutionPayload.GetBlockHash(),
+			},
+			version.Deneb,
+		),
+	)
+	require.NoError(t, err)
+	require.Equal(t, []string{"execution client is syncing"}, resets)
+
+	el.set("VALID")
+	require.NoError(t, ee.VerifyAndNotifyNewPayload(ctx, req))
+	require.Equal(t, 2, el.calls())
+}
+
+func TestVerifyAndNotifyNewPayloadHonorsDeadline(t *testing.T) {
+	el := &fakeEL{status: "VALID", delay: time.Minute}
+	ee := newEngine(t, el)
+
+	// A slow execution client fails a verification once its deadline
+	// expires, well before the RPC timeout.
+	ctx, cancel := context.WithTimeout(
+		context.Background(), 50*time.Millisecond,
+	)
+	defer cancel()
+	start := time.Now()
+	require.Error(t, ee.VerifyAndNotifyNewPayload(ctx, newPayloadRequest(1)))
+	require.Less(t, time.Since(start), client.DefaultConfig().RPCTimeout)
+
+	// Finalizing a block tolerates it and is not held up either.
+	ctx, cancel = context.WithTimeout(
+		context.Background(), 50*time.Millisecond,
+	)
+	defer cancel()
+	start = time.Now()
+	req := newPayloadRequest(2)
+	req.Optimistic = true
+	require.NoError(t, ee.VerifyAndNotifyNewPayload(ctx, req))
+	require.Less(t, time.Since(start), client.DefaultConfig().RPCTimeout)
+}
+
+func TestNewPayloadHooksAndHealthProbe(t *testing.T) {
+	el := &fakeEL{status: "VALID"}
+	ee := newEngine(t, el)
+	ctx := context.Background()
+
+	var outcomes []error
+	ee.OnNewPayload(func(err error) { outcomes = append(outcomes, err) })
+
+	// An answering execution client is healthy, even when it rejects the
+	// payload.
+	require.NoError(t, ee.VerifyAndNotifyNewPayload(ctx, newPayloadRequest(1)))
+	el.set("INVALID")
+	require.ErrorIs(t,
+		ee.VerifyAndNotifyNewPayload(ctx, newPayloadRequest(2)),
+		engine.ErrBadBlockProduced,
+	)
+	require.Equal(t, []error{nil, nil}, outcomes)
+	require.NoError(t, ee.CheckHealth(ctx))
+
+	// A syncing execution client fails the health probe.
+	el.set("SYNCING")
+	require.ErrorIs(t, ee.CheckHealth(ctx), engine.ErrExecutionClientSyncing)
+
+	// An execution client that does not answer in time is not.
+	el.set("VALID")
+	el.mu.Lock()
+	el.delay = time.Minute
+	el.mu.Unlock()
+	timeoutCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	require.Error(t,
+		ee.VerifyAndNotifyNewPayload(timeoutCtx, newPayloadRequest(3)),
+	)
+	require.Len(t, outcomes, 3)
+	require.Error(t, outcomes[2])
+}
@@ -35,4 +35,8 @@ var (
 	ErrNilPayloadOnValidResponse = errors.New(
 		"received nil payload ID on VALID engine response",
 	)
+
+	// ErrExecutionClientSyncing is returned by the health probe when the
+	// execution client is still syncing.
+	ErrExecutionClientSyncing = errors.New("execution client is syncing")
 )
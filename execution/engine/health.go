@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package engine
+
+import "context"
+
+// OnNewPayload registers a function called with the outcome of every
+// newPayload call that reaches the execution client. The error is nil
+// whenever the execution client answered, even if it rejected the payload,
+// so that the hooks track the health of the execution client rather than
+// the validity of the payloads.
+func (ee *Engine[_, _, _, _]) OnNewPayload(fn func(err error)) {
+	ee.hooksMu.Lock()
+	defer ee.hooksMu.Unlock()
+	ee.newPayloadHooks = append(ee.newPayloadHooks, fn)
+}
+
+// notifyNewPayload notifies the registered newPayload hooks.
+func (ee *Engine[_, _, _, _]) notifyNewPayload(err error) {
+	ee.hooksMu.RLock()
+	defer ee.hooksMu.RUnlock()
+	for _, fn := range ee.newPayloadHooks {
+		fn(err)
+	}
+}
+
+// CheckHealth probes the execution client, returning an error if it is
+// unreachable or still syncing.
+func (ee *Engine[_, _, _, _]) CheckHealth(ctx context.Context) error {
+	progress, err := ee.ec.SyncProgress(ctx)
+	if err != nil {
+		return err
+	}
+	if progress != nil {
+		return ErrExecutionClientSyncing
+	}
+	return nil
+}
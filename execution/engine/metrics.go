@@ -80,6 +80,27 @@ func (em *engineMetrics) markNewPayloadValid(
 	)
 }
 
+// markNewPayloadCachedVerdict increments the counter for payloads whose
+// verdict was cached.
+func (em *engineMetrics) markNewPayloadCachedVerdict(
+	payloadHash common.ExecutionHash,
+	isValid bool,
+	isOptimistic bool,
+) {
+	em.logger.Debug(
+		"Reusing cached new payload verdict",
+		"payload_block_hash", payloadHash,
+		"is_valid", isValid,
+		"is_optimistic", isOptimistic,
+	)
+
+	em.sink.IncrementCounter(
+		"beacon_kit.execution.engine.new_payload_cached_verdict",
+		"is_valid", strconv.FormatBool(isValid),
+		"is_optimistic", strconv.FormatBool(isOptimistic),
+	)
+}
+
 // markNewPayloadAcceptedSyncingPayloadStatus increments
 // the counter for accepted syncing payload status.
 func (em *engineMetrics) markNewPayloadAcceptedSyncingPayloadStatus(
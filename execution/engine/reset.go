@@ -0,0 +1,42 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package engine
+
+// OnReset registers a function called whenever the execution client may have
+// lost its in-memory state, i.e. when it becomes unreachable or reports it is
+// syncing, e.g. after a restart. The state built against the execution
+// client, such as the IDs of the payloads it is building, must be dropped.
+func (ee *Engine[_, _, _, _]) OnReset(fn func(reason string)) {
+	ee.hooksMu.Lock()
+	defer ee.hooksMu.Unlock()
+	ee.resetHooks = append(ee.resetHooks, fn)
+}
+
+// reset drops the state cached about the execution client and notifies the
+// registered reset hooks.
+func (ee *Engine[_, _, _, _]) reset(reason string) {
+	ee.invalidateVerdicts(reason)
+
+	ee.hooksMu.RLock()
+	defer ee.hooksMu.RUnlock()
+	for _, fn := range ee.resetHooks {
+		fn(reason)
+	}
+}
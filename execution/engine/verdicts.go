@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package engine
+
+import (
+	"github.com/berachain/beacon-kit/primitives/common"
+	lru "github.com/hashicorp/golang-lru/v2"
+)
+
+// verdictCacheSize is the number of newPayload verdicts cached. A payload
+// is verified once in ProcessProposal and again in FinalizeBlock, so only
+// the most recent payloads are ever looked up.
+const verdictCacheSize = 64
+
+// payloadVerdict is the final status returned by the execution client for
+// a payload.
+type payloadVerdict struct {
+	// valid is true if the payload is VALID, false if INVALID.
+	valid bool
+	// parentHash is the block hash of the payload's parent.
+	parentHash common.ExecutionHash
+}
+
+// verdictCache caches the newPayload verdicts by execution block hash.
+type verdictCache = lru.Cache[common.ExecutionHash, payloadVerdict]
+
+// newVerdictCache creates an empty verdict cache.
+func newVerdictCache() *verdictCache {
+	//nolint:errcheck // only fails for a non-positive size.
+	cache, _ := lru.New[common.ExecutionHash, payloadVerdict](
+		verdictCacheSize,
+	)
+	return cache
+}
+
+// invalidateVerdicts drops the cached verdicts. The execution client may
+// have lost recently imported blocks when it becomes unreachable or reports
+// it is syncing, e.g. after a restart, in which case the payloads must be
+// sent to it again.
+func (ee *Engine[_, _, _, _]) invalidateVerdicts(reason string) {
+	if ee.verdicts.Len() == 0 {
+		return
+	}
+	ee.verdicts.Purge()
+	ee.logger.Info("Cleared cached payload verdicts", "reason", reason)
+}
+
+// invalidateDescendants marks the cached verdicts of the ancestors of an
+// invalid payload that descend from latestValidHash as invalid. The
+// execution client reports the latest valid ancestor along with an INVALID
+// status, so every block between it and the invalid payload is invalid too.
+func (ee *Engine[_, _, _, _]) invalidateDescendants(
+	parentHash common.ExecutionHash,
+	latestValidHash *common.ExecutionHash,
+) {
+	// Without a latest valid ancestor nothing is known about the ancestors.
+	if latestValidHash == nil || *latestValidHash == (common.ExecutionHash{}) {
+		return
+	}
+	for hash := parentHash; hash != *latestValidHash; {
+		verdict, ok := ee.verdicts.Peek(hash)
+		if !ok || !verdict.valid {
+			return
+		}
+		ee.verdicts.Add(hash, payloadVerdict{
+			valid: false, parentHash: verdict.parentHash,
+		})
+		hash = verdict.parentHash
+	}
+}
@@ -23,6 +23,7 @@ package bind
 import "github.com/ethereum/go-ethereum/accounts/abi/bind"
 
 type (
+	CallOpts         = bind.CallOpts
 	ContractBackend  = bind.ContractBackend
 	ContractFilterer = bind.ContractFilterer
 	FilterOpts       = bind.FilterOpts
@@ -30,4 +31,7 @@ type (
 )
 
 //nolint:gochecknoglobals //used an alias.
-var WaitMined = bind.WaitMined
+var (
+	NewKeyedTransactorWithChainID = bind.NewKeyedTransactorWithChainID
+	WaitMined                     = bind.WaitMined
+)
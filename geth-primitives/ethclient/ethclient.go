@@ -28,5 +28,6 @@ type (
 
 //nolint:gochecknoglobals // its okay.
 var (
+	Dial      = ethclient.Dial
 	NewClient = ethclient.NewClient
 )
@@ -25,6 +25,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	coretypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/ethereum/go-ethereum/trie"
 )
 
@@ -48,8 +49,16 @@ type (
 	Transaction    = coretypes.Transaction
 	Transactions   = coretypes.Transactions
 	Withdrawals    = coretypes.Withdrawals
+	StackTrie      = trie.StackTrie
 )
 
+// BlobTxType is the EIP-2718 type byte of an EIP-4844 blob transaction.
+const BlobTxType = coretypes.BlobTxType
+
+// ReceiptStatusSuccessful is the status of a receipt of a successful
+// transaction.
+const ReceiptStatusSuccessful = coretypes.ReceiptStatusSuccessful
+
 //nolint:gochecknoglobals // alias.
 var (
 	BlockToExecutableData = engine.BlockToExecutableData
@@ -57,4 +66,5 @@ var (
 	DeriveSha             = coretypes.DeriveSha
 	EmptyUncleHash        = coretypes.EmptyUncleHash
 	NewStackTrie          = trie.NewStackTrie
+	RLPAppendUint64       = rlp.AppendUint64
 )
@@ -61,6 +61,13 @@ type Colorable interface {
 	AddKeyValColor(key any, val any, color Color)
 }
 
+// Labelable extends the basic logger with fields added to every log entry.
+type Labelable interface {
+	// SetDefaultFields sets key/value pairs added to every log entry of the
+	// logger and of the loggers derived from it.
+	SetDefaultFields(keyVals ...any)
+}
+
 // AdvancedLogger extends the color logger with the ability to wrap the logger
 // with additional context and to access the underlying logger implementation.
 type AdvancedLogger[LoggerT any] interface {
@@ -74,3 +74,7 @@ func (n *Logger[ImplT]) AddKeyColor(any, log.Color) {
 func (n *Logger[ImplT]) AddKeyValColor(any, any, log.Color) {
 	// No operation
 }
+
+func (n *Logger[ImplT]) SetDefaultFields(...any) {
+	// No operation
+}
@@ -22,6 +22,7 @@ package phuslu
 
 import (
 	"io"
+	"sync/atomic"
 
 	"github.com/phuslu/log"
 )
@@ -32,6 +33,9 @@ type Logger struct {
 	logger *log.Logger
 	// context is a map of key-value pairs that are added to every log entry.
 	context log.Fields
+	// defaults holds the key-value pairs added to every log entry of this
+	// logger and of every logger derived from it.
+	defaults *atomic.Pointer[log.Fields]
 	// out is the writer to write logs to.
 	out io.Writer
 	// formatter is the formatter to use for the logger.
@@ -46,6 +50,7 @@ func NewLogger(
 	logger := &Logger{
 		logger:    &log.Logger{},
 		context:   make(log.Fields),
+		defaults:  new(atomic.Pointer[log.Fields]),
 		out:       out,
 		formatter: NewFormatter(),
 	}
@@ -117,10 +122,28 @@ func (l *Logger) Writer() io.Writer {
 	return l.out
 }
 
+// SetDefaultFields sets key-value pairs added to every log entry of the
+// logger and of every logger derived from it, including the ones derived
+// before the call.
+func (l *Logger) SetDefaultFields(keyVals ...any) {
+	fields := make(log.Fields, len(keyVals)/2)
+	for i := 0; i+1 < len(keyVals); i += 2 {
+		key, ok := keyVals[i].(string)
+		if !ok {
+			continue
+		}
+		fields[key] = keyVals[i+1]
+	}
+	l.defaults.Store(&fields)
+}
+
 // msgWithContext logs a message with keyVals and current context.
 func (l *Logger) msgWithContext(
 	msg string, e *log.Entry, keyVals ...any,
 ) {
+	if defaults := l.defaults.Load(); defaults != nil {
+		e = e.Fields(*defaults)
+	}
 	e.Fields(l.context).KeysAndValues(keyVals...).Msg(msg)
 }
 
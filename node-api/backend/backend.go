@@ -23,10 +23,16 @@ package backend
 import (
 	"context"
 
+	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/state-transition/core"
 )
 
+// ErrNoCommittedState is returned when the node has not committed a block yet,
+// so that there is no state to query.
+var ErrNoCommittedState = errors.New("no committed state to query yet")
+
 // Backend is the db access layer for the beacon node-api.
 // It serves as a wrapper around the storage backend and provides an abstraction
 // over building the query context for a given state.
@@ -60,11 +66,17 @@ type Backend[
 	WithdrawalT Withdrawal[WithdrawalT],
 	WithdrawalCredentialsT WithdrawalCredentials,
 ] struct {
-	sb   StorageBackendT
-	cs   common.ChainSpec
-	node NodeT
-
-	sp StateProcessor[BeaconStateT]
+	sb       StorageBackendT
+	cs       common.ChainSpec
+	node     NodeT
+	proposer BlockProposer[BeaconBlockT]
+
+	sp StateProcessor[
+		BeaconStateT, core.ReadOnlyBeaconState[
+			BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT, ForkT,
+			ValidatorT, ValidatorsT, WithdrawalT,
+		],
+	]
 }
 
 // New creates and returns a new Backend instance.
@@ -100,7 +112,13 @@ func New[
 ](
 	storageBackend StorageBackendT,
 	cs common.ChainSpec,
-	sp StateProcessor[BeaconStateT],
+	sp StateProcessor[
+		BeaconStateT, core.ReadOnlyBeaconState[
+			BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT, ForkT,
+			ValidatorT, ValidatorsT, WithdrawalT,
+		],
+	],
+	proposer BlockProposer[BeaconBlockT],
 ) *Backend[
 	AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 	BeaconStateT, BeaconStateMarshallableT, BlobSidecarsT, BlockStoreT,
@@ -115,9 +133,10 @@ func New[
 		NodeT, StateStoreT, StorageBackendT, ValidatorT, ValidatorsT, WithdrawalT,
 		WithdrawalCredentialsT,
 	]{
-		sb: storageBackend,
-		cs: cs,
-		sp: sp,
+		sb:       storageBackend,
+		cs:       cs,
+		sp:       sp,
+		proposer: proposer,
 	}
 }
 
@@ -136,6 +155,18 @@ func (b *Backend[
 	return b.cs
 }
 
+// LatestSlot returns the slot of the latest committed state.
+func (b *Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) LatestSlot() (math.Slot, error) {
+	height := b.node.LastBlockHeight()
+	if height <= 0 {
+		return 0, ErrNoCommittedState
+	}
+	//#nosec:G701 // the height is positive.
+	return math.Slot(height), nil
+}
+
 // GetSlotByBlockRoot retrieves the slot by a block root from the block store.
 func (b *Backend[
 	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
@@ -158,28 +189,27 @@ func (b *Backend[
 	return b.sb.BlockStore().GetParentSlotByTimestamp(timestamp)
 }
 
-// stateFromSlot returns the state at the given slot, after also processing the
-// next slot to ensure the returned beacon state is up to date.
+// stateFromSlot returns a read-only snapshot of the state at the given slot,
+// with the state and block roots of the slot cached as processing the next
+// slot would. Serving queries never writes to the queried state.
 func (b *Backend[
-	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
-]) stateFromSlot(slot math.Slot) (BeaconStateT, math.Slot, error) {
-	var (
-		st  BeaconStateT
-		err error
-	)
-	if st, slot, err = b.stateFromSlotRaw(slot); err != nil {
-		return st, slot, err
-	}
-
-	// Process the slot to update the latest state and block roots.
-	if _, err = b.sp.ProcessSlots(st, slot+1); err != nil {
-		return st, slot, err
+	_, _, _, BeaconBlockHeaderT, _, _, _, _, _, _, _, Eth1DataT,
+	ExecutionPayloadHeaderT, ForkT, _, _, _, ValidatorT, ValidatorsT,
+	WithdrawalT, _,
+]) stateFromSlot(slot math.Slot) (
+	core.ReadOnlyBeaconState[
+		BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT, ForkT,
+		ValidatorT, ValidatorsT, WithdrawalT,
+	],
+	math.Slot,
+	error,
+) {
+	st, slot, err := b.stateFromSlotRaw(slot)
+	if err != nil {
+		return nil, slot, err
 	}
-
-	// We need to set the slot on the state back since ProcessSlot will update
-	// it to slot + 1.
-	err = st.SetSlot(slot)
-	return st, slot, err
+	snapshot, err := b.sp.ReadOnlySnapshot(st)
+	return snapshot, slot, err
 }
 
 // stateFromSlotRaw returns the state at the given slot using query context,
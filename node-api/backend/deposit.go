@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package backend
+
+import (
+	"cosmossdk.io/collections"
+	"github.com/berachain/beacon-kit/errors"
+	beacontypes "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// Eth1DepositIndexAtSlot returns the index of the last deposit processed by
+// the state at the given slot, along with the slot of that state.
+func (b Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) Eth1DepositIndexAtSlot(slot math.Slot) (uint64, math.Slot, error) {
+	st, slot, err := b.stateFromSlot(slot)
+	if err != nil {
+		return 0, 0, err
+	}
+	index, err := st.GetEth1DepositIndex()
+	if err != nil {
+		return 0, 0, err
+	}
+	return index, slot, nil
+}
+
+// ValidatorByPubkey returns the validator with the given pubkey in the state
+// at the given slot, or nil if the pubkey is not in the registry.
+func (b Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, ValidatorT, _, _, _,
+]) ValidatorByPubkey(
+	slot math.Slot, pubkey crypto.BLSPubkey,
+) (*beacontypes.ValidatorData[ValidatorT], error) {
+	st, slot, err := b.stateFromSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+	index, err := st.ValidatorIndexByPubkey(pubkey)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, nil //nolint:nilnil // an unknown pubkey is not an error.
+	}
+	if err != nil {
+		return nil, err
+	}
+	return validatorData(st, index, b.cs.SlotToEpoch(slot))
+}
@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	beacontypes "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+)
+
+// ForkDigests returns the fork digest of every fork in the chain spec, in
+// activation order, and the ENRForkID of the chain at the epoch of the given
+// slot. Both are derived from the genesis validators root in the state at
+// that slot, so that tooling keying caches on fork digests can follow the
+// chain.
+func (b Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) ForkDigests(slot math.Slot) (*beacontypes.ForkDigestsData, error) {
+	st, slot, err := b.stateFromSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+	genesisValidatorsRoot, err := st.GetGenesisValidatorsRoot()
+	if err != nil {
+		return nil, err
+	}
+
+	// Walk the fork schedule from genesis.
+	var (
+		forks []*beacontypes.ForkDigestData
+		epoch math.Epoch
+	)
+	for {
+		forkVersion := version.FromUint32[common.Version](
+			b.cs.ActiveForkVersionForEpoch(epoch),
+		)
+		forks = append(forks, &beacontypes.ForkDigestData{
+			Epoch:   epoch.Unwrap(),
+			Version: forkVersion,
+			ForkDigest: types.NewForkData(
+				forkVersion, genesisValidatorsRoot,
+			).ComputeForkDigest(),
+		})
+		_, next := b.cs.NextForkForEpoch(epoch)
+		if next == math.Epoch(constants.FarFutureEpoch) {
+			break
+		}
+		epoch = next
+	}
+
+	enrForkID := types.NewENRForkID(
+		b.cs, genesisValidatorsRoot, b.cs.SlotToEpoch(slot),
+	)
+	eth2, err := enrForkID.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	return &beacontypes.ForkDigestsData{
+		ENRForkID: &beacontypes.ENRForkIDData{
+			ForkDigest:      enrForkID.ForkDigest,
+			NextForkVersion: enrForkID.NextForkVersion,
+			NextForkEpoch:   enrForkID.NextForkEpoch.Unwrap(),
+			Eth2:            eth2,
+		},
+		Forks: forks,
+	}, nil
+}
@@ -23,16 +23,26 @@ package backend
 import (
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
 )
 
-// GetGenesis returns the genesis state of the beacon chain.
+// GenesisValidatorsRoot returns the genesis validators root of the beacon
+// chain, read from the state at the given slot.
 func (b Backend[
 	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) GenesisValidatorsRoot(slot math.Slot) (common.Root, error) {
-	// needs genesis_time and gensis_fork_version
 	st, _, err := b.stateFromSlot(slot)
 	if err != nil {
 		return common.Root{}, err
 	}
 	return st.GetGenesisValidatorsRoot()
 }
+
+// GenesisForkVersion returns the fork version the beacon chain started with.
+func (b Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) GenesisForkVersion() common.Version {
+	return version.FromUint32[common.Version](
+		b.cs.ActiveForkVersionForEpoch(0),
+	)
+}
@@ -76,6 +76,51 @@ func (_c *Node_CreateQueryContext_Call[ContextT]) RunAndReturn(run func(int64, b
 	return _c
 }
 
+// LastBlockHeight provides a mock function with no fields
+func (_m *Node[ContextT]) LastBlockHeight() int64 {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for LastBlockHeight")
+	}
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func() int64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	return r0
+}
+
+// Node_LastBlockHeight_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LastBlockHeight'
+type Node_LastBlockHeight_Call[ContextT any] struct {
+	*mock.Call
+}
+
+// LastBlockHeight is a helper method to define mock.On call
+func (_e *Node_Expecter[ContextT]) LastBlockHeight() *Node_LastBlockHeight_Call[ContextT] {
+	return &Node_LastBlockHeight_Call[ContextT]{Call: _e.mock.On("LastBlockHeight")}
+}
+
+func (_c *Node_LastBlockHeight_Call[ContextT]) Run(run func()) *Node_LastBlockHeight_Call[ContextT] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Node_LastBlockHeight_Call[ContextT]) Return(_a0 int64) *Node_LastBlockHeight_Call[ContextT] {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Node_LastBlockHeight_Call[ContextT]) RunAndReturn(run func() int64) *Node_LastBlockHeight_Call[ContextT] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewNode creates a new instance of Node. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewNode[ContextT any](t interface {
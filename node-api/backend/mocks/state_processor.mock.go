@@ -3,48 +3,45 @@
 package mocks
 
 import (
-	math "github.com/berachain/beacon-kit/primitives/math"
 	mock "github.com/stretchr/testify/mock"
-
-	transition "github.com/berachain/beacon-kit/primitives/transition"
 )
 
 // StateProcessor is an autogenerated mock type for the StateProcessor type
-type StateProcessor[BeaconStateT any] struct {
+type StateProcessor[BeaconStateT any, ReadOnlyBeaconStateT any] struct {
 	mock.Mock
 }
 
-type StateProcessor_Expecter[BeaconStateT any] struct {
+type StateProcessor_Expecter[BeaconStateT any, ReadOnlyBeaconStateT any] struct {
 	mock *mock.Mock
 }
 
-func (_m *StateProcessor[BeaconStateT]) EXPECT() *StateProcessor_Expecter[BeaconStateT] {
-	return &StateProcessor_Expecter[BeaconStateT]{mock: &_m.Mock}
+func (_m *StateProcessor[BeaconStateT, ReadOnlyBeaconStateT]) EXPECT() *StateProcessor_Expecter[BeaconStateT, ReadOnlyBeaconStateT] {
+	return &StateProcessor_Expecter[BeaconStateT, ReadOnlyBeaconStateT]{mock: &_m.Mock}
 }
 
-// ProcessSlots provides a mock function with given fields: _a0, _a1
-func (_m *StateProcessor[BeaconStateT]) ProcessSlots(_a0 BeaconStateT, _a1 math.U64) (transition.ValidatorUpdates, error) {
-	ret := _m.Called(_a0, _a1)
+// ReadOnlySnapshot provides a mock function with given fields: _a0
+func (_m *StateProcessor[BeaconStateT, ReadOnlyBeaconStateT]) ReadOnlySnapshot(_a0 BeaconStateT) (ReadOnlyBeaconStateT, error) {
+	ret := _m.Called(_a0)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ProcessSlots")
+		panic("no return value specified for ReadOnlySnapshot")
 	}
 
-	var r0 transition.ValidatorUpdates
+	var r0 ReadOnlyBeaconStateT
 	var r1 error
-	if rf, ok := ret.Get(0).(func(BeaconStateT, math.U64) (transition.ValidatorUpdates, error)); ok {
-		return rf(_a0, _a1)
+	if rf, ok := ret.Get(0).(func(BeaconStateT) (ReadOnlyBeaconStateT, error)); ok {
+		return rf(_a0)
 	}
-	if rf, ok := ret.Get(0).(func(BeaconStateT, math.U64) transition.ValidatorUpdates); ok {
-		r0 = rf(_a0, _a1)
+	if rf, ok := ret.Get(0).(func(BeaconStateT) ReadOnlyBeaconStateT); ok {
+		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(transition.ValidatorUpdates)
+			r0 = ret.Get(0).(ReadOnlyBeaconStateT)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(BeaconStateT, math.U64) error); ok {
-		r1 = rf(_a0, _a1)
+	if rf, ok := ret.Get(1).(func(BeaconStateT) error); ok {
+		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -52,42 +49,41 @@ func (_m *StateProcessor[BeaconStateT]) ProcessSlots(_a0 BeaconStateT, _a1 math.
 	return r0, r1
 }
 
-// StateProcessor_ProcessSlots_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProcessSlots'
-type StateProcessor_ProcessSlots_Call[BeaconStateT any] struct {
+// StateProcessor_ReadOnlySnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadOnlySnapshot'
+type StateProcessor_ReadOnlySnapshot_Call[BeaconStateT any, ReadOnlyBeaconStateT any] struct {
 	*mock.Call
 }
 
-// ProcessSlots is a helper method to define mock.On call
+// ReadOnlySnapshot is a helper method to define mock.On call
 //   - _a0 BeaconStateT
-//   - _a1 math.U64
-func (_e *StateProcessor_Expecter[BeaconStateT]) ProcessSlots(_a0 interface{}, _a1 interface{}) *StateProcessor_ProcessSlots_Call[BeaconStateT] {
-	return &StateProcessor_ProcessSlots_Call[BeaconStateT]{Call: _e.mock.On("ProcessSlots", _a0, _a1)}
+func (_e *StateProcessor_Expecter[BeaconStateT, ReadOnlyBeaconStateT]) ReadOnlySnapshot(_a0 interface{}) *StateProcessor_ReadOnlySnapshot_Call[BeaconStateT, ReadOnlyBeaconStateT] {
+	return &StateProcessor_ReadOnlySnapshot_Call[BeaconStateT, ReadOnlyBeaconStateT]{Call: _e.mock.On("ReadOnlySnapshot", _a0)}
 }
 
-func (_c *StateProcessor_ProcessSlots_Call[BeaconStateT]) Run(run func(_a0 BeaconStateT, _a1 math.U64)) *StateProcessor_ProcessSlots_Call[BeaconStateT] {
+func (_c *StateProcessor_ReadOnlySnapshot_Call[BeaconStateT, ReadOnlyBeaconStateT]) Run(run func(_a0 BeaconStateT)) *StateProcessor_ReadOnlySnapshot_Call[BeaconStateT, ReadOnlyBeaconStateT] {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(BeaconStateT), args[1].(math.U64))
+		run(args[0].(BeaconStateT))
 	})
 	return _c
 }
 
-func (_c *StateProcessor_ProcessSlots_Call[BeaconStateT]) Return(_a0 transition.ValidatorUpdates, _a1 error) *StateProcessor_ProcessSlots_Call[BeaconStateT] {
+func (_c *StateProcessor_ReadOnlySnapshot_Call[BeaconStateT, ReadOnlyBeaconStateT]) Return(_a0 ReadOnlyBeaconStateT, _a1 error) *StateProcessor_ReadOnlySnapshot_Call[BeaconStateT, ReadOnlyBeaconStateT] {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *StateProcessor_ProcessSlots_Call[BeaconStateT]) RunAndReturn(run func(BeaconStateT, math.U64) (transition.ValidatorUpdates, error)) *StateProcessor_ProcessSlots_Call[BeaconStateT] {
+func (_c *StateProcessor_ReadOnlySnapshot_Call[BeaconStateT, ReadOnlyBeaconStateT]) RunAndReturn(run func(BeaconStateT) (ReadOnlyBeaconStateT, error)) *StateProcessor_ReadOnlySnapshot_Call[BeaconStateT, ReadOnlyBeaconStateT] {
 	_c.Call.Return(run)
 	return _c
 }
 
 // NewStateProcessor creates a new instance of StateProcessor. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewStateProcessor[BeaconStateT any](t interface {
+func NewStateProcessor[BeaconStateT any, ReadOnlyBeaconStateT any](t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *StateProcessor[BeaconStateT] {
-	mock := &StateProcessor[BeaconStateT]{}
+}) *StateProcessor[BeaconStateT, ReadOnlyBeaconStateT] {
+	mock := &StateProcessor[BeaconStateT, ReadOnlyBeaconStateT]{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// ProduceBlock builds an unsigned block for the given slot on the latest
+// committed state, for an external proposer to sign.
+func (b *Backend[
+	_, BeaconBlockT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) ProduceBlock(
+	slot math.Slot,
+	reveal crypto.BLSSignature,
+	graffiti common.Bytes32,
+) (BeaconBlockT, error) {
+	var blk BeaconBlockT
+	// Building the block writes to the state, the query context discards
+	// the writes.
+	queryCtx, err := b.node.CreateQueryContext(0, false)
+	if err != nil {
+		return blk, err
+	}
+	return b.proposer.ProduceBlock(queryCtx, slot, reveal, graffiti)
+}
+
+// SubmitBlock hands the block produced last, signed by the external
+// proposer, to the proposer of the node.
+func (b *Backend[
+	_, BeaconBlockT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) SubmitBlock(
+	blk BeaconBlockT,
+	signature crypto.BLSSignature,
+) error {
+	return b.proposer.SubmitBlock(blk, signature)
+}
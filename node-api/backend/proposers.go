@@ -0,0 +1,213 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"encoding/binary"
+
+	"github.com/berachain/beacon-kit/errors"
+	beacontypes "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/crypto/sha256"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+const (
+	// shuffleRoundCount is the number of swap-or-not rounds used to shuffle
+	// the active validator set, as in the Ethereum consensus specs.
+	shuffleRoundCount = 90
+	// maxRandomByte is the largest value a byte drawn from a seed can take.
+	maxRandomByte = 1<<8 - 1
+)
+
+// ErrNoActiveBalance is returned when no active validator has a non-zero
+// effective balance, so no proposer can be sampled.
+var ErrNoActiveBalance = errors.New("no active validator with balance")
+
+// proposerCandidate is the subset of a validator that proposer sampling reads.
+type proposerCandidate interface {
+	GetPubkey() crypto.BLSPubkey
+	GetEffectiveBalance() math.Gwei
+	IsActive(epoch math.Epoch) bool
+}
+
+// ProposerLookahead computes the proposer of every slot from the epoch of the
+// given slot up to, but excluding, epochs epochs later, using the validator set
+// in the state at that slot.
+//
+// Assignments follow the Ethereum consensus specs: the active validators are
+// shuffled with a seed derived from the RANDAO mix at the end of the previous
+// epoch and sampled by effective balance. The mix of the current epoch is only
+// fixed once the epoch ends, so every epoch after the current one is projected
+// from the latest mix and marked as not fixed. Note that blocks on this chain
+// are proposed by the validator CometBFT selects, so the lookahead is a
+// deterministic function of the beacon state rather than a schedule consensus
+// enforces.
+func (b Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, ValidatorT, _, _, _,
+]) ProposerLookahead(
+	slot math.Slot, epochs uint64,
+) ([]*beacontypes.ProposerLookaheadData, error) {
+	st, slot, err := b.stateFromSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+	validators, err := st.GetValidators()
+	if err != nil {
+		return nil, err
+	}
+
+	current := b.cs.SlotToEpoch(slot)
+	lookahead := make([]*beacontypes.ProposerLookaheadData, 0, epochs)
+	for epoch := current; epoch < current+math.Epoch(epochs); epoch++ {
+		// The seed of an epoch is taken from the mix at the end of the
+		// previous one, which is only final if that epoch is over.
+		mixEpoch, fixed := current, false
+		if epoch > 0 && epoch <= current {
+			mixEpoch, fixed = epoch-1, true
+		}
+		var mix common.Bytes32
+		mix, err = st.GetRandaoMixAtIndex(
+			mixEpoch.Unwrap() % b.cs.EpochsPerHistoricalVector(),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		var duties []*beacontypes.ProposerDutyData
+		duties, err = epochProposers(
+			[]ValidatorT(validators),
+			epoch,
+			b.cs.SlotsPerEpoch(),
+			proposerSeed(b.cs.DomainTypeProposer(), epoch, mix),
+			math.Gwei(b.cs.MaxEffectiveBalance()),
+		)
+		if err != nil {
+			return nil, err
+		}
+		lookahead = append(lookahead, &beacontypes.ProposerLookaheadData{
+			Epoch:     epoch.Unwrap(),
+			SeedFixed: fixed,
+			Duties:    duties,
+		})
+	}
+	return lookahead, nil
+}
+
+// epochProposers samples the proposer of every slot in the epoch from the
+// validators active in it.
+func epochProposers[ValidatorT proposerCandidate](
+	validators []ValidatorT,
+	epoch math.Epoch,
+	slotsPerEpoch uint64,
+	seed [32]byte,
+	maxEffectiveBalance math.Gwei,
+) ([]*beacontypes.ProposerDutyData, error) {
+	var (
+		active       = make([]math.ValidatorIndex, 0, len(validators))
+		totalBalance math.Gwei
+	)
+	for i, validator := range validators {
+		if validator.IsActive(epoch) {
+			active = append(active, math.ValidatorIndex(i))
+			totalBalance += validator.GetEffectiveBalance()
+		}
+	}
+	if totalBalance == 0 {
+		return nil, ErrNoActiveBalance
+	}
+
+	var (
+		duties = make([]*beacontypes.ProposerDutyData, 0, slotsPerEpoch)
+		buf    = make([]byte, len(seed)+8)
+		start  = epoch.Unwrap() * slotsPerEpoch
+	)
+	copy(buf, seed[:])
+	for slot := start; slot < start+slotsPerEpoch; slot++ {
+		binary.LittleEndian.PutUint64(buf[len(seed):], slot)
+		index := computeProposerIndex(
+			validators, active, sha256.Hash(buf), maxEffectiveBalance,
+		)
+		duties = append(duties, &beacontypes.ProposerDutyData{
+			Pubkey:         validators[index].GetPubkey(),
+			ValidatorIndex: index.Unwrap(),
+			Slot:           slot,
+		})
+	}
+	return duties, nil
+}
+
+// proposerSeed returns the proposer seed of the epoch given the RANDAO mix it
+// is drawn from.
+func proposerSeed(
+	domain common.DomainType, epoch math.Epoch, mix common.Bytes32,
+) [32]byte {
+	buf := make([]byte, 0, len(domain)+8+len(mix))
+	buf = append(buf, domain[:]...)
+	buf = binary.LittleEndian.AppendUint64(buf, epoch.Unwrap())
+	buf = append(buf, mix[:]...)
+	return sha256.Hash(buf)
+}
+
+// computeProposerIndex walks the shuffled active set and accepts a candidate
+// with probability proportional to its effective balance. The active set must
+// hold a non-zero effective balance.
+func computeProposerIndex[ValidatorT proposerCandidate](
+	validators []ValidatorT,
+	active []math.ValidatorIndex,
+	seed [32]byte,
+	maxEffectiveBalance math.Gwei,
+) math.ValidatorIndex {
+	total := uint64(len(active))
+	buf := make([]byte, len(seed)+8)
+	copy(buf, seed[:])
+	for i := uint64(0); ; i++ {
+		candidate := active[computeShuffledIndex(i%total, total, seed)]
+		binary.LittleEndian.PutUint64(buf[len(seed):], i/32)
+		randomByte := sha256.Hash(buf)[i%32]
+		balance := validators[candidate].GetEffectiveBalance()
+		if balance*maxRandomByte >= maxEffectiveBalance*math.Gwei(randomByte) {
+			return candidate
+		}
+	}
+}
+
+// computeShuffledIndex returns the position index is moved to when shuffling a
+// list of count elements with the swap-or-not shuffle.
+func computeShuffledIndex(index, count uint64, seed [32]byte) uint64 {
+	buf := make([]byte, len(seed)+1+4)
+	copy(buf, seed[:])
+	for round := range uint64(shuffleRoundCount) {
+		buf[len(seed)] = byte(round)
+		pivotHash := sha256.Hash(buf[:len(seed)+1])
+		pivot := binary.LittleEndian.Uint64(pivotHash[:8]) % count
+		flip := (pivot + count - index) % count
+		position := max(index, flip)
+		//#nosec:G115 // validator indices fit in 32 bits.
+		binary.LittleEndian.PutUint32(buf[len(seed)+1:], uint32(position/256))
+		source := sha256.Hash(buf)
+		if (source[(position%256)/8]>>(position%8))&1 == 1 {
+			index = flip
+		}
+	}
+	return index
+}
@@ -25,8 +25,8 @@ import (
 
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/constraints"
+	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/primitives/math"
-	"github.com/berachain/beacon-kit/primitives/transition"
 	"github.com/berachain/beacon-kit/state-transition/core"
 )
 
@@ -62,20 +62,32 @@ type BeaconBlockHeader[BeaconBlockHeaderT any] interface {
 	GetBodyRoot() common.Root
 }
 
-// BeaconState is the interface for the beacon state.
+// BeaconState is the interface for the beacon state. The backend only ever
+// reads from it.
 type BeaconState[
 	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
 	ForkT, ValidatorT, ValidatorsT, WithdrawalT any,
 ] interface {
-	// SetSlot sets the slot on the beacon state.
-	SetSlot(math.Slot) error
-
 	core.ReadOnlyBeaconState[
 		BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
 		ForkT, ValidatorT, ValidatorsT, WithdrawalT,
 	]
 }
 
+// BlockProposer builds the blocks of an external proposer.
+type BlockProposer[BeaconBlockT any] interface {
+	// ProduceBlock builds an unsigned block for the given slot on the state
+	// of the given context.
+	ProduceBlock(
+		ctx context.Context,
+		slot math.Slot,
+		reveal crypto.BLSSignature,
+		graffiti common.Bytes32,
+	) (BeaconBlockT, error)
+	// SubmitBlock accepts a produced block signed by the proposer.
+	SubmitBlock(blk BeaconBlockT, signature crypto.BLSSignature) error
+}
+
 // BlockStore is the interface for block storage.
 type BlockStore[BeaconBlockT any] interface {
 	// GetSlotByBlockRoot retrieves the slot by a given block root.
@@ -101,10 +113,16 @@ type Node[ContextT any] interface {
 	// CreateQueryContext creates a query context for a given height and proof
 	// flag.
 	CreateQueryContext(height int64, prove bool) (ContextT, error)
+	// LastBlockHeight returns the height of the latest committed block.
+	LastBlockHeight() int64
 }
 
-type StateProcessor[BeaconStateT any] interface {
-	ProcessSlots(BeaconStateT, math.Slot) (transition.ValidatorUpdates, error)
+// StateProcessor is the interface for the state processor, which hands out
+// read-only snapshots of the beacon state to the backend.
+type StateProcessor[BeaconStateT, ReadOnlyBeaconStateT any] interface {
+	// ReadOnlySnapshot returns a read-only view of the state with the state
+	// and block roots of its current slot cached.
+	ReadOnlySnapshot(BeaconStateT) (ReadOnlyBeaconStateT, error)
 }
 
 // StorageBackend is the interface for the storage backend.
@@ -121,6 +139,24 @@ type StorageBackend[
 // credentials. WithdrawalCredentialsT is a type parameter that must implement
 // the WithdrawalCredentials interface.
 type Validator[WithdrawalCredentialsT WithdrawalCredentials] interface {
+	// GetPubkey returns the public key of the validator.
+	GetPubkey() crypto.BLSPubkey
+	// GetEffectiveBalance returns the effective balance of the validator.
+	GetEffectiveBalance() math.Gwei
+	// GetActivationEligibilityEpoch returns the epoch when the validator
+	// became eligible for activation.
+	GetActivationEligibilityEpoch() math.Epoch
+	// GetActivationEpoch returns the epoch when the validator is activated.
+	GetActivationEpoch() math.Epoch
+	// GetExitEpoch returns the epoch when the validator exits.
+	GetExitEpoch() math.Epoch
+	// GetWithdrawableEpoch returns the epoch when the validator can
+	// withdraw.
+	GetWithdrawableEpoch() math.Epoch
+	// IsSlashed returns whether the validator has been slashed.
+	IsSlashed() bool
+	// IsActive checks if the validator is active at the given epoch.
+	IsActive(epoch math.Epoch) bool
 	// GetWithdrawalCredentials returns the withdrawal credentials of the
 	// validator.
 	GetWithdrawalCredentials() WithdrawalCredentialsT
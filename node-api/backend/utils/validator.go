@@ -23,8 +23,10 @@ package utils
 import (
 	"strconv"
 
+	"github.com/berachain/beacon-kit/primitives/constants"
 	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/transition"
 )
 
 // ValidatorIndexByID parses a validator index from a string.
@@ -44,3 +46,44 @@ func ValidatorIndexByID[
 	}
 	return st.ValidatorIndexByPubkey(key)
 }
+
+// StatusValidator is a validator whose status can be computed.
+type StatusValidator interface {
+	GetActivationEligibilityEpoch() math.Epoch
+	GetActivationEpoch() math.Epoch
+	GetExitEpoch() math.Epoch
+	GetWithdrawableEpoch() math.Epoch
+	IsSlashed() bool
+}
+
+// ValidatorStatus returns the status of a validator with the given balance at
+// the given epoch, as defined by the beacon node API.
+func ValidatorStatus(
+	validator StatusValidator, balance math.Gwei, epoch math.Epoch,
+) transition.ValidatorStatus {
+	farFuture := math.Epoch(constants.FarFutureEpoch)
+	switch {
+	case epoch < validator.GetActivationEpoch():
+		if validator.GetActivationEligibilityEpoch() == farFuture {
+			return transition.ValidatorStatusPendingInitialized
+		}
+		return transition.ValidatorStatusPending
+	case epoch < validator.GetExitEpoch():
+		if validator.GetExitEpoch() == farFuture {
+			return transition.ValidatorStatusActive
+		}
+		if validator.IsSlashed() {
+			return transition.ValidatorStatusSlashed
+		}
+		return transition.ValidatorStatusExiting
+	case epoch < validator.GetWithdrawableEpoch():
+		if validator.IsSlashed() {
+			return transition.ValidatorStatusExitedSlashed
+		}
+		return transition.ValidatorStatusExited
+	case balance > 0:
+		return transition.ValidatorStatusWithdrawable
+	default:
+		return transition.ValidatorStatusWithdrawn
+	}
+}
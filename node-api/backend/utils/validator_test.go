@@ -0,0 +1,146 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package utils_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/node-api/backend/utils"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/transition"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatorStatus(t *testing.T) {
+	farFuture := math.Epoch(constants.FarFutureEpoch)
+	const epoch = math.Epoch(10)
+	tests := []struct {
+		name      string
+		validator *types.Validator
+		balance   math.Gwei
+		want      transition.ValidatorStatus
+	}{
+		{
+			name: "pending initialized",
+			validator: &types.Validator{
+				ActivationEligibilityEpoch: farFuture,
+				ActivationEpoch:            farFuture,
+				ExitEpoch:                  farFuture,
+				WithdrawableEpoch:          farFuture,
+			},
+			balance: 32e9,
+			want:    transition.ValidatorStatusPendingInitialized,
+		},
+		{
+			name: "pending queued",
+			validator: &types.Validator{
+				ActivationEligibilityEpoch: 9,
+				ActivationEpoch:            11,
+				ExitEpoch:                  farFuture,
+				WithdrawableEpoch:          farFuture,
+			},
+			balance: 32e9,
+			want:    transition.ValidatorStatusPending,
+		},
+		{
+			name: "active ongoing",
+			validator: &types.Validator{
+				ActivationEligibilityEpoch: 8,
+				ActivationEpoch:            10,
+				ExitEpoch:                  farFuture,
+				WithdrawableEpoch:          farFuture,
+			},
+			balance: 32e9,
+			want:    transition.ValidatorStatusActive,
+		},
+		{
+			name: "active exiting",
+			validator: &types.Validator{
+				ActivationEpoch:   2,
+				ExitEpoch:         11,
+				WithdrawableEpoch: 12,
+			},
+			balance: 32e9,
+			want:    transition.ValidatorStatusExiting,
+		},
+		{
+			name: "active slashed",
+			validator: &types.Validator{
+				ActivationEpoch:   2,
+				ExitEpoch:         11,
+				WithdrawableEpoch: 12,
+				Slashed:           true,
+			},
+			balance: 32e9,
+			want:    transition.ValidatorStatusSlashed,
+		},
+		{
+			name: "exited unslashed",
+			validator: &types.Validator{
+				ActivationEpoch:   2,
+				ExitEpoch:         10,
+				WithdrawableEpoch: 11,
+			},
+			balance: 32e9,
+			want:    transition.ValidatorStatusExited,
+		},
+		{
+			name: "exited slashed",
+			validator: &types.Validator{
+				ActivationEpoch:   2,
+				ExitEpoch:         9,
+				WithdrawableEpoch: 11,
+				Slashed:           true,
+			},
+			balance: 32e9,
+			want:    transition.ValidatorStatusExitedSlashed,
+		},
+		{
+			name: "withdrawal possible",
+			validator: &types.Validator{
+				ActivationEpoch:   2,
+				ExitEpoch:         9,
+				WithdrawableEpoch: 10,
+			},
+			balance: 32e9,
+			want:    transition.ValidatorStatusWithdrawable,
+		},
+		{
+			name: "withdrawal done",
+			validator: &types.Validator{
+				ActivationEpoch:   2,
+				ExitEpoch:         9,
+				WithdrawableEpoch: 10,
+			},
+			want: transition.ValidatorStatusWithdrawn,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t,
+				tt.want,
+				utils.ValidatorStatus(tt.validator, tt.balance, epoch),
+			)
+		})
+	}
+}
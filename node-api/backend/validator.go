@@ -23,6 +23,7 @@ package backend
 import (
 	"github.com/berachain/beacon-kit/node-api/backend/utils"
 	beacontypes "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/math"
 )
 
@@ -34,7 +35,7 @@ func (b Backend[
 	// TODO: to adhere to the spec, this shouldn't error if the error
 	// is not found, but i can't think of a way to do that without coupling
 	// db impl to the api impl.
-	st, _, err := b.stateFromSlot(slot)
+	st, slot, err := b.stateFromSlot(slot)
 	if err != nil {
 		return nil, err
 	}
@@ -42,6 +43,47 @@ func (b Backend[
 	if err != nil {
 		return nil, err
 	}
+	return validatorData(st, index, b.cs.SlotToEpoch(slot))
+}
+
+// ValidatorsByWithdrawalAddress returns the validators whose withdrawals are
+// paid to the given execution address in the state at the given slot.
+func (b Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, ValidatorT, _, _, _,
+]) ValidatorsByWithdrawalAddress(
+	slot math.Slot, address common.ExecutionAddress,
+) ([]*beacontypes.ValidatorData[ValidatorT], error) {
+	st, slot, err := b.stateFromSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+	indices, err := st.ValidatorIndicesByWithdrawalAddress(address)
+	if err != nil {
+		return nil, err
+	}
+	validatorsData := make(
+		[]*beacontypes.ValidatorData[ValidatorT], 0, len(indices),
+	)
+	for _, index := range indices {
+		data, err := validatorData(st, index, b.cs.SlotToEpoch(slot))
+		if err != nil {
+			return nil, err
+		}
+		validatorsData = append(validatorsData, data)
+	}
+	return validatorsData, nil
+}
+
+// validatorData returns the validator at the given index in the state, along
+// with its balance and its status at the given epoch.
+func validatorData[ValidatorT utils.StatusValidator](
+	st interface {
+		ValidatorByIndex(math.ValidatorIndex) (ValidatorT, error)
+		GetBalance(math.ValidatorIndex) (math.Gwei, error)
+	},
+	index math.ValidatorIndex,
+	epoch math.Epoch,
+) (*beacontypes.ValidatorData[ValidatorT], error) {
 	validator, err := st.ValidatorByIndex(index)
 	if err != nil {
 		return nil, err
@@ -55,7 +97,9 @@ func (b Backend[
 			Index:   index.Unwrap(),
 			Balance: balance.Unwrap(),
 		},
-		Status:    "active_ongoing", // TODO: fix
+		Status: string(
+			utils.ValidatorStatus(validator, balance, epoch),
+		),
 		Validator: validator,
 	}, nil
 }
@@ -0,0 +1,138 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockstore
+
+import (
+	"context"
+	"time"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// backfillRetryInterval is the time waited before retrieving a block again
+// after the source failed to return it.
+const backfillRetryInterval = 5 * time.Second
+
+var (
+	// ErrParentRootMismatch is returned when a backfilled block is not the
+	// parent of the block following it.
+	ErrParentRootMismatch = errors.New("block is not the expected parent")
+
+	// ErrBlockPruned is returned by a BlockSource when the block at the
+	// requested slot was pruned from the source.
+	ErrBlockPruned = errors.New("block was pruned from the source")
+)
+
+// backfill retrieves the blocks preceding the anchor, down to the first
+// block, to the availability window or to the first block pruned from the
+// source, and stores them as they are retrieved. Every block must hash to the
+// parent root of the block following it, so that the backfilled chain links
+// to the finalized anchor. The walk stops at the blocks which fell out of the
+// window as blocks were finalized during it, so that they are not stored.
+func (s *Service[BeaconBlockT, _]) backfill(
+	ctx context.Context,
+	anchor BeaconBlockT,
+) {
+	//#nosec:G701 // the window is clamped to be non-negative.
+	window := uint64(max(s.config.AvailabilityWindow, 0))
+	s.logger.Info(
+		"Backfilling blocks", "anchor_slot", anchor.GetSlot(),
+		"window", window,
+	)
+
+	var (
+		expected = anchor.GetParentBlockRoot()
+		stored   int
+		oldest   math.Slot
+	)
+	// The genesis slot has no block.
+	for slot := anchor.GetSlot(); slot > 1; {
+		slot--
+		if slot.Unwrap()+window <= s.latest.Load() {
+			break
+		}
+		blk, err := s.fetchBlock(ctx, slot)
+		if errors.Is(err, ErrBlockPruned) {
+			s.logger.Info(
+				"Backfill reached the blocks pruned from the source",
+				"slot", slot,
+			)
+			break
+		}
+		if err != nil {
+			// The context was cancelled.
+			break
+		}
+		if blk.HashTreeRoot() != expected {
+			s.logger.Error(
+				"Aborting backfill", "slot", slot,
+				"error", errors.Wrapf(
+					ErrParentRootMismatch, "expected %s, got %s",
+					expected, blk.HashTreeRoot(),
+				),
+			)
+			break
+		}
+		expected = blk.GetParentBlockRoot()
+		if err = s.store.Set(blk); err != nil {
+			s.logger.Error(
+				"failed to store block", "slot", slot, "error", err,
+			)
+			continue
+		}
+		stored++
+		oldest = slot
+	}
+
+	fields := []any{"blocks", stored}
+	if stored > 0 {
+		fields = append(fields, "oldest_slot", oldest)
+		if oldest == 1 {
+			fields = append(fields, "reached_genesis", true)
+		}
+	}
+	s.logger.Info("Backfill complete", fields...)
+}
+
+// fetchBlock retrieves the block at the given slot from the source,
+// retrying until it succeeds, the block turns out to be pruned from the
+// source or the context is cancelled.
+func (s *Service[BeaconBlockT, _]) fetchBlock(
+	ctx context.Context,
+	slot math.Slot,
+) (BeaconBlockT, error) {
+	for {
+		blk, err := s.source.BlockAtSlot(ctx, slot)
+		if err == nil || errors.Is(err, ErrBlockPruned) {
+			return blk, err
+		}
+		s.logger.Warn(
+			"Failed to retrieve block to backfill, retrying",
+			"slot", slot, "error", err,
+		)
+		select {
+		case <-ctx.Done():
+			return blk, ctx.Err()
+		case <-time.After(backfillRetryInterval):
+		}
+	}
+}
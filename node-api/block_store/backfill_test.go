@@ -0,0 +1,293 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockstore_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/async/dispatcher"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/log/noop"
+	blockstore "github.com/berachain/beacon-kit/node-api/block_store"
+	"github.com/berachain/beacon-kit/primitives/async"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/berachain/beacon-kit/storage/block"
+	"github.com/stretchr/testify/require"
+)
+
+// backfillLogger signals the end of the backfill.
+type backfillLogger struct {
+	noop.Logger[any]
+	once sync.Once
+	done chan struct{}
+}
+
+func (l *backfillLogger) Info(msg string, _ ...any) {
+	if msg == "Backfill complete" {
+		l.once.Do(func() { close(l.done) })
+	}
+}
+
+// recordingStore records the slots of the stored blocks.
+type recordingStore struct {
+	mu    sync.Mutex
+	slots []math.Slot
+}
+
+func (s *recordingStore) Set(blk *ctypes.BeaconBlock) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.slots = append(s.slots, blk.GetSlot())
+	return nil
+}
+
+func (s *recordingStore) stored() []math.Slot {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]math.Slot(nil), s.slots...)
+}
+
+// chainSource serves the blocks of a chain, the ones below heldBelow after
+// release is closed. The blocks below prunedBelow are pruned.
+type chainSource struct {
+	chain       []*ctypes.BeaconBlock
+	heldBelow   math.Slot
+	prunedBelow math.Slot
+	release     chan struct{}
+}
+
+func newChainSource(chain []*ctypes.BeaconBlock) *chainSource {
+	return &chainSource{
+		chain:     chain,
+		heldBelow: math.Slot(len(chain)),
+		release:   make(chan struct{}),
+	}
+}
+
+func (s *chainSource) BlockAtSlot(
+	ctx context.Context, slot math.Slot,
+) (*ctypes.BeaconBlock, error) {
+	if slot < s.heldBelow {
+		select {
+		case <-s.release:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	if slot < s.prunedBelow {
+		return nil, blockstore.ErrBlockPruned
+	}
+	return s.chain[slot], nil
+}
+
+// newChain returns a chain of linked blocks from slot 0 to slot n.
+func newChain(t *testing.T, n uint64) []*ctypes.BeaconBlock {
+	t.Helper()
+	chain := make([]*ctypes.BeaconBlock, 0, n+1)
+	parent := common.Root{}
+	for slot := range n + 1 {
+		blk, err := (&ctypes.BeaconBlock{}).NewWithVersion(
+			math.Slot(slot), 0, parent, version.Deneb,
+		)
+		require.NoError(t, err)
+		blk.StateRoot = common.Root{byte(slot)}
+		blk.Body.ExecutionPayload = &ctypes.ExecutionPayload{
+			Timestamp: math.U64(slot),
+		}
+		chain = append(chain, blk)
+		parent = blk.HashTreeRoot()
+	}
+	return chain
+}
+
+// startService starts a block service backfilling from source into store
+// and returns the dispatcher the finalized blocks are published on, along
+// with the logger signalling the end of the backfill.
+func startService[BlockStoreT blockstore.BlockStore[*ctypes.BeaconBlock]](
+	t *testing.T, window int, store BlockStoreT, source *chainSource,
+) (*dispatcher.Dispatcher, *backfillLogger) {
+	t.Helper()
+	d, err := dispatcher.New(
+		noop.NewLogger[any](),
+		dispatcher.WithEvent[async.Event[*ctypes.BeaconBlock]](
+			async.BeaconBlockFinalized,
+		),
+	)
+	require.NoError(t, err)
+
+	logger := &backfillLogger{done: make(chan struct{})}
+	cfg := blockstore.DefaultConfig()
+	cfg.Enabled = true
+	cfg.AvailabilityWindow = window
+	svc := blockstore.NewService[*ctypes.BeaconBlock](
+		cfg, logger, d, store, source,
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	require.NoError(t, d.Start(ctx))
+	require.NoError(t, svc.Start(ctx))
+	return d, logger
+}
+
+func publish(t *testing.T, d *dispatcher.Dispatcher, blk *ctypes.BeaconBlock) {
+	t.Helper()
+	require.NoError(t, d.Publish(async.NewEvent(
+		context.Background(), async.BeaconBlockFinalized, blk,
+	)))
+}
+
+func waitBackfill(t *testing.T, logger *backfillLogger) {
+	t.Helper()
+	select {
+	case <-logger.done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("backfill did not complete")
+	}
+}
+
+func TestBackfill(t *testing.T) {
+	tests := []struct {
+		name       string
+		anchorSlot math.Slot
+		window     int
+		// backfilled are the slots stored after the anchor's, in the order
+		// they are retrieved.
+		backfilled []math.Slot
+	}{
+		{
+			name:       "anchor slot 0",
+			anchorSlot: 0,
+			window:     4,
+		},
+		{
+			name:       "anchor slot 1",
+			anchorSlot: 1,
+			window:     4,
+		},
+		{
+			name:       "window 0",
+			anchorSlot: 5,
+			window:     0,
+		},
+		{
+			name:       "window 1",
+			anchorSlot: 5,
+			window:     1,
+		},
+		{
+			name:       "window larger than the chain",
+			anchorSlot: 5,
+			window:     100,
+			backfilled: []math.Slot{4, 3, 2, 1},
+		},
+		{
+			name:       "chain longer than the window",
+			anchorSlot: 5,
+			window:     3,
+			backfilled: []math.Slot{4, 3},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chain := newChain(t, tt.anchorSlot.Unwrap())
+			source := newChainSource(chain)
+			close(source.release)
+			store := &recordingStore{}
+			d, logger := startService(t, tt.window, store, source)
+
+			publish(t, d, chain[tt.anchorSlot])
+			waitBackfill(t, logger)
+			require.Equal(t,
+				append([]math.Slot{tt.anchorSlot}, tt.backfilled...),
+				store.stored(),
+			)
+		})
+	}
+}
+
+func TestBackfillKeepsBlocksFinalizedDuringTheWalk(t *testing.T) {
+	const window = 4
+	chain := newChain(t, 6)
+	source := newChainSource(chain)
+	store := block.NewStore[*ctypes.BeaconBlock](
+		noop.NewLogger[any](), window,
+	)
+	d, logger := startService(t, window, store, source)
+
+	// Slot 6 is finalized while the blocks preceding slot 5 are retrieved,
+	// so slot 2 falls out of the window.
+	publish(t, d, chain[5])
+	publish(t, d, chain[6])
+	require.Eventually(t, func() bool {
+		_, err := store.GetSlotByBlockRoot(chain[6].HashTreeRoot())
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+	close(source.release)
+	waitBackfill(t, logger)
+
+	for slot := range math.Slot(7) {
+		_, err := store.GetSlotByBlockRoot(chain[slot].HashTreeRoot())
+		if slot >= 3 {
+			require.NoError(t, err, "slot %d", slot)
+		} else {
+			require.Error(t, err, "slot %d", slot)
+		}
+	}
+}
+
+func TestBackfillStoresBlocksAsTheyAreRetrieved(t *testing.T) {
+	chain := newChain(t, 6)
+	source := newChainSource(chain)
+	source.heldBelow = 3
+	store := &recordingStore{}
+	d, logger := startService(t, 100, store, source)
+
+	// The blocks retrieved are stored while the walk waits for the older
+	// ones.
+	publish(t, d, chain[6])
+	require.Eventually(t, func() bool {
+		return len(store.stored()) == 4
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, []math.Slot{6, 5, 4, 3}, store.stored())
+
+	close(source.release)
+	waitBackfill(t, logger)
+	require.Equal(t, []math.Slot{6, 5, 4, 3, 2, 1}, store.stored())
+}
+
+func TestBackfillStopsAtPrunedBlocks(t *testing.T) {
+	chain := newChain(t, 6)
+	source := newChainSource(chain)
+	source.prunedBelow = 3
+	close(source.release)
+	store := &recordingStore{}
+	d, logger := startService(t, 100, store, source)
+
+	publish(t, d, chain[6])
+	waitBackfill(t, logger)
+	require.Equal(t, []math.Slot{6, 5, 4, 3}, store.stored())
+}
@@ -30,6 +30,10 @@ type Config struct {
 	Enabled bool `mapstructure:"enabled"`
 	// AvailabilityWindow is the number of slots to keep in the store.
 	AvailabilityWindow int `mapstructure:"availability-window"`
+	// BackfillURL is the CometBFT RPC endpoint of a peer or an archive node
+	// to backfill the blocks preceding the first finalized block from, e.g.
+	// after state sync. Backfill is disabled if empty.
+	BackfillURL string `mapstructure:"backfill-url"`
 }
 
 // DefaultConfig returns the default configuration for the block service.
@@ -22,6 +22,8 @@ package blockstore
 
 import (
 	"context"
+	"sync"
+	"sync/atomic"
 
 	asynctypes "github.com/berachain/beacon-kit/async/types"
 	"github.com/berachain/beacon-kit/log"
@@ -43,6 +45,13 @@ type Service[
 	store BlockStoreT
 	// subFinalizedBlkEvents is a channel holding BeaconBlockFinalized
 	subFinalizedBlkEvents chan async.Event[BeaconBlockT]
+	// source retrieves the blocks to backfill. It is nil if backfill is
+	// disabled.
+	source BlockSource[BeaconBlockT]
+	// backfillOnce starts the backfill from the first finalized block.
+	backfillOnce sync.Once
+	// latest is the slot of the latest finalized block.
+	latest atomic.Uint64
 }
 
 // NewService creates a new block service.
@@ -54,6 +63,7 @@ func NewService[
 	logger log.Logger,
 	dispatcher asynctypes.EventDispatcher,
 	store BlockStoreT,
+	source BlockSource[BeaconBlockT],
 ) *Service[BeaconBlockT, BlockStoreT] {
 	return &Service[BeaconBlockT, BlockStoreT]{
 		config:                config,
@@ -61,6 +71,7 @@ func NewService[
 		dispatcher:            dispatcher,
 		store:                 store,
 		subFinalizedBlkEvents: make(chan async.Event[BeaconBlockT]),
+		source:                source,
 	}
 }
 
@@ -97,20 +108,27 @@ func (s *Service[_, _]) eventLoop(ctx context.Context) {
 		case <-ctx.Done():
 			return
 		case event := <-s.subFinalizedBlkEvents:
-			s.onFinalizeBlock(event)
+			s.onFinalizeBlock(ctx, event)
 		}
 	}
 }
 
 // onFinalizeBlock is triggered when a finalized block event is received.
-// It stores the block in the KVStore.
+// It stores the block in the KVStore, and backfills the blocks preceding the
+// first one in the background.
 func (s *Service[BeaconBlockT, _]) onFinalizeBlock(
+	ctx context.Context,
 	event async.Event[BeaconBlockT],
 ) {
 	slot := event.Data().GetSlot()
+	s.latest.Store(slot.Unwrap())
 	if err := s.store.Set(event.Data()); err != nil {
 		s.logger.Error(
 			"failed to store block", "slot", slot, "error", err,
 		)
 	}
+
+	if s.source != nil {
+		s.backfillOnce.Do(func() { go s.backfill(ctx, event.Data()) })
+	}
 }
@@ -21,7 +21,10 @@
 package blockstore
 
 import (
+	"context"
+
 	"github.com/berachain/beacon-kit/primitives/async"
+	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/constraints"
 	"github.com/berachain/beacon-kit/primitives/math"
 )
@@ -31,6 +34,17 @@ type BeaconBlock interface {
 	constraints.SSZMarshaler
 	// GetSlot returns the slot of the block.
 	GetSlot() math.U64
+	// HashTreeRoot returns the root of the block.
+	HashTreeRoot() common.Root
+	// GetParentBlockRoot returns the root of the parent block.
+	GetParentBlockRoot() common.Root
+}
+
+// BlockSource retrieves finalized blocks from outside of the node.
+type BlockSource[BeaconBlockT BeaconBlock] interface {
+	// BlockAtSlot returns the finalized block at the given slot, or
+	// ErrBlockPruned if the source pruned it.
+	BlockAtSlot(ctx context.Context, slot math.Slot) (BeaconBlockT, error)
 }
 
 // BlockStore is a generic interface for a block store.
@@ -0,0 +1,242 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package echo
+
+import (
+	"container/list"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/primitives/crypto/sha256"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	// immutableCacheControl lets clients keep a finalized response forever.
+	immutableCacheControl = "public, max-age=31536000, immutable"
+	// revalidateCacheControl makes clients check the ETag of a response that
+	// changes with the next block before reusing it.
+	revalidateCacheControl = "no-cache"
+)
+
+// idParams are the path parameters that pin a request to a state.
+//
+//nolint:gochecknoglobals // read-only.
+var idParams = []string{"state_id", "block_id", "timestamp_id"}
+
+// SlotSource reports the slot of the latest committed state.
+type SlotSource interface {
+	// LatestSlot returns the slot of the latest committed state.
+	LatestSlot() (math.Slot, error)
+}
+
+// ResponseCache keeps the encoded responses of cached routes, bounded by
+// their total size and evicted least recently used first.
+//
+// A response read from a finalized state requested by slot or root can never
+// change, so it is kept until evicted and served as immutable. A response to
+// a moving ID such as "head" is only reused while no block has been committed
+// since it was built.
+type ResponseCache struct {
+	mu      sync.Mutex
+	latest  SlotSource
+	maxSize uint64
+	size    uint64
+	order   *list.List
+	entries map[string]*list.Element
+}
+
+// cachedResponse is an encoded response along with when it may be reused.
+type cachedResponse struct {
+	key  string
+	body []byte
+	etag string
+	// immutable is set when the response can never change.
+	immutable bool
+	// slot is the latest committed slot when a mutable response was built.
+	slot math.Slot
+}
+
+// NewResponseCache creates a response cache holding up to maxSize bytes of
+// responses, invalidating mutable ones when latest moves.
+func NewResponseCache(maxSize uint64, latest SlotSource) *ResponseCache {
+	return &ResponseCache{
+		latest:  latest,
+		maxSize: maxSize,
+		order:   list.New(),
+		entries: make(map[string]*list.Element),
+	}
+}
+
+// middleware serves the route from the cache, building and storing its
+// response on a miss.
+func (rc *ResponseCache) middleware(
+	handler *handlers.Route[Context],
+) echo.HandlerFunc {
+	return func(c Context) error {
+		// The slot is read before the handler runs, so that a block committed
+		// meanwhile invalidates the response rather than being missed by it.
+		key := c.Request().URL.RequestURI()
+		slot, slotErr := rc.latest.LatestSlot()
+		cacheable := slotErr == nil && c.Request().Method == http.MethodGet
+		if cacheable {
+			if entry, ok := rc.get(key, slot); ok {
+				return serveCached(c, entry)
+			}
+		}
+
+		data, err := handler.Handler(c)
+		if _, ok := data.(types.EventStream); !cacheable || ok || err != nil {
+			return writeResponse(c, data, err)
+		}
+		body, err := json.Marshal(data)
+		if err != nil {
+			return writeResponse(c, nil, err)
+		}
+		entry := &cachedResponse{
+			key:       key,
+			body:      body,
+			etag:      etagOf(body),
+			immutable: isPinned(c) && isFinalized(data),
+			slot:      slot,
+		}
+		rc.put(entry)
+		return serveCached(c, entry)
+	}
+}
+
+// get returns the response cached under key if it is still valid with slot
+// as the latest committed slot.
+func (rc *ResponseCache) get(
+	key string, slot math.Slot,
+) (*cachedResponse, bool) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	elem, ok := rc.entries[key]
+	if !ok {
+		return nil, false
+	}
+	//nolint:errcheck // only cached responses are stored.
+	entry := elem.Value.(*cachedResponse)
+	if !entry.immutable && entry.slot != slot {
+		rc.remove(elem)
+		return nil, false
+	}
+	rc.order.MoveToFront(elem)
+	return entry, true
+}
+
+// put stores the response, evicting the least recently used ones until the
+// cache fits its size. Responses larger than the cache are not stored.
+func (rc *ResponseCache) put(entry *cachedResponse) {
+	size := uint64(len(entry.body))
+	if size > rc.maxSize {
+		return
+	}
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	if elem, ok := rc.entries[entry.key]; ok {
+		rc.remove(elem)
+	}
+	for rc.size+size > rc.maxSize {
+		rc.remove(rc.order.Back())
+	}
+	rc.entries[entry.key] = rc.order.PushFront(entry)
+	rc.size += size
+}
+
+// remove drops the element from the cache. The caller must hold the lock.
+func (rc *ResponseCache) remove(elem *list.Element) {
+	//nolint:errcheck // only cached responses are stored.
+	entry := rc.order.Remove(elem).(*cachedResponse)
+	delete(rc.entries, entry.key)
+	rc.size -= uint64(len(entry.body))
+}
+
+// serveCached writes the cached response, or Not Modified if the client
+// already holds it.
+func serveCached(c Context, entry *cachedResponse) error {
+	header := c.Response().Header()
+	header.Set("ETag", entry.etag)
+	if entry.immutable {
+		header.Set(echo.HeaderCacheControl, immutableCacheControl)
+	} else {
+		header.Set(echo.HeaderCacheControl, revalidateCacheControl)
+	}
+	if matchesETag(c.Request().Header.Get("If-None-Match"), entry.etag) {
+		return c.NoContent(http.StatusNotModified)
+	}
+	return c.JSONBlob(http.StatusOK, entry.body)
+}
+
+// isPinned reports whether every state, block or timestamp ID of the request
+// names a fixed slot or root, rather than one that moves with new blocks.
+func isPinned(c Context) bool {
+	pinned := false
+	for _, name := range idParams {
+		id := c.Param(name)
+		switch {
+		case id == "":
+			continue
+		case id == utils.StateIDHead, id == utils.StateIDFinalized,
+			id == utils.StateIDJustified, utils.IsTimestampIDPrefix(id):
+			return false
+		}
+		if slot, err := utils.U64FromString(id); err == nil &&
+			slot == utils.Head {
+			// A slot of zero stands for the latest state.
+			return false
+		}
+		pinned = true
+	}
+	return pinned
+}
+
+// isFinalized reports whether the response declares it was read from a
+// finalized state.
+func isFinalized(data any) bool {
+	finalizable, ok := data.(types.Finalizable)
+	return ok && finalizable.IsFinalized()
+}
+
+// etagOf returns a strong ETag for the encoded response.
+func etagOf(body []byte) string {
+	sum := sha256.Hash(body)
+	return `"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+// matchesETag reports whether the If-None-Match header lists the ETag.
+func matchesETag(ifNoneMatch, etag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
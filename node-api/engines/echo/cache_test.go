@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package echo_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/node-api/engines/echo"
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	beacontypes "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/stretchr/testify/require"
+)
+
+// latestSlot is a slot source the test moves by hand.
+type latestSlot struct {
+	slot atomic.Uint64
+}
+
+func (l *latestSlot) LatestSlot() (math.Slot, error) {
+	return math.Slot(l.slot.Load()), nil
+}
+
+// newCachedEngine serves a cached route counting how often its handler runs.
+func newCachedEngine(
+	t *testing.T, latest *latestSlot,
+) (*echo.Engine, *atomic.Int64) {
+	t.Helper()
+	calls := new(atomic.Int64)
+	engine := echo.NewDefaultEngine()
+	engine.UseResponseCache(echo.NewResponseCache(1<<20, latest))
+	engine.RegisterRoutes(handlers.NewRouteSet[echo.Context]("",
+		&handlers.Route[echo.Context]{
+			Method: http.MethodGet,
+			Path:   "/eth/v1/beacon/states/:state_id/root",
+			Handler: func(echo.Context) (any, error) {
+				calls.Add(1)
+				return beacontypes.ValidatorResponse{
+					Finalized: true,
+					Data:      calls.Load(),
+				}, nil
+			},
+			Cached: true,
+		},
+	), noop.NewLogger[log.Logger]())
+	return engine, calls
+}
+
+func get(
+	engine *echo.Engine, path, etag string,
+) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	if etag != "" {
+		req.Header.Set("If-None-Match", etag)
+	}
+	rec := httptest.NewRecorder()
+	engine.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestResponseCacheServesFinalizedResponsesAsImmutable(t *testing.T) {
+	latest := new(latestSlot)
+	latest.slot.Store(10)
+	engine, calls := newCachedEngine(t, latest)
+
+	first := get(engine, "/eth/v1/beacon/states/5/root", "")
+	require.Equal(t, http.StatusOK, first.Code)
+	require.Contains(t, first.Header().Get("Cache-Control"), "immutable")
+	etag := first.Header().Get("ETag")
+	require.NotEmpty(t, etag)
+
+	// New blocks do not invalidate a response pinned to a finalized slot.
+	latest.slot.Store(11)
+	second := get(engine, "/eth/v1/beacon/states/5/root", "")
+	require.Equal(t, first.Body.String(), second.Body.String())
+	require.Equal(t, etag, second.Header().Get("ETag"))
+	require.Equal(t, int64(1), calls.Load())
+
+	notModified := get(engine, "/eth/v1/beacon/states/5/root", etag)
+	require.Equal(t, http.StatusNotModified, notModified.Code)
+	require.Equal(t, int64(1), calls.Load())
+}
+
+func TestResponseCacheInvalidatesHeadOnNewBlock(t *testing.T) {
+	latest := new(latestSlot)
+	latest.slot.Store(10)
+	engine, calls := newCachedEngine(t, latest)
+
+	first := get(engine, "/eth/v1/beacon/states/head/root", "")
+	require.Equal(t, http.StatusOK, first.Code)
+	require.Equal(t, "no-cache", first.Header().Get("Cache-Control"))
+	get(engine, "/eth/v1/beacon/states/head/root", "")
+	require.Equal(t, int64(1), calls.Load())
+
+	latest.slot.Store(11)
+	stale := get(
+		engine, "/eth/v1/beacon/states/head/root", first.Header().Get("ETag"),
+	)
+	require.Equal(t, http.StatusOK, stale.Code)
+	require.NotEqual(t, first.Body.String(), stale.Body.String())
+	require.Equal(t, int64(2), calls.Load())
+}
@@ -31,6 +31,7 @@ import (
 type Engine struct {
 	*echo.Echo
 	logger log.Logger
+	cache  *ResponseCache
 }
 
 // New initializes a new API engine with the given Echo instance.
@@ -58,6 +59,12 @@ func (e *Engine) Run(addr string) error {
 	return e.Echo.Start(addr)
 }
 
+// UseResponseCache serves the routes marked as cached from the given cache.
+// It must be called before the routes are registered.
+func (e *Engine) UseResponseCache(cache *ResponseCache) {
+	e.cache = cache
+}
+
 // RegisterRoutes registers the given route set with the Echo engine.
 func (e *Engine) RegisterRoutes(
 	hs *handlers.RouteSet[Context],
@@ -67,10 +74,10 @@ func (e *Engine) RegisterRoutes(
 	group := e.Group(hs.BasePath)
 	for _, route := range hs.Routes {
 		route.DecorateWithLogs(e.logger)
-		group.Add(
-			route.Method,
-			route.Path,
-			responseMiddleware(route),
-		)
+		handler := responseMiddleware(route)
+		if route.Cached && e.cache != nil {
+			handler = e.cache.middleware(route)
+		}
+		group.Add(route.Method, route.Path, handler)
 	}
 }
@@ -42,11 +42,34 @@ func responseMiddleware(
 ) echo.HandlerFunc {
 	return func(c Context) error {
 		data, err := handler.Handler(c)
-		code, response := responseFromError(data, err)
-		return c.JSON(code, response)
+		return writeResponse(c, data, err)
 	}
 }
 
+// writeResponse writes the result of a handler, streaming it if it is an
+// event stream.
+func writeResponse(c Context, data any, err error) error {
+	if stream, ok := data.(types.EventStream); ok && err == nil {
+		return serveStream(c, stream)
+	}
+	code, response := responseFromError(data, err)
+	return c.JSON(code, response)
+}
+
+// serveStream writes the event stream to the response until the client
+// disconnects.
+func serveStream(c Context, stream types.EventStream) error {
+	header := c.Response().Header()
+	header.Set(echo.HeaderContentType, "text/event-stream")
+	header.Set(echo.HeaderCacheControl, "no-cache")
+	header.Set(echo.HeaderConnection, "keep-alive")
+	c.Response().WriteHeader(http.StatusOK)
+	c.Response().Flush()
+	return stream.Serve(
+		c.Request().Context(), c.Response(), c.Response().Flush,
+	)
+}
+
 // responseFromErr converts an error to an HTTP status code and response. If
 // the error is nil, the response is returned as is.
 func responseFromError(data any, err error) (int, any) {
@@ -61,13 +61,16 @@ func (cv *CustomValidator) Validate(i interface{}) error {
 
 func ConstructValidator() *validator.Validate {
 	validators := map[string](func(fl validator.FieldLevel) bool){
-		"state_id":         ValidateStateID,
-		"block_id":         ValidateBlockID,
-		"timestamp_id":     ValidateTimestampID,
-		"validator_id":     ValidateValidatorID,
-		"epoch":            ValidateUint64,
-		"slot":             ValidateUint64,
-		"validator_status": ValidateValidatorStatus,
+		"state_id":          ValidateStateID,
+		"block_id":          ValidateBlockID,
+		"timestamp_id":      ValidateTimestampID,
+		"validator_id":      ValidateValidatorID,
+		"epoch":             ValidateUint64,
+		"slot":              ValidateUint64,
+		"validator_status":  ValidateValidatorStatus,
+		"uint64":            ValidateUint64,
+		"execution_address": ValidateExecutionAddress,
+		"pubkey":            ValidatePubkey,
 	}
 	validate := validator.New()
 	for tag, fn := range validators {
@@ -150,6 +153,20 @@ func ValidateRoot(value string) bool {
 	return err == nil
 }
 
+// ValidateExecutionAddress checks if the provided field is a valid
+// hex-encoded execution address with "0x" prefix.
+func ValidateExecutionAddress(fl validator.FieldLevel) bool {
+	_, err := common.ParseExecutionAddress(fl.Field().String())
+	return err == nil
+}
+
+// ValidatePubkey checks if the provided field is a valid hex-encoded BLS
+// public key.
+func ValidatePubkey(fl validator.FieldLevel) bool {
+	var key crypto.BLSPubkey
+	return key.UnmarshalText([]byte(fl.Field().String())) == nil
+}
+
 func ValidateValidatorStatus(fl validator.FieldLevel) bool {
 	// Eth Beacon Node API specs: https://hackmd.io/ofFJ5gOmQpu1jjHilHbdQQ
 	allowedStatuses := map[string]bool{
@@ -21,7 +21,10 @@
 package beacon
 
 import (
+	"time"
+
 	"github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/math"
 )
@@ -31,17 +34,23 @@ type Backend[BlockHeaderT, ForkT, ValidatorT any] interface {
 	GenesisBackend
 	BlockBackend[BlockHeaderT]
 	RandaoBackend
+	ProposerBackend
+	ForkDigestBackend
 	StateBackend[ForkT]
 	ValidatorBackend[ValidatorT]
 	HistoricalBackend[ForkT]
-	// GetSlotByBlockRoot retrieves the slot by a given root from the store.
-	GetSlotByBlockRoot(root common.Root) (math.Slot, error)
-	// GetSlotByStateRoot retrieves the slot by a given root from the store.
-	GetSlotByStateRoot(root common.Root) (math.Slot, error)
+	utils.StateIndex
 }
 
 type GenesisBackend interface {
 	GenesisValidatorsRoot(slot math.Slot) (common.Root, error)
+	GenesisForkVersion() common.Version
+}
+
+// GenesisClock reports the genesis time of the chain.
+type GenesisClock interface {
+	// GenesisTime returns the time the first slot starts at.
+	GenesisTime() time.Time
 }
 
 type HistoricalBackend[ForkT any] interface {
@@ -53,6 +62,22 @@ type RandaoBackend interface {
 	RandaoAtEpoch(slot math.Slot, epoch math.Epoch) (common.Bytes32, error)
 }
 
+// ProposerBackend projects proposer assignments from the beacon state.
+type ProposerBackend interface {
+	// ProposerLookahead returns the proposers of the epoch of the given slot
+	// and of the epochs after it, one entry per epoch.
+	ProposerLookahead(
+		slot math.Slot, epochs uint64,
+	) ([]*types.ProposerLookaheadData, error)
+}
+
+// ForkDigestBackend derives the fork digests of the chain.
+type ForkDigestBackend interface {
+	// ForkDigests returns the fork digest of every fork of the chain and the
+	// ENRForkID of the chain at the given slot.
+	ForkDigests(slot math.Slot) (*types.ForkDigestsData, error)
+}
+
 type BlockBackend[BeaconBlockHeaderT any] interface {
 	BlockRootAtSlot(slot math.Slot) (common.Root, error)
 	BlockRewardsAtSlot(slot math.Slot) (*types.BlockRewardsData, error)
@@ -77,4 +102,8 @@ type ValidatorBackend[ValidatorT any] interface {
 		slot math.Slot,
 		ids []string,
 	) ([]*types.ValidatorBalanceData, error)
+	ValidatorsByWithdrawalAddress(
+		slot math.Slot,
+		address common.ExecutionAddress,
+	) ([]*types.ValidatorData[ValidatorT], error)
 }
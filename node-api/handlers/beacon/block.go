@@ -32,17 +32,17 @@ func (h *Handler[_, ContextT, _, _]) GetBlockRewards(c ContextT) (any, error) {
 	if err != nil {
 		return nil, err
 	}
-	slot, err := utils.SlotFromBlockID(req.BlockID, h.backend)
+	state, err := h.resolver.ResolveBlockID(req.BlockID)
 	if err != nil {
 		return nil, err
 	}
-	rewards, err := h.backend.BlockRewardsAtSlot(slot)
+	rewards, err := h.backend.BlockRewardsAtSlot(state.Slot)
 	if err != nil {
 		return nil, err
 	}
 	return &beacontypes.ValidatorResponse{
 		ExecutionOptimistic: false, // stubbed
-		Finalized:           false, // stubbed
+		Finalized:           state.Finalized,
 		Data:                rewards,
 	}, nil
 }
@@ -21,22 +21,32 @@
 package beacon
 
 import (
+	"strconv"
+
 	beacontypes "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
 	"github.com/berachain/beacon-kit/node-api/handlers/types"
 	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/primitives/common"
 )
 
 func (h *Handler[_, ContextT, _, _]) GetGenesis(_ ContextT) (any, error) {
-	genesisRoot, err := h.backend.GenesisValidatorsRoot(utils.Genesis)
+	state, err := h.resolver.ResolveStateID(utils.StateIDGenesis)
+	if err != nil {
+		return nil, err
+	}
+	genesisRoot, err := h.backend.GenesisValidatorsRoot(state.Slot)
 	if err != nil {
 		return nil, err
 	}
-	if len(genesisRoot) == 0 {
+	// The genesis validators root is only set once the chain started.
+	if genesisRoot == (common.Root{}) {
 		return nil, types.ErrNotFound
 	}
 	return types.Wrap(beacontypes.GenesisData{
-		GenesisTime:           "1590832934", // stub
+		GenesisTime: strconv.FormatInt(
+			h.clock.GenesisTime().Unix(), 10,
+		),
 		GenesisValidatorsRoot: genesisRoot,
-		GenesisForkVersion:    "0x00000000", // stub
+		GenesisForkVersion:    h.backend.GenesisForkVersion(),
 	}), nil
 }
@@ -23,6 +23,7 @@ package beacon
 import (
 	"github.com/berachain/beacon-kit/node-api/handlers"
 	"github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
 	"github.com/berachain/beacon-kit/node-api/server/context"
 )
 
@@ -34,7 +35,9 @@ type Handler[
 	ValidatorT any,
 ] struct {
 	*handlers.BaseHandler[ContextT]
-	backend Backend[BeaconBlockHeaderT, ForkT, ValidatorT]
+	backend  Backend[BeaconBlockHeaderT, ForkT, ValidatorT]
+	resolver *utils.StateResolver
+	clock    GenesisClock
 }
 
 // NewHandler creates a new handler for the beacon API.
@@ -45,12 +48,15 @@ func NewHandler[
 	ValidatorT any,
 ](
 	backend Backend[BeaconBlockHeaderT, ForkT, ValidatorT],
+	clock GenesisClock,
 ) *Handler[BeaconBlockHeaderT, ContextT, ForkT, ValidatorT] {
 	h := &Handler[BeaconBlockHeaderT, ContextT, ForkT, ValidatorT]{
 		BaseHandler: handlers.NewBaseHandler(
 			handlers.NewRouteSet[ContextT](""),
 		),
-		backend: backend,
+		backend:  backend,
+		resolver: utils.NewStateResolver(backend),
+		clock:    clock,
 	}
 	return h
 }
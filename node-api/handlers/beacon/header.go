@@ -39,13 +39,17 @@ func (h *Handler[
 	if err != nil {
 		return nil, err
 	}
-	header, err := h.backend.BlockHeaderAtSlot(slot)
+	state, err := h.resolver.ResolveSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+	header, err := h.backend.BlockHeaderAtSlot(state.Slot)
 	if err != nil {
 		return nil, err
 	}
 	return beacontypes.ValidatorResponse{
 		ExecutionOptimistic: false, // stubbed
-		Finalized:           false, // stubbed
+		Finalized:           state.Finalized,
 		Data: &beacontypes.BlockHeaderResponse[BeaconBlockHeaderT]{
 			Root:      header.GetBodyRoot(),
 			Canonical: true,
@@ -66,17 +70,17 @@ func (h *Handler[
 	if err != nil {
 		return nil, err
 	}
-	slot, err := utils.SlotFromBlockID(req.BlockID, h.backend)
+	state, err := h.resolver.ResolveBlockID(req.BlockID)
 	if err != nil {
 		return nil, err
 	}
-	header, err := h.backend.BlockHeaderAtSlot(slot)
+	header, err := h.backend.BlockHeaderAtSlot(state.Slot)
 	if err != nil {
 		return nil, err
 	}
 	return beacontypes.ValidatorResponse{
 		ExecutionOptimistic: false, // stubbed
-		Finalized:           false, // stubbed
+		Finalized:           state.Finalized,
 		Data: &beacontypes.BlockHeaderResponse[BeaconBlockHeaderT]{
 			Root:      header.GetBodyRoot(),
 			Canonical: true,
@@ -33,11 +33,11 @@ func (h *Handler[_, ContextT, _, _]) GetStateRoot(c ContextT) (any, error) {
 	if err != nil {
 		return nil, err
 	}
-	slot, err := utils.SlotFromStateID(req.StateID, h.backend)
+	state, err := h.resolver.ResolveStateID(req.StateID)
 	if err != nil {
 		return nil, err
 	}
-	stateRoot, err := h.backend.StateRootAtSlot(slot)
+	stateRoot, err := h.backend.StateRootAtSlot(state.Slot)
 	if err != nil {
 		return nil, err
 	}
@@ -46,7 +46,7 @@ func (h *Handler[_, ContextT, _, _]) GetStateRoot(c ContextT) (any, error) {
 	}
 	return beacontypes.ValidatorResponse{
 		ExecutionOptimistic: false, // stubbed
-		Finalized:           false, // stubbed
+		Finalized:           state.Finalized,
 		Data:                beacontypes.RootData{Root: stateRoot},
 	}, nil
 }
@@ -58,17 +58,17 @@ func (h *Handler[_, ContextT, _, _]) GetStateFork(c ContextT) (any, error) {
 	if err != nil {
 		return nil, err
 	}
-	slot, err := utils.SlotFromStateID(req.StateID, h.backend)
+	state, err := h.resolver.ResolveStateID(req.StateID)
 	if err != nil {
 		return nil, err
 	}
-	fork, err := h.backend.StateForkAtSlot(slot)
+	fork, err := h.backend.StateForkAtSlot(state.Slot)
 	if err != nil {
 		return nil, err
 	}
 	return beacontypes.ValidatorResponse{
 		ExecutionOptimistic: false, // stubbed
-		Finalized:           false, // stubbed
+		Finalized:           state.Finalized,
 		Data:                types.Wrap(fork),
 	}, nil
 }
@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacon
+
+import (
+	beacontypes "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// maxProposerLookaheadEpochs bounds how many epochs a single proposer
+// lookahead request may project.
+const maxProposerLookaheadEpochs = 16
+
+// GetProposerLookahead returns the proposers of the epoch of the requested
+// state and of the epochs following it. Epochs whose RANDAO seed is not yet
+// fixed are flagged, since their assignments will change as reveals land.
+func (h *Handler[_, ContextT, _, _]) GetProposerLookahead(
+	c ContextT,
+) (any, error) {
+	req, err := utils.BindAndValidate[beacontypes.GetProposerLookaheadRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	epochs := math.U64(1)
+	if req.Epochs != "" {
+		epochs, err = utils.U64FromString(req.Epochs)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if epochs == 0 || epochs > maxProposerLookaheadEpochs {
+		return nil, types.ErrInvalidRequest
+	}
+	state, err := h.resolver.ResolveStateID(req.StateID)
+	if err != nil {
+		return nil, err
+	}
+	lookahead, err := h.backend.ProposerLookahead(
+		state.Slot, epochs.Unwrap(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return beacontypes.ValidatorResponse{
+		ExecutionOptimistic: false, // stubbed
+		Finalized:           state.Finalized,
+		Data:                lookahead,
+	}, nil
+}
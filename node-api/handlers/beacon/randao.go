@@ -34,7 +34,7 @@ func (h *Handler[_, ContextT, _, _]) GetRandao(c ContextT) (any, error) {
 	if err != nil {
 		return nil, err
 	}
-	slot, err := utils.SlotFromStateID(req.StateID, h.backend)
+	state, err := h.resolver.ResolveStateID(req.StateID)
 	if err != nil {
 		return nil, err
 	}
@@ -45,13 +45,13 @@ func (h *Handler[_, ContextT, _, _]) GetRandao(c ContextT) (any, error) {
 			return nil, err
 		}
 	}
-	randao, err := h.backend.RandaoAtEpoch(slot, epoch)
+	randao, err := h.backend.RandaoAtEpoch(state.Slot, epoch)
 	if err != nil {
 		return nil, err
 	}
 	return beacontypes.ValidatorResponse{
 		ExecutionOptimistic: false, // stubbed
-		Finalized:           false, // stubbed
+		Finalized:           state.Finalized,
 		Data:                randao,
 	}, nil
 }
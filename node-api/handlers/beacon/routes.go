@@ -42,11 +42,13 @@ func (h *Handler[_, ContextT, _, _]) RegisterRoutes(
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/states/:state_id/root",
 			Handler: h.GetStateRoot,
+			Cached:  true,
 		},
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/states/:state_id/fork",
 			Handler: h.GetStateFork,
+			Cached:  true,
 		},
 		{
 			Method:  http.MethodGet,
@@ -57,6 +59,7 @@ func (h *Handler[_, ContextT, _, _]) RegisterRoutes(
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/states/:state_id/validators",
 			Handler: h.GetStateValidators,
+			Cached:  true,
 		},
 		{
 			Method:  http.MethodPost,
@@ -67,11 +70,19 @@ func (h *Handler[_, ContextT, _, _]) RegisterRoutes(
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/states/:state_id/validators/:validator_id",
 			Handler: h.GetStateValidator,
+			Cached:  true,
 		},
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/states/:state_id/validator_balances",
 			Handler: h.GetStateValidatorBalances,
+			Cached:  true,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/bkit/v1/beacon/states/:state_id/validators/by_address/:address",
+			Handler: h.GetStateValidatorsByWithdrawalAddress,
+			Cached:  true,
 		},
 		{
 			Method:  http.MethodPost,
@@ -92,6 +103,19 @@ func (h *Handler[_, ContextT, _, _]) RegisterRoutes(
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/states/:state_id/randao",
 			Handler: h.GetRandao,
+			Cached:  true,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/bkit/v1/beacon/states/:state_id/proposer_lookahead",
+			Handler: h.GetProposerLookahead,
+			Cached:  true,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/bkit/v1/beacon/states/:state_id/fork_digests",
+			Handler: h.GetForkDigests,
+			Cached:  true,
 		},
 		{
 			Method:  http.MethodGet,
@@ -102,22 +126,13 @@ func (h *Handler[_, ContextT, _, _]) RegisterRoutes(
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/headers/:block_id",
 			Handler: h.GetBlockHeaderByID,
-		},
-		{
-			Method:  http.MethodPost,
-			Path:    "/eth/v1/beacon/blocks/blinded_blocks",
-			Handler: h.NotImplemented,
+			Cached:  true,
 		},
 		{
 			Method:  http.MethodPost,
 			Path:    "eth/v2/beacon/blocks/blinded_blocks",
 			Handler: h.NotImplemented,
 		},
-		{
-			Method:  http.MethodPost,
-			Path:    "/eth/v1/beacon/blocks",
-			Handler: h.NotImplemented,
-		},
 		{
 			Method:  http.MethodPost,
 			Path:    "eth/v2/beacon/blocks",
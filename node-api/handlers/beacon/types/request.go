@@ -53,6 +53,11 @@ type GetStateValidatorRequest struct {
 	ValidatorID string `query:"validator_id" validate:"required,validator_id"`
 }
 
+type GetValidatorsByAddressRequest struct {
+	types.StateIDRequest
+	Address string `param:"address" validate:"required,execution_address"`
+}
+
 type GetValidatorBalancesRequest struct {
 	types.StateIDRequest
 	IDs []string `query:"id" validate:"dive,validator_id"`
@@ -80,6 +85,15 @@ type GetRandaoRequest struct {
 	EpochOptionalRequest
 }
 
+type GetProposerLookaheadRequest struct {
+	types.StateIDRequest
+	Epochs string `query:"epochs" validate:"uint64"`
+}
+
+type GetForkDigestsRequest struct {
+	types.StateIDRequest
+}
+
 type GetBlockHeadersRequest struct {
 	SlotRequest
 	ParentRoot string `query:"parent_root" validate:"hex"`
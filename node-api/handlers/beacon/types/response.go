@@ -23,6 +23,7 @@ package types
 import (
 	"github.com/berachain/beacon-kit/primitives/bytes"
 	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
 )
 
 type ValidatorResponse struct {
@@ -31,6 +32,11 @@ type ValidatorResponse struct {
 	Data                any  `json:"data"`
 }
 
+// IsFinalized reports whether the response was read from a finalized state.
+func (r ValidatorResponse) IsFinalized() bool {
+	return r.Finalized
+}
+
 type BlockResponse struct {
 	Version string `json:"version"`
 	ValidatorResponse
@@ -48,9 +54,31 @@ type BlockHeader[BlockHeaderT any] struct {
 }
 
 type GenesisData struct {
-	GenesisTime           string      `json:"genesis_time"`
-	GenesisValidatorsRoot common.Root `json:"genesis_validators_root"`
-	GenesisForkVersion    string      `json:"genesis_fork_version"`
+	GenesisTime           string         `json:"genesis_time"`
+	GenesisValidatorsRoot common.Root    `json:"genesis_validators_root"`
+	GenesisForkVersion    common.Version `json:"genesis_fork_version"`
+}
+
+// ForkDigestsData holds the fork digest of every fork of the chain, in
+// activation order, and the ENRForkID of the chain at the requested state.
+type ForkDigestsData struct {
+	ENRForkID *ENRForkIDData    `json:"enr_fork_id"`
+	Forks     []*ForkDigestData `json:"forks"`
+}
+
+// ENRForkIDData is the ENRForkID of the networking specification. Eth2 is its
+// SSZ encoding, the value of the eth2 entry of an ENR.
+type ENRForkIDData struct {
+	ForkDigest      common.ForkDigest `json:"fork_digest"`
+	NextForkVersion common.Version    `json:"next_fork_version"`
+	NextForkEpoch   uint64            `json:"next_fork_epoch,string"`
+	Eth2            bytes.Bytes       `json:"eth2"`
+}
+
+type ForkDigestData struct {
+	Epoch      uint64            `json:"epoch,string"`
+	Version    common.Version    `json:"version"`
+	ForkDigest common.ForkDigest `json:"fork_digest"`
 }
 
 type RootData struct {
@@ -83,3 +111,19 @@ type BlockRewardsData struct {
 	ProposerSlashings uint64 `json:"proposer_slashings,string"`
 	AttesterSlashings uint64 `json:"attester_slashings,string"`
 }
+
+// ProposerLookaheadData holds the projected proposer of every slot in an
+// epoch. SeedFixed is false when the RANDAO mix the epoch is seeded from is
+// still accumulating reveals, in which case the assignments are a projection
+// from the latest mix and will change.
+type ProposerLookaheadData struct {
+	Epoch     uint64              `json:"epoch,string"`
+	SeedFixed bool                `json:"seed_fixed"`
+	Duties    []*ProposerDutyData `json:"duties"`
+}
+
+type ProposerDutyData struct {
+	Pubkey         crypto.BLSPubkey `json:"pubkey"`
+	ValidatorIndex uint64           `json:"validator_index,string"`
+	Slot           uint64           `json:"slot,string"`
+}
@@ -24,6 +24,7 @@ import (
 	beacontypes "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
 	"github.com/berachain/beacon-kit/node-api/handlers/types"
 	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/primitives/common"
 )
 
 func (h *Handler[_, ContextT, _, _]) GetStateValidators(
@@ -39,12 +40,12 @@ func (h *Handler[_, ContextT, _, _]) GetStateValidators(
 	if len(req.Statuses) > 0 {
 		return nil, types.ErrNotImplemented
 	}
-	slot, err := utils.SlotFromStateID(req.StateID, h.backend)
+	state, err := h.resolver.ResolveStateID(req.StateID)
 	if err != nil {
 		return nil, err
 	}
 	validators, err := h.backend.ValidatorsByIDs(
-		slot,
+		state.Slot,
 		req.IDs,
 		req.Statuses,
 	)
@@ -56,7 +57,7 @@ func (h *Handler[_, ContextT, _, _]) GetStateValidators(
 	}
 	return beacontypes.ValidatorResponse{
 		ExecutionOptimistic: false, // stubbed
-		Finalized:           false, // stubbed
+		Finalized:           state.Finalized,
 		Data:                validators,
 	}, nil
 }
@@ -74,12 +75,12 @@ func (h *Handler[_, ContextT, _, _]) PostStateValidators(
 	if len(req.Statuses) > 0 {
 		return nil, types.ErrNotImplemented
 	}
-	slot, err := utils.SlotFromStateID(req.StateID, h.backend)
+	state, err := h.resolver.ResolveStateID(req.StateID)
 	if err != nil {
 		return nil, err
 	}
 	validators, err := h.backend.ValidatorsByIDs(
-		slot,
+		state.Slot,
 		req.IDs,
 		req.Statuses,
 	)
@@ -88,7 +89,7 @@ func (h *Handler[_, ContextT, _, _]) PostStateValidators(
 	}
 	return beacontypes.ValidatorResponse{
 		ExecutionOptimistic: false, // stubbed
-		Finalized:           false, // stubbed
+		Finalized:           state.Finalized,
 		Data:                validators,
 	}, nil
 }
@@ -102,12 +103,12 @@ func (h *Handler[_, ContextT, _, _]) GetStateValidator(
 	if err != nil {
 		return nil, err
 	}
-	slot, err := utils.SlotFromStateID(req.StateID, h.backend)
+	state, err := h.resolver.ResolveStateID(req.StateID)
 	if err != nil {
 		return nil, err
 	}
 	validator, err := h.backend.ValidatorByID(
-		slot,
+		state.Slot,
 		req.ValidatorID,
 	)
 	if err != nil {
@@ -125,12 +126,12 @@ func (h *Handler[_, ContextT, _, _]) GetStateValidatorBalances(
 	if err != nil {
 		return nil, err
 	}
-	slot, err := utils.SlotFromStateID(req.StateID, h.backend)
+	state, err := h.resolver.ResolveStateID(req.StateID)
 	if err != nil {
 		return nil, err
 	}
 	balances, err := h.backend.ValidatorBalancesByIDs(
-		slot,
+		state.Slot,
 		req.IDs,
 	)
 	if err != nil {
@@ -138,7 +139,7 @@ func (h *Handler[_, ContextT, _, _]) GetStateValidatorBalances(
 	}
 	return beacontypes.ValidatorResponse{
 		ExecutionOptimistic: false, // stubbed
-		Finalized:           false, // stubbed
+		Finalized:           state.Finalized,
 		Data:                balances,
 	}, nil
 }
@@ -152,12 +153,12 @@ func (h *Handler[_, ContextT, _, _]) PostStateValidatorBalances(
 	if err != nil {
 		return nil, err
 	}
-	slot, err := utils.SlotFromStateID(req.StateID, h.backend)
+	state, err := h.resolver.ResolveStateID(req.StateID)
 	if err != nil {
 		return nil, err
 	}
 	balances, err := h.backend.ValidatorBalancesByIDs(
-		slot,
+		state.Slot,
 		req.IDs,
 	)
 	if err != nil {
@@ -165,7 +166,40 @@ func (h *Handler[_, ContextT, _, _]) PostStateValidatorBalances(
 	}
 	return beacontypes.ValidatorResponse{
 		ExecutionOptimistic: false, // stubbed
-		Finalized:           false, // stubbed
+		Finalized:           state.Finalized,
 		Data:                balances,
 	}, nil
 }
+
+// GetStateValidatorsByWithdrawalAddress returns the validators whose
+// withdrawals are paid to an execution address.
+func (h *Handler[_, ContextT, _, _]) GetStateValidatorsByWithdrawalAddress(
+	c ContextT,
+) (any, error) {
+	req, err := utils.BindAndValidate[beacontypes.GetValidatorsByAddressRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	address, err := common.ParseExecutionAddress(req.Address)
+	if err != nil {
+		return nil, types.ErrInvalidRequest
+	}
+	state, err := h.resolver.ResolveStateID(req.StateID)
+	if err != nil {
+		return nil, err
+	}
+	validators, err := h.backend.ValidatorsByWithdrawalAddress(
+		state.Slot,
+		address,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return beacontypes.ValidatorResponse{
+		ExecutionOptimistic: false, // stubbed
+		Finalized:           state.Finalized,
+		Data:                validators,
+	}, nil
+}
@@ -23,17 +23,22 @@ package config
 import (
 	"github.com/berachain/beacon-kit/node-api/handlers"
 	"github.com/berachain/beacon-kit/node-api/server/context"
+	"github.com/berachain/beacon-kit/primitives/common"
 )
 
 type Handler[ContextT context.Context] struct {
 	*handlers.BaseHandler[ContextT]
+	chainSpec common.ChainSpec
 }
 
-func NewHandler[ContextT context.Context]() *Handler[ContextT] {
+func NewHandler[ContextT context.Context](
+	chainSpec common.ChainSpec,
+) *Handler[ContextT] {
 	h := &Handler[ContextT]{
 		BaseHandler: handlers.NewBaseHandler(
 			handlers.NewRouteSet[ContextT](""),
 		),
+		chainSpec: chainSpec,
 	}
 	return h
 }
@@ -0,0 +1,149 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package config
+
+import (
+	"encoding/hex"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+)
+
+// GetSpec returns the chain spec of the node as key/value pairs.
+func (h *Handler[ContextT]) GetSpec(ContextT) (any, error) {
+	return types.Wrap(Spec(h.chainSpec)), nil
+}
+
+// GetDepositContract returns the deposit contract of the chain.
+func (h *Handler[ContextT]) GetDepositContract(ContextT) (any, error) {
+	return types.Wrap(DepositContractData{
+		ChainID: strconv.FormatUint(h.chainSpec.DepositEth1ChainID(), 10),
+		Address: h.chainSpec.DepositContractAddress(),
+	}), nil
+}
+
+// GetForkSchedule returns the forks of the chain, in activation order.
+func (h *Handler[ContextT]) GetForkSchedule(ContextT) (any, error) {
+	return types.Wrap(ForkSchedule(h.chainSpec)), nil
+}
+
+// Spec returns the parameters of the chain spec as key/value pairs, in the
+// format of the beacon-API: every parameter is keyed by its configuration
+// name in upper snake case, with integers in decimal and bytes in hex.
+func Spec(cs common.ChainSpec) map[string]string {
+	spec := make(map[string]string)
+	data := reflect.ValueOf(cs.Data())
+	for i := range data.NumField() {
+		tag := data.Type().Field(i).Tag.Get("mapstructure")
+		value, ok := formatSpecValue(data.Field(i))
+		if tag == "" || !ok {
+			continue
+		}
+		key := strings.ToUpper(strings.ReplaceAll(tag, "-", "_"))
+		spec[key] = value
+		// The signature domains are also served under their beacon-API
+		// names, e.g. DOMAIN_DEPOSIT.
+		if domain, found := strings.CutPrefix(key, "DOMAIN_TYPE_"); found {
+			spec["DOMAIN_"+domain] = value
+		}
+	}
+
+	// The beacon-API parameters missing from the chain spec follow from it.
+	spec["SECONDS_PER_SLOT"] = strconv.FormatUint(
+		uint64(cs.SlotDuration()/time.Second), 10,
+	)
+	chainID := strconv.FormatUint(cs.DepositEth1ChainID(), 10)
+	spec["DEPOSIT_CHAIN_ID"] = chainID
+	spec["DEPOSIT_NETWORK_ID"] = chainID
+	spec["GENESIS_FORK_VERSION"] = version.FromUint32[common.Version](
+		cs.ActiveForkVersionForEpoch(0),
+	).String()
+	for _, fork := range ForkSchedule(cs) {
+		name := strings.ToUpper(strings.ReplaceAll(
+			version.Name(version.ToUint32(fork.CurrentVersion)), "-", "_",
+		))
+		spec[name+"_FORK_VERSION"] = fork.CurrentVersion.String()
+		spec[name+"_FORK_EPOCH"] = fork.Epoch
+	}
+	return spec
+}
+
+// ForkSchedule returns the forks of the chain, in activation order. Forks
+// superseded by a later fork activating at the same epoch are left out.
+func ForkSchedule(cs common.ChainSpec) []ForkData {
+	forks := []struct {
+		version uint32
+		epoch   math.Epoch
+	}{
+		{version.Deneb, 0},
+		{version.DenebPlus, cs.DenebPlusForkEpoch()},
+		{version.Electra, cs.ElectraForkEpoch()},
+	}
+
+	schedule := make([]ForkData, 0, len(forks))
+	previous := forks[0].version
+	for _, fork := range forks {
+		if cs.ActiveForkVersionForEpoch(fork.epoch) != fork.version {
+			continue
+		}
+		schedule = append(schedule, ForkData{
+			PreviousVersion: version.FromUint32[common.Version](previous),
+			CurrentVersion:  version.FromUint32[common.Version](fork.version),
+			Epoch:           fork.epoch.Base10(),
+		})
+		previous = fork.version
+	}
+	return schedule
+}
+
+// formatSpecValue formats a chain spec parameter, returning false if the
+// parameter is not a scalar served by the spec endpoint.
+func formatSpecValue(v reflect.Value) (string, bool) {
+	switch v.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
+		reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), true
+	case reflect.Array:
+		if v.Type().Elem().Kind() != reflect.Uint8 {
+			return "", false
+		}
+		bz := make([]byte, v.Len())
+		reflect.Copy(reflect.ValueOf(bz), v)
+		return "0x" + hex.EncodeToString(bz), true
+	case reflect.Slice:
+		if v.Type().Elem().Kind() != reflect.String {
+			return "", false
+		}
+		values := make([]string, v.Len())
+		for i := range values {
+			values[i] = v.Index(i).String()
+		}
+		return strings.Join(values, ","), true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package config_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/berachain/beacon-kit/chain-spec/chain"
+	"github.com/berachain/beacon-kit/config/spec"
+	"github.com/berachain/beacon-kit/node-api/handlers/config"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpec(t *testing.T) {
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+
+	values := config.Spec(cs)
+	require.Equal(t,
+		strconv.FormatUint(cs.SlotsPerEpoch(), 10), values["SLOTS_PER_EPOCH"],
+	)
+	require.Equal(t,
+		spec.DefaultDepositContractAddress,
+		values["DEPOSIT_CONTRACT_ADDRESS"],
+	)
+	require.Equal(t,
+		values["DOMAIN_TYPE_DEPOSIT"], values["DOMAIN_DEPOSIT"],
+	)
+	require.Equal(t, "0x03000000", values["DOMAIN_DEPOSIT"])
+	require.Equal(t, "80087", values["DEPOSIT_CHAIN_ID"])
+	require.Equal(t, values["DEPOSIT_CHAIN_ID"], values["DEPOSIT_NETWORK_ID"])
+	require.Equal(t, "0x04000000", values["GENESIS_FORK_VERSION"])
+	require.Equal(t, "0", values["DENEB_FORK_EPOCH"])
+	require.NotContains(t, values, "COMET_BFT_CONFIG")
+}
+
+func TestForkSchedule(t *testing.T) {
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+
+	deneb := version.FromUint32[common.Version](version.Deneb)
+	denebPlus := version.FromUint32[common.Version](version.DenebPlus)
+	electra := version.FromUint32[common.Version](version.Electra)
+
+	data := cs.Data()
+	data.DenebPlusForkEpoch = 10
+	data.ElectraForkEpoch = 20
+	cs, err = chain.NewChainSpec(data)
+	require.NoError(t, err)
+	require.Equal(t, []config.ForkData{
+		{PreviousVersion: deneb, CurrentVersion: deneb, Epoch: "0"},
+		{PreviousVersion: deneb, CurrentVersion: denebPlus, Epoch: "10"},
+		{PreviousVersion: denebPlus, CurrentVersion: electra, Epoch: "20"},
+	}, config.ForkSchedule(cs))
+
+	// A fork superseded as it activates never takes effect.
+	data.ElectraForkEpoch = 10
+	cs, err = chain.NewChainSpec(data)
+	require.NoError(t, err)
+	require.Equal(t, []config.ForkData{
+		{PreviousVersion: deneb, CurrentVersion: deneb, Epoch: "0"},
+		{PreviousVersion: deneb, CurrentVersion: electra, Epoch: "10"},
+	}, config.ForkSchedule(cs))
+}
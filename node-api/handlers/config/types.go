@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package config
+
+import "github.com/berachain/beacon-kit/primitives/common"
+
+// ForkData is a fork of the fork schedule.
+type ForkData struct {
+	// PreviousVersion is the last version before the fork.
+	PreviousVersion common.Version `json:"previous_version"`
+	// CurrentVersion is the first version after the fork.
+	CurrentVersion common.Version `json:"current_version"`
+	// Epoch is the epoch at which the fork activates.
+	Epoch string `json:"epoch"`
+}
+
+// DepositContractData is the deposit contract of the chain.
+type DepositContractData struct {
+	// ChainID is the chain ID of the execution chain hosting the contract.
+	ChainID string `json:"chain_id"`
+	// Address is the address of the deposit contract.
+	Address common.ExecutionAddress `json:"address"`
+}
@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package deposits
+
+import (
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/node-api/server/context"
+)
+
+// Handler serves the status of the deposits of a validator, from the deposit
+// store entries still to be processed to the resulting validator record.
+type Handler[ContextT context.Context, ValidatorT any] struct {
+	*handlers.BaseHandler[ContextT]
+	backend  Backend[ValidatorT]
+	resolver *utils.StateResolver
+	store    DepositStore
+}
+
+// NewHandler creates a new handler for the deposits API.
+func NewHandler[ContextT context.Context, ValidatorT any](
+	backend Backend[ValidatorT],
+	store DepositStore,
+) *Handler[ContextT, ValidatorT] {
+	h := &Handler[ContextT, ValidatorT]{
+		BaseHandler: handlers.NewBaseHandler(
+			handlers.NewRouteSet[ContextT](""),
+		),
+		backend:  backend,
+		resolver: utils.NewStateResolver(backend),
+		store:    store,
+	}
+	return h
+}
@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package deposits
+
+import (
+	"strconv"
+
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// maxPendingDeposits is the maximum number of deposit store entries scanned
+// for the deposits of a validator that are still to be processed.
+const maxPendingDeposits = 4096
+
+func (h *Handler[ContextT, ValidatorT]) GetDepositStatus(
+	c ContextT,
+) (any, error) {
+	req, err := utils.BindAndValidate[DepositStatusRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	var pubkey crypto.BLSPubkey
+	if err = pubkey.UnmarshalText([]byte(req.Pubkey)); err != nil {
+		return nil, types.ErrInvalidRequest
+	}
+
+	head, err := h.resolver.Head()
+	if err != nil {
+		return nil, err
+	}
+	depositIndex, slot, err := h.backend.Eth1DepositIndexAtSlot(head.Slot)
+	if err != nil {
+		return nil, err
+	}
+	// The deposit store is pruned up to the processed deposits, any deposit
+	// left past the last processed one is still to be included in a block.
+	deposits, err := h.store.GetDepositsByIndex(
+		depositIndex+1, maxPendingDeposits,
+	)
+	if err != nil {
+		return nil, err
+	}
+	pending := make([]*PendingDepositData, 0)
+	for _, deposit := range deposits {
+		if deposit.GetPubkey() != pubkey {
+			continue
+		}
+		pending = append(pending, &PendingDepositData{
+			Index:  deposit.GetIndex().Base10(),
+			Amount: deposit.GetAmount().Base10(),
+		})
+	}
+
+	data := &DepositStatusData[ValidatorT]{
+		Pubkey:           pubkey.String(),
+		Slot:             slot.Base10(),
+		Eth1DepositIndex: strconv.FormatUint(depositIndex, 10),
+		PendingDeposits:  pending,
+	}
+	validator, err := h.backend.ValidatorByPubkey(slot, pubkey)
+	if err != nil {
+		return nil, err
+	}
+	if validator != nil {
+		data.Validator = &ValidatorData[ValidatorT]{
+			Index:     math.U64(validator.Index).Base10(),
+			Balance:   math.Gwei(validator.Balance).Base10(),
+			Validator: validator.Validator,
+		}
+	}
+	return types.Wrap(data), nil
+}
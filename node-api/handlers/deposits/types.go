@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package deposits
+
+import (
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	beacontypes "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// Backend is the interface for backend of the deposits API.
+type Backend[ValidatorT any] interface {
+	utils.StateIndex
+	// Eth1DepositIndexAtSlot returns the index of the last deposit processed
+	// by the state at the given slot, along with the slot of that state.
+	Eth1DepositIndexAtSlot(slot math.Slot) (uint64, math.Slot, error)
+	// ValidatorByPubkey returns the validator with the given pubkey in the
+	// state at the given slot, or nil if the pubkey is not in the registry.
+	ValidatorByPubkey(
+		slot math.Slot, pubkey crypto.BLSPubkey,
+	) (*beacontypes.ValidatorData[ValidatorT], error)
+}
+
+// DepositStore is the store of the deposits observed on the execution layer
+// and not yet pruned.
+type DepositStore interface {
+	// GetDepositsByIndex returns up to numView contiguous deposits from
+	// startIndex on.
+	GetDepositsByIndex(
+		startIndex uint64, numView uint64,
+	) ([]*ctypes.Deposit, error)
+}
+
+type DepositStatusRequest struct {
+	Pubkey string `param:"pubkey" validate:"required,pubkey"`
+}
+
+type DepositStatusData[ValidatorT any] struct {
+	Pubkey           string                     `json:"pubkey"`
+	Slot             string                     `json:"slot"`
+	Eth1DepositIndex string                     `json:"eth1_deposit_index"`
+	PendingDeposits  []*PendingDepositData      `json:"pending_deposits"`
+	Validator        *ValidatorData[ValidatorT] `json:"validator"`
+}
+
+type PendingDepositData struct {
+	Index  string `json:"index"`
+	Amount string `json:"amount"`
+}
+
+type ValidatorData[ValidatorT any] struct {
+	Index     string     `json:"index"`
+	Balance   string     `json:"balance"`
+	Validator ValidatorT `json:"validator"`
+}
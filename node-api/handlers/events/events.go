@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package events
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+)
+
+// EventsRequest selects the topics to stream.
+type EventsRequest struct {
+	Topics []string `query:"topics" validate:"required"`
+}
+
+// Events streams the node events of the requested topics as server-sent
+// events.
+func (h *Handler[ContextT]) Events(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[EventsRequest](c, h.Logger())
+	if err != nil {
+		return nil, err
+	}
+	// Topics may be repeated or given as a comma separated list.
+	var topics []string
+	for _, param := range req.Topics {
+		for _, topic := range strings.Split(param, ",") {
+			if !slices.Contains(supportedTopics, topic) {
+				return nil, fmt.Errorf(
+					"%w: unsupported topic %q",
+					types.ErrInvalidRequest, topic,
+				)
+			}
+			topics = append(topics, topic)
+		}
+	}
+	return &subscription{stream: h.stream, topics: topics}, nil
+}
+
+// supportedTopics are the topics served by the events endpoint.
+//
+//nolint:gochecknoglobals // read-only.
+var supportedTopics = []string{
+	TopicHead,
+	TopicFinalizedCheckpoint,
+	TopicProposal,
+	TopicPayloadInvalid,
+	TopicDeposit,
+	TopicValidatorStatus,
+}
@@ -27,13 +27,17 @@ import (
 
 type Handler[ContextT context.Context] struct {
 	*handlers.BaseHandler[ContextT]
+	stream *Stream
 }
 
-func NewHandler[ContextT context.Context]() *Handler[ContextT] {
+func NewHandler[ContextT context.Context](
+	stream *Stream,
+) *Handler[ContextT] {
 	h := &Handler[ContextT]{
 		BaseHandler: handlers.NewBaseHandler(
 			handlers.NewRouteSet[ContextT](""),
 		),
+		stream: stream,
 	}
 	return h
 }
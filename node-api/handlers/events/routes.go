@@ -35,7 +35,7 @@ func (h *Handler[ContextT]) RegisterRoutes(
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/events",
-			Handler: h.NotImplemented,
+			Handler: h.Events,
 		},
 	})
 }
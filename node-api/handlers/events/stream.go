@@ -0,0 +1,211 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	asynctypes "github.com/berachain/beacon-kit/async/types"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/primitives/async"
+)
+
+const (
+	// clientBufferSize is the number of events buffered for a client before
+	// events are dropped for it.
+	clientBufferSize = 64
+	// keepAliveInterval is the interval at which idle streams are pinged.
+	keepAliveInterval = 15 * time.Second
+)
+
+// Stream fans out node events published on the dispatcher to the clients
+// of the events endpoint. Slow clients miss events rather than stalling
+// the publishers.
+type Stream struct {
+	logger log.Logger
+
+	subHeads           chan async.Event[async.Head]
+	subCheckpoints     chan async.Event[async.Checkpoint]
+	subProposals       chan async.Event[async.Proposal]
+	subInvalidPayloads chan async.Event[async.InvalidPayload]
+	subDeposits        chan async.Event[async.Deposits]
+	subStatusChanges   chan async.Event[async.ValidatorStatusChanges]
+
+	mu      sync.RWMutex
+	clients map[*client]struct{}
+}
+
+// client is a connected consumer of the stream.
+type client struct {
+	topics map[string]struct{}
+	events chan Event
+}
+
+// NewStream creates a new stream subscribed to the node events of the
+// dispatcher.
+func NewStream(
+	logger log.Logger,
+	dispatcher asynctypes.EventDispatcher,
+) (*Stream, error) {
+	s := &Stream{
+		logger:             logger,
+		subHeads:           make(chan async.Event[async.Head]),
+		subCheckpoints:     make(chan async.Event[async.Checkpoint]),
+		subProposals:       make(chan async.Event[async.Proposal]),
+		subInvalidPayloads: make(chan async.Event[async.InvalidPayload]),
+		subDeposits:        make(chan async.Event[async.Deposits]),
+		subStatusChanges:   make(chan async.Event[async.ValidatorStatusChanges]),
+		clients:            make(map[*client]struct{}),
+	}
+	for eventID, ch := range map[async.EventID]any{
+		async.NewHead:                s.subHeads,
+		async.FinalizedCheckpoint:    s.subCheckpoints,
+		async.ProposalBuilt:          s.subProposals,
+		async.PayloadInvalid:         s.subInvalidPayloads,
+		async.DepositObserved:        s.subDeposits,
+		async.ValidatorStatusChanged: s.subStatusChanges,
+	} {
+		if err := dispatcher.Subscribe(eventID, ch); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// Name returns the name of the stream.
+func (s *Stream) Name() string {
+	return "event-stream"
+}
+
+// Start fans out the subscribed events until the context is done.
+func (s *Stream) Start(ctx context.Context) error {
+	go s.eventLoop(ctx)
+	return nil
+}
+
+func (s *Stream) eventLoop(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case e := <-s.subHeads:
+			s.broadcast(headEvent(e))
+		case e := <-s.subCheckpoints:
+			s.broadcast(checkpointEvent(e))
+		case e := <-s.subProposals:
+			s.broadcast(proposalEvent(e))
+		case e := <-s.subInvalidPayloads:
+			s.broadcast(payloadInvalidEvent(e))
+		case e := <-s.subDeposits:
+			s.broadcast(depositEvent(e))
+		case e := <-s.subStatusChanges:
+			for _, event := range validatorStatusEvents(e) {
+				s.broadcast(event)
+			}
+		}
+	}
+}
+
+// broadcast sends the event to every client subscribed to its topic.
+func (s *Stream) broadcast(event Event) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for c := range s.clients {
+		if _, ok := c.topics[event.Topic]; !ok {
+			continue
+		}
+		select {
+		case c.events <- event:
+		default:
+			s.logger.Warn(
+				"Dropping event for slow events client",
+				"topic", event.Topic,
+			)
+		}
+	}
+}
+
+// subscribe registers a client for the given topics.
+func (s *Stream) subscribe(topics []string) *client {
+	c := &client{
+		topics: make(map[string]struct{}, len(topics)),
+		events: make(chan Event, clientBufferSize),
+	}
+	for _, topic := range topics {
+		c.topics[topic] = struct{}{}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.clients[c] = struct{}{}
+	return c
+}
+
+// unsubscribe removes the client from the stream.
+func (s *Stream) unsubscribe(c *client) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.clients, c)
+}
+
+// subscription streams the events of a client over an HTTP response.
+type subscription struct {
+	stream *Stream
+	topics []string
+}
+
+// Serve implements types.EventStream.
+func (sub *subscription) Serve(
+	ctx context.Context,
+	w io.Writer,
+	flush func(),
+) error {
+	c := sub.stream.subscribe(sub.topics)
+	defer sub.stream.unsubscribe(c)
+
+	ticker := time.NewTicker(keepAliveInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if _, err := io.WriteString(w, ":\n\n"); err != nil {
+				return err
+			}
+		case event := <-c.events:
+			data, err := json.Marshal(event.Data)
+			if err != nil {
+				return err
+			}
+			if _, err = fmt.Fprintf(
+				w, "event: %s\ndata: %s\n\n", event.Topic, data,
+			); err != nil {
+				return err
+			}
+		}
+		flush()
+	}
+}
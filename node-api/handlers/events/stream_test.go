@@ -0,0 +1,131 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package events_test
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/async/dispatcher"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/node-api/handlers/events"
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/primitives/async"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/stretchr/testify/require"
+)
+
+// topicsContext binds the given topics into an events request.
+type topicsContext struct {
+	topics []string
+}
+
+func (c topicsContext) Bind(req any) error {
+	//nolint:errcheck // the handler always binds an events request.
+	req.(*events.EventsRequest).Topics = c.topics
+	return nil
+}
+
+func (topicsContext) Validate(any) error { return nil }
+
+func TestStreamDeliversSubscribedTopics(t *testing.T) {
+	d, err := dispatcher.New(
+		noop.NewLogger[any](),
+		dispatcher.WithEvent[async.Event[async.Head]](async.NewHead),
+		dispatcher.WithEvent[async.Event[async.Checkpoint]](
+			async.FinalizedCheckpoint,
+		),
+		dispatcher.WithEvent[async.Event[async.Proposal]](async.ProposalBuilt),
+		dispatcher.WithEvent[async.Event[async.InvalidPayload]](
+			async.PayloadInvalid,
+		),
+		dispatcher.WithEvent[async.Event[async.Deposits]](
+			async.DepositObserved,
+		),
+		dispatcher.WithEvent[async.Event[async.ValidatorStatusChanges]](
+			async.ValidatorStatusChanged,
+		),
+	)
+	require.NoError(t, err)
+	stream, err := events.NewStream(noop.NewLogger[any](), d)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	require.NoError(t, d.Start(ctx))
+	require.NoError(t, stream.Start(ctx))
+
+	h := events.NewHandler[topicsContext](stream)
+	h.RegisterRoutes(noop.NewLogger[any]())
+
+	// Unknown topics are rejected.
+	_, err = h.Events(topicsContext{topics: []string{"head,block"}})
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+
+	resp, err := h.Events(topicsContext{topics: []string{"head,proposal"}})
+	require.NoError(t, err)
+	es, ok := resp.(types.EventStream)
+	require.True(t, ok)
+
+	r, w := io.Pipe()
+	go func() {
+		//nolint:errcheck // closing the pipe unblocks the reader.
+		w.CloseWithError(es.Serve(ctx, w, func() {}))
+	}()
+
+	// Publish until the client is registered and receives an event. The
+	// deposit topic is not subscribed and must never be delivered.
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		ticker := time.NewTicker(10 * time.Millisecond)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				_ = d.Publish(async.NewEvent(
+					ctx, async.DepositObserved, async.Deposits{Count: 1},
+				))
+				_ = d.Publish(async.NewEvent(
+					ctx, async.NewHead, async.Head{
+						Slot:      math.Slot(7),
+						BlockRoot: common.Root{0x01},
+					},
+				))
+			}
+		}
+	}()
+
+	lines := bufio.NewReader(r)
+	event, err := lines.ReadString('\n')
+	require.NoError(t, err)
+	require.Equal(t, "event: head\n", event)
+	data, err := lines.ReadString('\n')
+	require.NoError(t, err)
+	require.Contains(t, data, `"slot":"7"`)
+	require.Contains(t, data, `"block":"0x01`)
+}
@@ -0,0 +1,161 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package events
+
+import (
+	"strconv"
+
+	"github.com/berachain/beacon-kit/primitives/async"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+)
+
+// Topics served by the events endpoint.
+const (
+	TopicHead                = "head"
+	TopicFinalizedCheckpoint = "finalized_checkpoint"
+	TopicProposal            = "proposal"
+	TopicPayloadInvalid      = "payload_invalid"
+	TopicDeposit             = "deposit"
+	TopicValidatorStatus     = "validator_status_change"
+)
+
+// Event is a single server-sent event.
+type Event struct {
+	Topic string
+	Data  any
+}
+
+type HeadData struct {
+	Slot                string               `json:"slot"`
+	Block               common.Root          `json:"block"`
+	State               common.Root          `json:"state"`
+	ExecutionBlockHash  common.ExecutionHash `json:"execution_block_hash"`
+	ExecutionOptimistic bool                 `json:"execution_optimistic"`
+}
+
+type FinalizedCheckpointData struct {
+	Block               common.Root `json:"block"`
+	State               common.Root `json:"state"`
+	Epoch               string      `json:"epoch"`
+	ExecutionOptimistic bool        `json:"execution_optimistic"`
+}
+
+type ProposalData struct {
+	Slot  string      `json:"slot"`
+	Block common.Root `json:"block"`
+	Error string      `json:"error,omitempty"`
+}
+
+type PayloadInvalidData struct {
+	Slot      string               `json:"slot"`
+	BlockHash common.ExecutionHash `json:"block_hash"`
+	Error     string               `json:"error,omitempty"`
+}
+
+type DepositData struct {
+	ExecutionBlock string `json:"execution_block"`
+	FirstIndex     string `json:"first_index"`
+	Count          string `json:"count"`
+}
+
+type ValidatorStatusChangeData struct {
+	Epoch          string           `json:"epoch"`
+	ValidatorIndex string           `json:"validator_index"`
+	Pubkey         crypto.BLSPubkey `json:"pubkey"`
+	PreviousStatus string           `json:"previous_status"`
+	Status         string           `json:"status"`
+}
+
+// errString returns the message of err, empty if err is nil.
+func errString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
+
+func headEvent(e async.Event[async.Head]) Event {
+	head := e.Data()
+	return Event{Topic: TopicHead, Data: HeadData{
+		Slot:               head.Slot.Base10(),
+		Block:              head.BlockRoot,
+		State:              head.StateRoot,
+		ExecutionBlockHash: head.ExecutionBlockHash,
+	}}
+}
+
+func checkpointEvent(e async.Event[async.Checkpoint]) Event {
+	cp := e.Data()
+	return Event{Topic: TopicFinalizedCheckpoint, Data: FinalizedCheckpointData{
+		Block: cp.BlockRoot,
+		State: cp.StateRoot,
+		Epoch: cp.Epoch.Base10(),
+	}}
+}
+
+func proposalEvent(e async.Event[async.Proposal]) Event {
+	proposal := e.Data()
+	return Event{Topic: TopicProposal, Data: ProposalData{
+		Slot:  proposal.Slot.Base10(),
+		Block: proposal.BlockRoot,
+		Error: errString(e.Error()),
+	}}
+}
+
+func payloadInvalidEvent(e async.Event[async.InvalidPayload]) Event {
+	payload := e.Data()
+	return Event{Topic: TopicPayloadInvalid, Data: PayloadInvalidData{
+		Slot:      payload.Slot.Base10(),
+		BlockHash: payload.BlockHash,
+		Error:     errString(e.Error()),
+	}}
+}
+
+func depositEvent(e async.Event[async.Deposits]) Event {
+	deposits := e.Data()
+	return Event{Topic: TopicDeposit, Data: DepositData{
+		ExecutionBlock: deposits.ExecutionBlock.Base10(),
+		FirstIndex:     deposits.FirstIndex.Base10(),
+		Count:          strconv.FormatUint(deposits.Count, 10),
+	}}
+}
+
+// validatorStatusEvents returns an event per status change.
+func validatorStatusEvents(
+	e async.Event[async.ValidatorStatusChanges],
+) []Event {
+	changes := e.Data()
+	events := make([]Event, 0, len(changes.Changes))
+	for _, change := range changes.Changes {
+		events = append(events, Event{
+			Topic: TopicValidatorStatus,
+			Data: ValidatorStatusChangeData{
+				Epoch:          changes.Epoch.Base10(),
+				ValidatorIndex: change.Index.Base10(),
+				Pubkey:         change.Pubkey,
+				PreviousStatus: string(change.Previous),
+				Status:         string(change.Current),
+			},
+		})
+	}
+	return events
+}
@@ -0,0 +1,148 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package node
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+)
+
+const (
+	// HaltPath is the path of the halt endpoints.
+	HaltPath = "/bkit/v1/node/halt"
+	// maxHaltRequestSize bounds the size of a halt request body.
+	maxHaltRequestSize = 1 << 10
+)
+
+// HaltRequest arms the halt at a height or an epoch, given as decimal
+// strings.
+type HaltRequest struct {
+	Height string `json:"height"`
+	Epoch  string `json:"epoch"`
+}
+
+// HaltData is the halt target of the node.
+type HaltData struct {
+	Armed      bool   `json:"armed"`
+	Height     string `json:"height"`
+	Epoch      string `json:"epoch"`
+	HaltHeight string `json:"halt_height"`
+}
+
+// HaltStatus reports the halt target of the node.
+func (h *Handler[ContextT]) HaltStatus(ContextT) (any, error) {
+	return types.Wrap(haltData(h.halter)), nil
+}
+
+// HaltAdminHandler serves the halt endpoints, reporting, arming and
+// disarming the halt of the node. As they can stop the node, it must only be
+// served on the admin listener.
+type HaltAdminHandler struct {
+	halter Halter
+}
+
+// NewHaltAdminHandler creates a new HaltAdminHandler.
+func NewHaltAdminHandler(halter Halter) *HaltAdminHandler {
+	return &HaltAdminHandler{halter: halter}
+}
+
+// ServeHTTP reports the halt target of the node on GET, sets the height or
+// epoch at which the node stops finalizing blocks and shuts down on POST,
+// and clears it on DELETE.
+func (h *HaltAdminHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+	case http.MethodPost:
+		if err := h.arm(r); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	case http.MethodDelete:
+		h.halter.Disarm()
+	default:
+		w.Header().Set("Allow", "GET, POST, DELETE")
+		http.Error(
+			w, http.StatusText(http.StatusMethodNotAllowed),
+			http.StatusMethodNotAllowed,
+		)
+		return
+	}
+
+	bz, err := json.Marshal(types.Wrap(haltData(h.halter)))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(bz)
+}
+
+// arm arms the halt at the height or epoch of the request.
+func (h *HaltAdminHandler) arm(r *http.Request) error {
+	bz, err := io.ReadAll(io.LimitReader(r.Body, maxHaltRequestSize))
+	if err != nil {
+		return err
+	}
+	var req HaltRequest
+	if err = json.Unmarshal(bz, &req); err != nil {
+		return fmt.Errorf("%w: %w", types.ErrInvalidRequest, err)
+	}
+	height, err := parseUint64(req.Height)
+	if err != nil {
+		return err
+	}
+	epoch, err := parseUint64(req.Epoch)
+	if err != nil {
+		return err
+	}
+	if err = h.halter.Arm(height, epoch); err != nil {
+		return fmt.Errorf("%w: %w", types.ErrInvalidRequest, err)
+	}
+	return nil
+}
+
+// haltData returns the halt target of the halter.
+func haltData(halter Halter) HaltData {
+	height, epoch, haltHeight := halter.Status()
+	return HaltData{
+		Armed:      haltHeight > 0,
+		Height:     strconv.FormatUint(height, 10),
+		Epoch:      strconv.FormatUint(epoch, 10),
+		HaltHeight: strconv.FormatUint(haltHeight, 10),
+	}
+}
+
+// parseUint64 parses a validated decimal string, empty meaning zero.
+func parseUint64(value string) (uint64, error) {
+	if value == "" {
+		return 0, nil
+	}
+	v, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return 0, types.ErrInvalidRequest
+	}
+	return v, nil
+}
@@ -0,0 +1,100 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package node_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/node-api/handlers/node"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+	"github.com/stretchr/testify/require"
+)
+
+var errPastHeight = errors.New("past height")
+
+// halter records the halt target it is armed with.
+type halter struct {
+	height, epoch uint64
+}
+
+func (h *halter) Arm(height, epoch uint64) error {
+	if height == 1 {
+		return errPastHeight
+	}
+	h.height, h.epoch = height, epoch
+	return nil
+}
+
+func (h *halter) Disarm() { h.height, h.epoch = 0, 0 }
+
+func (h *halter) Status() (uint64, uint64, uint64) {
+	return h.height, h.epoch, max(h.height, h.epoch*10)
+}
+
+func TestHaltAdminHandler(t *testing.T) {
+	h := &halter{}
+	handler := node.NewHaltAdminHandler(h)
+	serve := func(method, body string) (int, node.HaltData) {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(
+			method, node.HaltPath, strings.NewReader(body),
+		))
+		var res struct {
+			Data node.HaltData `json:"data"`
+		}
+		if rec.Code == http.StatusOK {
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		}
+		return rec.Code, res.Data
+	}
+
+	code, data := serve(http.MethodGet, "")
+	require.Equal(t, http.StatusOK, code)
+	require.False(t, data.Armed)
+
+	code, data = serve(http.MethodPost, `{"height":"100"}`)
+	require.Equal(t, http.StatusOK, code)
+	require.True(t, data.Armed)
+	require.Equal(t, "100", data.HaltHeight)
+	require.Equal(t, uint64(100), h.height)
+
+	code, data = serve(http.MethodPost, `{"epoch":"20"}`)
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, "20", data.Epoch)
+	require.Equal(t, "200", data.HaltHeight)
+
+	for _, body := range []string{`{"height":"1"}`, `{"height":"x"}`, `{`} {
+		code, _ = serve(http.MethodPost, body)
+		require.Equal(t, http.StatusBadRequest, code, body)
+	}
+	require.Equal(t, uint64(20), h.epoch)
+
+	code, data = serve(http.MethodDelete, "")
+	require.Equal(t, http.StatusOK, code)
+	require.False(t, data.Armed)
+
+	code, _ = serve(http.MethodPut, "")
+	require.Equal(t, http.StatusMethodNotAllowed, code)
+}
@@ -21,19 +21,34 @@
 package node
 
 import (
+	"github.com/berachain/beacon-kit/config/features"
 	"github.com/berachain/beacon-kit/node-api/handlers"
 	"github.com/berachain/beacon-kit/node-api/server/context"
+	"github.com/berachain/beacon-kit/observability/identity"
 )
 
 type Handler[ContextT context.Context] struct {
 	*handlers.BaseHandler[ContextT]
+	featureFlags *features.Set
+	slotClock    SlotClock
+	nodeIdentity *identity.Identity
+	halter       Halter
 }
 
-func NewHandler[ContextT context.Context]() *Handler[ContextT] {
+func NewHandler[ContextT context.Context](
+	featureFlags *features.Set,
+	slotClock SlotClock,
+	nodeIdentity *identity.Identity,
+	halter Halter,
+) *Handler[ContextT] {
 	h := &Handler[ContextT]{
 		BaseHandler: handlers.NewBaseHandler(
 			handlers.NewRouteSet[ContextT](""),
 		),
+		featureFlags: featureFlags,
+		slotClock:    slotClock,
+		nodeIdentity: nodeIdentity,
+		halter:       halter,
 	}
 	return h
 }
@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package node
+
+import (
+	"github.com/berachain/beacon-kit/config/features"
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/observability/identity"
+)
+
+// IdentityData is the identity of the node. Besides the standard fields it
+// reports the configured feature flags and the paired execution client so
+// that fleets can be audited.
+type IdentityData struct {
+	PeerID             string                    `json:"peer_id"`
+	ENR                string                    `json:"enr"`
+	P2PAddresses       []string                  `json:"p2p_addresses"`
+	DiscoveryAddresses []string                  `json:"discovery_addresses"`
+	FeatureFlags       []features.Flag           `json:"feature_flags"`
+	Node               *identity.Identity        `json:"node"`
+	ExecutionClient    *identity.ExecutionClient `json:"execution_client"`
+}
+
+// Identity returns the identity of the node, including its feature flags.
+//
+// TODO: Populate the networking fields with real data.
+func (h *Handler[ContextT]) Identity(ContextT) (any, error) {
+	flags := make([]features.Flag, 0)
+	if h.featureFlags != nil {
+		flags = h.featureFlags.Flags()
+	}
+
+	return types.Wrap(IdentityData{
+		PeerID:             h.nodeIdentity.NodeID,
+		P2PAddresses:       make([]string, 0),
+		DiscoveryAddresses: make([]string, 0),
+		FeatureFlags:       flags,
+		Node:               h.nodeIdentity,
+		ExecutionClient:    h.nodeIdentity.ExecutionClient(),
+	}), nil
+}
@@ -35,7 +35,7 @@ func (h *Handler[ContextT]) RegisterRoutes(
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/node/identity",
-			Handler: h.NotImplemented,
+			Handler: h.Identity,
 		},
 		{
 			Method:  http.MethodGet,
@@ -67,5 +67,15 @@ func (h *Handler[ContextT]) RegisterRoutes(
 			Path:    "/eth/v1/node/health",
 			Handler: h.NotImplemented,
 		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/bkit/v1/node/slot_clock",
+			Handler: h.SlotClock,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    HaltPath,
+			Handler: h.HaltStatus,
+		},
 	})
 }
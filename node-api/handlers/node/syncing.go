@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package node
+
+import (
+	"strconv"
+
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
+)
+
+type SyncingData struct {
+	HeadSlot     string `json:"head_slot"`
+	SyncDistance string `json:"sync_distance"`
+	IsSyncing    bool   `json:"is_syncing"`
+	IsOptimistic bool   `json:"is_optimistic"`
+	ELOffline    bool   `json:"el_offline"`
+}
+
+type SlotClockData struct {
+	GenesisTime  string `json:"genesis_time"`
+	SlotDuration string `json:"slot_duration_ms"`
+	CurrentSlot  string `json:"current_slot"`
+	NextSlot     string `json:"next_slot"`
+	NextSlotTime string `json:"next_slot_time_ms"`
+	HeadSlot     string `json:"head_slot"`
+	SlotLag      string `json:"slot_lag"`
+	IsBehind     bool   `json:"is_behind"`
+}
+
+// Syncing reports the head slot of the node and its distance to the slot
+// clock.
+func (h *Handler[ContextT]) Syncing(ContextT) (any, error) {
+	return types.Wrap(SyncingData{
+		HeadSlot:     h.slotClock.HeadSlot().Base10(),
+		SyncDistance: strconv.FormatUint(h.slotClock.SlotLag(), 10),
+		IsSyncing:    h.slotClock.IsBehind(),
+		IsOptimistic: true,
+		ELOffline:    false,
+	}), nil
+}
+
+// SlotClock reports the current and next slot timing of the node.
+func (h *Handler[ContextT]) SlotClock(ContextT) (any, error) {
+	next := h.slotClock.NextSlot()
+	return types.Wrap(SlotClockData{
+		GenesisTime: strconv.FormatInt(
+			h.slotClock.GenesisTime().Unix(), 10,
+		),
+		SlotDuration: strconv.FormatInt(
+			h.slotClock.SlotDuration().Milliseconds(), 10,
+		),
+		CurrentSlot: h.slotClock.CurrentSlot().Base10(),
+		NextSlot:    next.Base10(),
+		NextSlotTime: strconv.FormatInt(
+			h.slotClock.SlotStart(next).UnixMilli(), 10,
+		),
+		HeadSlot: h.slotClock.HeadSlot().Base10(),
+		SlotLag:  strconv.FormatUint(h.slotClock.SlotLag(), 10),
+		IsBehind: h.slotClock.IsBehind(),
+	}), nil
+}
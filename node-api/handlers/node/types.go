@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package node
+
+import (
+	"time"
+
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// SlotClock reports the slot timing of the node and how far consensus is
+// behind it.
+type SlotClock interface {
+	// GenesisTime returns the time the first slot starts at.
+	GenesisTime() time.Time
+	// SlotDuration returns the duration of a slot.
+	SlotDuration() time.Duration
+	// CurrentSlot returns the slot in progress.
+	CurrentSlot() math.Slot
+	// NextSlot returns the slot following the one in progress.
+	NextSlot() math.Slot
+	// SlotStart returns the time the given slot starts at.
+	SlotStart(slot math.Slot) time.Time
+	// HeadSlot returns the slot of the last finalized block.
+	HeadSlot() math.Slot
+	// SlotLag returns the number of slots consensus is behind the clock.
+	SlotLag() uint64
+	// IsBehind returns true while consensus is behind the clock by more
+	// than the configured lag.
+	IsBehind() bool
+}
+
+// Halter arms and disarms the height at which the node stops finalizing
+// blocks.
+type Halter interface {
+	// Arm sets the height or epoch at which the node halts.
+	Arm(height uint64, epoch uint64) error
+	// Disarm clears the halt target.
+	Disarm()
+	// Status returns the configured halt height and epoch, and the
+	// resulting height at which the node halts, zero if disarmed.
+	Status() (uint64, uint64, uint64)
+}
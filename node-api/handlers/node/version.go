@@ -20,33 +20,7 @@
 
 package node
 
-// Syncing is a placeholder so that beacon API clients don't break.
-//
-// TODO: Implement with real data.
-func (h *Handler[ContextT]) Syncing(ContextT) (any, error) {
-	type SyncingResponse struct {
-		Data struct {
-			HeadSlot     string `json:"head_slot"`
-			SyncDistance string `json:"sync_distance"`
-			IsSyncing    bool   `json:"is_syncing"`
-			IsOptimistic bool   `json:"is_optimistic"`
-			ELOffline    bool   `json:"el_offline"`
-		} `json:"data"`
-	}
-
-	response := SyncingResponse{}
-	response.Data.HeadSlot = "0"
-	response.Data.SyncDistance = "1"
-	response.Data.IsSyncing = false
-	response.Data.IsOptimistic = true
-	response.Data.ELOffline = false
-
-	return response, nil
-}
-
-// Version is a placeholder so that beacon API clients don't break.
-//
-// TODO: Implement with real data.
+// Version returns the version of the client.
 func (h *Handler[ContextT]) Version(ContextT) (any, error) {
 	type VersionResponse struct {
 		Data struct {
@@ -55,7 +29,7 @@ func (h *Handler[ContextT]) Version(ContextT) (any, error) {
 	}
 
 	response := VersionResponse{}
-	response.Data.Version = "1.0.0"
+	response.Data.Version = "beacon-kit/" + h.nodeIdentity.ClientVersion
 
 	return response, nil
 }
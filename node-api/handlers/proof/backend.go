@@ -21,6 +21,7 @@
 package proof
 
 import (
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
 	"github.com/berachain/beacon-kit/primitives/math"
 )
 
@@ -28,7 +29,7 @@ import (
 type Backend[BeaconBlockHeaderT, BeaconStateT, ValidatorT any] interface {
 	BlockBackend[BeaconBlockHeaderT]
 	StateBackend[BeaconStateT]
-	GetParentSlotByTimestamp(timestamp math.U64) (math.Slot, error)
+	utils.StateIndex
 }
 
 type BlockBackend[BeaconBlockHeaderT any] interface {
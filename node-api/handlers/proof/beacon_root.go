@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package proof
+
+import (
+	"github.com/berachain/beacon-kit/node-api/handlers/proof/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+)
+
+// GetBeaconRoot returns the parent beacon block root of the execution block
+// with the given timestamp id, as exposed to the EVM by the EIP-4788 beacon
+// roots contract, along with the block header it is the hash tree root of.
+func (h *Handler[
+	BeaconBlockHeaderT, _, _, ContextT, _, _,
+]) GetBeaconRoot(c ContextT) (any, error) {
+	params, err := utils.BindAndValidate[types.BeaconRootRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	_, _, blockHeader, err := h.resolveTimestampID(params.TimestampID)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.BeaconRootResponse[BeaconBlockHeaderT]{
+		BeaconBlockHeader: blockHeader,
+		BeaconBlockRoot:   blockHeader.HashTreeRoot(),
+	}, nil
+}
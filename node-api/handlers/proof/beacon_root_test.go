@@ -0,0 +1,177 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package proof_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/node-api/engines/echo"
+	"github.com/berachain/beacon-kit/node-api/handlers/proof"
+	"github.com/berachain/beacon-kit/node-api/handlers/proof/merkle/mock"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/stretchr/testify/require"
+)
+
+var errUnknownSlot = errors.New("unknown slot")
+
+// fakeBackend serves the block headers of a chain of blocks, the execution
+// block of each being timestamped with the slot it was proposed in.
+type fakeBackend struct {
+	latest  math.Slot
+	headers map[math.Slot]*types.BeaconBlockHeader
+}
+
+func newBackend(latest math.Slot) *fakeBackend {
+	b := &fakeBackend{
+		latest:  latest,
+		headers: make(map[math.Slot]*types.BeaconBlockHeader),
+	}
+	var parentRoot common.Root
+	for slot := range latest + 1 {
+		header := types.NewBeaconBlockHeader(
+			slot, 0, parentRoot, common.Root{byte(slot)}, common.Root{},
+		)
+		b.headers[slot] = header
+		parentRoot = header.HashTreeRoot()
+	}
+	return b
+}
+
+func (b *fakeBackend) BlockHeaderAtSlot(
+	slot math.Slot,
+) (*types.BeaconBlockHeader, error) {
+	header, ok := b.headers[slot]
+	if !ok {
+		return nil, errUnknownSlot
+	}
+	return header, nil
+}
+
+func (b *fakeBackend) StateFromSlotForProof(
+	slot math.Slot,
+) (*mock.BeaconState, math.Slot, error) {
+	st, err := mock.NewBeaconState(slot, nil, 0, common.ExecutionAddress{})
+	return st, slot, err
+}
+
+func (b *fakeBackend) LatestSlot() (math.Slot, error) {
+	return b.latest, nil
+}
+
+func (b *fakeBackend) GetSlotByStateRoot(common.Root) (math.Slot, error) {
+	return 0, errUnknownSlot
+}
+
+func (b *fakeBackend) GetSlotByBlockRoot(common.Root) (math.Slot, error) {
+	return 0, errUnknownSlot
+}
+
+// GetParentSlotByTimestamp returns the parent of the block whose execution
+// payload has the given timestamp, being the slot before it.
+func (b *fakeBackend) GetParentSlotByTimestamp(
+	timestamp math.U64,
+) (math.Slot, error) {
+	if timestamp == 0 || timestamp > b.latest {
+		return 0, errUnknownSlot
+	}
+	return math.Slot(timestamp - 1), nil
+}
+
+func newEngine(backend *fakeBackend) *echo.Engine {
+	logger := noop.NewLogger[log.Logger]()
+	h := proof.NewHandler[
+		*types.BeaconBlockHeader,
+		*mock.BeaconState,
+		*mock.BeaconStateMarshallable,
+		echo.Context,
+		*types.ExecutionPayloadHeader,
+		*types.Validator,
+	](backend)
+	h.RegisterRoutes(logger)
+	engine := echo.NewDefaultEngine()
+	engine.RegisterRoutes(h.RouteSet(), logger)
+	return engine
+}
+
+func getBeaconRoot(
+	t *testing.T, engine *echo.Engine, timestampID string,
+) (*httptest.ResponseRecorder, *types.BeaconBlockHeader, common.Root) {
+	t.Helper()
+	req := httptest.NewRequest(
+		http.MethodGet, "/bkit/v1/proof/beacon_root/"+timestampID, nil,
+	)
+	rec := httptest.NewRecorder()
+	engine.ServeHTTP(rec, req)
+
+	var res struct {
+		BeaconBlockHeader *types.BeaconBlockHeader `json:"beacon_block_header"`
+		BeaconBlockRoot   common.Root              `json:"beacon_block_root"`
+	}
+	if rec.Code == http.StatusOK {
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	}
+	return rec, res.BeaconBlockHeader, res.BeaconBlockRoot
+}
+
+func TestGetBeaconRoot(t *testing.T) {
+	backend := newBackend(8)
+	engine := newEngine(backend)
+
+	// The root served for an execution block is the parent root of the
+	// beacon block carrying it, as exposed by the EIP-4788 contract. Slot 0
+	// is not queried, since it resolves to the latest block.
+	for slot := math.Slot(2); slot <= backend.latest; slot++ {
+		rec, header, root := getBeaconRoot(
+			t, engine, "t"+slot.Base10(),
+		)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		require.Equal(t, backend.headers[slot].GetParentBlockRoot(), root)
+		require.Equal(t, slot-1, header.GetSlot())
+		require.Equal(t, root, header.HashTreeRoot())
+	}
+
+	// Without the timestamp prefix the id resolves to the block at the slot.
+	rec, header, root := getBeaconRoot(t, engine, "5")
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	require.Equal(t, math.Slot(5), header.GetSlot())
+	require.Equal(t, backend.headers[6].GetParentBlockRoot(), root)
+}
+
+func TestGetBeaconRootRejectsInvalidRequests(t *testing.T) {
+	engine := newEngine(newBackend(8))
+	for _, timestampID := range []string{
+		"tnot-a-timestamp",
+		"t0",
+		"t9",
+		"9",
+	} {
+		rec, _, _ := getBeaconRoot(t, engine, timestampID)
+		require.NotEqual(t, http.StatusOK, rec.Code, timestampID)
+	}
+}
@@ -40,7 +40,8 @@ type Handler[
 	ValidatorT types.Validator,
 ] struct {
 	*handlers.BaseHandler[ContextT]
-	backend Backend[BeaconBlockHeaderT, BeaconStateT, ValidatorT]
+	backend  Backend[BeaconBlockHeaderT, BeaconStateT, ValidatorT]
+	resolver *utils.StateResolver
 }
 
 // NewHandler creates a new handler for the proof API.
@@ -66,7 +67,8 @@ func NewHandler[
 		BaseHandler: handlers.NewBaseHandler(
 			handlers.NewRouteSet[ContextT](""),
 		),
-		backend: backend,
+		backend:  backend,
+		resolver: utils.NewStateResolver(backend),
 	}
 	return h
 }
@@ -83,12 +85,12 @@ func (h *Handler[
 		blockHeader BeaconBlockHeaderT
 	)
 
-	slot, err := utils.ParentSlotFromTimestampID(timestampID, h.backend)
+	state, err := h.resolver.ResolveTimestampID(timestampID)
 	if err != nil {
 		return 0, beaconState, blockHeader, err
 	}
 
-	beaconState, slot, err = h.backend.StateFromSlotForProof(slot)
+	beaconState, slot, err := h.backend.StateFromSlotForProof(state.Slot)
 	if err != nil {
 		return 0, beaconState, blockHeader, err
 	}
@@ -32,25 +32,13 @@ import (
 func ProveBeaconStateInBlock(
 	bbh types.BeaconBlockHeader, verifyProof bool,
 ) ([]common.Root, error) {
-	blockProofTree, err := bbh.GetTree()
+	leaf, proof, err := merkle.Prove[common.Root](bbh, StateGIndexDenebBlock)
 	if err != nil {
 		return nil, err
 	}
 
-	stateInBlockProof, err := blockProofTree.Prove(StateGIndexDenebBlock)
-	if err != nil {
-		return nil, err
-	}
-
-	proof := make([]common.Root, len(stateInBlockProof.Hashes))
-	for i, hash := range stateInBlockProof.Hashes {
-		proof[i] = common.NewRootFromBytes(hash)
-	}
-
 	if verifyProof {
-		if err = verifyBeaconStateInBlock(
-			bbh, proof, common.NewRootFromBytes(stateInBlockProof.Leaf),
-		); err != nil {
+		if err = verifyBeaconStateInBlock(bbh, proof, leaf); err != nil {
 			return nil, err
 		}
 	}
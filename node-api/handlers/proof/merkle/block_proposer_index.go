@@ -34,26 +34,14 @@ import (
 func ProveProposerIndexInBlock[
 	BeaconBlockHeaderT types.BeaconBlockHeader,
 ](bbh BeaconBlockHeaderT) ([]common.Root, common.Root, error) {
-	blockProofTree, err := bbh.GetTree()
-	if err != nil {
-		return nil, common.Root{}, err
-	}
-
-	proposerIndexProof, err := blockProofTree.Prove(
-		ProposerIndexGIndexDenebBlock,
+	leaf, proof, err := merkle.Prove[common.Root](
+		bbh, ProposerIndexGIndexDenebBlock,
 	)
 	if err != nil {
 		return nil, common.Root{}, err
 	}
 
-	proof := make([]common.Root, len(proposerIndexProof.Hashes))
-	for i, hash := range proposerIndexProof.Hashes {
-		proof[i] = common.NewRootFromBytes(hash)
-	}
-
-	beaconRoot, err := verifyProposerIndexInBlock(
-		bbh, proof, common.NewRootFromBytes(proposerIndexProof.Leaf),
-	)
+	beaconRoot, err := verifyProposerIndexInBlock(bbh, proof, leaf)
 	if err != nil {
 		return nil, common.Root{}, err
 	}
@@ -59,9 +59,7 @@ func ProveProposerPubkeyInBlock[
 	}
 
 	// Sanity check that the combined proof verifies against our beacon root.
-	//
-	//nolint:gocritic // ok.
-	combinedProof := append(valPubkeyInStateProof, stateInBlockProof...)
+	combinedProof := merkle.CombineProofs(valPubkeyInStateProof, stateInBlockProof)
 	beaconRoot, err := verifyProposerInBlock(
 		bbh, proposerOffset, combinedProof, leaf,
 	)
@@ -88,23 +86,15 @@ func ProveProposerPubkeyInState[
 	if err != nil {
 		return nil, common.Root{}, err
 	}
-	stateProofTree, err := bsm.GetTree()
-	if err != nil {
-		return nil, common.Root{}, err
-	}
-
-	//#nosec:G701 // max proposer offset is 8 * (2^40 - 1).
-	gIndex := ZeroValidatorPubkeyGIndexDenebState + int(proposerOffset)
-	valPubkeyInStateProof, err := stateProofTree.Prove(gIndex)
+	// max proposer offset is 8 * (2^40 - 1).
+	gIndex := merkle.GeneralizedIndex(
+		ZeroValidatorPubkeyGIndexDenebState + proposerOffset.Unwrap(),
+	)
+	leaf, proof, err := merkle.Prove[common.Root](bsm, gIndex)
 	if err != nil {
 		return nil, common.Root{}, err
 	}
-
-	proof := make([]common.Root, len(valPubkeyInStateProof.Hashes))
-	for i, hash := range valPubkeyInStateProof.Hashes {
-		proof[i] = common.NewRootFromBytes(hash)
-	}
-	return proof, common.NewRootFromBytes(valPubkeyInStateProof.Leaf), nil
+	return proof, leaf, nil
 }
 
 // verifyProposerInBlock verifies the proposer pubkey in the beacon block,
@@ -20,21 +20,27 @@
 
 package merkle
 
+import "github.com/berachain/beacon-kit/consensus-types/gindex"
+
+// The generalized indices proofs are built for. They are aliases of the
+// generated constants of the gindex package.
+//
+//nolint:lll // generated names get long.
 const (
 	// ProposerIndexGIndexDenebBlock is the generalized index of the proposer
 	// index in the beacon block in the Deneb fork.
-	ProposerIndexGIndexDenebBlock = 9
+	ProposerIndexGIndexDenebBlock = gindex.GIndexDenebHeaderProposerIndex
 
 	// StateGIndexDenebBlock is the generalized index of the beacon state in
 	// the beacon block in the Deneb fork.
-	StateGIndexDenebBlock = 11
+	StateGIndexDenebBlock = gindex.GIndexDenebHeaderState
 
 	// ZeroValidatorPubkeyGIndexDenebState is the generalized index of the 0
 	// validator's pubkey in the beacon state in the Deneb fork. To get the
 	// GIndex of the pubkey of validator at index n, the formula is:
 	// GIndex = ZeroValidatorPubkeyGIndexDenebState +
 	//          (ValidatorPubkeyGIndexOffset * n)
-	ZeroValidatorPubkeyGIndexDenebState = 439804651110400
+	ZeroValidatorPubkeyGIndexDenebState = gindex.GIndexDenebStateValidators0Pubkey
 
 	// ZeroValidatorPubkeyGIndexDenebBlock is the generalized index of the 0
 	// validator's pubkey in the beacon block in the Deneb fork. This is
@@ -43,29 +49,41 @@ const (
 	// validator at index n, the formula is:
 	// GIndex = ZeroValidatorPubkeyGIndexDenebBlock +
 	//          (ValidatorPubkeyGIndexOffset * n)
-	ZeroValidatorPubkeyGIndexDenebBlock = 3254554418216960
+	ZeroValidatorPubkeyGIndexDenebBlock = gindex.GIndexDenebHeaderStateValidators0Pubkey
 
 	// ValidatorPubkeyGIndexOffset is the offset of a validator pubkey GIndex.
-	ValidatorPubkeyGIndexOffset = 8
+	ValidatorPubkeyGIndexOffset = gindex.GIndexDenebStateValidatorsFieldOffset
 
-	// ExecutionNumberGIndexDenebState is the generalized index of the latest
-	// execution payload header in the beacon state in the Deneb fork.
-	ExecutionNumberGIndexDenebState = 774
+	// ExecutionNumberGIndexDenebState is the generalized index of the number
+	// in the latest execution payload header in the beacon state in the Deneb
+	// fork.
+	ExecutionNumberGIndexDenebState = gindex.GIndexDenebStateLatestExecutionPayloadHeaderNumber
 
 	// ExecutionNumberGIndexDenebBlock is the generalized index of the number
 	// in the latest execution payload header in the beacon block in the Deneb
 	// fork. This is calculated by concatenating the
 	// (ExecutionNumberGIndexDenebState, StateGIndexDenebBlock) GIndices.
-	ExecutionNumberGIndexDenebBlock = 5894
+	ExecutionNumberGIndexDenebBlock = gindex.GIndexDenebHeaderStateLatestExecutionPayloadHeaderNumber
 
 	// ExecutionFeeRecipientGIndexDenebState is the generalized index of the
 	// fee recipient in the latest execution payload header in the beacon state
 	// in the Deneb fork.
-	ExecutionFeeRecipientGIndexDenebState = 769
+	ExecutionFeeRecipientGIndexDenebState = gindex.GIndexDenebStateLatestExecutionPayloadHeaderFeeRecipient
 
 	// ExecutionFeeRecipientGIndexDenebBlock is the generalized index of the
 	// fee recipient in the latest execution payload header in the beacon block
 	// in the Deneb fork. This is calculated by concatenating the
 	// (ExecutionFeeRecipientGIndexDenebState, StateGIndexDenebBlock) GIndices.
-	ExecutionFeeRecipientGIndexDenebBlock = 5889
+	ExecutionFeeRecipientGIndexDenebBlock = gindex.GIndexDenebHeaderStateLatestExecutionPayloadHeaderFeeRecipient
+
+	// ExecutionPayloadHeaderGIndexDenebState is the generalized index of the
+	// latest execution payload header in the beacon state in the Deneb fork.
+	ExecutionPayloadHeaderGIndexDenebState = gindex.GIndexDenebStateLatestExecutionPayloadHeader
+
+	// ExecutionPayloadHeaderGIndexDenebBlock is the generalized index of the
+	// latest execution payload header in the beacon block in the Deneb fork.
+	// This is calculated by concatenating the
+	// (ExecutionPayloadHeaderGIndexDenebState, StateGIndexDenebBlock)
+	// GIndices.
+	ExecutionPayloadHeaderGIndexDenebBlock = gindex.GIndexDenebHeaderStateLatestExecutionPayloadHeader
 )
@@ -57,9 +57,7 @@ func ProveExecutionFeeRecipientInBlock[
 	}
 
 	// Sanity check that the combined proof verifies against our beacon root.
-	//
-	//nolint:gocritic // ok.
-	combinedProof := append(feeRecipientInStateProof, stateInBlockProof...)
+	combinedProof := merkle.CombineProofs(feeRecipientInStateProof, stateInBlockProof)
 	beaconRoot, err := verifyExecutionFeeRecipientInBlock(
 		bbh, combinedProof, leaf,
 	)
@@ -86,23 +84,11 @@ func ProveExecutionFeeRecipientInState[
 	if err != nil {
 		return nil, common.Root{}, err
 	}
-	stateProofTree, err := bsm.GetTree()
+	leaf, proof, err := merkle.Prove[common.Root](bsm, ExecutionFeeRecipientGIndexDenebState)
 	if err != nil {
 		return nil, common.Root{}, err
 	}
-
-	feeRecipientInStateProof, err := stateProofTree.Prove(
-		ExecutionFeeRecipientGIndexDenebState,
-	)
-	if err != nil {
-		return nil, common.Root{}, err
-	}
-
-	proof := make([]common.Root, len(feeRecipientInStateProof.Hashes))
-	for i, hash := range feeRecipientInStateProof.Hashes {
-		proof[i] = common.NewRootFromBytes(hash)
-	}
-	return proof, common.NewRootFromBytes(feeRecipientInStateProof.Leaf), nil
+	return proof, leaf, nil
 }
 
 // verifyExecutionFeeRecipientInBlock verifies the execution fee recipient in
@@ -57,9 +57,7 @@ func ProveExecutionNumberInBlock[
 	}
 
 	// Sanity check that the combined proof verifies against our beacon root.
-	//
-	//nolint:gocritic // ok.
-	combinedProof := append(numberInStateProof, stateInBlockProof...)
+	combinedProof := merkle.CombineProofs(numberInStateProof, stateInBlockProof)
 	beaconRoot, err := verifyExecutionNumberInBlock(bbh, combinedProof, leaf)
 	if err != nil {
 		return nil, common.Root{}, err
@@ -83,23 +81,11 @@ func ProveExecutionNumberInState[
 	if err != nil {
 		return nil, common.Root{}, err
 	}
-	stateProofTree, err := bsm.GetTree()
+	leaf, proof, err := merkle.Prove[common.Root](bsm, ExecutionNumberGIndexDenebState)
 	if err != nil {
 		return nil, common.Root{}, err
 	}
-
-	numberInStateProof, err := stateProofTree.Prove(
-		ExecutionNumberGIndexDenebState,
-	)
-	if err != nil {
-		return nil, common.Root{}, err
-	}
-
-	proof := make([]common.Root, len(numberInStateProof.Hashes))
-	for i, hash := range numberInStateProof.Hashes {
-		proof[i] = common.NewRootFromBytes(hash)
-	}
-	return proof, common.NewRootFromBytes(numberInStateProof.Leaf), nil
+	return proof, leaf, nil
 }
 
 // verifyExecutionNumberInBlock verifies the execution number in the beacon
@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package merkle_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/proof/merkle"
+	"github.com/berachain/beacon-kit/node-api/handlers/proof/merkle/mock"
+	"github.com/berachain/beacon-kit/primitives/common"
+	ssz "github.com/berachain/beacon-kit/primitives/encoding/ssz/merkle"
+	"github.com/stretchr/testify/require"
+)
+
+// TestExecutionPayloadHeaderProof tests the
+// ProveExecutionPayloadHeaderInBlock function and that the generated proof
+// verifies the header root against the beacon block root.
+func TestExecutionPayloadHeaderProof(t *testing.T) {
+	bs, err := mock.NewBeaconState(
+		5, nil, 17, common.NewExecutionAddressFromHex(
+			"0x20f33ce90a13a4b5e7697e3544c3083b8f8a51d4",
+		),
+	)
+	require.NoError(t, err)
+
+	bbh := (&types.BeaconBlockHeader{}).New(
+		5,
+		95,
+		common.Root{1, 2, 3, 4, 5, 6},
+		bs.HashTreeRoot(),
+		common.Root{3, 2, 1, 9, 8, 7},
+	)
+
+	proof, beaconRoot, err := merkle.ProveExecutionPayloadHeaderInBlock(
+		bbh, bs,
+	)
+	require.NoError(t, err)
+	require.Equal(t, bbh.HashTreeRoot(), beaconRoot)
+
+	leph, err := bs.GetLatestExecutionPayloadHeader()
+	require.NoError(t, err)
+	ok, err := ssz.VerifyProof(
+		merkle.ExecutionPayloadHeaderGIndexDenebBlock,
+		leph.HashTreeRoot(), proof, beaconRoot,
+	)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// A proof of another header does not verify.
+	leph.Number++
+	ok, err = ssz.VerifyProof(
+		merkle.ExecutionPayloadHeaderGIndexDenebBlock,
+		leph.HashTreeRoot(), proof, beaconRoot,
+	)
+	require.NoError(t, err)
+	require.False(t, ok)
+}
@@ -47,5 +47,15 @@ func (
 			Path:    "bkit/v1/proof/execution_fee_recipient/:timestamp_id",
 			Handler: h.GetExecutionFeeRecipient,
 		},
+		{
+			Method:  http.MethodGet,
+			Path:    "bkit/v1/proof/execution_payload_header/:timestamp_id",
+			Handler: h.GetExecutionPayloadHeader,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "bkit/v1/proof/beacon_root/:timestamp_id",
+			Handler: h.GetBeaconRoot,
+		},
 	})
 }
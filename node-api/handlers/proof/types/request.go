@@ -39,3 +39,15 @@ type ExecutionNumberRequest struct {
 type ExecutionFeeRecipientRequest struct {
 	types.TimestampIDRequest
 }
+
+// ExecutionPayloadHeaderRequest is the request for the
+// `/proof/execution_payload_header/{timestamp_id}` endpoint.
+type ExecutionPayloadHeaderRequest struct {
+	types.TimestampIDRequest
+}
+
+// BeaconRootRequest is the request for the `/proof/beacon_root/{timestamp_id}`
+// endpoint.
+type BeaconRootRequest struct {
+	types.TimestampIDRequest
+}
@@ -87,3 +87,40 @@ type ExecutionFeeRecipientResponse[BeaconBlockHeaderT any] struct {
 	// using a Generalized Index of 5894 in the Deneb fork.
 	ExecutionFeeRecipientProof []common.Root `json:"execution_fee_recipient_proof"`
 }
+
+// ExecutionPayloadHeaderResponse is the response for the
+// `/proof/execution_payload_header/{timestamp_id}` endpoint.
+type ExecutionPayloadHeaderResponse[
+	BeaconBlockHeaderT, ExecutionPayloadHeaderT any,
+] struct {
+	// BeaconBlockHeader is the block header of which the hash tree root is the
+	// beacon block root to verify against.
+	BeaconBlockHeader BeaconBlockHeaderT `json:"beacon_block_header"`
+
+	// BeaconBlockRoot is the beacon block root for this slot.
+	BeaconBlockRoot common.Root `json:"beacon_block_root"`
+
+	// ExecutionPayloadHeader is the latest execution payload header in the
+	// beacon state of this slot.
+	ExecutionPayloadHeader ExecutionPayloadHeaderT `json:"execution_payload_header"`
+
+	// ExecutionPayloadHeaderRoot is the hash tree root of the execution
+	// payload header, the leaf of the proof.
+	ExecutionPayloadHeaderRoot common.Root `json:"execution_payload_header_root"`
+
+	// ExecutionPayloadHeaderProof can be verified against the beacon block
+	// root using a Generalized Index of 184 in the Deneb fork.
+	ExecutionPayloadHeaderProof []common.Root `json:"execution_payload_header_proof"`
+}
+
+// BeaconRootResponse is the response for the
+// `/proof/beacon_root/{timestamp_id}` endpoint.
+type BeaconRootResponse[BeaconBlockHeaderT any] struct {
+	// BeaconBlockHeader is the block header of which the hash tree root is the
+	// beacon block root.
+	BeaconBlockHeader BeaconBlockHeaderT `json:"beacon_block_header"`
+
+	// BeaconBlockRoot is the beacon block root stored by the EIP-4788 beacon
+	// roots contract for the execution block with the given timestamp.
+	BeaconBlockRoot common.Root `json:"beacon_block_root"`
+}
@@ -59,6 +59,7 @@ type BeaconStateMarshallable interface {
 
 // ExecutionPayloadHeader is the interface for an execution payload header.
 type ExecutionPayloadHeader interface {
+	constraints.SSZRootable
 	// GetNumber returns the block number of the ExecutionPayloadHeader.
 	GetNumber() math.U64
 	// GetFeeRecipient returns the fee recipient address of the
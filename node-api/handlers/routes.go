@@ -29,6 +29,9 @@ type Route[ContextT any] struct {
 	Method  string
 	Path    string
 	Handler handlerFn[ContextT]
+	// Cached marks expensive routes whose GET responses may be served from
+	// the response cache of the engine.
+	Cached bool
 }
 
 // DecorateWithLogs adds logging to the route's handler function as soon as
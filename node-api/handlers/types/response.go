@@ -20,6 +20,11 @@
 
 package types
 
+import (
+	"context"
+	"io"
+)
+
 type DataResponse struct {
 	Data any `json:"data"`
 }
@@ -29,3 +34,17 @@ func Wrap(data any) DataResponse {
 		Data: data,
 	}
 }
+
+// Finalizable is implemented by responses that report whether the state they
+// were read from is finalized, which makes them safe to cache indefinitely.
+type Finalizable interface {
+	IsFinalized() bool
+}
+
+// EventStream is returned by handlers serving server-sent events instead of
+// a single response.
+type EventStream interface {
+	// Serve writes events to w until the context is done, calling flush
+	// after every event.
+	Serve(ctx context.Context, w io.Writer, flush func()) error
+}
@@ -24,82 +24,9 @@ import (
 	"strconv"
 	"strings"
 
-	"github.com/berachain/beacon-kit/errors"
-	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/math"
 )
 
-// TODO: define unique types for each of the query-able IDs (state & block from
-// spec, execution unique to beacon-kit). For each type define validation
-// functions and resolvers to slot number.
-
-// SlotFromStateID returns a slot from the state ID.
-//
-// NOTE: Right now, `stateID` only supports querying by "head" (all of "head",
-// "finalized", "justified" are the same), "genesis", and <slot>.
-func SlotFromStateID[StorageBackendT interface {
-	GetSlotByStateRoot(root common.Root) (math.Slot, error)
-}](stateID string, storage StorageBackendT) (math.Slot, error) {
-	if slot, err := slotFromStateID(stateID); err == nil {
-		return slot, nil
-	}
-
-	// We assume that the state ID is a state hash.
-	root, err := common.NewRootFromHex(stateID)
-	if err != nil {
-		return 0, err
-	}
-	return storage.GetSlotByStateRoot(root)
-}
-
-// SlotFromBlockID returns a slot from the block ID.
-//
-// NOTE: `blockID` shares the same semantics as `stateID`, with the modification
-// of being able to query by beacon <blockRoot> instead of <stateRoot>.
-func SlotFromBlockID[StorageBackendT interface {
-	GetSlotByBlockRoot(root common.Root) (math.Slot, error)
-}](blockID string, storage StorageBackendT) (math.Slot, error) {
-	if slot, err := slotFromStateID(blockID); err == nil {
-		return slot, nil
-	}
-
-	// We assume that the block ID is a block hash.
-	root, err := common.NewRootFromHex(blockID)
-	if err != nil {
-		return 0, err
-	}
-	return storage.GetSlotByBlockRoot(root)
-}
-
-// ParentSlotFromTimestampID returns the parent slot corresponding to the
-// timestamp ID.
-//
-// NOTE: `timestampID` shares the same semantics as `stateID`, with the
-// modification of being able to query by next block's <timestamp> instead of
-// the current block's <stateRoot>.
-//
-// The <timestamp> must be prefixed by the 't', followed by the timestamp
-// in decimal UNIX notation. For example 't1728681738' corresponds to the slot
-// which has the next block with a timestamp of 1728681738. Providing just the
-// string '1728681738' (without the prefix 't') will query for the beacon block
-// for slot 1728681738.
-func ParentSlotFromTimestampID[StorageBackendT interface {
-	GetParentSlotByTimestamp(timestamp math.U64) (math.Slot, error)
-}](timestampID string, storage StorageBackendT) (math.Slot, error) {
-	if !IsTimestampIDPrefix(timestampID) {
-		return slotFromStateID(timestampID)
-	}
-
-	// Parse the timestamp from the timestampID.
-	timestamp, err := U64FromString(timestampID[1:])
-	if err != nil {
-		return 0, errors.Wrapf(
-			err, "failed to parse timestamp from timestampID: %s", timestampID,
-		)
-	}
-	return storage.GetParentSlotByTimestamp(timestamp)
-}
-
 // IsTimestampIDPrefix checks if the given timestampID is prefixed with the
 // correct prefix 't'.
 func IsTimestampIDPrefix(timestampID string) bool {
@@ -116,15 +43,3 @@ func U64FromString(id string) (math.U64, error) {
 
 	return math.U64(u64), nil
 }
-
-// slotFromStateID returns a slot number from the given state ID.
-func slotFromStateID(id string) (math.Slot, error) {
-	switch id {
-	case StateIDFinalized, StateIDJustified, StateIDHead:
-		return Head, nil
-	case StateIDGenesis:
-		return Genesis, nil
-	default:
-		return U64FromString(id)
-	}
-}
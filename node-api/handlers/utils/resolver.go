@@ -0,0 +1,212 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package utils
+
+import (
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// StateSource is the backing source a resolved state is read from.
+type StateSource uint8
+
+const (
+	// StateSourceLive is the latest committed state.
+	StateSourceLive StateSource = iota
+	// StateSourceHistorical is a state retained from a past height.
+	StateSourceHistorical
+)
+
+// String returns the name of the state source.
+func (s StateSource) String() string {
+	switch s {
+	case StateSourceLive:
+		return "live"
+	case StateSourceHistorical:
+		return "historical"
+	default:
+		return "unknown"
+	}
+}
+
+// StateIndex is the view of the node the StateResolver resolves IDs against.
+type StateIndex interface {
+	// LatestSlot returns the slot of the latest committed state.
+	LatestSlot() (math.Slot, error)
+	// GetSlotByStateRoot retrieves the slot by a given state root.
+	GetSlotByStateRoot(root common.Root) (math.Slot, error)
+	// GetSlotByBlockRoot retrieves the slot by a given block root.
+	GetSlotByBlockRoot(root common.Root) (math.Slot, error)
+	// GetParentSlotByTimestamp retrieves the parent slot by a given
+	// timestamp.
+	GetParentSlotByTimestamp(timestamp math.U64) (math.Slot, error)
+}
+
+// ResolvedState is an ID pinned to the slot of a committed state.
+type ResolvedState struct {
+	// Slot is the slot of the state. It is never the Head placeholder.
+	Slot math.Slot
+	// Source is the backing source the state is read from.
+	Source StateSource
+	// Finalized reports whether the state is finalized.
+	Finalized bool
+}
+
+// StateResolver maps the state, block and timestamp IDs of the node API to
+// the committed state they refer to.
+//
+// Named IDs are pinned to a slot once, when they are resolved, so that all
+// the reads serving a request see the same state even if a block is finalized
+// meanwhile, rather than each read picking up whatever state is latest when
+// it runs. CometBFT finalizes every block it commits, so "head", "finalized"
+// and "justified" all refer to the latest committed state.
+type StateResolver struct {
+	index StateIndex
+}
+
+// NewStateResolver creates a new state resolver over the given index.
+func NewStateResolver(index StateIndex) *StateResolver {
+	return &StateResolver{index: index}
+}
+
+// Head resolves the latest committed state.
+func (r *StateResolver) Head() (ResolvedState, error) {
+	return r.ResolveSlot(Head)
+}
+
+// ResolveSlot resolves a slot, with the Head placeholder standing for the
+// latest committed state.
+func (r *StateResolver) ResolveSlot(slot math.Slot) (ResolvedState, error) {
+	latest, err := r.index.LatestSlot()
+	if err != nil {
+		return ResolvedState{}, err
+	}
+	return r.pin(slot, latest)
+}
+
+// ResolveStateID resolves a state ID, one of "head", "finalized",
+// "justified", "genesis", a <slot> or a hex encoded <stateRoot>.
+func (r *StateResolver) ResolveStateID(stateID string) (ResolvedState, error) {
+	return r.resolve(stateID, r.index.GetSlotByStateRoot)
+}
+
+// ResolveBlockID resolves a block ID, which shares the semantics of a state
+// ID except for being able to query by beacon <blockRoot> instead of
+// <stateRoot>.
+func (r *StateResolver) ResolveBlockID(blockID string) (ResolvedState, error) {
+	return r.resolve(blockID, r.index.GetSlotByBlockRoot)
+}
+
+// ResolveTimestampID resolves a timestamp ID, which shares the semantics of
+// a state ID except for being able to query by next block's <timestamp>
+// instead of the current block's <stateRoot>.
+//
+// The <timestamp> must be prefixed by the 't', followed by the timestamp
+// in decimal UNIX notation. For example 't1728681738' corresponds to the slot
+// which has the next block with a timestamp of 1728681738. Providing just the
+// string '1728681738' (without the prefix 't') will query for the beacon block
+// for slot 1728681738.
+func (r *StateResolver) ResolveTimestampID(
+	timestampID string,
+) (ResolvedState, error) {
+	if !IsTimestampIDPrefix(timestampID) {
+		return r.ResolveStateID(timestampID)
+	}
+
+	// Parse the timestamp from the timestampID.
+	timestamp, err := U64FromString(timestampID[1:])
+	if err != nil {
+		return ResolvedState{}, errors.Wrapf(
+			err, "failed to parse timestamp from timestampID: %s", timestampID,
+		)
+	}
+	latest, err := r.index.LatestSlot()
+	if err != nil {
+		return ResolvedState{}, err
+	}
+	slot, err := r.index.GetParentSlotByTimestamp(timestamp)
+	if err != nil {
+		return ResolvedState{}, err
+	}
+	return r.pin(slot, latest)
+}
+
+// resolve resolves a named ID or a slot, falling back to looking up the slot
+// of a root with the given function.
+func (r *StateResolver) resolve(
+	id string,
+	slotByRoot func(common.Root) (math.Slot, error),
+) (ResolvedState, error) {
+	// The latest slot is read first, so that a block committed while
+	// resolving cannot move the ID past it.
+	latest, err := r.index.LatestSlot()
+	if err != nil {
+		return ResolvedState{}, err
+	}
+
+	var slot math.Slot
+	switch id {
+	case StateIDHead, StateIDFinalized, StateIDJustified:
+		slot = latest
+	case StateIDGenesis:
+		slot = Genesis
+	default:
+		if slot, err = U64FromString(id); err == nil {
+			break
+		}
+		// We assume that the ID is a root.
+		var root common.Root
+		if root, err = common.NewRootFromHex(id); err != nil {
+			return ResolvedState{}, err
+		}
+		if slot, err = slotByRoot(root); err != nil {
+			return ResolvedState{}, err
+		}
+	}
+	return r.pin(slot, latest)
+}
+
+// pin pins the slot of a state against the latest committed slot.
+func (r *StateResolver) pin(slot, latest math.Slot) (ResolvedState, error) {
+	// A slot of zero has always stood for the latest state.
+	if slot == Head {
+		slot = latest
+	}
+	if slot > latest {
+		return ResolvedState{}, errors.Wrapf(
+			types.ErrNotFound,
+			"slot %d is past the latest committed slot %d", slot, latest,
+		)
+	}
+
+	source := StateSourceHistorical
+	if slot == latest {
+		source = StateSourceLive
+	}
+	return ResolvedState{
+		Slot:   slot,
+		Source: source,
+		// Every committed state is final.
+		Finalized: true,
+	}, nil
+}
@@ -0,0 +1,160 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package utils_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/stretchr/testify/require"
+)
+
+var errUnknownRoot = errors.New("unknown root")
+
+// fakeIndex is an in-memory state index whose latest slot advances on every
+// read, as if a block were finalized concurrently with each query.
+type fakeIndex struct {
+	latest       math.Slot
+	advance      bool
+	stateRoots   map[common.Root]math.Slot
+	blockRoots   map[common.Root]math.Slot
+	parentByTime map[math.U64]math.Slot
+}
+
+func (f *fakeIndex) LatestSlot() (math.Slot, error) {
+	latest := f.latest
+	if f.advance {
+		f.latest++
+	}
+	return latest, nil
+}
+
+func (f *fakeIndex) GetSlotByStateRoot(root common.Root) (math.Slot, error) {
+	if slot, ok := f.stateRoots[root]; ok {
+		return slot, nil
+	}
+	return 0, errUnknownRoot
+}
+
+func (f *fakeIndex) GetSlotByBlockRoot(root common.Root) (math.Slot, error) {
+	if slot, ok := f.blockRoots[root]; ok {
+		return slot, nil
+	}
+	return 0, errUnknownRoot
+}
+
+func (f *fakeIndex) GetParentSlotByTimestamp(
+	timestamp math.U64,
+) (math.Slot, error) {
+	return f.parentByTime[timestamp], nil
+}
+
+func TestStateResolver_ResolveStateID(t *testing.T) {
+	stateRoot := common.Root{0x01}
+	index := &fakeIndex{
+		latest:     10,
+		stateRoots: map[common.Root]math.Slot{stateRoot: 4},
+	}
+	resolver := utils.NewStateResolver(index)
+
+	tests := []struct {
+		id     string
+		slot   math.Slot
+		source utils.StateSource
+	}{
+		{utils.StateIDHead, 10, utils.StateSourceLive},
+		{utils.StateIDFinalized, 10, utils.StateSourceLive},
+		{utils.StateIDJustified, 10, utils.StateSourceLive},
+		{utils.StateIDGenesis, utils.Genesis, utils.StateSourceHistorical},
+		{"0", 10, utils.StateSourceLive},
+		{"7", 7, utils.StateSourceHistorical},
+		{"10", 10, utils.StateSourceLive},
+		{stateRoot.Hex(), 4, utils.StateSourceHistorical},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			state, err := resolver.ResolveStateID(tt.id)
+			require.NoError(t, err)
+			require.Equal(t, tt.slot, state.Slot)
+			require.Equal(t, tt.source, state.Source)
+			require.True(t, state.Finalized)
+		})
+	}
+}
+
+func TestStateResolver_Errors(t *testing.T) {
+	resolver := utils.NewStateResolver(&fakeIndex{latest: 10})
+
+	_, err := resolver.ResolveStateID("11")
+	require.ErrorIs(t, err, types.ErrNotFound)
+
+	_, err = resolver.ResolveStateID(common.Root{0x02}.Hex())
+	require.ErrorIs(t, err, errUnknownRoot)
+
+	_, err = resolver.ResolveBlockID("not-an-id")
+	require.Error(t, err)
+
+	_, err = resolver.ResolveTimestampID("tnot-a-timestamp")
+	require.Error(t, err)
+}
+
+func TestStateResolver_BlockAndTimestampIDs(t *testing.T) {
+	blockRoot := common.Root{0x03}
+	resolver := utils.NewStateResolver(&fakeIndex{
+		latest:       10,
+		blockRoots:   map[common.Root]math.Slot{blockRoot: 9},
+		parentByTime: map[math.U64]math.Slot{1728681738: 8},
+	})
+
+	state, err := resolver.ResolveBlockID(blockRoot.Hex())
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(9), state.Slot)
+
+	state, err = resolver.ResolveTimestampID("t1728681738")
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(8), state.Slot)
+
+	state, err = resolver.ResolveTimestampID("5")
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(5), state.Slot)
+}
+
+func TestStateResolver_PinsHeadDuringFinalization(t *testing.T) {
+	// Each read of the latest slot sees a newly finalized block, yet a
+	// resolved head stays pinned to the slot it was resolved to.
+	index := &fakeIndex{latest: 10, advance: true}
+	resolver := utils.NewStateResolver(index)
+
+	head, err := resolver.Head()
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(10), head.Slot)
+	require.Equal(t, utils.StateSourceLive, head.Source)
+
+	// The same slot resolves as historical once a later block is committed.
+	state, err := resolver.ResolveSlot(head.Slot)
+	require.NoError(t, err)
+	require.Equal(t, head.Slot, state.Slot)
+	require.Equal(t, utils.StateSourceHistorical, state.Source)
+}
@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+)
+
+// ProduceBlock returns an unsigned block for the next slot, for the
+// external proposer to sign and submit.
+func (h *Handler[_, _, ContextT, _]) ProduceBlock(c ContextT) (any, error) {
+	blk, err := h.produceBlock(c)
+	if err != nil {
+		return nil, err
+	}
+	return types.Wrap(blk), nil
+}
+
+// ProduceBlindedBlock returns an unsigned block for the next slot with the
+// header of its execution payload in place of the payload, for the external
+// proposer to sign and submit blinded.
+func (h *Handler[_, _, ContextT, _]) ProduceBlindedBlock(
+	c ContextT,
+) (any, error) {
+	blk, err := h.produceBlock(c)
+	if err != nil {
+		return nil, err
+	}
+	blinded, err := blk.Blind()
+	if err != nil {
+		return nil, err
+	}
+	return types.Wrap(blinded), nil
+}
+
+// SubmitBlock accepts the block produced last, signed by the external
+// proposer, to be proposed in its slot.
+func (h *Handler[BeaconBlockT, _, ContextT, _]) SubmitBlock(
+	c ContextT,
+) (any, error) {
+	req, err := utils.BindAndValidate[SubmitBlockRequest[BeaconBlockT]](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return nil, h.backend.SubmitBlock(req.Message, req.Signature)
+}
+
+// SubmitBlindedBlock accepts the block produced last, signed blinded by the
+// external proposer. The signature reveals the execution payload, which must
+// match the header the proposer signed, and the full block is then proposed
+// in its slot.
+func (h *Handler[_, BlindedBeaconBlockT, ContextT, _]) SubmitBlindedBlock(
+	c ContextT,
+) (any, error) {
+	req, err := utils.BindAndValidate[SubmitBlockRequest[BlindedBeaconBlockT]]( //nolint:lll // generics.
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	payload, err := h.relay.RevealPayload(
+		req.Message.HashTreeRoot(), req.Signature,
+	)
+	if err != nil {
+		return nil, err
+	}
+	blk, err := req.Message.Unblind(payload)
+	if err != nil {
+		return nil, err
+	}
+	return nil, h.backend.SubmitBlock(blk, req.Signature)
+}
+
+// produceBlock parses the request for a block and produces it.
+func (h *Handler[BeaconBlockT, _, ContextT, _]) produceBlock(
+	c ContextT,
+) (BeaconBlockT, error) {
+	var blk BeaconBlockT
+	req, err := utils.BindAndValidate[ProduceBlockRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return blk, err
+	}
+	slot, err := utils.U64FromString(req.Slot)
+	if err != nil {
+		return blk, types.ErrInvalidRequest
+	}
+	var reveal crypto.BLSSignature
+	if err = reveal.UnmarshalText([]byte(req.RandaoReveal)); err != nil {
+		return blk, types.ErrInvalidRequest
+	}
+	var graffiti common.Bytes32
+	if req.Graffiti != "" {
+		if err = graffiti.UnmarshalText([]byte(req.Graffiti)); err != nil {
+			return blk, types.ErrInvalidRequest
+		}
+	}
+	return h.backend.ProduceBlock(slot, reveal, graffiti)
+}
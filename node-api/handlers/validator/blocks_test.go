@@ -0,0 +1,195 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/node-api/engines/echo"
+	"github.com/berachain/beacon-kit/node-api/handlers/validator"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeBackend produces a fixed block and records the submitted one.
+type fakeBackend struct {
+	blk       *ctypes.BeaconBlock
+	submitted *ctypes.BeaconBlock
+	signature crypto.BLSSignature
+}
+
+func (b *fakeBackend) ProduceBlock(
+	slot math.Slot, reveal crypto.BLSSignature, graffiti common.Bytes32,
+) (*ctypes.BeaconBlock, error) {
+	b.blk.Slot = slot
+	b.blk.Body.RandaoReveal = reveal
+	b.blk.Body.Graffiti = graffiti
+	return b.blk, nil
+}
+
+func (b *fakeBackend) SubmitBlock(
+	blk *ctypes.BeaconBlock, signature crypto.BLSSignature,
+) error {
+	b.submitted, b.signature = blk, signature
+	return nil
+}
+
+// RevealPayload reveals the payload of the produced block, as the relay
+// does once the proposer signed it blinded.
+func (b *fakeBackend) RevealPayload(
+	root common.Root, _ crypto.BLSSignature,
+) (*ctypes.ExecutionPayload, error) {
+	if root != b.blk.HashTreeRoot() {
+		return nil, errors.New("unknown block")
+	}
+	return b.blk.Body.ExecutionPayload, nil
+}
+
+func newEngine(backend *fakeBackend) *echo.Engine {
+	logger := noop.NewLogger[log.Logger]()
+	h := validator.NewHandler[
+		*ctypes.BeaconBlock,
+		*ctypes.BlindedBeaconBlock,
+		echo.Context,
+		*ctypes.ExecutionPayload,
+	](backend, backend)
+	h.RegisterRoutes(logger)
+	engine := echo.NewDefaultEngine()
+	engine.RegisterRoutes(h.RouteSet(), logger)
+	return engine
+}
+
+func serve(
+	engine *echo.Engine, method, path, body string,
+) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	engine.ServeHTTP(rec, req)
+	return rec
+}
+
+func newBackend() *fakeBackend {
+	return &fakeBackend{blk: &ctypes.BeaconBlock{
+		ProposerIndex: 3,
+		ParentRoot:    common.Root{1},
+		StateRoot:     common.Root{2},
+		Body: &ctypes.BeaconBlockBody{
+			Eth1Data: &ctypes.Eth1Data{},
+			ExecutionPayload: &ctypes.ExecutionPayload{
+				BaseFeePerGas: math.NewU256(7),
+				Transactions:  [][]byte{{8}},
+			},
+		},
+	}}
+}
+
+func TestProduceAndSubmitBlock(t *testing.T) {
+	backend := newBackend()
+	engine := newEngine(backend)
+
+	reveal := crypto.BLSSignature{4}
+	graffiti := common.Bytes32{5}
+	rec := serve(engine, http.MethodGet,
+		"/eth/v1/validator/blocks/12?randao_reveal="+reveal.String()+
+			"&graffiti="+graffiti.String(), "",
+	)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var produced struct {
+		Data json.RawMessage `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &produced))
+	require.Equal(t, math.Slot(12), backend.blk.Slot)
+	require.Equal(t, reveal, backend.blk.Body.RandaoReveal)
+	require.Equal(t, graffiti, common.Bytes32(backend.blk.Body.Graffiti))
+
+	// The external proposer submits the block back as served, signed.
+	signature := crypto.BLSSignature{6}
+	body := `{"message":` + string(produced.Data) +
+		`,"signature":"` + signature.String() + `"}`
+	rec = serve(engine, http.MethodPost, "/eth/v1/beacon/blocks", body)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	require.Equal(t,
+		backend.blk.HashTreeRoot(), backend.submitted.HashTreeRoot(),
+	)
+	require.Equal(t, signature, backend.signature)
+}
+
+func TestProduceAndSubmitBlindedBlock(t *testing.T) {
+	backend := newBackend()
+	engine := newEngine(backend)
+
+	reveal := crypto.BLSSignature{4}
+	rec := serve(engine, http.MethodGet,
+		"/eth/v1/validator/blinded_blocks/12?randao_reveal="+reveal.String(),
+		"",
+	)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var produced struct {
+		Data *ctypes.BlindedBeaconBlock `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &produced))
+	require.Equal(t,
+		backend.blk.HashTreeRoot(), produced.Data.HashTreeRoot(),
+	)
+
+	// The external proposer signs the block blinded, which reveals the
+	// payload the node unblinds it with.
+	signature := crypto.BLSSignature{6}
+	message, err := json.Marshal(produced.Data)
+	require.NoError(t, err)
+	body := `{"message":` + string(message) +
+		`,"signature":"` + signature.String() + `"}`
+	rec = serve(engine, http.MethodPost, "/eth/v1/beacon/blinded_blocks", body)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	require.Equal(t,
+		backend.blk.HashTreeRoot(), backend.submitted.HashTreeRoot(),
+	)
+	require.Equal(t,
+		backend.blk.Body.ExecutionPayload, backend.submitted.Body.ExecutionPayload,
+	)
+	require.Equal(t, signature, backend.signature)
+}
+
+func TestProduceBlockRejectsInvalidRequests(t *testing.T) {
+	engine := newEngine(&fakeBackend{})
+	reveal := crypto.BLSSignature{4}
+	for _, path := range []string{
+		"/eth/v1/validator/blocks/12",
+		"/eth/v1/validator/blocks/12?randao_reveal=0x04",
+		"/eth/v1/validator/blocks/head?randao_reveal=" + reveal.String(),
+		"/eth/v1/validator/blocks/12?randao_reveal=" + reveal.String() +
+			"&graffiti=0x05",
+	} {
+		rec := serve(engine, http.MethodGet, path, "")
+		require.Equal(t, http.StatusBadRequest, rec.Code, path)
+	}
+}
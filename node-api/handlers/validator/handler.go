@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	"github.com/berachain/beacon-kit/node-api/server/context"
+)
+
+type Handler[
+	BeaconBlockT BeaconBlock[BlindedBeaconBlockT],
+	BlindedBeaconBlockT BlindedBeaconBlock[BeaconBlockT, ExecutionPayloadT],
+	ContextT context.Context,
+	ExecutionPayloadT any,
+] struct {
+	*handlers.BaseHandler[ContextT]
+	backend Backend[BeaconBlockT]
+	relay   PayloadRelay[ExecutionPayloadT]
+}
+
+// NewHandler creates the handler serving the blocks of an external
+// proposer. The relay reveals the payloads of the blocks signed blinded.
+func NewHandler[
+	BeaconBlockT BeaconBlock[BlindedBeaconBlockT],
+	BlindedBeaconBlockT BlindedBeaconBlock[BeaconBlockT, ExecutionPayloadT],
+	ContextT context.Context,
+	ExecutionPayloadT any,
+](
+	backend Backend[BeaconBlockT],
+	relay PayloadRelay[ExecutionPayloadT],
+) *Handler[BeaconBlockT, BlindedBeaconBlockT, ContextT, ExecutionPayloadT] {
+	h := &Handler[
+		BeaconBlockT, BlindedBeaconBlockT, ContextT, ExecutionPayloadT,
+	]{
+		BaseHandler: handlers.NewBaseHandler(
+			handlers.NewRouteSet[ContextT](""),
+		),
+		backend: backend,
+		relay:   relay,
+	}
+	return h
+}
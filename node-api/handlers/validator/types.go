@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// Backend builds the blocks of an external proposer.
+type Backend[BeaconBlockT any] interface {
+	// ProduceBlock builds an unsigned block for the given slot, which must
+	// follow the head, with the randao reveal of the proposer.
+	ProduceBlock(
+		slot math.Slot,
+		reveal crypto.BLSSignature,
+		graffiti common.Bytes32,
+	) (BeaconBlockT, error)
+	// SubmitBlock accepts the block produced last, signed by the proposer,
+	// to be proposed in its slot.
+	SubmitBlock(blk BeaconBlockT, signature crypto.BLSSignature) error
+}
+
+// PayloadRelay reveals the execution payloads of the blocks signed blinded.
+type PayloadRelay[ExecutionPayloadT any] interface {
+	// RevealPayload returns the execution payload of the block with the
+	// given root, once signed by the proposer.
+	RevealPayload(
+		root common.Root, signature crypto.BLSSignature,
+	) (ExecutionPayloadT, error)
+}
+
+// BeaconBlock is a block the proposer may sign blinded.
+type BeaconBlock[BlindedBeaconBlockT any] interface {
+	// Blind returns the block with the header of its execution payload in
+	// place of the payload.
+	Blind() (BlindedBeaconBlockT, error)
+}
+
+// BlindedBeaconBlock is a block carrying the header of its execution payload
+// in place of the payload.
+type BlindedBeaconBlock[BeaconBlockT, ExecutionPayloadT any] interface {
+	// HashTreeRoot returns the root of the block, which is the root of the
+	// full block.
+	HashTreeRoot() common.Root
+	// Unblind returns the block with the given execution payload in place
+	// of its header, and errors if the payload does not match the header.
+	Unblind(payload ExecutionPayloadT) (BeaconBlockT, error)
+}
+
+// ProduceBlockRequest requests an unsigned block for a slot. The randao
+// reveal and the optional graffiti are hex encoded.
+type ProduceBlockRequest struct {
+	Slot         string `param:"slot"          validate:"required,slot"`
+	RandaoReveal string `query:"randao_reveal" validate:"required"`
+	Graffiti     string `query:"graffiti"`
+}
+
+// SubmitBlockRequest submits a produced block, full or blinded, signed by
+// the proposer.
+type SubmitBlockRequest[BeaconBlockT any] struct {
+	Message   BeaconBlockT        `json:"message"   validate:"required"`
+	Signature crypto.BLSSignature `json:"signature" validate:"required"`
+}
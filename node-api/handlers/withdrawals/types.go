@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package withdrawals
+
+import (
+	withdrawalstore "github.com/berachain/beacon-kit/node-api/withdrawal_store"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// ReceiptStore is the index of the withdrawal receipts.
+type ReceiptStore interface {
+	// ByValidator returns up to limit receipts of the given validator, by
+	// increasing withdrawal index starting at from.
+	ByValidator(
+		index math.ValidatorIndex, from math.U64, limit int,
+	) ([]*withdrawalstore.Receipt, error)
+	// ByAddress returns up to limit receipts paid to the given execution
+	// address, by increasing withdrawal index starting at from.
+	ByAddress(
+		address common.ExecutionAddress, from math.U64, limit int,
+	) ([]*withdrawalstore.Receipt, error)
+}
+
+// PageRequest selects a page of receipts, given as decimal strings.
+type PageRequest struct {
+	FromIndex string `query:"from_index" validate:"uint64"`
+	Limit     string `query:"limit"      validate:"uint64"`
+}
+
+// ValidatorWithdrawalsRequest queries the receipts of a validator.
+type ValidatorWithdrawalsRequest struct {
+	PageRequest
+	ValidatorIndex string `param:"validator_index" validate:"required,uint64"`
+}
+
+// AddressWithdrawalsRequest queries the receipts paid to an execution
+// address.
+type AddressWithdrawalsRequest struct {
+	PageRequest
+	Address string `param:"address" validate:"required,execution_address"`
+}
+
+// ReceiptData is a withdrawal receipt.
+type ReceiptData struct {
+	Index          string `json:"index"`
+	ValidatorIndex string `json:"validator_index"`
+	Address        string `json:"address"`
+	Amount         string `json:"amount"`
+	Slot           string `json:"slot"`
+}
@@ -0,0 +1,141 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package withdrawals
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	withdrawalstore "github.com/berachain/beacon-kit/node-api/withdrawal_store"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+const (
+	// defaultLimit is the number of receipts returned if no limit is given.
+	defaultLimit = 100
+	// maxLimit is the maximum number of receipts returned at once.
+	maxLimit = 1000
+)
+
+// GetValidatorWithdrawals returns the withdrawals of a validator processed
+// in the finalized blocks.
+func (h *Handler[ContextT]) GetValidatorWithdrawals(
+	c ContextT,
+) (any, error) {
+	req, err := utils.BindAndValidate[ValidatorWithdrawalsRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if h.store == nil {
+		return nil, errIndexDisabled
+	}
+	index, err := strconv.ParseUint(req.ValidatorIndex, 10, 64)
+	if err != nil {
+		return nil, types.ErrInvalidRequest
+	}
+	from, limit, err := parsePage(req.PageRequest)
+	if err != nil {
+		return nil, err
+	}
+	receipts, err := h.store.ByValidator(
+		math.ValidatorIndex(index), from, limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return types.Wrap(toReceiptData(receipts)), nil
+}
+
+// GetAddressWithdrawals returns the withdrawals paid to an execution address
+// processed in the finalized blocks.
+func (h *Handler[ContextT]) GetAddressWithdrawals(
+	c ContextT,
+) (any, error) {
+	req, err := utils.BindAndValidate[AddressWithdrawalsRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if h.store == nil {
+		return nil, errIndexDisabled
+	}
+	address, err := common.ParseExecutionAddress(req.Address)
+	if err != nil {
+		return nil, types.ErrInvalidRequest
+	}
+	from, limit, err := parsePage(req.PageRequest)
+	if err != nil {
+		return nil, err
+	}
+	receipts, err := h.store.ByAddress(address, from, limit)
+	if err != nil {
+		return nil, err
+	}
+	return types.Wrap(toReceiptData(receipts)), nil
+}
+
+// errIndexDisabled is returned when the withdrawal receipts are not indexed.
+var errIndexDisabled = fmt.Errorf(
+	"%w: withdrawal receipts are not indexed", types.ErrNotImplemented,
+)
+
+// parsePage parses a validated page request, capping the limit.
+func parsePage(req PageRequest) (math.U64, int, error) {
+	var from, limit uint64 = 0, defaultLimit
+	var err error
+	if req.FromIndex != "" {
+		if from, err = strconv.ParseUint(req.FromIndex, 10, 64); err != nil {
+			return 0, 0, types.ErrInvalidRequest
+		}
+	}
+	if req.Limit != "" {
+		if limit, err = strconv.ParseUint(req.Limit, 10, 64); err != nil {
+			return 0, 0, types.ErrInvalidRequest
+		}
+	}
+	switch {
+	case limit == 0:
+		limit = defaultLimit
+	case limit > maxLimit:
+		limit = maxLimit
+	}
+	return math.U64(from), int(limit), nil
+}
+
+func toReceiptData(receipts []*withdrawalstore.Receipt) []*ReceiptData {
+	data := make([]*ReceiptData, len(receipts))
+	for i, r := range receipts {
+		data[i] = &ReceiptData{
+			Index:          r.Index.Base10(),
+			ValidatorIndex: r.ValidatorIndex.Base10(),
+			Address:        r.Address.String(),
+			Amount:         r.Amount.Base10(),
+			Slot:           r.Slot.Base10(),
+		}
+	}
+	return data
+}
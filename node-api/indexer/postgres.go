@@ -0,0 +1,302 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package indexer
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/math"
+	// Registers the postgres driver.
+	_ "github.com/lib/pq"
+)
+
+// schema creates the explorer tables. Unsigned 64 bits integers are stored
+// as NUMERIC(20), which Postgres integers cannot hold. Deposits and
+// withdrawals are removed along with their block on a re-org.
+const schema = `
+CREATE TABLE IF NOT EXISTS blocks (
+	slot NUMERIC(20) PRIMARY KEY,
+	block_root BYTEA NOT NULL,
+	parent_root BYTEA NOT NULL,
+	state_root BYTEA NOT NULL,
+	proposer_index NUMERIC(20) NOT NULL,
+	execution_number NUMERIC(20) NOT NULL,
+	execution_hash BYTEA NOT NULL,
+	fee_recipient BYTEA NOT NULL,
+	timestamp NUMERIC(20) NOT NULL,
+	gas_used NUMERIC(20) NOT NULL,
+	transaction_count INTEGER NOT NULL
+);
+CREATE UNIQUE INDEX IF NOT EXISTS blocks_block_root_idx
+	ON blocks (block_root);
+CREATE TABLE IF NOT EXISTS deposits (
+	deposit_index NUMERIC(20) PRIMARY KEY,
+	slot NUMERIC(20) NOT NULL REFERENCES blocks (slot) ON DELETE CASCADE,
+	pubkey BYTEA NOT NULL,
+	withdrawal_credentials BYTEA NOT NULL,
+	amount NUMERIC(20) NOT NULL
+);
+CREATE INDEX IF NOT EXISTS deposits_pubkey_idx ON deposits (pubkey);
+CREATE TABLE IF NOT EXISTS withdrawals (
+	withdrawal_index NUMERIC(20) PRIMARY KEY,
+	slot NUMERIC(20) NOT NULL REFERENCES blocks (slot) ON DELETE CASCADE,
+	validator_index NUMERIC(20) NOT NULL,
+	address BYTEA NOT NULL,
+	amount NUMERIC(20) NOT NULL
+);
+CREATE INDEX IF NOT EXISTS withdrawals_validator_index_idx
+	ON withdrawals (validator_index);
+CREATE TABLE IF NOT EXISTS validators (
+	validator_index NUMERIC(20) PRIMARY KEY,
+	pubkey BYTEA NOT NULL,
+	withdrawal_credentials BYTEA NOT NULL,
+	balance NUMERIC(20) NOT NULL,
+	effective_balance NUMERIC(20) NOT NULL,
+	slashed BOOLEAN NOT NULL,
+	activation_eligibility_epoch NUMERIC(20) NOT NULL,
+	activation_epoch NUMERIC(20) NOT NULL,
+	exit_epoch NUMERIC(20) NOT NULL,
+	withdrawable_epoch NUMERIC(20) NOT NULL,
+	updated_slot NUMERIC(20) NOT NULL
+);
+CREATE INDEX IF NOT EXISTS validators_pubkey_idx ON validators (pubkey);
+`
+
+const (
+	selectBlockRoot = `SELECT block_root FROM blocks WHERE slot = $1`
+
+	// deleteFromSlot removes the blocks of an abandoned fork, starting at
+	// the given slot. Their deposits and withdrawals are removed in cascade.
+	deleteFromSlot = `DELETE FROM blocks WHERE slot >= $1`
+
+	upsertBlock = `
+INSERT INTO blocks (
+	slot, block_root, parent_root, state_root, proposer_index,
+	execution_number, execution_hash, fee_recipient, timestamp, gas_used,
+	transaction_count
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+ON CONFLICT (slot) DO UPDATE SET
+	block_root = EXCLUDED.block_root,
+	parent_root = EXCLUDED.parent_root,
+	state_root = EXCLUDED.state_root,
+	proposer_index = EXCLUDED.proposer_index,
+	execution_number = EXCLUDED.execution_number,
+	execution_hash = EXCLUDED.execution_hash,
+	fee_recipient = EXCLUDED.fee_recipient,
+	timestamp = EXCLUDED.timestamp,
+	gas_used = EXCLUDED.gas_used,
+	transaction_count = EXCLUDED.transaction_count`
+
+	upsertDeposit = `
+INSERT INTO deposits (
+	deposit_index, slot, pubkey, withdrawal_credentials, amount
+) VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (deposit_index) DO UPDATE SET
+	slot = EXCLUDED.slot,
+	pubkey = EXCLUDED.pubkey,
+	withdrawal_credentials = EXCLUDED.withdrawal_credentials,
+	amount = EXCLUDED.amount`
+
+	upsertWithdrawal = `
+INSERT INTO withdrawals (
+	withdrawal_index, slot, validator_index, address, amount
+) VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (withdrawal_index) DO UPDATE SET
+	slot = EXCLUDED.slot,
+	validator_index = EXCLUDED.validator_index,
+	address = EXCLUDED.address,
+	amount = EXCLUDED.amount`
+
+	// upsertValidator never overwrites a validator with an older snapshot.
+	upsertValidator = `
+INSERT INTO validators (
+	validator_index, pubkey, withdrawal_credentials, balance,
+	effective_balance, slashed, activation_eligibility_epoch,
+	activation_epoch, exit_epoch, withdrawable_epoch, updated_slot
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+ON CONFLICT (validator_index) DO UPDATE SET
+	pubkey = EXCLUDED.pubkey,
+	withdrawal_credentials = EXCLUDED.withdrawal_credentials,
+	balance = EXCLUDED.balance,
+	effective_balance = EXCLUDED.effective_balance,
+	slashed = EXCLUDED.slashed,
+	activation_eligibility_epoch = EXCLUDED.activation_eligibility_epoch,
+	activation_epoch = EXCLUDED.activation_epoch,
+	exit_epoch = EXCLUDED.exit_epoch,
+	withdrawable_epoch = EXCLUDED.withdrawable_epoch,
+	updated_slot = EXCLUDED.updated_slot
+WHERE validators.updated_slot <= EXCLUDED.updated_slot`
+)
+
+// PostgresSink is a Sink writing the indexed records to a Postgres database.
+type PostgresSink struct {
+	db *sql.DB
+}
+
+// NewPostgresSink creates a new Postgres sink for the given connection
+// string. The database is not connected to until the sink is initialized.
+func NewPostgresSink(dsn string) (*PostgresSink, error) {
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open indexer database")
+	}
+	return &PostgresSink{db: db}, nil
+}
+
+// Init creates the schema if it does not exist.
+func (s *PostgresSink) Init(ctx context.Context) error {
+	if err := s.db.PingContext(ctx); err != nil {
+		return errors.Wrap(err, "failed to connect to indexer database")
+	}
+	if _, err := s.db.ExecContext(ctx, schema); err != nil {
+		return errors.Wrap(err, "failed to create indexer schema")
+	}
+	return nil
+}
+
+// IndexBlock stores a block along with its deposits and withdrawals in a
+// single transaction. If a different block is stored at its slot, the
+// blocks from that slot onwards are removed first.
+func (s *PostgresSink) IndexBlock(
+	ctx context.Context,
+	blk *BlockRecord,
+) error {
+	return s.withTx(ctx, func(tx *sql.Tx) error {
+		slot := numeric(blk.Slot)
+		var root []byte
+		err := tx.QueryRowContext(ctx, selectBlockRoot, slot).Scan(&root)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+		case err != nil:
+			return err
+		case string(root) != string(blk.Root[:]):
+			if _, err = tx.ExecContext(ctx, deleteFromSlot, slot); err != nil {
+				return err
+			}
+		}
+
+		if _, err = tx.ExecContext(
+			ctx, upsertBlock,
+			slot,
+			blk.Root[:],
+			blk.ParentRoot[:],
+			blk.StateRoot[:],
+			numeric(blk.ProposerIndex),
+			numeric(blk.ExecutionNumber),
+			blk.ExecutionHash[:],
+			blk.FeeRecipient[:],
+			numeric(blk.Timestamp),
+			numeric(blk.GasUsed),
+			blk.TransactionCount,
+		); err != nil {
+			return err
+		}
+		for _, d := range blk.Deposits {
+			if _, err = tx.ExecContext(
+				ctx, upsertDeposit,
+				numeric(d.Index),
+				slot,
+				d.Pubkey[:],
+				d.WithdrawalCredentials[:],
+				numeric(d.Amount),
+			); err != nil {
+				return err
+			}
+		}
+		for _, w := range blk.Withdrawals {
+			if _, err = tx.ExecContext(
+				ctx, upsertWithdrawal,
+				numeric(w.Index),
+				slot,
+				numeric(w.ValidatorIndex),
+				w.Address[:],
+				numeric(w.Amount),
+			); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// IndexValidators stores the validator set as of the given slot in a single
+// transaction.
+func (s *PostgresSink) IndexValidators(
+	ctx context.Context,
+	slot math.Slot,
+	validators []*ValidatorRecord,
+) error {
+	return s.withTx(ctx, func(tx *sql.Tx) error {
+		stmt, err := tx.PrepareContext(ctx, upsertValidator)
+		if err != nil {
+			return err
+		}
+		defer stmt.Close()
+		for _, v := range validators {
+			if _, err = stmt.ExecContext(
+				ctx,
+				numeric(v.Index),
+				v.Pubkey[:],
+				v.WithdrawalCredentials[:],
+				numeric(v.Balance),
+				numeric(v.EffectiveBalance),
+				v.Slashed,
+				numeric(v.ActivationEligibilityEpoch),
+				numeric(v.ActivationEpoch),
+				numeric(v.ExitEpoch),
+				numeric(v.WithdrawableEpoch),
+				numeric(slot),
+			); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Close closes the database.
+func (s *PostgresSink) Close() error {
+	return s.db.Close()
+}
+
+// withTx runs fn in a transaction, which is committed if fn succeeds and
+// rolled back otherwise.
+func (s *PostgresSink) withTx(
+	ctx context.Context,
+	fn func(*sql.Tx) error,
+) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err = fn(tx); err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+	return tx.Commit()
+}
+
+// numeric formats an unsigned integer for a NUMERIC(20) column.
+func numeric[T ~uint64](v T) string {
+	return strconv.FormatUint(uint64(v), 10)
+}
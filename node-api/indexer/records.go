@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package indexer
+
+import (
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// BlockRecord is the indexed representation of a finalized beacon block.
+type BlockRecord struct {
+	Slot          math.Slot
+	Root          common.Root
+	ParentRoot    common.Root
+	StateRoot     common.Root
+	ProposerIndex math.ValidatorIndex
+	// ExecutionNumber is the number of the execution block.
+	ExecutionNumber math.U64
+	// ExecutionHash is the hash of the execution block.
+	ExecutionHash    common.ExecutionHash
+	FeeRecipient     common.ExecutionAddress
+	Timestamp        math.U64
+	GasUsed          math.U64
+	TransactionCount int
+	Deposits         []*DepositRecord
+	Withdrawals      []*WithdrawalRecord
+}
+
+// DepositRecord is the indexed representation of a deposit included in a
+// block.
+type DepositRecord struct {
+	Index                 math.U64
+	Pubkey                crypto.BLSPubkey
+	WithdrawalCredentials common.Bytes32
+	Amount                math.Gwei
+}
+
+// WithdrawalRecord is the indexed representation of a withdrawal processed
+// in the execution payload of a block.
+type WithdrawalRecord struct {
+	Index          math.U64
+	ValidatorIndex math.ValidatorIndex
+	Address        common.ExecutionAddress
+	Amount         math.Gwei
+}
+
+// ValidatorRecord is the indexed representation of a validator.
+type ValidatorRecord struct {
+	Index                      math.ValidatorIndex
+	Pubkey                     crypto.BLSPubkey
+	WithdrawalCredentials      common.Bytes32
+	Balance                    math.Gwei
+	EffectiveBalance           math.Gwei
+	Slashed                    bool
+	ActivationEligibilityEpoch math.Epoch
+	ActivationEpoch            math.Epoch
+	ExitEpoch                  math.Epoch
+	WithdrawableEpoch          math.Epoch
+}
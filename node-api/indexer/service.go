@@ -0,0 +1,306 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package indexer
+
+import (
+	"context"
+	"time"
+
+	asynctypes "github.com/berachain/beacon-kit/async/types"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/primitives/async"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// job is a finalized block waiting to be written to the sink.
+type job struct {
+	// block is the block to index.
+	block *BlockRecord
+	// snapshot is true if the validator set must be indexed along with the
+	// block.
+	snapshot bool
+}
+
+// Service is a Service that listens for finalized blocks and streams them,
+// along with the validator set at every epoch boundary, into a Sink.
+type Service[
+	BeaconBlockT BeaconBlock[BeaconBlockBodyT],
+	BeaconBlockBodyT BeaconBlockBody[DepositT, ExecutionPayloadT],
+	BeaconStateT BeaconState,
+	DepositT Deposit[WithdrawalCredentialsT],
+	ExecutionPayloadT ExecutionPayload[WithdrawalsT],
+	WithdrawalT Withdrawal,
+	WithdrawalsT ~[]WithdrawalT,
+	WithdrawalCredentialsT ~[32]byte,
+] struct {
+	// config is the configuration for the indexer service.
+	config Config
+	// logger is used for logging information and errors.
+	logger log.Logger
+	// chainSpec is the chain spec, used to detect epoch boundaries.
+	chainSpec common.ChainSpec
+	// dispatcher is the dispatcher for the service.
+	dispatcher asynctypes.EventDispatcher
+	// sink is where the indexed records are written.
+	sink Sink
+	// states retrieves the states the validator set is read from. Validators
+	// are not indexed if it is nil.
+	states StateSource[BeaconStateT]
+	// subFinalizedBlkEvents is a channel holding BeaconBlockFinalized
+	// events.
+	subFinalizedBlkEvents chan async.Event[BeaconBlockT]
+	// pending holds the blocks that have not been written to the sink yet,
+	// oldest first.
+	pending []*job
+	// initialized is true once the sink has been initialized.
+	initialized bool
+	// snapshotted is true once a validator snapshot has been scheduled.
+	snapshotted bool
+}
+
+// NewService creates a new indexer service.
+func NewService[
+	BeaconBlockT BeaconBlock[BeaconBlockBodyT],
+	BeaconBlockBodyT BeaconBlockBody[DepositT, ExecutionPayloadT],
+	BeaconStateT BeaconState,
+	DepositT Deposit[WithdrawalCredentialsT],
+	ExecutionPayloadT ExecutionPayload[WithdrawalsT],
+	WithdrawalT Withdrawal,
+	WithdrawalsT ~[]WithdrawalT,
+	WithdrawalCredentialsT ~[32]byte,
+](
+	config Config,
+	logger log.Logger,
+	chainSpec common.ChainSpec,
+	dispatcher asynctypes.EventDispatcher,
+	sink Sink,
+	states StateSource[BeaconStateT],
+) *Service[
+	BeaconBlockT, BeaconBlockBodyT, BeaconStateT, DepositT,
+	ExecutionPayloadT, WithdrawalT, WithdrawalsT, WithdrawalCredentialsT,
+] {
+	return &Service[
+		BeaconBlockT, BeaconBlockBodyT, BeaconStateT, DepositT,
+		ExecutionPayloadT, WithdrawalT, WithdrawalsT, WithdrawalCredentialsT,
+	]{
+		config:                config,
+		logger:                logger,
+		chainSpec:             chainSpec,
+		dispatcher:            dispatcher,
+		sink:                  sink,
+		states:                states,
+		subFinalizedBlkEvents: make(chan async.Event[BeaconBlockT]),
+	}
+}
+
+// Name returns the name of the service.
+func (s *Service[_, _, _, _, _, _, _, _]) Name() string {
+	return "indexer"
+}
+
+// Start subscribes the indexer service to BeaconBlockFinalized events and
+// starts the main event loop to handle them accordingly.
+func (s *Service[_, _, _, _, _, _, _, _]) Start(ctx context.Context) error {
+	if !s.config.Enabled {
+		s.logger.Info("indexer service is disabled, skipping indexing")
+		return nil
+	}
+
+	// subscribe a channel to the finalized block events.
+	if err := s.dispatcher.Subscribe(
+		async.BeaconBlockFinalized, s.subFinalizedBlkEvents,
+	); err != nil {
+		s.logger.Error("failed to subscribe to block events", "error", err)
+		return err
+	}
+
+	// start the event loop to listen and handle events.
+	go s.eventLoop(ctx)
+	return nil
+}
+
+// eventLoop is the main event loop for the indexer service.
+func (s *Service[_, _, _, _, _, _, _, _]) eventLoop(ctx context.Context) {
+	ticker := time.NewTicker(s.config.RetryInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			if err := s.sink.Close(); err != nil {
+				s.logger.Error("failed to close indexer sink", "error", err)
+			}
+			return
+		case event := <-s.subFinalizedBlkEvents:
+			s.enqueue(event.Data())
+			s.flush(ctx)
+		case <-ticker.C:
+			if len(s.pending) > 0 {
+				s.flush(ctx)
+			}
+		}
+	}
+}
+
+// enqueue appends a finalized block to the pending blocks, dropping the
+// oldest one if the buffer is full.
+func (s *Service[
+	BeaconBlockT, _, _, _, _, _, _, _,
+]) enqueue(blk BeaconBlockT) {
+	slot := blk.GetSlot()
+	j := &job{
+		block: s.toBlock(blk),
+		snapshot: !s.snapshotted ||
+			slot.Unwrap()%s.chainSpec.SlotsPerEpoch() == 0,
+	}
+	s.snapshotted = true
+
+	if len(s.pending) >= s.config.MaxPending {
+		s.logger.Warn(
+			"indexer buffer is full, dropping block",
+			"slot", s.pending[0].block.Slot,
+		)
+		s.pending = s.pending[1:]
+	}
+	s.pending = append(s.pending, j)
+}
+
+// flush writes the pending blocks to the sink in order, stopping at the
+// first failure. The remaining blocks are retried on the next flush.
+func (s *Service[_, _, _, _, _, _, _, _]) flush(ctx context.Context) {
+	if !s.initialized {
+		if err := s.sink.Init(ctx); err != nil {
+			s.logger.Warn("failed to initialize indexer sink", "error", err)
+			return
+		}
+		s.initialized = true
+	}
+
+	for len(s.pending) > 0 {
+		j := s.pending[0]
+		if err := s.index(ctx, j); err != nil {
+			s.logger.Warn(
+				"failed to index block, will retry",
+				"slot", j.block.Slot,
+				"pending", len(s.pending),
+				"error", err,
+			)
+			return
+		}
+		s.pending = s.pending[1:]
+	}
+}
+
+// index writes a block, and the validator set if required, to the sink.
+func (s *Service[_, _, _, _, _, _, _, _]) index(
+	ctx context.Context,
+	j *job,
+) error {
+	if err := s.sink.IndexBlock(ctx, j.block); err != nil {
+		return err
+	}
+	// The state of the block is only committed once the block is, hence
+	// the validator set is read from the state of the parent block.
+	if !j.snapshot || s.states == nil || j.block.Slot <= 1 {
+		return nil
+	}
+	slot := j.block.Slot - 1
+	validators, err := s.validatorsAt(slot)
+	if err != nil {
+		return err
+	}
+	return s.sink.IndexValidators(ctx, slot, validators)
+}
+
+// validatorsAt returns the validator set as of the given slot.
+func (s *Service[_, _, _, _, _, _, _, _]) validatorsAt(
+	slot math.Slot,
+) ([]*ValidatorRecord, error) {
+	st, _, err := s.states.StateFromSlotForProof(slot)
+	if err != nil {
+		return nil, err
+	}
+	vals, err := st.GetValidators()
+	if err != nil {
+		return nil, err
+	}
+	balances, err := st.GetBalances()
+	if err != nil {
+		return nil, err
+	}
+
+	validators := make([]*ValidatorRecord, len(vals))
+	for i, v := range vals {
+		validators[i] = &ValidatorRecord{
+			Index:                      math.ValidatorIndex(i),
+			Pubkey:                     v.GetPubkey(),
+			WithdrawalCredentials:      common.Bytes32(v.WithdrawalCredentials),
+			EffectiveBalance:           v.GetEffectiveBalance(),
+			Slashed:                    v.IsSlashed(),
+			ActivationEligibilityEpoch: v.ActivationEligibilityEpoch,
+			ActivationEpoch:            v.ActivationEpoch,
+			ExitEpoch:                  v.ExitEpoch,
+			WithdrawableEpoch:          v.WithdrawableEpoch,
+		}
+		if i < len(balances) {
+			validators[i].Balance = math.Gwei(balances[i])
+		}
+	}
+	return validators, nil
+}
+
+// toBlock converts a beacon block to its indexed representation.
+func (s *Service[
+	BeaconBlockT, _, _, _, _, _, _, _,
+]) toBlock(blk BeaconBlockT) *BlockRecord {
+	body := blk.GetBody()
+	payload := body.GetExecutionPayload()
+	b := &BlockRecord{
+		Slot:             blk.GetSlot(),
+		Root:             blk.HashTreeRoot(),
+		ParentRoot:       blk.GetParentBlockRoot(),
+		StateRoot:        blk.GetStateRoot(),
+		ProposerIndex:    blk.GetProposerIndex(),
+		ExecutionNumber:  payload.GetNumber(),
+		ExecutionHash:    payload.GetBlockHash(),
+		FeeRecipient:     payload.GetFeeRecipient(),
+		Timestamp:        payload.GetTimestamp(),
+		GasUsed:          payload.GetGasUsed(),
+		TransactionCount: len(payload.GetTransactions()),
+	}
+	for _, d := range body.GetDeposits() {
+		b.Deposits = append(b.Deposits, &DepositRecord{
+			Index:                 d.GetIndex(),
+			Pubkey:                d.GetPubkey(),
+			WithdrawalCredentials: common.Bytes32(d.GetWithdrawalCredentials()),
+			Amount:                d.GetAmount(),
+		})
+	}
+	for _, w := range payload.GetWithdrawals() {
+		b.Withdrawals = append(b.Withdrawals, &WithdrawalRecord{
+			Index:          w.GetIndex(),
+			ValidatorIndex: w.GetValidatorIndex(),
+			Address:        w.GetAddress(),
+			Amount:         w.GetAmount(),
+		})
+	}
+	return b
+}
@@ -0,0 +1,245 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package indexer_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/async/dispatcher"
+	"github.com/berachain/beacon-kit/config/spec"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/node-api/indexer"
+	"github.com/berachain/beacon-kit/primitives/async"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/stretchr/testify/require"
+)
+
+var errUnavailable = errors.New("database unavailable")
+
+// fakeSink records the indexed records, failing the first failures
+// blocks it is given.
+type fakeSink struct {
+	mu         sync.Mutex
+	failures   int
+	blocks     []*indexer.BlockRecord
+	validators map[math.Slot][]*indexer.ValidatorRecord
+}
+
+func (s *fakeSink) Init(context.Context) error { return nil }
+
+func (s *fakeSink) IndexBlock(_ context.Context, b *indexer.BlockRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.failures > 0 {
+		s.failures--
+		return errUnavailable
+	}
+	s.blocks = append(s.blocks, b)
+	return nil
+}
+
+func (s *fakeSink) IndexValidators(
+	_ context.Context, slot math.Slot, vals []*indexer.ValidatorRecord,
+) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.validators[slot] = vals
+	return nil
+}
+
+func (s *fakeSink) Close() error { return nil }
+
+func (s *fakeSink) indexed() ([]*indexer.BlockRecord, int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*indexer.BlockRecord(nil), s.blocks...), len(s.validators)
+}
+
+// fakeState is a beacon state holding a single validator.
+type fakeState struct{}
+
+func (fakeState) GetValidators() (ctypes.Validators, error) {
+	return ctypes.Validators{{
+		Pubkey:           [48]byte{7},
+		EffectiveBalance: 32e9,
+		ExitEpoch:        math.Epoch(^uint64(0)),
+	}}, nil
+}
+
+func (fakeState) GetBalances() ([]uint64, error) {
+	return []uint64{33e9}, nil
+}
+
+// fakeStates serves a fakeState at every slot.
+type fakeStates struct{}
+
+func (fakeStates) StateFromSlotForProof(
+	slot math.Slot,
+) (fakeState, math.Slot, error) {
+	return fakeState{}, slot, nil
+}
+
+// startService starts an indexer service writing to sink and returns the
+// dispatcher the finalized blocks are published on.
+func startService(
+	t *testing.T, sink *fakeSink,
+) *dispatcher.Dispatcher {
+	t.Helper()
+	d, err := dispatcher.New(
+		noop.NewLogger[any](),
+		dispatcher.WithEvent[async.Event[*ctypes.BeaconBlock]](
+			async.BeaconBlockFinalized,
+		),
+	)
+	require.NoError(t, err)
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+
+	cfg := indexer.DefaultConfig()
+	cfg.Enabled = true
+	cfg.RetryInterval = 10 * time.Millisecond
+	svc := indexer.NewService[
+		*ctypes.BeaconBlock,
+		*ctypes.BeaconBlockBody,
+		fakeState,
+		*ctypes.Deposit,
+		*ctypes.ExecutionPayload,
+		*engineprimitives.Withdrawal,
+		engineprimitives.Withdrawals,
+		ctypes.WithdrawalCredentials,
+	](cfg, noop.NewLogger[any](), cs, d, sink, fakeStates{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	require.NoError(t, d.Start(ctx))
+	require.NoError(t, svc.Start(ctx))
+	return d
+}
+
+// newBlock returns a block at the given slot carrying a deposit and a
+// withdrawal.
+func newBlock(t *testing.T, slot math.Slot) *ctypes.BeaconBlock {
+	t.Helper()
+	blk, err := (&ctypes.BeaconBlock{}).NewWithVersion(
+		slot, 3, common.Root{1}, version.Deneb,
+	)
+	require.NoError(t, err)
+	blk.Body.Deposits = []*ctypes.Deposit{{
+		Pubkey: [48]byte{2}, Amount: 32e9, Index: 4,
+	}}
+	blk.Body.ExecutionPayload = &ctypes.ExecutionPayload{
+		Number:       math.U64(slot) + 100,
+		Transactions: engineprimitives.Transactions{{1}, {2}},
+		Withdrawals: []*engineprimitives.Withdrawal{{
+			Index: 5, Validator: 6, Address: common.ExecutionAddress{8},
+			Amount: 1e9,
+		}},
+	}
+	return blk
+}
+
+func publish(t *testing.T, d *dispatcher.Dispatcher, blk *ctypes.BeaconBlock) {
+	t.Helper()
+	require.NoError(t, d.Publish(async.NewEvent(
+		context.Background(), async.BeaconBlockFinalized, blk,
+	)))
+}
+
+func TestServiceIndexesFinalizedBlocks(t *testing.T) {
+	sink := &fakeSink{validators: map[math.Slot][]*indexer.ValidatorRecord{}}
+	d := startService(t, sink)
+
+	blk := newBlock(t, 5)
+	publish(t, d, blk)
+	publish(t, d, newBlock(t, 6))
+	publish(t, d, newBlock(t, 64))
+	require.Eventually(t, func() bool {
+		blocks, _ := sink.indexed()
+		return len(blocks) == 3
+	}, time.Second, 10*time.Millisecond)
+
+	blocks, _ := sink.indexed()
+	b := blocks[0]
+	require.Equal(t, math.Slot(5), b.Slot)
+	require.Equal(t, blk.HashTreeRoot(), b.Root)
+	require.Equal(t, math.ValidatorIndex(3), b.ProposerIndex)
+	require.Equal(t, math.U64(105), b.ExecutionNumber)
+	require.Equal(t, 2, b.TransactionCount)
+	require.Len(t, b.Deposits, 1)
+	require.Equal(t, math.U64(4), b.Deposits[0].Index)
+	require.Equal(t, math.Gwei(32e9), b.Deposits[0].Amount)
+	require.Len(t, b.Withdrawals, 1)
+	require.Equal(t, math.ValidatorIndex(6), b.Withdrawals[0].ValidatorIndex)
+	require.Equal(t, common.ExecutionAddress{8}, b.Withdrawals[0].Address)
+
+	// The validators are snapshotted with the first block and at every
+	// epoch boundary, from the state of the parent block.
+	sink.mu.Lock()
+	defer sink.mu.Unlock()
+	require.Len(t, sink.validators, 2)
+	require.Contains(t, sink.validators, math.Slot(4))
+	vals := sink.validators[math.Slot(63)]
+	require.Len(t, vals, 1)
+	require.Equal(t, math.Gwei(33e9), vals[0].Balance)
+	require.Equal(t, math.Gwei(32e9), vals[0].EffectiveBalance)
+}
+
+func TestServiceRetriesFailedBlocks(t *testing.T) {
+	sink := &fakeSink{
+		failures:   3,
+		validators: map[math.Slot][]*indexer.ValidatorRecord{},
+	}
+	d := startService(t, sink)
+
+	publish(t, d, newBlock(t, 1))
+	publish(t, d, newBlock(t, 2))
+	require.Eventually(t, func() bool {
+		blocks, _ := sink.indexed()
+		return len(blocks) == 2
+	}, time.Second, 10*time.Millisecond)
+
+	// The buffered blocks are written in order once the sink recovers.
+	blocks, _ := sink.indexed()
+	require.Equal(t, math.Slot(1), blocks[0].Slot)
+	require.Equal(t, math.Slot(2), blocks[1].Slot)
+}
+
+func TestServiceDisabled(t *testing.T) {
+	svc := indexer.NewService[
+		*ctypes.BeaconBlock,
+		*ctypes.BeaconBlockBody,
+		fakeState,
+		*ctypes.Deposit,
+		*ctypes.ExecutionPayload,
+		*engineprimitives.Withdrawal,
+		engineprimitives.Withdrawals,
+		ctypes.WithdrawalCredentials,
+	](indexer.DefaultConfig(), noop.NewLogger[any](), nil, nil, nil, nil)
+	require.NoError(t, svc.Start(context.Background()))
+}
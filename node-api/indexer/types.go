@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package indexer
+
+import (
+	"context"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// Sink receives the indexed records. Implementations must be idempotent, as
+// blocks are written again after a failure or a restart.
+type Sink interface {
+	// Init prepares the sink, e.g. by creating the schema.
+	Init(ctx context.Context) error
+	// IndexBlock stores a block along with its deposits and withdrawals,
+	// replacing any different block previously stored at its slot or after.
+	IndexBlock(ctx context.Context, blk *BlockRecord) error
+	// IndexValidators stores the validator set as of the given slot.
+	IndexValidators(
+		ctx context.Context, slot math.Slot, validators []*ValidatorRecord,
+	) error
+	// Close releases the resources held by the sink.
+	Close() error
+}
+
+// StateSource retrieves the committed beacon state at a given slot.
+type StateSource[BeaconStateT any] interface {
+	StateFromSlotForProof(slot math.Slot) (BeaconStateT, math.Slot, error)
+}
+
+// BeaconBlock is an interface for beacon blocks.
+type BeaconBlock[BeaconBlockBodyT any] interface {
+	GetSlot() math.Slot
+	GetProposerIndex() math.ValidatorIndex
+	GetParentBlockRoot() common.Root
+	GetStateRoot() common.Root
+	HashTreeRoot() common.Root
+	GetBody() BeaconBlockBodyT
+}
+
+// BeaconBlockBody is an interface for beacon block bodies.
+type BeaconBlockBody[DepositT, ExecutionPayloadT any] interface {
+	GetDeposits() []DepositT
+	GetExecutionPayload() ExecutionPayloadT
+}
+
+// Deposit is an interface for deposits.
+type Deposit[WithdrawalCredentialsT ~[32]byte] interface {
+	GetIndex() math.U64
+	GetPubkey() crypto.BLSPubkey
+	GetWithdrawalCredentials() WithdrawalCredentialsT
+	GetAmount() math.Gwei
+}
+
+// ExecutionPayload is an interface for execution payloads.
+type ExecutionPayload[WithdrawalsT any] interface {
+	GetNumber() math.U64
+	GetBlockHash() common.ExecutionHash
+	GetFeeRecipient() common.ExecutionAddress
+	GetTimestamp() math.U64
+	GetGasUsed() math.U64
+	GetTransactions() engineprimitives.Transactions
+	GetWithdrawals() WithdrawalsT
+}
+
+// Withdrawal is an interface for withdrawals.
+type Withdrawal interface {
+	GetIndex() math.U64
+	GetValidatorIndex() math.ValidatorIndex
+	GetAddress() common.ExecutionAddress
+	GetAmount() math.Gwei
+}
+
+// BeaconState is an interface for the beacon state.
+type BeaconState interface {
+	GetValidators() (ctypes.Validators, error)
+	GetBalances() ([]uint64, error)
+}
@@ -21,7 +21,8 @@
 package server
 
 const (
-	defaultAddress = "127.0.0.1:3500"
+	defaultAddress   = "127.0.0.1:3500"
+	defaultCacheSize = 64 << 20
 )
 
 // Config is the configuration for the node API server.
@@ -32,13 +33,17 @@ type Config struct {
 	Address string `mapstructure:"address"`
 	// Logging is the flag to enable API logging.
 	Logging bool `mapstructure:"logging"`
+	// CacheSize is the number of bytes of responses the node API keeps
+	// cached for its expensive endpoints. Zero disables the cache.
+	CacheSize uint64 `mapstructure:"cache-size"`
 }
 
 // DefaultConfig returns the default configuration for the node API server.
 func DefaultConfig() Config {
 	return Config{
-		Enabled: false,
-		Address: defaultAddress,
-		Logging: false,
+		Enabled:   false,
+		Address:   defaultAddress,
+		Logging:   false,
+		CacheSize: defaultCacheSize,
 	}
 }
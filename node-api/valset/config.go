@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package valset
+
+import "time"
+
+const (
+	// DefaultRetryInterval is the default interval between two delivery
+	// attempts to an unavailable webhook.
+	DefaultRetryInterval = 5 * time.Second
+	// DefaultRetention is the default number of delivered messages kept for
+	// replay.
+	DefaultRetention = 8192
+)
+
+// Config is the configuration for the validator set export.
+type Config struct {
+	// Enabled enables the validator set export.
+	Enabled bool `mapstructure:"enabled"`
+	// Webhooks are the URLs the validator set updates are posted to.
+	Webhooks []string `mapstructure:"webhooks"`
+	// RetryInterval is the interval between two delivery attempts to an
+	// unavailable webhook.
+	RetryInterval time.Duration `mapstructure:"retry-interval"`
+	// Retention is the number of messages kept for replay once delivered to
+	// every webhook.
+	Retention uint64 `mapstructure:"retention"`
+	// ReplayFrom is the sequence number to redeliver the messages from on
+	// startup, to every webhook. Replay is disabled if zero.
+	ReplayFrom uint64 `mapstructure:"replay-from"`
+}
+
+// DefaultConfig returns the default configuration for the validator set
+// export.
+func DefaultConfig() Config {
+	return Config{
+		Enabled:       false,
+		RetryInterval: DefaultRetryInterval,
+		Retention:     DefaultRetention,
+	}
+}
@@ -0,0 +1,291 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package valset
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	asynctypes "github.com/berachain/beacon-kit/async/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/primitives/async"
+)
+
+const (
+	// deliveryTimeout bounds the time to deliver a single message.
+	deliveryTimeout = 10 * time.Second
+	// deliveryBatch is the number of messages read from the outbox at once.
+	deliveryBatch = 64
+)
+
+var (
+	// ErrMissingWebhooks is returned when the export is enabled without a
+	// webhook to deliver the updates to.
+	ErrMissingWebhooks = errors.New(
+		"validator set export enabled without webhooks",
+	)
+	// ErrUnexpectedStatus is returned when a webhook responds with a non
+	// 2xx status code.
+	ErrUnexpectedStatus = errors.New("unexpected webhook response status")
+)
+
+// Exporter pushes the validator set updates to the configured webhooks.
+// Updates are stored in an outbox before delivery and a webhook's cursor
+// only advances once it acknowledges a message, so that every message is
+// delivered at least once, in order, across restarts.
+type Exporter struct {
+	config     Config
+	logger     log.Logger
+	dispatcher asynctypes.EventDispatcher
+	outbox     *Outbox
+	client     *http.Client
+	// subValSetUpdates is the channel holding ValidatorSetUpdated events.
+	subValSetUpdates chan async.Event[async.ValidatorSetUpdate]
+	// notify wakes up the delivery to each webhook, by URL.
+	notify map[string]chan struct{}
+	// wg tracks the loops using the outbox, which is closed once they
+	// return.
+	wg sync.WaitGroup
+}
+
+// NewExporter creates a new validator set exporter.
+func NewExporter(
+	config Config,
+	logger log.Logger,
+	dispatcher asynctypes.EventDispatcher,
+	outbox *Outbox,
+) (*Exporter, error) {
+	if config.Enabled && len(config.Webhooks) == 0 {
+		return nil, ErrMissingWebhooks
+	}
+	notify := make(map[string]chan struct{}, len(config.Webhooks))
+	for _, webhook := range config.Webhooks {
+		notify[webhook] = make(chan struct{}, 1)
+	}
+	return &Exporter{
+		config:     config,
+		logger:     logger,
+		dispatcher: dispatcher,
+		outbox:     outbox,
+		client:     &http.Client{Timeout: deliveryTimeout},
+		subValSetUpdates: make(
+			chan async.Event[async.ValidatorSetUpdate],
+		),
+		notify: notify,
+	}, nil
+}
+
+// Name returns the name of the exporter.
+func (e *Exporter) Name() string {
+	return "validator-set-export"
+}
+
+// Start subscribes the exporter to ValidatorSetUpdated events and starts
+// delivering the updates to every webhook.
+func (e *Exporter) Start(ctx context.Context) error {
+	if !e.config.Enabled {
+		return nil
+	}
+
+	if e.config.ReplayFrom > 0 {
+		for webhook := range e.notify {
+			if err := e.outbox.SetCursor(
+				webhook, e.config.ReplayFrom-1,
+			); err != nil {
+				return err
+			}
+		}
+		e.logger.Info(
+			"replaying validator set updates", "from", e.config.ReplayFrom,
+		)
+	}
+
+	if err := e.dispatcher.Subscribe(
+		async.ValidatorSetUpdated, e.subValSetUpdates,
+	); err != nil {
+		e.logger.Error("failed to subscribe to validator set updates",
+			"error", err,
+		)
+		return err
+	}
+
+	e.wg.Add(1 + len(e.notify))
+	go e.eventLoop(ctx)
+	for webhook, notify := range e.notify {
+		go e.deliveryLoop(ctx, webhook, notify)
+	}
+	go func() {
+		e.wg.Wait()
+		if err := e.outbox.Close(); err != nil {
+			e.logger.Error("failed to close outbox", "error", err)
+		}
+	}()
+	return nil
+}
+
+// eventLoop stores the validator set updates in the outbox.
+func (e *Exporter) eventLoop(ctx context.Context) {
+	defer e.wg.Done()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event := <-e.subValSetUpdates:
+			e.onValidatorSetUpdate(event)
+		}
+	}
+}
+
+// onValidatorSetUpdate appends an update to the outbox, wakes up the
+// deliveries and prunes the messages delivered everywhere.
+func (e *Exporter) onValidatorSetUpdate(
+	event async.Event[async.ValidatorSetUpdate],
+) {
+	msg := NewMessage(event.Data())
+	if err := e.outbox.Append(msg); err != nil {
+		e.logger.Error(
+			"failed to store validator set update",
+			"slot", msg.Slot, "error", err,
+		)
+		return
+	}
+	for _, notify := range e.notify {
+		select {
+		case notify <- struct{}{}:
+		default:
+		}
+	}
+
+	if err := e.prune(); err != nil {
+		e.logger.Warn("failed to prune outbox", "error", err)
+	}
+}
+
+// prune deletes the messages delivered to every webhook beyond the
+// retention.
+func (e *Exporter) prune() error {
+	var delivered uint64
+	first := true
+	for webhook := range e.notify {
+		cursor, err := e.outbox.Cursor(webhook)
+		if err != nil {
+			return err
+		}
+		if first || cursor < delivered {
+			delivered, first = cursor, false
+		}
+	}
+	if delivered <= e.config.Retention {
+		return nil
+	}
+	return e.outbox.Prune(delivered - e.config.Retention + 1)
+}
+
+// deliveryLoop delivers the pending messages to a webhook whenever a new
+// one is appended, retrying periodically until it succeeds.
+func (e *Exporter) deliveryLoop(
+	ctx context.Context,
+	webhook string,
+	notify <-chan struct{},
+) {
+	defer e.wg.Done()
+	ticker := time.NewTicker(e.config.RetryInterval)
+	defer ticker.Stop()
+	for {
+		if err := e.deliverPending(ctx, webhook); err != nil {
+			e.logger.Warn(
+				"failed to deliver validator set update, will retry",
+				"webhook", webhook, "error", err,
+			)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-notify:
+		case <-ticker.C:
+		}
+	}
+}
+
+// deliverPending delivers the messages following the webhook's cursor in
+// order, advancing the cursor after each acknowledged message.
+func (e *Exporter) deliverPending(
+	ctx context.Context,
+	webhook string,
+) error {
+	for {
+		cursor, err := e.outbox.Cursor(webhook)
+		if err != nil {
+			return err
+		}
+		msgs, err := e.outbox.Messages(cursor, deliveryBatch)
+		if err != nil || len(msgs) == 0 {
+			return err
+		}
+		for _, msg := range msgs {
+			if err = e.deliver(ctx, webhook, msg); err != nil {
+				return errors.Wrapf(err, "sequence %d", msg.Sequence)
+			}
+			if err = e.outbox.SetCursor(webhook, msg.Sequence); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// deliver posts a message to a webhook.
+func (e *Exporter) deliver(
+	ctx context.Context,
+	webhook string,
+	msg *Message,
+) error {
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deliveryTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodPost, webhook, bytes.NewReader(body),
+	)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK ||
+		resp.StatusCode >= http.StatusMultipleChoices {
+		return errors.Wrap(ErrUnexpectedStatus, resp.Status)
+	}
+	return nil
+}
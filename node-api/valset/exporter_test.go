@@ -0,0 +1,207 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package valset_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	storev2 "cosmossdk.io/store/v2/db"
+	"github.com/berachain/beacon-kit/async/dispatcher"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/node-api/valset"
+	"github.com/berachain/beacon-kit/primitives/async"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/transition"
+	"github.com/stretchr/testify/require"
+)
+
+// webhook records the messages it receives, rejecting the first failures
+// deliveries.
+type webhook struct {
+	mu       sync.Mutex
+	failures int
+	received []*valset.Message
+}
+
+func (w *webhook) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.failures > 0 {
+		w.failures--
+		rw.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+	msg := new(valset.Message)
+	if err := json.NewDecoder(r.Body).Decode(msg); err != nil {
+		rw.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	w.received = append(w.received, msg)
+}
+
+// sequences returns the sequences of the received messages.
+func (w *webhook) sequences() []uint64 {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	seqs := make([]uint64, len(w.received))
+	for i, msg := range w.received {
+		seqs[i] = msg.Sequence
+	}
+	return seqs
+}
+
+// startExporter starts an exporter over db delivering to the given
+// webhooks and returns the dispatcher the updates are published on.
+func startExporter(
+	ctx context.Context,
+	t *testing.T,
+	db *storev2.MemDB,
+	cfg valset.Config,
+	urls ...string,
+) *dispatcher.Dispatcher {
+	t.Helper()
+	d, err := dispatcher.New(
+		noop.NewLogger[any](),
+		dispatcher.WithEvent[async.Event[async.ValidatorSetUpdate]](
+			async.ValidatorSetUpdated,
+		),
+	)
+	require.NoError(t, err)
+	outbox, err := valset.NewOutbox(db)
+	require.NoError(t, err)
+
+	cfg.Enabled = true
+	cfg.Webhooks = urls
+	cfg.RetryInterval = 10 * time.Millisecond
+	e, err := valset.NewExporter(cfg, noop.NewLogger[any](), d, outbox)
+	require.NoError(t, err)
+	require.NoError(t, d.Start(ctx))
+	require.NoError(t, e.Start(ctx))
+	return d
+}
+
+func publish(t *testing.T, d *dispatcher.Dispatcher, slot math.Slot) {
+	t.Helper()
+	require.NoError(t, d.Publish(async.NewEvent(
+		context.Background(), async.ValidatorSetUpdated,
+		async.ValidatorSetUpdate{
+			Slot: slot,
+			Updates: transition.ValidatorUpdates{{
+				Pubkey: [48]byte{byte(slot)}, EffectiveBalance: 32e9,
+			}},
+		},
+	)))
+}
+
+func TestExporterDeliversInOrder(t *testing.T) {
+	ok, flaky := &webhook{}, &webhook{failures: 3}
+	okSrv, flakySrv := httptest.NewServer(ok), httptest.NewServer(flaky)
+	t.Cleanup(okSrv.Close)
+	t.Cleanup(flakySrv.Close)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	d := startExporter(
+		ctx, t, storev2.NewMemDB(), valset.DefaultConfig(),
+		okSrv.URL, flakySrv.URL,
+	)
+	publish(t, d, 32)
+	publish(t, d, 64)
+
+	// Both webhooks receive every update in order, the unavailable one once
+	// it recovers.
+	for _, w := range []*webhook{ok, flaky} {
+		require.Eventually(t, func() bool {
+			return len(w.sequences()) == 2
+		}, time.Second, 10*time.Millisecond)
+		require.Equal(t, []uint64{1, 2}, w.sequences())
+	}
+	msg := ok.received[1]
+	require.Equal(t, uint64(64), msg.Slot)
+	require.Len(t, msg.Updates, 1)
+	require.Equal(t, uint64(32e9), msg.Updates[0].EffectiveBalance)
+}
+
+func TestExporterResumesAndReplays(t *testing.T) {
+	w := &webhook{}
+	srv := httptest.NewServer(w)
+	t.Cleanup(srv.Close)
+	db := storev2.NewMemDB()
+
+	// Deliver two updates, then stop the exporter.
+	ctx, cancel := context.WithCancel(context.Background())
+	d := startExporter(ctx, t, db, valset.DefaultConfig(), srv.URL)
+	publish(t, d, 32)
+	publish(t, d, 64)
+	require.Eventually(t, func() bool {
+		return len(w.sequences()) == 2
+	}, time.Second, 10*time.Millisecond)
+	cancel()
+
+	// A restarted exporter continues the sequence without redelivering.
+	ctx, cancel = context.WithCancel(context.Background())
+	d = startExporter(ctx, t, db, valset.DefaultConfig(), srv.URL)
+	publish(t, d, 96)
+	require.Eventually(t, func() bool {
+		return len(w.sequences()) == 3
+	}, time.Second, 10*time.Millisecond)
+	require.Equal(t, []uint64{1, 2, 3}, w.sequences())
+	cancel()
+
+	// Replaying redelivers the updates from the given sequence.
+	ctx, cancel = context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	cfg := valset.DefaultConfig()
+	cfg.ReplayFrom = 2
+	startExporter(ctx, t, db, cfg, srv.URL)
+	require.Eventually(t, func() bool {
+		return len(w.sequences()) == 5
+	}, time.Second, 10*time.Millisecond)
+	require.Equal(t, []uint64{1, 2, 3, 2, 3}, w.sequences())
+}
+
+func TestOutboxPrune(t *testing.T) {
+	outbox, err := valset.NewOutbox(storev2.NewMemDB())
+	require.NoError(t, err)
+	for range 5 {
+		require.NoError(t, outbox.Append(&valset.Message{}))
+	}
+	require.NoError(t, outbox.Prune(4))
+
+	msgs, err := outbox.Messages(0, 10)
+	require.NoError(t, err)
+	require.Len(t, msgs, 2)
+	require.Equal(t, uint64(4), msgs[0].Sequence)
+	require.Equal(t, uint64(5), msgs[1].Sequence)
+}
+
+func TestExporterRequiresWebhooks(t *testing.T) {
+	cfg := valset.DefaultConfig()
+	cfg.Enabled = true
+	_, err := valset.NewExporter(cfg, noop.NewLogger[any](), nil, nil)
+	require.ErrorIs(t, err, valset.ErrMissingWebhooks)
+}
@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package valset
+
+import (
+	"github.com/berachain/beacon-kit/primitives/async"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+)
+
+// Message is a validator set update as posted to the webhooks.
+type Message struct {
+	// Sequence is the position of the message in the export, starting at 1.
+	// Messages are delivered at least once, in order, and consumers
+	// deduplicate them by sequence.
+	Sequence uint64 `json:"sequence,string"`
+	// Slot is the slot of the block producing the updates, zero for the
+	// genesis.
+	Slot uint64 `json:"slot,string"`
+	// Updates are the validator updates, in canonical order. A zero
+	// effective balance removes the validator from the set.
+	Updates []*Update `json:"updates"`
+}
+
+// Update is the new voting power of a validator.
+type Update struct {
+	Pubkey           crypto.BLSPubkey `json:"pubkey"`
+	EffectiveBalance uint64           `json:"effective_balance,string"`
+}
+
+// NewMessage creates the message of a validator set update, to be
+// sequenced by the outbox.
+func NewMessage(update async.ValidatorSetUpdate) *Message {
+	msg := &Message{
+		Slot:    update.Slot.Unwrap(),
+		Updates: make([]*Update, len(update.Updates)),
+	}
+	for i, u := range update.Updates {
+		msg.Updates[i] = &Update{
+			Pubkey:           u.Pubkey,
+			EffectiveBalance: u.EffectiveBalance.Unwrap(),
+		}
+	}
+	return msg
+}
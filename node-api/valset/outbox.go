@@ -0,0 +1,171 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package valset
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"sync"
+
+	"cosmossdk.io/core/store"
+	"github.com/berachain/beacon-kit/errors"
+)
+
+const (
+	// messagePrefix prefixes the messages, keyed by big endian sequence.
+	messagePrefix byte = 'm'
+	// cursorPrefix prefixes the cursors, keyed by webhook URL.
+	cursorPrefix byte = 'c'
+	// sequenceSize is the size of an encoded sequence.
+	sequenceSize = 8
+)
+
+// Outbox durably stores the exported messages and the sequence of the last
+// message delivered to every webhook.
+type Outbox struct {
+	db store.KVStoreWithBatch
+	// mu protects next.
+	mu sync.Mutex
+	// next is the sequence of the next appended message.
+	next uint64
+}
+
+// NewOutbox creates an outbox backed by the given database, resuming the
+// sequence from its last message.
+func NewOutbox(db store.KVStoreWithBatch) (*Outbox, error) {
+	it, err := db.ReverseIterator(
+		[]byte{messagePrefix}, []byte{messagePrefix + 1},
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	next := uint64(1)
+	if it.Valid() {
+		next = decodeSequence(it.Key()) + 1
+	}
+	return &Outbox{db: db, next: next}, it.Error()
+}
+
+// Append sequences and stores a message.
+func (o *Outbox) Append(msg *Message) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	msg.Sequence = o.next
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	if err = o.db.Set(messageKey(msg.Sequence), bz); err != nil {
+		return err
+	}
+	o.next++
+	return nil
+}
+
+// Messages returns at most limit messages following the given sequence,
+// in order.
+func (o *Outbox) Messages(after uint64, limit int) ([]*Message, error) {
+	it, err := o.db.Iterator(
+		messageKey(after+1), []byte{messagePrefix + 1},
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var msgs []*Message
+	for ; it.Valid() && len(msgs) < limit; it.Next() {
+		msg := new(Message)
+		if err = json.Unmarshal(it.Value(), msg); err != nil {
+			return nil, errors.Wrapf(
+				err, "corrupted message %d", decodeSequence(it.Key()),
+			)
+		}
+		msgs = append(msgs, msg)
+	}
+	return msgs, it.Error()
+}
+
+// Cursor returns the sequence of the last message delivered to the webhook,
+// zero if none.
+func (o *Outbox) Cursor(webhook string) (uint64, error) {
+	bz, err := o.db.Get(cursorKey(webhook))
+	if err != nil || len(bz) != sequenceSize {
+		return 0, err
+	}
+	return binary.BigEndian.Uint64(bz), nil
+}
+
+// SetCursor records the sequence of the last message delivered to the
+// webhook.
+func (o *Outbox) SetCursor(webhook string, sequence uint64) error {
+	return o.db.Set(
+		cursorKey(webhook), binary.BigEndian.AppendUint64(nil, sequence),
+	)
+}
+
+// Prune deletes the messages preceding the given sequence.
+func (o *Outbox) Prune(before uint64) error {
+	it, err := o.db.Iterator(
+		[]byte{messagePrefix}, messageKey(before),
+	)
+	if err != nil {
+		return err
+	}
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, append([]byte(nil), it.Key()...))
+	}
+	if err = errors.Join(it.Error(), it.Close()); err != nil {
+		return err
+	}
+
+	batch := o.db.NewBatch()
+	defer batch.Close()
+	for _, key := range keys {
+		if err = batch.Delete(key); err != nil {
+			return err
+		}
+	}
+	return batch.Write()
+}
+
+// Close closes the database.
+func (o *Outbox) Close() error {
+	return o.db.Close()
+}
+
+// messageKey returns the key of the message with the given sequence.
+func messageKey(sequence uint64) []byte {
+	return binary.BigEndian.AppendUint64([]byte{messagePrefix}, sequence)
+}
+
+// cursorKey returns the key of the cursor of the given webhook.
+func cursorKey(webhook string) []byte {
+	return append([]byte{cursorPrefix}, webhook...)
+}
+
+// decodeSequence returns the sequence of a message key.
+func decodeSequence(key []byte) uint64 {
+	return binary.BigEndian.Uint64(key[1:])
+}
@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package withdrawalstore
+
+import (
+	"encoding/binary"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+const (
+	// addressSize is the size of an execution address.
+	addressSize = len(common.ExecutionAddress{})
+	// receiptSize is the size of an encoded receipt.
+	receiptSize = 4*8 + addressSize
+)
+
+// ErrInvalidReceipt is returned when a stored receipt cannot be decoded.
+var ErrInvalidReceipt = errors.New("invalid withdrawal receipt")
+
+// Receipt is a withdrawal processed in a finalized block.
+type Receipt struct {
+	// Index is the withdrawal index.
+	Index math.U64
+	// ValidatorIndex is the index of the withdrawn validator.
+	ValidatorIndex math.ValidatorIndex
+	// Address is the execution address the amount was sent to.
+	Address common.ExecutionAddress
+	// Amount is the withdrawn amount.
+	Amount math.Gwei
+	// Slot is the slot of the block processing the withdrawal.
+	Slot math.Slot
+}
+
+// encode returns the fixed size encoding of the receipt.
+func (r *Receipt) encode() []byte {
+	bz := make([]byte, 0, receiptSize)
+	bz = binary.BigEndian.AppendUint64(bz, r.Index.Unwrap())
+	bz = binary.BigEndian.AppendUint64(bz, r.ValidatorIndex.Unwrap())
+	bz = append(bz, r.Address[:]...)
+	bz = binary.BigEndian.AppendUint64(bz, r.Amount.Unwrap())
+	return binary.BigEndian.AppendUint64(bz, r.Slot.Unwrap())
+}
+
+// decodeReceipt decodes a receipt encoded by encode.
+func decodeReceipt(bz []byte) (*Receipt, error) {
+	if len(bz) != receiptSize {
+		return nil, errors.Wrapf(
+			ErrInvalidReceipt, "expected %d bytes, got %d",
+			receiptSize, len(bz),
+		)
+	}
+	r := &Receipt{
+		Index:          math.U64(binary.BigEndian.Uint64(bz)),
+		ValidatorIndex: math.ValidatorIndex(binary.BigEndian.Uint64(bz[8:])),
+	}
+	bz = bz[16:]
+	copy(r.Address[:], bz)
+	bz = bz[addressSize:]
+	r.Amount = math.Gwei(binary.BigEndian.Uint64(bz))
+	r.Slot = math.Slot(binary.BigEndian.Uint64(bz[8:]))
+	return r, nil
+}
@@ -0,0 +1,152 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package withdrawalstore
+
+import (
+	"context"
+
+	asynctypes "github.com/berachain/beacon-kit/async/types"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/primitives/async"
+	"github.com/berachain/beacon-kit/state-transition/core/state"
+)
+
+// Service is a Service that listens for finalized blocks and indexes the
+// withdrawals they processed into a Store.
+type Service[
+	BeaconBlockT BeaconBlock[BeaconBlockBodyT],
+	BeaconBlockBodyT BeaconBlockBody[ExecutionPayloadT],
+	ExecutionPayloadT ExecutionPayload[WithdrawalsT],
+	WithdrawalT Withdrawal,
+	WithdrawalsT ~[]WithdrawalT,
+] struct {
+	// logger is used for logging information and errors.
+	logger log.Logger
+	// dispatcher is the dispatcher for the service.
+	dispatcher asynctypes.EventDispatcher
+	// store is where the receipts are indexed. The withdrawals are not
+	// indexed if it is nil.
+	store *Store
+	// subFinalizedBlkEvents is a channel holding BeaconBlockFinalized
+	// events.
+	subFinalizedBlkEvents chan async.Event[BeaconBlockT]
+}
+
+// NewService creates a new withdrawal receipts service.
+func NewService[
+	BeaconBlockT BeaconBlock[BeaconBlockBodyT],
+	BeaconBlockBodyT BeaconBlockBody[ExecutionPayloadT],
+	ExecutionPayloadT ExecutionPayload[WithdrawalsT],
+	WithdrawalT Withdrawal,
+	WithdrawalsT ~[]WithdrawalT,
+](
+	logger log.Logger,
+	dispatcher asynctypes.EventDispatcher,
+	store *Store,
+) *Service[
+	BeaconBlockT, BeaconBlockBodyT, ExecutionPayloadT,
+	WithdrawalT, WithdrawalsT,
+] {
+	return &Service[
+		BeaconBlockT, BeaconBlockBodyT, ExecutionPayloadT,
+		WithdrawalT, WithdrawalsT,
+	]{
+		logger:                logger,
+		dispatcher:            dispatcher,
+		store:                 store,
+		subFinalizedBlkEvents: make(chan async.Event[BeaconBlockT]),
+	}
+}
+
+// Name returns the name of the service.
+func (s *Service[_, _, _, _, _]) Name() string {
+	return "withdrawal-store"
+}
+
+// Start subscribes the service to BeaconBlockFinalized events and starts
+// the main event loop to handle them accordingly.
+func (s *Service[_, _, _, _, _]) Start(ctx context.Context) error {
+	if s.store == nil {
+		s.logger.Info("withdrawal receipts are disabled, skipping indexing")
+		return nil
+	}
+
+	// subscribe a channel to the finalized block events.
+	if err := s.dispatcher.Subscribe(
+		async.BeaconBlockFinalized, s.subFinalizedBlkEvents,
+	); err != nil {
+		s.logger.Error("failed to subscribe to block events", "error", err)
+		return err
+	}
+
+	// start the event loop to listen and handle events.
+	go s.eventLoop(ctx)
+	return nil
+}
+
+// eventLoop is the main event loop for the withdrawal receipts service.
+func (s *Service[_, _, _, _, _]) eventLoop(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			if err := s.store.Close(); err != nil {
+				s.logger.Error(
+					"failed to close withdrawal store", "error", err,
+				)
+			}
+			return
+		case event := <-s.subFinalizedBlkEvents:
+			s.handleFinalizedBlock(event.Data())
+		}
+	}
+}
+
+// handleFinalizedBlock indexes the withdrawals of a finalized block. They
+// were checked against the expected withdrawals of the state by
+// processWithdrawals before the block was finalized.
+func (s *Service[BeaconBlockT, _, _, _, _]) handleFinalizedBlock(
+	blk BeaconBlockT,
+) {
+	slot := blk.GetSlot()
+	withdrawals := blk.GetBody().GetExecutionPayload().GetWithdrawals()
+	receipts := make([]*Receipt, 0, len(withdrawals))
+	for _, w := range withdrawals {
+		// The EVM inflation withdrawal does not withdraw from a validator.
+		if w.GetIndex() == state.EVMInflationWithdrawalIndex {
+			continue
+		}
+		receipts = append(receipts, &Receipt{
+			Index:          w.GetIndex(),
+			ValidatorIndex: w.GetValidatorIndex(),
+			Address:        w.GetAddress(),
+			Amount:         w.GetAmount(),
+			Slot:           slot,
+		})
+	}
+	if len(receipts) == 0 {
+		return
+	}
+	if err := s.store.Put(receipts); err != nil {
+		s.logger.Error(
+			"failed to index withdrawals", "slot", slot, "error", err,
+		)
+	}
+}
@@ -0,0 +1,172 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package withdrawalstore
+
+import (
+	"encoding/binary"
+
+	"cosmossdk.io/core/store"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+const (
+	// receiptPrefix prefixes the receipts, keyed by big endian withdrawal
+	// index.
+	receiptPrefix byte = 'r'
+	// validatorPrefix prefixes the withdrawal indexes of a validator, keyed
+	// by big endian validator index then withdrawal index.
+	validatorPrefix byte = 'v'
+	// addressPrefix prefixes the withdrawal indexes of an execution address,
+	// keyed by address then big endian withdrawal index.
+	addressPrefix byte = 'a'
+	// indexSize is the size of an encoded index.
+	indexSize = 8
+)
+
+// Store durably indexes the withdrawal receipts by validator index and by
+// execution address.
+type Store struct {
+	db store.KVStoreWithBatch
+}
+
+// NewStore creates a withdrawal receipts store backed by the given database.
+func NewStore(db store.KVStoreWithBatch) *Store {
+	return &Store{db: db}
+}
+
+// Put stores the receipts. Receipts are keyed by withdrawal index, so
+// storing a receipt again is a no-op.
+func (s *Store) Put(receipts []*Receipt) error {
+	batch := s.db.NewBatch()
+	defer batch.Close()
+	for _, r := range receipts {
+		if err := batch.Set(receiptKey(r.Index), r.encode()); err != nil {
+			return err
+		}
+		if err := batch.Set(
+			validatorKey(r.ValidatorIndex, r.Index), []byte{},
+		); err != nil {
+			return err
+		}
+		if err := batch.Set(
+			addressKey(r.Address, r.Index), []byte{},
+		); err != nil {
+			return err
+		}
+	}
+	return batch.Write()
+}
+
+// ByValidator returns up to limit receipts of the given validator, by
+// increasing withdrawal index starting at from.
+func (s *Store) ByValidator(
+	index math.ValidatorIndex,
+	from math.U64,
+	limit int,
+) ([]*Receipt, error) {
+	prefix := binary.BigEndian.AppendUint64(
+		[]byte{validatorPrefix}, index.Unwrap(),
+	)
+	return s.scan(prefix, from, limit)
+}
+
+// ByAddress returns up to limit receipts paid to the given execution
+// address, by increasing withdrawal index starting at from.
+func (s *Store) ByAddress(
+	address common.ExecutionAddress,
+	from math.U64,
+	limit int,
+) ([]*Receipt, error) {
+	prefix := append([]byte{addressPrefix}, address[:]...)
+	return s.scan(prefix, from, limit)
+}
+
+// Close closes the underlying database.
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// scan resolves the receipts whose withdrawal index follows the given
+// secondary index prefix, starting at from.
+func (s *Store) scan(
+	prefix []byte,
+	from math.U64,
+	limit int,
+) ([]*Receipt, error) {
+	start := binary.BigEndian.AppendUint64(
+		append([]byte{}, prefix...), from.Unwrap(),
+	)
+	it, err := s.db.Iterator(start, prefixEnd(prefix))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	receipts := make([]*Receipt, 0)
+	for ; it.Valid() && len(receipts) < limit; it.Next() {
+		key := it.Key()
+		bz, err := s.db.Get(receiptKey(
+			math.U64(binary.BigEndian.Uint64(key[len(key)-indexSize:])),
+		))
+		if err != nil {
+			return nil, err
+		}
+		r, err := decodeReceipt(bz)
+		if err != nil {
+			return nil, err
+		}
+		receipts = append(receipts, r)
+	}
+	return receipts, it.Error()
+}
+
+// receiptKey returns the key of the receipt of a withdrawal.
+func receiptKey(index math.U64) []byte {
+	return binary.BigEndian.AppendUint64([]byte{receiptPrefix}, index.Unwrap())
+}
+
+// validatorKey returns the key indexing a withdrawal by validator.
+func validatorKey(validator math.ValidatorIndex, index math.U64) []byte {
+	key := binary.BigEndian.AppendUint64(
+		[]byte{validatorPrefix}, validator.Unwrap(),
+	)
+	return binary.BigEndian.AppendUint64(key, index.Unwrap())
+}
+
+// addressKey returns the key indexing a withdrawal by execution address.
+func addressKey(address common.ExecutionAddress, index math.U64) []byte {
+	key := append([]byte{addressPrefix}, address[:]...)
+	return binary.BigEndian.AppendUint64(key, index.Unwrap())
+}
+
+// prefixEnd returns the first key following all the keys with the given
+// prefix.
+func prefixEnd(prefix []byte) []byte {
+	end := append([]byte{}, prefix...)
+	for i := len(end) - 1; i >= 0; i-- {
+		end[i]++
+		if end[i] != 0 {
+			return end[:i+1]
+		}
+	}
+	return nil
+}
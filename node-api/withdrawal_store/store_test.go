@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package withdrawalstore_test
+
+import (
+	"testing"
+
+	db "cosmossdk.io/store/v2/db"
+	withdrawalstore "github.com/berachain/beacon-kit/node-api/withdrawal_store"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStoreQueries(t *testing.T) {
+	var (
+		store = withdrawalstore.NewStore(db.NewMemDB())
+		alice = common.ExecutionAddress{0xa}
+		bob   = common.ExecutionAddress{0xb}
+	)
+	receipts := []*withdrawalstore.Receipt{
+		{Index: 1, ValidatorIndex: 2, Address: alice, Amount: 10, Slot: 5},
+		{Index: 2, ValidatorIndex: 3, Address: bob, Amount: 20, Slot: 5},
+		{Index: 3, ValidatorIndex: 2, Address: alice, Amount: 30, Slot: 6},
+		{Index: 4, ValidatorIndex: 2, Address: bob, Amount: 40, Slot: 7},
+	}
+	require.NoError(t, store.Put(receipts[:2]))
+	require.NoError(t, store.Put(receipts[2:]))
+	// Indexing a block again is a no-op.
+	require.NoError(t, store.Put(receipts[2:]))
+
+	got, err := store.ByValidator(2, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, []*withdrawalstore.Receipt{
+		receipts[0], receipts[2], receipts[3],
+	}, got)
+
+	got, err = store.ByAddress(alice, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, []*withdrawalstore.Receipt{
+		receipts[0], receipts[2],
+	}, got)
+
+	// Pages start at the given withdrawal index.
+	got, err = store.ByValidator(2, 2, 1)
+	require.NoError(t, err)
+	require.Equal(t, []*withdrawalstore.Receipt{receipts[2]}, got)
+
+	got, err = store.ByAddress(bob, math.U64(5), 10)
+	require.NoError(t, err)
+	require.Empty(t, got)
+
+	got, err = store.ByValidator(4, 0, 10)
+	require.NoError(t, err)
+	require.Empty(t, got)
+}
+
+func TestStoreValidatorKeysDoNotOverlap(t *testing.T) {
+	store := withdrawalstore.NewStore(db.NewMemDB())
+	receipts := []*withdrawalstore.Receipt{
+		{Index: 1, ValidatorIndex: 0xff, Amount: 1},
+		{Index: 2, ValidatorIndex: 0x100, Amount: 2},
+	}
+	require.NoError(t, store.Put(receipts))
+
+	got, err := store.ByValidator(0xff, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, receipts[:1], got)
+}
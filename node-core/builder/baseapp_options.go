@@ -27,11 +27,14 @@ import (
 	"path/filepath"
 
 	"cosmossdk.io/store"
+	"cosmossdk.io/store/snapshots"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
 	storetypes "cosmossdk.io/store/types"
 	server "github.com/berachain/beacon-kit/cli/commands/server"
 	"github.com/berachain/beacon-kit/config"
 	cometbft "github.com/berachain/beacon-kit/consensus/cometbft/service"
 	"github.com/berachain/beacon-kit/log"
+	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 	"github.com/spf13/cast"
@@ -68,6 +71,15 @@ func DefaultServiceOptions[
 		}
 	}
 
+	snapshotStore, err := loadSnapshotStore(appOpts)
+	if err != nil {
+		panic(err)
+	}
+	snapshotOpts := snapshottypes.NewSnapshotOptions(
+		cast.ToUint64(appOpts.Get(server.FlagStateSyncSnapshotInterval)),
+		cast.ToUint32(appOpts.Get(server.FlagStateSyncSnapshotKeepRecent)),
+	)
+
 	return []func(*cometbft.Service[LoggerT]){
 		cometbft.SetPruning[LoggerT](pruningOpts),
 		cometbft.SetMinRetainBlocks[LoggerT](
@@ -82,7 +94,29 @@ func DefaultServiceOptions[
 			true,
 		),
 		cometbft.SetChainID[LoggerT](chainID),
+		cometbft.SetSnapshot[LoggerT](snapshotStore, snapshotOpts),
+	}
+}
+
+// loadSnapshotStore opens the store of state sync snapshots in the data
+// directory of the node.
+func loadSnapshotStore(appOpts config.AppOptions) (*snapshots.Store, error) {
+	snapshotDir := filepath.Join(
+		cast.ToString(appOpts.Get(flags.FlagHome)), "data", "snapshots",
+	)
+	if err := os.MkdirAll(snapshotDir, 0o744); err != nil {
+		return nil, fmt.Errorf(
+			"failed to create snapshots directory: %w", err,
+		)
+	}
+
+	snapshotDB, err := dbm.NewDB(
+		"metadata", dbm.PebbleDBBackend, snapshotDir,
+	)
+	if err != nil {
+		return nil, err
 	}
+	return snapshots.NewStore(snapshotDB, snapshotDir)
 }
 
 func loadChainIDFromGenesis(appOpts config.AppOptions) (string, error) {
@@ -29,6 +29,7 @@ import (
 	cometbft "github.com/berachain/beacon-kit/consensus/cometbft/service"
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/node-core/types"
+	"github.com/berachain/beacon-kit/observability/identity"
 	cmtcfg "github.com/cometbft/cometbft/config"
 	dbm "github.com/cosmos/cosmos-db"
 )
@@ -43,6 +44,7 @@ type NodeBuilder[
 	LoggerT interface {
 		log.AdvancedLogger[LoggerT]
 		log.Configurable[LoggerT, LoggerConfigT]
+		log.Labelable
 	},
 	LoggerConfigT any,
 ] struct {
@@ -56,6 +58,7 @@ func New[
 	LoggerT interface {
 		log.AdvancedLogger[LoggerT]
 		log.Configurable[LoggerT, LoggerConfigT]
+		log.Labelable
 	},
 	LoggerConfigT any,
 ](
@@ -83,9 +86,10 @@ func (nb *NodeBuilder[NodeT, LoggerT, LoggerConfigT]) Build(
 		apiBackend interface {
 			AttachQueryBackend(*cometbft.Service[LoggerT])
 		}
-		beaconNode NodeT
-		cmtService *cometbft.Service[LoggerT]
-		config     *config.Config
+		beaconNode   NodeT
+		cmtService   *cometbft.Service[LoggerT]
+		config       *config.Config
+		nodeIdentity *identity.Identity
 	)
 
 	// build all node components using depinject
@@ -105,6 +109,7 @@ func (nb *NodeBuilder[NodeT, LoggerT, LoggerConfigT]) Build(
 		&beaconNode,
 		&cmtService,
 		&config,
+		&nodeIdentity,
 	); err != nil {
 		panic(err)
 	}
@@ -118,6 +123,7 @@ func (nb *NodeBuilder[NodeT, LoggerT, LoggerConfigT]) Build(
 	// TODO: so hood
 	//nolint:errcheck // should be safe
 	logger.WithConfig(any(config.GetLogger()).(LoggerConfigT))
+	logger.SetDefaultFields(nodeIdentity.LogFields()...)
 	apiBackend.AttachQueryBackend(cmtService)
 	return beaconNode
 }
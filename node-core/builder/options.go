@@ -31,6 +31,7 @@ type Opt[
 	LoggerT interface {
 		log.AdvancedLogger[LoggerT]
 		log.Configurable[LoggerT, LoggerConfigT]
+		log.Labelable
 	},
 	LoggerConfigT any,
 ] func(*NodeBuilder[NodeT, LoggerT, LoggerConfigT])
@@ -41,6 +42,7 @@ func WithComponents[
 	LoggerT interface {
 		log.AdvancedLogger[LoggerT]
 		log.Configurable[LoggerT, LoggerConfigT]
+		log.Labelable
 	},
 	LoggerConfigT any,
 ](components []any) Opt[NodeT, LoggerT, LoggerConfigT] {
@@ -29,27 +29,55 @@ import (
 	"github.com/berachain/beacon-kit/node-api/handlers"
 	"github.com/berachain/beacon-kit/node-api/server"
 	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/state-transition/core"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+type NodeAPIEngineInput[
+	BeaconBlockHeaderT any,
+	BeaconStateT any,
+	NodeT any,
+] struct {
+	depinject.In
+
+	Backend NodeAPIBackend[
+		BeaconBlockHeaderT, BeaconStateT, *Fork, NodeT, *Validator,
+	]
+	Config *config.Config
+}
+
 // TODO: we could make engine type configurable
-func ProvideNodeAPIEngine() *echo.Engine {
-	return echo.NewDefaultEngine()
+func ProvideNodeAPIEngine[
+	BeaconBlockHeaderT any,
+	BeaconStateT any,
+	NodeT any,
+](
+	in NodeAPIEngineInput[BeaconBlockHeaderT, BeaconStateT, NodeT],
+) *echo.Engine {
+	engine := echo.NewDefaultEngine()
+	if size := in.Config.NodeAPI.CacheSize; size > 0 {
+		engine.UseResponseCache(echo.NewResponseCache(size, in.Backend))
+	}
+	return engine
 }
 
 type NodeAPIBackendInput[
 	BeaconBlockT any,
+	BeaconBlockHeaderT any,
 	BeaconStateT any,
-	DepositT any,
 	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
 	StorageBackendT any,
+	WithdrawalT any,
 ] struct {
 	depinject.In
 
 	ChainSpec      common.ChainSpec
-	StateProcessor StateProcessor[
-		BeaconBlockT, BeaconStateT, *Context,
-		DepositT, ExecutionPayloadHeaderT,
+	Proposer       BlockProposer[BeaconBlockT]
+	StateProcessor ReadOnlyStateProcessor[
+		BeaconStateT, core.ReadOnlyBeaconState[
+			BeaconBlockHeaderT, *Eth1Data, ExecutionPayloadHeaderT, *Fork,
+			*Validator, Validators, WithdrawalT,
+		],
 	]
 	StorageBackend StorageBackendT
 }
@@ -73,6 +101,7 @@ func ProvideNodeAPIBackend[
 	KVStoreT any,
 	NodeT interface {
 		CreateQueryContext(height int64, prove bool) (sdk.Context, error)
+		LastBlockHeight() int64
 	},
 	StorageBackendT StorageBackend[
 		AvailabilityStoreT, BeaconStateT, BeaconBlockStoreT, DepositStoreT,
@@ -80,8 +109,8 @@ func ProvideNodeAPIBackend[
 	WithdrawalT Withdrawal[WithdrawalT],
 ](
 	in NodeAPIBackendInput[
-		BeaconBlockT, BeaconStateT, DepositT, ExecutionPayloadHeaderT,
-		StorageBackendT,
+		BeaconBlockT, BeaconBlockHeaderT, BeaconStateT, ExecutionPayloadHeaderT,
+		StorageBackendT, WithdrawalT,
 	],
 ) *backend.Backend[
 	AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
@@ -116,6 +145,7 @@ func ProvideNodeAPIBackend[
 		in.StorageBackend,
 		in.ChainSpec,
 		in.StateProcessor,
+		in.Proposer,
 	)
 }
 
@@ -22,17 +22,29 @@ package components
 
 import (
 	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/beacon/clock"
+	"github.com/berachain/beacon-kit/config/features"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/consensus/cometbft/service/halt"
+	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/node-api/handlers"
 	beaconapi "github.com/berachain/beacon-kit/node-api/handlers/beacon"
 	builderapi "github.com/berachain/beacon-kit/node-api/handlers/builder"
 	configapi "github.com/berachain/beacon-kit/node-api/handlers/config"
 	debugapi "github.com/berachain/beacon-kit/node-api/handlers/debug"
+	depositsapi "github.com/berachain/beacon-kit/node-api/handlers/deposits"
 	eventsapi "github.com/berachain/beacon-kit/node-api/handlers/events"
 	nodeapi "github.com/berachain/beacon-kit/node-api/handlers/node"
 	proofapi "github.com/berachain/beacon-kit/node-api/handlers/proof"
+	validatorapi "github.com/berachain/beacon-kit/node-api/handlers/validator"
+	withdrawalsapi "github.com/berachain/beacon-kit/node-api/handlers/withdrawals"
+	withdrawalstore "github.com/berachain/beacon-kit/node-api/withdrawal_store"
+	"github.com/berachain/beacon-kit/observability/identity"
+	"github.com/berachain/beacon-kit/primitives/common"
 )
 
 type NodeAPIHandlersInput[
+	BeaconBlockT validatorapi.BeaconBlock[BlindedBeaconBlockT],
 	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
 	BeaconStateT BeaconState[
 		BeaconStateT, BeaconBlockHeaderT, BeaconStateMarshallableT,
@@ -43,6 +55,10 @@ type NodeAPIHandlersInput[
 		BeaconStateMarshallableT, BeaconBlockHeaderT, *Eth1Data,
 		ExecutionPayloadHeaderT, *Fork, *Validator,
 	],
+	BlindedBeaconBlockT validatorapi.BlindedBeaconBlock[
+		BeaconBlockT, ExecutionPayloadT,
+	],
+	ExecutionPayloadT any,
 	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
 	KVStoreT any,
 	NodeAPIContextT NodeAPIContext,
@@ -52,18 +68,24 @@ type NodeAPIHandlersInput[
 	BeaconAPIHandler *beaconapi.Handler[
 		BeaconBlockHeaderT, NodeAPIContextT, *Fork, *Validator,
 	]
-	BuilderAPIHandler *builderapi.Handler[NodeAPIContextT]
-	ConfigAPIHandler  *configapi.Handler[NodeAPIContextT]
-	DebugAPIHandler   *debugapi.Handler[NodeAPIContextT]
-	EventsAPIHandler  *eventsapi.Handler[NodeAPIContextT]
-	NodeAPIHandler    *nodeapi.Handler[NodeAPIContextT]
-	ProofAPIHandler   *proofapi.Handler[
+	BuilderAPIHandler  *builderapi.Handler[NodeAPIContextT]
+	ConfigAPIHandler   *configapi.Handler[NodeAPIContextT]
+	DebugAPIHandler    *debugapi.Handler[NodeAPIContextT]
+	DepositsAPIHandler *depositsapi.Handler[NodeAPIContextT, *Validator]
+	EventsAPIHandler   *eventsapi.Handler[NodeAPIContextT]
+	NodeAPIHandler     *nodeapi.Handler[NodeAPIContextT]
+	ProofAPIHandler    *proofapi.Handler[
 		BeaconBlockHeaderT, BeaconStateT, BeaconStateMarshallableT,
 		NodeAPIContextT, ExecutionPayloadHeaderT, *Validator,
 	]
+	ValidatorAPIHandler *validatorapi.Handler[
+		BeaconBlockT, BlindedBeaconBlockT, NodeAPIContextT, ExecutionPayloadT,
+	]
+	WithdrawalsAPIHandler *withdrawalsapi.Handler[NodeAPIContextT]
 }
 
 func ProvideNodeAPIHandlers[
+	BeaconBlockT validatorapi.BeaconBlock[BlindedBeaconBlockT],
 	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
 	BeaconStateT BeaconState[
 		BeaconStateT, BeaconBlockHeaderT, BeaconStateMarshallableT,
@@ -74,15 +96,19 @@ func ProvideNodeAPIHandlers[
 		BeaconStateMarshallableT, BeaconBlockHeaderT, *Eth1Data,
 		ExecutionPayloadHeaderT, *Fork, *Validator,
 	],
+	BlindedBeaconBlockT validatorapi.BlindedBeaconBlock[
+		BeaconBlockT, ExecutionPayloadT,
+	],
+	ExecutionPayloadT any,
 	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
 	KVStoreT any,
 	NodeAPIContextT NodeAPIContext,
 	WithdrawalT Withdrawal[WithdrawalT],
 ](
 	in NodeAPIHandlersInput[
-		BeaconBlockHeaderT, BeaconStateT,
-		BeaconStateMarshallableT, ExecutionPayloadHeaderT, KVStoreT,
-		NodeAPIContextT, WithdrawalT,
+		BeaconBlockT, BeaconBlockHeaderT, BeaconStateT,
+		BeaconStateMarshallableT, BlindedBeaconBlockT, ExecutionPayloadT,
+		ExecutionPayloadHeaderT, KVStoreT, NodeAPIContextT, WithdrawalT,
 	],
 ) []handlers.Handlers[NodeAPIContextT] {
 	return []handlers.Handlers[NodeAPIContextT]{
@@ -90,9 +116,12 @@ func ProvideNodeAPIHandlers[
 		in.BuilderAPIHandler,
 		in.ConfigAPIHandler,
 		in.DebugAPIHandler,
+		in.DepositsAPIHandler,
 		in.EventsAPIHandler,
 		in.NodeAPIHandler,
 		in.ProofAPIHandler,
+		in.ValidatorAPIHandler,
+		in.WithdrawalsAPIHandler,
 	}
 }
 
@@ -101,13 +130,16 @@ func ProvideNodeAPIBeaconHandler[
 	BeaconStateT any,
 	NodeT any,
 	NodeAPIContextT NodeAPIContext,
-](b NodeAPIBackend[
-	BeaconBlockHeaderT,
-	BeaconStateT,
-	*Fork,
-	NodeT,
-	*Validator,
-]) *beaconapi.Handler[
+](
+	b NodeAPIBackend[
+		BeaconBlockHeaderT,
+		BeaconStateT,
+		*Fork,
+		NodeT,
+		*Validator,
+	],
+	slotClock *clock.SlotClock,
+) *beaconapi.Handler[
 	BeaconBlockHeaderT, NodeAPIContextT, *Fork, *Validator,
 ] {
 	return beaconapi.NewHandler[
@@ -115,7 +147,7 @@ func ProvideNodeAPIBeaconHandler[
 		NodeAPIContextT,
 		*Fork,
 		*Validator,
-	](b)
+	](b, slotClock)
 }
 
 func ProvideNodeAPIBuilderHandler[
@@ -126,8 +158,8 @@ func ProvideNodeAPIBuilderHandler[
 
 func ProvideNodeAPIConfigHandler[
 	NodeAPIContextT NodeAPIContext,
-]() *configapi.Handler[NodeAPIContextT] {
-	return configapi.NewHandler[NodeAPIContextT]()
+](chainSpec common.ChainSpec) *configapi.Handler[NodeAPIContextT] {
+	return configapi.NewHandler[NodeAPIContextT](chainSpec)
 }
 
 func ProvideNodeAPIDebugHandler[
@@ -136,16 +168,61 @@ func ProvideNodeAPIDebugHandler[
 	return debugapi.NewHandler[NodeAPIContextT]()
 }
 
+// EventStreamInput is the input for the event stream provider.
+func ProvideNodeAPIDepositsHandler[
+	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
+	BeaconStateT any,
+	NodeT any,
+	NodeAPIContextT NodeAPIContext,
+](
+	b NodeAPIBackend[
+		BeaconBlockHeaderT,
+		BeaconStateT,
+		*Fork,
+		NodeT,
+		*Validator,
+	],
+	store DepositStore[*types.Deposit],
+) *depositsapi.Handler[NodeAPIContextT, *Validator] {
+	return depositsapi.NewHandler[NodeAPIContextT, *Validator](b, store)
+}
+
+type EventStreamInput[LoggerT any] struct {
+	depinject.In
+	Dispatcher Dispatcher
+	Logger     LoggerT
+}
+
+// ProvideEventStream provides the stream of node events served by the
+// events endpoint.
+func ProvideEventStream[LoggerT log.AdvancedLogger[LoggerT]](
+	in EventStreamInput[LoggerT],
+) (*eventsapi.Stream, error) {
+	return eventsapi.NewStream(
+		in.Logger.With("service", "event-stream"), in.Dispatcher,
+	)
+}
+
 func ProvideNodeAPIEventsHandler[
 	NodeAPIContextT NodeAPIContext,
-]() *eventsapi.Handler[NodeAPIContextT] {
-	return eventsapi.NewHandler[NodeAPIContextT]()
+](stream *eventsapi.Stream) *eventsapi.Handler[NodeAPIContextT] {
+	return eventsapi.NewHandler[NodeAPIContextT](stream)
 }
 
 func ProvideNodeAPINodeHandler[
 	NodeAPIContextT NodeAPIContext,
-]() *nodeapi.Handler[NodeAPIContextT] {
-	return nodeapi.NewHandler[NodeAPIContextT]()
+	BeaconBlockT BeaconBlock[BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT],
+	BeaconBlockBodyT any,
+	BeaconBlockHeaderT any,
+](
+	featureFlags *features.Set,
+	slotClock *clock.Service[BeaconBlockT],
+	nodeIdentity *identity.Identity,
+	haltController *halt.Controller,
+) *nodeapi.Handler[NodeAPIContextT] {
+	return nodeapi.NewHandler[NodeAPIContextT](
+		featureFlags, slotClock, nodeIdentity, haltController,
+	)
 }
 
 func ProvideNodeAPIProofHandler[
@@ -183,3 +260,32 @@ func ProvideNodeAPIProofHandler[
 		*Validator,
 	](b)
 }
+
+func ProvideNodeAPIValidatorHandler[
+	BeaconBlockT validatorapi.BeaconBlock[BlindedBeaconBlockT],
+	BlindedBeaconBlockT validatorapi.BlindedBeaconBlock[
+		BeaconBlockT, ExecutionPayloadT,
+	],
+	ExecutionPayloadT any,
+	NodeAPIContextT NodeAPIContext,
+](
+	b NodeAPIValidatorBackend[BeaconBlockT],
+	relay PayloadRelay[ExecutionPayloadT],
+) *validatorapi.Handler[
+	BeaconBlockT, BlindedBeaconBlockT, NodeAPIContextT, ExecutionPayloadT,
+] {
+	return validatorapi.NewHandler[
+		BeaconBlockT, BlindedBeaconBlockT, NodeAPIContextT, ExecutionPayloadT,
+	](b, relay)
+}
+
+func ProvideNodeAPIWithdrawalsHandler[
+	NodeAPIContextT NodeAPIContext,
+](store *withdrawalstore.Store) *withdrawalsapi.Handler[NodeAPIContextT] {
+	// The queries are rejected if the withdrawals are not indexed.
+	var receipts withdrawalsapi.ReceiptStore
+	if store != nil {
+		receipts = store
+	}
+	return withdrawalsapi.NewHandler[NodeAPIContextT](receipts)
+}
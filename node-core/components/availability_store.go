@@ -27,6 +27,7 @@ import (
 	"github.com/berachain/beacon-kit/config"
 	dastore "github.com/berachain/beacon-kit/da/store"
 	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/node-core/components/metrics"
 	"github.com/berachain/beacon-kit/primitives/async"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/eip4844"
@@ -85,6 +86,7 @@ type AvailabilityPrunerInput[
 	ChainSpec         common.ChainSpec
 	Dispatcher        Dispatcher
 	Logger            LoggerT
+	TelemetrySink     *metrics.TelemetrySink
 }
 
 // ProvideAvailabilityPruner provides a availability pruner for the depinject
@@ -122,5 +124,6 @@ func ProvideAvailabilityPruner[
 		manager.AvailabilityPrunerName,
 		subFinalizedBlocks,
 		dastore.BuildPruneRangeFn[BeaconBlockT](in.ChainSpec),
+		in.TelemetrySink,
 	), nil
 }
@@ -21,10 +21,19 @@
 package components
 
 import (
+	"context"
+	"fmt"
+
 	"cosmossdk.io/depinject"
 	"github.com/berachain/beacon-kit/config"
+	"github.com/berachain/beacon-kit/consensus/cometbft/service/encoding"
+	"github.com/berachain/beacon-kit/consensus/cometbft/service/middleware"
+	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/log"
 	blockstore "github.com/berachain/beacon-kit/node-api/block_store"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
 )
 
 // BlockServiceInput is the input for the block service.
@@ -36,6 +45,7 @@ type BlockServiceInput[
 	depinject.In
 
 	BlockStore BeaconBlockStoreT
+	ChainSpec  common.ChainSpec
 	Config     *config.Config
 	Dispatcher Dispatcher
 	Logger     LoggerT
@@ -52,13 +62,70 @@ func ProvideBlockStoreService[
 	LoggerT log.AdvancedLogger[LoggerT],
 ](
 	in BlockServiceInput[BeaconBlockT, BeaconBlockStoreT, LoggerT],
-) *blockstore.Service[
+) (*blockstore.Service[
 	BeaconBlockT, BeaconBlockStoreT,
-] {
+], error) {
+	var source blockstore.BlockSource[BeaconBlockT]
+	if url := in.Config.BlockStoreService.BackfillURL; url != "" {
+		client, err := rpchttp.New(url)
+		if err != nil {
+			return nil, err
+		}
+		source = &cometBlockSource[BeaconBlockT]{
+			client:    client,
+			chainSpec: in.ChainSpec,
+		}
+	}
 	return blockstore.NewService(
 		in.Config.BlockStoreService,
 		in.Logger,
 		in.Dispatcher,
 		in.BlockStore,
+		source,
+	), nil
+}
+
+// cometBlockSource retrieves beacon blocks from the CometBFT blocks of a
+// peer or an archive node, the beacon block being the first transaction of
+// every block.
+type cometBlockSource[BeaconBlockT interface {
+	NewFromSSZ([]byte, uint32) (BeaconBlockT, error)
+}] struct {
+	client    *rpchttp.HTTP
+	chainSpec common.ChainSpec
+}
+
+// BlockAtSlot implements blockstore.BlockSource.
+func (s *cometBlockSource[BeaconBlockT]) BlockAtSlot(
+	ctx context.Context,
+	slot math.Slot,
+) (BeaconBlockT, error) {
+	var blk BeaconBlockT
+	//#nosec:G701 // slots are heights, which fit in an int64.
+	height := int64(slot.Unwrap())
+	res, err := s.client.Block(ctx, &height)
+	if err != nil {
+		// The error does not tell pruned heights apart, so check the height
+		// against the earliest one the source still has.
+		status, statusErr := s.client.Status(ctx)
+		if statusErr == nil && height < status.SyncInfo.EarliestBlockHeight {
+			return blk, errors.Wrapf(
+				blockstore.ErrBlockPruned, "height %d, earliest height %d",
+				height, status.SyncInfo.EarliestBlockHeight,
+			)
+		}
+		return blk, err
+	}
+	if res.Block == nil ||
+		uint(len(res.Block.Txs)) <= middleware.BeaconBlockTxIndex {
+		return blk, fmt.Errorf("no beacon block at height %d", height)
+	}
+	forkVersion := s.chainSpec.ActiveForkVersionForSlot(slot)
+	body, err := encoding.Decode(
+		res.Block.Txs[middleware.BeaconBlockTxIndex], forkVersion,
 	)
+	if err != nil {
+		return blk, err
+	}
+	return blk.NewFromSSZ(body, forkVersion)
 }
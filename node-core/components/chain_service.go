@@ -29,6 +29,8 @@ import (
 	"github.com/berachain/beacon-kit/execution/engine"
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	"github.com/berachain/beacon-kit/observability/alerts"
+	"github.com/berachain/beacon-kit/observability/profiling"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/crypto"
 )
@@ -49,6 +51,7 @@ type ChainServiceInput[
 ] struct {
 	depinject.In
 
+	Alerts       *alerts.Manager
 	ChainSpec    common.ChainSpec
 	Cfg          *config.Config
 	EngineClient *client.EngineClient[
@@ -61,9 +64,13 @@ type ChainServiceInput[
 		PayloadID,
 		WithdrawalsT,
 	]
-	Dispatcher     Dispatcher
+	Dispatcher    Dispatcher
+	ForkRehearsal *blockchain.ForkRehearsal[
+		BeaconBlockT, BeaconStateT, DepositT, ExecutionPayloadHeaderT,
+	]
 	LocalBuilder   LocalBuilder[BeaconStateT, ExecutionPayloadT]
 	Logger         LoggerT
+	Profiler       *profiling.BlockProfiler
 	Signer         crypto.BLSSigner
 	StateProcessor StateProcessor[
 		BeaconBlockT, BeaconStateT, *Context,
@@ -138,6 +145,9 @@ func ProvideChainService[
 		in.ExecutionEngine,
 		in.LocalBuilder,
 		in.StateProcessor,
+		in.ForkRehearsal,
+		in.Alerts,
+		in.Profiler,
 		in.TelemetrySink,
 		// If optimistic is enabled, we want to skip post finalization FCUs.
 		in.Cfg.Validator.EnableOptimisticPayloadBuilds,
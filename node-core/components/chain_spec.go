@@ -23,37 +23,45 @@ package components
 import (
 	"os"
 
+	"github.com/berachain/beacon-kit/cli/flags"
+	"github.com/berachain/beacon-kit/config/network"
 	"github.com/berachain/beacon-kit/config/spec"
 	"github.com/berachain/beacon-kit/primitives/common"
 )
 
 const (
-	ChainSpecTypeEnvVar  = "CHAIN_SPEC"
-	DevnetChainSpecType  = "devnet"
-	BetnetChainSpecType  = "betnet"
-	BoonetChainSpecType  = "boonet"
-	TestnetChainSpecType = "testnet"
+	// ChainSpecTypeEnvVar selects one of the chain spec presets by name.
+	ChainSpecTypeEnvVar = "CHAIN_SPEC"
+	// ChainSpecFileEnvVar points to a TOML or YAML chain spec file. It takes
+	// precedence over ChainSpecTypeEnvVar.
+	ChainSpecFileEnvVar = "CHAIN_SPEC_FILE"
+
+	DevnetChainSpecType  = spec.DevnetPreset
+	BetnetChainSpecType  = spec.BetnetPreset
+	BoonetChainSpecType  = spec.BoonetPreset
+	TestnetChainSpecType = spec.TestnetPreset
 )
 
-// ProvideChainSpec provides the chain spec based on the environment variable.
+// ProvideChainSpec provides the chain spec based on the environment variables.
+// A chain spec file, if given, is layered on top of the preset it selects.
+// Otherwise the chain spec of the selected network is used, or else the
+// preset named by ChainSpecTypeEnvVar, defaulting to the testnet preset.
 func ProvideChainSpec() (common.ChainSpec, error) {
-	// TODO: This is hood as fuck needs to be improved
-	// but for now we ball to get CI unblocked.
-	var (
-		chainSpec common.ChainSpec
-		err       error
-	)
-	switch os.Getenv(ChainSpecTypeEnvVar) {
-	case DevnetChainSpecType:
-		chainSpec, err = spec.DevnetChainSpec()
-	case BetnetChainSpecType:
-		chainSpec, err = spec.BetnetChainSpec()
-	case BoonetChainSpecType:
-		chainSpec, err = spec.BoonetChainSpec()
-	case TestnetChainSpecType:
-		fallthrough
-	default:
-		chainSpec, err = spec.TestnetChainSpec()
+	if path := os.Getenv(ChainSpecFileEnvVar); path != "" {
+		return spec.LoadFile(path)
+	}
+
+	if name := flags.SelectedNetwork(os.Args[1:]); name != "" {
+		n, err := network.Get(name)
+		if err != nil {
+			return nil, err
+		}
+		return n.ChainSpec, nil
+	}
+
+	preset := os.Getenv(ChainSpecTypeEnvVar)
+	if preset == "" {
+		preset = TestnetChainSpecType
 	}
-	return chainSpec, err
+	return spec.FromPreset(preset)
 }
@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/config"
+	"github.com/berachain/beacon-kit/execution/chaos"
+	"github.com/berachain/beacon-kit/log"
+)
+
+// ChaosInjectorInput is the input for the chaos injector provider.
+type ChaosInjectorInput[LoggerT any] struct {
+	depinject.In
+	Cfg    *config.Config
+	Logger LoggerT
+}
+
+// ProvideChaosInjector provides the injector of the configured fault
+// scenario. It returns nil if no scenario is configured.
+func ProvideChaosInjector[LoggerT log.AdvancedLogger[LoggerT]](
+	in ChaosInjectorInput[LoggerT],
+) (*chaos.Injector, error) {
+	path := in.Cfg.Chaos.ScenarioFile
+	if path == "" {
+		return nil, nil
+	}
+	if !chaos.Enabled {
+		return nil, chaos.ErrDisabled
+	}
+	scenario, err := chaos.LoadScenario(path)
+	if err != nil {
+		return nil, err
+	}
+	logger := in.Logger.With("service", "chaos")
+	logger.Warn("Fault injection enabled", "scenario", path)
+	return chaos.New(logger, scenario)
+}
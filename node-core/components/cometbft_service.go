@@ -21,18 +21,24 @@
 package components
 
 import (
+	snapshottypes "cosmossdk.io/store/snapshots/types"
 	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/beacon/validator/lease"
 	"github.com/berachain/beacon-kit/config"
 	cometbft "github.com/berachain/beacon-kit/consensus/cometbft/service"
+	"github.com/berachain/beacon-kit/consensus/cometbft/service/halt"
+	"github.com/berachain/beacon-kit/consensus/cometbft/service/voteext"
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/node-core/builder"
 	"github.com/berachain/beacon-kit/primitives/common"
 	cmtcfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/privval"
 	dbm "github.com/cosmos/cosmos-db"
 )
 
 // ProvideCometBFTService provides the CometBFT service component.
 func ProvideCometBFTService[
+	DepositStoreT snapshottypes.ExtensionSnapshotter,
 	LoggerT log.AdvancedLogger[LoggerT],
 ](
 	logger LoggerT,
@@ -42,7 +48,31 @@ func ProvideCometBFTService[
 	cmtCfg *cmtcfg.Config,
 	appOpts config.AppOptions,
 	chainSpec common.ChainSpec,
+	voteExtensions *voteext.Handler,
+	haltController *halt.Controller,
+	depositStore DepositStoreT,
+	signingLease *lease.FileLease,
 ) *cometbft.Service[LoggerT] {
+	options := append(
+		builder.DefaultServiceOptions[LoggerT](appOpts),
+		cometbft.SetVoteExtensionHandler[LoggerT](voteExtensions),
+		cometbft.SetHaltController[LoggerT](haltController),
+		// The deposit store is kept outside of the multistore, so it is
+		// added to state sync snapshots as an extension.
+		cometbft.SetSnapshotExtensions[LoggerT](depositStore),
+	)
+	// Only sign votes and proposals while holding the signing lease, if any.
+	if signingLease != nil {
+		options = append(options, cometbft.SetPrivValidator[LoggerT](
+			lease.NewPrivValidator(
+				privval.LoadOrGenFilePV(
+					cmtCfg.PrivValidatorKeyFile(),
+					cmtCfg.PrivValidatorStateFile(),
+				),
+				signingLease,
+			),
+		))
+	}
 	return cometbft.NewService(
 		storeKey,
 		logger,
@@ -50,6 +80,6 @@ func ProvideCometBFTService[
 		abciMiddleware,
 		cmtCfg,
 		chainSpec,
-		builder.DefaultServiceOptions[LoggerT](appOpts)...,
+		options...,
 	)
 }
@@ -52,6 +52,9 @@ func ProvideServerConfig(in ConfigInput) (*sdkconfig.Config, error) {
 		))); err != nil {
 		return nil, err
 	}
+	if err := cfg.ValidateBasic(); err != nil {
+		return nil, err
+	}
 
 	return &cfg, nil
 }
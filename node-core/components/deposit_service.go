@@ -22,6 +22,7 @@ package components
 
 import (
 	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/consensus-types/types"
 	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
 	"github.com/berachain/beacon-kit/execution/client"
 	"github.com/berachain/beacon-kit/execution/deposit"
@@ -29,6 +30,7 @@ import (
 	"github.com/berachain/beacon-kit/node-core/components/metrics"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/state-transition/core"
 )
 
 // DepositServiceIn is the input for the deposit service.
@@ -104,3 +106,25 @@ func ProvideDepositService[
 		in.Dispatcher,
 	), nil
 }
+
+// DepositPreVerifierInput is the input for the deposit pre-verifier.
+type DepositPreVerifierInput[LoggerT any] struct {
+	depinject.In
+	DepositSignatures *core.DepositSignatures
+	DepositStore      DepositStore[*types.Deposit]
+	Dispatcher        Dispatcher
+	Logger            LoggerT
+}
+
+// ProvideDepositPreVerifier provides the service pre-verifying the
+// signatures of the deposits read from the deposit contract.
+func ProvideDepositPreVerifier[LoggerT log.AdvancedLogger[LoggerT]](
+	in DepositPreVerifierInput[LoggerT],
+) *deposit.PreVerifier[*types.Deposit] {
+	return deposit.NewPreVerifier(
+		in.Logger.With("service", "deposit-pre-verifier"),
+		in.DepositStore,
+		in.DepositSignatures,
+		in.Dispatcher,
+	)
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/berachain/beacon-kit/config"
 	"github.com/berachain/beacon-kit/execution/deposit"
 	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/node-core/components/metrics"
 	"github.com/berachain/beacon-kit/node-core/components/storage"
 	"github.com/berachain/beacon-kit/primitives/async"
 	"github.com/berachain/beacon-kit/primitives/common"
@@ -75,10 +76,11 @@ type DepositPrunerInput[
 	LoggerT any,
 ] struct {
 	depinject.In
-	ChainSpec    common.ChainSpec
-	DepositStore DepositStoreT
-	Dispatcher   Dispatcher
-	Logger       LoggerT
+	ChainSpec     common.ChainSpec
+	DepositStore  DepositStoreT
+	Dispatcher    Dispatcher
+	Logger        LoggerT
+	TelemetrySink *metrics.TelemetrySink
 }
 
 // ProvideDepositPruner provides a deposit pruner for the depinject framework.
@@ -119,5 +121,6 @@ func ProvideDepositPruner[
 			DepositT,
 			WithdrawalCredentials,
 		](in.ChainSpec),
+		in.TelemetrySink,
 	), nil
 }
@@ -65,5 +65,20 @@ func ProvideDispatcher[
 			async.FinalValidatorUpdatesProcessed,
 		),
 		dp.WithEvent[async.Event[BeaconBlockT]](async.BeaconBlockFinalized),
+		dp.WithEvent[async.Event[async.Head]](async.NewHead),
+		dp.WithEvent[async.Event[async.Checkpoint]](
+			async.FinalizedCheckpoint,
+		),
+		dp.WithEvent[async.Event[async.Proposal]](async.ProposalBuilt),
+		dp.WithEvent[async.Event[async.InvalidPayload]](
+			async.PayloadInvalid,
+		),
+		dp.WithEvent[async.Event[async.Deposits]](async.DepositObserved),
+		dp.WithEvent[async.Event[async.ValidatorSetUpdate]](
+			async.ValidatorSetUpdated,
+		),
+		dp.WithEvent[async.Event[async.ValidatorStatusChanges]](
+			async.ValidatorStatusChanged,
+		),
 	)
 }
@@ -26,7 +26,9 @@ import (
 	"cosmossdk.io/depinject"
 	"github.com/berachain/beacon-kit/config"
 	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/execution/chaos"
 	"github.com/berachain/beacon-kit/execution/client"
+	ethclientrpc "github.com/berachain/beacon-kit/execution/client/ethclient/rpc"
 	"github.com/berachain/beacon-kit/execution/engine"
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/node-core/components/metrics"
@@ -38,7 +40,9 @@ import (
 type EngineClientInputs[LoggerT any] struct {
 	depinject.In
 	ChainSpec common.ChainSpec
-	Config    *config.Config
+	// Chaos injects faults into the calls to the execution client, if set.
+	Chaos  *chaos.Injector `optional:"true"`
+	Config *config.Config
 	// TODO: this feels like a hood way to handle it.
 	JWTSecret     *jwt.Secret `optional:"true"`
 	Logger        LoggerT
@@ -60,6 +64,12 @@ func ProvideEngineClient[
 	ExecutionPayloadT,
 	*engineprimitives.PayloadAttributes[WithdrawalT],
 ] {
+	var rpcOpts []func(*ethclientrpc.Client)
+	if in.Chaos != nil {
+		rpcOpts = append(
+			rpcOpts, ethclientrpc.WithInterceptor(in.Chaos.Intercept),
+		)
+	}
 	return client.New[
 		ExecutionPayloadT,
 		*engineprimitives.PayloadAttributes[WithdrawalT],
@@ -69,6 +79,13 @@ func ProvideEngineClient[
 		in.JWTSecret,
 		in.TelemetrySink,
 		new(big.Int).SetUint64(in.ChainSpec.DepositEth1ChainID()),
+		&client.DepositContract{
+			Address: in.ChainSpec.DepositContractAddress(),
+			CodeHash: common.ExecutionHash(
+				in.ChainSpec.DepositContractCodeHash(),
+			),
+		},
+		rpcOpts...,
 	)
 }
 
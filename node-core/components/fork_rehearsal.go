@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/beacon/blockchain"
+	"github.com/berachain/beacon-kit/config"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/execution/engine"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/state-transition/core"
+)
+
+// ForkRehearsalInput is the input for the fork rehearsal provider.
+type ForkRehearsalInput[
+	LoggerT log.AdvancedLogger[LoggerT],
+] struct {
+	depinject.In
+	Cfg             *config.Config
+	Logger          LoggerT
+	ChainSpec       common.ChainSpec
+	ExecutionEngine *engine.Engine[
+		*types.ExecutionPayload,
+		*engineprimitives.PayloadAttributes[*engineprimitives.Withdrawal],
+		PayloadID,
+		engineprimitives.Withdrawals,
+	]
+	DepositStore  DepositStore[*types.Deposit]
+	Signer        crypto.BLSSigner
+	TelemetrySink *metrics.TelemetrySink
+}
+
+// ProvideForkRehearsal provides the fork rehearsal to the depinject
+// framework. It returns nil if the rehearsal mode is disabled.
+func ProvideForkRehearsal[
+	LoggerT log.AdvancedLogger[LoggerT],
+	BeaconStateT BeaconState[
+		BeaconStateT, *types.BeaconBlockHeader, BeaconStateMarshallableT,
+		*Eth1Data, *types.ExecutionPayloadHeader, *Fork, KVStoreT, *Validator,
+		Validators, *engineprimitives.Withdrawal,
+	],
+	BeaconStateMarshallableT any,
+	KVStoreT BeaconStore[
+		KVStoreT, *types.BeaconBlockHeader, *Eth1Data,
+		*types.ExecutionPayloadHeader, *Fork, *Validator, Validators,
+		*engineprimitives.Withdrawal,
+	],
+](
+	in ForkRehearsalInput[LoggerT],
+) (*blockchain.ForkRehearsal[
+	*types.BeaconBlock, BeaconStateT, *types.Deposit,
+	*types.ExecutionPayloadHeader,
+], error) {
+	cfg := in.Cfg.ForkRehearsal
+	if !cfg.Enabled {
+		//nolint:nilnil // rehearsal mode is disabled.
+		return nil, nil
+	}
+
+	chainSpec, err := blockchain.NewRehearsalChainSpec(in.ChainSpec, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	sp, err := core.NewStateProcessor[BeaconStateT, *Context, KVStoreT](
+		core.WithLogger(in.Logger.With("service", "fork-rehearsal")),
+		core.WithForkSchedule(chainSpec),
+		core.WithExecutionEngine(in.ExecutionEngine),
+		core.WithDepositStore(in.DepositStore),
+		core.WithSigner(in.Signer),
+		core.WithTelemetry(in.TelemetrySink),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return blockchain.NewForkRehearsal(cfg, sp), nil
+}
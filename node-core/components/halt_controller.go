@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/cli/commands/server"
+	"github.com/berachain/beacon-kit/config"
+	"github.com/berachain/beacon-kit/consensus/cometbft/service/halt"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/spf13/cast"
+)
+
+// HaltControllerInput is the input for the halt controller provider.
+type HaltControllerInput[LoggerT any] struct {
+	depinject.In
+	AppOpts   config.AppOptions
+	ChainSpec common.ChainSpec
+	Logger    LoggerT
+}
+
+// ProvideHaltController provides the controller deciding the height at
+// which the node stops finalizing blocks, armed from the halt flags.
+func ProvideHaltController[
+	LoggerT log.AdvancedLogger[LoggerT],
+](
+	in HaltControllerInput[LoggerT],
+) *halt.Controller {
+	return halt.NewController(
+		in.Logger.With("service", "halt"),
+		in.ChainSpec.SlotsPerEpoch(),
+		cast.ToUint64(in.AppOpts.Get(server.FlagHaltHeight)),
+		cast.ToUint64(in.AppOpts.Get(server.FlagHaltEpoch)),
+	)
+}
@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/config"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/node-api/indexer"
+	"github.com/berachain/beacon-kit/primitives/common"
+)
+
+// IndexerServiceInput is the input for the indexer service.
+type IndexerServiceInput[
+	BeaconStateT any,
+	LoggerT any,
+] struct {
+	depinject.In
+
+	ChainSpec   common.ChainSpec
+	Config      *config.Config
+	Dispatcher  Dispatcher
+	Logger      LoggerT
+	StateSource indexer.StateSource[BeaconStateT]
+}
+
+// ProvideIndexerService provides the block explorer indexer service.
+func ProvideIndexerService[
+	BeaconBlockT BeaconBlock[
+		BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
+	],
+	BeaconBlockBodyT BeaconBlockBody[
+		BeaconBlockBodyT, *AttestationData, DepositT,
+		*Eth1Data, ExecutionPayloadT, *SlashingInfo,
+	],
+	BeaconBlockHeaderT any,
+	BeaconStateT indexer.BeaconState,
+	DepositT Deposit[
+		DepositT, *ForkData, WithdrawalCredentials,
+	],
+	ExecutionPayloadT ExecutionPayload[
+		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
+	],
+	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
+	LoggerT log.AdvancedLogger[LoggerT],
+	WithdrawalT Withdrawal[WithdrawalT],
+	WithdrawalsT Withdrawals[WithdrawalT],
+](
+	in IndexerServiceInput[BeaconStateT, LoggerT],
+) (*indexer.Service[
+	BeaconBlockT, BeaconBlockBodyT, BeaconStateT, DepositT,
+	ExecutionPayloadT, WithdrawalT, WithdrawalsT, WithdrawalCredentials,
+], error) {
+	// The database is only opened if the indexer is enabled.
+	var sink indexer.Sink
+	if in.Config.Indexer.Enabled {
+		pg, err := indexer.NewPostgresSink(in.Config.Indexer.DSN)
+		if err != nil {
+			return nil, err
+		}
+		sink = pg
+	}
+	return indexer.NewService[
+		BeaconBlockT,
+		BeaconBlockBodyT,
+		BeaconStateT,
+		DepositT,
+		ExecutionPayloadT,
+		WithdrawalT,
+		WithdrawalsT,
+		WithdrawalCredentials,
+	](
+		in.Config.Indexer,
+		in.Logger.With("service", "indexer"),
+		in.ChainSpec,
+		in.Dispatcher,
+		sink,
+		in.StateSource,
+	), nil
+}
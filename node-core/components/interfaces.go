@@ -35,6 +35,7 @@ import (
 	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/primitives/eip4844"
 	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/merkle"
 	"github.com/berachain/beacon-kit/primitives/transition"
 	v1 "github.com/cometbft/cometbft/api/cometbft/abci/v1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -128,6 +129,9 @@ type (
 		// GetTimestamp returns the timestamp of the block from the execution
 		// payload.
 		GetTimestamp() math.U64
+		// ValidateLimits checks the block against the per-block limits of
+		// the chain spec.
+		ValidateLimits(common.ChainSpec) error
 	}
 
 	// BeaconBlockBody represents a generic interface for the body of a beacon
@@ -170,6 +174,9 @@ type (
 		// SetBlobKzgCommitments sets the blob KZG commitments of the beacon
 		// block body.
 		SetBlobKzgCommitments(eip4844.KZGCommitments[common.ExecutionHash])
+		// SetOperations sets the application-defined operations of the
+		// beacon block body.
+		SetOperations([]*transition.Operation)
 	}
 
 	// BeaconBlockHeader is the interface for a beacon block header.
@@ -521,7 +528,7 @@ type (
 	// ExecutionPayloadHeader is the interface for the execution payload
 	// header.
 	ExecutionPayloadHeader[T any] interface {
-		constraints.SSZMarshallable
+		constraints.SSZMarshallableRootable
 		constraints.Versionable
 		NewFromSSZ([]byte, uint32) (T, error)
 		// GetNumber returns the block number of the ExecutionPayloadHeader.
@@ -567,6 +574,7 @@ type (
 
 	// IndexDB is the interface for the range DB.
 	IndexDB interface {
+		Get(index uint64, key []byte) ([]byte, error)
 		Has(index uint64, key []byte) (bool, error)
 		Set(index uint64, key []byte, value []byte) error
 		Prune(start uint64, end uint64) error
@@ -612,6 +620,17 @@ type (
 			headEth1BlockHash common.ExecutionHash,
 			finalEth1BlockHash common.ExecutionHash,
 		) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error)
+		// RequestPayloadNow requests a payload for the given slot and
+		// fetches it without waiting for it to be filled.
+		RequestPayloadNow(
+			ctx context.Context,
+			st BeaconStateT,
+			slot math.Slot,
+			timestamp uint64,
+			parentBlockRoot common.Root,
+			headEth1BlockHash common.ExecutionHash,
+			finalEth1BlockHash common.ExecutionHash,
+		) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error)
 	}
 
 	// 	// PayloadAttributes is the interface for the payload attributes.
@@ -655,6 +674,9 @@ type (
 		DepositT any,
 		ExecutionPayloadHeaderT any,
 	] interface {
+		// Eth1Data returns the Eth1Data the block must carry on top of the
+		// state.
+		Eth1Data(st BeaconStateT, blk BeaconBlockT) (*Eth1Data, error)
 		// InitializePreminedBeaconStateFromEth1 initializes the premined beacon
 		// state
 		// from the eth1 deposits.
@@ -664,6 +686,9 @@ type (
 			ExecutionPayloadHeaderT,
 			common.Version,
 		) (transition.ValidatorUpdates, error)
+		// PrecomputeNextSlot computes ahead the state root the processing
+		// of the next slot starts with.
+		PrecomputeNextSlot(st BeaconStateT) error
 		// ProcessSlot processes the slot.
 		ProcessSlots(
 			st BeaconStateT, slot math.Slot,
@@ -674,6 +699,43 @@ type (
 			st BeaconStateT,
 			blk BeaconBlockT,
 		) (transition.ValidatorUpdates, error)
+		// ValidatorStatusChanges returns the validator status changes
+		// produced by the processing of the given epoch.
+		ValidatorStatusChanges(
+			epoch math.Epoch,
+		) []transition.ValidatorStatusChange
+	}
+
+	// BlockProposer builds the blocks of an external proposer.
+	BlockProposer[BeaconBlockT any] interface {
+		// ProduceBlock builds an unsigned block for the given slot on the
+		// state of the given context.
+		ProduceBlock(
+			ctx context.Context,
+			slot math.Slot,
+			reveal crypto.BLSSignature,
+			graffiti common.Bytes32,
+		) (BeaconBlockT, error)
+		// SubmitBlock accepts a produced block signed by the proposer.
+		SubmitBlock(blk BeaconBlockT, signature crypto.BLSSignature) error
+	}
+
+	// PayloadRelay reveals the execution payloads of the blocks an external
+	// proposer signed blinded.
+	PayloadRelay[ExecutionPayloadT any] interface {
+		// RevealPayload returns the execution payload of the produced block
+		// with the given root, once signed by the proposer.
+		RevealPayload(
+			root common.Root, signature crypto.BLSSignature,
+		) (ExecutionPayloadT, error)
+	}
+
+	// ReadOnlyStateProcessor hands out read-only snapshots of the beacon
+	// state, for readers which must not write to it.
+	ReadOnlyStateProcessor[BeaconStateT, ReadOnlyBeaconStateT any] interface {
+		// ReadOnlySnapshot returns a read-only view of the state with the
+		// state and block roots of its current slot cached.
+		ReadOnlySnapshot(BeaconStateT) (ReadOnlyBeaconStateT, error)
 	}
 
 	SidecarFactory[BeaconBlockT any, BlobSidecarsT any] interface {
@@ -682,6 +744,9 @@ type (
 			blk BeaconBlockT,
 			blobs engineprimitives.BlobsBundle,
 		) (BlobSidecarsT, error)
+		// SidecarsSize returns the size of the encoded sidecars built for
+		// the given blobs bundle.
+		SidecarsSize(blobs engineprimitives.BlobsBundle) uint64
 	}
 
 	// StorageBackend defines an interface for accessing various storage
@@ -821,6 +886,9 @@ type (
 		Copy() T
 		Context() context.Context
 		HashTreeRoot() common.Root
+		ParallelHashTreeRoot(
+			hasher *merkle.ParallelHasher,
+		) (common.Root, error)
 		GetMarshallable() (BeaconStateMarshallableT, error)
 
 		ReadOnlyBeaconState[
@@ -869,6 +937,12 @@ type (
 		SetEth1DepositIndex(
 			index uint64,
 		) error
+		// GetDepositBranchAtIndex retrieves the node of the deposit tree branch
+		// at the given height.
+		GetDepositBranchAtIndex(index uint64) (common.Root, error)
+		// UpdateDepositBranchAtIndex sets the node of the deposit tree branch at
+		// the given height.
+		UpdateDepositBranchAtIndex(index uint64, node common.Root) error
 		// GetBalance retrieves the balance of a validator.
 		GetBalance(idx math.ValidatorIndex) (math.Gwei, error)
 		// SetBalance sets the balance of a validator.
@@ -955,6 +1029,12 @@ type (
 		ValidatorIndexByCometBFTAddress(
 			cometBFTAddress []byte,
 		) (math.ValidatorIndex, error)
+		// ValidatorIndicesByWithdrawalAddress retrieves the indices of the
+		// validators whose withdrawals are paid to the given execution
+		// address.
+		ValidatorIndicesByWithdrawalAddress(
+			address common.ExecutionAddress,
+		) ([]math.ValidatorIndex, error)
 		// GetValidatorsByEffectiveBalance retrieves validators by effective
 		// balance.
 		GetValidatorsByEffectiveBalance() ([]ValidatorT, error)
@@ -990,6 +1070,9 @@ type (
 		ValidatorIndexByCometBFTAddress(
 			cometBFTAddress []byte,
 		) (math.ValidatorIndex, error)
+		ValidatorIndicesByWithdrawalAddress(
+			address common.ExecutionAddress,
+		) ([]math.ValidatorIndex, error)
 	}
 
 	// WriteOnlyBeaconState is the interface for a write-only beacon state.
@@ -1067,6 +1150,7 @@ type (
 	WriteOnlyEth1Data[Eth1DataT, ExecutionPayloadHeaderT any] interface {
 		SetEth1Data(Eth1DataT) error
 		SetEth1DepositIndex(uint64) error
+		UpdateDepositBranchAtIndex(uint64, common.Root) error
 		SetLatestExecutionPayloadHeader(
 			ExecutionPayloadHeaderT,
 		) error
@@ -1076,6 +1160,7 @@ type (
 	ReadOnlyEth1Data[Eth1DataT, ExecutionPayloadHeaderT any] interface {
 		GetEth1Data() (Eth1DataT, error)
 		GetEth1DepositIndex() (uint64, error)
+		GetDepositBranchAtIndex(uint64) (common.Root, error)
 		GetLatestExecutionPayloadHeader() (
 			ExecutionPayloadHeaderT, error,
 		)
@@ -1124,6 +1209,7 @@ type (
 		NodeAPIProofBackend[
 			BeaconBlockHeaderT, BeaconStateT, ForkT, ValidatorT,
 		]
+		NodeAPIDepositsBackend[ValidatorT]
 	}
 
 	// NodeAPIBackend is the interface for backend of the beacon API.
@@ -1133,13 +1219,12 @@ type (
 		GenesisBackend
 		BlockBackend[BeaconBlockHeaderT]
 		RandaoBackend
+		ProposerBackend
+		ForkDigestBackend
 		StateBackend[BeaconStateT, ForkT]
 		ValidatorBackend[ValidatorT]
 		HistoricalBackend[ForkT]
-		// GetSlotByBlockRoot retrieves the slot by a given root from the store.
-		GetSlotByBlockRoot(root common.Root) (math.Slot, error)
-		// GetSlotByStateRoot retrieves the slot by a given root from the store.
-		GetSlotByStateRoot(root common.Root) (math.Slot, error)
+		StateIndex
 	}
 
 	// NodeAPIProofBackend is the interface for backend of the proof API.
@@ -1148,11 +1233,43 @@ type (
 	] interface {
 		BlockBackend[BeaconBlockHeaderT]
 		StateBackend[BeaconStateT, ForkT]
+		StateIndex
+	}
+
+	// NodeAPIDepositsBackend is the interface for backend of the deposits
+	// API.
+	NodeAPIDepositsBackend[ValidatorT any] interface {
+		StateIndex
+		Eth1DepositIndexAtSlot(slot math.Slot) (uint64, math.Slot, error)
+		ValidatorByPubkey(
+			slot math.Slot, pubkey crypto.BLSPubkey,
+		) (*types.ValidatorData[ValidatorT], error)
+	}
+
+	// NodeAPIValidatorBackend is the interface for backend of the validator
+	// API.
+	NodeAPIValidatorBackend[BeaconBlockT any] interface {
+		ProduceBlock(
+			slot math.Slot,
+			reveal crypto.BLSSignature,
+			graffiti common.Bytes32,
+		) (BeaconBlockT, error)
+		SubmitBlock(blk BeaconBlockT, signature crypto.BLSSignature) error
+	}
+
+	// StateIndex is the view of the node the node API resolves state IDs
+	// against.
+	StateIndex interface {
+		// LatestSlot returns the slot of the latest committed state.
+		LatestSlot() (math.Slot, error)
+		GetSlotByBlockRoot(root common.Root) (math.Slot, error)
+		GetSlotByStateRoot(root common.Root) (math.Slot, error)
 		GetParentSlotByTimestamp(timestamp math.U64) (math.Slot, error)
 	}
 
 	GenesisBackend interface {
 		GenesisValidatorsRoot(slot math.Slot) (common.Root, error)
+		GenesisForkVersion() common.Version
 	}
 
 	HistoricalBackend[ForkT any] interface {
@@ -1164,6 +1281,16 @@ type (
 		RandaoAtEpoch(slot math.Slot, epoch math.Epoch) (common.Bytes32, error)
 	}
 
+	ProposerBackend interface {
+		ProposerLookahead(
+			slot math.Slot, epochs uint64,
+		) ([]*types.ProposerLookaheadData, error)
+	}
+
+	ForkDigestBackend interface {
+		ForkDigests(slot math.Slot) (*types.ForkDigestsData, error)
+	}
+
 	BlockBackend[BeaconBlockHeaderT any] interface {
 		BlockRootAtSlot(slot math.Slot) (common.Root, error)
 		BlockRewardsAtSlot(slot math.Slot) (*types.BlockRewardsData, error)
@@ -1189,5 +1316,9 @@ type (
 			slot math.Slot,
 			ids []string,
 		) ([]*types.ValidatorBalanceData, error)
+		ValidatorsByWithdrawalAddress(
+			slot math.Slot,
+			address common.ExecutionAddress,
+		) ([]*types.ValidatorData[ValidatorT], error)
 	}
 )
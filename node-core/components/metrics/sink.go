@@ -21,38 +21,80 @@
 package metrics
 
 import (
+	"slices"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	"github.com/hashicorp/go-metrics"
 )
 
-type TelemetrySink struct{}
+// Backend is a monitoring backend metrics are recorded into, such as
+// Prometheus, StatsD or an OTLP collector.
+type Backend interface {
+	// IncrementCounter increments the counter identified by the key and
+	// labels.
+	IncrementCounter(key string, args ...string)
+	// SetGauge sets the gauge identified by the key and labels.
+	SetGauge(key string, value int64, args ...string)
+	// MeasureSince records the time elapsed since start in the timer
+	// identified by the key and labels.
+	MeasureSince(key string, start time.Time, args ...string)
+}
+
+// TelemetrySink records metrics through the SDK telemetry and into every
+// configured backend, independently of the SDK telemetry configuration.
+type TelemetrySink struct {
+	backends []Backend
+	// labels are the key-value pairs added to every metric.
+	labels []string
+}
 
-// NewTelemetrySink creates a new TelemetrySink.
-func NewTelemetrySink() TelemetrySink {
-	return TelemetrySink{}
+// NewTelemetrySink creates a new TelemetrySink that also records every
+// metric into the given backends.
+func NewTelemetrySink(backends ...Backend) TelemetrySink {
+	return TelemetrySink{backends: backends}
+}
+
+// WithLabels returns a copy of the TelemetrySink which adds the given
+// key-value pairs to every metric.
+func (s TelemetrySink) WithLabels(labels ...string) TelemetrySink {
+	s.labels = slices.Concat(s.labels, labels)
+	return s
 }
 
 // IncrementCounter increments a counter metric identified by the provided
 // keys.
-func (TelemetrySink) IncrementCounter(key string, args ...string) {
+func (s TelemetrySink) IncrementCounter(key string, args ...string) {
+	args = s.withLabels(args)
 	telemetry.IncrCounterWithLabels([]string{key}, 1, argsToLabels(args...))
+	for _, backend := range s.backends {
+		backend.IncrementCounter(key, args...)
+	}
 }
 
 // SetGauge sets a gauge metric to the specified value, identified by the
 // provided keys.
-func (TelemetrySink) SetGauge(key string, value int64, args ...string) {
+func (s TelemetrySink) SetGauge(key string, value int64, args ...string) {
+	args = s.withLabels(args)
 	telemetry.SetGaugeWithLabels(
 		[]string{key},
 		float32(value),
 		argsToLabels(args...),
 	)
+	for _, backend := range s.backends {
+		backend.SetGauge(key, value, args...)
+	}
 }
 
 // MeasureSince measures the time since the provided start time and records
 // the duration in a metric identified by the provided key.
-func (TelemetrySink) MeasureSince(key string, start time.Time, args ...string) {
+func (s TelemetrySink) MeasureSince(
+	key string, start time.Time, args ...string,
+) {
+	args = s.withLabels(args)
+	for _, backend := range s.backends {
+		backend.MeasureSince(key, start, args...)
+	}
 	if !telemetry.IsTelemetryEnabled() {
 		return
 	}
@@ -65,6 +107,14 @@ func (TelemetrySink) MeasureSince(key string, start time.Time, args ...string) {
 	)
 }
 
+// withLabels appends the labels of the sink to the given args.
+func (s TelemetrySink) withLabels(args []string) []string {
+	if len(s.labels) == 0 {
+		return args
+	}
+	return slices.Concat(args, s.labels)
+}
+
 // argsToLabels converts a list of key-value pairs to a list of metrics labels.
 //
 //nolint:mnd // its okay.
@@ -22,6 +22,8 @@ package components
 
 import (
 	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/config"
+	"github.com/berachain/beacon-kit/consensus/cometbft/service/encoding"
 	"github.com/berachain/beacon-kit/consensus/cometbft/service/middleware"
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/node-core/components/metrics"
@@ -36,6 +38,7 @@ type ABCIMiddlewareInput[
 ] struct {
 	depinject.In
 	ChainSpec     common.ChainSpec
+	Config        *config.Config
 	Dispatcher    Dispatcher
 	Logger        LoggerT
 	TelemetrySink *metrics.TelemetrySink
@@ -58,6 +61,10 @@ func ProvideABCIMiddleware[
 ) (*middleware.ABCIMiddleware[
 	BeaconBlockT, BeaconBlockHeaderT, BlobSidecarsT, GenesisT, *SlotData,
 ], error) {
+	wireFormat, err := encoding.ParseFormat(in.Config.Validator.WireFormat)
+	if err != nil {
+		return nil, err
+	}
 	return middleware.NewABCIMiddleware[
 		BeaconBlockT,
 		BeaconBlockHeaderT,
@@ -69,5 +76,6 @@ func ProvideABCIMiddleware[
 		in.Dispatcher,
 		in.Logger,
 		in.TelemetrySink,
+		wireFormat,
 	), nil
 }
@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/beacon/clock"
+	"github.com/berachain/beacon-kit/observability/identity"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/version"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/p2p"
+	sdkversion "github.com/cosmos/cosmos-sdk/version"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+// NodeIdentityInput is the input for the node identity provider.
+type NodeIdentityInput struct {
+	depinject.In
+	ChainSpec common.ChainSpec
+	CmtCfg    *cmtcfg.Config
+	SlotClock *clock.SlotClock
+}
+
+// ProvideNodeIdentity provides the identity of the node, attached to its
+// logs, metrics and node API.
+func ProvideNodeIdentity(in NodeIdentityInput) (*identity.Identity, error) {
+	nodeKey, err := p2p.LoadOrGenNodeKey(in.CmtCfg.NodeKeyFile())
+	if err != nil {
+		return nil, err
+	}
+	appGenesis, err := genutiltypes.AppGenesisFromFile(
+		in.CmtCfg.GenesisFile(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &identity.Identity{
+		NodeID:        string(nodeKey.ID()),
+		Moniker:       in.CmtCfg.Moniker,
+		ClientVersion: sdkversion.Version,
+		Commit:        sdkversion.Commit,
+		ChainID:       appGenesis.ChainID,
+		ActiveFork: version.Name(
+			in.ChainSpec.ActiveForkVersionForSlot(
+				in.SlotClock.CurrentSlot(),
+			),
+		),
+	}, nil
+}
@@ -22,10 +22,14 @@ package components
 
 import (
 	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/beacon/clock"
 	"github.com/berachain/beacon-kit/config"
 	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/execution/chaos"
 	"github.com/berachain/beacon-kit/execution/engine"
 	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	"github.com/berachain/beacon-kit/observability/alerts"
 	payloadbuilder "github.com/berachain/beacon-kit/payload/builder"
 	"github.com/berachain/beacon-kit/payload/cache"
 	"github.com/berachain/beacon-kit/primitives/common"
@@ -44,18 +48,23 @@ type LocalBuilderInput[
 	WithdrawalsT Withdrawals[WithdrawalT],
 ] struct {
 	depinject.In
+	Alerts            *alerts.Manager
 	AttributesFactory AttributesFactory[
 		BeaconStateT, *engineprimitives.PayloadAttributes[WithdrawalT],
 	]
-	Cfg             *config.Config
-	ChainSpec       common.ChainSpec
+	Cfg       *config.Config
+	ChainSpec common.ChainSpec
+	// Chaos injects faults into the payload ID cache, if set.
+	Chaos           *chaos.Injector `optional:"true"`
 	ExecutionEngine *engine.Engine[
 		ExecutionPayloadT,
 		*engineprimitives.PayloadAttributes[WithdrawalT],
 		PayloadID,
 		WithdrawalsT,
 	]
-	Logger LoggerT
+	Logger        LoggerT
+	SlotClock     *clock.SlotClock
+	TelemetrySink *metrics.TelemetrySink
 }
 
 // ProvideLocalBuilder provides a local payload builder for the
@@ -85,7 +94,25 @@ func ProvideLocalBuilder[
 	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
 	*engineprimitives.PayloadAttributes[WithdrawalT], PayloadID, WithdrawalT,
 ] {
-	return payloadbuilder.New[
+	payloadIDs := cache.NewPayloadIDCache[PayloadID, [32]byte, math.Slot](
+		cache.WithTelemetrySink(in.TelemetrySink),
+	)
+	// The payload IDs are lost along with the payloads being built when the
+	// execution client restarts.
+	in.ExecutionEngine.OnReset(func(reason string) {
+		if count := payloadIDs.Invalidate(); count > 0 {
+			in.Logger.Info(
+				"Cleared cached payload IDs",
+				"reason", reason, "num_payload_ids", count,
+			)
+		}
+	})
+
+	var pc chaos.PayloadCache[PayloadID, [32]byte, math.Slot] = payloadIDs
+	if in.Chaos != nil {
+		pc = chaos.WrapPayloadCache(pc, in.Chaos)
+	}
+	builder := payloadbuilder.New[
 		BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
 		*engineprimitives.PayloadAttributes[WithdrawalT], PayloadID, WithdrawalT,
 	](
@@ -93,10 +120,13 @@ func ProvideLocalBuilder[
 		in.ChainSpec,
 		in.Logger.With("service", "payload-builder"),
 		in.ExecutionEngine,
-		cache.NewPayloadIDCache[
-			PayloadID,
-			[32]byte, math.Slot,
-		](),
+		pc,
 		in.AttributesFactory,
+		in.SlotClock,
+		in.Alerts,
 	)
+	// Blocks the execution client fails to verify count towards tripping
+	// the circuit breaker of the builder.
+	in.ExecutionEngine.OnNewPayload(builder.ObserveNewPayload)
+	return builder
 }
@@ -0,0 +1,106 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"cosmossdk.io/depinject"
+	storev2 "cosmossdk.io/store/v2/db"
+	"github.com/berachain/beacon-kit/config"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/storage/payloadheader"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cast"
+)
+
+// PayloadHeaderStoreInput is the input for the execution payload header
+// store.
+type PayloadHeaderStoreInput struct {
+	depinject.In
+	AppOpts config.AppOptions
+	Config  *config.Config
+}
+
+// ProvidePayloadHeaderStore provides the store of the execution payload
+// headers of the finalized blocks, which is nil if it is disabled.
+func ProvidePayloadHeaderStore[
+	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
+](
+	in PayloadHeaderStoreInput,
+) (*payloadheader.Store[ExecutionPayloadHeaderT], error) {
+	if !in.Config.PayloadHeaderStore.Enabled {
+		return nil, nil
+	}
+	name := "payload_headers"
+	dir := cast.ToString(in.AppOpts.Get(flags.FlagHome)) + "/data"
+	db, err := storev2.NewDB(storev2.DBTypePebbleDB, name, dir, nil)
+	if err != nil {
+		return nil, err
+	}
+	return payloadheader.NewStore[ExecutionPayloadHeaderT](db), nil
+}
+
+// PayloadHeaderStoreServiceInput is the input for the execution payload
+// header service.
+type PayloadHeaderStoreServiceInput[
+	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
+	LoggerT any,
+] struct {
+	depinject.In
+	Dispatcher Dispatcher
+	Logger     LoggerT
+	Store      *payloadheader.Store[ExecutionPayloadHeaderT]
+}
+
+// ProvidePayloadHeaderStoreService provides the service storing the
+// execution payload headers of the finalized blocks.
+func ProvidePayloadHeaderStoreService[
+	BeaconBlockT BeaconBlock[
+		BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
+	],
+	BeaconBlockBodyT BeaconBlockBody[
+		BeaconBlockBodyT, *AttestationData, DepositT,
+		*Eth1Data, ExecutionPayloadT, *SlashingInfo,
+	],
+	BeaconBlockHeaderT any,
+	DepositT any,
+	ExecutionPayloadT ExecutionPayload[
+		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
+	],
+	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
+	LoggerT log.AdvancedLogger[LoggerT],
+	WithdrawalT Withdrawal[WithdrawalT],
+	WithdrawalsT Withdrawals[WithdrawalT],
+](
+	in PayloadHeaderStoreServiceInput[ExecutionPayloadHeaderT, LoggerT],
+) *payloadheader.Service[
+	BeaconBlockT, BeaconBlockBodyT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+] {
+	return payloadheader.NewService[
+		BeaconBlockT,
+		BeaconBlockBodyT,
+		ExecutionPayloadT,
+		ExecutionPayloadHeaderT,
+	](
+		in.Logger.With("service", "payload-header-store"),
+		in.Dispatcher,
+		in.Store,
+	)
+}
@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"path/filepath"
+
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/config"
+	"github.com/berachain/beacon-kit/consensus/cometbft/service/halt"
+	"github.com/berachain/beacon-kit/log"
+	nodeapi "github.com/berachain/beacon-kit/node-api/handlers/node"
+	"github.com/berachain/beacon-kit/observability/profiling"
+	cmtcfg "github.com/cometbft/cometbft/config"
+)
+
+// ProfilingInput is the input for the profiling providers.
+type ProfilingInput[LoggerT any] struct {
+	depinject.In
+	Cfg    *config.Config
+	CmtCfg *cmtcfg.Config
+	Logger LoggerT
+}
+
+// ProvideBlockProfiler provides the profiler capturing CPU profiles of
+// slow blocks.
+func ProvideBlockProfiler[LoggerT log.AdvancedLogger[LoggerT]](
+	in ProfilingInput[LoggerT],
+) *profiling.BlockProfiler {
+	dir := in.Cfg.Profiling.ProfileDir
+	if !filepath.IsAbs(dir) {
+		dir = filepath.Join(in.CmtCfg.RootDir, dir)
+	}
+	return profiling.NewBlockProfiler(
+		in.Cfg.Profiling, dir, in.Logger.With("service", "profiling"),
+	)
+}
+
+// ProfilingServiceInput is the input for the profiling service provider.
+type ProfilingServiceInput[LoggerT any] struct {
+	depinject.In
+	Cfg            *config.Config
+	HaltController *halt.Controller
+	Logger         LoggerT
+}
+
+// ProvideProfilingService provides the service serving the admin listener,
+// with the pprof endpoints if enabled and the halt endpoints.
+func ProvideProfilingService[LoggerT log.AdvancedLogger[LoggerT]](
+	in ProfilingServiceInput[LoggerT],
+) *profiling.Service {
+	svc := profiling.NewService(
+		in.Cfg.Profiling, in.Logger.With("service", "profiling"),
+	)
+	svc.Handle(
+		nodeapi.HaltPath, nodeapi.NewHaltAdminHandler(in.HaltController),
+	)
+	return svc
+}
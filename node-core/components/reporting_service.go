@@ -26,6 +26,8 @@ import (
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/node-core/components/metrics"
 	"github.com/berachain/beacon-kit/node-core/services/version"
+	"github.com/berachain/beacon-kit/observability/identity"
+	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/constraints"
 	sdkversion "github.com/cosmos/cosmos-sdk/version"
 )
@@ -37,6 +39,8 @@ type ReportingServiceInput[
 ] struct {
 	depinject.In
 	Logger        LoggerT
+	ChainSpec     common.ChainSpec
+	NodeIdentity  *identity.Identity
 	TelemetrySink *metrics.TelemetrySink
 	EngineClient  *client.EngineClient[
 		ExecutionPayloadT,
@@ -58,5 +62,7 @@ func ProvideReportingService[
 		in.TelemetrySink,
 		sdkversion.Version,
 		in.EngineClient,
+		in.NodeIdentity,
+		in.ChainSpec.IncompatibleExecutionClients(),
 	)
 }
@@ -23,7 +23,9 @@ package components
 import (
 	"cosmossdk.io/depinject"
 	"github.com/berachain/beacon-kit/beacon/blockchain"
+	"github.com/berachain/beacon-kit/beacon/clock"
 	"github.com/berachain/beacon-kit/beacon/validator"
+	"github.com/berachain/beacon-kit/consensus-types/types"
 	cometbft "github.com/berachain/beacon-kit/consensus/cometbft/service"
 	"github.com/berachain/beacon-kit/consensus/cometbft/service/middleware"
 	"github.com/berachain/beacon-kit/da/da"
@@ -32,11 +34,21 @@ import (
 	"github.com/berachain/beacon-kit/execution/deposit"
 	"github.com/berachain/beacon-kit/log"
 	blockstore "github.com/berachain/beacon-kit/node-api/block_store"
+	eventsapi "github.com/berachain/beacon-kit/node-api/handlers/events"
+	"github.com/berachain/beacon-kit/node-api/indexer"
 	"github.com/berachain/beacon-kit/node-api/server"
+	"github.com/berachain/beacon-kit/node-api/valset"
+	withdrawalstore "github.com/berachain/beacon-kit/node-api/withdrawal_store"
 	"github.com/berachain/beacon-kit/node-core/components/metrics"
 	service "github.com/berachain/beacon-kit/node-core/services/registry"
 	"github.com/berachain/beacon-kit/node-core/services/version"
+	"github.com/berachain/beacon-kit/observability/alerts"
+	"github.com/berachain/beacon-kit/observability/otlp"
+	"github.com/berachain/beacon-kit/observability/profiling"
+	"github.com/berachain/beacon-kit/observability/prometheus"
+	"github.com/berachain/beacon-kit/observability/statsd"
 	"github.com/berachain/beacon-kit/observability/telemetry"
+	"github.com/berachain/beacon-kit/storage/payloadheader"
 )
 
 // ServiceRegistryInput is the input for the service registry provider.
@@ -95,26 +107,47 @@ type ServiceRegistryInput[
 		BeaconBlockT, BeaconBlockBodyT, DepositT,
 		ExecutionPayloadT, WithdrawalCredentials,
 	]
-	Dispatcher   Dispatcher
-	EngineClient *client.EngineClient[
+	DepositPreVerifier *deposit.PreVerifier[*types.Deposit]
+	Dispatcher         Dispatcher
+	EventStream        *eventsapi.Stream
+	EngineClient       *client.EngineClient[
 		ExecutionPayloadT,
 		*engineprimitives.PayloadAttributes[WithdrawalT],
 	]
+	IndexerService *indexer.Service[
+		BeaconBlockT, BeaconBlockBodyT, BeaconStateT, DepositT,
+		ExecutionPayloadT, WithdrawalT, WithdrawalsT, WithdrawalCredentials,
+	]
 	Logger           LoggerT
 	NodeAPIServer    *server.Server[NodeAPIContextT]
 	ReportingService *version.ReportingService[
 		ExecutionPayloadT,
 		*engineprimitives.PayloadAttributes[WithdrawalT],
 	]
-	TelemetrySink    *metrics.TelemetrySink
-	TelemetryService *telemetry.Service
-	ValidatorService *validator.Service[
+	TelemetrySink     *metrics.TelemetrySink
+	TelemetryService  *telemetry.Service
+	PrometheusService *prometheus.Service
+	StatsDService     *statsd.Service
+	OTLPService       *otlp.Service
+	AlertManager      *alerts.Manager
+	SlotClockService  *clock.Service[BeaconBlockT]
+	ProfilingService  *profiling.Service
+	ValidatorService  *validator.Service[
 		*AttestationData, BeaconBlockT, BeaconBlockBodyT,
 		BeaconStateT, BlobSidecarsT, DepositT, DepositStoreT,
 		*Eth1Data, ExecutionPayloadT, ExecutionPayloadHeaderT,
 		*ForkData, *SlashingInfo, *SlotData,
 	]
-	CometBFTService *cometbft.Service[LoggerT]
+	CometBFTService      *cometbft.Service[LoggerT]
+	ValidatorSetExporter *valset.Exporter
+	WithdrawalStore      *withdrawalstore.Service[
+		BeaconBlockT, BeaconBlockBodyT, ExecutionPayloadT,
+		WithdrawalT, WithdrawalsT,
+	]
+	PayloadHeaderStore *payloadheader.Service[
+		BeaconBlockT, BeaconBlockBodyT, ExecutionPayloadT,
+		ExecutionPayloadHeaderT,
+	]
 }
 
 // ProvideServiceRegistry is the depinject provider for the service registry.
@@ -163,16 +196,28 @@ func ProvideServiceRegistry[
 		service.WithLogger(in.Logger),
 		service.WithService(in.ABCIService),
 		service.WithService(in.Dispatcher),
+		service.WithService(in.EventStream),
 		service.WithService(in.ValidatorService),
 		service.WithService(in.BlockStoreService),
+		service.WithService(in.IndexerService),
+		service.WithService(in.ValidatorSetExporter),
+		service.WithService(in.WithdrawalStore),
+		service.WithService(in.PayloadHeaderStore),
 		service.WithService(in.ChainService),
 		service.WithService(in.DAService),
 		service.WithService(in.DepositService),
+		service.WithService(in.DepositPreVerifier),
 		service.WithService(in.NodeAPIServer),
 		service.WithService(in.ReportingService),
 		service.WithService(in.DBManager),
 		service.WithService(in.EngineClient),
 		service.WithService(in.TelemetryService),
+		service.WithService(in.PrometheusService),
+		service.WithService(in.StatsDService),
+		service.WithService(in.OTLPService),
+		service.WithService(in.AlertManager),
+		service.WithService(in.SlotClockService),
+		service.WithService(in.ProfilingService),
 		service.WithService(in.CometBFTService),
 	)
 }
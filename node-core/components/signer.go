@@ -24,6 +24,7 @@ import (
 	"path/filepath"
 
 	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/beacon/validator/lease"
 	"github.com/berachain/beacon-kit/config"
 	"github.com/berachain/beacon-kit/node-core/components/signer"
 	"github.com/berachain/beacon-kit/primitives/constants"
@@ -36,7 +37,8 @@ import (
 type BlsSignerInput struct {
 	depinject.In
 	AppOpts config.AppOptions
-	PrivKey LegacyKey `optional:"true"`
+	Lease   *lease.FileLease `optional:"true"`
+	PrivKey LegacyKey        `optional:"true"`
 }
 
 // ProvideBlsSigner is a function that provides the module to the application.
@@ -58,7 +60,14 @@ func ProvideBlsSigner(in BlsSignerInput) (crypto.BLSSigner, error) {
 		if !filepath.IsAbs(privValStateFile) {
 			privValStateFile = filepath.Join(homeDir, privValStateFile)
 		}
-		return signer.NewBLSSigner(privValKeyFile, privValStateFile), nil
+		blsSigner := signer.NewBLSSigner(privValKeyFile, privValStateFile)
+		// Only sign while holding the signing lease, if any.
+		if in.Lease != nil {
+			blsSigner.PrivValidator = lease.NewPrivValidator(
+				blsSigner.PrivValidator, in.Lease,
+			)
+		}
+		return blsSigner, nil
 	}
 	return signer.NewLegacySigner(in.PrivKey)
 }
@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"os"
+
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/beacon/validator/lease"
+	"github.com/berachain/beacon-kit/config"
+)
+
+// SigningLeaseInput is the input for the signing lease provider.
+type SigningLeaseInput struct {
+	depinject.In
+	Cfg *config.Config
+}
+
+// ProvideSigningLease provides the signing lease shared by redundant nodes
+// running the same validator keys. It is nil if no lease file is configured.
+func ProvideSigningLease(in SigningLeaseInput) (*lease.FileLease, error) {
+	cfg := in.Cfg.Validator
+	if cfg.LeaseFile == "" {
+		return nil, nil //nolint:nilnil // no lease is not an error.
+	}
+	holder := cfg.LeaseHolder
+	if holder == "" {
+		hostname, err := os.Hostname()
+		if err != nil {
+			return nil, err
+		}
+		holder = hostname
+	}
+	return lease.NewFileLease(cfg.LeaseFile, holder, cfg.LeaseDuration), nil
+}
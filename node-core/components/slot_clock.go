@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"time"
+
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/beacon/clock"
+	"github.com/berachain/beacon-kit/config"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	"github.com/berachain/beacon-kit/primitives/common"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+// SlotClockInput is the input for the slot clock provider.
+type SlotClockInput struct {
+	depinject.In
+	ChainSpec common.ChainSpec
+	CmtCfg    *cmtcfg.Config
+}
+
+// ProvideSlotClock provides a slot clock starting at the genesis time of
+// the chain spec, or of the CometBFT genesis if the chain spec leaves it
+// unset.
+func ProvideSlotClock(in SlotClockInput) (*clock.SlotClock, error) {
+	if genesisTime := in.ChainSpec.GenesisTime(); genesisTime != 0 {
+		//#nosec:G701 // genesis times fit in an int64.
+		return clock.New(
+			time.Unix(int64(genesisTime), 0),
+			in.ChainSpec.SlotDuration(),
+		), nil
+	}
+	appGenesis, err := genutiltypes.AppGenesisFromFile(
+		in.CmtCfg.GenesisFile(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return clock.New(
+		appGenesis.GenesisTime, in.ChainSpec.SlotDuration(),
+	), nil
+}
+
+// SlotClockServiceInput is the input for the slot clock service provider.
+type SlotClockServiceInput[LoggerT any] struct {
+	depinject.In
+	Cfg           *config.Config
+	Dispatcher    Dispatcher
+	Logger        LoggerT
+	SlotClock     *clock.SlotClock
+	TelemetrySink *metrics.TelemetrySink
+}
+
+// ProvideSlotClockService provides the service tracking consensus against
+// the slot clock.
+func ProvideSlotClockService[
+	BeaconBlockT BeaconBlock[
+		BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
+	],
+	BeaconBlockBodyT any,
+	BeaconBlockHeaderT any,
+	LoggerT log.AdvancedLogger[LoggerT],
+](
+	in SlotClockServiceInput[LoggerT],
+) *clock.Service[BeaconBlockT] {
+	return clock.NewService[BeaconBlockT](
+		in.Cfg.SlotClock,
+		in.SlotClock,
+		in.Logger.With("service", "slot-clock"),
+		in.Dispatcher,
+		in.TelemetrySink,
+	)
+}
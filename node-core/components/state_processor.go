@@ -22,12 +22,15 @@ package components
 
 import (
 	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/config"
+	"github.com/berachain/beacon-kit/consensus-types/types"
 	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
 	"github.com/berachain/beacon-kit/execution/engine"
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/node-core/components/metrics"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/merkle"
 	"github.com/berachain/beacon-kit/state-transition/core"
 )
 
@@ -35,93 +38,65 @@ import (
 // framework.
 type StateProcessorInput[
 	LoggerT log.AdvancedLogger[LoggerT],
-	ExecutionPayloadT ExecutionPayload[
-		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
-	],
-	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
-	DepositT Deposit[DepositT, *ForkData, WithdrawalCredentials],
-	WithdrawalT Withdrawal[WithdrawalT],
-	WithdrawalsT Withdrawals[WithdrawalT],
 ] struct {
 	depinject.In
 	Logger          LoggerT
+	Cfg             *config.Config
 	ChainSpec       common.ChainSpec
 	ExecutionEngine *engine.Engine[
-		ExecutionPayloadT,
-		*engineprimitives.PayloadAttributes[WithdrawalT],
+		*types.ExecutionPayload,
+		*engineprimitives.PayloadAttributes[*engineprimitives.Withdrawal],
 		PayloadID,
-		WithdrawalsT,
+		engineprimitives.Withdrawals,
 	]
-	DepositStore  DepositStore[DepositT]
-	Signer        crypto.BLSSigner
-	TelemetrySink *metrics.TelemetrySink
+	DepositStore      DepositStore[*types.Deposit]
+	DepositSignatures *core.DepositSignatures
+	Signer            crypto.BLSSigner
+	TelemetrySink     *metrics.TelemetrySink
 }
 
 // ProvideStateProcessor provides the state processor to the depinject
 // framework.
 func ProvideStateProcessor[
 	LoggerT log.AdvancedLogger[LoggerT],
-	BeaconBlockT BeaconBlock[BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT],
-	BeaconBlockBodyT BeaconBlockBody[
-		BeaconBlockBodyT, *AttestationData, DepositT,
-		*Eth1Data, ExecutionPayloadT, *SlashingInfo,
-	],
-	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
 	BeaconStateT BeaconState[
-		BeaconStateT, BeaconBlockHeaderT, BeaconStateMarshallableT,
-		*Eth1Data, ExecutionPayloadHeaderT, *Fork, KVStoreT, *Validator,
-		Validators, WithdrawalT,
+		BeaconStateT, *types.BeaconBlockHeader, BeaconStateMarshallableT,
+		*Eth1Data, *types.ExecutionPayloadHeader, *Fork, KVStoreT, *Validator,
+		Validators, *engineprimitives.Withdrawal,
 	],
 	BeaconStateMarshallableT any,
-	DepositT Deposit[DepositT, *ForkData, WithdrawalCredentials],
-	DepositStoreT DepositStore[DepositT],
-	ExecutionPayloadT ExecutionPayload[
-		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
-	],
-	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
 	KVStoreT BeaconStore[
-		KVStoreT, BeaconBlockHeaderT, *Eth1Data, ExecutionPayloadHeaderT,
-		*Fork, *Validator, Validators, WithdrawalT,
+		KVStoreT, *types.BeaconBlockHeader, *Eth1Data,
+		*types.ExecutionPayloadHeader, *Fork, *Validator, Validators,
+		*engineprimitives.Withdrawal,
 	],
-	WithdrawalsT Withdrawals[WithdrawalT],
-	WithdrawalT Withdrawal[WithdrawalT],
 ](
-	in StateProcessorInput[
-		LoggerT,
-		ExecutionPayloadT, ExecutionPayloadHeaderT,
-		DepositT, WithdrawalT, WithdrawalsT,
-	],
-) *core.StateProcessor[
-	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
-	BeaconStateT, *Context, DepositT, *Eth1Data, ExecutionPayloadT,
-	ExecutionPayloadHeaderT, *Fork, *ForkData, KVStoreT, *Validator,
-	Validators, WithdrawalT, WithdrawalsT, WithdrawalCredentials,
-] {
-	return core.NewStateProcessor[
-		BeaconBlockT,
-		BeaconBlockBodyT,
-		BeaconBlockHeaderT,
-		BeaconStateT,
-		*Context,
-		DepositT,
-		*Eth1Data,
-		ExecutionPayloadT,
-		ExecutionPayloadHeaderT,
-		*Fork,
-		*ForkData,
-		KVStoreT,
-		*Validator,
-		Validators,
-		WithdrawalT,
-		WithdrawalsT,
-		WithdrawalCredentials,
-	](
-		in.Logger.With("service", "state-processor"),
-		in.ChainSpec,
-		in.ExecutionEngine,
-		in.DepositStore,
-		in.Signer,
-		crypto.GetAddressFromPubKey,
-		in.TelemetrySink,
-	)
+	in StateProcessorInput[LoggerT],
+) (*core.StateProcessor[BeaconStateT, *Context, KVStoreT], error) {
+	opts := []core.Option{
+		core.WithLogger(in.Logger.With("service", "state-processor")),
+		core.WithForkSchedule(in.ChainSpec),
+		core.WithExecutionEngine(in.ExecutionEngine),
+		core.WithDepositStore(in.DepositStore),
+		core.WithDepositSignatures(in.DepositSignatures),
+		core.WithSigner(in.Signer),
+		core.WithTelemetry(in.TelemetrySink),
+		core.WithStateHasher(
+			merkle.NewParallelHasher(in.Cfg.StateHash.Workers),
+		),
+	}
+	// Debug builds halt at the first block violating the state invariants.
+	if core.InvariantChecksEnabled {
+		opts = append(opts, core.WithInvariantChecks())
+	}
+	return core.NewStateProcessor[BeaconStateT, *Context, KVStoreT](opts...)
+}
+
+// ProvideDepositSignatures provides the verifier of the deposit signatures
+// shared by the state processor and the deposit pre-verifier.
+func ProvideDepositSignatures(
+	chainSpec common.ChainSpec,
+	signer crypto.BLSSigner,
+) *core.DepositSignatures {
+	return core.NewDepositSignatures(chainSpec, signer)
 }
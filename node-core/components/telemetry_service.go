@@ -21,13 +21,79 @@
 package components
 
 import (
-	"github.com/berachain/beacon-kit/config/config"
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/config"
+	cmtconfig "github.com/berachain/beacon-kit/config/config"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/observability/otlp"
+	"github.com/berachain/beacon-kit/observability/prometheus"
+	"github.com/berachain/beacon-kit/observability/statsd"
 	"github.com/berachain/beacon-kit/observability/telemetry"
 )
 
 // ProvideTelemetryService is a function that provides a TelemetrySink.
 func ProvideTelemetryService(
-	cfg *config.Config,
+	cfg *cmtconfig.Config,
 ) (*telemetry.Service, error) {
 	return telemetry.NewService(&cfg.Telemetry)
 }
+
+// PrometheusServiceInput is the input for the Prometheus service provider.
+type PrometheusServiceInput[LoggerT any] struct {
+	depinject.In
+	Cfg            *config.Config
+	Logger         LoggerT
+	PrometheusSink *prometheus.Sink
+}
+
+// ProvidePrometheusService provides the service serving the Prometheus
+// metrics endpoint.
+func ProvidePrometheusService[LoggerT log.AdvancedLogger[LoggerT]](
+	in PrometheusServiceInput[LoggerT],
+) *prometheus.Service {
+	return prometheus.NewService(
+		in.Cfg.Prometheus,
+		in.PrometheusSink,
+		in.Logger.With("service", "prometheus"),
+	)
+}
+
+// StatsDServiceInput is the input for the StatsD service provider.
+type StatsDServiceInput[LoggerT any] struct {
+	depinject.In
+	Cfg        *config.Config
+	Logger     LoggerT
+	StatsDSink *statsd.Sink
+}
+
+// ProvideStatsDService provides the service sending the metrics to a StatsD
+// agent.
+func ProvideStatsDService[LoggerT log.AdvancedLogger[LoggerT]](
+	in StatsDServiceInput[LoggerT],
+) *statsd.Service {
+	return statsd.NewService(
+		in.Cfg.StatsD,
+		in.StatsDSink,
+		in.Logger.With("service", "statsd"),
+	)
+}
+
+// OTLPServiceInput is the input for the OTLP service provider.
+type OTLPServiceInput[LoggerT any] struct {
+	depinject.In
+	Cfg      *config.Config
+	Logger   LoggerT
+	OTLPSink *otlp.Sink
+}
+
+// ProvideOTLPService provides the service exporting the metrics to an OTLP
+// collector.
+func ProvideOTLPService[LoggerT log.AdvancedLogger[LoggerT]](
+	in OTLPServiceInput[LoggerT],
+) *otlp.Service {
+	return otlp.NewService(
+		in.Cfg.OTLP,
+		in.OTLPSink,
+		in.Logger.With("service", "otlp"),
+	)
+}
@@ -20,9 +20,76 @@
 
 package components
 
-import "github.com/berachain/beacon-kit/node-core/components/metrics"
+import (
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/config"
+	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	"github.com/berachain/beacon-kit/observability/identity"
+	"github.com/berachain/beacon-kit/observability/otlp"
+	"github.com/berachain/beacon-kit/observability/prometheus"
+	"github.com/berachain/beacon-kit/observability/statsd"
+)
 
-// ProvideTelemetrySink is a function that provides a TelemetrySink.
-func ProvideTelemetrySink() *metrics.TelemetrySink {
-	return &metrics.TelemetrySink{}
+// TelemetrySinkInput is the input for the telemetry sink provider.
+type TelemetrySinkInput struct {
+	depinject.In
+	Identity       *identity.Identity
+	OTLPSink       *otlp.Sink
+	PrometheusSink *prometheus.Sink
+	StatsDSink     *statsd.Sink
+}
+
+// ProvideTelemetrySink is a function that provides a TelemetrySink recording
+// into the enabled backends. Every metric is labeled with the identity of
+// the node.
+func ProvideTelemetrySink(in TelemetrySinkInput) *metrics.TelemetrySink {
+	var backends []metrics.Backend
+	if in.PrometheusSink != nil {
+		backends = append(backends, in.PrometheusSink)
+	}
+	if in.StatsDSink != nil {
+		backends = append(backends, in.StatsDSink)
+	}
+	if in.OTLPSink != nil {
+		backends = append(backends, in.OTLPSink)
+	}
+	sink := metrics.NewTelemetrySink(backends...).
+		WithLabels(in.Identity.Labels()...)
+
+	// Publish the identity of the node as an info metric.
+	sink.SetGauge("beacon_kit.node.info", 1)
+	return &sink
+}
+
+// MetricsBackendInput is the input for the metrics backend providers.
+type MetricsBackendInput struct {
+	depinject.In
+	Cfg *config.Config
+}
+
+// ProvidePrometheusSink provides the Prometheus sink metrics are recorded
+// into, or nil if the Prometheus endpoint is disabled.
+func ProvidePrometheusSink(in MetricsBackendInput) *prometheus.Sink {
+	if !in.Cfg.Prometheus.Enabled {
+		return nil
+	}
+	return prometheus.NewSink(in.Cfg.Prometheus.Namespace)
+}
+
+// ProvideStatsDSink provides the StatsD sink metrics are recorded into, or
+// nil if pushing metrics to StatsD is disabled.
+func ProvideStatsDSink(in MetricsBackendInput) *statsd.Sink {
+	if !in.Cfg.StatsD.Enabled {
+		return nil
+	}
+	return statsd.NewSink(in.Cfg.StatsD.Datadog)
+}
+
+// ProvideOTLPSink provides the OTLP sink metrics are recorded into, or nil
+// if exporting metrics to an OTLP collector is disabled.
+func ProvideOTLPSink(in MetricsBackendInput) *otlp.Sink {
+	if !in.Cfg.OTLP.Enabled {
+		return nil
+	}
+	return otlp.NewSink(in.Cfg.OTLP.ServiceName)
 }
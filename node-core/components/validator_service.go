@@ -21,13 +21,18 @@
 package components
 
 import (
+	"context"
+
 	"cosmossdk.io/depinject"
 	"github.com/berachain/beacon-kit/beacon/validator"
+	"github.com/berachain/beacon-kit/beacon/validator/lease"
 	"github.com/berachain/beacon-kit/config"
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/node-core/components/metrics"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/crypto"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	"github.com/ethereum/go-ethereum"
 )
 
 // ValidatorServiceInput is the input for the validator service provider.
@@ -37,6 +42,7 @@ type ValidatorServiceInput[
 	BeaconStateT any,
 	BlobSidecarsT any,
 	DepositT any,
+	EngineClientT SyncReader,
 	ExecutionPayloadT ExecutionPayload[
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
 	],
@@ -49,9 +55,13 @@ type ValidatorServiceInput[
 	depinject.In
 	Cfg            *config.Config
 	ChainSpec      common.ChainSpec
+	CmtCfg         *cmtcfg.Config
 	Dispatcher     Dispatcher
+	EngineClient   EngineClientT
+	Lease          *lease.FileLease
 	LocalBuilder   LocalBuilder[BeaconStateT, ExecutionPayloadT]
 	Logger         LoggerT
+	OperationPool  validator.OperationPool `optional:"true"`
 	StateProcessor StateProcessor[
 		BeaconBlockT, BeaconStateT, *Context, DepositT, ExecutionPayloadHeaderT,
 	]
@@ -82,6 +92,7 @@ func ProvideValidatorService[
 	BlobSidecarsT any,
 	DepositT any,
 	DepositStoreT DepositStore[DepositT],
+	EngineClientT SyncReader,
 	ExecutionPayloadT ExecutionPayload[
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
 	],
@@ -96,8 +107,9 @@ func ProvideValidatorService[
 ](
 	in ValidatorServiceInput[
 		AvailabilityStoreT, BeaconBlockT, BeaconStateT,
-		BlobSidecarsT, DepositT, ExecutionPayloadT, ExecutionPayloadHeaderT,
-		LoggerT, StorageBackendT, WithdrawalT, WithdrawalsT,
+		BlobSidecarsT, DepositT, EngineClientT, ExecutionPayloadT,
+		ExecutionPayloadHeaderT, LoggerT, StorageBackendT, WithdrawalT,
+		WithdrawalsT,
 	],
 ) (*validator.Service[
 	*AttestationData, BeaconBlockT, BeaconBlockBodyT,
@@ -105,6 +117,20 @@ func ProvideValidatorService[
 	*Eth1Data, ExecutionPayloadT, ExecutionPayloadHeaderT,
 	*ForkData, *SlashingInfo, *SlotData,
 ], error) {
+	// The payload must be delivered before CometBFT gives up on the
+	// proposal.
+	cfg := in.Cfg.Validator
+
+	// Refuse to propose early on a node not holding the signing lease.
+	var proposalLease validator.ProposalLease
+	if in.Lease != nil {
+		proposalLease = in.Lease
+	}
+	if timeout := in.CmtCfg.Consensus.TimeoutPropose; timeout > 0 &&
+		cfg.PayloadDeadline > timeout {
+		cfg.PayloadDeadline = timeout
+	}
+
 	// Build the builder service.
 	return validator.NewService[
 		*AttestationData,
@@ -121,7 +147,7 @@ func ProvideValidatorService[
 		*SlashingInfo,
 		*SlotData,
 	](
-		&in.Cfg.Validator,
+		&cfg,
 		in.Logger.With("service", "validator"),
 		in.ChainSpec,
 		in.StorageBackend,
@@ -132,7 +158,37 @@ func ProvideValidatorService[
 		[]validator.PayloadBuilder[BeaconStateT, ExecutionPayloadT]{
 			in.LocalBuilder,
 		},
+		&executionSyncer{
+			executionObserver: executionObserver{client: in.EngineClient},
+			client:            in.EngineClient,
+		},
+		proposalLease,
+		in.OperationPool,
 		in.TelemetrySink,
 		in.Dispatcher,
 	), nil
 }
+
+// SyncReader reads the sync status and head of the execution client.
+type SyncReader interface {
+	HeaderReader
+	// SyncProgress returns the sync progress of the execution client, or
+	// nil if it is not syncing.
+	SyncProgress(context.Context) (*ethereum.SyncProgress, error)
+}
+
+// executionSyncer reports whether the execution client is ready to build on
+// the beacon head.
+type executionSyncer struct {
+	executionObserver
+	client SyncReader
+}
+
+// IsSyncing implements validator.ExecutionSyncer.
+func (s *executionSyncer) IsSyncing(ctx context.Context) (bool, error) {
+	progress, err := s.client.SyncProgress(ctx)
+	if err != nil {
+		return false, err
+	}
+	return progress != nil, nil
+}
@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"cosmossdk.io/depinject"
+	storev2 "cosmossdk.io/store/v2/db"
+	"github.com/berachain/beacon-kit/config"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/node-api/valset"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cast"
+)
+
+// ValidatorSetExporterInput is the input for the validator set exporter.
+type ValidatorSetExporterInput[
+	LoggerT any,
+] struct {
+	depinject.In
+	AppOpts    config.AppOptions
+	Config     *config.Config
+	Dispatcher Dispatcher
+	Logger     LoggerT
+}
+
+// ProvideValidatorSetExporter provides the exporter pushing the validator
+// set updates to webhooks.
+func ProvideValidatorSetExporter[
+	LoggerT log.AdvancedLogger[LoggerT],
+](
+	in ValidatorSetExporterInput[LoggerT],
+) (*valset.Exporter, error) {
+	// The outbox is only opened if the export is enabled.
+	var outbox *valset.Outbox
+	if in.Config.ValidatorSetExport.Enabled {
+		name := "validator-set-export"
+		dir := cast.ToString(in.AppOpts.Get(flags.FlagHome)) + "/data"
+		db, err := storev2.NewDB(storev2.DBTypePebbleDB, name, dir, nil)
+		if err != nil {
+			return nil, err
+		}
+		if outbox, err = valset.NewOutbox(db); err != nil {
+			return nil, err
+		}
+	}
+	return valset.NewExporter(
+		in.Config.ValidatorSetExport,
+		in.Logger.With("service", "validator-set-export"),
+		in.Dispatcher,
+		outbox,
+	)
+}
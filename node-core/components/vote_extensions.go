@@ -0,0 +1,122 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"context"
+	"math/big"
+
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/config"
+	"github.com/berachain/beacon-kit/consensus/cometbft/service/voteext"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	cmtcrypto "github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/crypto/bls12381"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// VoteExtensionInput is the input for the vote extension handler.
+type VoteExtensionInput[
+	EngineClientT HeaderReader,
+	KVStoreT ValidatorReader[KVStoreT],
+	LoggerT log.AdvancedLogger[LoggerT],
+] struct {
+	depinject.In
+	Config       *config.Config
+	EngineClient EngineClientT
+	KVStore      KVStoreT
+	Logger       LoggerT
+}
+
+// HeaderReader reads execution block headers.
+type HeaderReader interface {
+	// HeaderByNumber returns the header of the block with the given number,
+	// or of the latest block if number is nil.
+	HeaderByNumber(context.Context, *big.Int) (*ethtypes.Header, error)
+}
+
+// ValidatorReader reads the validator registry from the beacon state.
+type ValidatorReader[T any] interface {
+	// WithContext returns a copy of the store with the given context.
+	WithContext(context.Context) T
+	// GetValidators retrieves all validators.
+	GetValidators() (Validators, error)
+}
+
+// ProvideVoteExtensionHandler provides the handler producing and verifying
+// vote extensions.
+func ProvideVoteExtensionHandler[
+	EngineClientT HeaderReader,
+	KVStoreT ValidatorReader[KVStoreT],
+	LoggerT log.AdvancedLogger[LoggerT],
+](
+	in VoteExtensionInput[EngineClientT, KVStoreT, LoggerT],
+) *voteext.Handler {
+	return voteext.NewHandler(
+		in.Config.VoteExtensions,
+		in.Logger.With("service", "vote-extensions"),
+		&executionObserver{client: in.EngineClient},
+		&validatorStore[KVStoreT]{kv: in.KVStore},
+	)
+}
+
+// executionObserver reports the execution head seen by the execution
+// client.
+type executionObserver struct {
+	client HeaderReader
+}
+
+// LatestExecutionHead implements voteext.Observer.
+func (o *executionObserver) LatestExecutionHead(
+	ctx context.Context,
+) (math.U64, common.ExecutionHash, error) {
+	header, err := o.client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return 0, common.ExecutionHash{}, err
+	}
+	return math.U64(header.Number.Uint64()),
+		common.ExecutionHash(header.Hash()), nil
+}
+
+// validatorStore resolves CometBFT addresses against the consensus keys of
+// the validators in the beacon state.
+type validatorStore[KVStoreT ValidatorReader[KVStoreT]] struct {
+	kv KVStoreT
+}
+
+// PubKeysByAddress implements voteext.ValidatorStore.
+func (s *validatorStore[_]) PubKeysByAddress(
+	ctx context.Context,
+) (map[string]cmtcrypto.PubKey, error) {
+	validators, err := s.kv.WithContext(ctx).GetValidators()
+	if err != nil {
+		return nil, err
+	}
+	pubKeys := make(map[string]cmtcrypto.PubKey, len(validators))
+	for _, val := range validators {
+		pk := val.GetPubkey()
+		pubKey := bls12381.PubKey(pk[:])
+		pubKeys[string(pubKey.Address())] = pubKey
+	}
+	return pubKeys, nil
+}
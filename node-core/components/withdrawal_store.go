@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"cosmossdk.io/depinject"
+	storev2 "cosmossdk.io/store/v2/db"
+	"github.com/berachain/beacon-kit/config"
+	"github.com/berachain/beacon-kit/log"
+	withdrawalstore "github.com/berachain/beacon-kit/node-api/withdrawal_store"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cast"
+)
+
+// WithdrawalStoreInput is the input for the withdrawal receipts store.
+type WithdrawalStoreInput struct {
+	depinject.In
+	AppOpts config.AppOptions
+	Config  *config.Config
+}
+
+// ProvideWithdrawalStore provides the store indexing the withdrawal
+// receipts, which is nil if the index is disabled.
+func ProvideWithdrawalStore(
+	in WithdrawalStoreInput,
+) (*withdrawalstore.Store, error) {
+	if !in.Config.WithdrawalStore.Enabled {
+		return nil, nil
+	}
+	name := "withdrawals"
+	dir := cast.ToString(in.AppOpts.Get(flags.FlagHome)) + "/data"
+	db, err := storev2.NewDB(storev2.DBTypePebbleDB, name, dir, nil)
+	if err != nil {
+		return nil, err
+	}
+	return withdrawalstore.NewStore(db), nil
+}
+
+// WithdrawalStoreServiceInput is the input for the withdrawal receipts
+// service.
+type WithdrawalStoreServiceInput[
+	LoggerT any,
+] struct {
+	depinject.In
+	Dispatcher Dispatcher
+	Logger     LoggerT
+	Store      *withdrawalstore.Store
+}
+
+// ProvideWithdrawalStoreService provides the service indexing the
+// withdrawals of the finalized blocks.
+func ProvideWithdrawalStoreService[
+	BeaconBlockT BeaconBlock[
+		BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
+	],
+	BeaconBlockBodyT BeaconBlockBody[
+		BeaconBlockBodyT, *AttestationData, DepositT,
+		*Eth1Data, ExecutionPayloadT, *SlashingInfo,
+	],
+	BeaconBlockHeaderT any,
+	DepositT any,
+	ExecutionPayloadT ExecutionPayload[
+		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
+	],
+	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
+	LoggerT log.AdvancedLogger[LoggerT],
+	WithdrawalT Withdrawal[WithdrawalT],
+	WithdrawalsT Withdrawals[WithdrawalT],
+](
+	in WithdrawalStoreServiceInput[LoggerT],
+) *withdrawalstore.Service[
+	BeaconBlockT, BeaconBlockBodyT, ExecutionPayloadT,
+	WithdrawalT, WithdrawalsT,
+] {
+	return withdrawalstore.NewService[
+		BeaconBlockT,
+		BeaconBlockBodyT,
+		ExecutionPayloadT,
+		WithdrawalT,
+		WithdrawalsT,
+	](
+		in.Logger.With("service", "withdrawal-store"),
+		in.Dispatcher,
+		in.Store,
+	)
+}
@@ -24,6 +24,7 @@ import (
 	"context"
 	"fmt"
 	"runtime"
+	"strings"
 	"time"
 
 	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
@@ -31,6 +32,7 @@ import (
 	"github.com/berachain/beacon-kit/execution/client"
 	"github.com/berachain/beacon-kit/execution/client/ethclient"
 	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/observability/identity"
 	"github.com/berachain/beacon-kit/primitives/constraints"
 )
 
@@ -54,6 +56,11 @@ type ReportingService[
 	sink TelemetrySink
 	// client to query the execution layer
 	client *client.EngineClient[ExecutionPayloadT, PayloadAttributesT]
+	// nodeIdentity is updated with the execution client on every report.
+	nodeIdentity *identity.Identity
+	// incompatibleClients lists the execution clients known to be
+	// incompatible with the chain, as "<name>/<version prefix>" entries.
+	incompatibleClients []string
 }
 
 // NewReportingService creates a new VersionReporterService.
@@ -65,17 +72,21 @@ func NewReportingService[
 	telemetrySink TelemetrySink,
 	version string,
 	engineClient *client.EngineClient[ExecutionPayloadT, PayloadAttributesT],
+	nodeIdentity *identity.Identity,
+	incompatibleClients []string,
 ) *ReportingService[
 	ExecutionPayloadT, PayloadAttributesT,
 ] {
 	return &ReportingService[
 		ExecutionPayloadT, PayloadAttributesT,
 	]{
-		logger:            logger,
-		version:           version,
-		reportingInterval: defaultReportingInterval,
-		sink:              telemetrySink,
-		client:            engineClient,
+		logger:              logger,
+		version:             version,
+		reportingInterval:   defaultReportingInterval,
+		sink:                telemetrySink,
+		client:              engineClient,
+		nodeIdentity:        nodeIdentity,
+		incompatibleClients: incompatibleClients,
 	}
 }
 
@@ -187,8 +198,7 @@ func (rs *ReportingService[_, _]) GetEthVersion(
 			return ethVersion, errors.New("no client version returned")
 		}
 
-		ethVersion.Version = info[0].Version
-		ethVersion.Name = info[0].Name
+		ethVersion = info[0]
 	} else {
 		rs.logger.Warn("Client does not have capability to get client version")
 	}
@@ -198,6 +208,24 @@ func (rs *ReportingService[_, _]) GetEthVersion(
 
 func (rs *ReportingService[_, _]) logTelemetry(
 	ethVersion engineprimitives.ClientVersionV1) {
+	rs.nodeIdentity.SetExecutionClient(identity.ExecutionClient{
+		Code:    ethVersion.Code,
+		Name:    ethVersion.Name,
+		Version: ethVersion.Version,
+		Commit:  ethVersion.Commit,
+	})
+	if entry, ok := IsIncompatible(
+		ethVersion, rs.incompatibleClients,
+	); ok {
+		rs.logger.Warn(
+			"Execution client is known to be incompatible with the chain, "+
+				"please upgrade it",
+			"eth_name", ethVersion.Name,
+			"eth_version", ethVersion.Version,
+			"incompatible", entry,
+		)
+	}
+
 	systemInfo := runtime.GOOS + "/" + runtime.GOARCH
 
 	// TODO: Delete this counter as it should be included in the new
@@ -222,3 +250,30 @@ func (rs *ReportingService[_, _]) logTelemetry(
 	}
 	rs.sink.SetGauge("beacon_kit.runtime.version", 1, args[:]...)
 }
+
+// IsIncompatible returns the first "<name>/<version prefix>" entry that the
+// given execution client matches, if any. Names are matched against either
+// the name or the code of the client, ignoring case, and versions ignore a
+// leading "v". An entry without a version matches every version.
+func IsIncompatible(
+	ethVersion engineprimitives.ClientVersionV1,
+	entries []string,
+) (string, bool) {
+	version := normalizeVersion(ethVersion.Version)
+	for _, entry := range entries {
+		name, prefix, _ := strings.Cut(entry, "/")
+		if !strings.EqualFold(name, ethVersion.Name) &&
+			!strings.EqualFold(name, ethVersion.Code) {
+			continue
+		}
+		if strings.HasPrefix(version, normalizeVersion(prefix)) {
+			return entry, true
+		}
+	}
+	return "", false
+}
+
+// normalizeVersion lowercases the given version and strips its leading "v".
+func normalizeVersion(version string) string {
+	return strings.TrimPrefix(strings.ToLower(version), "v")
+}
@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package version_test
+
+import (
+	"testing"
+
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/node-core/services/version"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsIncompatible(t *testing.T) {
+	geth := engineprimitives.ClientVersionV1{
+		Code:    "GE",
+		Name:    "Geth",
+		Version: "v1.14.3-stable",
+	}
+	entries := []string{"reth/1.0", "geth/v1.14.3"}
+
+	entry, ok := version.IsIncompatible(geth, entries)
+	require.True(t, ok)
+	require.Equal(t, "geth/v1.14.3", entry)
+
+	entry, ok = version.IsIncompatible(geth, []string{"GE"})
+	require.True(t, ok)
+	require.Equal(t, "GE", entry)
+
+	_, ok = version.IsIncompatible(geth, []string{"geth/1.14.4", "nethermind"})
+	require.False(t, ok)
+
+	_, ok = version.IsIncompatible(geth, nil)
+	require.False(t, ok)
+}
@@ -0,0 +1,147 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package alerts
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// ErrUnknownFormat is returned when the configured webhook format is not
+// supported.
+var ErrUnknownFormat = errors.New("unknown alert webhook format")
+
+// Condition is a consensus-critical condition that can raise an alert.
+type Condition string
+
+const (
+	// StateRootMismatch is raised when incoming blocks are repeatedly
+	// rejected because their state root does not match the local one.
+	StateRootMismatch Condition = "state_root_mismatch"
+	// ELUnavailable is raised when the execution client fails forkchoice
+	// updates for several consecutive slots.
+	ELUnavailable Condition = "execution_client_unavailable"
+	// MissedProposal is raised when the local validator fails to build
+	// the blocks it was asked to propose.
+	MissedProposal Condition = "missed_proposal"
+	// DepositDivergence is raised when incoming blocks carry deposits that
+	// diverge from the local deposit store.
+	DepositDivergence Condition = "deposit_divergence"
+	// PayloadBuilderCircuitOpen is raised when the payload builder stops
+	// building payloads ahead of time because the execution client keeps
+	// failing.
+	PayloadBuilderCircuitOpen Condition = "payload_builder_circuit_open"
+)
+
+// Severity returns the PagerDuty severity of the condition.
+func (c Condition) Severity() string {
+	switch c {
+	case StateRootMismatch, DepositDivergence:
+		return "critical"
+	case ELUnavailable, PayloadBuilderCircuitOpen:
+		return "error"
+	default:
+		return "warning"
+	}
+}
+
+// Alert is a single firing of a condition.
+type Alert struct {
+	// Condition is the condition that fired.
+	Condition Condition
+	// Slot is the slot at which the threshold was reached.
+	Slot math.Slot
+	// Count is the number of consecutive occurrences of the condition.
+	Count uint64
+	// Err is the last error observed for the condition, if any.
+	Err error
+	// Time is the time at which the alert fired.
+	Time time.Time
+}
+
+// Summary returns a human readable, single line description of the alert.
+func (a Alert) Summary() string {
+	summary := fmt.Sprintf(
+		"beacon-kit %s: %d consecutive occurrences at slot %d",
+		a.Condition, a.Count, a.Slot.Unwrap(),
+	)
+	if a.Err != nil {
+		summary += ": " + a.Err.Error()
+	}
+	return summary
+}
+
+// slackMessage is the payload of a Slack incoming webhook.
+type slackMessage struct {
+	Text string `json:"text"`
+}
+
+// pagerDutyEvent is the payload of a PagerDuty Events API v2 event.
+type pagerDutyEvent struct {
+	RoutingKey  string           `json:"routing_key"`
+	EventAction string           `json:"event_action"`
+	DedupKey    string           `json:"dedup_key"`
+	Payload     pagerDutyPayload `json:"payload"`
+}
+
+// pagerDutyPayload is the body of a PagerDuty event.
+type pagerDutyPayload struct {
+	Summary       string         `json:"summary"`
+	Source        string         `json:"source"`
+	Severity      string         `json:"severity"`
+	Timestamp     string         `json:"timestamp"`
+	Component     string         `json:"component"`
+	CustomDetails map[string]any `json:"custom_details"`
+}
+
+// Encode encodes the alert as the webhook payload of the given format.
+func (a Alert) Encode(cfg Config, source string) ([]byte, error) {
+	switch cfg.Format {
+	case FormatSlack:
+		return json.Marshal(slackMessage{
+			Text: ":rotating_light: [" + source + "] " + a.Summary(),
+		})
+	case FormatPagerDuty:
+		return json.Marshal(pagerDutyEvent{
+			RoutingKey:  cfg.RoutingKey,
+			EventAction: "trigger",
+			DedupKey:    source + "/" + string(a.Condition),
+			Payload: pagerDutyPayload{
+				Summary:   a.Summary(),
+				Source:    source,
+				Severity:  a.Condition.Severity(),
+				Timestamp: a.Time.UTC().Format(time.RFC3339),
+				Component: "beacon-kit",
+				CustomDetails: map[string]any{
+					"condition": a.Condition,
+					"slot":      a.Slot.Unwrap(),
+					"count":     a.Count,
+				},
+			},
+		})
+	default:
+		return nil, errors.Wrap(ErrUnknownFormat, cfg.Format)
+	}
+}
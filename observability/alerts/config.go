@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package alerts
+
+import "time"
+
+const (
+	// FormatSlack sends alerts as Slack incoming webhook messages.
+	FormatSlack = "slack"
+	// FormatPagerDuty sends alerts as PagerDuty Events API v2 events.
+	FormatPagerDuty = "pagerduty"
+
+	defaultStateRootMismatches = 3
+	defaultELOfflineSlots      = 10
+	defaultMissedProposals     = 1
+	defaultDepositDivergences  = 1
+	defaultCooldown            = 10 * time.Minute
+)
+
+// Config is the configuration for the alerting webhooks.
+type Config struct {
+	// Enabled is the flag to enable alerting.
+	Enabled bool `mapstructure:"enabled"`
+	// WebhookURL is the URL alerts are posted to.
+	WebhookURL string `mapstructure:"webhook-url"`
+	// Format is the payload format of the webhook, either "slack" or
+	// "pagerduty".
+	Format string `mapstructure:"format"`
+	// RoutingKey is the PagerDuty integration key, only used with the
+	// "pagerduty" format.
+	RoutingKey string `mapstructure:"routing-key"`
+	// StateRootMismatches is the number of consecutive incoming
+	// blocks rejected for a state root mismatch before alerting.
+	StateRootMismatches uint64 `mapstructure:"state-root-mismatches"`
+	// ELOfflineSlots is the number of consecutive slots the
+	// execution client must fail forkchoice updates before alerting.
+	ELOfflineSlots uint64 `mapstructure:"el-offline-slots"`
+	// MissedProposals is the number of consecutive proposals the
+	// local validator must fail to build before alerting.
+	MissedProposals uint64 `mapstructure:"missed-proposals"`
+	// DepositDivergences is the number of consecutive incoming
+	// blocks rejected for diverging deposits before alerting.
+	DepositDivergences uint64 `mapstructure:"deposit-divergences"`
+	// Cooldown is the minimum time between two alerts for the same
+	// condition.
+	Cooldown time.Duration `mapstructure:"cooldown"`
+}
+
+// DefaultConfig returns the default configuration for the alerting
+// webhooks.
+func DefaultConfig() Config {
+	return Config{
+		Enabled:             false,
+		WebhookURL:          "",
+		Format:              FormatSlack,
+		RoutingKey:          "",
+		StateRootMismatches: defaultStateRootMismatches,
+		ELOfflineSlots:      defaultELOfflineSlots,
+		MissedProposals:     defaultMissedProposals,
+		DepositDivergences:  defaultDepositDivergences,
+		Cooldown:            defaultCooldown,
+	}
+}
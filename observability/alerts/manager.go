@@ -0,0 +1,277 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package alerts
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	asynctypes "github.com/berachain/beacon-kit/async/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/primitives/async"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/state-transition/core"
+)
+
+const (
+	// queueSize is the number of alerts buffered for delivery.
+	queueSize = 16
+	// deliveryTimeout bounds the time to deliver a single alert.
+	deliveryTimeout = 10 * time.Second
+)
+
+var (
+	// ErrMissingWebhookURL is returned when alerting is enabled without a
+	// webhook to deliver alerts to.
+	ErrMissingWebhookURL = errors.New("alerts enabled without a webhook url")
+	// ErrUnexpectedStatus is returned when the webhook responds with a non
+	// 2xx status code.
+	ErrUnexpectedStatus = errors.New("unexpected webhook response status")
+)
+
+// Manager tracks consensus-critical conditions and fires a webhook when one
+// of them crosses its configured threshold. All methods are no-ops when
+// alerting is disabled.
+type Manager struct {
+	cfg    Config
+	logger log.Logger
+	client *http.Client
+	source string
+	queue  chan Alert
+	// subProposals is the channel holding ProposalBuilt events, nil until
+	// the manager subscribes to them.
+	subProposals chan async.Event[async.Proposal]
+
+	mu        sync.Mutex
+	counts    map[Condition]uint64
+	lastFired map[Condition]time.Time
+}
+
+// NewManager creates a new alert manager.
+func NewManager(cfg Config, logger log.Logger) (*Manager, error) {
+	if cfg.Enabled {
+		if cfg.WebhookURL == "" {
+			return nil, ErrMissingWebhookURL
+		}
+		if cfg.Format != FormatSlack && cfg.Format != FormatPagerDuty {
+			return nil, errors.Wrap(ErrUnknownFormat, cfg.Format)
+		}
+	}
+
+	source, err := os.Hostname()
+	if err != nil || source == "" {
+		source = "beacon-kit"
+	}
+
+	return &Manager{
+		cfg:       cfg,
+		logger:    logger,
+		client:    &http.Client{Timeout: deliveryTimeout},
+		source:    source,
+		queue:     make(chan Alert, queueSize),
+		counts:    make(map[Condition]uint64),
+		lastFired: make(map[Condition]time.Time),
+	}, nil
+}
+
+// Name returns the name of the alerts service.
+func (m *Manager) Name() string {
+	return "alerts"
+}
+
+// SubscribeProposals subscribes the manager to ProposalBuilt events, which
+// are observed once the manager is started. It is a no-op when alerting is
+// disabled.
+func (m *Manager) SubscribeProposals(
+	dispatcher asynctypes.EventDispatcher,
+) error {
+	if !m.cfg.Enabled {
+		return nil
+	}
+	sub := make(chan async.Event[async.Proposal])
+	if err := dispatcher.Subscribe(async.ProposalBuilt, sub); err != nil {
+		return err
+	}
+	m.subProposals = sub
+	return nil
+}
+
+// Start delivers fired alerts to the webhook until the context is done.
+func (m *Manager) Start(ctx context.Context) error {
+	if !m.cfg.Enabled {
+		return nil
+	}
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-m.subProposals:
+				m.ObserveProposal(event.Data().Slot, event.Error())
+			case alert := <-m.queue:
+				if err := m.deliver(ctx, alert); err != nil {
+					m.logger.Error(
+						"Failed to deliver alert",
+						"condition", alert.Condition, "error", err,
+					)
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+// ObserveBlockVerification records the outcome of verifying an incoming
+// block. Rejections caused by a state root mismatch or by diverging
+// deposits count towards their conditions, an accepted block resets both.
+func (m *Manager) ObserveBlockVerification(slot math.Slot, err error) {
+	switch {
+	case err == nil:
+		m.reset(StateRootMismatch)
+		m.reset(DepositDivergence)
+	case errors.Is(err, core.ErrStateRootMismatch):
+		m.record(
+			StateRootMismatch, m.cfg.StateRootMismatches, slot, err,
+		)
+	case errors.Is(err, core.ErrDepositMismatch),
+		errors.Is(err, core.ErrDepositsLengthMismatch),
+		errors.Is(err, core.ErrDepositIndexOutOfOrder):
+		m.record(
+			DepositDivergence, m.cfg.DepositDivergences, slot, err,
+		)
+	}
+}
+
+// ObserveForkchoiceUpdate records the outcome of the forkchoice update sent
+// to the execution client after finalizing the block at the given slot.
+func (m *Manager) ObserveForkchoiceUpdate(slot math.Slot, err error) {
+	if err == nil {
+		m.reset(ELUnavailable)
+		return
+	}
+	m.record(ELUnavailable, m.cfg.ELOfflineSlots, slot, err)
+}
+
+// ObserveProposal records the outcome of building a block for a slot the
+// local validator proposes.
+func (m *Manager) ObserveProposal(slot math.Slot, err error) {
+	if err == nil {
+		m.reset(MissedProposal)
+		return
+	}
+	m.record(MissedProposal, m.cfg.MissedProposals, slot, err)
+}
+
+// ObservePayloadBuilderCircuit records that the circuit breaker of the
+// payload builder tripped on the given error, which fires an alert right
+// away, or closed if it is nil.
+func (m *Manager) ObservePayloadBuilderCircuit(slot math.Slot, err error) {
+	if err == nil {
+		m.reset(PayloadBuilderCircuitOpen)
+		return
+	}
+	m.record(PayloadBuilderCircuitOpen, 1, slot, err)
+}
+
+// record counts an occurrence of the condition and fires an alert once the
+// threshold is reached, at most once per cooldown.
+func (m *Manager) record(
+	condition Condition, threshold uint64, slot math.Slot, err error,
+) {
+	if !m.cfg.Enabled {
+		return
+	}
+
+	m.mu.Lock()
+	m.counts[condition]++
+	count := m.counts[condition]
+	now := time.Now()
+	if count < max(threshold, 1) ||
+		now.Sub(m.lastFired[condition]) < m.cfg.Cooldown {
+		m.mu.Unlock()
+		return
+	}
+	m.lastFired[condition] = now
+	m.mu.Unlock()
+
+	alert := Alert{
+		Condition: condition,
+		Slot:      slot,
+		Count:     count,
+		Err:       err,
+		Time:      now,
+	}
+	m.logger.Warn("Alert fired", "alert", alert.Summary())
+
+	select {
+	case m.queue <- alert:
+	default:
+		m.logger.Error(
+			"Dropping alert, delivery queue is full",
+			"condition", condition,
+		)
+	}
+}
+
+// reset clears the consecutive occurrences of the condition.
+func (m *Manager) reset(condition Condition) {
+	if !m.cfg.Enabled {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.counts, condition)
+}
+
+// deliver posts the alert to the configured webhook.
+func (m *Manager) deliver(ctx context.Context, alert Alert) error {
+	body, err := alert.Encode(m.cfg, m.source)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deliveryTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodPost, m.cfg.WebhookURL, bytes.NewReader(body),
+	)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK ||
+		resp.StatusCode >= http.StatusMultipleChoices {
+		return errors.Wrap(ErrUnexpectedStatus, resp.Status)
+	}
+	return nil
+}
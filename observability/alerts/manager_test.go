@@ -0,0 +1,198 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package alerts_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/async/dispatcher"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/observability/alerts"
+	"github.com/berachain/beacon-kit/primitives/async"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/state-transition/core"
+	"github.com/stretchr/testify/require"
+)
+
+// startManager starts a manager posting to a test server and returns the
+// channel of received webhook bodies.
+func startManager(
+	t *testing.T, cfg alerts.Config,
+) (*alerts.Manager, <-chan map[string]any) {
+	t.Helper()
+	m, received := newManager(t, cfg)
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	require.NoError(t, m.Start(ctx))
+	return m, received
+}
+
+// newManager creates an enabled manager posting to a test server and
+// returns the channel of received webhook bodies.
+func newManager(
+	t *testing.T, cfg alerts.Config,
+) (*alerts.Manager, <-chan map[string]any) {
+	t.Helper()
+	received := make(chan map[string]any, 8)
+	srv := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			var payload map[string]any
+			if err = json.Unmarshal(body, &payload); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			received <- payload
+		},
+	))
+	t.Cleanup(srv.Close)
+
+	cfg.Enabled = true
+	cfg.WebhookURL = srv.URL
+	m, err := alerts.NewManager(cfg, noop.NewLogger[any]())
+	require.NoError(t, err)
+	return m, received
+}
+
+func TestStateRootMismatchThreshold(t *testing.T) {
+	cfg := alerts.DefaultConfig()
+	m, received := startManager(t, cfg)
+	mismatch := errors.Wrap(core.ErrStateRootMismatch, "block 0x01")
+
+	// An accepted block resets the consecutive mismatches.
+	m.ObserveBlockVerification(math.Slot(1), mismatch)
+	m.ObserveBlockVerification(math.Slot(2), mismatch)
+	m.ObserveBlockVerification(math.Slot(3), nil)
+	m.ObserveBlockVerification(math.Slot(4), mismatch)
+	m.ObserveBlockVerification(math.Slot(5), mismatch)
+	require.Never(t, func() bool { return len(received) > 0 },
+		100*time.Millisecond, 10*time.Millisecond)
+
+	m.ObserveBlockVerification(math.Slot(6), mismatch)
+	payload := <-received
+	require.Contains(t, payload["text"], "state_root_mismatch")
+	require.Contains(t, payload["text"], "slot 6")
+
+	// Further mismatches are silenced by the cooldown.
+	m.ObserveBlockVerification(math.Slot(7), mismatch)
+	require.Never(t, func() bool { return len(received) > 0 },
+		100*time.Millisecond, 10*time.Millisecond)
+}
+
+func TestUnrelatedRejectionsIgnored(t *testing.T) {
+	cfg := alerts.DefaultConfig()
+	cfg.StateRootMismatches = 1
+	m, received := startManager(t, cfg)
+
+	m.ObserveBlockVerification(math.Slot(1), core.ErrSlotMismatch)
+	require.Never(t, func() bool { return len(received) > 0 },
+		100*time.Millisecond, 10*time.Millisecond)
+}
+
+func TestPagerDutyPayload(t *testing.T) {
+	cfg := alerts.DefaultConfig()
+	cfg.Format = alerts.FormatPagerDuty
+	cfg.RoutingKey = "routing-key"
+	m, received := startManager(t, cfg)
+
+	m.ObserveBlockVerification(math.Slot(9), core.ErrDepositIndexOutOfOrder)
+	payload := <-received
+	require.Equal(t, "routing-key", payload["routing_key"])
+	require.Equal(t, "trigger", payload["event_action"])
+	body, ok := payload["payload"].(map[string]any)
+	require.True(t, ok)
+	require.Equal(t, "critical", body["severity"])
+	require.Contains(t, body["summary"], "deposit_divergence")
+}
+
+func TestELUnavailableAndMissedProposals(t *testing.T) {
+	cfg := alerts.DefaultConfig()
+	cfg.ELOfflineSlots = 2
+	cfg.Cooldown = 0
+	m, received := startManager(t, cfg)
+	errOffline := errors.New("connection refused")
+
+	m.ObserveForkchoiceUpdate(math.Slot(1), errOffline)
+	m.ObserveForkchoiceUpdate(math.Slot(2), errOffline)
+	require.Contains(t, (<-received)["text"], "execution_client_unavailable")
+
+	m.ObserveProposal(math.Slot(3), errOffline)
+	require.Contains(t, (<-received)["text"], "missed_proposal")
+
+	m.ObservePayloadBuilderCircuit(math.Slot(4), errOffline)
+	require.Contains(t,
+		(<-received)["text"], "payload_builder_circuit_open",
+	)
+}
+
+func TestProposalBuiltEvents(t *testing.T) {
+	d, err := dispatcher.New(
+		noop.NewLogger[any](),
+		dispatcher.WithEvent[async.Event[async.Proposal]](async.ProposalBuilt),
+	)
+	require.NoError(t, err)
+
+	cfg := alerts.DefaultConfig()
+	cfg.MissedProposals = 1
+	m, received := newManager(t, cfg)
+	require.NoError(t, m.SubscribeProposals(d))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	require.NoError(t, d.Start(ctx))
+	require.NoError(t, m.Start(ctx))
+
+	require.NoError(t, d.Publish(async.NewEvent(
+		ctx, async.ProposalBuilt, async.Proposal{Slot: math.Slot(4)},
+		errors.New("execution client is syncing"),
+	)))
+	payload := <-received
+	require.Contains(t, payload["text"], "missed_proposal")
+	require.Contains(t, payload["text"], "slot 4")
+}
+
+func TestNewManagerValidation(t *testing.T) {
+	cfg := alerts.DefaultConfig()
+	cfg.Enabled = true
+	_, err := alerts.NewManager(cfg, noop.NewLogger[any]())
+	require.ErrorIs(t, err, alerts.ErrMissingWebhookURL)
+
+	cfg.WebhookURL = "http://localhost"
+	cfg.Format = "email"
+	_, err = alerts.NewManager(cfg, noop.NewLogger[any]())
+	require.ErrorIs(t, err, alerts.ErrUnknownFormat)
+
+	// Disabled managers accept observations without a webhook.
+	m, err := alerts.NewManager(alerts.DefaultConfig(), noop.NewLogger[any]())
+	require.NoError(t, err)
+	m.ObserveProposal(math.Slot(1), errors.New("connection refused"))
+}
@@ -0,0 +1,100 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package identity
+
+import "sync"
+
+// Identity identifies the node emitting logs and metrics, so that telemetry
+// collected from a fleet of nodes can be told apart.
+type Identity struct {
+	// NodeID is the stable ID derived from the CometBFT node key.
+	NodeID string `json:"node_id"`
+	// Moniker is the human readable name of the node.
+	Moniker string `json:"moniker"`
+	// ClientVersion is the version of the beacon-kit client.
+	ClientVersion string `json:"client_version"`
+	// Commit is the commit the client was built from.
+	Commit string `json:"commit"`
+	// ChainID is the CometBFT chain ID of the network.
+	ChainID string `json:"chain_id"`
+	// ActiveFork is the fork active when the node started.
+	ActiveFork string `json:"active_fork"`
+
+	// mu protects executionClient, which is refreshed while the node runs.
+	mu sync.RWMutex
+	// executionClient is the execution client last reported by the engine
+	// API, or nil if it has not been queried yet.
+	executionClient *ExecutionClient
+}
+
+// ExecutionClient identifies the execution client paired with the node, as
+// reported by engine_getClientVersionV1.
+type ExecutionClient struct {
+	// Code is the two letter code of the client, e.g. "GE" for geth.
+	Code string `json:"code"`
+	// Name is the human readable name of the client.
+	Name string `json:"name"`
+	// Version is the version string of the client.
+	Version string `json:"version"`
+	// Commit is the first four bytes of the commit the client was built
+	// from.
+	Commit string `json:"commit"`
+}
+
+// SetExecutionClient records the execution client paired with the node.
+func (i *Identity) SetExecutionClient(client ExecutionClient) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.executionClient = &client
+}
+
+// ExecutionClient returns a copy of the execution client paired with the
+// node, or nil if it is not known yet.
+func (i *Identity) ExecutionClient() *ExecutionClient {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	if i.executionClient == nil {
+		return nil
+	}
+	client := *i.executionClient
+	return &client
+}
+
+// Labels returns the key-value pairs added to every metric of the node.
+func (i *Identity) Labels() []string {
+	return []string{
+		"node_id", i.NodeID,
+		"chain_id", i.ChainID,
+		"client_version", i.ClientVersion,
+		"active_fork", i.ActiveFork,
+	}
+}
+
+// LogFields returns the key-value pairs added to every log entry of the
+// node.
+func (i *Identity) LogFields() []any {
+	labels := i.Labels()
+	fields := make([]any, len(labels))
+	for idx, label := range labels {
+		fields[idx] = label
+	}
+	return fields
+}
@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package identity_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/observability/identity"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIdentityLabels(t *testing.T) {
+	id := &identity.Identity{
+		NodeID:        "f00d",
+		Moniker:       "validator-0",
+		ClientVersion: "v1.0.0",
+		ChainID:       "beacond-2061",
+		ActiveFork:    "deneb",
+	}
+
+	require.Equal(t, []string{
+		"node_id", "f00d",
+		"chain_id", "beacond-2061",
+		"client_version", "v1.0.0",
+		"active_fork", "deneb",
+	}, id.Labels())
+	require.Equal(t, []any{
+		"node_id", "f00d",
+		"chain_id", "beacond-2061",
+		"client_version", "v1.0.0",
+		"active_fork", "deneb",
+	}, id.LogFields())
+}
+
+func TestIdentityExecutionClient(t *testing.T) {
+	id := &identity.Identity{NodeID: "f00d"}
+	require.Nil(t, id.ExecutionClient())
+
+	id.SetExecutionClient(identity.ExecutionClient{
+		Code:    "GE",
+		Name:    "Geth",
+		Version: "v1.14.3",
+		Commit:  "deadbeef",
+	})
+	client := id.ExecutionClient()
+	require.Equal(t, "Geth", client.Name)
+
+	// The returned client is a copy.
+	client.Name = "Reth"
+	require.Equal(t, "Geth", id.ExecutionClient().Name)
+}
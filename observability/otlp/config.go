@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package otlp
+
+import "time"
+
+const (
+	defaultEndpoint      = "http://127.0.0.1:4318/v1/metrics"
+	defaultServiceName   = "beacond"
+	defaultFlushInterval = 10 * time.Second
+)
+
+// Config is the configuration for pushing metrics to an OTLP collector.
+type Config struct {
+	// Enabled is the flag to enable pushing metrics to an OTLP collector.
+	Enabled bool `mapstructure:"enabled"`
+	// Endpoint is the URL of the OTLP/HTTP metrics endpoint of the
+	// collector.
+	Endpoint string `mapstructure:"endpoint"`
+	// ServiceName is the service name the metrics are reported under.
+	ServiceName string `mapstructure:"service-name"`
+	// FlushInterval is the interval at which the metrics are exported.
+	FlushInterval time.Duration `mapstructure:"flush-interval"`
+}
+
+// DefaultConfig returns the default configuration for pushing metrics to an
+// OTLP collector.
+func DefaultConfig() Config {
+	return Config{
+		Enabled:       false,
+		Endpoint:      defaultEndpoint,
+		ServiceName:   defaultServiceName,
+		FlushInterval: defaultFlushInterval,
+	}
+}
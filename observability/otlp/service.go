@@ -0,0 +1,135 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package otlp
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log"
+)
+
+var (
+	// ErrInvalidFlushInterval is returned when the flush interval is not
+	// positive.
+	ErrInvalidFlushInterval = errors.New("invalid otlp flush interval")
+	// ErrUnexpectedStatus is returned when the collector responds with a
+	// non 2xx status.
+	ErrUnexpectedStatus = errors.New("unexpected otlp collector status")
+)
+
+// Service periodically exports the metrics of a Sink to an OTLP collector.
+type Service struct {
+	config Config
+	sink   *Sink
+	logger log.Logger
+	client *http.Client
+}
+
+// NewService creates a new Service exporting the metrics of the given sink.
+func NewService(config Config, sink *Sink, logger log.Logger) *Service {
+	return &Service{
+		config: config,
+		sink:   sink,
+		logger: logger,
+		// An export must not outlast the interval to the next one.
+		client: &http.Client{Timeout: config.FlushInterval},
+	}
+}
+
+// Name returns the name of the OTLP service.
+func (s *Service) Name() string {
+	return "otlp"
+}
+
+// Start exports the metrics to the configured collector at every flush
+// interval until the context is done.
+func (s *Service) Start(ctx context.Context) error {
+	if !s.config.Enabled {
+		return nil
+	}
+	if s.config.FlushInterval <= 0 {
+		return errors.Wrap(
+			ErrInvalidFlushInterval, s.config.FlushInterval.String(),
+		)
+	}
+	go s.exportLoop(ctx)
+
+	s.logger.Info(
+		"Exporting OTLP metrics",
+		"endpoint", s.config.Endpoint,
+		"flush_interval", s.config.FlushInterval,
+	)
+	return nil
+}
+
+// exportLoop exports the metrics at every flush interval, and a last time
+// once the context is done.
+func (s *Service) exportLoop(ctx context.Context) {
+	ticker := time.NewTicker(s.config.FlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			s.export(context.WithoutCancel(ctx))
+			return
+		case <-ticker.C:
+			s.export(ctx)
+		}
+	}
+}
+
+// export posts the metrics to the collector.
+func (s *Service) export(ctx context.Context) {
+	if err := s.post(ctx); err != nil {
+		s.logger.Error("Failed to export OTLP metrics", "error", err)
+	}
+}
+
+// post posts the current metrics to the collector.
+func (s *Service) post(ctx context.Context) error {
+	body, err := s.sink.Export(time.Now())
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodPost, s.config.Endpoint, bytes.NewReader(body),
+	)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK ||
+		resp.StatusCode >= http.StatusMultipleChoices {
+		return errors.Wrap(ErrUnexpectedStatus, resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,231 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package otlp
+
+import (
+	"encoding/json"
+	"maps"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// bucketBounds are the upper bounds, in seconds, of the buckets of the
+// histograms, which are the Prometheus default ones.
+//
+//nolint:gochecknoglobals // read-only.
+var bucketBounds = []float64{
+	.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10,
+}
+
+// numberPoint is the value of a counter or gauge for a set of labels.
+type numberPoint struct {
+	name       string
+	attributes []keyValue
+	value      int64
+}
+
+// histogramPoint is the distribution of a timer for a set of labels.
+type histogramPoint struct {
+	name         string
+	attributes   []keyValue
+	count        uint64
+	sum          float64
+	bucketCounts []uint64
+}
+
+// Sink aggregates metrics in memory until they are exported as an OTLP
+// metrics export request. Counters and histograms are cumulative since the
+// creation of the sink.
+type Sink struct {
+	serviceName string
+	start       time.Time
+
+	mu         sync.Mutex
+	counters   map[string]*numberPoint
+	gauges     map[string]*numberPoint
+	histograms map[string]*histogramPoint
+}
+
+// NewSink creates a new Sink reporting the metrics under the given service
+// name.
+func NewSink(serviceName string) *Sink {
+	return &Sink{
+		serviceName: serviceName,
+		start:       time.Now(),
+		counters:    make(map[string]*numberPoint),
+		gauges:      make(map[string]*numberPoint),
+		histograms:  make(map[string]*histogramPoint),
+	}
+}
+
+// IncrementCounter increments the counter identified by the key and labels.
+func (s *Sink) IncrementCounter(key string, args ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	point, ok := s.counters[seriesID(key, args)]
+	if !ok {
+		point = &numberPoint{name: key, attributes: attributes(args)}
+		s.counters[seriesID(key, args)] = point
+	}
+	point.value++
+}
+
+// SetGauge sets the gauge identified by the key and labels.
+func (s *Sink) SetGauge(key string, value int64, args ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	point, ok := s.gauges[seriesID(key, args)]
+	if !ok {
+		point = &numberPoint{name: key, attributes: attributes(args)}
+		s.gauges[seriesID(key, args)] = point
+	}
+	point.value = value
+}
+
+// MeasureSince observes the seconds elapsed since start in the histogram
+// identified by the key and labels.
+func (s *Sink) MeasureSince(key string, start time.Time, args ...string) {
+	elapsed := time.Since(start).Seconds()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	point, ok := s.histograms[seriesID(key, args)]
+	if !ok {
+		point = &histogramPoint{
+			name:         key,
+			attributes:   attributes(args),
+			bucketCounts: make([]uint64, len(bucketBounds)+1),
+		}
+		s.histograms[seriesID(key, args)] = point
+	}
+	point.count++
+	point.sum += elapsed
+	point.bucketCounts[sort.SearchFloat64s(bucketBounds, elapsed)]++
+}
+
+// Export encodes the metrics recorded so far as an OTLP metrics export
+// request in JSON, timestamped with now.
+func (s *Sink) Export(now time.Time) ([]byte, error) {
+	var (
+		startNano = strconv.FormatInt(s.start.UnixNano(), 10)
+		nowNano   = strconv.FormatInt(now.UnixNano(), 10)
+		byName    = make(map[string]*metric)
+	)
+	metricNamed := func(name string, newMetric func() *metric) *metric {
+		m, ok := byName[name]
+		if !ok {
+			m = newMetric()
+			byName[name] = m
+		}
+		return m
+	}
+
+	s.mu.Lock()
+	for _, id := range slices.Sorted(maps.Keys(s.counters)) {
+		point := s.counters[id]
+		m := metricNamed(point.name, func() *metric {
+			return &metric{Name: point.name, Sum: &sum{
+				AggregationTemporality: aggregationTemporalityCumulative,
+				IsMonotonic:            true,
+			}}
+		})
+		m.Sum.DataPoints = append(m.Sum.DataPoints, numberDataPoint{
+			Attributes:        point.attributes,
+			StartTimeUnixNano: startNano,
+			TimeUnixNano:      nowNano,
+			AsInt:             strconv.FormatInt(point.value, 10),
+		})
+	}
+	for _, id := range slices.Sorted(maps.Keys(s.gauges)) {
+		point := s.gauges[id]
+		m := metricNamed(point.name, func() *metric {
+			return &metric{Name: point.name, Gauge: &gauge{}}
+		})
+		m.Gauge.DataPoints = append(m.Gauge.DataPoints, numberDataPoint{
+			Attributes:   point.attributes,
+			TimeUnixNano: nowNano,
+			AsInt:        strconv.FormatInt(point.value, 10),
+		})
+	}
+	for _, id := range slices.Sorted(maps.Keys(s.histograms)) {
+		point := s.histograms[id]
+		m := metricNamed(point.name, func() *metric {
+			return &metric{Name: point.name, Unit: "s", Histogram: &histogram{
+				AggregationTemporality: aggregationTemporalityCumulative,
+			}}
+		})
+		bucketCounts := make([]string, len(point.bucketCounts))
+		for i, count := range point.bucketCounts {
+			bucketCounts[i] = strconv.FormatUint(count, 10)
+		}
+		m.Histogram.DataPoints = append(
+			m.Histogram.DataPoints, histogramDataPoint{
+				Attributes:        point.attributes,
+				StartTimeUnixNano: startNano,
+				TimeUnixNano:      nowNano,
+				Count:             strconv.FormatUint(point.count, 10),
+				Sum:               point.sum,
+				BucketCounts:      bucketCounts,
+				ExplicitBounds:    bucketBounds,
+			},
+		)
+	}
+	s.mu.Unlock()
+
+	metrics := make([]metric, 0, len(byName))
+	for _, name := range slices.Sorted(maps.Keys(byName)) {
+		metrics = append(metrics, *byName[name])
+	}
+	return json.Marshal(exportRequest{
+		ResourceMetrics: []resourceMetrics{{
+			Resource: resource{Attributes: []keyValue{{
+				Key:   "service.name",
+				Value: anyValue{StringValue: s.serviceName},
+			}}},
+			ScopeMetrics: []scopeMetrics{{
+				Scope:   scope{Name: "beacon-kit"},
+				Metrics: metrics,
+			}},
+		}},
+	})
+}
+
+// seriesID identifies a series by its key and labels.
+func seriesID(key string, args []string) string {
+	return key + "{" + strings.Join(args, ",") + "}"
+}
+
+// attributes converts key-value label pairs into OTLP attributes. A
+// trailing key without a value is dropped.
+func attributes(args []string) []keyValue {
+	//nolint:mnd // pairs.
+	attrs := make([]keyValue, 0, len(args)/2)
+	for i := 0; i+1 < len(args); i += 2 {
+		attrs = append(attrs, keyValue{
+			Key:   args[i],
+			Value: anyValue{StringValue: args[i+1]},
+		})
+	}
+	return attrs
+}
@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package otlp_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/observability/otlp"
+	"github.com/stretchr/testify/require"
+)
+
+// export is the subset of an OTLP metrics export request the tests check.
+type export struct {
+	ResourceMetrics []struct {
+		Resource struct {
+			Attributes []attribute `json:"attributes"`
+		} `json:"resource"`
+		ScopeMetrics []struct {
+			Metrics []struct {
+				Name string `json:"name"`
+				Sum  *struct {
+					DataPoints             []dataPoint `json:"dataPoints"`
+					AggregationTemporality int         `json:"aggregationTemporality"`
+					IsMonotonic            bool        `json:"isMonotonic"`
+				} `json:"sum"`
+				Gauge *struct {
+					DataPoints []dataPoint `json:"dataPoints"`
+				} `json:"gauge"`
+				Histogram *struct {
+					DataPoints []struct {
+						Count          string    `json:"count"`
+						BucketCounts   []string  `json:"bucketCounts"`
+						ExplicitBounds []float64 `json:"explicitBounds"`
+					} `json:"dataPoints"`
+				} `json:"histogram"`
+			} `json:"metrics"`
+		} `json:"scopeMetrics"`
+	} `json:"resourceMetrics"`
+}
+
+type attribute struct {
+	Key   string `json:"key"`
+	Value struct {
+		StringValue string `json:"stringValue"`
+	} `json:"value"`
+}
+
+type dataPoint struct {
+	Attributes []attribute `json:"attributes"`
+	AsInt      string      `json:"asInt"`
+}
+
+func TestSinkExport(t *testing.T) {
+	sink := otlp.NewSink("beacond")
+	sink.IncrementCounter("beacon_kit.engine.calls", "method", "new-payload")
+	sink.IncrementCounter("beacon_kit.engine.calls", "method", "new-payload")
+	sink.IncrementCounter("beacon_kit.engine.calls", "method", "fcu")
+	sink.SetGauge("beacon_kit.deposit.index", 3)
+	sink.SetGauge("beacon_kit.deposit.index", 7)
+	sink.MeasureSince("beacon_kit.process_block", time.Now())
+
+	bz, err := sink.Export(time.Now())
+	require.NoError(t, err)
+	var req export
+	require.NoError(t, json.Unmarshal(bz, &req))
+
+	require.Len(t, req.ResourceMetrics, 1)
+	rm := req.ResourceMetrics[0]
+	require.Equal(t, "service.name", rm.Resource.Attributes[0].Key)
+	require.Equal(t, "beacond", rm.Resource.Attributes[0].Value.StringValue)
+
+	metrics := rm.ScopeMetrics[0].Metrics
+	require.Len(t, metrics, 3)
+
+	// Metrics are sorted by name.
+	require.Equal(t, "beacon_kit.deposit.index", metrics[0].Name)
+	require.Equal(t, "7", metrics[0].Gauge.DataPoints[0].AsInt)
+
+	require.Equal(t, "beacon_kit.engine.calls", metrics[1].Name)
+	require.True(t, metrics[1].Sum.IsMonotonic)
+	require.Equal(t, 2, metrics[1].Sum.AggregationTemporality)
+	points := metrics[1].Sum.DataPoints
+	require.Len(t, points, 2)
+	require.Equal(t, "fcu", points[0].Attributes[0].Value.StringValue)
+	require.Equal(t, "1", points[0].AsInt)
+	require.Equal(t, "new-payload", points[1].Attributes[0].Value.StringValue)
+	require.Equal(t, "2", points[1].AsInt)
+
+	require.Equal(t, "beacon_kit.process_block", metrics[2].Name)
+	hist := metrics[2].Histogram.DataPoints[0]
+	require.Equal(t, "1", hist.Count)
+	require.Len(t, hist.BucketCounts, len(hist.ExplicitBounds)+1)
+	require.Equal(t, "1", hist.BucketCounts[0])
+}
@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package profiling
+
+import "time"
+
+const (
+	defaultAdminAddress       = "127.0.0.1:6061"
+	defaultSlowBlockThreshold = time.Second
+	defaultProfileDir         = "data/profiles"
+)
+
+// Config is the configuration for the profiling endpoints and the
+// automatic profiling of slow blocks.
+type Config struct {
+	// Enabled is the flag to serve the pprof endpoints on the admin
+	// listener.
+	Enabled bool `mapstructure:"enabled"`
+	// AdminAddress is the address of the admin listener, serving the halt
+	// endpoints and the pprof endpoints if enabled. It should not be
+	// reachable from untrusted networks.
+	AdminAddress string `mapstructure:"admin-address"`
+	// AutoProfile is the flag to capture a CPU profile of every block whose
+	// processing exceeds SlowBlockThreshold.
+	AutoProfile bool `mapstructure:"auto-profile"`
+	// SlowBlockThreshold is the processing time above which a block is
+	// profiled.
+	SlowBlockThreshold time.Duration `mapstructure:"slow-block-threshold"`
+	// ProfileDir is the directory profiles of slow blocks are saved to,
+	// relative to the node home unless absolute.
+	ProfileDir string `mapstructure:"profile-dir"`
+}
+
+// DefaultConfig returns the default configuration for profiling.
+func DefaultConfig() Config {
+	return Config{
+		Enabled:            false,
+		AdminAddress:       defaultAdminAddress,
+		AutoProfile:        false,
+		SlowBlockThreshold: defaultSlowBlockThreshold,
+		ProfileDir:         defaultProfileDir,
+	}
+}
@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package profiling
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime/pprof"
+	"time"
+
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// profileFilePermissions is the file mode profiles are written with.
+const profileFilePermissions = 0o600
+
+// BlockProfiler captures a CPU profile of the processing of every block and
+// keeps the ones of blocks slower than the configured threshold.
+type BlockProfiler struct {
+	cfg    Config
+	dir    string
+	logger log.Logger
+}
+
+// NewBlockProfiler creates a new block profiler saving profiles to dir.
+func NewBlockProfiler(
+	cfg Config, dir string, logger log.Logger,
+) *BlockProfiler {
+	return &BlockProfiler{
+		cfg:    cfg,
+		dir:    dir,
+		logger: logger,
+	}
+}
+
+// StartBlock starts profiling the processing of the block at the given
+// slot. The returned function stops the profile and saves it if the block
+// took longer than the slow block threshold, the block root is only computed
+// in that case. Blocks are not profiled while another CPU profile, such as
+// one requested on the admin listener, is running.
+func (p *BlockProfiler) StartBlock(
+	slot math.Slot, root func() common.Root,
+) func() {
+	if !p.cfg.AutoProfile {
+		return func() {}
+	}
+
+	buf := new(bytes.Buffer)
+	if err := pprof.StartCPUProfile(buf); err != nil {
+		p.logger.Debug("Skipping block profile", "reason", err)
+		return func() {}
+	}
+	start := time.Now()
+
+	return func() {
+		pprof.StopCPUProfile()
+		elapsed := time.Since(start)
+		if elapsed < p.cfg.SlowBlockThreshold {
+			return
+		}
+
+		blockRoot := root()
+		path, err := p.save(slot, blockRoot, buf.Bytes())
+		if err != nil {
+			p.logger.Error(
+				"Failed to save slow block profile",
+				"slot", slot.Base10(), "error", err,
+			)
+			return
+		}
+		p.logger.Warn(
+			"Captured CPU profile of slow block",
+			"slot", slot.Base10(),
+			"block_root", blockRoot,
+			"duration", elapsed.String(),
+			"profile", path,
+		)
+	}
+}
+
+// save writes the profile of the block to the profile directory.
+func (p *BlockProfiler) save(
+	slot math.Slot, root common.Root, profile []byte,
+) (string, error) {
+	if err := os.MkdirAll(p.dir, os.ModePerm); err != nil {
+		return "", err
+	}
+	path := filepath.Join(
+		p.dir, fmt.Sprintf("block-%d-%s.cpu.pprof", slot.Unwrap(), root.Hex()),
+	)
+	return path, os.WriteFile(path, profile, profileFilePermissions)
+}
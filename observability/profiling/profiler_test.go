@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package profiling_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/observability/profiling"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockProfiler(t *testing.T) {
+	root := common.Root{0xab}
+	rootFn := func() common.Root { return root }
+
+	tests := []struct {
+		name      string
+		auto      bool
+		threshold time.Duration
+		expected  int
+	}{
+		{name: "disabled", auto: false, threshold: 0, expected: 0},
+		{name: "fast block", auto: true, threshold: time.Hour, expected: 0},
+		{name: "slow block", auto: true, threshold: 0, expected: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			cfg := profiling.DefaultConfig()
+			cfg.AutoProfile = tt.auto
+			cfg.SlowBlockThreshold = tt.threshold
+			p := profiling.NewBlockProfiler(
+				cfg, dir, noop.NewLogger[any](),
+			)
+
+			p.StartBlock(math.Slot(42), rootFn)()
+
+			entries, err := os.ReadDir(dir)
+			require.NoError(t, err)
+			require.Len(t, entries, tt.expected)
+			if tt.expected == 0 {
+				return
+			}
+			name := "block-42-" + root.Hex() + ".cpu.pprof"
+			require.Equal(t, name, entries[0].Name())
+			info, err := os.Stat(filepath.Join(dir, name))
+			require.NoError(t, err)
+			require.Positive(t, info.Size())
+		})
+	}
+}
@@ -0,0 +1,106 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package profiling
+
+import (
+	"context"
+	"net/http"
+	//nolint:gosec // the endpoints are only served on the admin listener.
+	"net/http/pprof"
+	"time"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log"
+)
+
+// readHeaderTimeout bounds the time to read the headers of a request.
+const readHeaderTimeout = 5 * time.Second
+
+// Service serves the admin listener, with the pprof endpoints if enabled
+// and the admin endpoints of the other services.
+type Service struct {
+	cfg    Config
+	logger log.Logger
+	mux    *http.ServeMux
+	// hasAdminRoutes is set once another service registers an endpoint.
+	hasAdminRoutes bool
+}
+
+// NewService creates a new Service serving the admin listener.
+func NewService(cfg Config, logger log.Logger) *Service {
+	return &Service{
+		cfg:    cfg,
+		logger: logger,
+		mux:    http.NewServeMux(),
+	}
+}
+
+// Handle registers an admin endpoint, served on the admin listener only. It
+// must be called before the service is started.
+func (s *Service) Handle(pattern string, handler http.Handler) {
+	s.mux.Handle(pattern, handler)
+	s.hasAdminRoutes = true
+}
+
+// Name returns the name of the profiling service.
+func (s *Service) Name() string {
+	return "profiling"
+}
+
+// Start serves the admin listener until the context is done. The listener
+// is only opened if the pprof endpoints are enabled or another service
+// registered an endpoint.
+func (s *Service) Start(ctx context.Context) error {
+	if !s.cfg.Enabled && !s.hasAdminRoutes {
+		return nil
+	}
+
+	if s.cfg.Enabled {
+		s.mux.HandleFunc("/debug/pprof/", pprof.Index)
+		s.mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+		s.mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+		s.mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+		s.mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	}
+	srv := &http.Server{
+		Addr:              s.cfg.AdminAddress,
+		Handler:           s.mux,
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+
+	go func() {
+		if err := srv.ListenAndServe(); err != nil &&
+			!errors.Is(err, http.ErrServerClosed) {
+			s.logger.Error("Admin listener failed", "error", err)
+		}
+	}()
+	go func() {
+		<-ctx.Done()
+		//nolint:contextcheck // the parent context is done.
+		_ = srv.Shutdown(context.Background())
+	}()
+
+	s.logger.Info(
+		"Serving the admin listener",
+		"address", s.cfg.AdminAddress, "pprof", s.cfg.Enabled,
+	)
+	return nil
+}
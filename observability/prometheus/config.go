@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package prometheus
+
+const (
+	defaultAddress   = "127.0.0.1:9102"
+	defaultNamespace = "beacon_kit"
+)
+
+// Config is the configuration for the Prometheus metrics endpoint.
+type Config struct {
+	// Enabled is the flag to enable the Prometheus metrics endpoint.
+	Enabled bool `mapstructure:"enabled"`
+	// Address is the address to serve the metrics endpoint on.
+	Address string `mapstructure:"address"`
+	// Namespace is the prefix of every metric name.
+	Namespace string `mapstructure:"namespace"`
+}
+
+// DefaultConfig returns the default configuration for the Prometheus metrics
+// endpoint.
+func DefaultConfig() Config {
+	return Config{
+		Enabled:   false,
+		Address:   defaultAddress,
+		Namespace: defaultNamespace,
+	}
+}
@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package prometheus
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// readHeaderTimeout bounds the time to read the headers of a scrape.
+const readHeaderTimeout = 5 * time.Second
+
+// Service serves the metrics of a Sink on the /metrics endpoint.
+type Service struct {
+	config Config
+	sink   *Sink
+	logger log.Logger
+}
+
+// NewService creates a new Service serving the metrics of the given sink.
+func NewService(config Config, sink *Sink, logger log.Logger) *Service {
+	return &Service{
+		config: config,
+		sink:   sink,
+		logger: logger,
+	}
+}
+
+// Name returns the name of the Prometheus service.
+func (s *Service) Name() string {
+	return "prometheus"
+}
+
+// Start serves the metrics endpoint at the configured address until the
+// context is done.
+func (s *Service) Start(ctx context.Context) error {
+	if !s.config.Enabled {
+		return nil
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(
+		s.sink.Registry(), promhttp.HandlerOpts{},
+	))
+	srv := &http.Server{
+		Addr:              s.config.Address,
+		Handler:           mux,
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+
+	go func() {
+		if err := srv.ListenAndServe(); err != nil &&
+			!errors.Is(err, http.ErrServerClosed) {
+			s.logger.Error("Prometheus metrics server failed", "error", err)
+		}
+	}()
+	go func() {
+		<-ctx.Done()
+		//nolint:contextcheck // the parent context is done.
+		_ = srv.Shutdown(context.Background())
+	}()
+
+	s.logger.Info("Serving Prometheus metrics", "address", s.config.Address)
+	return nil
+}
@@ -0,0 +1,159 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package prometheus
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+)
+
+// Sink records metrics into a Prometheus registry. Metrics are created on
+// first use from their key and label names, so that callers can keep using
+// the same keys as for the telemetry sink.
+type Sink struct {
+	namespace string
+	registry  *prometheus.Registry
+
+	mu         sync.Mutex
+	counters   map[string]*prometheus.CounterVec
+	gauges     map[string]*prometheus.GaugeVec
+	histograms map[string]*prometheus.HistogramVec
+}
+
+// NewSink creates a new Sink with a registry holding the process and Go
+// runtime collectors.
+func NewSink(namespace string) *Sink {
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		collectors.NewGoCollector(),
+	)
+	return &Sink{
+		namespace:  namespace,
+		registry:   registry,
+		counters:   make(map[string]*prometheus.CounterVec),
+		gauges:     make(map[string]*prometheus.GaugeVec),
+		histograms: make(map[string]*prometheus.HistogramVec),
+	}
+}
+
+// Registry returns the registry the sink records into.
+func (s *Sink) Registry() *prometheus.Registry {
+	return s.registry
+}
+
+// IncrementCounter increments the counter identified by the key and labels.
+func (s *Sink) IncrementCounter(key string, args ...string) {
+	names, values := splitLabels(args)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := metricID(key, names)
+	vec, ok := s.counters[id]
+	if !ok {
+		vec = prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: s.namespace,
+			Name:      metricName(key),
+			Help:      key,
+		}, names)
+		if s.registry.Register(vec) != nil {
+			return
+		}
+		s.counters[id] = vec
+	}
+	vec.WithLabelValues(values...).Inc()
+}
+
+// SetGauge sets the gauge identified by the key and labels.
+func (s *Sink) SetGauge(key string, value int64, args ...string) {
+	names, values := splitLabels(args)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := metricID(key, names)
+	vec, ok := s.gauges[id]
+	if !ok {
+		vec = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: s.namespace,
+			Name:      metricName(key),
+			Help:      key,
+		}, names)
+		if s.registry.Register(vec) != nil {
+			return
+		}
+		s.gauges[id] = vec
+	}
+	vec.WithLabelValues(values...).Set(float64(value))
+}
+
+// MeasureSince observes the seconds elapsed since start in the histogram
+// identified by the key and labels.
+func (s *Sink) MeasureSince(key string, start time.Time, args ...string) {
+	elapsed := time.Since(start)
+	names, values := splitLabels(args)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := metricID(key, names)
+	vec, ok := s.histograms[id]
+	if !ok {
+		vec = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: s.namespace,
+			Name:      metricName(key) + "_seconds",
+			Help:      key,
+			Buckets:   prometheus.DefBuckets,
+		}, names)
+		if s.registry.Register(vec) != nil {
+			return
+		}
+		s.histograms[id] = vec
+	}
+	vec.WithLabelValues(values...).Observe(elapsed.Seconds())
+}
+
+// metricName converts a telemetry key into a Prometheus metric name. The
+// namespace is added by the sink, so a leading "beacon_kit" is dropped.
+func metricName(key string) string {
+	name := strings.NewReplacer(".", "_", "-", "_").Replace(key)
+	return strings.TrimPrefix(name, defaultNamespace+"_")
+}
+
+// metricID identifies a metric by its key and label names.
+func metricID(key string, names []string) string {
+	return key + "{" + strings.Join(names, ",") + "}"
+}
+
+// splitLabels splits key-value label pairs into label names and values. A
+// trailing key without a value is dropped.
+func splitLabels(args []string) ([]string, []string) {
+	//nolint:mnd // pairs.
+	n := len(args) / 2
+	names, values := make([]string, n), make([]string, n)
+	for i := range n {
+		names[i] = strings.NewReplacer(".", "_", "-", "_").Replace(args[2*i])
+		values[i] = args[2*i+1]
+	}
+	return names, values
+}
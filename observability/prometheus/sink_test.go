@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package prometheus_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/observability/prometheus"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSink(t *testing.T) {
+	sink := prometheus.NewSink("beacon_kit")
+	sink.IncrementCounter("beacon_kit.engine.calls", "method", "new-payload")
+	sink.IncrementCounter("beacon_kit.engine.calls", "method", "new-payload")
+	sink.SetGauge("beacon_kit.deposit.index", 7)
+	sink.MeasureSince(
+		"beacon_kit.state_processor.process_block", time.Now(),
+		"success", "true",
+	)
+
+	families, err := sink.Registry().Gather()
+	require.NoError(t, err)
+	byName := make(map[string]float64)
+	for _, family := range families {
+		m := family.GetMetric()[0]
+		switch {
+		case m.GetCounter() != nil:
+			byName[family.GetName()] = m.GetCounter().GetValue()
+		case m.GetGauge() != nil:
+			byName[family.GetName()] = m.GetGauge().GetValue()
+		case m.GetHistogram() != nil:
+			byName[family.GetName()] = float64(
+				m.GetHistogram().GetSampleCount(),
+			)
+		}
+	}
+
+	require.InDelta(t, 2, byName["beacon_kit_engine_calls"], 0)
+	require.InDelta(t, 7, byName["beacon_kit_deposit_index"], 0)
+	require.InDelta(
+		t, 1,
+		byName["beacon_kit_state_processor_process_block_seconds"], 0,
+	)
+	// Process and Go runtime collectors are registered.
+	require.Contains(t, byName, "go_goroutines")
+	require.Contains(t, byName, "process_start_time_seconds")
+}
+
+func TestSinkConflictingLabels(t *testing.T) {
+	sink := prometheus.NewSink("beacon_kit")
+	sink.IncrementCounter("beacon_kit.calls", "method", "a")
+	// A metric reused with different labels is dropped rather than panic.
+	require.NotPanics(t, func() {
+		sink.IncrementCounter("beacon_kit.calls", "other", "b")
+	})
+}
@@ -0,0 +1,196 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package proposal breaks the time to propose a block down into the stages
+// of the proposal path.
+package proposal
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// Stage is a stage of the proposal path.
+type Stage string
+
+const (
+	// StageForkchoiceUpdate is the forkchoice update with the payload
+	// attributes. It is skipped if the payload was built optimistically.
+	StageForkchoiceUpdate Stage = "forkchoice_update"
+	// StagePayloadWait is the time waiting for the execution client to
+	// build and deliver the payload.
+	StagePayloadWait Stage = "payload_wait"
+	// StageSidecarBuild is the time building the blob sidecars.
+	StageSidecarBuild Stage = "sidecar_build"
+	// StageStateRoot is the time computing the state root of the block.
+	StageStateRoot Stage = "state_root"
+	// StageEncode is the time encoding the block and sidecars.
+	StageEncode Stage = "encode"
+	// StageHandOff is the time from CometBFT requesting the proposal until
+	// it is handed back to CometBFT.
+	StageHandOff Stage = "hand_off"
+)
+
+// Stages are the stages of the proposal path, in order.
+//
+//nolint:gochecknoglobals // read-only.
+var Stages = []Stage{
+	StageForkchoiceUpdate,
+	StagePayloadWait,
+	StageSidecarBuild,
+	StageStateRoot,
+	StageEncode,
+	StageHandOff,
+}
+
+// reportKey is the context key of the report.
+type reportKey struct{}
+
+// Report is the breakdown of the proposal of a block. It is carried in the
+// context of the proposal, so that every component on the proposal path
+// records its stages. All methods are no-ops on a nil report.
+type Report struct {
+	mu           sync.Mutex
+	durations    map[Stage]time.Duration
+	payloadValue *math.U256
+	blobCount    uint64
+	depositCount uint64
+}
+
+// NewReport returns an empty report of a proposal.
+func NewReport() *Report {
+	return &Report{
+		durations: make(map[Stage]time.Duration),
+	}
+}
+
+// WithReport returns a copy of the context carrying the report.
+func WithReport(ctx context.Context, r *Report) context.Context {
+	return context.WithValue(ctx, reportKey{}, r)
+}
+
+// FromContext returns the report carried by the context, nil if the context
+// does not belong to a proposal.
+func FromContext(ctx context.Context) *Report {
+	r, _ := ctx.Value(reportKey{}).(*Report)
+	return r
+}
+
+// Observe records the time elapsed since start in the given stage.
+func (r *Report) Observe(stage Stage, start time.Time) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.durations[stage] += time.Since(start)
+}
+
+// Duration returns the time spent in the given stage and false if the stage
+// was not observed.
+func (r *Report) Duration(stage Stage) (time.Duration, bool) {
+	if r == nil {
+		return 0, false
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	d, ok := r.durations[stage]
+	return d, ok
+}
+
+// SetPayload records the value of the payload in Wei and its blob count.
+func (r *Report) SetPayload(value *math.U256, blobCount uint64) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.payloadValue = value
+	r.blobCount = blobCount
+}
+
+// SetDepositCount records the number of deposits in the block.
+func (r *Report) SetDepositCount(count uint64) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.depositCount = count
+}
+
+// PayloadValue returns the value of the payload in Wei, nil if unknown.
+func (r *Report) PayloadValue() *math.U256 {
+	if r == nil {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.payloadValue
+}
+
+// BlobCount returns the number of blobs of the payload.
+func (r *Report) BlobCount() uint64 {
+	if r == nil {
+		return 0
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.blobCount
+}
+
+// DepositCount returns the number of deposits in the block.
+func (r *Report) DepositCount() uint64 {
+	if r == nil {
+		return 0
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.depositCount
+}
+
+// Fields returns the report as key-value pairs for a structured log line,
+// the observed stages in order followed by the payload and block contents.
+func (r *Report) Fields() []any {
+	if r == nil {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	fields := make([]any, 0, 2*(len(Stages)+3)) //nolint:mnd // pairs.
+	for _, stage := range Stages {
+		if d, ok := r.durations[stage]; ok {
+			fields = append(fields, string(stage), d.String())
+		}
+	}
+	value := "unknown"
+	if r.payloadValue != nil {
+		value = r.payloadValue.Dec()
+	}
+	return append(fields,
+		"payload_value", value,
+		"blob_count", r.blobCount,
+		"deposit_count", r.depositCount,
+	)
+}
@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package proposal_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/observability/proposal"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReport_FromContext(t *testing.T) {
+	// Stages observed outside of a proposal are ignored.
+	require.Nil(t, proposal.FromContext(context.Background()))
+	proposal.FromContext(context.Background()).Observe(
+		proposal.StageForkchoiceUpdate, time.Now(),
+	)
+
+	report := proposal.NewReport()
+	ctx := proposal.WithReport(context.Background(), report)
+	require.Same(t, report, proposal.FromContext(ctx))
+}
+
+func TestReport_Fields(t *testing.T) {
+	report := proposal.NewReport()
+	start := time.Now().Add(-time.Second)
+	report.Observe(proposal.StageHandOff, start)
+	report.Observe(proposal.StagePayloadWait, start)
+	report.SetPayload(math.NewU256(42), 3)
+	report.SetDepositCount(2)
+
+	_, ok := report.Duration(proposal.StageForkchoiceUpdate)
+	require.False(t, ok)
+	d, ok := report.Duration(proposal.StagePayloadWait)
+	require.True(t, ok)
+	require.GreaterOrEqual(t, d, time.Second)
+
+	// Only the observed stages are reported, in the order of the path.
+	fields := report.Fields()
+	require.Len(t, fields, 10)
+	require.Equal(t, string(proposal.StagePayloadWait), fields[0])
+	require.Equal(t, string(proposal.StageHandOff), fields[2])
+	require.Equal(t, []any{
+		"payload_value", "42", "blob_count", uint64(3),
+		"deposit_count", uint64(2),
+	}, fields[4:])
+}
@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package statsd
+
+import "time"
+
+const (
+	defaultAddress       = "127.0.0.1:8125"
+	defaultFlushInterval = time.Second
+)
+
+// Config is the configuration for pushing metrics to a StatsD agent.
+type Config struct {
+	// Enabled is the flag to enable pushing metrics to a StatsD agent.
+	Enabled bool `mapstructure:"enabled"`
+	// Address is the UDP address of the StatsD agent.
+	Address string `mapstructure:"address"`
+	// Datadog is the flag to send the labels of the metrics as DogStatsD
+	// tags, rather than appending their values to the metric names.
+	Datadog bool `mapstructure:"datadog"`
+	// FlushInterval is the interval at which the buffered metrics are sent.
+	FlushInterval time.Duration `mapstructure:"flush-interval"`
+}
+
+// DefaultConfig returns the default configuration for pushing metrics to a
+// StatsD agent.
+func DefaultConfig() Config {
+	return Config{
+		Enabled:       false,
+		Address:       defaultAddress,
+		Datadog:       false,
+		FlushInterval: defaultFlushInterval,
+	}
+}
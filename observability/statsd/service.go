@@ -0,0 +1,106 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package statsd
+
+import (
+	"context"
+	"io"
+	"net"
+	"time"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log"
+)
+
+// ErrInvalidFlushInterval is returned when the flush interval is not
+// positive.
+var ErrInvalidFlushInterval = errors.New("invalid statsd flush interval")
+
+// Service periodically sends the metrics of a Sink to a StatsD agent.
+type Service struct {
+	config Config
+	sink   *Sink
+	logger log.Logger
+}
+
+// NewService creates a new Service sending the metrics of the given sink.
+func NewService(config Config, sink *Sink, logger log.Logger) *Service {
+	return &Service{
+		config: config,
+		sink:   sink,
+		logger: logger,
+	}
+}
+
+// Name returns the name of the StatsD service.
+func (s *Service) Name() string {
+	return "statsd"
+}
+
+// Start sends the metrics to the configured agent at every flush interval
+// until the context is done.
+func (s *Service) Start(ctx context.Context) error {
+	if !s.config.Enabled {
+		return nil
+	}
+	if s.config.FlushInterval <= 0 {
+		return errors.Wrap(
+			ErrInvalidFlushInterval, s.config.FlushInterval.String(),
+		)
+	}
+
+	conn, err := net.Dial("udp", s.config.Address)
+	if err != nil {
+		return err
+	}
+	go s.flushLoop(ctx, conn)
+
+	s.logger.Info(
+		"Sending StatsD metrics",
+		"address", s.config.Address,
+		"flush_interval", s.config.FlushInterval,
+	)
+	return nil
+}
+
+// flushLoop flushes the sink to conn at every flush interval, and a last
+// time once the context is done.
+func (s *Service) flushLoop(ctx context.Context, conn net.Conn) {
+	defer conn.Close()
+	ticker := time.NewTicker(s.config.FlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			s.flush(conn)
+			return
+		case <-ticker.C:
+			s.flush(conn)
+		}
+	}
+}
+
+// flush sends the buffered metrics to w.
+func (s *Service) flush(w io.Writer) {
+	if err := s.sink.Flush(w); err != nil {
+		s.logger.Error("Failed to send StatsD metrics", "error", err)
+	}
+}
@@ -0,0 +1,136 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package statsd
+
+import (
+	"bytes"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// maxPacketSize is the size of the packets the metrics are sent in,
+	// which fits the MTU of most networks.
+	maxPacketSize = 1432
+	// maxBufferSize bounds the metrics buffered between two flushes.
+	maxBufferSize = 1 << 20
+)
+
+// replacer replaces the characters of the StatsD line protocol in names,
+// tags and label values.
+var replacer = strings.NewReplacer(
+	":", "_", "|", "_", "@", "_", ",", "_", "#", "_", " ", "_", "\n", "_",
+)
+
+// Sink buffers metrics in the StatsD line protocol until they are flushed.
+// Metrics recorded while the buffer is full are dropped.
+type Sink struct {
+	// datadog sends the labels as DogStatsD tags.
+	datadog bool
+
+	mu  sync.Mutex
+	buf []byte
+}
+
+// NewSink creates a new Sink, sending the labels of the metrics as
+// DogStatsD tags if datadog is set.
+func NewSink(datadog bool) *Sink {
+	return &Sink{datadog: datadog}
+}
+
+// IncrementCounter increments the counter identified by the key and labels.
+func (s *Sink) IncrementCounter(key string, args ...string) {
+	s.record(key, "1", "c", args)
+}
+
+// SetGauge sets the gauge identified by the key and labels.
+func (s *Sink) SetGauge(key string, value int64, args ...string) {
+	s.record(key, strconv.FormatInt(value, 10), "g", args)
+}
+
+// MeasureSince records the milliseconds elapsed since start in the timer
+// identified by the key and labels.
+func (s *Sink) MeasureSince(key string, start time.Time, args ...string) {
+	elapsed := float64(time.Since(start)) / float64(time.Millisecond)
+	s.record(key, strconv.FormatFloat(elapsed, 'f', -1, 64), "ms", args)
+}
+
+// Flush writes the buffered metrics to w, in packets of whole lines of at
+// most maxPacketSize bytes, unless a single line is longer.
+func (s *Sink) Flush(w io.Writer) error {
+	s.mu.Lock()
+	buf := s.buf
+	s.buf = nil
+	s.mu.Unlock()
+
+	for len(buf) > 0 {
+		end := len(buf)
+		if end > maxPacketSize {
+			end = bytes.LastIndexByte(buf[:maxPacketSize], '\n') + 1
+			if end == 0 {
+				end = bytes.IndexByte(buf, '\n') + 1
+			}
+		}
+		if _, err := w.Write(buf[:end]); err != nil {
+			return err
+		}
+		buf = buf[end:]
+	}
+	return nil
+}
+
+// record buffers a metric of the given StatsD type.
+func (s *Sink) record(key, value, kind string, args []string) {
+	line := s.line(key, value, kind, args)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.buf)+len(line) > maxBufferSize {
+		return
+	}
+	s.buf = append(s.buf, line...)
+}
+
+// line formats a metric in the StatsD line protocol. A trailing label key
+// without a value is dropped.
+func (s *Sink) line(key, value, kind string, args []string) string {
+	var (
+		name = replacer.Replace(key)
+		tags []string
+	)
+	for i := 0; i+1 < len(args); i += 2 {
+		if s.datadog {
+			tags = append(tags,
+				replacer.Replace(args[i])+":"+replacer.Replace(args[i+1]),
+			)
+		} else {
+			name += "." + replacer.Replace(args[i+1])
+		}
+	}
+
+	line := name + ":" + value + "|" + kind
+	if len(tags) > 0 {
+		line += "|#" + strings.Join(tags, ",")
+	}
+	return line + "\n"
+}
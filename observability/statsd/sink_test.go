@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package statsd_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/observability/statsd"
+	"github.com/stretchr/testify/require"
+)
+
+// packetWriter records every packet written to it.
+type packetWriter struct {
+	packets []string
+}
+
+func (w *packetWriter) Write(p []byte) (int, error) {
+	w.packets = append(w.packets, string(p))
+	return len(p), nil
+}
+
+func TestSink(t *testing.T) {
+	sink := statsd.NewSink(false)
+	sink.IncrementCounter("beacon_kit.engine.calls", "method", "new-payload")
+	sink.SetGauge("beacon_kit.deposit.index", 7)
+	sink.MeasureSince("beacon_kit.process_block", time.Now(), "success")
+
+	var buf bytes.Buffer
+	require.NoError(t, sink.Flush(&buf))
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	require.Len(t, lines, 3)
+	require.Equal(t, "beacon_kit.engine.calls.new-payload:1|c", lines[0])
+	require.Equal(t, "beacon_kit.deposit.index:7|g", lines[1])
+	require.True(t, strings.HasPrefix(lines[2], "beacon_kit.process_block:"))
+	require.True(t, strings.HasSuffix(lines[2], "|ms"))
+
+	// Flushed metrics are not sent again.
+	buf.Reset()
+	require.NoError(t, sink.Flush(&buf))
+	require.Empty(t, buf.String())
+}
+
+func TestSinkDatadog(t *testing.T) {
+	sink := statsd.NewSink(true)
+	sink.IncrementCounter(
+		"beacon_kit.engine.calls", "method", "new:payload", "node", "a|b",
+	)
+
+	var buf bytes.Buffer
+	require.NoError(t, sink.Flush(&buf))
+	require.Equal(t,
+		"beacon_kit.engine.calls:1|c|#method:new_payload,node:a_b\n",
+		buf.String(),
+	)
+}
+
+func TestSinkPackets(t *testing.T) {
+	sink := statsd.NewSink(false)
+	for range 200 {
+		sink.IncrementCounter("beacon_kit.engine.calls")
+	}
+
+	w := &packetWriter{}
+	require.NoError(t, sink.Flush(w))
+	require.Greater(t, len(w.packets), 1)
+	var lines int
+	for _, packet := range w.packets {
+		require.LessOrEqual(t, len(packet), 1432)
+		require.True(t, strings.HasSuffix(packet, "\n"))
+		lines += strings.Count(packet, "\n")
+	}
+	require.Equal(t, 200, lines)
+}
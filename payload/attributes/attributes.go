@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package attributes
+
+import (
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// PrevRandao returns the RANDAO mix that the payload built for the given slot
+// must use as its prevRandao (EIP-4399).
+func PrevRandao[WithdrawalT any](
+	chainSpec common.ChainSpec,
+	st BeaconState[WithdrawalT],
+	slot math.Slot,
+) (common.Bytes32, error) {
+	epoch := chainSpec.SlotToEpoch(slot)
+	return st.GetRandaoMixAtIndex(
+		epoch.Unwrap() % chainSpec.EpochsPerHistoricalVector(),
+	)
+}
+
+// BuildPayloadAttributes builds the attributes of the payload for the given
+// slot. The withdrawals are the ones expected by the state (EIP-4895) and the
+// parent beacon block root is the root of the block the payload builds on
+// (EIP-4788). Both the payload builder and external proposer tooling use it,
+// so that they construct identical attributes.
+func BuildPayloadAttributes[
+	PayloadAttributesT PayloadAttributes[PayloadAttributesT, WithdrawalT],
+	WithdrawalT any,
+](
+	chainSpec common.ChainSpec,
+	st BeaconState[WithdrawalT],
+	slot math.Slot,
+	timestamp uint64,
+	prevRandao common.Bytes32,
+	feeRecipient common.ExecutionAddress,
+	parentBlockRoot common.Root,
+) (PayloadAttributesT, error) {
+	var attributes PayloadAttributesT
+
+	// Get the expected withdrawals to include in this payload.
+	withdrawals, err := st.ExpectedWithdrawals()
+	if err != nil {
+		return attributes, err
+	}
+
+	return attributes.New(
+		chainSpec.ActiveForkVersionForSlot(slot),
+		timestamp,
+		prevRandao,
+		feeRecipient,
+		withdrawals,
+		parentBlockRoot,
+	)
+}
@@ -0,0 +1,117 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package attributes_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/berachain/beacon-kit/config/spec"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/payload/attributes"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	withdrawal        = *engineprimitives.Withdrawal
+	payloadAttributes = *engineprimitives.PayloadAttributes[withdrawal]
+)
+
+// mockState is a beacon state with fixed withdrawals and randao mixes.
+type mockState struct {
+	withdrawals    []withdrawal
+	withdrawalsErr error
+	randaoMixes    map[uint64]common.Bytes32
+}
+
+func (m *mockState) ExpectedWithdrawals() ([]withdrawal, error) {
+	return m.withdrawals, m.withdrawalsErr
+}
+
+func (m *mockState) GetRandaoMixAtIndex(
+	index uint64,
+) (common.Bytes32, error) {
+	return m.randaoMixes[index], nil
+}
+
+func TestBuildPayloadAttributes(t *testing.T) {
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+
+	var (
+		slot            = math.Slot(3*cs.SlotsPerEpoch() + 1)
+		feeRecipient    = common.ExecutionAddress{0x01}
+		parentBlockRoot = common.Root{0x02}
+		randaoMix       = common.Bytes32{0x03}
+		st              = &mockState{
+			withdrawals: []withdrawal{
+				{Index: 7, Validator: 1, Amount: 10},
+				{Index: 8, Validator: 2, Amount: 20},
+			},
+			randaoMixes: map[uint64]common.Bytes32{3: randaoMix},
+		}
+	)
+
+	prevRandao, err := attributes.PrevRandao(cs, st, slot)
+	require.NoError(t, err)
+	require.Equal(t, randaoMix, prevRandao)
+
+	attrs, err := attributes.BuildPayloadAttributes[payloadAttributes](
+		cs, st, slot, 1_700_000_000, prevRandao, feeRecipient, parentBlockRoot,
+	)
+	require.NoError(t, err)
+	require.Equal(t, cs.ActiveForkVersionForSlot(slot), attrs.Version())
+	require.Equal(t, math.U64(1_700_000_000), attrs.Timestamp)
+	require.Equal(t, randaoMix, attrs.PrevRandao)
+	require.Equal(t, feeRecipient, attrs.GetSuggestedFeeRecipient())
+	require.Equal(t, st.withdrawals, attrs.Withdrawals)
+	require.Equal(t, parentBlockRoot, attrs.ParentBeaconBlockRoot)
+
+	// The payload builder constructs identical attributes.
+	factory := attributes.NewAttributesFactory[
+		*mockState, payloadAttributes, withdrawal,
+	](cs, noop.NewLogger[any](), feeRecipient)
+	built, err := factory.BuildPayloadAttributes(
+		st, slot, 1_700_000_000, parentBlockRoot,
+	)
+	require.NoError(t, err)
+	require.Equal(t, attrs, built)
+}
+
+func TestBuildPayloadAttributes_WithdrawalsError(t *testing.T) {
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+
+	errWithdrawals := errors.New("withdrawals unavailable")
+	_, err = attributes.BuildPayloadAttributes[payloadAttributes](
+		cs,
+		&mockState{withdrawalsErr: errWithdrawals},
+		1,
+		1_700_000_000,
+		common.Bytes32{0x03},
+		common.ExecutionAddress{},
+		common.Root{},
+	)
+	require.ErrorIs(t, err, errWithdrawals)
+}
@@ -69,36 +69,29 @@ func (f *Factory[
 	timestamp uint64,
 	prevHeadRoot [32]byte,
 ) (PayloadAttributesT, error) {
-	var (
-		prevRandao [32]byte
-		attributes PayloadAttributesT
-		epoch      = f.chainSpec.SlotToEpoch(slot)
-	)
-
-	// Get the expected withdrawals to include in this payload.
-	withdrawals, err := st.ExpectedWithdrawals()
-	if err != nil {
-		f.logger.Error(
-			"Could not get expected withdrawals to get payload attribute",
-			"error",
-			err,
-		)
-		return attributes, err
-	}
+	var attributes PayloadAttributesT
 
 	// Get the previous randao mix.
-	if prevRandao, err = st.GetRandaoMixAtIndex(
-		epoch.Unwrap() % f.chainSpec.EpochsPerHistoricalVector(),
-	); err != nil {
+	prevRandao, err := PrevRandao(f.chainSpec, st, slot)
+	if err != nil {
 		return attributes, err
 	}
 
-	return attributes.New(
-		f.chainSpec.ActiveForkVersionForEpoch(epoch),
+	attributes, err = BuildPayloadAttributes[PayloadAttributesT](
+		f.chainSpec,
+		st,
+		slot,
 		timestamp,
 		prevRandao,
 		f.suggestedFeeRecipient,
-		withdrawals,
 		prevHeadRoot,
 	)
+	if err != nil {
+		f.logger.Error(
+			"Could not build payload attributes",
+			"error",
+			err,
+		)
+	}
+	return attributes, err
 }
@@ -0,0 +1,181 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package builder
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// circuitBreaker trips after a number of consecutive execution client
+// failures, and stays open until the execution client passes a health probe.
+type circuitBreaker struct {
+	// threshold is the number of consecutive failures tripping the breaker,
+	// zero disables it.
+	threshold uint64
+	// probeInterval is the minimum time between two health probes while the
+	// breaker is open.
+	probeInterval time.Duration
+
+	mu sync.Mutex
+	// failures is the number of consecutive failures.
+	failures uint64
+	// open is true while the breaker is tripped.
+	open bool
+	// lastProbe is the time of the last health probe.
+	lastProbe time.Time
+}
+
+// newCircuitBreaker creates a new circuit breaker.
+func newCircuitBreaker(
+	threshold uint64, probeInterval time.Duration,
+) *circuitBreaker {
+	return &circuitBreaker{
+		threshold:     threshold,
+		probeInterval: probeInterval,
+	}
+}
+
+// isOpen returns true if the breaker is tripped.
+func (cb *circuitBreaker) isOpen() bool {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	return cb.open
+}
+
+// recordSuccess resets the consecutive failures of a closed breaker. An open
+// breaker only closes once the execution client passes a health probe.
+func (cb *circuitBreaker) recordSuccess() {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	if !cb.open {
+		cb.failures = 0
+	}
+}
+
+// recordFailure counts a failure and returns true if it tripped the breaker.
+func (cb *circuitBreaker) recordFailure(now time.Time) bool {
+	if cb.threshold == 0 {
+		return false
+	}
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	if cb.open {
+		return false
+	}
+	cb.failures++
+	if cb.failures < cb.threshold {
+		return false
+	}
+	cb.open = true
+	cb.lastProbe = now
+	return true
+}
+
+// probeDue returns true if the breaker is open and the next health probe is
+// due, in which case the probe is accounted for.
+func (cb *circuitBreaker) probeDue(now time.Time) bool {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	if !cb.open || now.Sub(cb.lastProbe) < cb.probeInterval {
+		return false
+	}
+	cb.lastProbe = now
+	return true
+}
+
+// close closes the breaker and returns true if it was open.
+func (cb *circuitBreaker) close() bool {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	wasOpen := cb.open
+	cb.open = false
+	cb.failures = 0
+	return wasOpen
+}
+
+// ObserveNewPayload records the outcome of a newPayload call sent to the
+// execution client, so that the circuit breaker also trips when the
+// execution client fails to verify blocks.
+func (pb *PayloadBuilder[
+	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	PayloadAttributesT, PayloadIDT, WithdrawalT,
+]) ObserveNewPayload(err error) {
+	pb.observe(pb.slotClock.CurrentSlot(), err)
+}
+
+// observe records the outcome of a call to the execution client, tripping
+// the circuit breaker after too many consecutive failures.
+func (pb *PayloadBuilder[
+	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	PayloadAttributesT, PayloadIDT, WithdrawalT,
+]) observe(slot math.Slot, err error) {
+	switch {
+	case err == nil:
+		pb.breaker.recordSuccess()
+	case errors.Is(err, context.Canceled):
+		// The call was abandoned by the caller, which says nothing about
+		// the execution client.
+	case pb.breaker.recordFailure(time.Now()):
+		pb.logger.Error(
+			"Execution client keeps failing - no longer building payloads "+
+				"ahead of time, proposing empty blocks until it recovers",
+			"for_slot", slot.Base10(),
+			"num_failures", pb.cfg.CircuitBreakerThreshold,
+			"error", err,
+		)
+		pb.alerts.ObservePayloadBuilderCircuit(slot, err)
+	}
+}
+
+// circuitOpen returns true if the circuit breaker is open. While it is, the
+// execution client is probed at the configured interval and the breaker
+// closes as soon as a probe succeeds.
+func (pb *PayloadBuilder[
+	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	PayloadAttributesT, PayloadIDT, WithdrawalT,
+]) circuitOpen(ctx context.Context) bool {
+	if !pb.breaker.probeDue(time.Now()) {
+		return pb.breaker.isOpen()
+	}
+	if err := pb.ee.CheckHealth(ctx); err != nil {
+		pb.logger.Warn(
+			"Execution client failed health probe - "+
+				"still proposing empty blocks",
+			"error", err,
+		)
+		return true
+	}
+	if pb.breaker.close() {
+		pb.logger.Info(
+			"Execution client passed health probe - " +
+				"building payloads ahead of time again",
+		)
+		pb.alerts.ObservePayloadBuilderCircuit(
+			pb.slotClock.CurrentSlot(), nil,
+		)
+	}
+	return false
+}
@@ -50,6 +50,13 @@ type PayloadBuilder[
 	pc PayloadCache[PayloadIDT, [32]byte, math.Slot]
 	// attributesFactory is used to create attributes for the
 	attributesFactory AttributesFactory[BeaconStateT, PayloadAttributesT]
+	// slotClock is used to check payloads are built before their slot ends.
+	slotClock SlotClock
+	// alerts raises an alert when the circuit breaker trips.
+	alerts AlertManager
+	// breaker pauses building payloads ahead of time while the execution
+	// client keeps failing.
+	breaker *circuitBreaker
 }
 
 // New creates a new service.
@@ -67,6 +74,8 @@ func New[
 	ee ExecutionEngine[ExecutionPayloadT, PayloadAttributesT, PayloadIDT],
 	pc PayloadCache[PayloadIDT, [32]byte, math.Slot],
 	af AttributesFactory[BeaconStateT, PayloadAttributesT],
+	slotClock SlotClock,
+	alerts AlertManager,
 ) *PayloadBuilder[
 	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
 	PayloadAttributesT, PayloadIDT, WithdrawalT,
@@ -81,6 +90,11 @@ func New[
 		ee:                ee,
 		pc:                pc,
 		attributesFactory: af,
+		slotClock:         slotClock,
+		alerts:            alerts,
+		breaker: newCircuitBreaker(
+			cfg.CircuitBreakerThreshold, cfg.CircuitBreakerProbeInterval,
+		),
 	}
 }
 
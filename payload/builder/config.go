@@ -30,6 +30,13 @@ const (
 	// defaultPayloadTimeout is the default value for local build
 	// payload timeout.
 	defaultPayloadTimeout = 1200 * time.Millisecond
+	// defaultCircuitBreakerThreshold is the default number of consecutive
+	// execution client failures tripping the circuit breaker.
+	defaultCircuitBreakerThreshold = 5
+	// defaultCircuitBreakerProbeInterval is the default interval between two
+	// health probes of the execution client while the circuit breaker is
+	// open.
+	defaultCircuitBreakerProbeInterval = 10 * time.Second
 )
 
 // Config is the configuration for the payload builder.
@@ -44,15 +51,26 @@ type Config struct {
 	// PayloadTimeout is the timeout parameter for local build
 	// payload. This should match, or be slightly less than the configured
 	// timeout on your execution client. It also must be less than
-	// timeout_proposal in the CometBFT configuration.
+	// timeout_proposal in the CometBFT configuration. It is capped at the
+	// slot duration of the chain spec.
 	PayloadTimeout time.Duration `mapstructure:"payload-timeout"`
+	// CircuitBreakerThreshold is the number of consecutive getPayload or
+	// newPayload failures after which the builder stops requesting payloads
+	// ahead of time and proposes empty blocks, until the execution client
+	// passes a health probe. Zero disables the circuit breaker.
+	CircuitBreakerThreshold uint64 `mapstructure:"circuit-breaker-threshold"`
+	// CircuitBreakerProbeInterval is the interval between two health probes
+	// of the execution client while the circuit breaker is open.
+	CircuitBreakerProbeInterval time.Duration `mapstructure:"circuit-breaker-probe-interval"`
 }
 
 // DefaultConfig returns the default fork configuration.
 func DefaultConfig() Config {
 	return Config{
-		Enabled:               true,
-		SuggestedFeeRecipient: common.ExecutionAddress{},
-		PayloadTimeout:        defaultPayloadTimeout,
+		Enabled:                     true,
+		SuggestedFeeRecipient:       common.ExecutionAddress{},
+		PayloadTimeout:              defaultPayloadTimeout,
+		CircuitBreakerThreshold:     defaultCircuitBreakerThreshold,
+		CircuitBreakerProbeInterval: defaultCircuitBreakerProbeInterval,
 	}
 }
@@ -37,4 +37,12 @@ var (
 	// ErrNilPayloadEnvelope is returned when a nil payload envelope is
 	// received.
 	ErrNilPayloadEnvelope = errors.New("received nil payload envelope")
+
+	// ErrBaseFeeMismatch is returned when the base fee per gas of a payload
+	// does not follow from its parent.
+	ErrBaseFeeMismatch = errors.New("payload base fee mismatch")
+
+	// ErrExcessBlobGasMismatch is returned when the excess blob gas of a
+	// payload does not follow from its parent.
+	ErrExcessBlobGasMismatch = errors.New("payload excess blob gas mismatch")
 )
@@ -25,12 +25,16 @@ import (
 	"time"
 
 	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	engineerrors "github.com/berachain/beacon-kit/engine-primitives/errors"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/observability/proposal"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/math"
 )
 
 // RequestPayloadAsync builds a payload for the given slot and
-// returns the payload ID.
+// returns the payload ID. While the circuit breaker is open, the forkchoice
+// update is sent without attributes and no payload ID is returned.
 func (pb *PayloadBuilder[
 	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
 	PayloadAttributesT, PayloadIDT, WithdrawalT,
@@ -47,6 +51,39 @@ func (pb *PayloadBuilder[
 		return nil, ErrPayloadBuilderDisabled
 	}
 
+	// Keep the execution client following the chain, without asking it to
+	// build a payload it keeps failing to deliver.
+	if pb.circuitOpen(ctx) {
+		return nil, pb.notifyForkchoice(
+			ctx, slot, headEth1BlockHash, finalEth1BlockHash,
+		)
+	}
+
+	return pb.requestPayload(
+		ctx,
+		st,
+		slot,
+		timestamp,
+		parentBlockRoot,
+		headEth1BlockHash,
+		finalEth1BlockHash,
+	)
+}
+
+// requestPayload sends a forkchoice update with the attributes of the
+// payload for the given slot and caches the returned payload ID.
+func (pb *PayloadBuilder[
+	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	PayloadAttributesT, PayloadIDT, WithdrawalT,
+]) requestPayload(
+	ctx context.Context,
+	st BeaconStateT,
+	slot math.Slot,
+	timestamp uint64,
+	parentBlockRoot common.Root,
+	headEth1BlockHash common.ExecutionHash,
+	finalEth1BlockHash common.ExecutionHash,
+) (*PayloadIDT, error) {
 	if payloadID, found := pb.pc.Get(slot, parentBlockRoot); found {
 		pb.logger.Warn(
 			"aborting payload build; payload already exists in cache",
@@ -67,6 +104,9 @@ func (pb *PayloadBuilder[
 
 	// Submit the forkchoice update to the execution client.
 	var payloadID *PayloadIDT
+	defer proposal.FromContext(ctx).Observe(
+		proposal.StageForkchoiceUpdate, time.Now(),
+	)
 	payloadID, _, err = pb.ee.NotifyForkchoiceUpdate(
 		ctx, &engineprimitives.ForkchoiceUpdateRequest[PayloadAttributesT]{
 			State: &engineprimitives.ForkchoiceStateV1{
@@ -91,7 +131,8 @@ func (pb *PayloadBuilder[
 }
 
 // RequestPayloadSync request a payload for the given slot and
-// blocks until the payload is delivered.
+// blocks until the payload is delivered. While the circuit breaker is open,
+// the payload is fetched right away instead, see RequestPayloadNow.
 func (pb *PayloadBuilder[
 	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
 	PayloadAttributesT, PayloadIDT, WithdrawalT,
@@ -108,9 +149,23 @@ func (pb *PayloadBuilder[
 		return nil, ErrPayloadBuilderDisabled
 	}
 
+	// Do not wait on an execution client that keeps failing, propose an
+	// empty block instead.
+	if pb.circuitOpen(ctx) {
+		return pb.RequestPayloadNow(
+			ctx,
+			st,
+			slot,
+			timestamp,
+			parentBlockRoot,
+			parentEth1Hash,
+			finalBlockHash,
+		)
+	}
+
 	// Build the payload and wait for the execution client to
 	// return the payload ID.
-	payloadID, err := pb.RequestPayloadAsync(
+	payloadID, err := pb.requestPayload(
 		ctx,
 		st,
 		slot,
@@ -126,13 +181,19 @@ func (pb *PayloadBuilder[
 		return nil, ErrNilPayloadID
 	}
 
-	// Wait for the payload to be delivered to the execution client.
+	// Wait for the payload to be delivered to the execution client, for at
+	// most a slot so that short slots are not missed.
+	defer proposal.FromContext(ctx).Observe(
+		proposal.StagePayloadWait, time.Now(),
+	)
+	timeout := min(pb.cfg.PayloadTimeout, pb.slotClock.SlotDuration())
 	pb.logger.Info(
 		"Waiting for local payload to be delivered to execution client",
-		"for_slot", slot.Base10(), "timeout", pb.cfg.PayloadTimeout.String(),
+		"for_slot", slot.Base10(), "timeout", timeout.String(),
+		"time_left_in_slot", pb.slotClock.TimeUntilSlot(slot+1).String(),
 	)
 	select {
-	case <-time.After(pb.cfg.PayloadTimeout):
+	case <-time.After(timeout):
 		// We want to trigger delivery of the payload to the execution client
 		// before the timestamp expires.
 		break
@@ -141,7 +202,48 @@ func (pb *PayloadBuilder[
 	}
 
 	// Get the payload from the execution client.
-	return pb.getPayload(ctx, *payloadID, slot)
+	return pb.getPayload(ctx, *payloadID, slot, parentBlockRoot)
+}
+
+// RequestPayloadNow requests a payload for the given slot and fetches it
+// right away, without waiting for the execution client to fill it with
+// transactions. If no payload was built for the slot yet, the execution client
+// serves its initial, empty payload.
+func (pb *PayloadBuilder[
+	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	PayloadAttributesT, PayloadIDT, WithdrawalT,
+]) RequestPayloadNow(
+	ctx context.Context,
+	st BeaconStateT,
+	slot math.Slot,
+	timestamp uint64,
+	parentBlockRoot common.Root,
+	parentEth1Hash common.ExecutionHash,
+	finalBlockHash common.ExecutionHash,
+) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
+	if !pb.Enabled() {
+		return nil, ErrPayloadBuilderDisabled
+	}
+
+	payloadID, err := pb.requestPayload(
+		ctx,
+		st,
+		slot,
+		timestamp,
+		parentBlockRoot,
+		parentEth1Hash,
+		finalBlockHash,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if payloadID == nil {
+		return nil, ErrNilPayloadID
+	}
+	defer proposal.FromContext(ctx).Observe(
+		proposal.StagePayloadWait, time.Now(),
+	)
+	return pb.getPayload(ctx, *payloadID, slot, parentBlockRoot)
 }
 
 // RetrievePayload attempts to pull a previously built payload
@@ -168,7 +270,9 @@ func (pb *PayloadBuilder[
 	}
 
 	// Get the payload from the execution client.
-	envelope, err := pb.getPayload(ctx, payloadID, slot)
+	start := time.Now()
+	envelope, err := pb.getPayload(ctx, payloadID, slot, parentBlockRoot)
+	proposal.FromContext(ctx).Observe(proposal.StagePayloadWait, start)
 	if err != nil {
 		return nil, err
 	}
@@ -236,15 +340,28 @@ func (pb *PayloadBuilder[
 		"finalized_eth1_hash", lph.GetParentHash(),
 		"for_slot", slot.Base10(),
 	)
+	return pb.notifyForkchoice(
+		ctx, slot, lph.GetBlockHash(), lph.GetParentHash(),
+	)
+}
 
-	// Submit the forkchoice update to the execution client.
+// notifyForkchoice submits a forkchoice update without attributes to the
+// execution client.
+func (pb *PayloadBuilder[
+	_, _, _, PayloadAttributesT, _, _,
+]) notifyForkchoice(
+	ctx context.Context,
+	slot math.Slot,
+	headEth1BlockHash common.ExecutionHash,
+	finalEth1BlockHash common.ExecutionHash,
+) error {
 	var attrs PayloadAttributesT
-	_, _, err = pb.ee.NotifyForkchoiceUpdate(
+	_, _, err := pb.ee.NotifyForkchoiceUpdate(
 		ctx, &engineprimitives.ForkchoiceUpdateRequest[PayloadAttributesT]{
 			State: &engineprimitives.ForkchoiceStateV1{
-				HeadBlockHash:      lph.GetBlockHash(),
-				SafeBlockHash:      lph.GetParentHash(),
-				FinalizedBlockHash: lph.GetParentHash(),
+				HeadBlockHash:      headEth1BlockHash,
+				SafeBlockHash:      finalEth1BlockHash,
+				FinalizedBlockHash: finalEth1BlockHash,
 			},
 			PayloadAttributes: attrs,
 			ForkVersion:       pb.chainSpec.ActiveForkVersionForSlot(slot),
@@ -253,6 +370,11 @@ func (pb *PayloadBuilder[
 	return err
 }
 
+// getPayload fetches the payload with the given ID from the execution client,
+// recording the outcome with the circuit breaker. The payload ID is evicted
+// from the cache if the execution client no longer knows it, e.g. after a
+// restart, so that the payload is built again on the next request rather
+// than failing over and over.
 func (pb *PayloadBuilder[
 	_, ExecutionPayloadT, _,
 	_, PayloadIDT, _,
@@ -260,6 +382,7 @@ func (pb *PayloadBuilder[
 	ctx context.Context,
 	payloadID PayloadIDT,
 	slot math.U64,
+	parentBlockRoot common.Root,
 ) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
 	envelope, err := pb.ee.GetPayload(
 		ctx,
@@ -268,6 +391,15 @@ func (pb *PayloadBuilder[
 			ForkVersion: pb.chainSpec.ActiveForkVersionForSlot(slot),
 		},
 	)
+	pb.observe(slot, err)
+	if errors.Is(err, engineerrors.ErrUnknownPayload) {
+		pb.logger.Warn(
+			"Evicting payload ID unknown to the execution client",
+			"for_slot", slot.Base10(),
+			"parent_block_root", parentBlockRoot,
+		)
+		pb.pc.Delete(slot, parentBlockRoot)
+	}
 	if err != nil {
 		return nil, err
 	}
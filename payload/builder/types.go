@@ -22,6 +22,7 @@ package builder
 
 import (
 	"context"
+	"time"
 
 	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
 	"github.com/berachain/beacon-kit/primitives/common"
@@ -56,6 +57,7 @@ type PayloadCache[PayloadIDT, RootT, SlotT any] interface {
 	Get(slot SlotT, stateRoot RootT) (PayloadIDT, bool)
 	Has(slot SlotT, stateRoot RootT) bool
 	Set(slot SlotT, stateRoot RootT, pid PayloadIDT)
+	Delete(slot SlotT, stateRoot RootT)
 	UnsafePrunePrior(slot SlotT)
 }
 
@@ -78,6 +80,21 @@ type ExecutionPayloadHeader interface {
 	GetParentHash() common.ExecutionHash
 }
 
+// FeeHeader is the interface for the fee fields of an execution payload or
+// payload header, from which the fees of its child follow.
+type FeeHeader interface {
+	// GetGasLimit returns the gas limit.
+	GetGasLimit() math.U64
+	// GetGasUsed returns the gas used.
+	GetGasUsed() math.U64
+	// GetBaseFeePerGas returns the base fee per gas.
+	GetBaseFeePerGas() *math.U256
+	// GetBlobGasUsed returns the blob gas used.
+	GetBlobGasUsed() math.U64
+	// GetExcessBlobGas returns the excess blob gas.
+	GetExcessBlobGas() math.U64
+}
+
 // AttributesFactory is the interface for the attributes factory.
 type AttributesFactory[
 	BeaconStateT any,
@@ -123,4 +140,24 @@ type ExecutionEngine[
 		ctx context.Context,
 		req *engineprimitives.ForkchoiceUpdateRequest[PayloadAttributesT],
 	) (*PayloadIDT, *common.ExecutionHash, error)
+	// CheckHealth probes the execution client, returning an error if it is
+	// unreachable or still syncing.
+	CheckHealth(ctx context.Context) error
+}
+
+// AlertManager raises alerts when the execution client keeps failing.
+type AlertManager interface {
+	// ObservePayloadBuilderCircuit records that the circuit breaker of the
+	// payload builder tripped on the given error, or closed if it is nil.
+	ObservePayloadBuilderCircuit(slot math.Slot, err error)
+}
+
+// SlotClock is the interface for the wall-clock slot timing.
+type SlotClock interface {
+	// CurrentSlot returns the slot of the current wall-clock time.
+	CurrentSlot() math.Slot
+	// SlotDuration returns the duration of a slot.
+	SlotDuration() time.Duration
+	// TimeUntilSlot returns the time left until the given slot starts.
+	TimeUntilSlot(slot math.Slot) time.Duration
 }
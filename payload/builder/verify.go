@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package builder
+
+import (
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/eip1559"
+	"github.com/berachain/beacon-kit/primitives/eip4844"
+)
+
+// VerifyFees checks that the base fee per gas and the excess blob gas of a
+// payload follow from its parent, so that payloads from external builders can
+// be sanity-checked before they are proposed.
+func VerifyFees(parent, payload FeeHeader) error {
+	expectedBaseFee := eip1559.CalcBaseFee(
+		parent.GetGasLimit(),
+		parent.GetGasUsed(),
+		parent.GetBaseFeePerGas(),
+	)
+	if !expectedBaseFee.Eq(payload.GetBaseFeePerGas()) {
+		return errors.Wrapf(
+			ErrBaseFeeMismatch,
+			"expected: %s, got: %s",
+			expectedBaseFee, payload.GetBaseFeePerGas(),
+		)
+	}
+
+	expectedExcessBlobGas := eip4844.CalcExcessBlobGas(
+		parent.GetExcessBlobGas(),
+		parent.GetBlobGasUsed(),
+	)
+	if expectedExcessBlobGas != payload.GetExcessBlobGas() {
+		return errors.Wrapf(
+			ErrExcessBlobGasMismatch,
+			"expected: %d, got: %d",
+			expectedExcessBlobGas, payload.GetExcessBlobGas(),
+		)
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package cache
+
+import "strconv"
+
+// TelemetrySink is an interface for sending metrics to a telemetry backend.
+type TelemetrySink interface {
+	// IncrementCounter increments a counter metric identified by the provided
+	// keys.
+	IncrementCounter(key string, args ...string)
+}
+
+// noopTelemetrySink discards the metrics of a cache without sink.
+type noopTelemetrySink struct{}
+
+// IncrementCounter does nothing.
+func (noopTelemetrySink) IncrementCounter(string, ...string) {}
+
+// cacheMetrics is a struct that contains metrics for the payload ID cache.
+type cacheMetrics struct {
+	// sink is the sink for the metrics.
+	sink TelemetrySink
+}
+
+// newCacheMetrics creates a new cacheMetrics.
+func newCacheMetrics(sink TelemetrySink) *cacheMetrics {
+	return &cacheMetrics{sink: sink}
+}
+
+// markHit increments the counter of payload IDs found in the cache.
+func (cm *cacheMetrics) markHit() {
+	cm.sink.IncrementCounter("beacon_kit.payload.cache.hit")
+}
+
+// markMiss increments the counter of payload IDs not found in the cache.
+func (cm *cacheMetrics) markMiss() {
+	cm.sink.IncrementCounter("beacon_kit.payload.cache.miss")
+}
+
+// markExpired increments the counter of payload IDs removed because their
+// slot rolled over or the execution client no longer knows them.
+func (cm *cacheMetrics) markExpired(count int) {
+	for range count {
+		cm.sink.IncrementCounter("beacon_kit.payload.cache.expired")
+	}
+}
+
+// markEvicted increments the counter of payload IDs evicted to keep the
+// cache within its size.
+func (cm *cacheMetrics) markEvicted() {
+	cm.sink.IncrementCounter("beacon_kit.payload.cache.evicted")
+}
+
+// markInvalidated increments the counter of cache invalidations, labelled
+// with the number of dropped payload IDs.
+func (cm *cacheMetrics) markInvalidated(count int) {
+	cm.sink.IncrementCounter(
+		"beacon_kit.payload.cache.invalidated",
+		"num_payload_ids", strconv.Itoa(count),
+	)
+}
@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package cache
+
+// config is the configuration of a PayloadIDCache.
+type config struct {
+	// size is the maximum number of payload IDs in the cache.
+	size int
+	// sink is the sink of the cache metrics.
+	sink TelemetrySink
+}
+
+// Option is a functional option for the PayloadIDCache.
+type Option func(*config)
+
+// WithSize sets the maximum number of payload IDs in the cache.
+func WithSize(size int) Option {
+	return func(cfg *config) {
+		cfg.size = size
+	}
+}
+
+// WithTelemetrySink sets the sink of the cache metrics.
+func WithTelemetrySink(sink TelemetrySink) Option {
+	return func(cfg *config) {
+		cfg.sink = sink
+	}
+}
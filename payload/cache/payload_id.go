@@ -17,44 +17,75 @@
 // EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
 // MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
 // TITLE.
-
 package cache
 
 import (
 	"sync"
+
+	lru "github.com/hashicorp/golang-lru/v2"
 )
 
-// historicalPayloadIDCacheSize defines the maximum number of slots to retain
-// in the cache. Beyond this number, older slots will be pruned to manage
-// memory usage.
-const historicalPayloadIDCacheSize = 2
+const (
+	// historicalPayloadIDCacheSize defines the maximum number of slots to
+	// retain in the cache. Beyond this number, older slots will be pruned to
+	// manage memory usage.
+	historicalPayloadIDCacheSize = 2
+
+	// defaultPayloadIDCacheEntries is the default maximum number of payload
+	// IDs in the cache. A slot only ever has a handful of parent roots, e.g.
+	// when rounds restart on different proposals, so the bound is only hit
+	// if the payload IDs of a slot pile up.
+	defaultPayloadIDCacheEntries = 64
+)
+
+// payloadIDKey is the key of a payload ID in the cache.
+type payloadIDKey[RootT ~[32]byte, SlotT ~uint64] struct {
+	slot      SlotT
+	stateRoot RootT
+}
 
 // PayloadIDCache provides a mechanism to store and retrieve payload IDs based
 // on slot and parent block hash. It is designed to improve the efficiency of
 // payload ID retrieval by caching recent entries.
+//
+// The cache holds a bounded number of payload IDs, evicting the least
+// recently used ones first, and expires the payload IDs of older slots as
+// the slot rolls over.
 type PayloadIDCache[
 	PayloadIDT ~[8]byte, RootT ~[32]byte, SlotT ~uint64,
 ] struct {
-	// mu protects access to the slotToStateRootToPayloadID map.
+	// mu serializes the updates of the cache spanning several entries.
 	mu sync.RWMutex
-	// slotToStateRootToPayloadID is used for storing payload ID mappings
-	slotToStateRootToPayloadID map[SlotT]map[RootT]PayloadIDT
+	// payloadIDs holds the payload IDs by slot and parent block root.
+	payloadIDs *lru.Cache[payloadIDKey[RootT, SlotT], PayloadIDT]
+	// metrics is the metrics of the cache.
+	metrics *cacheMetrics
 }
 
 // NewPayloadIDCache initializes and returns a new instance of PayloadIDCache.
 // It prepares the internal data structures for storing payload ID mappings.
 func NewPayloadIDCache[
 	PayloadIDT ~[8]byte, RootT ~[32]byte, SlotT ~uint64,
-]() *PayloadIDCache[PayloadIDT, RootT, SlotT] {
+](opts ...Option) *PayloadIDCache[PayloadIDT, RootT, SlotT] {
+	cfg := &config{
+		size: defaultPayloadIDCacheEntries,
+		sink: noopTelemetrySink{},
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	//nolint:errcheck // only fails for a non-positive size.
+	payloadIDs, _ := lru.New[payloadIDKey[RootT, SlotT], PayloadIDT](
+		max(cfg.size, 1),
+	)
 	return &PayloadIDCache[PayloadIDT, RootT, SlotT]{
-		mu: sync.RWMutex{},
-		slotToStateRootToPayloadID: make(
-			map[SlotT]map[RootT]PayloadIDT,
-		),
+		mu:         sync.RWMutex{},
+		payloadIDs: payloadIDs,
+		metrics:    newCacheMetrics(cfg.sink),
 	}
 }
 
-// Has retrieves the payload ID associated with a given slot and eth1 hash.
 // Has checks if a payload ID exists for a given slot and eth1 hash.
 func (p *PayloadIDCache[_, RootT, SlotT]) Has(
 	slot SlotT,
@@ -62,25 +93,23 @@ func (p *PayloadIDCache[_, RootT, SlotT]) Has(
 ) bool {
 	p.mu.RLock()
 	defer p.mu.RUnlock()
-	_, ok := p.slotToStateRootToPayloadID[slot][stateRoot]
-	return ok
+	return p.payloadIDs.Contains(payloadIDKey[RootT, SlotT]{slot, stateRoot})
 }
 
-// Get was successful.
+// Get retrieves the payload ID associated with a given slot and eth1 hash,
+// returning false if it is not cached.
 func (p *PayloadIDCache[PayloadIDT, RootT, SlotT]) Get(
 	slot SlotT,
 	stateRoot RootT,
 ) (PayloadIDT, bool) {
 	p.mu.RLock()
 	defer p.mu.RUnlock()
-	innerMap, ok := p.slotToStateRootToPayloadID[slot]
-	if !ok {
-		return PayloadIDT{}, false
-	}
-	pid, ok := innerMap[stateRoot]
+	pid, ok := p.payloadIDs.Get(payloadIDKey[RootT, SlotT]{slot, stateRoot})
 	if !ok {
+		p.metrics.markMiss()
 		return PayloadIDT{}, false
 	}
+	p.metrics.markHit()
 	return pid, true
 }
 
@@ -93,18 +122,44 @@ func (p *PayloadIDCache[PayloadIDT, RootT, SlotT]) Set(
 	p.mu.Lock()
 	defer p.mu.Unlock()
 
-	// Prune older slots to maintain the cache size limit.
+	// Expire older slots to maintain the cache size limit.
 	if slot >= historicalPayloadIDCacheSize {
-		p.prunePrior(slot - historicalPayloadIDCacheSize)
+		p.metrics.markExpired(p.prunePrior(slot - historicalPayloadIDCacheSize))
 	}
 
 	// Update the cache with the new payload ID.
-	innerMap, exists := p.slotToStateRootToPayloadID[slot]
-	if !exists {
-		innerMap = make(map[RootT]PayloadIDT)
-		p.slotToStateRootToPayloadID[slot] = innerMap
+	if p.payloadIDs.Add(payloadIDKey[RootT, SlotT]{slot, stateRoot}, pid) {
+		p.metrics.markEvicted()
+	}
+}
+
+// Delete removes the payload ID for a given slot and eth1 hash, e.g. once
+// the execution client no longer knows it.
+func (p *PayloadIDCache[_, RootT, SlotT]) Delete(
+	slot SlotT,
+	stateRoot RootT,
+) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.payloadIDs.Remove(payloadIDKey[RootT, SlotT]{slot, stateRoot}) {
+		p.metrics.markExpired(1)
+	}
+}
+
+// Invalidate removes all payload IDs from the cache and returns their number.
+// It must be called when the execution client loses the payloads it is
+// building, e.g. when it restarts, so that no stale payload ID is requested
+// from it.
+func (p *PayloadIDCache[_, _, _]) Invalidate() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	count := p.payloadIDs.Len()
+	if count == 0 {
+		return 0
 	}
-	innerMap[stateRoot] = pid
+	p.payloadIDs.Purge()
+	p.metrics.markInvalidated(count)
+	return count
 }
 
 // UnsafePrunePrior removes payload IDs from the cache for slots less than
@@ -118,12 +173,15 @@ func (p *PayloadIDCache[_, _, SlotT]) UnsafePrunePrior(
 }
 
 // prunePrior removes payload IDs from the cache for slots less than
-// the specified slot. This method helps in managing the memory usage
-// of the cache by discarding outdated entries.
-func (p *PayloadIDCache[_, _, SlotT]) prunePrior(slot SlotT) {
-	for s := range p.slotToStateRootToPayloadID {
-		if s < slot {
-			delete(p.slotToStateRootToPayloadID, s)
+// the specified slot and returns the number of removed payload IDs. This
+// method helps in managing the memory usage of the cache by discarding
+// outdated entries.
+func (p *PayloadIDCache[_, _, SlotT]) prunePrior(slot SlotT) int {
+	var pruned int
+	for _, key := range p.payloadIDs.Keys() {
+		if key.slot < slot && p.payloadIDs.Remove(key) {
+			pruned++
 		}
 	}
+	return pruned
 }
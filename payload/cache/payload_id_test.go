@@ -21,6 +21,7 @@
 package cache_test
 
 import (
+	"sync"
 	"testing"
 
 	"github.com/berachain/beacon-kit/payload/cache"
@@ -100,3 +101,63 @@ func TestPayloadIDCache(t *testing.T) {
 		}
 	})
 }
+
+// countingSink counts the increments of every counter.
+type countingSink struct {
+	mu       sync.Mutex
+	counters map[string]int
+}
+
+func (s *countingSink) IncrementCounter(key string, _ ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.counters == nil {
+		s.counters = make(map[string]int)
+	}
+	s.counters[key]++
+}
+
+func (s *countingSink) count(key string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.counters["beacon_kit.payload.cache."+key]
+}
+
+func TestPayloadIDCacheBoundsAndMetrics(t *testing.T) {
+	sink := &countingSink{}
+	pc := cache.NewPayloadIDCache[[8]byte, [32]byte, uint64](
+		cache.WithSize(2), cache.WithTelemetrySink(sink),
+	)
+
+	// The least recently used payload ID is evicted beyond the bound.
+	pc.Set(10, [32]byte{1}, [8]byte{1})
+	pc.Set(10, [32]byte{2}, [8]byte{2})
+	_, ok := pc.Get(10, [32]byte{1})
+	require.True(t, ok)
+	pc.Set(10, [32]byte{3}, [8]byte{3})
+	require.False(t, pc.Has(10, [32]byte{2}))
+	require.True(t, pc.Has(10, [32]byte{1}))
+	require.True(t, pc.Has(10, [32]byte{3}))
+	require.Equal(t, 1, sink.count("evicted"))
+
+	// The payload IDs of older slots expire as the slot rolls over.
+	pc.Set(13, [32]byte{4}, [8]byte{4})
+	_, ok = pc.Get(10, [32]byte{1})
+	require.False(t, ok)
+	require.Equal(t, 2, sink.count("expired"))
+
+	// A payload ID unknown to the execution client is evicted.
+	pc.Delete(13, [32]byte{4})
+	require.False(t, pc.Has(13, [32]byte{4}))
+	require.Equal(t, 3, sink.count("expired"))
+
+	// The cache is dropped when the execution client restarts.
+	pc.Set(14, [32]byte{5}, [8]byte{5})
+	require.Equal(t, 1, pc.Invalidate())
+	require.Zero(t, pc.Invalidate())
+	require.False(t, pc.Has(14, [32]byte{5}))
+	require.Equal(t, 1, sink.count("invalidated"))
+
+	require.Equal(t, 1, sink.count("hit"))
+	require.Equal(t, 1, sink.count("miss"))
+}
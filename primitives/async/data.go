@@ -0,0 +1,103 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package async
+
+import (
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/transition"
+)
+
+// Head is the data of a NewHead event, published once a block has been
+// processed and its state is the head of the chain.
+type Head struct {
+	// Slot is the slot of the head block.
+	Slot math.Slot
+	// BlockRoot is the root of the head block.
+	BlockRoot common.Root
+	// StateRoot is the state root of the head block.
+	StateRoot common.Root
+	// ExecutionBlockHash is the hash of the execution payload of the head
+	// block.
+	ExecutionBlockHash common.ExecutionHash
+}
+
+// Checkpoint is the data of a FinalizedCheckpoint event. Every block is
+// final once committed, so a checkpoint is published for every block.
+type Checkpoint struct {
+	// Epoch is the epoch of the finalized block.
+	Epoch math.Epoch
+	// BlockRoot is the root of the finalized block.
+	BlockRoot common.Root
+	// StateRoot is the state root of the finalized block.
+	StateRoot common.Root
+}
+
+// Proposal is the data of a ProposalBuilt event. The event carries the
+// error if the local validator failed to build the block.
+type Proposal struct {
+	// Slot is the slot the block was built for.
+	Slot math.Slot
+	// BlockRoot is the root of the built block, zero if building failed.
+	BlockRoot common.Root
+}
+
+// InvalidPayload is the data of a PayloadInvalid event, published when the
+// execution client rejects the payload of an incoming block. The event
+// carries the error returned by the execution client.
+type InvalidPayload struct {
+	// Slot is the slot of the block carrying the payload.
+	Slot math.Slot
+	// BlockHash is the hash of the rejected payload.
+	BlockHash common.ExecutionHash
+}
+
+// Deposits is the data of a DepositObserved event, published when deposits
+// are read from the deposit contract.
+type Deposits struct {
+	// ExecutionBlock is the execution block the deposits were read from.
+	ExecutionBlock math.U64
+	// FirstIndex is the index of the first deposit read.
+	FirstIndex math.U64
+	// Count is the number of deposits read.
+	Count uint64
+}
+
+// ValidatorSetUpdate is the data of a ValidatorSetUpdated event, published
+// when the genesis or a finalized block changes the validator set handed to
+// consensus.
+type ValidatorSetUpdate struct {
+	// Slot is the slot of the block producing the updates, zero for the
+	// genesis.
+	Slot math.Slot
+	// Updates are the validator updates, in canonical order.
+	Updates transition.ValidatorUpdates
+}
+
+// ValidatorStatusChanges is the data of a ValidatorStatusChanged event,
+// published when a finalized block turning an epoch changes the status of
+// validators.
+type ValidatorStatusChanges struct {
+	// Epoch is the epoch from which the changes apply.
+	Epoch math.Epoch
+	// Changes are the status changes, sorted by validator index.
+	Changes []transition.ValidatorStatusChange
+}
@@ -46,4 +46,13 @@ const (
 	FinalSidecarsReceived          = "final-blob-sidecars-received"
 	FinalValidatorUpdatesProcessed = "final-validator-updates"
 	BeaconBlockFinalized           = "beacon-block-finalized"
+
+	// node events, published for any number of consumers.
+	NewHead                = "new-head"
+	FinalizedCheckpoint    = "finalized-checkpoint"
+	ProposalBuilt          = "proposal-built"
+	PayloadInvalid         = "payload-invalid"
+	DepositObserved        = "deposit-observed"
+	ValidatorSetUpdated    = "validator-set-updated"
+	ValidatorStatusChanged = "validator-status-changed"
 )
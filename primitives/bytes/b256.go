@@ -24,6 +24,7 @@ package bytes
 import (
 	"fmt"
 
+	"github.com/berachain/beacon-kit/primitives/bytes/buffer"
 	"github.com/berachain/beacon-kit/primitives/encoding/hex"
 	"github.com/prysmaticlabs/gohashtree"
 )
@@ -96,7 +97,9 @@ func (h B256) MarshalSSZ() ([]byte, error) {
 // HashTreeRoot returns the hash tree root of the B256.
 func (h B256) HashTreeRoot() (B32, error) {
 	//nolint:mnd // for a tree height of 3 we need 8 working chunks.
-	result := make([][32]byte, 8)
+	buf := buffer.GetChunks(8)
+	defer buffer.PutChunks(buf)
+	result := *buf
 	copy(result[0][:], h[:32])
 	copy(result[1][:], h[32:64])
 	copy(result[2][:], h[64:96])
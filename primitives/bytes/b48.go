@@ -24,6 +24,7 @@ package bytes
 import (
 	"fmt"
 
+	"github.com/berachain/beacon-kit/primitives/bytes/buffer"
 	"github.com/berachain/beacon-kit/primitives/encoding/hex"
 	"github.com/prysmaticlabs/gohashtree"
 )
@@ -90,7 +91,9 @@ func (h B48) MarshalSSZ() ([]byte, error) {
 
 func (h B48) HashTreeRoot() B32 {
 	//nolint:mnd // for a tree height of 1 we need 2 working chunks.
-	result := make([][32]byte, 2)
+	buf := buffer.GetChunks(2)
+	defer buffer.PutChunks(buf)
+	result := *buf
 	copy(result[0][:], h[:32])
 	copy(result[1][:], h[32:48])
 	gohashtree.HashChunks(result, result)
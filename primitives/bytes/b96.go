@@ -24,6 +24,7 @@ package bytes
 import (
 	"fmt"
 
+	"github.com/berachain/beacon-kit/primitives/bytes/buffer"
 	"github.com/berachain/beacon-kit/primitives/encoding/hex"
 	"github.com/prysmaticlabs/gohashtree"
 )
@@ -91,7 +92,9 @@ func (h B96) MarshalSSZ() ([]byte, error) {
 // HashTreeRoot returns the hash tree root of the B96.
 func (h B96) HashTreeRoot() B32 {
 	//nolint:mnd // for a tree height of 2 we need 4 working chunks.
-	result := make([][32]byte, 4)
+	buf := buffer.GetChunks(4)
+	defer buffer.PutChunks(buf)
+	result := *buf
 	copy(result[0][:], h[:32])
 	copy(result[1][:], h[32:64])
 	copy(result[2][:], h[64:96])
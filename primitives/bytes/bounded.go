@@ -0,0 +1,118 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package bytes
+
+import (
+	"fmt"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/encoding/hex"
+)
+
+// ErrExceedsLimit is returned when a bounded byte list is longer than its
+// limit.
+var ErrExceedsLimit = errors.New("byte list exceeds its limit")
+
+// Limit is the maximum length of a Bounded byte list. It is implemented by
+// zero-sized marker types so that the limit is part of the type.
+type Limit interface {
+	// MaxLength returns the maximum number of bytes in the list.
+	MaxLength() uint64
+}
+
+// Limit32 limits a Bounded byte list to 32 bytes, as used for the extra
+// data of execution payloads and for graffiti.
+type Limit32 struct{}
+
+// MaxLength returns 32.
+func (Limit32) MaxLength() uint64 { return B32Size }
+
+// Bounded is a byte list holding at most L.MaxLength() bytes.
+// For SSZ purposes it is serialized as a `List[Byte, L.MaxLength()]`.
+type Bounded[L Limit] []byte
+
+// NewBounded returns the input as a Bounded byte list. It errs if the input
+// is longer than the limit.
+func NewBounded[L Limit](input []byte) (Bounded[L], error) {
+	b := Bounded[L](input)
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// MaxLength returns the maximum number of bytes of the list.
+func (b Bounded[L]) MaxLength() uint64 {
+	var limit L
+	return limit.MaxLength()
+}
+
+// Validate returns an error if the list is longer than its limit.
+func (b Bounded[L]) Validate() error {
+	if uint64(len(b)) > b.MaxLength() {
+		return fmt.Errorf(
+			"%w, got %d, max %d", ErrExceedsLimit, len(b), b.MaxLength(),
+		)
+	}
+	return nil
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                TextMarshaler                               */
+/* -------------------------------------------------------------------------- */
+
+// MarshalText implements the encoding.TextMarshaler interface for Bounded.
+func (b Bounded[L]) MarshalText() ([]byte, error) {
+	return []byte(b.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for
+// Bounded.
+func (b *Bounded[L]) UnmarshalText(text []byte) error {
+	dec, err := hex.UnmarshalByteText(text)
+	if err != nil {
+		return err
+	}
+	bounded, err := NewBounded[L](dec)
+	if err != nil {
+		return err
+	}
+	*b = bounded
+	return nil
+}
+
+// String returns the hex string representation of Bounded.
+func (b Bounded[L]) String() string {
+	return hex.EncodeBytes(b)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                JSONMarshaler                               */
+/* -------------------------------------------------------------------------- */
+
+// UnmarshalJSON implements the json.Unmarshaler interface for Bounded.
+func (b *Bounded[L]) UnmarshalJSON(input []byte) error {
+	strippedInput, err := hex.ValidateQuotedString(input)
+	if err != nil {
+		return err
+	}
+	return b.UnmarshalText(strippedInput)
+}
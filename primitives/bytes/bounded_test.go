@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package bytes_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/berachain/beacon-kit/primitives/bytes"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBounded(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   []byte
+		wantErr error
+	}{
+		{name: "empty", input: []byte{}},
+		{name: "at limit", input: make([]byte, 32)},
+		{
+			name:    "over limit",
+			input:   make([]byte, 33),
+			wantErr: bytes.ErrExceedsLimit,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := bytes.NewBounded[bytes.Limit32](tt.input)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.input, []byte(b))
+			require.Equal(t, uint64(32), b.MaxLength())
+		})
+	}
+}
+
+func TestBoundedJSON(t *testing.T) {
+	b := bytes.Bounded[bytes.Limit32]{0x01, 0x02}
+	out, err := json.Marshal(b)
+	require.NoError(t, err)
+	require.JSONEq(t, `"0x0102"`, string(out))
+
+	var got bytes.Bounded[bytes.Limit32]
+	require.NoError(t, json.Unmarshal(out, &got))
+	require.Equal(t, b, got)
+
+	tooLong, err := json.Marshal(bytes.Bytes(make([]byte, 33)))
+	require.NoError(t, err)
+	err = json.Unmarshal(tooLong, &got)
+	require.ErrorIs(t, err, bytes.ErrExceedsLimit)
+}
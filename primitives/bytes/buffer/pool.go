@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package buffer
+
+import "sync"
+
+// chunks is the process-wide pool of hashing chunks shared by the
+// fixed-size HashTreeRoot implementations.
+//
+//nolint:gochecknoglobals // shared across hashers by design.
+var chunks = NewPool[[32]byte]()
+
+// GetChunks returns a zeroed slice of hashing chunks of the given size from
+// the shared pool. The buffer must be handed back with PutChunks once the
+// caller no longer references it.
+func GetChunks(size int) *[][32]byte {
+	return chunks.Get(size)
+}
+
+// PutChunks returns a buffer obtained from GetChunks to the shared pool.
+func PutChunks(buf *[][32]byte) {
+	chunks.Put(buf)
+}
+
+// Pool is a concurrency-safe pool of buffers for merkle tree hashing. Unlike
+// ReusableBuffer it may be shared between goroutines, at the cost of the
+// caller having to hand every buffer back with Put. Buffers are passed by
+// pointer so that returning them to the pool does not allocate.
+type Pool[RootT ~[32]byte] struct {
+	pool sync.Pool
+}
+
+// NewPool creates a new pool of buffers for merkle tree hashing.
+func NewPool[RootT ~[32]byte]() *Pool[RootT] {
+	return &Pool[RootT]{
+		pool: sync.Pool{
+			New: func() any {
+				buf := make([]RootT, 0, initialBufferSize)
+				return &buf
+			},
+		},
+	}
+}
+
+// Get returns a buffer holding a zeroed slice of roots of the given size.
+func (p *Pool[RootT]) Get(size int) *[]RootT {
+	//nolint:errcheck // the pool only ever holds *[]RootT.
+	buf := p.pool.Get().(*[]RootT)
+	if cap(*buf) < size {
+		*buf = make([]RootT, size)
+	}
+	*buf = (*buf)[:size]
+	clear(*buf)
+	return buf
+}
+
+// Put returns a buffer obtained from Get to the pool.
+func (p *Pool[RootT]) Put(buf *[]RootT) {
+	*buf = (*buf)[:0]
+	p.pool.Put(buf)
+}
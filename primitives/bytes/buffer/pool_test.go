@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+
+package buffer_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/berachain/beacon-kit/primitives/bytes/buffer"
+	"github.com/stretchr/testify/require"
+)
+
+// Test that buffers handed out by the pool are always zeroed, even when a
+// previously returned buffer held data.
+func TestPoolGetZeroed(t *testing.T) {
+	pool := buffer.NewPool[[32]byte]()
+	for _, size := range []int{0, 1, 4, 64, 65, 3} {
+		buf := pool.Get(size)
+		require.Len(t, *buf, size)
+		for i := range *buf {
+			require.Equal(t, [32]byte{}, (*buf)[i])
+			(*buf)[i] = [32]byte{0xff}
+		}
+		pool.Put(buf)
+	}
+}
+
+// Test that the shared pool may be used from many goroutines at once.
+func TestGetChunksConcurrent(t *testing.T) {
+	var wg sync.WaitGroup
+	for g := range 16 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 1000 {
+				buf := buffer.GetChunks(g%8 + 1)
+				for i := range *buf {
+					if (*buf)[i] != [32]byte{} {
+						t.Error("pooled chunk was not zeroed")
+						return
+					}
+					(*buf)[i][0] = byte(g)
+				}
+				buffer.PutChunks(buf)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// Benchmark for the Get and Put methods on the shared chunk pool.
+func BenchmarkGetChunks(b *testing.B) {
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			buf := buffer.GetChunks(8)
+			(*buf)[0][0] = 1
+			buffer.PutChunks(buf)
+		}
+	})
+}
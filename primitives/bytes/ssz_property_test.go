@@ -0,0 +1,145 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package bytes_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/primitives/bytes"
+	fastssz "github.com/ferranbt/fastssz"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+)
+
+// fixedBytes is the set of methods shared by the fixed-size byte vectors.
+type fixedBytes interface {
+	MarshalSSZ() ([]byte, error)
+	MarshalText() ([]byte, error)
+}
+
+func TestFixedBytesProperties(t *testing.T) {
+	t.Run("B4", func(t *testing.T) {
+		checkFixedBytes(t, bytes.B4Size,
+			func(b []byte) bytes.B4 { return bytes.B4(b) },
+			bytes.B4.HashTreeRoot,
+		)
+	})
+	t.Run("B8", func(t *testing.T) {
+		checkFixedBytes(t, bytes.B8Size,
+			func(b []byte) bytes.B8 { return bytes.B8(b) },
+			bytes.B8.HashTreeRoot,
+		)
+	})
+	t.Run("B20", func(t *testing.T) {
+		checkFixedBytes(t, bytes.B20Size,
+			func(b []byte) bytes.B20 { return bytes.B20(b) },
+			bytes.B20.HashTreeRoot,
+		)
+	})
+	t.Run("B32", func(t *testing.T) {
+		checkFixedBytes(t, bytes.B32Size,
+			func(b []byte) bytes.B32 { return bytes.B32(b) },
+			func(h bytes.B32) (bytes.B32, error) {
+				return h.HashTreeRoot(), nil
+			},
+		)
+	})
+	t.Run("B48", func(t *testing.T) {
+		checkFixedBytes(t, bytes.B48Size,
+			func(b []byte) bytes.B48 { return bytes.B48(b) },
+			func(h bytes.B48) (bytes.B32, error) {
+				return h.HashTreeRoot(), nil
+			},
+		)
+	})
+	t.Run("B96", func(t *testing.T) {
+		checkFixedBytes(t, bytes.B96Size,
+			func(b []byte) bytes.B96 { return bytes.B96(b) },
+			func(h bytes.B96) (bytes.B32, error) {
+				return h.HashTreeRoot(), nil
+			},
+		)
+	})
+	t.Run("B256", func(t *testing.T) {
+		checkFixedBytes(t, bytes.B256Size,
+			func(b []byte) bytes.B256 { return bytes.B256(b) },
+			bytes.B256.HashTreeRoot,
+		)
+	})
+}
+
+// checkFixedBytes checks that a vector of the given size encodes to its
+// own bytes, merkleizes the same way as fastssz and survives a text round
+// trip.
+func checkFixedBytes[T fixedBytes, PT interface {
+	*T
+	UnmarshalText(text []byte) error
+}](
+	t *testing.T,
+	size int,
+	fromBytes func([]byte) T,
+	root func(T) (bytes.B32, error),
+) {
+	t.Helper()
+	rapid.Check(t, func(t *rapid.T) {
+		raw := rapid.SliceOfN(rapid.Byte(), size, size).Draw(t, "raw")
+		h := fromBytes(raw)
+
+		bz, err := h.MarshalSSZ()
+		require.NoError(t, err)
+		require.Equal(t, raw, bz)
+
+		hh := fastssz.NewHasher()
+		hh.PutBytes(raw)
+		want, err := hh.HashRoot()
+		require.NoError(t, err)
+		got, err := root(h)
+		require.NoError(t, err)
+		require.Equal(t, bytes.B32(want), got)
+
+		text, err := h.MarshalText()
+		require.NoError(t, err)
+		var decoded T
+		require.NoError(t, PT(&decoded).UnmarshalText(text))
+		require.Equal(t, h, decoded)
+	})
+}
+
+func TestBoundedProperties(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		raw := rapid.SliceOfN(
+			rapid.Byte(), 0, 2*bytes.B32Size,
+		).Draw(t, "raw")
+
+		b, err := bytes.NewBounded[bytes.Limit32](raw)
+		if len(raw) > bytes.B32Size {
+			require.ErrorIs(t, err, bytes.ErrExceedsLimit)
+			return
+		}
+		require.NoError(t, err)
+
+		text, err := b.MarshalText()
+		require.NoError(t, err)
+		var decoded bytes.Bounded[bytes.Limit32]
+		require.NoError(t, decoded.UnmarshalText(text))
+		require.Equal(t, []byte(b), []byte(decoded))
+	})
+}
@@ -23,7 +23,9 @@ package common
 import (
 	"bytes"
 	"encoding"
+	"strings"
 
+	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/primitives/encoding/hex"
 	"github.com/berachain/beacon-kit/primitives/encoding/json"
 	"golang.org/x/crypto/sha3"
@@ -41,6 +43,10 @@ var (
 	_ json.Unmarshaler         = (*ExecutionAddress)(nil)
 )
 
+// ErrInvalidChecksum is returned when a mixed-case execution address does not
+// match its EIP-55 checksum.
+var ErrInvalidChecksum = errors.New("invalid EIP-55 address checksum")
+
 /* -------------------------------------------------------------------------- */
 /*                                ExecutionHash                               */
 /* -------------------------------------------------------------------------- */
@@ -51,8 +57,24 @@ var (
 type ExecutionHash [32]byte
 
 // NewExecutionHashFromHex creates a new hash from a hex string.
+// It panics if the input is not a valid hash, see ParseExecutionHash.
 func NewExecutionHashFromHex(input string) ExecutionHash {
-	return ExecutionHash(hex.MustToBytes(input))
+	h, err := ParseExecutionHash(input)
+	if err != nil {
+		panic(err)
+	}
+	return h
+}
+
+// ParseExecutionHash parses a 0x prefixed hex string into a hash. Unlike a
+// plain conversion, inputs of the wrong length are rejected rather than
+// truncated or padded.
+func ParseExecutionHash(input string) (ExecutionHash, error) {
+	var h ExecutionHash
+	if err := hex.DecodeFixedText([]byte(input), h[:]); err != nil {
+		return ExecutionHash{}, err
+	}
+	return h, nil
 }
 
 // Hex converts a hash to a hex string.
@@ -94,8 +116,34 @@ func (h *ExecutionHash) UnmarshalJSON(input []byte) error {
 type ExecutionAddress [20]byte
 
 // NewExecutionAddressFromHex creates a new address from a hex string.
+// It panics if the input is not a valid address, see ParseExecutionAddress.
 func NewExecutionAddressFromHex(input string) ExecutionAddress {
-	return ExecutionAddress(hex.MustToBytes(input))
+	a, err := ParseExecutionAddress(input)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// ParseExecutionAddress parses a 0x prefixed hex string into an address.
+// Inputs of the wrong length are rejected rather than truncated or padded.
+// All lower or all upper case inputs are accepted as is, while mixed-case
+// inputs must carry a valid EIP-55 checksum.
+func ParseExecutionAddress(input string) (ExecutionAddress, error) {
+	var a ExecutionAddress
+	if err := hex.DecodeFixedText([]byte(input), a[:]); err != nil {
+		return ExecutionAddress{}, err
+	}
+
+	digits := input[len(hex.Prefix):]
+	if digits != strings.ToLower(digits) &&
+		digits != strings.ToUpper(digits) &&
+		digits != a.Hex()[len(hex.Prefix):] {
+		return ExecutionAddress{}, errors.Wrapf(
+			ErrInvalidChecksum, "got %s, want %s", input, a.Hex(),
+		)
+	}
+	return a, nil
 }
 
 // Equals returns true if the two addresses are the same.
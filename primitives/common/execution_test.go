@@ -22,6 +22,7 @@ package common_test
 
 import (
 	"encoding/json"
+	"strings"
 	"testing"
 
 	"github.com/berachain/beacon-kit/primitives/common"
@@ -67,3 +68,69 @@ func TestExecutionAddressMarshalling(t *testing.T) {
 		})
 	}
 }
+
+func TestParseExecutionAddress(t *testing.T) {
+	// Checksummed test vector from EIP-55.
+	const checksummed = "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"
+	tests := []struct {
+		name    string
+		input   string
+		wantErr error
+	}{
+		{name: "checksummed", input: checksummed},
+		{
+			name:  "lower case",
+			input: "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed",
+		},
+		{
+			name:  "upper case",
+			input: "0x5AAEB6053F3E94C9B9A09F33669435E7EF1BEAED",
+		},
+		{
+			name:    "bad checksum",
+			input:   "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD",
+			wantErr: common.ErrInvalidChecksum,
+		},
+		{
+			name:    "too short",
+			input:   "0x5aaeb6053f3e94c9b9a09f33669435e7ef1bea",
+			wantErr: hex.ErrInvalidHexStringLength,
+		},
+		{
+			name:    "too long",
+			input:   "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed00",
+			wantErr: hex.ErrInvalidHexStringLength,
+		},
+		{
+			name:    "missing prefix",
+			input:   "5aaeb6053f3e94c9b9a09f33669435e7ef1beaed",
+			wantErr: hex.ErrMissingPrefix,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			addr, err := common.ParseExecutionAddress(tt.input)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				require.Panics(t, func() {
+					common.NewExecutionAddressFromHex(tt.input)
+				})
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, checksummed, addr.Hex())
+		})
+	}
+}
+
+func TestParseExecutionHash(t *testing.T) {
+	_, err := common.ParseExecutionHash("0x" + strings.Repeat("ab", 32))
+	require.NoError(t, err)
+
+	_, err = common.ParseExecutionHash("0x" + strings.Repeat("ab", 33))
+	require.ErrorIs(t, err, hex.ErrInvalidHexStringLength)
+
+	_, err = common.ParseExecutionHash("0x" + strings.Repeat("zz", 32))
+	require.ErrorIs(t, err, hex.ErrInvalidString)
+}
@@ -27,4 +27,12 @@ const (
 	//
 	// https://github.com/ethereum/EIPs/blob/master/EIPS/eip-4844.md
 	BlobCommitmentVersion uint8 = 0x01
+
+	// BlobGasPerBlob is the amount of blob gas consumed by a single blob, as
+	// defined in EIP-4844.
+	BlobGasPerBlob uint64 = 1 << 17
+
+	// TargetBlobGasPerBlock is the blob gas a block targets, above which the
+	// excess blob gas, and so the blob base fee, increases.
+	TargetBlobGasPerBlock = 3 * BlobGasPerBlob
 )
@@ -38,6 +38,18 @@ const (
 	// execution payload.
 	MaxWithdrawalsPerPayload uint64 = 16
 
+	// MaxBlobCommitmentsPerBlock is the maximum number of blob KZG commitments
+	// per block.
+	MaxBlobCommitmentsPerBlock uint64 = 16
+
+	// MaxOperationsPerBlock is the maximum number of application-defined
+	// operations per block.
+	MaxOperationsPerBlock uint64 = 16
+
+	// MaxBytesPerOperation is the maximum number of bytes per
+	// application-defined operation.
+	MaxBytesPerOperation uint64 = 1024
+
 	// MaxBytesPerTx is the maximum number of bytes per transaction.
 	MaxBytesPerTx uint64 = 1073741824
 )
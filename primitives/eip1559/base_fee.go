@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package eip1559
+
+import (
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// CalcBaseFee returns the base fee per gas a child block must have, given the
+// gas limit, gas used and base fee per gas of its parent, as defined in
+// EIP-1559.
+//
+// https://github.com/ethereum/EIPs/blob/master/EIPS/eip-1559.md
+func CalcBaseFee(
+	parentGasLimit, parentGasUsed math.U64,
+	parentBaseFee *math.U256,
+) *math.U256 {
+	parentGasTarget := parentGasLimit.Unwrap() / constants.ElasticityMultiplier
+	if parentGasTarget == 0 || parentGasUsed.Unwrap() == parentGasTarget {
+		return new(math.U256).Set(parentBaseFee)
+	}
+
+	var (
+		baseFee = new(math.U256).Set(parentBaseFee)
+		delta   = new(math.U256)
+		target  = math.NewU256(parentGasTarget)
+		denom   = math.NewU256(constants.BaseFeeChangeDenominator)
+	)
+	if parentGasUsed.Unwrap() > parentGasTarget {
+		// The base fee increases by at least one wei if the parent used more
+		// gas than its target.
+		delta.Mul(
+			parentBaseFee,
+			math.NewU256(parentGasUsed.Unwrap()-parentGasTarget),
+		)
+		delta.Div(delta, target)
+		delta.Div(delta, denom)
+		if delta.IsZero() {
+			delta.SetOne()
+		}
+		return baseFee.Add(baseFee, delta)
+	}
+
+	delta.Mul(
+		parentBaseFee,
+		math.NewU256(parentGasTarget-parentGasUsed.Unwrap()),
+	)
+	delta.Div(delta, target)
+	delta.Div(delta, denom)
+	if delta.Gt(baseFee) {
+		return baseFee.Clear()
+	}
+	return baseFee.Sub(baseFee, delta)
+}
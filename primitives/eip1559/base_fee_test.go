@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package eip1559_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/primitives/eip1559"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalcBaseFee(t *testing.T) {
+	tests := []struct {
+		name          string
+		parentGasUsed math.U64
+		expected      uint64
+	}{
+		{
+			name:          "at target",
+			parentGasUsed: 10_000_000,
+			expected:      1_000_000_000,
+		},
+		{
+			name:          "full block",
+			parentGasUsed: 20_000_000,
+			expected:      1_125_000_000,
+		},
+		{
+			name:          "empty block",
+			parentGasUsed: 0,
+			expected:      875_000_000,
+		},
+		{
+			name:          "above target",
+			parentGasUsed: 11_000_000,
+			expected:      1_012_500_000,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t,
+				tt.expected,
+				eip1559.CalcBaseFee(
+					20_000_000, tt.parentGasUsed, math.NewU256(1_000_000_000),
+				).Uint64(),
+			)
+		})
+	}
+}
+
+func TestCalcBaseFee_MinimumIncrease(t *testing.T) {
+	// The base fee of a block above its target increases by at least one wei.
+	require.Equal(t,
+		uint64(8),
+		eip1559.CalcBaseFee(20_000_000, 10_000_001, math.NewU256(7)).Uint64(),
+	)
+}
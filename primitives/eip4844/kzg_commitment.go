@@ -22,6 +22,7 @@ package eip4844
 
 import (
 	"github.com/berachain/beacon-kit/primitives/bytes"
+	"github.com/berachain/beacon-kit/primitives/bytes/buffer"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/constants"
 	"github.com/berachain/beacon-kit/primitives/crypto/sha256"
@@ -55,7 +56,12 @@ func (c KZGCommitment) ToHashChunks() [][32]byte {
 
 // HashTreeRoot returns the hash tree root of the commitment.
 func (c KZGCommitment) HashTreeRoot() common.Root {
-	chunks := c.ToHashChunks()
+	buf := buffer.GetChunks(2) //nolint:mnd // 2 chunks.
+	defer buffer.PutChunks(buf)
+	chunks := *buf
+	copy(chunks[0][:], c[:constants.RootLength])
+	copy(chunks[1][:], c[constants.RootLength:])
+	gohashtree.HashChunks(chunks, chunks)
 	return chunks[0]
 }
 
@@ -27,7 +27,9 @@ import (
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/constants"
 	"github.com/berachain/beacon-kit/primitives/eip4844"
+	fastssz "github.com/ferranbt/fastssz"
 	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
 )
 
 func TestKzgCommitmentToVersionedHash(t *testing.T) {
@@ -109,6 +111,20 @@ func TestKZGCommitmentHashTreeRoot(t *testing.T) {
 	}
 }
 
+func TestKZGCommitmentHashTreeRootProperty(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		raw := rapid.SliceOfN(rapid.Byte(), 48, 48).Draw(t, "commitment")
+
+		hh := fastssz.NewHasher()
+		hh.PutBytes(raw)
+		want, err := hh.HashRoot()
+		require.NoError(t, err)
+		require.Equal(
+			t, common.Root(want), eip4844.KZGCommitment(raw).HashTreeRoot(),
+		)
+	})
+}
+
 func TestKZGCommitmentUnmarshalJSON(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package compress
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/golang/snappy"
+)
+
+// MaxDecompressedSize is the largest size Decompress inflates data to, which
+// bounds the memory a malicious payload can make a node allocate.
+const MaxDecompressedSize = 1 << 27
+
+// ErrTooLarge is returned when compressed data inflates past
+// MaxDecompressedSize.
+var ErrTooLarge = errors.New("decompressed data too large")
+
+// snappyStreamIdentifier is the chunk every snappy framed stream starts with.
+//
+//nolint:gochecknoglobals // constant byte slice.
+var snappyStreamIdentifier = []byte("\xff\x06\x00\x00sNaPpY")
+
+// Compress compresses the data in the snappy framing format.
+func Compress(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	w := snappy.NewBufferedWriter(&buf)
+	if _, err := w.Write(data); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// IsCompressed reports whether the data is in the snappy framing format. The
+// SSZ encoding of blocks and sidecars starts with a slot or an index, which
+// would have to exceed 2^56 to spell out the stream identifier.
+func IsCompressed(data []byte) bool {
+	return bytes.HasPrefix(data, snappyStreamIdentifier)
+}
+
+// Decompress decompresses data written by Compress. Data that is not
+// compressed is returned as is, so that it may be stored before compression
+// was introduced.
+func Decompress(data []byte) ([]byte, error) {
+	if !IsCompressed(data) {
+		return data, nil
+	}
+	out, err := io.ReadAll(io.LimitReader(
+		snappy.NewReader(bytes.NewReader(data)), MaxDecompressedSize+1,
+	))
+	if err != nil {
+		return nil, err
+	}
+	if len(out) > MaxDecompressedSize {
+		return nil, ErrTooLarge
+	}
+	return out, nil
+}
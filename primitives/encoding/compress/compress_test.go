@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package compress_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/berachain/beacon-kit/primitives/encoding/compress"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompressRoundTrip(t *testing.T) {
+	data := bytes.Repeat([]byte("beacon"), 1<<15)
+
+	compressed, err := compress.Compress(data)
+	require.NoError(t, err)
+	require.True(t, compress.IsCompressed(compressed))
+	require.Less(t, len(compressed), len(data))
+
+	got, err := compress.Decompress(compressed)
+	require.NoError(t, err)
+	require.Equal(t, data, got)
+}
+
+func TestDecompressUncompressed(t *testing.T) {
+	for _, data := range [][]byte{nil, {}, {0x04, 0, 0, 0}, {0xff, 0x06}} {
+		require.False(t, compress.IsCompressed(data))
+		got, err := compress.Decompress(data)
+		require.NoError(t, err)
+		require.Equal(t, data, got)
+	}
+}
+
+func TestDecompressCorrupted(t *testing.T) {
+	compressed, err := compress.Compress(bytes.Repeat([]byte{1}, 1024))
+	require.NoError(t, err)
+	compressed[len(compressed)-1] ^= 0xff
+
+	_, err = compress.Decompress(compressed)
+	require.Error(t, err)
+}
@@ -264,3 +264,66 @@ func BenchmarkDecodeFixedText(b *testing.B) {
 		})
 	}
 }
+
+func TestDecodeFixedConstantTime(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		outLen   int
+		expected []byte
+		wantErr  error
+	}{
+		{
+			name:     "mixed case",
+			input:    "0x09aFbC",
+			outLen:   3,
+			expected: []byte{0x09, 0xaf, 0xbc},
+		},
+		{
+			name:    "invalid character",
+			input:   "0x09aGbc",
+			outLen:  3,
+			wantErr: hex.ErrInvalidString,
+		},
+		{
+			name:    "boundary character",
+			input:   "0x09a/bc",
+			outLen:  3,
+			wantErr: hex.ErrInvalidString,
+		},
+		{
+			name:    "wrong length",
+			input:   "0x09afbc",
+			outLen:  4,
+			wantErr: hex.ErrInvalidHexStringLength,
+		},
+		{
+			name:    "missing prefix",
+			input:   "09afbc",
+			outLen:  3,
+			wantErr: hex.ErrMissingPrefix,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := make([]byte, tt.outLen)
+			err := hex.DecodeFixedConstantTime(tt.input, out)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, out)
+		})
+	}
+
+	// Every byte value must decode to the same result as DecodeFixedText.
+	for b := range 256 {
+		in := hex.EncodeBytes([]byte{byte(b)})
+		want, got := make([]byte, 1), make([]byte, 1)
+		require.NoError(t, hex.DecodeFixedText([]byte(in), want))
+		require.NoError(t, hex.DecodeFixedConstantTime(in, got))
+		require.Equal(t, want, got)
+	}
+}
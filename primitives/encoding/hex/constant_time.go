@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package hex
+
+import "github.com/berachain/beacon-kit/errors"
+
+// DecodeFixedConstantTime decodes the input as a string with 0x prefix into
+// out, whose length determines the required input length. Unlike
+// DecodeFixedText the time taken does not depend on the value of the decoded
+// bytes, which makes it suitable for parsing secrets such as JWT keys.
+func DecodeFixedConstantTime(input string, out []byte) error {
+	raw, err := formatAndValidateText([]byte(input))
+	if err != nil {
+		return err
+	}
+	if len(raw)/encDecRatio != len(out) {
+		return errors.Wrapf(
+			ErrInvalidHexStringLength,
+			"hex string has length %d, want %d",
+			len(raw), len(out)*encDecRatio,
+		)
+	}
+
+	// Accumulate validity over the whole input and only check it at the end,
+	// so that no branch depends on the content of the input.
+	var invalid byte
+	for i := 0; i < len(raw); i += 2 {
+		high, highOK := decodeNibbleConstantTime(raw[i])
+		low, lowOK := decodeNibbleConstantTime(raw[i+1])
+		invalid |= ^(highOK & lowOK)
+		out[i/2] = high<<nibbleShift | low
+	}
+	if invalid != 0 {
+		clear(out)
+		return ErrInvalidString
+	}
+	return nil
+}
+
+// decodeNibbleConstantTime decodes a single hexadecimal nibble without data
+// dependent branches. It returns the decoded value along with 0xff if the
+// input is a valid hex character, 0x00 otherwise.
+func decodeNibbleConstantTime(in byte) (byte, byte) {
+	c := int32(in)
+	digit := inRange(c, '0', '9')
+	upper := inRange(c, 'A', 'F')
+	lower := inRange(c, 'a', 'f')
+
+	//#nosec:G701 // masked values are in the range 0-15.
+	value := byte(digit&(c-hexBaseOffset) |
+		upper&(c-hexAlphaOffsetUpper) |
+		lower&(c-hexAlphaOffsetLower))
+	//#nosec:G701 // the mask is either 0 or -1.
+	return value, byte(digit | upper | lower)
+}
+
+// inRange returns -1 (all bits set) if lo <= c <= hi, 0 otherwise.
+//
+//nolint:mnd // 31 is the sign bit of an int32.
+func inRange(c, lo, hi int32) int32 {
+	return ^((c - lo) >> 31) & ^((hi - c) >> 31)
+}
@@ -0,0 +1,124 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package merkle
+
+import (
+	"slices"
+
+	fastssz "github.com/ferranbt/fastssz"
+)
+
+// Treeable is an SSZ object that can be represented as a Merkle tree, which
+// is the case for every SSZ container in the codebase.
+type Treeable interface {
+	// GetTree returns the Merkle tree of the object.
+	GetTree() (*fastssz.Node, error)
+}
+
+// Prove generates a Merkle proof for the node at the given generalized index
+// in the tree of obj. Returns the node, which may be an intermediate root,
+// along with its proof ordered from the bottom of the tree to the top.
+func Prove[RootT ~[32]byte](
+	obj Treeable,
+	gIndex GeneralizedIndex,
+) (RootT, []RootT, error) {
+	tree, err := obj.GetTree()
+	if err != nil {
+		return RootT{}, nil, err
+	}
+
+	// fastssz panics when proving past the bottom of the tree, so make sure
+	// the node exists first.
+	//#nosec:G701 // generalized indices of real containers fit in an int.
+	index := int(gIndex)
+	if _, err = tree.Get(index); err != nil {
+		return RootT{}, nil, err
+	}
+
+	p, err := tree.Prove(index)
+	if err != nil {
+		return RootT{}, nil, err
+	}
+
+	proof := make([]RootT, len(p.Hashes))
+	for i, hash := range p.Hashes {
+		proof[i] = toRoot[RootT](hash)
+	}
+	return toRoot[RootT](p.Leaf), proof, nil
+}
+
+// ProveMulti generates a Merkle multiproof for the nodes at the given
+// generalized indices in the tree of obj. Returns the nodes in the order of
+// gIndices along with the helper nodes in the order of GetHelperIndices, so
+// the result can be checked with VerifyMultiproof.
+func ProveMulti[RootT ~[32]byte](
+	obj Treeable,
+	gIndices GeneralizedIndices,
+) ([]RootT, []RootT, error) {
+	tree, err := obj.GetTree()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	leaves := make([]RootT, len(gIndices))
+	for i, gIndex := range gIndices {
+		if leaves[i], err = nodeAt[RootT](tree, gIndex); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	helperIndices := gIndices.GetHelperIndices()
+	proof := make([]RootT, len(helperIndices))
+	for i, gIndex := range helperIndices {
+		if proof[i], err = nodeAt[RootT](tree, gIndex); err != nil {
+			return nil, nil, err
+		}
+	}
+	return leaves, proof, nil
+}
+
+// CombineProofs combines the proof of a node within an inner object with the
+// proof of the inner object's root within an outer object. The result proves
+// the node against the outer root at the generalized index
+// GeneralizedIndices{outerIndex, innerIndex}.Concat().
+func CombineProofs[RootT ~[32]byte](inner, outer []RootT) []RootT {
+	return slices.Concat(inner, outer)
+}
+
+// nodeAt returns the hash of the node at the given generalized index.
+func nodeAt[RootT ~[32]byte](
+	tree *fastssz.Node,
+	gIndex GeneralizedIndex,
+) (RootT, error) {
+	//#nosec:G701 // generalized indices of real containers fit in an int.
+	node, err := tree.Get(int(gIndex))
+	if err != nil {
+		return RootT{}, err
+	}
+	return toRoot[RootT](node.Hash()), nil
+}
+
+// toRoot copies a hash returned by fastssz into a root.
+func toRoot[RootT ~[32]byte](hash []byte) RootT {
+	var root RootT
+	copy(root[:], hash)
+	return root
+}
@@ -0,0 +1,100 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package merkle_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/encoding/ssz/merkle"
+	"github.com/stretchr/testify/require"
+)
+
+// Generalized indices of the BeaconBlockHeader fields, which has 5 fields
+// padded to 8 leaves.
+const (
+	slotGIndex      = 8
+	stateRootGIndex = 11
+	bodyRootGIndex  = 12
+)
+
+func testHeader() *types.BeaconBlockHeader {
+	return types.NewBeaconBlockHeader(
+		7,
+		3,
+		common.Root{0x01},
+		common.Root{0x02},
+		common.Root{0x03},
+	)
+}
+
+func TestProve(t *testing.T) {
+	header := testHeader()
+	root := header.HashTreeRoot()
+
+	leaf, proof, err := merkle.Prove[common.Root](header, stateRootGIndex)
+	require.NoError(t, err)
+	require.Equal(t, common.Root{0x02}, leaf)
+	require.Len(t, proof, 3)
+
+	ok, err := merkle.VerifyProof(stateRootGIndex, leaf, proof, root)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// An intermediate node can be proven as well.
+	leaf, proof, err = merkle.Prove[common.Root](header, 2)
+	require.NoError(t, err)
+	ok, err = merkle.VerifyProof(2, leaf, proof, root)
+	require.NoError(t, err)
+	require.True(t, ok)
+}
+
+func TestProveMulti(t *testing.T) {
+	header := testHeader()
+	gIndices := merkle.GeneralizedIndices{
+		slotGIndex, stateRootGIndex, bodyRootGIndex,
+	}
+
+	leaves, proof, err := merkle.ProveMulti[common.Root](header, gIndices)
+	require.NoError(t, err)
+	require.Len(t, leaves, len(gIndices))
+	require.Len(t, proof, len(gIndices.GetHelperIndices()))
+	require.Equal(t, common.Root{0x07}, leaves[0])
+	require.True(t, merkle.VerifyMultiproof(
+		gIndices, leaves, proof, header.HashTreeRoot(),
+	))
+
+	leaves[1] = common.Root{0xff}
+	require.False(t, merkle.VerifyMultiproof(
+		gIndices, leaves, proof, header.HashTreeRoot(),
+	))
+}
+
+func TestProveOutOfRange(t *testing.T) {
+	_, _, err := merkle.Prove[common.Root](testHeader(), 1<<10)
+	require.Error(t, err)
+
+	_, _, err = merkle.ProveMulti[common.Root](
+		testHeader(), merkle.GeneralizedIndices{1 << 10},
+	)
+	require.Error(t, err)
+}
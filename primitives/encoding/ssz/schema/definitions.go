@@ -161,6 +161,8 @@ func (l list) ItemPosition(p string) (uint64, uint8, uint8, error) {
 type container struct {
 	Fields     []SSZType
 	FieldIndex map[string]uint64
+	// named are the fields of the container, in order.
+	named []*Field[SSZType]
 }
 
 func DefineContainer(fields ...*Field[SSZType]) SSZType {
@@ -171,7 +173,17 @@ func DefineContainer(fields ...*Field[SSZType]) SSZType {
 		fieldIndex[f.GetName()] = uint64(i)
 		types[i] = f.GetValue()
 	}
-	return container{Fields: types, FieldIndex: fieldIndex}
+	return container{Fields: types, FieldIndex: fieldIndex, named: fields}
+}
+
+// ContainerFields returns the fields of a container type in order, or nil if
+// the type is not a container.
+func ContainerFields(typ SSZType) []*Field[SSZType] {
+	c, ok := typ.(container)
+	if !ok {
+		return nil
+	}
+	return c.named
 }
 
 func (c container) ID() ID { return Container }
@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package math
+
+import (
+	"math/bits"
+
+	"github.com/berachain/beacon-kit/errors"
+)
+
+// SafeAdd returns u + v, or ErrOverflow if the sum does not fit in a U64.
+func (u U64) SafeAdd(v U64) (U64, error) {
+	sum, carry := bits.Add64(uint64(u), uint64(v), 0)
+	if carry != 0 {
+		return 0, errors.Wrapf(ErrOverflow, "%d + %d", u, v)
+	}
+	return U64(sum), nil
+}
+
+// SafeSub returns u - v, or ErrUnderflow if v is greater than u.
+func (u U64) SafeSub(v U64) (U64, error) {
+	diff, borrow := bits.Sub64(uint64(u), uint64(v), 0)
+	if borrow != 0 {
+		return 0, errors.Wrapf(ErrUnderflow, "%d - %d", u, v)
+	}
+	return U64(diff), nil
+}
+
+// SafeMul returns u * v, or ErrOverflow if the product does not fit in a U64.
+func (u U64) SafeMul(v U64) (U64, error) {
+	hi, lo := bits.Mul64(uint64(u), uint64(v))
+	if hi != 0 {
+		return 0, errors.Wrapf(ErrOverflow, "%d * %d", u, v)
+	}
+	return U64(lo), nil
+}
+
+// Epoch returns the epoch of the slot u, as per compute_epoch_at_slot in the
+// Ethereum 2.0 Specification. slotsPerEpoch must be non-zero.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#compute_epoch_at_slot
+//
+//nolint:lll // link.
+func (u U64) Epoch(slotsPerEpoch uint64) Epoch {
+	return Epoch(uint64(u) / slotsPerEpoch)
+}
+
+// StartSlot returns the first slot of the epoch u, as per
+// compute_start_slot_at_epoch in the Ethereum 2.0 Specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#compute_start_slot_at_epoch
+//
+//nolint:lll // link.
+func (u U64) StartSlot(slotsPerEpoch uint64) (Slot, error) {
+	return u.SafeMul(U64(slotsPerEpoch))
+}
+
+// IsEpochBoundary reports whether the slot u is the last slot of its epoch,
+// i.e. whether processing it transitions the state into a new epoch.
+// slotsPerEpoch must be non-zero.
+func (u U64) IsEpochBoundary(slotsPerEpoch uint64) bool {
+	return (uint64(u)+1)%slotsPerEpoch == 0
+}
+
+// ComputeActivationExitEpoch returns the epoch during which validator
+// activations and exits initiated in epoch are effective, as per the
+// Ethereum 2.0 Specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#compute_activation_exit_epoch
+//
+//nolint:lll // link.
+func ComputeActivationExitEpoch(
+	epoch Epoch, maxSeedLookahead uint64,
+) (Epoch, error) {
+	next, err := epoch.SafeAdd(1)
+	if err != nil {
+		return 0, err
+	}
+	return next.SafeAdd(U64(maxSeedLookahead))
+}
@@ -0,0 +1,126 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package math_test
+
+import (
+	stdmath "math"
+	"math/big"
+	"testing"
+
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestU64_SafeArithmetic(t *testing.T) {
+	sum, err := math.U64(2).SafeAdd(3)
+	require.NoError(t, err)
+	require.Equal(t, math.U64(5), sum)
+	_, err = math.U64(stdmath.MaxUint64).SafeAdd(1)
+	require.ErrorIs(t, err, math.ErrOverflow)
+
+	diff, err := math.U64(3).SafeSub(3)
+	require.NoError(t, err)
+	require.Equal(t, math.U64(0), diff)
+	_, err = math.U64(2).SafeSub(3)
+	require.ErrorIs(t, err, math.ErrUnderflow)
+
+	prod, err := math.U64(1 << 32).SafeMul(1 << 31)
+	require.NoError(t, err)
+	require.Equal(t, math.U64(1<<63), prod)
+	_, err = math.U64(1 << 32).SafeMul(1 << 32)
+	require.ErrorIs(t, err, math.ErrOverflow)
+}
+
+func TestSlotEpochConversions(t *testing.T) {
+	const slotsPerEpoch = 32
+
+	require.Equal(t, math.Epoch(0), math.Slot(0).Epoch(slotsPerEpoch))
+	require.Equal(t, math.Epoch(0), math.Slot(31).Epoch(slotsPerEpoch))
+	require.Equal(t, math.Epoch(1), math.Slot(32).Epoch(slotsPerEpoch))
+
+	start, err := math.Epoch(3).StartSlot(slotsPerEpoch)
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(96), start)
+	_, err = math.Epoch(stdmath.MaxUint64).StartSlot(slotsPerEpoch)
+	require.ErrorIs(t, err, math.ErrOverflow)
+
+	require.False(t, math.Slot(30).IsEpochBoundary(slotsPerEpoch))
+	require.True(t, math.Slot(31).IsEpochBoundary(slotsPerEpoch))
+	require.True(t, math.Slot(0).IsEpochBoundary(1))
+}
+
+func TestComputeActivationExitEpoch(t *testing.T) {
+	epoch, err := math.ComputeActivationExitEpoch(10, 4)
+	require.NoError(t, err)
+	require.Equal(t, math.Epoch(15), epoch)
+
+	_, err = math.ComputeActivationExitEpoch(stdmath.MaxUint64, 0)
+	require.ErrorIs(t, err, math.ErrOverflow)
+	_, err = math.ComputeActivationExitEpoch(0, stdmath.MaxUint64)
+	require.ErrorIs(t, err, math.ErrOverflow)
+}
+
+func FuzzU64_SafeArithmetic(f *testing.F) {
+	f.Add(uint64(0), uint64(0))
+	f.Add(uint64(stdmath.MaxUint64), uint64(1))
+	f.Add(uint64(1<<32), uint64(1<<32))
+	f.Fuzz(func(t *testing.T, a, b uint64) {
+		bigA, bigB := new(big.Int).SetUint64(a), new(big.Int).SetUint64(b)
+		fits := func(x *big.Int) bool { return x.Sign() >= 0 && x.IsUint64() }
+
+		want := new(big.Int).Add(bigA, bigB)
+		sum, err := math.U64(a).SafeAdd(math.U64(b))
+		require.Equal(t, fits(want), err == nil)
+		if err == nil {
+			require.Equal(t, want.Uint64(), sum.Unwrap())
+		}
+
+		want = new(big.Int).Sub(bigA, bigB)
+		diff, err := math.U64(a).SafeSub(math.U64(b))
+		require.Equal(t, fits(want), err == nil)
+		if err == nil {
+			require.Equal(t, want.Uint64(), diff.Unwrap())
+		}
+
+		want = new(big.Int).Mul(bigA, bigB)
+		prod, err := math.U64(a).SafeMul(math.U64(b))
+		require.Equal(t, fits(want), err == nil)
+		if err == nil {
+			require.Equal(t, want.Uint64(), prod.Unwrap())
+		}
+	})
+}
+
+func FuzzSlotEpochRoundTrip(f *testing.F) {
+	f.Add(uint64(0), uint64(32))
+	f.Add(uint64(stdmath.MaxUint64), uint64(1))
+	f.Fuzz(func(t *testing.T, slot, slotsPerEpoch uint64) {
+		if slotsPerEpoch == 0 {
+			t.Skip()
+		}
+		epoch := math.Slot(slot).Epoch(slotsPerEpoch)
+		start, err := epoch.StartSlot(slotsPerEpoch)
+		require.NoError(t, err)
+		require.LessOrEqual(t, start.Unwrap(), slot)
+		require.Less(t, slot-start.Unwrap(), slotsPerEpoch)
+		require.Equal(t, epoch, start.Epoch(slotsPerEpoch))
+	})
+}
@@ -28,6 +28,12 @@ var (
 	// ErrUnexpectedInputLengthBase is the base error for unexpected input
 	// length errors.
 	ErrUnexpectedInputLengthBase = errors.New("unexpected input length")
+
+	// ErrOverflow is returned when an arithmetic operation overflows.
+	ErrOverflow = errors.New("arithmetic overflow")
+
+	// ErrUnderflow is returned when an arithmetic operation underflows.
+	ErrUnderflow = errors.New("arithmetic underflow")
 )
 
 // ErrUnexpectedInputLength returns an error indicating that the input length.
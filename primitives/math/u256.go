@@ -24,6 +24,7 @@ import (
 	"fmt"
 	"math/big"
 
+	"github.com/berachain/beacon-kit/primitives/encoding/hex"
 	"github.com/holiman/uint256"
 )
 
@@ -60,7 +61,12 @@ func (u *U256Hex) MarshalJSON() ([]byte, error) {
 }
 
 // UnmarshalJSON implements the json.Unmarshaler interface.
-// It expects the input to be a hexadecimal string.
+// It expects the input to be a quoted, 0x prefixed hexadecimal string without
+// leading zeros, as quantities are encoded in the Engine API.
 func (u *U256Hex) UnmarshalJSON(data []byte) error {
-	return (*uint256.Int)(u).UnmarshalJSON(data)
+	strippedInput, err := hex.ValidateQuotedString(data)
+	if err != nil {
+		return err
+	}
+	return (*uint256.Int)(u).SetFromHex(string(strippedInput))
 }
@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package math_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestU256_SSZLittleEndian(t *testing.T) {
+	u := math.NewU256(0x0102)
+	bz, err := u.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, bz, 32)
+
+	// SSZ encodes uint256 values in little-endian byte order.
+	expected := make([]byte, 32)
+	expected[0], expected[1] = 0x02, 0x01
+	require.Equal(t, expected, bz)
+
+	decoded := new(math.U256)
+	require.NoError(t, decoded.UnmarshalSSZ(bz))
+	require.Equal(t, u, decoded)
+}
+
+func TestNewU256FromBigInt(t *testing.T) {
+	b, ok := new(big.Int).SetString("0x1000000000000000000000000", 0)
+	require.True(t, ok)
+	u, err := math.NewU256FromBigInt(b)
+	require.NoError(t, err)
+	require.Equal(t, b, u.ToBig())
+
+	_, err = math.NewU256FromBigInt(big.NewInt(-1))
+	require.Error(t, err)
+}
+
+func TestU256Hex_JSON(t *testing.T) {
+	u := math.U256Hex(*math.NewU256(1_000_000_000))
+	bz, err := json.Marshal(&u)
+	require.NoError(t, err)
+	require.JSONEq(t, `"0x3b9aca00"`, string(bz))
+
+	var decoded math.U256Hex
+	require.NoError(t, json.Unmarshal(bz, &decoded))
+	require.Equal(t, u, decoded)
+}
+
+func TestU256Hex_UnmarshalJSONRejectsNonQuantities(t *testing.T) {
+	for _, input := range []string{
+		`1`, `"1"`, `"0x"`, `"0x01"`, `"0xg"`, `null`,
+		`"0x1` + strings.Repeat("0", 64) + `"`,
+	} {
+		var decoded math.U256Hex
+		require.Error(t, json.Unmarshal([]byte(input), &decoded), input)
+	}
+}
@@ -0,0 +1,113 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package merkle
+
+import (
+	"runtime"
+
+	"github.com/prysmaticlabs/gohashtree"
+	"golang.org/x/sync/errgroup"
+)
+
+// chunkSize is the size of a chunk of an SSZ merkle tree.
+const chunkSize = 32
+
+// ParallelHasher merkleizes large SSZ lists across a fixed number of
+// workers. Both the per-element hash tree roots and every layer of the
+// resulting tree are split into contiguous segments, one per worker, so the
+// result is independent of the number of workers.
+type ParallelHasher struct {
+	// workers is the number of goroutines, including the caller, used to
+	// hash a single list.
+	workers int
+}
+
+// NewParallelHasher creates a new ParallelHasher using the given number of
+// workers. A non-positive value uses one worker per available CPU.
+func NewParallelHasher(workers int) *ParallelHasher {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	return &ParallelHasher{workers: workers}
+}
+
+// Workers returns the number of workers used to hash a single list.
+func (p *ParallelHasher) Workers() int {
+	return p.workers
+}
+
+// Leaves computes leafFn for every index in [0, n) across the workers and
+// returns the results in index order.
+func (p *ParallelHasher) Leaves(
+	n int,
+	leafFn func(i int) [32]byte,
+) [][32]byte {
+	leaves := make([][32]byte, n)
+	_ = p.run(n, func(start, end int) error {
+		for i := start; i < end; i++ {
+			leaves[i] = leafFn(i)
+		}
+		return nil
+	})
+	return leaves
+}
+
+// Hash hashes every pair of chunks of input into a chunk of dst, splitting
+// the layer across the workers. It is a fastssz HashFn, which merkleizes
+// lists one layer at a time, so dst may be input itself.
+func (p *ParallelHasher) Hash(dst, input []byte) error {
+	pairs := len(input) / (two * chunkSize)
+	if p.workers == 1 || pairs < MinParallelizationSize {
+		return gohashtree.HashByteSlice(dst, input)
+	}
+
+	// The segments of dst overlap the ones of input hashed by the other
+	// workers, hash into a separate layer instead.
+	layer := make([]byte, pairs*chunkSize)
+	if err := p.run(pairs, func(start, end int) error {
+		return gohashtree.HashByteSlice(
+			layer[start*chunkSize:end*chunkSize],
+			input[start*two*chunkSize:end*two*chunkSize],
+		)
+	}); err != nil {
+		return err
+	}
+	copy(dst, layer)
+	return nil
+}
+
+// run calls fn on contiguous segments of [0, n) across the workers,
+// returning the first error.
+func (p *ParallelHasher) run(n int, fn func(start, end int) error) error {
+	if p.workers == 1 || n < MinParallelizationSize {
+		return fn(0, n)
+	}
+
+	var (
+		eg      errgroup.Group
+		segment = (n + p.workers - 1) / p.workers
+	)
+	for start := 0; start < n; start += segment {
+		end := min(start+segment, n)
+		eg.Go(func() error { return fn(start, end) })
+	}
+	return eg.Wait()
+}
@@ -44,22 +44,19 @@ const EthereumJWTLength = 32
 // Secret represents a JSON Web Token as a fixed-size byte array.
 type Secret [EthereumJWTLength]byte
 
-// NewFromHex creates a new JWT secret from a hexadecimal string.
+// NewFromHex creates a new JWT secret from a hexadecimal string. The secret is
+// decoded in constant time so that parsing it does not leak its content.
 func NewFromHex(hexStr string) (*Secret, error) {
-	// Ensure the hex string contains only hexadecimal characters.
-	if !HexRegexp.MatchString(hexStr) {
+	var s Secret
+	err := hex.DecodeFixedConstantTime(hexStr, s[:])
+	switch {
+	case errors.Is(err, hex.ErrInvalidString):
 		return nil, ErrContainsIllegalCharacter
-	}
-
-	// Convert the hex string to a byte array.
-	bz, err := hex.ToBytes(hexStr)
-	if err != nil {
-		return nil, err
-	}
-	if bz == nil || len(bz) != EthereumJWTLength {
+	case errors.Is(err, hex.ErrInvalidHexStringLength):
 		return nil, ErrLengthMismatch
+	case err != nil:
+		return nil, err
 	}
-	s := Secret(bz)
 	return &s, nil
 }
 
@@ -0,0 +1,152 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package signing
+
+import (
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/common"
+)
+
+var (
+	// ErrDuplicateDomainType is returned when two signature domains share the
+	// same domain type.
+	ErrDuplicateDomainType = errors.New("duplicate signature domain type")
+
+	// ErrApplicationDomainType is returned when a protocol domain type
+	// overlaps with the application domain type mask.
+	ErrApplicationDomainType = errors.New(
+		"protocol domain type overlaps the application mask",
+	)
+)
+
+// DomainTypes is the registry of signature domain types used across the
+// beacon chain. Every component that signs or verifies a message should
+// source its domain type from here (or from a ChainSpec populated from here),
+// so that external signers compute identical signing roots.
+type DomainTypes struct {
+	// Proposer is the domain type for beacon block proposals.
+	Proposer common.DomainType
+	// Attester is the domain type for beacon attestations.
+	Attester common.DomainType
+	// Randao is the domain type for RANDAO reveals.
+	Randao common.DomainType
+	// Deposit is the domain type for deposit messages.
+	Deposit common.DomainType
+	// VoluntaryExit is the domain type for voluntary exits.
+	VoluntaryExit common.DomainType
+	// SelectionProof is the domain type for aggregator selection proofs.
+	SelectionProof common.DomainType
+	// AggregateAndProof is the domain type for aggregate and proofs.
+	AggregateAndProof common.DomainType
+	// SyncCommittee is the domain type for sync committee messages.
+	SyncCommittee common.DomainType
+	// SyncCommitteeSelectionProof is the domain type for sync committee
+	// aggregator selection proofs.
+	SyncCommitteeSelectionProof common.DomainType
+	// ContributionAndProof is the domain type for sync committee
+	// contributions.
+	ContributionAndProof common.DomainType
+	// BLSToExecutionChange is the domain type for withdrawal credential
+	// changes. It is always mixed with the genesis fork version.
+	BLSToExecutionChange common.DomainType
+	// ApplicationMask is the domain type mask for application signatures.
+	ApplicationMask common.DomainType
+}
+
+// NamedDomainType is a domain type along with its name in the specification.
+type NamedDomainType struct {
+	// Name is the name of the domain type, e.g. DOMAIN_BEACON_PROPOSER.
+	Name string
+	// Type is the domain type.
+	Type common.DomainType
+}
+
+// DefaultDomainTypes returns the domain types as defined in the Ethereum 2.0
+// specification:
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#domain-types
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/altair/beacon-chain.md#domain-types
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/capella/beacon-chain.md#domain-types
+//
+//nolint:lll // links.
+func DefaultDomainTypes() DomainTypes {
+	return DomainTypes{
+		Proposer:          common.DomainType{0x00, 0x00, 0x00, 0x00},
+		Attester:          common.DomainType{0x01, 0x00, 0x00, 0x00},
+		Randao:            common.DomainType{0x02, 0x00, 0x00, 0x00},
+		Deposit:           common.DomainType{0x03, 0x00, 0x00, 0x00},
+		VoluntaryExit:     common.DomainType{0x04, 0x00, 0x00, 0x00},
+		SelectionProof:    common.DomainType{0x05, 0x00, 0x00, 0x00},
+		AggregateAndProof: common.DomainType{0x06, 0x00, 0x00, 0x00},
+		SyncCommittee:     common.DomainType{0x07, 0x00, 0x00, 0x00},
+		SyncCommitteeSelectionProof: common.DomainType{
+			0x08, 0x00, 0x00, 0x00,
+		},
+		ContributionAndProof: common.DomainType{0x09, 0x00, 0x00, 0x00},
+		BLSToExecutionChange: common.DomainType{0x0a, 0x00, 0x00, 0x00},
+		ApplicationMask:      common.DomainType{0x00, 0x00, 0x00, 0x01},
+	}
+}
+
+// Protocol returns every protocol domain type of the registry, i.e. all of
+// them except the application mask, in specification order.
+func (d DomainTypes) Protocol() []NamedDomainType {
+	return []NamedDomainType{
+		{Name: "DOMAIN_BEACON_PROPOSER", Type: d.Proposer},
+		{Name: "DOMAIN_BEACON_ATTESTER", Type: d.Attester},
+		{Name: "DOMAIN_RANDAO", Type: d.Randao},
+		{Name: "DOMAIN_DEPOSIT", Type: d.Deposit},
+		{Name: "DOMAIN_VOLUNTARY_EXIT", Type: d.VoluntaryExit},
+		{Name: "DOMAIN_SELECTION_PROOF", Type: d.SelectionProof},
+		{Name: "DOMAIN_AGGREGATE_AND_PROOF", Type: d.AggregateAndProof},
+		{Name: "DOMAIN_SYNC_COMMITTEE", Type: d.SyncCommittee},
+		{
+			Name: "DOMAIN_SYNC_COMMITTEE_SELECTION_PROOF",
+			Type: d.SyncCommitteeSelectionProof,
+		},
+		{Name: "DOMAIN_CONTRIBUTION_AND_PROOF", Type: d.ContributionAndProof},
+		{Name: "DOMAIN_BLS_TO_EXECUTION_CHANGE", Type: d.BLSToExecutionChange},
+	}
+}
+
+// Validate ensures that no two protocol domains share a domain type and that
+// none of them can be mistaken for an application domain.
+func (d DomainTypes) Validate() error {
+	seen := make(map[common.DomainType]string)
+	for _, domain := range d.Protocol() {
+		if other, ok := seen[domain.Type]; ok {
+			return errors.Wrapf(
+				ErrDuplicateDomainType,
+				"%s and %s are both %s", other, domain.Name, domain.Type,
+			)
+		}
+		seen[domain.Type] = domain.Name
+
+		for i := range domain.Type {
+			if domain.Type[i]&d.ApplicationMask[i] != 0 {
+				return errors.Wrapf(
+					ErrApplicationDomainType, "%s is %s",
+					domain.Name, domain.Type,
+				)
+			}
+		}
+	}
+	return nil
+}
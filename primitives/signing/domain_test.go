@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package signing_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/signing"
+	"github.com/stretchr/testify/require"
+)
+
+// specDomainTypes are the domain type values as published in the Ethereum
+// consensus specs, keyed by their spec name.
+var specDomainTypes = map[string]string{
+	"DOMAIN_BEACON_PROPOSER":                "0x00000000",
+	"DOMAIN_BEACON_ATTESTER":                "0x01000000",
+	"DOMAIN_RANDAO":                         "0x02000000",
+	"DOMAIN_DEPOSIT":                        "0x03000000",
+	"DOMAIN_VOLUNTARY_EXIT":                 "0x04000000",
+	"DOMAIN_SELECTION_PROOF":                "0x05000000",
+	"DOMAIN_AGGREGATE_AND_PROOF":            "0x06000000",
+	"DOMAIN_SYNC_COMMITTEE":                 "0x07000000",
+	"DOMAIN_SYNC_COMMITTEE_SELECTION_PROOF": "0x08000000",
+	"DOMAIN_CONTRIBUTION_AND_PROOF":         "0x09000000",
+	"DOMAIN_BLS_TO_EXECUTION_CHANGE":        "0x0a000000",
+}
+
+func TestDefaultDomainTypes_MatchSpec(t *testing.T) {
+	domainTypes := signing.DefaultDomainTypes()
+	protocol := domainTypes.Protocol()
+	require.Len(t, protocol, len(specDomainTypes))
+	for _, domain := range protocol {
+		want, ok := specDomainTypes[domain.Name]
+		require.True(t, ok, domain.Name)
+		require.Equal(t, want, domain.Type.String(), domain.Name)
+	}
+	require.Equal(t, "0x00000001", domainTypes.ApplicationMask.String())
+	require.NoError(t, domainTypes.Validate())
+}
+
+func TestDomainTypes_Validate(t *testing.T) {
+	domainTypes := signing.DefaultDomainTypes()
+	domainTypes.Randao = domainTypes.Deposit
+	require.ErrorIs(t, domainTypes.Validate(), signing.ErrDuplicateDomainType)
+
+	domainTypes = signing.DefaultDomainTypes()
+	domainTypes.VoluntaryExit = common.DomainType{0x04, 0x00, 0x00, 0x01}
+	require.ErrorIs(
+		t, domainTypes.Validate(), signing.ErrApplicationDomainType,
+	)
+}
+
+func TestComputeDomain_MixesForkVersion(t *testing.T) {
+	deposit := signing.DefaultDomainTypes().Deposit
+	genesis := signing.ComputeDomain(deposit, common.Version{}, common.Root{})
+	deneb := signing.ComputeDomain(
+		deposit, common.Version{0x04, 0x00, 0x00, 0x00}, common.Root{},
+	)
+
+	// The domain type prefix is preserved while the fork data root differs.
+	require.Equal(t, deposit[:], genesis[:len(deposit)])
+	require.Equal(t, deposit[:], deneb[:len(deposit)])
+	require.NotEqual(t, genesis, deneb)
+}
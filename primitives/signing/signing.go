@@ -0,0 +1,166 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package signing
+
+import (
+	"encoding/binary"
+
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/crypto/sha256"
+	"github.com/berachain/beacon-kit/primitives/merkle"
+)
+
+// Hasher computes fork data roots, signing domains and signing roots using a
+// pluggable hash function. All of the objects hashed here are fixed-size SSZ
+// containers of two 32-byte chunks, so their hash tree root reduces to a
+// single hash over the concatenation of both chunks.
+type Hasher struct {
+	hashFn merkle.HashFn
+}
+
+// NewHasher creates a new Hasher that uses the given hash function.
+func NewHasher(hashFn merkle.HashFn) *Hasher {
+	return &Hasher{hashFn: hashFn}
+}
+
+// DefaultHasher returns a Hasher backed by SHA-256, as required by the
+// Ethereum 2.0 specification.
+func DefaultHasher() *Hasher {
+	return NewHasher(sha256.Hash)
+}
+
+// ComputeForkDataRoot as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#compute_fork_data_root
+//
+//nolint:lll // link.
+func (h *Hasher) ComputeForkDataRoot(
+	currentVersion common.Version,
+	genesisValidatorsRoot common.Root,
+) common.Root {
+	var buf [2 * constants.RootLength]byte
+	copy(buf[:], currentVersion[:])
+	copy(buf[constants.RootLength:], genesisValidatorsRoot[:])
+	return h.hashFn(buf[:])
+}
+
+// ComputeForkDigest as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#compute_fork_digest
+//
+//nolint:lll // link.
+func (h *Hasher) ComputeForkDigest(
+	currentVersion common.Version,
+	genesisValidatorsRoot common.Root,
+) common.ForkDigest {
+	var digest common.ForkDigest
+	forkDataRoot := h.ComputeForkDataRoot(currentVersion, genesisValidatorsRoot)
+	copy(digest[:], forkDataRoot[:])
+	return digest
+}
+
+// ComputeDomain as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#compute_domain
+//
+//nolint:lll // link.
+func (h *Hasher) ComputeDomain(
+	domainType common.DomainType,
+	forkVersion common.Version,
+	genesisValidatorsRoot common.Root,
+) common.Domain {
+	var domain common.Domain
+	forkDataRoot := h.ComputeForkDataRoot(forkVersion, genesisValidatorsRoot)
+	copy(domain[:], domainType[:])
+	copy(domain[constants.DomainTypeLength:], forkDataRoot[:])
+	return domain
+}
+
+// ComputeSigningRoot as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#compute_signing_root
+//
+//nolint:lll // link.
+func (h *Hasher) ComputeSigningRoot(
+	objectRoot common.Root,
+	domain common.Domain,
+) common.Root {
+	var buf [2 * constants.RootLength]byte
+	copy(buf[:], objectRoot[:])
+	copy(buf[constants.RootLength:], domain[:])
+	return h.hashFn(buf[:])
+}
+
+// ComputeSigningRootUInt64 computes the signing root of a uint64 value, whose
+// hash tree root is its little-endian encoding padded to 32 bytes.
+func (h *Hasher) ComputeSigningRootUInt64(
+	value uint64,
+	domain common.Domain,
+) common.Root {
+	var objectRoot common.Root
+	binary.LittleEndian.PutUint64(objectRoot[:], value)
+	return h.ComputeSigningRoot(objectRoot, domain)
+}
+
+// ComputeForkDataRoot computes the fork data root using SHA-256.
+func ComputeForkDataRoot(
+	currentVersion common.Version,
+	genesisValidatorsRoot common.Root,
+) common.Root {
+	return DefaultHasher().ComputeForkDataRoot(
+		currentVersion, genesisValidatorsRoot,
+	)
+}
+
+// ComputeForkDigest computes the fork digest using SHA-256.
+func ComputeForkDigest(
+	currentVersion common.Version,
+	genesisValidatorsRoot common.Root,
+) common.ForkDigest {
+	return DefaultHasher().ComputeForkDigest(
+		currentVersion, genesisValidatorsRoot,
+	)
+}
+
+// ComputeDomain computes the signing domain using SHA-256.
+func ComputeDomain(
+	domainType common.DomainType,
+	forkVersion common.Version,
+	genesisValidatorsRoot common.Root,
+) common.Domain {
+	return DefaultHasher().ComputeDomain(
+		domainType, forkVersion, genesisValidatorsRoot,
+	)
+}
+
+// ComputeSigningRoot computes the signing root using SHA-256.
+func ComputeSigningRoot(
+	objectRoot common.Root,
+	domain common.Domain,
+) common.Root {
+	return DefaultHasher().ComputeSigningRoot(objectRoot, domain)
+}
+
+// ComputeSigningRootUInt64 computes the signing root of a uint64 value using
+// SHA-256.
+func ComputeSigningRootUInt64(
+	value uint64,
+	domain common.Domain,
+) common.Root {
+	return DefaultHasher().ComputeSigningRootUInt64(value, domain)
+}
@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package signing_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/encoding/hex"
+	"github.com/berachain/beacon-kit/primitives/signing"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputeDomain_MainnetDeposit(t *testing.T) {
+	// The deposit domain on Ethereum mainnet, computed over the genesis fork
+	// version and an empty genesis validators root.
+	expected := hex.MustToBytes(
+		"0x03000000f5a5fd42d16a20302798ef6ed309979b43003d2320d9f0e8ea9831a9",
+	)
+	domain := signing.ComputeDomain(
+		signing.DefaultDomainTypes().Deposit,
+		common.Version{},
+		common.Root{},
+	)
+	require.Equal(t, expected, domain[:])
+}
+
+func TestComputeDomain_MatchesSSZ(t *testing.T) {
+	version := common.Version{0x04, 0x00, 0x00, 0x00}
+	genesisValidatorsRoot := common.Root{0x01, 0x02, 0x03}
+	forkData := types.NewForkData(version, genesisValidatorsRoot)
+
+	require.Equal(t,
+		forkData.HashTreeRoot(),
+		signing.ComputeForkDataRoot(version, genesisValidatorsRoot),
+	)
+
+	domainType := signing.DefaultDomainTypes().Randao
+	domain := signing.ComputeDomain(domainType, version, genesisValidatorsRoot)
+	forkDataRoot := forkData.HashTreeRoot()
+	require.Equal(t, domainType[:], domain[:4])
+	require.Equal(t, forkDataRoot[:28], domain[4:])
+}
+
+func TestComputeForkDigest(t *testing.T) {
+	// The fork digest of the Ethereum mainnet at genesis.
+	genesisValidatorsRoot, err := common.NewRootFromHex(
+		"0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95",
+	)
+	require.NoError(t, err)
+	digest := signing.ComputeForkDigest(
+		common.Version{}, genesisValidatorsRoot,
+	)
+	require.Equal(t, []byte{0xb5, 0x30, 0x3f, 0x2a}, digest[:])
+}
+
+func TestComputeSigningRoot_MatchesSSZ(t *testing.T) {
+	objectRoot := common.Root{0xaa, 0xbb, 0xcc}
+	domain := signing.ComputeDomain(
+		signing.DefaultDomainTypes().Proposer,
+		common.Version{},
+		common.Root{0x42},
+	)
+	signingData := &types.SigningData{ObjectRoot: objectRoot, Domain: domain}
+	require.Equal(t,
+		signingData.HashTreeRoot(),
+		signing.ComputeSigningRoot(objectRoot, domain),
+	)
+
+	var epochRoot common.Root
+	epochRoot[0] = 0x07
+	require.Equal(t,
+		signing.ComputeSigningRoot(epochRoot, domain),
+		signing.ComputeSigningRootUInt64(7, domain),
+	)
+}
+
+func TestHasher_CustomHashFn(t *testing.T) {
+	var calls int
+	hasher := signing.NewHasher(func(input []byte) [32]byte {
+		calls++
+		require.Len(t, input, 64)
+		return [32]byte{0xff}
+	})
+
+	root := hasher.ComputeSigningRoot(common.Root{}, common.Domain{})
+	require.Equal(t, common.Root{0xff}, root)
+	require.Equal(t, 1, calls)
+
+	domain := hasher.ComputeDomain(
+		signing.DefaultDomainTypes().Deposit, common.Version{}, common.Root{},
+	)
+	require.Equal(t, byte(0x03), domain[0])
+	require.Equal(t, byte(0xff), domain[4])
+	require.Equal(t, 2, calls)
+}
@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package transition
+
+import (
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	fastssz "github.com/ferranbt/fastssz"
+	"github.com/karalabe/ssz"
+)
+
+// OperationTypeID identifies the type of an application-defined operation.
+type OperationTypeID uint16
+
+// Compile-time assertion to ensure Operation implements ssz.DynamicObject.
+var _ ssz.DynamicObject = (*Operation)(nil)
+
+// Operation is an application-defined block operation, carried as the SSZ
+// encoding of the operation tagged with the ID of its type.
+type Operation struct {
+	// TypeID identifies the registered type decoding Data.
+	TypeID OperationTypeID
+	// Data is the SSZ encoding of the operation.
+	Data []byte
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                     SSZ                                    */
+/* -------------------------------------------------------------------------- */
+
+// SizeSSZ returns the size of the Operation in SSZ.
+func (o *Operation) SizeSSZ(siz *ssz.Sizer, fixed bool) uint32 {
+	var size uint32 = 2 + 4
+	if fixed {
+		return size
+	}
+	size += ssz.SizeDynamicBytes(siz, o.Data)
+	return size
+}
+
+// DefineSSZ defines the SSZ encoding of the Operation.
+func (o *Operation) DefineSSZ(codec *ssz.Codec) {
+	// Define the static data (fields and dynamic offsets)
+	ssz.DefineUint16(codec, &o.TypeID)
+	ssz.DefineDynamicBytesOffset(
+		codec, &o.Data, constants.MaxBytesPerOperation,
+	)
+
+	// Define the dynamic data (fields)
+	ssz.DefineDynamicBytesContent(
+		codec, &o.Data, constants.MaxBytesPerOperation,
+	)
+}
+
+// MarshalSSZ serializes the Operation to SSZ-encoded bytes.
+func (o *Operation) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, ssz.Size(o))
+	return buf, ssz.EncodeToBytes(buf, o)
+}
+
+// UnmarshalSSZ deserializes the Operation from SSZ-encoded bytes.
+func (o *Operation) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, o)
+}
+
+// HashTreeRoot returns the SSZ hash tree root of the Operation.
+func (o *Operation) HashTreeRoot() common.Root {
+	return ssz.HashSequential(o)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                   FastSSZ                                  */
+/* -------------------------------------------------------------------------- */
+
+// HashTreeRootWith ssz hashes the Operation object with a hasher.
+func (o *Operation) HashTreeRootWith(hh fastssz.HashWalker) error {
+	indx := hh.Index()
+
+	// Field (0) 'TypeID'
+	hh.PutUint16(uint16(o.TypeID))
+
+	// Field (1) 'Data'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(o.Data))
+		if byteLen > constants.MaxBytesPerOperation {
+			return fastssz.ErrIncorrectListSize
+		}
+		hh.Append(o.Data)
+		hh.MerkleizeWithMixin(
+			elemIndx, byteLen, (constants.MaxBytesPerOperation+31)/32,
+		)
+	}
+
+	hh.Merkleize(indx)
+	return nil
+}
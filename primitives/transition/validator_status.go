@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package transition
+
+import (
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// ValidatorStatus is the status of a validator, named after the statuses of
+// the beacon node API.
+type ValidatorStatus string
+
+const (
+	// ValidatorStatusPendingInitialized is the status of a validator with a
+	// deposit processed that is not eligible for activation yet.
+	ValidatorStatusPendingInitialized ValidatorStatus = "pending_initialized"
+	// ValidatorStatusPending is the status of a validator with a deposit
+	// processed that is not part of the active set yet.
+	ValidatorStatusPending ValidatorStatus = "pending_queued"
+	// ValidatorStatusActive is the status of a validator in the active set.
+	ValidatorStatusActive ValidatorStatus = "active_ongoing"
+	// ValidatorStatusExiting is the status of a validator leaving the active
+	// set, whose balance is withdrawn.
+	ValidatorStatusExiting ValidatorStatus = "active_exiting"
+	// ValidatorStatusSlashed is the status of a slashed validator leaving the
+	// active set.
+	ValidatorStatusSlashed ValidatorStatus = "active_slashed"
+	// ValidatorStatusExited is the status of a validator out of the active
+	// set whose balance is not withdrawable yet.
+	ValidatorStatusExited ValidatorStatus = "exited_unslashed"
+	// ValidatorStatusExitedSlashed is the status of a slashed validator out
+	// of the active set whose balance is not withdrawable yet.
+	ValidatorStatusExitedSlashed ValidatorStatus = "exited_slashed"
+	// ValidatorStatusWithdrawable is the status of a validator whose balance
+	// is withdrawable.
+	ValidatorStatusWithdrawable ValidatorStatus = "withdrawal_possible"
+	// ValidatorStatusWithdrawn is the status of a validator whose balance
+	// has been withdrawn.
+	ValidatorStatusWithdrawn ValidatorStatus = "withdrawal_done"
+)
+
+// ValidatorStatusChange is a change of the status of a validator, produced by
+// the processing of an epoch.
+type ValidatorStatusChange struct {
+	// Index is the index of the validator in the registry.
+	Index math.ValidatorIndex
+	// Pubkey is the public key of the validator.
+	Pubkey crypto.BLSPubkey
+	// Previous is the status of the validator before the epoch.
+	Previous ValidatorStatus
+	// Current is the status of the validator from the epoch on.
+	Current ValidatorStatus
+}
@@ -22,6 +22,7 @@ package version
 
 import (
 	"encoding/binary"
+	"strconv"
 )
 
 const (
@@ -45,3 +46,25 @@ func FromUint32[VersionT ~[4]byte](version uint32) VersionT {
 func ToUint32[VersionT ~[4]byte](version VersionT) uint32 {
 	return binary.LittleEndian.Uint32(version[:])
 }
+
+// Name returns the human readable name of the given version.
+func Name(version uint32) string {
+	switch version {
+	case Phase0:
+		return "phase0"
+	case Altair:
+		return "altair"
+	case Bellatrix:
+		return "bellatrix"
+	case Capella:
+		return "capella"
+	case Deneb:
+		return "deneb"
+	case DenebPlus:
+		return "deneb-plus"
+	case Electra:
+		return "electra"
+	default:
+		return "unknown(" + strconv.FormatUint(uint64(version), 10) + ")"
+	}
+}
@@ -11,6 +11,7 @@
 #################
 
 DEVNET_CHAIN_SPEC = devnet
+FAST_DEVNET_CHAIN_SPEC = devnet-fast
 JWT_PATH = ${TESTAPP_FILES_DIR}/jwt.hex
 ETH_GENESIS_PATH = ${TESTAPP_FILES_DIR}/eth-genesis.json
 NETHER_ETH_GENESIS_PATH = ${TESTAPP_FILES_DIR}/eth-nether-genesis.json
@@ -35,6 +36,11 @@ start: ## start an ephemeral `beacond` node
 	CHAIN_SPEC=$(DEVNET_CHAIN_SPEC) \
 	${TESTAPP_FILES_DIR}/entrypoint.sh
 
+start-fast: ## start an ephemeral `beacond` node with fast epochs
+	@JWT_SECRET_PATH=$(JWT_PATH) \
+	CHAIN_SPEC=$(FAST_DEVNET_CHAIN_SPEC) \
+	${TESTAPP_FILES_DIR}/entrypoint.sh
+
 start-bartio:
 	@JWT_SECRET_PATH=$(JWT_PATH) \
 	CHAIN_SPEC=$(TESTNET_CHAIN_SPEC) \
@@ -269,6 +275,14 @@ test-unit-bench: ## run golang unit benchmarks
 	@go list -f '{{.Dir}}/...' -m | xargs \
 		go test -bench=. -run=^$ -benchmem
 
+test-unit-bls: ## run golang unit tests that need the bls12381 and pebbledb build tags
+	@echo "Running unit tests with the bls12381 and pebbledb build tags..."
+	go test -tags bls12381,pebbledb ./cli/commands/debug/. ./cli/commands/genesis/.
+
+test-replay: ## replay the recorded replay bundles through the state transition
+	@echo "Replaying recorded bundles..."
+	go test -tags bls12381 -run ^TestReplayBundles$$ ./cli/commands/debug/.
+
 # On MacOS, if there is a linking issue on the fuzz tests,
 # use the old linker with flags -ldflags=-extldflags=-Wl,-ld_classic
 test-unit-fuzz: ## run fuzz tests
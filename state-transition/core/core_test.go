@@ -91,23 +91,7 @@ type (
 	]
 
 	TestStateProcessorT = core.StateProcessor[
-		*types.BeaconBlock,
-		*types.BeaconBlockBody,
-		*types.BeaconBlockHeader,
-		*TestBeaconStateT,
-		*transition.Context,
-		*types.Deposit,
-		*types.Eth1Data,
-		*types.ExecutionPayload,
-		*types.ExecutionPayloadHeader,
-		*types.Fork,
-		*types.ForkData,
-		*TestKVStoreT,
-		*types.Validator,
-		types.Validators,
-		*engineprimitives.Withdrawal,
-		engineprimitives.Withdrawals,
-		types.WithdrawalCredentials,
+		*TestBeaconStateT, *transition.Context, *TestKVStoreT,
 	]
 )
 
@@ -160,6 +144,8 @@ func initTestStores() (
 	}
 	testStoreService := &testKVStoreService{ctx: ctx}
 
+	// The beacon store opens the store from its own context, so that copies
+	// of the state branch off into a cache context as they do in the node.
 	return beacondb.New[
 			*types.BeaconBlockHeader,
 			*types.Eth1Data,
@@ -168,9 +154,9 @@ func initTestStores() (
 			*types.Validator,
 			types.Validators,
 		](
-			testStoreService,
+			components.NewKVStoreService(testStoreKey),
 			testCodec,
-		),
+		).WithContext(ctx),
 		depositstore.NewStore[*types.Deposit](testStoreService, nopLog),
 		nil
 }
@@ -191,6 +177,7 @@ func setupState(
 	t *testing.T, cs chain.Spec[
 		bytes.B4, math.U64, common.ExecutionAddress, math.U64, any,
 	],
+	opts ...core.Option,
 ) (
 	*TestStateProcessorT,
 	*TestBeaconStateT,
@@ -217,37 +204,23 @@ func setupState(
 	require.NoError(t, err)
 	beaconState := new(TestBeaconStateT).NewFromDB(kvStore, cs)
 
-	sp := core.NewStateProcessor[
-		*types.BeaconBlock,
-		*types.BeaconBlockBody,
-		*types.BeaconBlockHeader,
-		*TestBeaconStateT,
-		*transition.Context,
-		*types.Deposit,
-		*types.Eth1Data,
-		*types.ExecutionPayload,
-		*types.ExecutionPayloadHeader,
-		*types.Fork,
-		*types.ForkData,
-		*TestKVStoreT,
-		*types.Validator,
-		types.Validators,
-		*engineprimitives.Withdrawal,
-		engineprimitives.Withdrawals,
-		types.WithdrawalCredentials,
-	](
-		noop.NewLogger[any](),
-		cs,
-		execEngine,
-		depositStore,
-		mocksSigner,
-		func(bytes.B48) ([]byte, error) {
+	sp, err := core.NewStateProcessor[
+		*TestBeaconStateT, *transition.Context, *TestKVStoreT,
+	](append([]core.Option{
+		core.WithLogger(noop.NewLogger[any]()),
+		core.WithForkSchedule(cs),
+		core.WithExecutionEngine(execEngine),
+		core.WithDepositStore(depositStore),
+		core.WithSigner(mocksSigner),
+		core.WithAddressFromPubKey(func(bytes.B48) ([]byte, error) {
 			return dummyProposerAddr, nil
-		},
-		nodemetrics.NewNoOpTelemetrySink(),
-	)
+		}),
+		core.WithTelemetry(nodemetrics.NewNoOpTelemetrySink()),
+	}, opts...)...)
+	require.NoError(t, err)
 
 	ctx := &transition.Context{
+		Context:                 context.Background(),
 		SkipPayloadVerification: true,
 		SkipValidateResult:      true,
 		ProposerAddress:         dummyProposerAddr,
@@ -281,6 +254,7 @@ func progressStateToSlot(
 
 func buildNextBlock(
 	t *testing.T,
+	sp *TestStateProcessorT,
 	beaconState *TestBeaconStateT,
 	nextBlkBody *types.BeaconBlockBody,
 ) *types.BeaconBlock {
@@ -292,12 +266,15 @@ func buildNextBlock(
 	root := beaconState.HashTreeRoot()
 	parentBlkHeader.SetStateRoot(root)
 
-	// finally build the block
-	return &types.BeaconBlock{
+	// finally build the block, with the Eth1Data following from its body
+	blk := &types.BeaconBlock{
 		Slot:          parentBlkHeader.GetSlot() + 1,
 		ProposerIndex: parentBlkHeader.GetProposerIndex(),
 		ParentRoot:    parentBlkHeader.HashTreeRoot(),
 		StateRoot:     common.Root{},
 		Body:          nextBlkBody,
 	}
+	blk.Body.Eth1Data, err = sp.Eth1Data(beaconState, blk)
+	require.NoError(t, err)
+	return blk
 }
@@ -0,0 +1,140 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"sync"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/crypto/sha256"
+	"github.com/sourcegraph/conc/iter"
+)
+
+// DepositSignatures verifies the signatures of the deposits creating
+// validators, remembering the deposits pre-verified as they are read from
+// the deposit contract so that their processing skips the BLS verification.
+//
+// A deposit is only skipped if it was verified over the same fork data as the
+// one it is processed with, so pre-verification never changes the outcome of
+// the processing.
+type DepositSignatures struct {
+	cs     common.ChainSpec
+	signer crypto.BLSSigner
+
+	// mu protects forkData and verified.
+	mu sync.RWMutex
+	// forkData is the fork data of the latest deposits processed, which
+	// deposits are pre-verified over.
+	forkData *types.ForkData
+	// verified maps the index of each pre-verified deposit to the key of
+	// the verification.
+	verified map[uint64]common.Root
+}
+
+// NewDepositSignatures creates a new deposit signatures verifier.
+func NewDepositSignatures(
+	cs common.ChainSpec,
+	signer crypto.BLSSigner,
+) *DepositSignatures {
+	return &DepositSignatures{
+		cs:       cs,
+		signer:   signer,
+		verified: make(map[uint64]common.Root),
+	}
+}
+
+// PreVerify verifies the signatures of the given deposits concurrently,
+// remembering the valid ones. It is a no-op until a block with deposits is
+// processed, since the fork data they are signed over is unknown until then.
+func (d *DepositSignatures) PreVerify(deposits []*types.Deposit) {
+	d.mu.RLock()
+	forkData := d.forkData
+	d.mu.RUnlock()
+	if forkData == nil {
+		return
+	}
+
+	keys := iter.Map(deposits, func(dep **types.Deposit) common.Root {
+		if err := (*dep).VerifySignature(
+			forkData, d.cs.DomainTypeDeposit(), d.signer.VerifySignature,
+		); err != nil {
+			return common.Root{}
+		}
+		return verificationKey(*dep, forkData)
+	})
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for i, dep := range deposits {
+		if keys[i] != (common.Root{}) {
+			d.verified[dep.GetIndex().Unwrap()] = keys[i]
+		}
+	}
+}
+
+// Verify verifies the signature of the deposit over the given fork data,
+// returning true if it was pre-verified.
+func (d *DepositSignatures) Verify(
+	dep *types.Deposit, forkData *types.ForkData,
+) (bool, error) {
+	d.mu.RLock()
+	key, found := d.verified[dep.GetIndex().Unwrap()]
+	d.mu.RUnlock()
+	if found && key == verificationKey(dep, forkData) {
+		return true, nil
+	}
+	return false, dep.VerifySignature(
+		forkData, d.cs.DomainTypeDeposit(), d.signer.VerifySignature,
+	)
+}
+
+// observe records the fork data of the latest deposits processed.
+func (d *DepositSignatures) observe(forkData *types.ForkData) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.forkData = forkData
+}
+
+// forget forgets the deposits below the given index, which have been
+// processed.
+func (d *DepositSignatures) forget(index uint64) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for i := range d.verified {
+		if i < index {
+			delete(d.verified, i)
+		}
+	}
+}
+
+// verificationKey returns the key of the verification of the deposit
+// signature over the given fork data.
+func verificationKey(
+	dep *types.Deposit, forkData *types.ForkData,
+) common.Root {
+	depositRoot := dep.HashTreeRoot()
+	forkDataRoot := forkData.HashTreeRoot()
+	return common.Root(
+		sha256.Hash(append(depositRoot[:], forkDataRoot[:]...)),
+	)
+}
@@ -0,0 +1,153 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/node-core/components"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	cryptomocks "github.com/berachain/beacon-kit/primitives/crypto/mocks"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/berachain/beacon-kit/state-transition/core"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDepositSignaturesPreVerification(t *testing.T) {
+	var (
+		badSignature = crypto.BLSSignature{0xba, 0xd}
+		errBad       = errors.New("bad signature")
+	)
+	signer := &cryptomocks.BLSSigner{}
+	signer.On(
+		"VerifySignature", mock.Anything, mock.Anything, badSignature,
+	).Return(errBad)
+	signer.On(
+		"VerifySignature", mock.Anything, mock.Anything, mock.Anything,
+	).Return(nil)
+
+	cs := setupChain(t, components.BetnetChainSpecType)
+	signatures := core.NewDepositSignatures(cs, signer)
+	sp, st, ds, ctx := setupState(
+		t, cs, core.WithDepositSignatures(signatures),
+	)
+
+	var (
+		maxBalance  = math.Gwei(cs.MaxEffectiveBalance())
+		credentials = types.NewCredentialsFromExecutionAddress(
+			common.ExecutionAddress{},
+		)
+		genDeposits = []*types.Deposit{{
+			Pubkey:      [48]byte{0x01},
+			Credentials: credentials,
+			Amount:      maxBalance,
+			Index:       0,
+		}}
+		good = &types.Deposit{
+			Pubkey:      [48]byte{0x02},
+			Credentials: credentials,
+			Amount:      maxBalance,
+			Index:       1,
+		}
+		bad = &types.Deposit{
+			Pubkey:      [48]byte{0x03},
+			Credentials: credentials,
+			Amount:      maxBalance,
+			Signature:   badSignature,
+			Index:       2,
+		}
+	)
+	_, err := sp.InitializePreminedBeaconStateFromEth1(
+		st,
+		genDeposits,
+		new(types.ExecutionPayloadHeader).Empty(),
+		version.FromUint32[common.Version](version.Deneb),
+	)
+	require.NoError(t, err)
+	signer.AssertNumberOfCalls(t, "VerifySignature", 1)
+
+	// The fork data is unknown until a block is processed.
+	signatures.PreVerify([]*types.Deposit{good, bad})
+	signer.AssertNumberOfCalls(t, "VerifySignature", 1)
+
+	nextBlock := func(timestamp math.U64, deposits []*types.Deposit) {
+		blk := buildNextBlock(t, sp, st, &types.BeaconBlockBody{
+			ExecutionPayload: &types.ExecutionPayload{
+				Timestamp:    timestamp,
+				ExtraData:    []byte("testing"),
+				Transactions: [][]byte{},
+				Withdrawals: []*engineprimitives.Withdrawal{
+					st.EVMInflationWithdrawal(),
+				},
+				BaseFeePerGas: math.NewU256(0),
+			},
+			Eth1Data: &types.Eth1Data{},
+			Deposits: deposits,
+		})
+		require.NoError(t, ds.EnqueueDeposits(deposits))
+		_, err = sp.Transition(ctx, st, blk)
+		require.NoError(t, err)
+	}
+	nextBlock(10, []*types.Deposit{})
+
+	signatures.PreVerify([]*types.Deposit{good, bad})
+	signer.AssertNumberOfCalls(t, "VerifySignature", 3)
+
+	// The pre-verified deposit skips the verification, the invalid one is
+	// verified again and ignored.
+	nextBlock(11, []*types.Deposit{good, bad})
+	signer.AssertNumberOfCalls(t, "VerifySignature", 4)
+
+	_, err = st.ValidatorIndexByPubkey(good.Pubkey)
+	require.NoError(t, err)
+	_, err = st.ValidatorIndexByPubkey(bad.Pubkey)
+	require.Error(t, err)
+
+	// A deposit is only skipped over the fork data it was verified with.
+	genesisValidatorsRoot, err := st.GetGenesisValidatorsRoot()
+	require.NoError(t, err)
+	forkData := types.NewForkData(
+		version.FromUint32[common.Version](version.Deneb),
+		genesisValidatorsRoot,
+	)
+	next := &types.Deposit{
+		Pubkey:      [48]byte{0x04},
+		Credentials: credentials,
+		Amount:      maxBalance,
+		Index:       3,
+	}
+	signatures.PreVerify([]*types.Deposit{next})
+	preVerified, err := signatures.Verify(next, forkData)
+	require.NoError(t, err)
+	require.True(t, preVerified)
+
+	preVerified, err = signatures.Verify(
+		next, types.NewForkData(common.Version{0xff}, genesisValidatorsRoot),
+	)
+	require.NoError(t, err)
+	require.False(t, preVerified)
+}
@@ -0,0 +1,379 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"testing"
+
+	payloadtime "github.com/berachain/beacon-kit/beacon/payload-time"
+	"github.com/berachain/beacon-kit/chain-spec/chain"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/node-core/components"
+	"github.com/berachain/beacon-kit/primitives/bytes"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/merkle"
+	"github.com/berachain/beacon-kit/primitives/transition"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/berachain/beacon-kit/testing/differential"
+	blsu "github.com/protolambda/bls12-381-util"
+	"github.com/stretchr/testify/require"
+)
+
+// stateProcessor runs the beacon-kit StateProcessor for the differential
+// harness, on a fresh store loaded with the state to process.
+type stateProcessor struct {
+	t        *testing.T
+	cs       chain.Spec[bytes.B4, math.U64, common.ExecutionAddress, math.U64, any]
+	deposits []*types.Deposit
+}
+
+func (*stateProcessor) Name() string {
+	return "beacon-kit"
+}
+
+func (*stateProcessor) Fields() []string {
+	return types.BeaconStateFieldNames()
+}
+
+func (p *stateProcessor) ProcessSlots(
+	pre *differential.BeaconState, slot math.Slot,
+) (*differential.BeaconState, error) {
+	sp, st, _ := p.load(pre)
+	if _, err := sp.ProcessSlots(st, slot); err != nil {
+		return nil, err
+	}
+	return snapshot(p.t, st), nil
+}
+
+func (p *stateProcessor) ProcessBlock(
+	pre *differential.BeaconState, blk *types.BeaconBlock,
+) (*differential.BeaconState, error) {
+	sp, st, ctx := p.load(pre)
+	if err := sp.ProcessBlock(ctx, st, blk); err != nil {
+		return nil, err
+	}
+	return snapshot(p.t, st), nil
+}
+
+// load returns a state processor and a fresh state holding pre, whose
+// deposit store holds the deposits of the chain.
+func (p *stateProcessor) load(pre *differential.BeaconState) (
+	*TestStateProcessorT, *TestBeaconStateT, *transition.Context,
+) {
+	sp, st, ds, ctx := setupState(p.t, p.cs)
+	require.NoError(p.t, ds.EnqueueDeposits(p.deposits))
+	require.NoError(p.t, loadState(st, pre))
+	require.NoError(p.t, loadDepositBranch(
+		st, p.deposits[:pre.Eth1Data.DepositCount],
+	))
+	return sp, st, ctx
+}
+
+// loadState writes the marshallable state into the store backing st.
+//
+//nolint:gocognit // one setter per field.
+func loadState(st *TestBeaconStateT, m *differential.BeaconState) error {
+	if err := st.SetGenesisValidatorsRoot(m.GenesisValidatorsRoot); err != nil {
+		return err
+	}
+	if err := st.SetSlot(m.Slot); err != nil {
+		return err
+	}
+	if err := st.SetFork(m.Fork); err != nil {
+		return err
+	}
+	if err := st.SetLatestBlockHeader(m.LatestBlockHeader); err != nil {
+		return err
+	}
+	for i, root := range m.BlockRoots {
+		if err := st.UpdateBlockRootAtIndex(uint64(i), root); err != nil {
+			return err
+		}
+	}
+	for i, root := range m.StateRoots {
+		if err := st.UpdateStateRootAtIndex(uint64(i), root); err != nil {
+			return err
+		}
+	}
+	if err := st.SetEth1Data(m.Eth1Data); err != nil {
+		return err
+	}
+	if err := st.SetEth1DepositIndex(m.Eth1DepositIndex); err != nil {
+		return err
+	}
+	if err := st.SetLatestExecutionPayloadHeader(
+		m.LatestExecutionPayloadHeader,
+	); err != nil {
+		return err
+	}
+	for i, val := range m.Validators {
+		if err := st.AddValidator(val); err != nil {
+			return err
+		}
+		if err := st.SetBalance(
+			math.ValidatorIndex(i), math.Gwei(m.Balances[i]),
+		); err != nil {
+			return err
+		}
+	}
+	for i, mix := range m.RandaoMixes {
+		if err := st.UpdateRandaoMixAtIndex(uint64(i), mix); err != nil {
+			return err
+		}
+	}
+	if err := st.SetNextWithdrawalIndex(m.NextWithdrawalIndex); err != nil {
+		return err
+	}
+	if err := st.SetNextWithdrawalValidatorIndex(
+		m.NextWithdrawalValidatorIndex,
+	); err != nil {
+		return err
+	}
+	for i, slashing := range m.Slashings {
+		if err := st.SetSlashingAtIndex(uint64(i), slashing); err != nil {
+			return err
+		}
+	}
+	return st.SetTotalSlashing(m.TotalSlashing)
+}
+
+// loadDepositBranch writes the branch of the deposit tree holding the given
+// deposits, which the marshallable state does not carry, into the store
+// backing st. The node at each height is the root of the latest complete
+// subtree of that height.
+func loadDepositBranch(st *TestBeaconStateT, deposits []*types.Deposit) error {
+	leaves := make([]common.Root, len(deposits))
+	for i, dep := range deposits {
+		leaves[i] = dep.DataRoot()
+	}
+	count := uint64(len(leaves))
+	for height := range uint8(32) {
+		if (count>>height)&1 == 0 {
+			continue
+		}
+		start := (count >> (height + 1)) << (height + 1)
+		node := leaves[start]
+		if height > 0 {
+			tree, err := merkle.NewTreeFromLeavesWithDepth(
+				leaves[start:start+1<<height], height,
+			)
+			if err != nil {
+				return err
+			}
+			node = tree.Root()
+		}
+		if err := st.UpdateDepositBranchAtIndex(
+			uint64(height), node,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// tampered is a reference implementation whose balances are off by one
+// gwei after processing the block at the given slot.
+type tampered struct {
+	differential.Implementation
+	slot math.Slot
+}
+
+func (t *tampered) ProcessBlock(
+	pre *differential.BeaconState, blk *types.BeaconBlock,
+) (*differential.BeaconState, error) {
+	post, err := t.Implementation.ProcessBlock(pre, blk)
+	if err == nil && blk.GetSlot() == t.slot {
+		post.Balances[0]++
+	}
+	return post, err
+}
+
+// blsSigner is a BLS signer holding its secret key in memory.
+type blsSigner struct {
+	sk *blsu.SecretKey
+}
+
+func newBLSSigner(t *testing.T, scalar byte) *blsSigner {
+	t.Helper()
+	sk := new(blsu.SecretKey)
+	require.NoError(t, sk.Deserialize(&[32]byte{31: scalar}))
+	return &blsSigner{sk: sk}
+}
+
+func (s *blsSigner) PublicKey() crypto.BLSPubkey {
+	pk, err := blsu.SkToPk(s.sk)
+	if err != nil {
+		return crypto.BLSPubkey{}
+	}
+	return pk.Serialize()
+}
+
+func (s *blsSigner) Sign(msg []byte) (crypto.BLSSignature, error) {
+	return blsu.Sign(s.sk, msg).Serialize(), nil
+}
+
+func (*blsSigner) VerifySignature(
+	pubKey crypto.BLSPubkey, msg []byte, signature crypto.BLSSignature,
+) error {
+	pk, sig := new(blsu.Pubkey), new(blsu.Signature)
+	if err := pk.Deserialize((*[48]byte)(&pubKey)); err != nil {
+		return err
+	}
+	if err := sig.Deserialize((*[96]byte)(&signature)); err != nil {
+		return err
+	}
+	if !blsu.Verify(pk, msg, sig) {
+		return errors.New("invalid signature")
+	}
+	return nil
+}
+
+// TestDifferential processes a chain of blocks carrying deposits with
+// beacon-kit and with the zrnt implementation of the consensus
+// specification, and checks they agree on every block. It also checks a
+// divergence is reported on the right block and field.
+func TestDifferential(t *testing.T) {
+	cs := setupChain(t, components.BetnetChainSpecType)
+	sp, st, ds, ctx := setupState(t, cs)
+
+	var (
+		maxBalance  = math.Gwei(cs.MaxEffectiveBalance())
+		increment   = math.Gwei(cs.EffectiveBalanceIncrement())
+		credentials = types.NewCredentialsFromExecutionAddress(
+			common.ExecutionAddress{0x01},
+		)
+		proposer    = newBLSSigner(t, 1)
+		joiner      = newBLSSigner(t, 2)
+		genDeposits = []*types.Deposit{{
+			Pubkey:      proposer.PublicKey(),
+			Credentials: credentials,
+			Amount:      maxBalance - 4*increment,
+			Index:       0,
+		}}
+	)
+	_, err := sp.InitializePreminedBeaconStateFromEth1(
+		st,
+		genDeposits,
+		new(types.ExecutionPayloadHeader).Empty(),
+		version.FromUint32[common.Version](version.Deneb),
+	)
+	require.NoError(t, err)
+	gvr, err := st.GetGenesisValidatorsRoot()
+	require.NoError(t, err)
+	forkData := func(epoch math.Epoch) *types.ForkData {
+		return types.NewForkData(
+			version.FromUint32[common.Version](
+				cs.ActiveForkVersionForEpoch(epoch),
+			), gvr,
+		)
+	}
+
+	// The first block tops the proposer up and adds a validator.
+	msg, sig, err := types.CreateAndSignDepositMessage(
+		forkData(0), cs.DomainTypeDeposit(), joiner, credentials, maxBalance,
+	)
+	require.NoError(t, err)
+	blkDeposits := []*types.Deposit{
+		{
+			Pubkey:      proposer.PublicKey(),
+			Credentials: credentials,
+			Amount:      2 * increment,
+			Index:       1,
+		},
+		{
+			Pubkey:      msg.Pubkey,
+			Credentials: msg.Credentials,
+			Amount:      msg.Amount,
+			Signature:   sig,
+			Index:       2,
+		},
+	}
+	require.NoError(t, ds.EnqueueDeposits(blkDeposits))
+	pre := snapshot(t, st)
+
+	// Build a chain of blocks passing the checks of the specification.
+	blocks := make([]*types.BeaconBlock, 0, 3)
+	for i := range 3 {
+		deposits := []*types.Deposit{}
+		if i == 0 {
+			deposits = blkDeposits
+		}
+		slot := math.Slot(i + 1)
+		epoch := cs.SlotToEpoch(slot)
+		lph, lphErr := st.GetLatestExecutionPayloadHeader()
+		require.NoError(t, lphErr)
+		mix, mixErr := st.GetRandaoMixAtIndex(
+			epoch.Unwrap() % cs.EpochsPerHistoricalVector(),
+		)
+		require.NoError(t, mixErr)
+		signingRoot := forkData(epoch).ComputeRandaoSigningRoot(
+			cs.DomainTypeRandao(), epoch,
+		)
+		reveal, signErr := proposer.Sign(signingRoot[:])
+		require.NoError(t, signErr)
+
+		blk := buildNextBlock(t, sp, st, &types.BeaconBlockBody{
+			RandaoReveal: reveal,
+			ExecutionPayload: &types.ExecutionPayload{
+				ParentHash: lph.GetBlockHash(),
+				Random:     mix,
+				Number:     math.U64(i + 1),
+				Timestamp: payloadtime.SlotTimestamp(
+					math.U64(cs.GenesisTime()), cs.SlotDuration(), slot,
+				),
+				ExtraData:    []byte("testing"),
+				BlockHash:    common.ExecutionHash{byte(i + 1)},
+				Transactions: [][]byte{},
+				Withdrawals: []*engineprimitives.Withdrawal{
+					st.EVMInflationWithdrawal(),
+				},
+				BaseFeePerGas: math.NewU256(0),
+			},
+			Eth1Data: &types.Eth1Data{},
+			Deposits: deposits,
+		})
+		_, err = sp.Transition(ctx, st, blk)
+		require.NoError(t, err)
+		blocks = append(blocks, blk)
+	}
+
+	subject := &stateProcessor{
+		t:        t,
+		cs:       cs,
+		deposits: append(genDeposits, blkDeposits...),
+	}
+	reference := differential.NewZrnt(cs)
+	require.NoError(t, differential.New(subject, reference).Run(pre, blocks))
+
+	err = differential.New(subject, &tampered{
+		Implementation: reference,
+		slot:           blocks[1].GetSlot(),
+	}).Run(pre, blocks)
+	var d *differential.Divergence
+	require.ErrorAs(t, err, &d)
+	require.Equal(t, 1, d.Block)
+	require.Equal(t, blocks[1].GetSlot(), d.Slot)
+	require.Equal(t, "Balances", d.Field)
+}
@@ -46,6 +46,14 @@ var (
 	// block is different from the correspondent one from store.
 	ErrDepositMismatch = errors.New("deposit mismatched")
 
+	// ErrEth1DataMismatch is returned when the Eth1Data of a block does not
+	// match the one following from its content.
+	ErrEth1DataMismatch = errors.New("eth1 data mismatch")
+
+	// ErrMissingDeposits is returned when the deposit store misses some of
+	// the deposits needed to fill the deposit tree in at the Eth1Data fork.
+	ErrMissingDeposits = errors.New("missing deposits")
+
 	// ErrDepositIndexOutOfOrder is returned when deposits are not in
 	// contiguous order.
 	ErrDepositIndexOutOfOrder = errors.New("deposit index out of order")
@@ -62,6 +70,18 @@ var (
 	// payload does not match the expected value.
 	ErrRandaoMixMismatch = errors.New("randao mix mismatch")
 
+	// ErrUnknownOperationType is returned when a block carries an operation
+	// whose type has not been registered.
+	ErrUnknownOperationType = errors.New("unknown operation type")
+
+	// ErrDuplicateOperationType is returned when an operation type is
+	// registered more than once.
+	ErrDuplicateOperationType = errors.New("duplicate operation type")
+
+	// ErrInvalidOperation is returned when an operation fails to decode or
+	// to validate against the state.
+	ErrInvalidOperation = errors.New("invalid operation")
+
 	// ErrExceedsBlockDepositLimit is returned when the block exceeds the
 	// deposit limit.
 	ErrExceedsBlockDepositLimit = errors.New("block exceeds deposit limit")
@@ -70,6 +90,12 @@ var (
 	// in a block does not match the expected value.
 	ErrRewardsLengthMismatch = errors.New("rewards length mismatch")
 
+	// ErrRegistryBalancesMismatch is returned when the number of balances in
+	// the state does not match the number of validators.
+	ErrRegistryBalancesMismatch = errors.New(
+		"validators and balances length mismatch",
+	)
+
 	// ErrPenaltiesLengthMismatch is returned when the length of the penalties
 	// in a block does not match the expected value.
 	ErrPenaltiesLengthMismatch = errors.New("penalties length mismatch")
@@ -78,6 +104,10 @@ var (
 	// limit.
 	ErrExceedsBlockBlobLimit = errors.New("block exceeds blob limit")
 
+	// ErrProposerAddressNotFound is returned when the consensus address of
+	// a proposer is not cached for the requested epoch.
+	ErrProposerAddressNotFound = errors.New("proposer address not found")
+
 	// ErrSlashedProposer is returned when a block is processed in which
 	// the proposer is slashed.
 	ErrSlashedProposer = errors.New(
@@ -109,4 +139,12 @@ var (
 	// not match the local state's expected value.
 	ErrWithdrawalMismatch = errors.New(
 		"withdrawal mismatch between local state and payload")
+
+	// ErrMissingDependency is returned when a required dependency of the
+	// state processor is not configured.
+	ErrMissingDependency = errors.New("missing state processor dependency")
+
+	// ErrInvariantViolation is returned when the state after a transition
+	// violates one of its conserved-quantity invariants.
+	ErrInvariantViolation = errors.New("state invariant violation")
 )
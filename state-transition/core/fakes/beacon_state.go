@@ -0,0 +1,126 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package fakes
+
+import (
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/node-core/components"
+	"github.com/berachain/beacon-kit/primitives/common"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+	"github.com/berachain/beacon-kit/storage/beacondb"
+	"github.com/berachain/beacon-kit/storage/db"
+	"github.com/berachain/beacon-kit/storage/encoding"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type (
+	// BeaconStateMarshallable is the marshallable beacon state backing
+	// BeaconState.
+	BeaconStateMarshallable = types.BeaconState[
+		*types.BeaconBlockHeader,
+		*types.Eth1Data,
+		*types.ExecutionPayloadHeader,
+		*types.Fork,
+		*types.Validator,
+		types.BeaconBlockHeader,
+		types.Eth1Data,
+		types.ExecutionPayloadHeader,
+		types.Fork,
+		types.Validator,
+	]
+
+	// KVStore is the beacon KV store backing BeaconState.
+	KVStore = beacondb.KVStore[
+		*types.BeaconBlockHeader,
+		*types.Eth1Data,
+		*types.ExecutionPayloadHeader,
+		*types.Fork,
+		*types.Validator,
+		types.Validators,
+	]
+
+	// BeaconState is the beacon state used by the node, backed by an
+	// in-memory store.
+	BeaconState = statedb.StateDB[
+		*types.BeaconBlockHeader,
+		*BeaconStateMarshallable,
+		*types.Eth1Data,
+		*types.ExecutionPayloadHeader,
+		*types.Fork,
+		*KVStore,
+		*types.Validator,
+		types.Validators,
+		*engineprimitives.Withdrawal,
+		types.WithdrawalCredentials,
+	]
+)
+
+// storeKey is the key the in-memory beacon store is mounted under.
+//
+//nolint:gochecknoglobals // store keys are compared by pointer.
+var storeKey = storetypes.NewKVStoreKey("fake-beacon-state")
+
+// NewKVStore returns an empty beacon KV store held in memory.
+func NewKVStore() (*KVStore, error) {
+	memDB, err := db.OpenDB("", dbm.MemDBBackend)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed opening mem db")
+	}
+
+	nopLog := log.NewNopLogger()
+	cms := store.NewCommitMultiStore(
+		memDB, nopLog, metrics.NewNoOpMetrics(),
+	)
+	cms.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, nil)
+	if err = cms.LoadLatestVersion(); err != nil {
+		return nil, errors.Wrap(err, "failed to load latest version")
+	}
+
+	// The store is opened from the context it carries, so that copies of
+	// the state branch off into a cache context as they do in the node.
+	return beacondb.New[
+		*types.BeaconBlockHeader,
+		*types.Eth1Data,
+		*types.ExecutionPayloadHeader,
+		*types.Fork,
+		*types.Validator,
+		types.Validators,
+	](
+		components.NewKVStoreService(storeKey),
+		&encoding.SSZInterfaceCodec[*types.ExecutionPayloadHeader]{},
+	).WithContext(sdk.NewContext(cms, true, nopLog)), nil
+}
+
+// NewBeaconState returns an empty beacon state held in memory.
+func NewBeaconState(cs common.ChainSpec) (*BeaconState, error) {
+	kvStore, err := NewKVStore()
+	if err != nil {
+		return nil, err
+	}
+	return new(BeaconState).NewFromDB(kvStore, cs), nil
+}
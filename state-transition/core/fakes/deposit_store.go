@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package fakes
+
+import (
+	"sync"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/state-transition/core"
+)
+
+// Compile time check to ensure DepositStore implements the deposit store
+// required by the state processor.
+var _ core.DepositStore[*types.Deposit] = (*DepositStore)(nil)
+
+// DepositStore is an in-memory deposit store keyed by deposit index. As with
+// the KV backed store, reads stop at the first index which has not been
+// enqueued.
+type DepositStore struct {
+	// mu protects deposits for concurrent access.
+	mu       sync.RWMutex
+	deposits map[uint64]*types.Deposit
+}
+
+// NewDepositStore returns an empty deposit store.
+func NewDepositStore() *DepositStore {
+	return &DepositStore{deposits: make(map[uint64]*types.Deposit)}
+}
+
+// GetDepositsByIndex returns at most numView deposits starting at startIndex.
+func (ds *DepositStore) GetDepositsByIndex(
+	startIndex, numView uint64,
+) ([]*types.Deposit, error) {
+	ds.mu.RLock()
+	defer ds.mu.RUnlock()
+	deposits := make([]*types.Deposit, 0, numView)
+	for i := startIndex; i < startIndex+numView; i++ {
+		deposit, ok := ds.deposits[i]
+		if !ok {
+			break
+		}
+		deposits = append(deposits, deposit)
+	}
+	return deposits, nil
+}
+
+// EnqueueDeposits stores the deposits under their own index, overwriting any
+// deposit previously stored at the same index.
+func (ds *DepositStore) EnqueueDeposits(deposits []*types.Deposit) error {
+	ds.mu.Lock()
+	defer ds.mu.Unlock()
+	for _, deposit := range deposits {
+		ds.deposits[deposit.GetIndex().Unwrap()] = deposit
+	}
+	return nil
+}
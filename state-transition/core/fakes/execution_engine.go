@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package fakes provides in-memory test doubles for the dependencies of the
+// state processor. Unlike the generated mocks, fakes carry real state and can
+// be composed into a working StateProcessor without scripting expectations.
+package fakes
+
+import (
+	"context"
+	"sync"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/state-transition/core"
+)
+
+// Compile time check to ensure ExecutionEngine implements the execution
+// engine required by the state processor.
+var _ core.ExecutionEngine[
+	*types.ExecutionPayload,
+	*types.ExecutionPayloadHeader,
+	engineprimitives.Withdrawals,
+] = (*ExecutionEngine)(nil)
+
+// ExecutionEngine is an in-memory execution engine which records every payload
+// it is notified of. Payloads are accepted unless an error has been registered
+// for their block hash through Reject.
+type ExecutionEngine struct {
+	// mu protects payloads and rejected for concurrent access.
+	mu       sync.Mutex
+	payloads []*types.ExecutionPayload
+	rejected map[common.ExecutionHash]error
+}
+
+// NewExecutionEngine returns an execution engine accepting every payload.
+func NewExecutionEngine() *ExecutionEngine {
+	return &ExecutionEngine{
+		rejected: make(map[common.ExecutionHash]error),
+	}
+}
+
+// VerifyAndNotifyNewPayload records the payload of the request, or returns the
+// error registered for its block hash. Like an unreachable execution client,
+// the engine fails requests whose context is done, unless they are
+// optimistic.
+func (ee *ExecutionEngine) VerifyAndNotifyNewPayload(
+	ctx context.Context,
+	req *engineprimitives.NewPayloadRequest[
+		*types.ExecutionPayload, engineprimitives.Withdrawals,
+	],
+) error {
+	if err := ctx.Err(); err != nil {
+		if req.Optimistic {
+			return nil
+		}
+		return err
+	}
+
+	ee.mu.Lock()
+	defer ee.mu.Unlock()
+	if err, ok := ee.rejected[req.ExecutionPayload.GetBlockHash()]; ok {
+		return err
+	}
+	ee.payloads = append(ee.payloads, req.ExecutionPayload)
+	return nil
+}
+
+// Reject makes the engine fail the payload with the given block hash.
+func (ee *ExecutionEngine) Reject(hash common.ExecutionHash, err error) {
+	ee.mu.Lock()
+	defer ee.mu.Unlock()
+	ee.rejected[hash] = err
+}
+
+// Payloads returns the accepted payloads in the order they were received.
+func (ee *ExecutionEngine) Payloads() []*types.ExecutionPayload {
+	ee.mu.Lock()
+	defer ee.mu.Unlock()
+	payloads := make([]*types.ExecutionPayload, len(ee.payloads))
+	copy(payloads, ee.payloads)
+	return payloads
+}
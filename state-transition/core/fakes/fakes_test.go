@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package fakes_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/berachain/beacon-kit/config/spec"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/node-core/components"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/berachain/beacon-kit/state-transition/core/fakes"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDepositStore(t *testing.T) {
+	t.Parallel()
+	ds := fakes.NewDepositStore()
+	require.NoError(t, ds.EnqueueDeposits([]*types.Deposit{
+		{Index: 0}, {Index: 1}, {Index: 3},
+	}))
+
+	deposits, err := ds.GetDepositsByIndex(0, 5)
+	require.NoError(t, err)
+	require.Len(t, deposits, 2)
+
+	deposits, err = ds.GetDepositsByIndex(3, 5)
+	require.NoError(t, err)
+	require.Len(t, deposits, 1)
+	require.Equal(t, uint64(3), deposits[0].Index)
+}
+
+func TestExecutionEngine(t *testing.T) {
+	t.Parallel()
+	var (
+		ee     = fakes.NewExecutionEngine()
+		errBad = errors.New("bad payload")
+		good   = &types.ExecutionPayload{BlockHash: common.ExecutionHash{1}}
+		bad    = &types.ExecutionPayload{BlockHash: common.ExecutionHash{2}}
+		newReq = func(
+			payload *types.ExecutionPayload,
+		) *engineprimitives.NewPayloadRequest[
+			*types.ExecutionPayload, engineprimitives.Withdrawals,
+		] {
+			return &engineprimitives.NewPayloadRequest[
+				*types.ExecutionPayload, engineprimitives.Withdrawals,
+			]{ExecutionPayload: payload}
+		}
+	)
+	ee.Reject(bad.BlockHash, errBad)
+
+	ctx := context.Background()
+	require.NoError(t, ee.VerifyAndNotifyNewPayload(ctx, newReq(good)))
+	require.ErrorIs(t, ee.VerifyAndNotifyNewPayload(ctx, newReq(bad)), errBad)
+	require.Equal(t, []*types.ExecutionPayload{good}, ee.Payloads())
+}
+
+func TestNewStateProcessor(t *testing.T) {
+	t.Setenv(components.ChainSpecTypeEnvVar, spec.BetnetPreset)
+	cs, err := components.ProvideChainSpec()
+	require.NoError(t, err)
+
+	sp, err := fakes.NewStateProcessor(cs)
+	require.NoError(t, err)
+	st, err := fakes.NewBeaconState(cs)
+	require.NoError(t, err)
+
+	maxBalance := math.Gwei(cs.MaxEffectiveBalance())
+	genVals, err := sp.InitializePreminedBeaconStateFromEth1(
+		st,
+		[]*types.Deposit{
+			{Pubkey: [48]byte{0x01}, Amount: maxBalance, Index: 0},
+			{Pubkey: [48]byte{0x02}, Amount: maxBalance, Index: 1},
+		},
+		new(types.ExecutionPayloadHeader).Empty(),
+		version.FromUint32[common.Version](version.Deneb),
+	)
+	require.NoError(t, err)
+	require.Len(t, genVals, 2)
+
+	numVals, err := st.GetTotalValidators()
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), numVals)
+}
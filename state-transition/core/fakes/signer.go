@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package fakes
+
+import (
+	"sync"
+
+	"github.com/berachain/beacon-kit/primitives/crypto"
+)
+
+// addressLen is the length of a CometBFT address.
+const addressLen = 20
+
+// Compile time check to ensure Signer implements crypto.BLSSigner.
+var _ crypto.BLSSigner = (*Signer)(nil)
+
+// Signer is a BLS signer which produces empty signatures and accepts every
+// signature unless it has been told otherwise through RejectSignatures.
+type Signer struct {
+	// mu protects verifyErr for concurrent access.
+	mu        sync.RWMutex
+	pubkey    crypto.BLSPubkey
+	verifyErr error
+}
+
+// NewSigner returns a signer for the given public key.
+func NewSigner(pubkey crypto.BLSPubkey) *Signer {
+	return &Signer{pubkey: pubkey}
+}
+
+// PublicKey returns the public key of the signer.
+func (s *Signer) PublicKey() crypto.BLSPubkey {
+	return s.pubkey
+}
+
+// Sign returns an empty signature.
+func (s *Signer) Sign([]byte) (crypto.BLSSignature, error) {
+	return crypto.BLSSignature{}, nil
+}
+
+// VerifySignature returns the error set through RejectSignatures, if any.
+func (s *Signer) VerifySignature(
+	crypto.BLSPubkey, []byte, crypto.BLSSignature,
+) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.verifyErr
+}
+
+// RejectSignatures makes every subsequent verification fail with err. A nil
+// err restores acceptance.
+func (s *Signer) RejectSignatures(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.verifyErr = err
+}
+
+// AddressFromPubKey derives a proposer address by truncating the public key
+// to the length of a CometBFT address, so that processors built from fakes do
+// not depend on CometBFT being compiled with BLS support.
+func AddressFromPubKey(pubkey crypto.BLSPubkey) ([]byte, error) {
+	return pubkey[:addressLen], nil
+}
@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package fakes
+
+import (
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/transition"
+	"github.com/berachain/beacon-kit/state-transition/core"
+)
+
+// StateProcessor is the state processor operating on the fake BeaconState.
+type StateProcessor = core.StateProcessor[
+	*BeaconState, *transition.Context, *KVStore,
+]
+
+// NewStateProcessor returns a state processor following the given fork
+// schedule, wired to a fresh ExecutionEngine, DepositStore and Signer and
+// deriving proposer addresses with AddressFromPubKey. Any of them, as well as
+// the remaining dependencies, can be replaced through opts, which are applied
+// after the defaults.
+func NewStateProcessor(
+	cs common.ChainSpec,
+	opts ...core.Option,
+) (*StateProcessor, error) {
+	return core.NewStateProcessor[
+		*BeaconState, *transition.Context, *KVStore,
+	](append([]core.Option{
+		core.WithForkSchedule(cs),
+		core.WithExecutionEngine(NewExecutionEngine()),
+		core.WithDepositStore(NewDepositStore()),
+		core.WithSigner(NewSigner(crypto.BLSPubkey{})),
+		core.WithAddressFromPubKey(AddressFromPubKey),
+	}, opts...)...)
+}
@@ -0,0 +1,136 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"flag"
+	"path/filepath"
+	"testing"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/node-core/components"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/berachain/beacon-kit/testing/golden"
+	"github.com/stretchr/testify/require"
+)
+
+//nolint:gochecknoglobals // test flag.
+var updateGolden = flag.Bool(
+	"update-golden", false, "record the golden states again",
+)
+
+// goldenStates is the file holding the golden states of TestGoldenStates.
+const goldenStates = "testdata/golden/states.json"
+
+// snapshot returns a copy of the state, which does not share the cached
+// objects later transitions update in place.
+func snapshot(t *testing.T, st *TestBeaconStateT) *golden.BeaconState {
+	t.Helper()
+	m, err := st.GetMarshallable()
+	require.NoError(t, err)
+	bz, err := m.MarshalSSZ()
+	require.NoError(t, err)
+	cpy := &golden.BeaconState{}
+	require.NoError(t, cpy.UnmarshalSSZ(bz))
+	return cpy
+}
+
+// TestGoldenStates processes a fixed chain of blocks and checks every
+// post-state against the golden states recorded by a previous run. A change
+// to the state transition of these blocks fails the test; when the change is
+// intended, record the golden states again with -update-golden.
+func TestGoldenStates(t *testing.T) {
+	cs := setupChain(t, components.BetnetChainSpecType)
+	sp, st, ds, ctx := setupState(t, cs)
+
+	var (
+		maxBalance  = math.Gwei(cs.MaxEffectiveBalance())
+		increment   = math.Gwei(cs.EffectiveBalanceIncrement())
+		credentials = types.NewCredentialsFromExecutionAddress(
+			common.ExecutionAddress{0x01},
+		)
+		genDeposits = []*types.Deposit{
+			{
+				Pubkey:      [48]byte{0x01},
+				Credentials: credentials,
+				Amount:      maxBalance,
+				Index:       0,
+			},
+			{
+				Pubkey:      [48]byte{0x02},
+				Credentials: credentials,
+				Amount:      maxBalance - 4*increment,
+				Index:       1,
+			},
+		}
+		blkDeposit = &types.Deposit{
+			Pubkey:      genDeposits[1].Pubkey,
+			Credentials: credentials,
+			Amount:      2 * increment,
+			Index:       2,
+		}
+	)
+	_, err := sp.InitializePreminedBeaconStateFromEth1(
+		st,
+		genDeposits,
+		new(types.ExecutionPayloadHeader).Empty(),
+		version.FromUint32[common.Version](version.Deneb),
+	)
+	require.NoError(t, err)
+	require.NoError(t, ds.EnqueueDeposits([]*types.Deposit{blkDeposit}))
+
+	posts := make([]*golden.BeaconState, 0, 3)
+	for i := range 3 {
+		deposits := []*types.Deposit{}
+		if i == 0 {
+			deposits = append(deposits, blkDeposit)
+		}
+		blk := buildNextBlock(t, sp, st, &types.BeaconBlockBody{
+			ExecutionPayload: &types.ExecutionPayload{
+				Timestamp:    math.U64(10 + i),
+				ExtraData:    []byte("testing"),
+				Transactions: [][]byte{},
+				Withdrawals: []*engineprimitives.Withdrawal{
+					st.EVMInflationWithdrawal(),
+				},
+				BaseFeePerGas: math.NewU256(0),
+			},
+			Eth1Data: &types.Eth1Data{},
+			Deposits: deposits,
+		})
+		_, err = sp.Transition(ctx, st, blk)
+		require.NoError(t, err)
+		posts = append(posts, snapshot(t, st))
+	}
+
+	path := filepath.FromSlash(goldenStates)
+	if *updateGolden {
+		recorded, recErr := golden.Record(posts)
+		require.NoError(t, recErr)
+		require.NoError(t, recorded.Write(path))
+	}
+	states, err := golden.Load(path)
+	require.NoError(t, err)
+	require.NoError(t, states.Check(posts))
+}
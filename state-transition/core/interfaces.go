@@ -23,24 +23,22 @@ package core
 import (
 	"context"
 
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/merkle"
 )
 
+// Mocks of the interfaces consumed by the state processor live in ./mocks;
+// in-memory fakes composing into a working processor live in ./fakes.
+//
+//go:generate go run github.com/vektra/mockery/v2@v2.49.0 --config=../../.mockery.yaml
+
 // BeaconState is the interface for the beacon state. It
 // is a combination of the read-only and write-only beacon state types.
-type BeaconState[
-	T any,
-	BeaconBlockHeaderT,
-	Eth1DataT,
-	ExecutionPayloadHeaderT,
-	ForkT,
-	KVStoreT,
-	ValidatorT,
-	ValidatorsT,
-	WithdrawalT any,
-] interface {
+type BeaconState[T, KVStoreT any] interface {
 	NewFromDB(
 		bdb KVStoreT,
 		cs common.ChainSpec,
@@ -48,16 +46,27 @@ type BeaconState[
 	Copy() T
 	Context() context.Context
 	HashTreeRoot() common.Root
-	ReadOnlyBeaconState[
-		BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
-		ForkT, ValidatorT, ValidatorsT, WithdrawalT,
-	]
-	WriteOnlyBeaconState[
-		BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
-		ForkT, ValidatorT,
-	]
+	ParallelHashTreeRoot(hasher *merkle.ParallelHasher) (common.Root, error)
+	ReadOnlyState
+	WriteOnlyState
 }
 
+// ReadOnlyState is the read-only view of the beacon state the state processor
+// operates on. Processing steps which only inspect the state take it, as do
+// API readers through ReadOnlySnapshot.
+type ReadOnlyState = ReadOnlyBeaconState[
+	*types.BeaconBlockHeader, *types.Eth1Data,
+	*types.ExecutionPayloadHeader, *types.Fork,
+	*types.Validator, types.Validators, *engineprimitives.Withdrawal,
+]
+
+// WriteOnlyState is the write-only view of the beacon state the state
+// processor operates on.
+type WriteOnlyState = WriteOnlyBeaconState[
+	*types.BeaconBlockHeader, *types.Eth1Data,
+	*types.ExecutionPayloadHeader, *types.Fork, *types.Validator,
+]
+
 // ReadOnlyBeaconState is the interface for a read-only beacon state.
 type ReadOnlyBeaconState[
 	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
@@ -70,6 +79,7 @@ type ReadOnlyBeaconState[
 	ReadOnlyWithdrawals[WithdrawalT]
 
 	GetBalance(math.ValidatorIndex) (math.Gwei, error)
+	GetBalances() ([]uint64, error)
 	GetSlot() (math.Slot, error)
 	GetFork() (ForkT, error)
 	GetGenesisValidatorsRoot() (common.Root, error)
@@ -86,6 +96,9 @@ type ReadOnlyBeaconState[
 	ValidatorIndexByCometBFTAddress(
 		cometBFTAddress []byte,
 	) (math.ValidatorIndex, error)
+	ValidatorIndicesByWithdrawalAddress(
+		address common.ExecutionAddress,
+	) ([]math.ValidatorIndex, error)
 }
 
 // WriteOnlyBeaconState is the interface for a write-only beacon state.
@@ -161,6 +174,7 @@ type ReadOnlyValidators[ValidatorT any] interface {
 type WriteOnlyEth1Data[Eth1DataT, ExecutionPayloadHeaderT any] interface {
 	SetEth1Data(Eth1DataT) error
 	SetEth1DepositIndex(uint64) error
+	UpdateDepositBranchAtIndex(uint64, common.Root) error
 	SetLatestExecutionPayloadHeader(
 		ExecutionPayloadHeaderT,
 	) error
@@ -170,6 +184,7 @@ type WriteOnlyEth1Data[Eth1DataT, ExecutionPayloadHeaderT any] interface {
 type ReadOnlyEth1Data[Eth1DataT, ExecutionPayloadHeaderT any] interface {
 	GetEth1Data() (Eth1DataT, error)
 	GetEth1DepositIndex() (uint64, error)
+	GetDepositBranchAtIndex(uint64) (common.Root, error)
 	GetLatestExecutionPayloadHeader() (
 		ExecutionPayloadHeaderT, error,
 	)
@@ -20,6 +20,30 @@
 
 package core
 
+import (
+	"strconv"
+	"time"
+)
+
+const (
+	// Block processing phases.
+	phaseBlockHeader      = "header"
+	phaseExecutionPayload = "payload"
+	phaseWithdrawals      = "withdrawals"
+	phaseRandaoReveal     = "randao"
+	phaseEth1Data         = "eth1_data"
+	phaseOperations       = "operations"
+	phaseStateRoot        = "state_root"
+
+	// Epoch processing phases.
+	phaseRewardsAndPenalties     = "rewards_and_penalties"
+	phaseEffectiveBalanceUpdates = "effective_balance_updates"
+	phaseEjections               = "ejections"
+	phaseSlashingsReset          = "slashings_reset"
+	phaseRandaoMixesReset        = "randao_mixes_reset"
+	phaseValidatorsSetUpdates    = "validators_set_updates"
+)
+
 type stateProcessorMetrics struct {
 	// sink is the sink for the metrics.
 	sink TelemetrySink
@@ -43,3 +67,53 @@ func (s *stateProcessorMetrics) gaugeTimestamps(
 	diff := int64(payloadTimestamp) - int64(consensusTimestamp) //#nosec:G701
 	s.sink.SetGauge("beacon_kit.state.payload_consensus_timestamp_diff", diff)
 }
+
+// measureBlockPhase measures the time taken by a block processing phase,
+// labeled by whether the phase succeeded.
+func (s *stateProcessorMetrics) measureBlockPhase(
+	phase string,
+	start time.Time,
+	err error,
+) {
+	s.sink.MeasureSince(
+		"beacon_kit.state.process_block_phase_duration", start,
+		"phase", phase, "success", strconv.FormatBool(err == nil),
+	)
+}
+
+// measureEpochPhase measures the time taken by an epoch processing phase,
+// labeled by whether the phase succeeded.
+func (s *stateProcessorMetrics) measureEpochPhase(
+	phase string,
+	start time.Time,
+	err error,
+) {
+	s.sink.MeasureSince(
+		"beacon_kit.state.process_epoch_phase_duration", start,
+		"phase", phase, "success", strconv.FormatBool(err == nil),
+	)
+}
+
+// countSettledEpoch counts the epochs processed without re-running the
+// balance-driven updates, since no block was processed after the previous
+// epoch boundary.
+func (s *stateProcessorMetrics) countSettledEpoch() {
+	s.sink.IncrementCounter("beacon_kit.state.settled_epochs")
+}
+
+// countPrecomputedSlot counts the slots processed, labeled by whether the
+// state root was precomputed.
+func (s *stateProcessorMetrics) countPrecomputedSlot(hit bool) {
+	s.sink.IncrementCounter(
+		"beacon_kit.state.precomputed_slot", "hit", strconv.FormatBool(hit),
+	)
+}
+
+// countDepositSignature counts the signatures of the deposits creating
+// validators, labeled by whether they were pre-verified.
+func (s *stateProcessorMetrics) countDepositSignature(preVerified bool) {
+	s.sink.IncrementCounter(
+		"beacon_kit.state.deposit_signature",
+		"pre_verified", strconv.FormatBool(preVerified),
+	)
+}
@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"slices"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/transition"
+)
+
+// OperationState is the view of the beacon state custom operations are
+// validated against and applied to.
+type OperationState interface {
+	ReadOnlyState
+	WriteOnlyState
+}
+
+// OperationType defines an application-specific block operation, letting
+// applications embedding beacon-kit extend processOperations without forking
+// the state processor.
+type OperationType[OperationT any] struct {
+	// ID tags the operations of this type.
+	ID transition.OperationTypeID
+	// Decode decodes an operation from its SSZ encoding.
+	Decode func([]byte) (OperationT, error)
+	// Validate checks the operation against the state it is applied to.
+	Validate func(ReadOnlyState, OperationT) error
+	// Process applies the operation to the state.
+	Process func(OperationState, OperationT) error
+}
+
+// operationHandler erases the operation type of an OperationType so that
+// types of different operations can share a registry.
+type operationHandler interface {
+	apply(st OperationState, data []byte) error
+}
+
+// apply decodes, validates and processes a single operation.
+func (ot OperationType[OperationT]) apply(
+	st OperationState, data []byte,
+) error {
+	op, err := ot.Decode(data)
+	if err != nil {
+		return errors.Wrapf(
+			ErrInvalidOperation, "type %d: decode: %v", ot.ID, err,
+		)
+	}
+	if err = ot.Validate(st, op); err != nil {
+		return errors.Wrapf(
+			ErrInvalidOperation, "type %d: validate: %v", ot.ID, err,
+		)
+	}
+	return ot.Process(st, op)
+}
+
+// WithOperationType registers an application-specific operation type. Each
+// type ID may be registered once.
+func WithOperationType[OperationT any](
+	ot OperationType[OperationT],
+) Option {
+	return func(cfg *config) error {
+		if ot.Decode == nil || ot.Validate == nil || ot.Process == nil {
+			return errors.Wrapf(
+				ErrMissingDependency, "operation type %d handlers", ot.ID,
+			)
+		}
+		if _, ok := cfg.operations[ot.ID]; ok {
+			return errors.Wrapf(ErrDuplicateOperationType, "%d", ot.ID)
+		}
+		cfg.operations[ot.ID] = ot
+		return nil
+	}
+}
+
+// processCustomOperations applies the application-defined operations carried
+// by the block body. Operations are applied by ascending type ID and, for a
+// given type, in the order of the body, so that the result does not depend
+// on how they were gathered.
+func (sp *StateProcessor[BeaconStateT, _, _]) processCustomOperations(
+	st BeaconStateT,
+	ops []*transition.Operation,
+) error {
+	if len(ops) == 0 {
+		return nil
+	}
+	ordered := slices.Clone(ops)
+	slices.SortStableFunc(ordered, func(a, b *transition.Operation) int {
+		return int(a.TypeID) - int(b.TypeID)
+	})
+	for _, op := range ordered {
+		handler, ok := sp.operations[op.TypeID]
+		if !ok {
+			return errors.Wrapf(ErrUnknownOperationType, "%d", op.TypeID)
+		}
+		if err := handler.apply(st, op.Data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,176 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/node-core/components"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/transition"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/berachain/beacon-kit/state-transition/core"
+	"github.com/berachain/beacon-kit/state-transition/core/fakes"
+	"github.com/stretchr/testify/require"
+)
+
+// balanceOperation builds an operation type moving validator balances by the
+// amount of SSZ encoded withdrawals, recording the order operations run in.
+func balanceOperation(
+	id transition.OperationTypeID,
+	applied *[]transition.OperationTypeID,
+	apply func(core.OperationState, math.ValidatorIndex, math.Gwei) error,
+) core.Option {
+	return core.WithOperationType(core.OperationType[*engineprimitives.Withdrawal]{
+		ID: id,
+		Decode: func(bz []byte) (*engineprimitives.Withdrawal, error) {
+			w := new(engineprimitives.Withdrawal)
+			return w, w.UnmarshalSSZ(bz)
+		},
+		Validate: func(
+			st core.ReadOnlyState, w *engineprimitives.Withdrawal,
+		) error {
+			if w.Amount == 0 {
+				return errors.New("zero amount")
+			}
+			_, err := st.ValidatorByIndex(w.Validator)
+			return err
+		},
+		Process: func(
+			st core.OperationState, w *engineprimitives.Withdrawal,
+		) error {
+			*applied = append(*applied, id)
+			return apply(st, w.Validator, w.Amount)
+		},
+	})
+}
+
+func TestTransitionCustomOperations(t *testing.T) {
+	cs := setupChain(t, components.BetnetChainSpecType)
+
+	const (
+		penaltyID transition.OperationTypeID = iota + 1
+		bonusID
+	)
+	var applied []transition.OperationTypeID
+	sp, st, _, ctx := setupState(t, cs,
+		balanceOperation(bonusID, &applied,
+			func(st core.OperationState, idx math.ValidatorIndex,
+				amount math.Gwei) error {
+				return st.IncreaseBalance(idx, amount)
+			},
+		),
+		balanceOperation(penaltyID, &applied,
+			func(st core.OperationState, idx math.ValidatorIndex,
+				amount math.Gwei) error {
+				return st.DecreaseBalance(idx, amount)
+			},
+		),
+	)
+
+	maxBalance := math.Gwei(cs.MaxEffectiveBalance())
+	_, err := sp.InitializePreminedBeaconStateFromEth1(
+		st,
+		[]*types.Deposit{{
+			Pubkey: [48]byte{0x01},
+			Credentials: types.NewCredentialsFromExecutionAddress(
+				common.ExecutionAddress{},
+			),
+			Amount: maxBalance,
+			Index:  0,
+		}},
+		new(types.ExecutionPayloadHeader).Empty(),
+		version.FromUint32[common.Version](version.Deneb),
+	)
+	require.NoError(t, err)
+
+	operation := func(
+		id transition.OperationTypeID, idx math.ValidatorIndex, amount uint64,
+	) *transition.Operation {
+		bz, errMarshal := (&engineprimitives.Withdrawal{
+			Validator: idx,
+			Amount:    math.Gwei(amount),
+		}).MarshalSSZ()
+		require.NoError(t, errMarshal)
+		return &transition.Operation{TypeID: id, Data: bz}
+	}
+	nextBlock := func(ops ...*transition.Operation) *types.BeaconBlock {
+		return buildNextBlock(t, sp, st, &types.BeaconBlockBody{
+			ExecutionPayload: &types.ExecutionPayload{
+				Timestamp:    10,
+				ExtraData:    []byte("testing"),
+				Transactions: [][]byte{},
+				Withdrawals: []*engineprimitives.Withdrawal{
+					st.EVMInflationWithdrawal(),
+				},
+				BaseFeePerGas: math.NewU256(0),
+			},
+			Eth1Data:   &types.Eth1Data{},
+			Deposits:   []*types.Deposit{},
+			Operations: ops,
+		})
+	}
+
+	// operations run by ascending type ID, in body order within a type
+	_, err = sp.Transition(ctx, st, nextBlock(
+		operation(bonusID, 0, 1),
+		operation(penaltyID, 0, 5),
+		operation(bonusID, 0, 2),
+	))
+	require.NoError(t, err)
+	require.Equal(t,
+		[]transition.OperationTypeID{penaltyID, bonusID, bonusID}, applied,
+	)
+	balance, err := st.GetBalance(0)
+	require.NoError(t, err)
+	require.Equal(t, maxBalance-2, balance)
+
+	// operations of unknown types or failing validation invalidate the block
+	blk := nextBlock(operation(bonusID+1, 0, 1))
+	_, err = sp.Transition(ctx, st.Copy(), blk)
+	require.ErrorIs(t, err, core.ErrUnknownOperationType)
+
+	blk.Body.Operations = []*transition.Operation{operation(bonusID, 0, 0)}
+	_, err = sp.Transition(ctx, st.Copy(), blk)
+	require.ErrorIs(t, err, core.ErrInvalidOperation)
+
+	blk.Body.Operations = []*transition.Operation{operation(bonusID, 1, 1)}
+	_, err = sp.Transition(ctx, st.Copy(), blk)
+	require.ErrorIs(t, err, core.ErrInvalidOperation)
+}
+
+func TestWithOperationTypeRejectsDuplicates(t *testing.T) {
+	cs := setupChain(t, components.BetnetChainSpecType)
+	var applied []transition.OperationTypeID
+	noop := func(core.OperationState, math.ValidatorIndex, math.Gwei) error {
+		return nil
+	}
+
+	_, err := fakes.NewStateProcessor(cs,
+		balanceOperation(1, &applied, noop),
+		balanceOperation(1, &applied, noop),
+	)
+	require.ErrorIs(t, err, core.ErrDuplicateOperationType)
+}
@@ -0,0 +1,204 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"time"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/merkle"
+	"github.com/berachain/beacon-kit/primitives/transition"
+)
+
+// Option is a functional option configuring the dependencies of a
+// StateProcessor.
+type Option func(*config) error
+
+// config collects the dependencies of a StateProcessor before they are
+// validated.
+type config struct {
+	logger          log.Logger
+	cs              common.ChainSpec
+	executionEngine ExecutionEngine[
+		*types.ExecutionPayload, *types.ExecutionPayloadHeader,
+		engineprimitives.Withdrawals,
+	]
+	ds                    DepositStore[*types.Deposit]
+	signer                crypto.BLSSigner
+	fGetAddressFromPubKey func(crypto.BLSPubkey) ([]byte, error)
+	telemetrySink         TelemetrySink
+	operations            map[transition.OperationTypeID]operationHandler
+	checkInvariants       bool
+	depositSignatures     *DepositSignatures
+	stateHasher           *merkle.ParallelHasher
+}
+
+// defaultConfig returns the config with the optional dependencies set.
+func defaultConfig() *config {
+	return &config{
+		logger:                noop.NewLogger[log.Logger](),
+		fGetAddressFromPubKey: crypto.GetAddressFromPubKey,
+		telemetrySink:         noopTelemetrySink{},
+		stateHasher:           merkle.NewParallelHasher(0),
+		operations: make(
+			map[transition.OperationTypeID]operationHandler,
+		),
+	}
+}
+
+// WithLogger sets the logger of the state processor. Defaults to a no-op
+// logger.
+func WithLogger(logger log.Logger) Option {
+	return func(c *config) error {
+		c.logger = logger
+		return nil
+	}
+}
+
+// WithForkSchedule sets the chain spec of the state processor, which carries
+// the fork schedule along with the other consensus parameters. Required.
+func WithForkSchedule(cs common.ChainSpec) Option {
+	return func(c *config) error {
+		c.cs = cs
+		return nil
+	}
+}
+
+// WithExecutionEngine sets the engine used to verify execution payloads.
+// Required unless payload verification is always skipped, as it happens for
+// genesis tooling.
+func WithExecutionEngine(
+	executionEngine ExecutionEngine[
+		*types.ExecutionPayload, *types.ExecutionPayloadHeader,
+		engineprimitives.Withdrawals,
+	],
+) Option {
+	return func(c *config) error {
+		c.executionEngine = executionEngine
+		return nil
+	}
+}
+
+// WithDepositStore sets the store used to check block deposits against the
+// deposit contract. Required.
+func WithDepositStore(ds DepositStore[*types.Deposit]) Option {
+	return func(c *config) error {
+		c.ds = ds
+		return nil
+	}
+}
+
+// WithSigner sets the BLS signer used to verify signatures. Required.
+func WithSigner(signer crypto.BLSSigner) Option {
+	return func(c *config) error {
+		c.signer = signer
+		return nil
+	}
+}
+
+// WithAddressFromPubKey sets the function converting validator public keys
+// to consensus addresses. Defaults to crypto.GetAddressFromPubKey.
+// NOTE: Should only be used for testing.
+func WithAddressFromPubKey(
+	fGetAddressFromPubKey func(crypto.BLSPubkey) ([]byte, error),
+) Option {
+	return func(c *config) error {
+		c.fGetAddressFromPubKey = fGetAddressFromPubKey
+		return nil
+	}
+}
+
+// WithTelemetry sets the sink for the state processor metrics. Defaults to a
+// no-op sink.
+func WithTelemetry(sink TelemetrySink) Option {
+	return func(c *config) error {
+		c.telemetrySink = sink
+		return nil
+	}
+}
+
+// WithInvariantChecks makes the state processor check the conserved
+// quantities of the state after each transition, failing the transition
+// which violates them. Disabled by default, as it walks the whole validator
+// registry on every block: it is meant for debug builds, see
+// InvariantChecksEnabled.
+func WithInvariantChecks() Option {
+	return func(c *config) error {
+		c.checkInvariants = true
+		return nil
+	}
+}
+
+// WithDepositSignatures sets the verifier of the deposit signatures, which
+// may be shared with the service pre-verifying the deposits read from the
+// deposit contract. Defaults to a verifier without pre-verified deposits.
+func WithDepositSignatures(ds *DepositSignatures) Option {
+	return func(c *config) error {
+		c.depositSignatures = ds
+		return nil
+	}
+}
+
+// WithStateHasher sets the hasher merkleizing the validator registry of the
+// state across its workers. Defaults to one worker per available CPU.
+func WithStateHasher(hasher *merkle.ParallelHasher) Option {
+	return func(c *config) error {
+		c.stateHasher = hasher
+		return nil
+	}
+}
+
+// validate ensures all the required dependencies are set.
+func (c *config) validate() error {
+	switch {
+	case c.logger == nil:
+		return errors.Wrap(ErrMissingDependency, "logger")
+	case c.cs == nil:
+		return errors.Wrap(ErrMissingDependency, "chain spec")
+	case c.ds == nil:
+		return errors.Wrap(ErrMissingDependency, "deposit store")
+	case c.signer == nil:
+		return errors.Wrap(ErrMissingDependency, "signer")
+	case c.fGetAddressFromPubKey == nil:
+		return errors.Wrap(ErrMissingDependency, "address from pubkey")
+	case c.telemetrySink == nil:
+		return errors.Wrap(ErrMissingDependency, "telemetry sink")
+	case c.stateHasher == nil:
+		return errors.Wrap(ErrMissingDependency, "state hasher")
+	default:
+		return nil
+	}
+}
+
+// noopTelemetrySink is the TelemetrySink used when none is configured.
+type noopTelemetrySink struct{}
+
+func (noopTelemetrySink) SetGauge(string, int64, ...string) {}
+
+func (noopTelemetrySink) MeasureSince(string, time.Time, ...string) {}
+
+func (noopTelemetrySink) IncrementCounter(string, ...string) {}
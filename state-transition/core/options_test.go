@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/node-core/components"
+	cryptomocks "github.com/berachain/beacon-kit/primitives/crypto/mocks"
+	"github.com/berachain/beacon-kit/primitives/transition"
+	"github.com/berachain/beacon-kit/state-transition/core"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestStateProcessor(opts ...core.Option) (
+	*TestStateProcessorT, error,
+) {
+	return core.NewStateProcessor[
+		*TestBeaconStateT, *transition.Context, *TestKVStoreT,
+	](opts...)
+}
+
+func TestNewStateProcessorOptions(t *testing.T) {
+	cs := setupChain(t, components.BetnetChainSpecType)
+	_, depositStore, err := initTestStores()
+	require.NoError(t, err)
+
+	required := []core.Option{
+		core.WithForkSchedule(cs),
+		core.WithDepositStore(depositStore),
+		core.WithSigner(&cryptomocks.BLSSigner{}),
+	}
+
+	sp, err := newTestStateProcessor(required...)
+	require.NoError(t, err)
+	require.NotNil(t, sp)
+
+	// every required dependency must be set
+	for i := range required {
+		opts := append([]core.Option{}, required[:i]...)
+		opts = append(opts, required[i+1:]...)
+		_, err = newTestStateProcessor(opts...)
+		require.ErrorIs(t, err, core.ErrMissingDependency)
+	}
+
+	// optional dependencies cannot be unset
+	_, err = newTestStateProcessor(append(required, core.WithLogger(nil))...)
+	require.ErrorIs(t, err, core.ErrMissingDependency)
+}
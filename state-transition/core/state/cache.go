@@ -0,0 +1,215 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package state
+
+import (
+	"sync"
+
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// cached is a memoized value read from the store.
+type cached[T any] struct {
+	value T
+	ok    bool
+}
+
+// get returns the memoized value, reading it with the given function if
+// it has not been read yet. Errors are not memoized.
+func (c *cached[T]) get(read func() (T, error)) (T, error) {
+	if c.ok {
+		return c.value, nil
+	}
+	value, err := read()
+	if err != nil {
+		return value, err
+	}
+	c.value, c.ok = value, true
+	return value, nil
+}
+
+// readCache memoizes the state reads repeated across the phases of a state
+// transition. A write to the store invalidates the reads it affects.
+//
+// Cached headers and validators are returned as copies, so that a caller
+// changing one without writing it back leaves the cache matching the store.
+type readCache[BeaconBlockHeaderT, ForkT, ValidatorT any] struct {
+	mu                    sync.Mutex
+	slot                  cached[math.Slot]
+	fork                  cached[ForkT]
+	genesisValidatorsRoot cached[common.Root]
+	latestBlockHeader     cached[BeaconBlockHeaderT]
+	totalValidators       cached[uint64]
+	validators            map[math.ValidatorIndex]ValidatorT
+}
+
+// newReadCache creates an empty read cache.
+func newReadCache[
+	BeaconBlockHeaderT, ForkT, ValidatorT any,
+]() *readCache[BeaconBlockHeaderT, ForkT, ValidatorT] {
+	return &readCache[BeaconBlockHeaderT, ForkT, ValidatorT]{
+		validators: make(map[math.ValidatorIndex]ValidatorT),
+	}
+}
+
+// GetSlot retrieves the current slot.
+func (s *StateDB[
+	_, _, _, _, _, _, _, _, _, _,
+]) GetSlot() (math.Slot, error) {
+	s.cache.mu.Lock()
+	defer s.cache.mu.Unlock()
+	return s.cache.slot.get(s.KVStore.GetSlot)
+}
+
+// SetSlot sets the current slot.
+func (s *StateDB[
+	_, _, _, _, _, _, _, _, _, _,
+]) SetSlot(slot math.Slot) error {
+	s.cache.mu.Lock()
+	defer s.cache.mu.Unlock()
+	s.cache.slot = cached[math.Slot]{}
+	return s.KVStore.SetSlot(slot)
+}
+
+// GetFork retrieves the fork.
+func (s *StateDB[
+	_, _, _, _, ForkT, _, _, _, _, _,
+]) GetFork() (ForkT, error) {
+	s.cache.mu.Lock()
+	defer s.cache.mu.Unlock()
+	return s.cache.fork.get(s.KVStore.GetFork)
+}
+
+// SetFork sets the fork.
+func (s *StateDB[
+	_, _, _, _, ForkT, _, _, _, _, _,
+]) SetFork(fork ForkT) error {
+	s.cache.mu.Lock()
+	defer s.cache.mu.Unlock()
+	s.cache.fork = cached[ForkT]{}
+	return s.KVStore.SetFork(fork)
+}
+
+// GetGenesisValidatorsRoot retrieves the genesis validators root.
+func (s *StateDB[
+	_, _, _, _, _, _, _, _, _, _,
+]) GetGenesisValidatorsRoot() (common.Root, error) {
+	s.cache.mu.Lock()
+	defer s.cache.mu.Unlock()
+	return s.cache.genesisValidatorsRoot.get(
+		s.KVStore.GetGenesisValidatorsRoot,
+	)
+}
+
+// SetGenesisValidatorsRoot sets the genesis validators root.
+func (s *StateDB[
+	_, _, _, _, _, _, _, _, _, _,
+]) SetGenesisValidatorsRoot(root common.Root) error {
+	s.cache.mu.Lock()
+	defer s.cache.mu.Unlock()
+	s.cache.genesisValidatorsRoot = cached[common.Root]{}
+	return s.KVStore.SetGenesisValidatorsRoot(root)
+}
+
+// GetLatestBlockHeader retrieves the latest block header.
+func (s *StateDB[
+	BeaconBlockHeaderT, _, _, _, _, _, _, _, _, _,
+]) GetLatestBlockHeader() (BeaconBlockHeaderT, error) {
+	s.cache.mu.Lock()
+	defer s.cache.mu.Unlock()
+	header, err := s.cache.latestBlockHeader.get(
+		s.KVStore.GetLatestBlockHeader,
+	)
+	if err != nil {
+		return header, err
+	}
+	return header.Copy(), nil
+}
+
+// SetLatestBlockHeader sets the latest block header.
+func (s *StateDB[
+	BeaconBlockHeaderT, _, _, _, _, _, _, _, _, _,
+]) SetLatestBlockHeader(header BeaconBlockHeaderT) error {
+	s.cache.mu.Lock()
+	defer s.cache.mu.Unlock()
+	s.cache.latestBlockHeader = cached[BeaconBlockHeaderT]{}
+	return s.KVStore.SetLatestBlockHeader(header)
+}
+
+// GetTotalValidators retrieves the total number of validators.
+func (s *StateDB[
+	_, _, _, _, _, _, _, _, _, _,
+]) GetTotalValidators() (uint64, error) {
+	s.cache.mu.Lock()
+	defer s.cache.mu.Unlock()
+	return s.cache.totalValidators.get(s.KVStore.GetTotalValidators)
+}
+
+// ValidatorByIndex retrieves the validator at the given index.
+func (s *StateDB[
+	_, _, _, _, _, _, ValidatorT, _, _, _,
+]) ValidatorByIndex(index math.ValidatorIndex) (ValidatorT, error) {
+	s.cache.mu.Lock()
+	defer s.cache.mu.Unlock()
+	if val, ok := s.cache.validators[index]; ok {
+		return val.Copy(), nil
+	}
+	val, err := s.KVStore.ValidatorByIndex(index)
+	if err != nil {
+		return val, err
+	}
+	s.cache.validators[index] = val
+	return val.Copy(), nil
+}
+
+// UpdateValidatorAtIndex updates the validator at the given index.
+func (s *StateDB[
+	_, _, _, _, _, _, ValidatorT, _, _, _,
+]) UpdateValidatorAtIndex(
+	index math.ValidatorIndex,
+	val ValidatorT,
+) error {
+	s.cache.mu.Lock()
+	defer s.cache.mu.Unlock()
+	delete(s.cache.validators, index)
+	return s.KVStore.UpdateValidatorAtIndex(index, val)
+}
+
+// AddValidator adds a validator.
+func (s *StateDB[
+	_, _, _, _, _, _, ValidatorT, _, _, _,
+]) AddValidator(val ValidatorT) error {
+	s.cache.mu.Lock()
+	defer s.cache.mu.Unlock()
+	s.cache.totalValidators = cached[uint64]{}
+	return s.KVStore.AddValidator(val)
+}
+
+// AddValidatorBartio adds a validator to the Bartio chain.
+func (s *StateDB[
+	_, _, _, _, _, _, ValidatorT, _, _, _,
+]) AddValidatorBartio(val ValidatorT) error {
+	s.cache.mu.Lock()
+	defer s.cache.mu.Unlock()
+	s.cache.totalValidators = cached[uint64]{}
+	return s.KVStore.AddValidatorBartio(val)
+}
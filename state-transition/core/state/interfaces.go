@@ -59,6 +59,12 @@ type KVStore[
 	SetEth1DepositIndex(
 		index uint64,
 	) error
+	// GetDepositBranchAtIndex retrieves the node of the deposit tree branch
+	// at the given height.
+	GetDepositBranchAtIndex(index uint64) (common.Root, error)
+	// UpdateDepositBranchAtIndex sets the node of the deposit tree branch at
+	// the given height.
+	UpdateDepositBranchAtIndex(index uint64, node common.Root) error
 	// GetBalance retrieves the balance of a validator.
 	GetBalance(idx math.ValidatorIndex) (math.Gwei, error)
 	// SetBalance sets the balance of a validator.
@@ -140,6 +146,11 @@ type KVStore[
 	ValidatorIndexByCometBFTAddress(
 		cometBFTAddress []byte,
 	) (math.ValidatorIndex, error)
+	// ValidatorIndicesByWithdrawalAddress retrieves the indices of the
+	// validators whose withdrawals are paid to the given execution address.
+	ValidatorIndicesByWithdrawalAddress(
+		address common.ExecutionAddress,
+	) ([]math.ValidatorIndex, error)
 	// GetValidatorsByEffectiveBalance retrieves validators by effective
 	// balance.
 	GetValidatorsByEffectiveBalance() ([]ValidatorT, error)
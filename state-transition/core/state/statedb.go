@@ -25,13 +25,14 @@ import (
 	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/merkle"
 )
 
 // StateDB is the underlying struct behind the BeaconState interface.
 //
 //nolint:revive // todo fix somehow
 type StateDB[
-	BeaconBlockHeaderT any,
+	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
 	BeaconStateMarshallableT BeaconStateMarshallable[
 		BeaconStateMarshallableT,
 		BeaconBlockHeaderT,
@@ -52,7 +53,7 @@ type StateDB[
 		ValidatorT,
 		ValidatorsT,
 	],
-	ValidatorT Validator[WithdrawalCredentialsT],
+	ValidatorT Validator[ValidatorT, WithdrawalCredentialsT],
 	ValidatorsT ~[]ValidatorT,
 	WithdrawalT Withdrawal[WithdrawalT],
 	WithdrawalCredentialsT WithdrawalCredentials,
@@ -66,7 +67,8 @@ type StateDB[
 		ValidatorT,
 		ValidatorsT,
 	]
-	cs common.ChainSpec
+	cs    common.ChainSpec
+	cache *readCache[BeaconBlockHeaderT, ForkT, ValidatorT]
 }
 
 // NewBeaconStateFromDB creates a new beacon state from an underlying state db.
@@ -103,6 +105,7 @@ func (s *StateDB[
 	]{
 		KVStore: bdb,
 		cs:      cs,
+		cache:   newReadCache[BeaconBlockHeaderT, ForkT, ValidatorT](),
 	}
 }
 
@@ -233,7 +236,7 @@ func (s *StateDB[
 		withdrawals = append(withdrawals, s.EVMInflationWithdrawal())
 	}
 
-	epoch := math.Epoch(slot.Unwrap() / s.cs.SlotsPerEpoch())
+	epoch := slot.Epoch(s.cs.SlotsPerEpoch())
 
 	withdrawalIndex, err := s.GetNextWithdrawalIndex()
 	if err != nil {
@@ -472,3 +475,17 @@ func (s *StateDB[
 	}
 	return st.HashTreeRoot()
 }
+
+// ParallelHashTreeRoot computes the hash tree root of the beacon state,
+// merkleizing the validator registry across the workers of the given hasher.
+func (s *StateDB[
+	_, _, _, _, _, _, _, _, _, _,
+]) ParallelHashTreeRoot(
+	hasher *merkle.ParallelHasher,
+) (common.Root, error) {
+	st, err := s.GetMarshallable()
+	if err != nil {
+		return common.Root{}, err
+	}
+	return st.ParallelHashTreeRoot(hasher)
+}
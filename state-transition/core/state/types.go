@@ -24,6 +24,7 @@ import (
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/constraints"
 	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/merkle"
 )
 
 // BeaconStateMarshallable represents an interface for a beacon state
@@ -56,12 +57,26 @@ type BeaconStateMarshallable[
 		nextWithdrawalValidatorIndex math.U64,
 		slashings []math.U64, totalSlashing math.U64,
 	) (T, error)
+	// ParallelHashTreeRoot computes the hash tree root, merkleizing the
+	// validator registry across the workers of the given hasher.
+	ParallelHashTreeRoot(hasher *merkle.ParallelHasher) (common.Root, error)
+}
+
+// BeaconBlockHeader represents an interface for a beacon block header.
+type BeaconBlockHeader[BeaconBlockHeaderT any] interface {
+	// Copy returns a copy of the header.
+	Copy() BeaconBlockHeaderT
 }
 
 // Validator represents an interface for a validator with generic withdrawal
 // credentials. WithdrawalCredentialsT is a type parameter that must implement
 // the WithdrawalCredentials interface.
-type Validator[WithdrawalCredentialsT WithdrawalCredentials] interface {
+type Validator[
+	ValidatorT any,
+	WithdrawalCredentialsT WithdrawalCredentials,
+] interface {
+	// Copy returns a copy of the validator.
+	Copy() ValidatorT
 	// GetWithdrawalCredentials returns the withdrawal credentials of the
 	// validator.
 	GetWithdrawalCredentials() WithdrawalCredentialsT
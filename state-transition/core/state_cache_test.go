@@ -0,0 +1,167 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/berachain/beacon-kit/chain-spec/chain"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/node-core/components"
+	"github.com/berachain/beacon-kit/primitives/bytes"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+	"github.com/stretchr/testify/require"
+)
+
+// countingKVStore counts the reads that reach the store.
+type countingKVStore struct {
+	*TestKVStoreT
+	slotReads      *int
+	validatorReads *int
+}
+
+func (kv *countingKVStore) GetSlot() (math.Slot, error) {
+	*kv.slotReads++
+	return kv.TestKVStoreT.GetSlot()
+}
+
+func (kv *countingKVStore) ValidatorByIndex(
+	index math.ValidatorIndex,
+) (*types.Validator, error) {
+	*kv.validatorReads++
+	return kv.TestKVStoreT.ValidatorByIndex(index)
+}
+
+func (kv *countingKVStore) Copy() *countingKVStore {
+	return &countingKVStore{
+		TestKVStoreT:   kv.TestKVStoreT.Copy(),
+		slotReads:      kv.slotReads,
+		validatorReads: kv.validatorReads,
+	}
+}
+
+func (kv *countingKVStore) WithContext(ctx context.Context) *countingKVStore {
+	return &countingKVStore{
+		TestKVStoreT:   kv.TestKVStoreT.WithContext(ctx),
+		slotReads:      kv.slotReads,
+		validatorReads: kv.validatorReads,
+	}
+}
+
+type countingBeaconStateT = statedb.StateDB[
+	*types.BeaconBlockHeader,
+	*TestBeaconStateMarshallableT,
+	*types.Eth1Data,
+	*types.ExecutionPayloadHeader,
+	*types.Fork,
+	*countingKVStore,
+	*types.Validator,
+	types.Validators,
+	*engineprimitives.Withdrawal,
+	types.WithdrawalCredentials,
+]
+
+func TestStateReadCache(t *testing.T) {
+	cs := setupChain(t, components.BetnetChainSpecType)
+	kvStore, _, err := initTestStores()
+	require.NoError(t, err)
+	var slotReads, validatorReads int
+	st := new(countingBeaconStateT).NewFromDB(&countingKVStore{
+		TestKVStoreT:   kvStore,
+		slotReads:      &slotReads,
+		validatorReads: &validatorReads,
+	}, cs)
+
+	// Repeated reads hit the store once.
+	require.NoError(t, st.SetSlot(5))
+	for range 3 {
+		slot, sErr := st.GetSlot()
+		require.NoError(t, sErr)
+		require.Equal(t, math.Slot(5), slot)
+	}
+	require.Equal(t, 1, slotReads)
+
+	// A write invalidates the cached read.
+	require.NoError(t, st.SetSlot(6))
+	slot, err := st.GetSlot()
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(6), slot)
+	require.Equal(t, 2, slotReads)
+
+	val := newTestValidator(cs, 1)
+	require.NoError(t, st.AddValidator(val))
+	total, err := st.GetTotalValidators()
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), total)
+
+	for range 3 {
+		_, err = st.ValidatorByIndex(0)
+		require.NoError(t, err)
+	}
+	require.Equal(t, 1, validatorReads)
+
+	// Updates are visible to later reads.
+	updated := newTestValidator(cs, 1)
+	updated.SetWithdrawableEpoch(7)
+	require.NoError(t, st.UpdateValidatorAtIndex(0, updated))
+	got, err := st.ValidatorByIndex(0)
+	require.NoError(t, err)
+	require.Equal(t, math.Epoch(7), got.GetWithdrawableEpoch())
+	require.Equal(t, 2, validatorReads)
+
+	// Adding a validator invalidates the validator count.
+	require.NoError(t, st.AddValidator(newTestValidator(cs, 2)))
+	total, err = st.GetTotalValidators()
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), total)
+
+	// Changes not written back do not reach the cached reads.
+	got.SetWithdrawableEpoch(8)
+	got, err = st.ValidatorByIndex(0)
+	require.NoError(t, err)
+	require.Equal(t, math.Epoch(7), got.GetWithdrawableEpoch())
+
+	require.NoError(t, st.SetLatestBlockHeader(&types.BeaconBlockHeader{}))
+	header, err := st.GetLatestBlockHeader()
+	require.NoError(t, err)
+	header.SetStateRoot(common.Root{1})
+	header, err = st.GetLatestBlockHeader()
+	require.NoError(t, err)
+	require.Equal(t, common.Root{}, header.GetStateRoot())
+}
+
+func newTestValidator(
+	cs chain.Spec[bytes.B4, math.U64, common.ExecutionAddress, math.U64, any],
+	key byte,
+) *types.Validator {
+	return types.NewValidatorFromDeposit(
+		crypto.BLSPubkey{key},
+		types.NewCredentialsFromExecutionAddress(common.ExecutionAddress{}),
+		math.Gwei(cs.MaxEffectiveBalance()),
+		math.Gwei(cs.EffectiveBalanceIncrement()),
+		math.Gwei(cs.MaxEffectiveBalance()),
+	)
+}
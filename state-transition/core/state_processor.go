@@ -24,63 +24,27 @@ import (
 	"bytes"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/berachain/beacon-kit/config/spec"
 	"github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
 	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/constants"
 	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/merkle"
 	"github.com/berachain/beacon-kit/primitives/transition"
 )
 
 // StateProcessor is a basic Processor, which takes care of the
 // main state transition for the beacon chain.
 type StateProcessor[
-	BeaconBlockT BeaconBlock[
-		DepositT, BeaconBlockBodyT,
-		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
-	],
-	BeaconBlockBodyT BeaconBlockBody[
-		BeaconBlockBodyT, DepositT,
-		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
-	],
-	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
-	BeaconStateT BeaconState[
-		BeaconStateT,
-		BeaconBlockHeaderT, Eth1DataT,
-		ExecutionPayloadHeaderT, ForkT, KVStoreT,
-		ValidatorT, ValidatorsT, WithdrawalT,
-	],
+	BeaconStateT BeaconState[BeaconStateT, KVStoreT],
 	ContextT Context,
-	DepositT Deposit[DepositT, ForkDataT, WithdrawalCredentialsT],
-	Eth1DataT interface {
-		New(common.Root, math.U64, common.ExecutionHash) Eth1DataT
-		GetDepositCount() math.U64
-	},
-	ExecutionPayloadT ExecutionPayload[
-		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
-	],
-	ExecutionPayloadHeaderT ExecutionPayloadHeader,
-	ForkT interface {
-		New(common.Version, common.Version, math.Epoch) ForkT
-	},
-	ForkDataT ForkData[ForkDataT],
 	KVStoreT any,
-	ValidatorT Validator[ValidatorT, WithdrawalCredentialsT],
-	ValidatorsT interface {
-		~[]ValidatorT
-		HashTreeRoot() common.Root
-	},
-	WithdrawalT Withdrawal[WithdrawalT],
-	WithdrawalsT interface {
-		~[]WithdrawalT
-		Len() int
-		EncodeIndex(int, *bytes.Buffer)
-	},
-	WithdrawalCredentialsT ~[32]byte,
 ] struct {
 	// logger is used for logging information and errors.
 	logger log.Logger
@@ -94,10 +58,17 @@ type StateProcessor[
 	fGetAddressFromPubKey func(crypto.BLSPubkey) ([]byte, error)
 	// executionEngine is the engine responsible for executing transactions.
 	executionEngine ExecutionEngine[
-		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
+		*types.ExecutionPayload, *types.ExecutionPayloadHeader,
+		engineprimitives.Withdrawals,
 	]
 	// ds allows checking payload deposits against the deposit contract
-	ds DepositStore[DepositT]
+	ds DepositStore[*types.Deposit]
+	// depositSignatures verifies the signatures of the deposits creating
+	// validators, skipping the pre-verified ones.
+	depositSignatures *DepositSignatures
+	// stateHasher merkleizes the validator registry of the state across its
+	// workers.
+	stateHasher *merkle.ParallelHasher
 	// metrics is the metrics for the service.
 	metrics *stateProcessorMetrics
 
@@ -112,99 +83,98 @@ type StateProcessor[
 	// as a block is finalized eventually, and its changes will be the last
 	// ones.
 	// We prune the map to preserve only current and previous epoch
-	valSetByEpoch map[math.Epoch][]ValidatorT
+	valSetByEpoch map[math.Epoch][]*types.Validator
+
+	// proposerAddrsByEpoch maps, for the same epochs as valSetByEpoch, the
+	// index of each active validator to its consensus address, so that
+	// block headers can be verified without converting the proposer pubkey.
+	proposerAddrsByEpoch map[math.Epoch]map[math.ValidatorIndex][]byte
+
+	// statusChangesByEpoch maps the latest epochs processed to the validator
+	// status changes their processing produced. Like valSetByEpoch, it may
+	// hold changes of blocks not finalized until the block turning the epoch
+	// is finalized.
+	statusChangesByEpoch map[math.Epoch][]transition.ValidatorStatusChange
+
+	// operations maps the IDs of the application-defined operation types
+	// to their handlers.
+	operations map[transition.OperationTypeID]operationHandler
+
+	// checkInvariants is true if the conserved quantities of the state are
+	// checked after each transition.
+	checkInvariants bool
+
+	// precomputedMu protects precomputed for concurrent accesses.
+	precomputedMu sync.RWMutex
+	// precomputed is the state root computed ahead of the processing of
+	// the next slot, if any.
+	precomputed *precomputedSlot
 }
 
-// NewStateProcessor creates a new state processor.
+// NewStateProcessor creates a new state processor from the given options,
+// returning an error if a required dependency is missing.
 func NewStateProcessor[
-	BeaconBlockT BeaconBlock[
-		DepositT, BeaconBlockBodyT,
-		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
-	],
-	BeaconBlockBodyT BeaconBlockBody[
-		BeaconBlockBodyT,
-		DepositT, ExecutionPayloadT,
-		ExecutionPayloadHeaderT,
-		WithdrawalsT,
-	],
-	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
-	BeaconStateT BeaconState[
-		BeaconStateT, BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT, ForkT,
-		KVStoreT, ValidatorT, ValidatorsT, WithdrawalT,
-	],
+	BeaconStateT BeaconState[BeaconStateT, KVStoreT],
 	ContextT Context,
-	DepositT Deposit[DepositT, ForkDataT, WithdrawalCredentialsT],
-	Eth1DataT interface {
-		New(common.Root, math.U64, common.ExecutionHash) Eth1DataT
-		GetDepositCount() math.U64
-	},
-	ExecutionPayloadT ExecutionPayload[
-		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
-	],
-	ExecutionPayloadHeaderT ExecutionPayloadHeader,
-	ForkT interface {
-		New(common.Version, common.Version, math.Epoch) ForkT
-	},
-	ForkDataT ForkData[ForkDataT],
 	KVStoreT any,
-	ValidatorT Validator[ValidatorT, WithdrawalCredentialsT],
-	ValidatorsT interface {
-		~[]ValidatorT
-		HashTreeRoot() common.Root
-	},
-	WithdrawalT Withdrawal[WithdrawalT],
-	WithdrawalsT interface {
-		~[]WithdrawalT
-		Len() int
-		EncodeIndex(int, *bytes.Buffer)
-	},
-	WithdrawalCredentialsT ~[32]byte,
 ](
-	logger log.Logger,
-	cs common.ChainSpec,
-	executionEngine ExecutionEngine[
-		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
-	],
-	ds DepositStore[DepositT],
-	signer crypto.BLSSigner,
-	fGetAddressFromPubKey func(crypto.BLSPubkey) ([]byte, error),
-	telemetrySink TelemetrySink,
-) *StateProcessor[
-	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
-	BeaconStateT, ContextT, DepositT, Eth1DataT, ExecutionPayloadT,
-	ExecutionPayloadHeaderT, ForkT, ForkDataT, KVStoreT, ValidatorT,
-	ValidatorsT, WithdrawalT, WithdrawalsT, WithdrawalCredentialsT,
-] {
-	return &StateProcessor[
-		BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
-		BeaconStateT, ContextT, DepositT, Eth1DataT, ExecutionPayloadT,
-		ExecutionPayloadHeaderT, ForkT, ForkDataT, KVStoreT, ValidatorT,
-		ValidatorsT, WithdrawalT, WithdrawalsT, WithdrawalCredentialsT,
-	]{
-		logger:                logger,
-		cs:                    cs,
-		executionEngine:       executionEngine,
-		signer:                signer,
-		fGetAddressFromPubKey: fGetAddressFromPubKey,
-		ds:                    ds,
-		metrics:               newStateProcessorMetrics(telemetrySink),
-		valSetByEpoch:         make(map[math.Epoch][]ValidatorT, 0),
+	opts ...Option,
+) (*StateProcessor[BeaconStateT, ContextT, KVStoreT], error) {
+	cfg := defaultConfig()
+	for _, opt := range opts {
+		if err := opt(cfg); err != nil {
+			return nil, errors.Wrap(err, "failed to apply option")
+		}
+	}
+	if err := cfg.validate(); err != nil {
+		return nil, err
 	}
+	if cfg.depositSignatures == nil {
+		cfg.depositSignatures = NewDepositSignatures(cfg.cs, cfg.signer)
+	}
+
+	return &StateProcessor[BeaconStateT, ContextT, KVStoreT]{
+		logger:                cfg.logger,
+		cs:                    cfg.cs,
+		executionEngine:       cfg.executionEngine,
+		signer:                cfg.signer,
+		fGetAddressFromPubKey: cfg.fGetAddressFromPubKey,
+		ds:                    cfg.ds,
+		depositSignatures:     cfg.depositSignatures,
+		stateHasher:           cfg.stateHasher,
+		metrics:               newStateProcessorMetrics(cfg.telemetrySink),
+		valSetByEpoch:         make(map[math.Epoch][]*types.Validator, 0),
+		proposerAddrsByEpoch: make(
+			map[math.Epoch]map[math.ValidatorIndex][]byte,
+		),
+		statusChangesByEpoch: make(
+			map[math.Epoch][]transition.ValidatorStatusChange,
+		),
+		operations:      cfg.operations,
+		checkInvariants: cfg.checkInvariants,
+	}, nil
 }
 
 // Transition is the main function for processing a state transition.
-func (sp *StateProcessor[
-	BeaconBlockT, _, _, BeaconStateT, ContextT,
-	_, _, _, _, _, _, _, _, _, _, _, _,
-]) Transition(
+func (sp *StateProcessor[BeaconStateT, ContextT, _]) Transition(
 	ctx ContextT,
 	st BeaconStateT,
-	blk BeaconBlockT,
+	blk *types.BeaconBlock,
 ) (transition.ValidatorUpdates, error) {
 	if blk.IsNil() {
 		return nil, nil
 	}
 
+	var (
+		pre *invariantSnapshot
+		err error
+	)
+	if sp.checkInvariants {
+		if pre, err = sp.snapshotInvariants(st); err != nil {
+			return nil, err
+		}
+	}
+
 	// Process the slots.
 	validatorUpdates, err := sp.ProcessSlots(st, blk.GetSlot())
 	if err != nil {
@@ -216,12 +186,16 @@ func (sp *StateProcessor[
 		return nil, err
 	}
 
+	if pre != nil {
+		if err = sp.verifyInvariants(pre, st, blk); err != nil {
+			return nil, err
+		}
+	}
+
 	return validatorUpdates, nil
 }
 
-func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
-]) ProcessSlots(
+func (sp *StateProcessor[BeaconStateT, _, _]) ProcessSlots(
 	st BeaconStateT, slot math.Slot,
 ) (transition.ValidatorUpdates, error) {
 	var res transition.ValidatorUpdates
@@ -231,6 +205,13 @@ func (sp *StateProcessor[
 		return nil, err
 	}
 
+	// settled is set once an epoch boundary has been processed. Since no
+	// block is processed in between, the following boundaries crossed while
+	// catching up can skip the balance-driven epoch work, as long as they
+	// enforce ejections as the settled one did: settledEjections records it,
+	// since enforcement starts at a fork crossed while catching up.
+	var settled, settledEjections bool
+
 	// Iterate until we are "caught up".
 	for ; stateSlot < slot; stateSlot++ {
 		if err = sp.processSlot(st); err != nil {
@@ -260,13 +241,23 @@ func (sp *StateProcessor[
 		}
 
 		// Process the Epoch Boundary.
-		boundary := (stateSlot.Unwrap()+1)%sp.cs.SlotsPerEpoch() == 0
-		if boundary {
+		if stateSlot.IsEpochBoundary(sp.cs.SlotsPerEpoch()) {
+			ejections := sp.enforcesEjection(stateSlot)
 			var epochUpdates transition.ValidatorUpdates
-			if epochUpdates, err = sp.processEpoch(st); err != nil {
+			if epochUpdates, err = sp.processEpoch(
+				st, settled && settledEjections == ejections,
+			); err != nil {
 				return nil, err
 			}
 			res = append(res, epochUpdates...)
+			settled, settledEjections = true, ejections
+		}
+
+		// Fill the deposit tree in when entering the Eth1Data fork epoch.
+		if sp.isEth1DataForkBoundary(stateSlot) {
+			if err = sp.upgradeEth1Data(st); err != nil {
+				return nil, err
+			}
 		}
 
 		// We update on the state because we need to
@@ -279,10 +270,23 @@ func (sp *StateProcessor[
 	return res, nil
 }
 
+// ReadOnlySnapshot returns a read-only view of st with the state and block
+// roots of its current slot cached, as processing the next slot would. The
+// roots are written to a copy of st, leaving st untouched, and no epoch
+// processing runs: readers such as the node API never mutate the processor
+// caches nor contend for their locks with the state transition.
+func (sp *StateProcessor[BeaconStateT, _, _]) ReadOnlySnapshot(
+	st BeaconStateT,
+) (ReadOnlyState, error) {
+	snapshot := st.Copy()
+	if err := sp.processSlot(snapshot); err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
 // processSlot is run when a slot is missed.
-func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
-]) processSlot(
+func (sp *StateProcessor[BeaconStateT, _, _]) processSlot(
 	st BeaconStateT,
 ) error {
 	stateSlot, err := st.GetSlot()
@@ -290,14 +294,6 @@ func (sp *StateProcessor[
 		return err
 	}
 
-	// Before we make any changes, we calculate the previous state root.
-	prevStateRoot := st.HashTreeRoot()
-	if err = st.UpdateStateRootAtIndex(
-		stateSlot.Unwrap()%sp.cs.SlotsPerHistoricalRoot(), prevStateRoot,
-	); err != nil {
-		return err
-	}
-
 	// We get the latest block header, this will not have
 	// a state root on it.
 	latestHeader, err := st.GetLatestBlockHeader()
@@ -305,6 +301,24 @@ func (sp *StateProcessor[
 		return err
 	}
 
+	// Before we make any changes, we calculate the previous state root,
+	// unless it was precomputed.
+	prevStateRoot, ok := sp.precomputedStateRoot(
+		stateSlot, latestHeader.HashTreeRoot(),
+	)
+	if !ok {
+		if prevStateRoot, err = st.ParallelHashTreeRoot(
+			sp.stateHasher,
+		); err != nil {
+			return err
+		}
+	}
+	if err = st.UpdateStateRootAtIndex(
+		stateSlot.Unwrap()%sp.cs.SlotsPerHistoricalRoot(), prevStateRoot,
+	); err != nil {
+		return err
+	}
+
 	// We set the "rawHeader" in the StateProcessor, but cannot fill in
 	// the StateRoot until the following block.
 	if (latestHeader.GetStateRoot() == common.Root{}) {
@@ -323,30 +337,50 @@ func (sp *StateProcessor[
 
 // ProcessBlock processes the block, it optionally verifies the
 // state root.
-func (sp *StateProcessor[
-	BeaconBlockT, _, _, BeaconStateT, ContextT, _, _, _, _, _, _, _, _, _, _, _, _,
-]) ProcessBlock(
+func (sp *StateProcessor[BeaconStateT, ContextT, _]) ProcessBlock(
 	ctx ContextT,
 	st BeaconStateT,
-	blk BeaconBlockT,
+	blk *types.BeaconBlock,
 ) error {
-	if err := sp.processBlockHeader(ctx, st, blk); err != nil {
+	start := time.Now()
+	err := sp.processBlockHeader(ctx, st, blk)
+	sp.metrics.measureBlockPhase(phaseBlockHeader, start, err)
+	if err != nil {
+		return err
+	}
+
+	start = time.Now()
+	err = sp.processExecutionPayload(ctx, st, blk)
+	sp.metrics.measureBlockPhase(phaseExecutionPayload, start, err)
+	if err != nil {
 		return err
 	}
 
-	if err := sp.processExecutionPayload(ctx, st, blk); err != nil {
+	start = time.Now()
+	err = sp.processWithdrawals(st, blk)
+	sp.metrics.measureBlockPhase(phaseWithdrawals, start, err)
+	if err != nil {
 		return err
 	}
 
-	if err := sp.processWithdrawals(st, blk); err != nil {
+	start = time.Now()
+	err = sp.processRandaoReveal(ctx, st, blk)
+	sp.metrics.measureBlockPhase(phaseRandaoReveal, start, err)
+	if err != nil {
 		return err
 	}
 
-	if err := sp.processRandaoReveal(ctx, st, blk); err != nil {
+	start = time.Now()
+	err = sp.processEth1Data(st, blk)
+	sp.metrics.measureBlockPhase(phaseEth1Data, start, err)
+	if err != nil {
 		return err
 	}
 
-	if err := sp.processOperations(st, blk); err != nil {
+	start = time.Now()
+	err = sp.processOperations(st, blk)
+	sp.metrics.measureBlockPhase(phaseOperations, start, err)
+	if err != nil {
 		return err
 	}
 
@@ -358,22 +392,26 @@ func (sp *StateProcessor[
 
 	// Ensure the calculated state root matches the state root on
 	// the block.
-	stateRoot := st.HashTreeRoot()
-	if blk.GetStateRoot() != stateRoot {
-		return errors.Wrapf(
+	start = time.Now()
+	stateRoot, err := st.ParallelHashTreeRoot(sp.stateHasher)
+	if err == nil && blk.GetStateRoot() != stateRoot {
+		err = errors.Wrapf(
 			ErrStateRootMismatch, "expected %s, got %s",
 			stateRoot, blk.GetStateRoot(),
 		)
 	}
-
-	return nil
+	sp.metrics.measureBlockPhase(phaseStateRoot, start, err)
+	return err
 }
 
 // processEpoch processes the epoch and ensures it matches the local state.
-func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
-]) processEpoch(
+// If settled is true, an epoch boundary enforcing ejections alike has already
+// been processed since the latest block, so balances are unchanged and the
+// effective balance updates and ejections are skipped, since they would be
+// no-ops.
+func (sp *StateProcessor[BeaconStateT, _, _]) processEpoch(
 	st BeaconStateT,
+	settled bool,
 ) (transition.ValidatorUpdates, error) {
 	slot, err := st.GetSlot()
 	if err != nil {
@@ -381,44 +419,89 @@ func (sp *StateProcessor[
 	}
 
 	switch {
-	case sp.cs.DepositEth1ChainID() == spec.BartioChainID:
-		if err = sp.hollowProcessRewardsAndPenalties(st); err != nil {
-			return nil, err
-		}
-	case sp.cs.DepositEth1ChainID() == spec.BoonetEth1ChainID &&
-		slot < math.U64(spec.BoonetFork3Height):
+	case sp.isLegacyEpochProcessing(slot):
 		// We cannot simply drop hollowProcessRewardsAndPenalties because
 		// appHash accounts for the list of operations carried out
 		// over the state even if the operations does not affect the state
-		// (rewards and penalties are always zero at this stage of beaconKit)
-		if err = sp.hollowProcessRewardsAndPenalties(st); err != nil {
+		// (rewards and penalties are always zero at this stage of beaconKit).
+		// For the same reason, settled epochs are processed in full.
+		start := time.Now()
+		err = sp.hollowProcessRewardsAndPenalties(st)
+		sp.metrics.measureEpochPhase(phaseRewardsAndPenalties, start, err)
+		if err != nil {
 			return nil, err
 		}
+		if err = sp.processBalanceDrivenUpdates(st); err != nil {
+			return nil, err
+		}
+	case settled:
+		sp.metrics.countSettledEpoch()
 	default:
-		// no real need to perform hollowProcessRewardsAndPenalties
+		if err = sp.processBalanceDrivenUpdates(st); err != nil {
+			return nil, err
+		}
 	}
 
-	if err = sp.processEffectiveBalanceUpdates(st); err != nil {
+	start := time.Now()
+	err = sp.processSlashingsReset(st)
+	sp.metrics.measureEpochPhase(phaseSlashingsReset, start, err)
+	if err != nil {
 		return nil, err
 	}
-	if err = sp.processSlashingsReset(st); err != nil {
+
+	start = time.Now()
+	err = sp.processRandaoMixesReset(st)
+	sp.metrics.measureEpochPhase(phaseRandaoMixesReset, start, err)
+	if err != nil {
 		return nil, err
 	}
-	if err = sp.processRandaoMixesReset(st); err != nil {
-		return nil, err
+
+	start = time.Now()
+	updates, err := sp.processValidatorsSetUpdates(st)
+	sp.metrics.measureEpochPhase(phaseValidatorsSetUpdates, start, err)
+	return updates, err
+}
+
+// processBalanceDrivenUpdates runs the epoch work depending on validators
+// balances only, which is idempotent as long as no block is processed.
+func (sp *StateProcessor[BeaconStateT, _, _]) processBalanceDrivenUpdates(
+	st BeaconStateT,
+) error {
+	start := time.Now()
+	err := sp.processEffectiveBalanceUpdates(st)
+	sp.metrics.measureEpochPhase(phaseEffectiveBalanceUpdates, start, err)
+	if err != nil {
+		return err
+	}
+
+	start = time.Now()
+	err = sp.processEjections(st)
+	sp.metrics.measureEpochPhase(phaseEjections, start, err)
+	return err
+}
+
+// isLegacyEpochProcessing returns true if the network still runs the hollow
+// rewards and penalties processing at the given slot.
+func (sp *StateProcessor[_, _, _]) isLegacyEpochProcessing(
+	slot math.Slot,
+) bool {
+	switch {
+	case sp.cs.DepositEth1ChainID() == spec.BartioChainID:
+		return true
+	case sp.cs.DepositEth1ChainID() == spec.BoonetEth1ChainID &&
+		slot < math.U64(spec.BoonetFork3Height):
+		return true
+	default:
+		return false
 	}
-	return sp.processValidatorsSetUpdates(st)
 }
 
 // processBlockHeader processes the header and ensures it matches the local
 // state.
-func (sp *StateProcessor[
-	BeaconBlockT, _, BeaconBlockHeaderT, BeaconStateT,
-	ContextT, _, _, _, _, _, _, _, ValidatorT, _, _, _, _,
-]) processBlockHeader(
+func (sp *StateProcessor[BeaconStateT, ContextT, _]) processBlockHeader(
 	ctx ContextT,
 	st BeaconStateT,
-	blk BeaconBlockT,
+	blk *types.BeaconBlock,
 ) error {
 	// Ensure the block slot matches the state slot.
 	slot, err := st.GetSlot()
@@ -449,7 +532,11 @@ func (sp *StateProcessor[
 	if err != nil {
 		return err
 	}
-	stateProposerAddress, err := sp.fGetAddressFromPubKey(proposer.GetPubkey())
+	stateProposerAddress, err := sp.proposerAddress(
+		slot.Epoch(sp.cs.SlotsPerEpoch()),
+		blk.GetProposerIndex(),
+		proposer.GetPubkey(),
+	)
 	if err != nil {
 		return err
 	}
@@ -479,8 +566,7 @@ func (sp *StateProcessor[
 
 	// Cache current block as the new latest block
 	bodyRoot := blk.GetBody().HashTreeRoot()
-	var lbh BeaconBlockHeaderT
-	lbh = lbh.New(
+	lbh := types.NewBeaconBlockHeader(
 		blk.GetSlot(),
 		blk.GetProposerIndex(),
 		blk.GetParentBlockRoot(),
@@ -492,9 +578,9 @@ func (sp *StateProcessor[
 	return st.SetLatestBlockHeader(lbh)
 }
 
-func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
-]) hollowProcessRewardsAndPenalties(st BeaconStateT) error {
+func (sp *StateProcessor[BeaconStateT, _, _]) hollowProcessRewardsAndPenalties(
+	st BeaconStateT,
+) error {
 	slot, err := st.GetSlot()
 	if err != nil {
 		return err
@@ -530,16 +616,27 @@ func (sp *StateProcessor[
 // https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#effective-balances-updates
 //
 //nolint:lll
-func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
-]) processEffectiveBalanceUpdates(
+func (sp *StateProcessor[BeaconStateT, _, _]) processEffectiveBalanceUpdates(
 	st BeaconStateT,
 ) error {
-	// Update effective balances with hysteresis
+	// Update effective balances with hysteresis. Validators and balances are
+	// both index-addressed, so walk them side by side rather than resolving
+	// each validator's index and balance individually.
 	validators, err := st.GetValidators()
 	if err != nil {
 		return err
 	}
+	balances, err := st.GetBalances()
+	if err != nil {
+		return err
+	}
+	if len(validators) != len(balances) {
+		return errors.Wrapf(
+			ErrRegistryBalancesMismatch,
+			"%d validators, %d balances",
+			len(validators), len(balances),
+		)
+	}
 
 	var (
 		hysteresisIncrement = sp.cs.EffectiveBalanceIncrement() / sp.cs.HysteresisQuotient()
@@ -549,22 +646,10 @@ func (sp *StateProcessor[
 		upwardThreshold = math.Gwei(
 			hysteresisIncrement * sp.cs.HysteresisUpwardMultiplier(),
 		)
-
-		idx     math.U64
-		balance math.Gwei
 	)
 
-	for _, val := range validators {
-		idx, err = st.ValidatorIndexByPubkey(val.GetPubkey())
-		if err != nil {
-			return err
-		}
-
-		balance, err = st.GetBalance(idx)
-		if err != nil {
-			return err
-		}
-
+	for i, val := range validators {
+		balance := math.Gwei(balances[i])
 		if balance+downwardThreshold < val.GetEffectiveBalance() ||
 			val.GetEffectiveBalance()+upwardThreshold < balance {
 			updatedBalance := types.ComputeEffectiveBalance(
@@ -573,7 +658,9 @@ func (sp *StateProcessor[
 				math.U64(sp.cs.MaxEffectiveBalance()),
 			)
 			val.SetEffectiveBalance(updatedBalance)
-			if err = st.UpdateValidatorAtIndex(idx, val); err != nil {
+			if err = st.UpdateValidatorAtIndex(
+				math.ValidatorIndex(i), val,
+			); err != nil {
 				return err
 			}
 		}
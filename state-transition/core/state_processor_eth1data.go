@@ -0,0 +1,260 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"encoding/binary"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto/sha256"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/merkle/zero"
+)
+
+// depositTreeDepth is the depth of the deposit tree, as in the deposit
+// contract.
+const depositTreeDepth = 32
+
+// Unlike Eth 2.0 specs, Eth1Data is not voted on. Since every block is
+// final, it is derived deterministically from the block instead:
+//   - DepositRoot is the root of the deposit tree holding every deposit
+//     included in the chain so far, as the deposit contract computes it;
+//   - DepositCount is the number of deposits included in the chain so far;
+//   - BlockHash is the hash of the parent of the execution payload of the
+//     block, which is the latest finalized execution block.
+//
+// Blocks must carry the Eth1Data following from their content, so proposers
+// cannot inject arbitrary Eth1Data. This holds from the Eth1Data fork epoch
+// of the chain spec on: blocks before it carry empty Eth1Data, which is left
+// as it is.
+
+// Eth1Data returns the Eth1Data the given block must carry on top of the
+// given state.
+func (sp *StateProcessor[BeaconStateT, _, _]) Eth1Data(
+	st BeaconStateT,
+	blk *types.BeaconBlock,
+) (*types.Eth1Data, error) {
+	if !sp.eth1DataActive(blk.GetSlot()) {
+		return new(types.Eth1Data).Empty(), nil
+	}
+	tree, err := sp.depositTree(st)
+	if err != nil {
+		return nil, err
+	}
+	body := blk.GetBody()
+	tree.push(body.GetDeposits())
+	return tree.eth1Data(body.GetExecutionPayload().GetParentHash()), nil
+}
+
+// processEth1Data ensures the block carries the Eth1Data following from its
+// content, and adds its deposits to the deposit tree.
+func (sp *StateProcessor[BeaconStateT, _, _]) processEth1Data(
+	st BeaconStateT,
+	blk *types.BeaconBlock,
+) error {
+	if !sp.eth1DataActive(blk.GetSlot()) {
+		return nil
+	}
+	tree, err := sp.depositTree(st)
+	if err != nil {
+		return err
+	}
+	body := blk.GetBody()
+	tree.push(body.GetDeposits())
+	eth1Data := tree.eth1Data(body.GetExecutionPayload().GetParentHash())
+	if got := body.GetEth1Data(); got == nil || *got != *eth1Data {
+		return errors.Wrapf(
+			ErrEth1DataMismatch, "expected: %+v, got: %+v", eth1Data, got,
+		)
+	}
+	return sp.setDepositTree(st, tree, eth1Data)
+}
+
+// processGenesisEth1Data adds the genesis deposits to the deposit tree, with
+// the genesis execution block as the latest finalized one.
+func (sp *StateProcessor[BeaconStateT, _, _]) processGenesisEth1Data(
+	st BeaconStateT,
+	deposits []*types.Deposit,
+	blockHash common.ExecutionHash,
+) error {
+	if !sp.eth1DataActive(0) {
+		// Keep the genesis deposits, which are not read from the deposit
+		// contract, for the deposit tree to be filled in at the fork.
+		return sp.ds.EnqueueDeposits(deposits)
+	}
+	tree := new(depositTree)
+	tree.push(deposits)
+	return sp.setDepositTree(st, tree, tree.eth1Data(blockHash))
+}
+
+// upgradeEth1Data fills the deposit tree in with the deposits included in the
+// chain before the Eth1Data fork, which the state did not keep a tree of. The
+// deposit store keeps every deposit until the fork, including the genesis
+// ones, for this purpose. Every chain has genesis deposits, so the deposit
+// index of the state is the index of the latest included deposit.
+func (sp *StateProcessor[BeaconStateT, _, _]) upgradeEth1Data(
+	st BeaconStateT,
+) error {
+	depositIndex, err := st.GetEth1DepositIndex()
+	if err != nil {
+		return err
+	}
+	count := depositIndex + 1
+	deposits, err := sp.ds.GetDepositsByIndex(0, count)
+	if err != nil {
+		return err
+	}
+	if uint64(len(deposits)) != count {
+		return errors.Wrapf(
+			ErrMissingDeposits, "expected: %d, got: %d", count, len(deposits),
+		)
+	}
+	header, err := st.GetLatestExecutionPayloadHeader()
+	if err != nil {
+		return err
+	}
+	tree := new(depositTree)
+	tree.push(deposits)
+	eth1Data := tree.eth1Data(header.GetBlockHash())
+	sp.logger.Info(
+		"Filled deposit tree in at Eth1Data fork",
+		"deposit_count", eth1Data.DepositCount,
+		"deposit_root", eth1Data.DepositRoot,
+	)
+	return sp.setDepositTree(st, tree, eth1Data)
+}
+
+// eth1DataActive returns whether blocks of the given slot must carry the
+// Eth1Data following from their content.
+func (sp *StateProcessor[_, _, _]) eth1DataActive(slot math.Slot) bool {
+	return sp.cs.SlotToEpoch(slot) >= sp.cs.Eth1DataForkEpoch()
+}
+
+// isEth1DataForkBoundary returns whether the given slot is the last one
+// before the Eth1Data fork epoch, after which the deposit tree is filled in.
+func (sp *StateProcessor[_, _, _]) isEth1DataForkBoundary(
+	slot math.Slot,
+) bool {
+	return slot.IsEpochBoundary(sp.cs.SlotsPerEpoch()) &&
+		sp.cs.SlotToEpoch(slot)+1 == sp.cs.Eth1DataForkEpoch()
+}
+
+// depositTree loads the deposit tree of the state.
+func (sp *StateProcessor[BeaconStateT, _, _]) depositTree(
+	st BeaconStateT,
+) (*depositTree, error) {
+	eth1Data, err := st.GetEth1Data()
+	if err != nil {
+		return nil, err
+	}
+	tree := &depositTree{count: eth1Data.DepositCount.Unwrap()}
+	for height := range uint64(depositTreeDepth) {
+		if !tree.holds(height) {
+			continue
+		}
+		if tree.branch[height], err = st.GetDepositBranchAtIndex(
+			height,
+		); err != nil {
+			return nil, err
+		}
+	}
+	return tree, nil
+}
+
+// setDepositTree stores the deposit tree and the Eth1Data following from it
+// in the state.
+func (sp *StateProcessor[BeaconStateT, _, _]) setDepositTree(
+	st BeaconStateT,
+	tree *depositTree,
+	eth1Data *types.Eth1Data,
+) error {
+	for height := range uint64(depositTreeDepth) {
+		if !tree.holds(height) {
+			continue
+		}
+		if err := st.UpdateDepositBranchAtIndex(
+			height, tree.branch[height],
+		); err != nil {
+			return err
+		}
+	}
+	return st.SetEth1Data(eth1Data)
+}
+
+// depositTree is the incremental Merkle tree of the deposits, as kept by the
+// deposit contract. Only the branch needed to add deposits and compute the
+// root is kept.
+type depositTree struct {
+	// branch holds, at each height, the root of the latest complete subtree
+	// of that height.
+	branch [depositTreeDepth]common.Root
+	// count is the number of deposits in the tree.
+	count uint64
+}
+
+// holds returns whether the branch holds a node at the given height.
+func (t *depositTree) holds(height uint64) bool {
+	return (t.count>>height)&1 == 1
+}
+
+// push adds the deposits to the tree.
+func (t *depositTree) push(deposits []*types.Deposit) {
+	for _, dep := range deposits {
+		node := dep.DataRoot()
+		size := t.count + 1
+		for height := range uint64(depositTreeDepth) {
+			if size&1 == 1 {
+				t.branch[height] = node
+				break
+			}
+			node = hashPair(t.branch[height], node)
+			size >>= 1
+		}
+		t.count++
+	}
+}
+
+// eth1Data returns the Eth1Data of the tree, with the given block as the
+// latest finalized execution block.
+func (t *depositTree) eth1Data(
+	blockHash common.ExecutionHash,
+) *types.Eth1Data {
+	var node common.Root
+	for height := range uint64(depositTreeDepth) {
+		if t.holds(height) {
+			node = hashPair(t.branch[height], node)
+		} else {
+			node = hashPair(node, zero.Hashes[height])
+		}
+	}
+	var count common.Root
+	binary.LittleEndian.PutUint64(count[:], t.count)
+	return new(types.Eth1Data).New(
+		hashPair(node, count), math.U64(t.count), blockHash,
+	)
+}
+
+// hashPair returns the hash of the concatenation of the two nodes.
+func hashPair(left, right common.Root) common.Root {
+	return sha256.Hash(append(left[:], right[:]...))
+}
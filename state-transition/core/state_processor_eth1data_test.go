@@ -0,0 +1,263 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/chain-spec/chain"
+	"github.com/berachain/beacon-kit/config/spec"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/node-core/components"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/merkle"
+	"github.com/berachain/beacon-kit/primitives/transition"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/berachain/beacon-kit/state-transition/core"
+	depositstore "github.com/berachain/beacon-kit/storage/deposit"
+	"github.com/stretchr/testify/require"
+)
+
+// depositRoot returns the root of the deposit tree holding the given
+// deposits, built from scratch.
+func depositRoot(t *testing.T, deposits []*types.Deposit) common.Root {
+	t.Helper()
+	leaves := make([]common.Root, len(deposits))
+	for i, dep := range deposits {
+		leaves[i] = dep.DataRoot()
+	}
+	tree, err := merkle.NewTreeFromLeavesWithDepth(leaves, 32)
+	require.NoError(t, err)
+	return tree.HashTreeRoot()
+}
+
+func TestTransitionEth1Data(t *testing.T) {
+	cs := setupChain(t, components.BetnetChainSpecType)
+	sp, st, ds, ctx := setupState(t, cs)
+
+	var (
+		maxBalance  = math.Gwei(cs.MaxEffectiveBalance())
+		credentials = types.NewCredentialsFromExecutionAddress(
+			common.ExecutionAddress{},
+		)
+		deposits = make([]*types.Deposit, 5)
+	)
+	for i := range deposits {
+		deposits[i] = &types.Deposit{
+			Pubkey:      [48]byte{byte(i + 1)},
+			Credentials: credentials,
+			Amount:      maxBalance,
+			Index:       uint64(i),
+		}
+	}
+	genPayloadHeader := &types.ExecutionPayloadHeader{
+		BlockHash: common.ExecutionHash{0xaa},
+	}
+
+	// Genesis deposits are the first leaves of the deposit tree.
+	_, err := sp.InitializePreminedBeaconStateFromEth1(
+		st,
+		deposits[:3],
+		genPayloadHeader,
+		version.FromUint32[common.Version](version.Deneb),
+	)
+	require.NoError(t, err)
+	eth1Data, err := st.GetEth1Data()
+	require.NoError(t, err)
+	require.Equal(t, &types.Eth1Data{
+		DepositRoot:  depositRoot(t, deposits[:3]),
+		DepositCount: 3,
+		BlockHash:    genPayloadHeader.BlockHash,
+	}, eth1Data)
+
+	// A block carrying arbitrary Eth1Data is rejected.
+	require.NoError(t, ds.EnqueueDeposits(deposits[3:]))
+	body := &types.BeaconBlockBody{
+		ExecutionPayload: &types.ExecutionPayload{
+			ParentHash:   genPayloadHeader.BlockHash,
+			Timestamp:    10,
+			ExtraData:    []byte("testing"),
+			Transactions: [][]byte{},
+			Withdrawals: []*engineprimitives.Withdrawal{
+				st.EVMInflationWithdrawal(),
+			},
+			BaseFeePerGas: math.NewU256(0),
+		},
+		Deposits: deposits[3:],
+	}
+	blk := buildNextBlock(t, sp, st, body)
+	expected := blk.Body.Eth1Data
+	blk.Body.Eth1Data = &types.Eth1Data{
+		DepositRoot:  common.Root{0x01},
+		DepositCount: expected.DepositCount,
+		BlockHash:    expected.BlockHash,
+	}
+	_, err = sp.Transition(ctx, st.Copy(), blk)
+	require.ErrorIs(t, err, core.ErrEth1DataMismatch)
+
+	// The block deposits are added to the tree, and the parent of the
+	// payload is the latest finalized execution block.
+	blk.Body.Eth1Data = expected
+	_, err = sp.Transition(ctx, st, blk)
+	require.NoError(t, err)
+	eth1Data, err = st.GetEth1Data()
+	require.NoError(t, err)
+	require.Equal(t, &types.Eth1Data{
+		DepositRoot:  depositRoot(t, deposits),
+		DepositCount: 5,
+		BlockHash:    genPayloadHeader.BlockHash,
+	}, eth1Data)
+}
+
+func TestTransitionEth1DataFork(t *testing.T) {
+	// Use a custom chain spec entering the Eth1Data fork at slot 2.
+	csData := spec.BaseSpec()
+	csData.DepositEth1ChainID = spec.BetnetEth1ChainID
+	csData.SlotsPerEpoch = 2
+	csData.Eth1DataForkEpoch = 1
+	cs, err := chain.NewChainSpec(csData)
+	require.NoError(t, err)
+
+	var (
+		maxBalance  = math.Gwei(cs.MaxEffectiveBalance())
+		credentials = types.NewCredentialsFromExecutionAddress(
+			common.ExecutionAddress{},
+		)
+		deposits = make([]*types.Deposit, 5)
+	)
+	for i := range deposits {
+		deposits[i] = &types.Deposit{
+			Pubkey:      [48]byte{byte(i + 1)},
+			Credentials: credentials,
+			Amount:      maxBalance,
+			Index:       uint64(i),
+		}
+	}
+	genPayloadHeader := &types.ExecutionPayloadHeader{
+		BlockHash: common.ExecutionHash{0xaa},
+	}
+	genesis := func() (
+		*TestStateProcessorT,
+		*TestBeaconStateT,
+		*depositstore.KVStore[*types.Deposit],
+		*transition.Context,
+	) {
+		sp, st, ds, ctx := setupState(t, cs)
+		_, err = sp.InitializePreminedBeaconStateFromEth1(
+			st,
+			deposits[:3],
+			genPayloadHeader,
+			version.FromUint32[common.Version](version.Deneb),
+		)
+		require.NoError(t, err)
+		return sp, st, ds, ctx
+	}
+	sp, st, ds, ctx := genesis()
+
+	// Before the fork, Eth1Data is left as genesis sets it and the genesis
+	// deposits are kept for the fork.
+	emptyEth1Data := &types.Eth1Data{BlockHash: genPayloadHeader.BlockHash}
+	eth1Data, err := st.GetEth1Data()
+	require.NoError(t, err)
+	require.Equal(t, emptyEth1Data, eth1Data)
+	kept, err := ds.GetDepositsByIndex(0, 3)
+	require.NoError(t, err)
+	require.Equal(t, deposits[:3], kept)
+
+	// Blocks before the fork are built with empty Eth1Data, and the Eth1Data
+	// they carry is not checked.
+	require.NoError(t, ds.EnqueueDeposits(deposits[3:4]))
+	blk := buildNextBlock(t, sp, st, &types.BeaconBlockBody{
+		ExecutionPayload: &types.ExecutionPayload{
+			ParentHash:   genPayloadHeader.BlockHash,
+			BlockHash:    common.ExecutionHash{0xbb},
+			Timestamp:    10,
+			ExtraData:    []byte("testing"),
+			Transactions: [][]byte{},
+			Withdrawals: []*engineprimitives.Withdrawal{
+				st.EVMInflationWithdrawal(),
+			},
+			BaseFeePerGas: math.NewU256(0),
+		},
+		Deposits: deposits[3:4],
+	})
+	require.Equal(t, new(types.Eth1Data).Empty(), blk.Body.Eth1Data)
+	blk.Body.Eth1Data = &types.Eth1Data{DepositRoot: common.Root{0x01}}
+	_, err = sp.Transition(ctx, st, blk)
+	require.NoError(t, err)
+	eth1Data, err = st.GetEth1Data()
+	require.NoError(t, err)
+	require.Equal(t, emptyEth1Data, eth1Data)
+
+	// Entering the fork epoch fills the deposit tree in with the deposits
+	// included so far, up to the latest execution block.
+	require.NoError(t, ds.EnqueueDeposits(deposits[4:]))
+	body := &types.BeaconBlockBody{
+		ExecutionPayload: &types.ExecutionPayload{
+			ParentHash:   common.ExecutionHash{0xbb},
+			Timestamp:    11,
+			ExtraData:    []byte("testing"),
+			Transactions: [][]byte{},
+			Withdrawals: []*engineprimitives.Withdrawal{
+				st.EVMInflationWithdrawal(),
+			},
+			BaseFeePerGas: math.NewU256(0),
+		},
+		Deposits: deposits[4:],
+	}
+	blk = buildNextBlock(t, sp, st, body)
+	forked := st.Copy()
+	_, err = sp.ProcessSlots(forked, blk.GetSlot())
+	require.NoError(t, err)
+	eth1Data, err = forked.GetEth1Data()
+	require.NoError(t, err)
+	require.Equal(t, &types.Eth1Data{
+		DepositRoot:  depositRoot(t, deposits[:4]),
+		DepositCount: 4,
+		BlockHash:    common.ExecutionHash{0xbb},
+	}, eth1Data)
+
+	// From the fork on, blocks must carry the Eth1Data following from their
+	// deposits.
+	_, err = sp.Transition(ctx, st.Copy(), blk)
+	require.ErrorIs(t, err, core.ErrEth1DataMismatch)
+
+	blk.Body.Eth1Data, err = sp.Eth1Data(forked, blk)
+	require.NoError(t, err)
+	_, err = sp.Transition(ctx, st, blk)
+	require.NoError(t, err)
+	eth1Data, err = st.GetEth1Data()
+	require.NoError(t, err)
+	require.Equal(t, &types.Eth1Data{
+		DepositRoot:  depositRoot(t, deposits),
+		DepositCount: 5,
+		BlockHash:    body.ExecutionPayload.ParentHash,
+	}, eth1Data)
+
+	// The fork fails if the deposit store misses some deposits.
+	sp, st, ds, _ = genesis()
+	require.NoError(t, ds.Prune(0, 1))
+	progressStateToSlot(t, st, 1)
+	_, err = sp.ProcessSlots(st, 2)
+	require.ErrorIs(t, err, core.ErrMissingDeposits)
+}
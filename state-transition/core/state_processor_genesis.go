@@ -22,6 +22,7 @@ package core
 
 import (
 	"github.com/berachain/beacon-kit/config/spec"
+	"github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/constants"
 	"github.com/berachain/beacon-kit/primitives/encoding/hex"
@@ -34,19 +35,19 @@ import (
 //
 //nolint:gocognit // todo fix.
 func (sp *StateProcessor[
-	_, BeaconBlockBodyT, BeaconBlockHeaderT, BeaconStateT, _, DepositT,
-	Eth1DataT, _, ExecutionPayloadHeaderT, ForkT, _, _, ValidatorT, _, _, _, _,
+	BeaconStateT, _, _,
 ]) InitializePreminedBeaconStateFromEth1(
 	st BeaconStateT,
-	deposits []DepositT,
-	execPayloadHeader ExecutionPayloadHeaderT,
+	deposits []*types.Deposit,
+	execPayloadHeader *types.ExecutionPayloadHeader,
 	genesisVersion common.Version,
 ) (transition.ValidatorUpdates, error) {
+	sp.resetPrecomputed()
 	if err := st.SetSlot(0); err != nil {
 		return nil, err
 	}
 
-	var fork ForkT
+	var fork *types.Fork
 	fork = fork.New(
 		genesisVersion,
 		genesisVersion,
@@ -58,7 +59,7 @@ func (sp *StateProcessor[
 
 	// Eth1DepositIndex will be set in processDeposit
 
-	var eth1Data Eth1DataT
+	var eth1Data *types.Eth1Data
 	eth1Data = eth1Data.New(
 		common.Root{},
 		0,
@@ -69,11 +70,10 @@ func (sp *StateProcessor[
 	}
 
 	// TODO: we need to handle common.Version vs uint32 better.
-	var blkBody BeaconBlockBodyT
+	var blkBody *types.BeaconBlockBody
 	blkBody = blkBody.Empty(version.ToUint32(genesisVersion))
 
-	var blkHeader BeaconBlockHeaderT
-	blkHeader = blkHeader.New(
+	blkHeader := types.NewBeaconBlockHeader(
 		0,                      // slot
 		0,                      // proposer index
 		common.Root{},          // parent block root
@@ -102,6 +102,11 @@ func (sp *StateProcessor[
 			return nil, err
 		}
 	}
+	if err := sp.processGenesisEth1Data(
+		st, deposits, execPayloadHeader.GetBlockHash(),
+	); err != nil {
+		return nil, err
+	}
 
 	// Handle special case bartio genesis.
 	validatorsRoot := common.Root(hex.MustToBytes(spec.BartioValRoot))
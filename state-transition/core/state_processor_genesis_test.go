@@ -30,6 +30,7 @@ import (
 	"github.com/berachain/beacon-kit/primitives/constants"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/berachain/beacon-kit/state-transition/core"
 	"github.com/stretchr/testify/require"
 )
 
@@ -129,6 +130,18 @@ func TestInitialize(t *testing.T) {
 	latestValIdx, err := st.GetEth1DepositIndex()
 	require.NoError(t, err)
 	require.Equal(t, uint64(len(genDeposits)-1), latestValIdx)
+
+	// check that the proposer lookup table is populated for the genesis epoch
+	genesisEpoch := math.Epoch(constants.GenesisEpoch)
+	for _, dep := range goodDeposits {
+		idx, errIdx := st.ValidatorIndexByPubkey(dep.Pubkey)
+		require.NoError(t, errIdx)
+		addr, errAddr := sp.ProposerAddressAt(genesisEpoch, idx)
+		require.NoError(t, errAddr)
+		require.Equal(t, []byte{0xff}, addr)
+	}
+	_, err = sp.ProposerAddressAt(genesisEpoch, 100)
+	require.ErrorIs(t, err, core.ErrProposerAddressNotFound)
 }
 
 func checkValidatorNonBartio(
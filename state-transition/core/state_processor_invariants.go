@@ -0,0 +1,305 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/berachain/beacon-kit/config/spec"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// invariantSnapshot collects the conserved quantities of a state before a
+// transition, against which the state after the transition is checked.
+type invariantSnapshot struct {
+	// totalBalance is the sum of the validator balances.
+	totalBalance math.Gwei
+	// depositIndex is the eth1 deposit index.
+	depositIndex uint64
+	// pubkeys is the set of public keys in the validator registry.
+	pubkeys map[crypto.BLSPubkey]struct{}
+}
+
+// invariantViolation describes an invariant which does not hold after a
+// transition.
+type invariantViolation struct {
+	name     string
+	expected any
+	got      any
+}
+
+// String implements fmt.Stringer.
+func (v invariantViolation) String() string {
+	return fmt.Sprintf("%s: expected %v, got %v", v.name, v.expected, v.got)
+}
+
+// snapshotInvariants collects the conserved quantities of st ahead of a
+// transition.
+func (sp *StateProcessor[_, _, _]) snapshotInvariants(
+	st ReadOnlyState,
+) (*invariantSnapshot, error) {
+	totalBalance, err := sumBalances(st)
+	if err != nil {
+		return nil, err
+	}
+	depositIndex, err := st.GetEth1DepositIndex()
+	if err != nil {
+		return nil, err
+	}
+	vals, err := st.GetValidators()
+	if err != nil {
+		return nil, err
+	}
+	pubkeys := make(map[crypto.BLSPubkey]struct{}, len(vals))
+	for _, val := range vals {
+		pubkeys[val.GetPubkey()] = struct{}{}
+	}
+	return &invariantSnapshot{
+		totalBalance: totalBalance,
+		depositIndex: depositIndex,
+		pubkeys:      pubkeys,
+	}, nil
+}
+
+// verifyInvariants verifies that the transition of the state captured by pre
+// to st through blk preserved the conserved quantities of the state:
+//   - the validator balances only move by the deposits credited and the
+//     validator withdrawals of the block;
+//   - the eth1 deposit index never decreases and points to the last deposit
+//     of the block;
+//   - the total slashing matches the sum of the slashings vector.
+//
+// A detailed report of the violated invariants is returned wrapped in
+// ErrInvariantViolation, so that the node halts at the block introducing
+// the inconsistency.
+func (sp *StateProcessor[BeaconStateT, _, _]) verifyInvariants(
+	pre *invariantSnapshot,
+	st BeaconStateT,
+	blk *types.BeaconBlock,
+) error {
+	var violations []invariantViolation
+
+	// Bartio and Boonet, until its second fork, credit deposits and debit
+	// withdrawals under legacy rules, and fix their deposit index in place.
+	// Only the slashings accounting is checked on them.
+	slot := blk.GetSlot()
+	legacy := sp.cs.DepositEth1ChainID() == spec.BartioChainID ||
+		(sp.cs.DepositEth1ChainID() == spec.BoonetEth1ChainID &&
+			slot <= math.U64(spec.BoonetFork2Height))
+
+	// Application-defined operations may move balances arbitrarily.
+	if !legacy && len(blk.GetBody().GetOperations()) == 0 {
+		v, err := sp.checkBalances(pre, st, blk)
+		if err != nil {
+			return err
+		}
+		violations = append(violations, v...)
+	}
+
+	if !legacy {
+		v, err := checkDepositIndex(pre, st, blk)
+		if err != nil {
+			return err
+		}
+		violations = append(violations, v...)
+	}
+
+	v, err := sp.checkSlashings(st)
+	if err != nil {
+		return err
+	}
+	violations = append(violations, v...)
+
+	if len(violations) == 0 {
+		return nil
+	}
+
+	report := make([]string, len(violations))
+	for i, violation := range violations {
+		report[i] = violation.String()
+	}
+	sp.logger.Error(
+		"State invariants violated",
+		"slot", slot,
+		"block_root", blk.HashTreeRoot(),
+		"violations", report,
+	)
+	return errors.Wrapf(
+		ErrInvariantViolation, "slot %d: %s", slot,
+		strings.Join(report, "; "),
+	)
+}
+
+// checkBalances verifies that the total balance only moved by the deposits
+// credited and the validator withdrawals of blk.
+func (sp *StateProcessor[_, _, _]) checkBalances(
+	pre *invariantSnapshot,
+	st ReadOnlyState,
+	blk *types.BeaconBlock,
+) ([]invariantViolation, error) {
+	credited, err := sp.creditedDeposits(pre, st, blk.GetBody().GetDeposits())
+	if err != nil {
+		return nil, err
+	}
+
+	// The first withdrawal mints the EVM inflation and debits no validator.
+	var debited math.Gwei
+	withdrawals := blk.GetBody().GetExecutionPayload().GetWithdrawals()
+	for i := 1; i < len(withdrawals); i++ {
+		debited += withdrawals[i].GetAmount()
+	}
+
+	totalBalance, err := sumBalances(st)
+	if err != nil {
+		return nil, err
+	}
+	if pre.totalBalance+credited != totalBalance+debited {
+		return []invariantViolation{{
+			name: "total balance",
+			expected: fmt.Sprintf(
+				"%d (%d + %d deposited - %d withdrawn)",
+				pre.totalBalance+credited-debited,
+				pre.totalBalance, credited, debited,
+			),
+			got: totalBalance,
+		}}, nil
+	}
+	return nil, nil
+}
+
+// creditedDeposits returns the sum of the deposits credited to a validator
+// balance. Deposits topping up a validator are always credited, while the
+// ones creating a validator are dropped if their signature is invalid.
+func (sp *StateProcessor[_, _, _]) creditedDeposits(
+	pre *invariantSnapshot,
+	st ReadOnlyState,
+	deposits []*types.Deposit,
+) (math.Gwei, error) {
+	if len(deposits) == 0 {
+		return 0, nil
+	}
+
+	// The block slot is the one deposits were processed at.
+	forkData, err := sp.depositForkData(st)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		credited math.Gwei
+		created  = make(map[crypto.BLSPubkey]struct{})
+	)
+	for _, dep := range deposits {
+		_, existing := pre.pubkeys[dep.GetPubkey()]
+		if _, ok := created[dep.GetPubkey()]; ok {
+			existing = true
+		}
+		if !existing {
+			if err = dep.VerifySignature(
+				forkData,
+				sp.cs.DomainTypeDeposit(),
+				sp.signer.VerifySignature,
+			); err != nil {
+				continue
+			}
+			created[dep.GetPubkey()] = struct{}{}
+		}
+		credited += dep.GetAmount()
+	}
+	return credited, nil
+}
+
+// checkDepositIndex verifies that the eth1 deposit index did not decrease
+// and points to the last deposit of blk, if any.
+func checkDepositIndex(
+	pre *invariantSnapshot,
+	st ReadOnlyState,
+	blk *types.BeaconBlock,
+) ([]invariantViolation, error) {
+	depositIndex, err := st.GetEth1DepositIndex()
+	if err != nil {
+		return nil, err
+	}
+	if depositIndex < pre.depositIndex {
+		return []invariantViolation{{
+			name:     "deposit index monotonicity",
+			expected: fmt.Sprintf(">= %d", pre.depositIndex),
+			got:      depositIndex,
+		}}, nil
+	}
+
+	expected := pre.depositIndex
+	if deposits := blk.GetBody().GetDeposits(); len(deposits) > 0 {
+		expected = deposits[len(deposits)-1].GetIndex().Unwrap()
+	}
+	if depositIndex != expected {
+		return []invariantViolation{{
+			name:     "deposit index",
+			expected: expected,
+			got:      depositIndex,
+		}}, nil
+	}
+	return nil, nil
+}
+
+// checkSlashings verifies that the total slashing matches the sum of the
+// slashings vector.
+func (sp *StateProcessor[_, _, _]) checkSlashings(
+	st ReadOnlyState,
+) ([]invariantViolation, error) {
+	var sum math.Gwei
+	for i := range sp.cs.EpochsPerSlashingsVector() {
+		slashing, err := st.GetSlashingAtIndex(i)
+		if err != nil {
+			return nil, err
+		}
+		sum += slashing
+	}
+	totalSlashing, err := st.GetTotalSlashing()
+	if err != nil {
+		return nil, err
+	}
+	if totalSlashing != sum {
+		return []invariantViolation{{
+			name:     "total slashing",
+			expected: sum,
+			got:      totalSlashing,
+		}}, nil
+	}
+	return nil, nil
+}
+
+// sumBalances returns the sum of the validator balances of st.
+func sumBalances(st ReadOnlyState) (math.Gwei, error) {
+	balances, err := st.GetBalances()
+	if err != nil {
+		return 0, err
+	}
+	var total math.Gwei
+	for _, balance := range balances {
+		total += math.Gwei(balance)
+	}
+	return total, nil
+}
@@ -0,0 +1,116 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/node-core/components"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/berachain/beacon-kit/state-transition/core"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTransitionInvariants shows that transitions crediting deposits pass the
+// invariant checks, while a state whose slashings accounting got corrupted
+// halts the transition.
+func TestTransitionInvariants(t *testing.T) {
+	cs := setupChain(t, components.BetnetChainSpecType)
+	sp, st, ds, ctx := setupState(t, cs, core.WithInvariantChecks())
+
+	var (
+		maxBalance  = math.Gwei(cs.MaxEffectiveBalance())
+		credentials = types.NewCredentialsFromExecutionAddress(
+			common.ExecutionAddress{},
+		)
+	)
+
+	genDeposits := []*types.Deposit{
+		{
+			Pubkey:      [48]byte{0x01},
+			Credentials: credentials,
+			Amount:      maxBalance / 2,
+			Index:       0,
+		},
+	}
+	_, err := sp.InitializePreminedBeaconStateFromEth1(
+		st,
+		genDeposits,
+		new(types.ExecutionPayloadHeader).Empty(),
+		version.FromUint32[common.Version](version.Deneb),
+	)
+	require.NoError(t, err)
+
+	// Top up the genesis validator and create a new one.
+	blkDeposits := []*types.Deposit{
+		{
+			Pubkey:      genDeposits[0].Pubkey,
+			Credentials: credentials,
+			Amount:      maxBalance / 4,
+			Index:       1,
+		},
+		{
+			Pubkey:      [48]byte{0x02},
+			Credentials: credentials,
+			Amount:      maxBalance,
+			Index:       2,
+		},
+	}
+	require.NoError(t, ds.EnqueueDeposits(blkDeposits))
+	blk := buildNextBlock(t, sp, st, &types.BeaconBlockBody{
+		ExecutionPayload: &types.ExecutionPayload{
+			Timestamp:    10,
+			ExtraData:    []byte("testing"),
+			Transactions: [][]byte{},
+			Withdrawals: []*engineprimitives.Withdrawal{
+				st.EVMInflationWithdrawal(),
+			},
+			BaseFeePerGas: math.NewU256(0),
+		},
+		Eth1Data: &types.Eth1Data{},
+		Deposits: blkDeposits,
+	})
+	_, err = sp.Transition(ctx, st, blk)
+	require.NoError(t, err)
+
+	// Corrupt the total slashing, as a buggy transition would.
+	require.NoError(t, st.SetTotalSlashing(1))
+	blk = buildNextBlock(t, sp, st, &types.BeaconBlockBody{
+		ExecutionPayload: &types.ExecutionPayload{
+			Timestamp:    11,
+			ExtraData:    []byte("testing"),
+			Transactions: [][]byte{},
+			Withdrawals: []*engineprimitives.Withdrawal{
+				st.EVMInflationWithdrawal(),
+			},
+			BaseFeePerGas: math.NewU256(0),
+		},
+		Eth1Data: &types.Eth1Data{},
+		Deposits: []*types.Deposit{},
+	})
+	_, err = sp.Transition(ctx, st, blk)
+	require.ErrorIs(t, err, core.ErrInvariantViolation)
+	require.ErrorContains(t, err, "total slashing: expected 0, got 1")
+}
@@ -25,6 +25,7 @@ import (
 
 	payloadtime "github.com/berachain/beacon-kit/beacon/payload-time"
 	"github.com/berachain/beacon-kit/config/spec"
+	"github.com/berachain/beacon-kit/consensus-types/types"
 	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
 	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/primitives/math"
@@ -33,19 +34,16 @@ import (
 
 // processExecutionPayload processes the execution payload and ensures it
 // matches the local state.
-func (sp *StateProcessor[
-	BeaconBlockT, _, _, BeaconStateT, ContextT,
-	_, _, _, ExecutionPayloadHeaderT, _, _, _, _, _, _, _, _,
-]) processExecutionPayload(
+func (sp *StateProcessor[BeaconStateT, ContextT, _]) processExecutionPayload(
 	ctx ContextT,
 	st BeaconStateT,
-	blk BeaconBlockT,
+	blk *types.BeaconBlock,
 ) error {
 	var (
 		body    = blk.GetBody()
 		payload = body.GetExecutionPayload()
-		header  ExecutionPayloadHeaderT
-		g, gCtx = errgroup.WithContext(context.Background())
+		header  *types.ExecutionPayloadHeader
+		g, gCtx = errgroup.WithContext(ctx)
 	)
 
 	payloadTimestamp := payload.GetTimestamp().Unwrap()
@@ -92,13 +90,10 @@ func (sp *StateProcessor[
 
 // validateExecutionPayload validates the execution payload against both local
 // state and the execution engine.
-func (sp *StateProcessor[
-	BeaconBlockT, _, _, BeaconStateT,
-	_, _, _, _, _, _, _, _, _, _, _, _, _,
-]) validateExecutionPayload(
+func (sp *StateProcessor[_, _, _]) validateExecutionPayload(
 	ctx context.Context,
-	st BeaconStateT,
-	blk BeaconBlockT,
+	st ReadOnlyState,
+	blk *types.BeaconBlock,
 	consensusTime math.U64,
 	optimisticEngine bool,
 ) error {
@@ -115,11 +110,8 @@ func (sp *StateProcessor[
 }
 
 // validateStatelessPayload performs stateless checks on the execution payload.
-func (sp *StateProcessor[
-	BeaconBlockT, _, _, _,
-	_, _, _, _, _, _, _, _, _, _, _, _, _,
-]) validateStatelessPayload(
-	blk BeaconBlockT,
+func (sp *StateProcessor[_, _, _]) validateStatelessPayload(
+	blk *types.BeaconBlock,
 ) error {
 	body := blk.GetBody()
 	payload := body.GetExecutionPayload()
@@ -134,6 +126,22 @@ func (sp *StateProcessor[
 		)
 	}
 
+	// Verify the payload timestamp against the start of its slot, from the
+	// slot timestamp fork.
+	if payloadtime.Anchored(sp.cs, blk.GetSlot()) {
+		if err := payloadtime.VerifySlot(
+			payloadtime.SlotTimestamp(
+				math.U64(sp.cs.GenesisTime()),
+				sp.cs.SlotDuration(),
+				blk.GetSlot(),
+			),
+			payload.GetTimestamp(),
+			math.U64(sp.cs.PayloadTimestampTolerance()),
+		); err != nil {
+			return err
+		}
+	}
+
 	// Verify the number of blobs.
 	blobKzgCommitments := body.GetBlobKzgCommitments()
 	if uint64(len(blobKzgCommitments)) > sp.cs.MaxBlobsPerBlock() {
@@ -148,13 +156,10 @@ func (sp *StateProcessor[
 }
 
 // validateStatefulPayload performs stateful checks on the execution payload.
-func (sp *StateProcessor[
-	BeaconBlockT, _, _, BeaconStateT,
-	_, _, _, _, _, _, _, _, _, _, _, _, _,
-]) validateStatefulPayload(
+func (sp *StateProcessor[_, _, _]) validateStatefulPayload(
 	ctx context.Context,
-	st BeaconStateT,
-	blk BeaconBlockT,
+	st ReadOnlyState,
+	blk *types.BeaconBlock,
 	consensusTime math.U64,
 	optimisticEngine bool,
 ) error {
@@ -168,8 +173,12 @@ func (sp *StateProcessor[
 
 	// We skip timestamp check on Bartio for backward compatibility reasons
 	// TODO: enforce the check when we drop other Bartio special cases.
-	if sp.cs.DepositEth1ChainID() != spec.BartioChainID {
+	// Timestamps anchored to slots are checked against the slot instead,
+	// since the chain may run ahead of the consensus time.
+	if sp.cs.DepositEth1ChainID() != spec.BartioChainID &&
+		!payloadtime.Anchored(sp.cs, blk.GetSlot()) {
 		if err = payloadtime.Verify(
+			math.U64(sp.cs.GenesisTime()),
 			consensusTime,
 			lph.GetTimestamp(),
 			payload.GetTimestamp(),
@@ -0,0 +1,187 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"context"
+	"testing"
+
+	payloadtime "github.com/berachain/beacon-kit/beacon/payload-time"
+	"github.com/berachain/beacon-kit/chain-spec/chain"
+	"github.com/berachain/beacon-kit/config/spec"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/node-core/components"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/berachain/beacon-kit/state-transition/core"
+	"github.com/berachain/beacon-kit/state-transition/core/fakes"
+	"github.com/stretchr/testify/require"
+)
+
+// The transition context bounds the execution engine calls: once it is done,
+// payloads are no longer verified, which only fails non-optimistic
+// transitions.
+func TestTransitionCancellation(t *testing.T) {
+	cs := setupChain(t, components.BetnetChainSpecType)
+	ee := fakes.NewExecutionEngine()
+	sp, st, _, ctx := setupState(t, cs, core.WithExecutionEngine(ee))
+
+	_, err := sp.InitializePreminedBeaconStateFromEth1(
+		st,
+		[]*types.Deposit{{
+			Pubkey: [48]byte{0x01},
+			Credentials: types.NewCredentialsFromExecutionAddress(
+				common.ExecutionAddress{},
+			),
+			Amount: math.Gwei(cs.MaxEffectiveBalance()),
+			Index:  0,
+		}},
+		new(types.ExecutionPayloadHeader).Empty(),
+		version.FromUint32[common.Version](version.Deneb),
+	)
+	require.NoError(t, err)
+
+	blk := buildNextBlock(t, sp, st, &types.BeaconBlockBody{
+		ExecutionPayload: &types.ExecutionPayload{
+			Timestamp:    10,
+			ExtraData:    []byte("testing"),
+			Transactions: [][]byte{},
+			Withdrawals: []*engineprimitives.Withdrawal{
+				st.EVMInflationWithdrawal(),
+			},
+			BaseFeePerGas: math.NewU256(0),
+		},
+		Eth1Data: &types.Eth1Data{},
+		Deposits: []*types.Deposit{},
+	})
+
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	ctx.Context = cancelled
+	ctx.SkipPayloadVerification = false
+	ctx.ConsensusTime = blk.GetBody().GetExecutionPayload().GetTimestamp()
+
+	// A proposal is rejected once its deadline expired.
+	_, err = sp.Transition(ctx, st.Copy(), blk)
+	require.ErrorIs(t, err, context.Canceled)
+
+	// A finalized block is applied regardless.
+	ctx.OptimisticEngine = true
+	_, err = sp.Transition(ctx, st.Copy(), blk)
+	require.NoError(t, err)
+	require.Empty(t, ee.Payloads())
+
+	ctx.Context = context.Background()
+	ctx.OptimisticEngine = false
+	_, err = sp.Transition(ctx, st, blk)
+	require.NoError(t, err)
+	require.Len(t, ee.Payloads(), 1)
+}
+
+// From the slot timestamp fork, payload timestamps must match the start of
+// their slot, as the proposer builds them, rather than follow the consensus
+// time.
+func TestTransitionSlotTimestamp(t *testing.T) {
+	const genesisTime = 1000
+	data := spec.BaseSpec()
+	data.DepositEth1ChainID = spec.BetnetEth1ChainID
+	data.SlotsPerEpoch = 2
+	data.GenesisTime = genesisTime
+	data.SlotDurationMs = 2000
+	data.SlotTimestampForkEpoch = 1
+	cs, err := chain.NewChainSpec(data)
+	require.NoError(t, err)
+	sp, st, _, ctx := setupState(
+		t, cs, core.WithExecutionEngine(fakes.NewExecutionEngine()),
+	)
+	ctx.SkipPayloadVerification = false
+
+	_, err = sp.InitializePreminedBeaconStateFromEth1(
+		st,
+		[]*types.Deposit{{
+			Pubkey: [48]byte{0x01},
+			Credentials: types.NewCredentialsFromExecutionAddress(
+				common.ExecutionAddress{},
+			),
+			Amount: math.Gwei(cs.MaxEffectiveBalance()),
+			Index:  0,
+		}},
+		new(types.ExecutionPayloadHeader).Empty(),
+		version.FromUint32[common.Version](version.Deneb),
+	)
+	require.NoError(t, err)
+
+	nextBlock := func(timestamp math.U64) *types.BeaconBlock {
+		// The payload carries the randao mix of the epoch of the block.
+		slot, err := st.GetSlot()
+		require.NoError(t, err)
+		next := st.Copy()
+		_, err = sp.ProcessSlots(next, slot+1)
+		require.NoError(t, err)
+		mix, err := next.GetRandaoMixAtIndex(
+			cs.SlotToEpoch(slot+1).Unwrap() % cs.EpochsPerHistoricalVector(),
+		)
+		require.NoError(t, err)
+
+		return buildNextBlock(t, sp, st, &types.BeaconBlockBody{
+			ExecutionPayload: &types.ExecutionPayload{
+				Timestamp:    timestamp,
+				Random:       mix,
+				ExtraData:    []byte("testing"),
+				Transactions: [][]byte{},
+				Withdrawals: []*engineprimitives.Withdrawal{
+					st.EVMInflationWithdrawal(),
+				},
+				BaseFeePerGas: math.NewU256(0),
+			},
+			Eth1Data: &types.Eth1Data{},
+			Deposits: []*types.Deposit{},
+		})
+	}
+
+	// Before the fork, the timestamp follows the consensus time.
+	ctx.ConsensusTime = genesisTime + 30
+	require.False(t, payloadtime.Anchored(cs, 1))
+	timestamp := payloadtime.ForSlot(cs, 1, ctx.ConsensusTime, 0, false)
+	require.Equal(t, ctx.ConsensusTime, timestamp)
+	_, err = sp.Transition(ctx, st, nextBlock(timestamp))
+	require.NoError(t, err)
+
+	// From the fork, it is the start of the slot, even if the chain runs
+	// ahead of the consensus time.
+	ctx.ConsensusTime = genesisTime + 1
+	require.True(t, payloadtime.Anchored(cs, 2))
+	timestamp = payloadtime.ForSlot(cs, 2, ctx.ConsensusTime, timestamp, false)
+	require.Equal(t, math.U64(genesisTime+2*2), timestamp)
+
+	_, err = sp.Transition(ctx, st.Copy(), nextBlock(timestamp+1))
+	require.ErrorIs(t, err, payloadtime.ErrSlotTimestampMismatch)
+	_, err = sp.Transition(ctx, st, nextBlock(timestamp))
+	require.NoError(t, err)
+
+	// Consecutive slots yield strictly increasing timestamps.
+	next := payloadtime.ForSlot(cs, 3, ctx.ConsensusTime, timestamp, false)
+	require.Equal(t, timestamp+2, next)
+	_, err = sp.Transition(ctx, st, nextBlock(next))
+	require.NoError(t, err)
+}
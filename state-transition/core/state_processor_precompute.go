@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// precomputedSlot is the state root of a state, computed ahead of the
+// processing of its next slot.
+type precomputedSlot struct {
+	// slot is the slot of the state.
+	slot math.Slot
+	// headerRoot is the root of the latest block header of the state.
+	headerRoot common.Root
+	// stateRoot is the root of the state.
+	stateRoot common.Root
+}
+
+// PrecomputeNextSlot computes the state root that processing the slot after
+// the one of st starts with, so that it is not on the critical path of the
+// next block. Slot processing does not depend on the block, so it is meant
+// to run in the background once the block of st is finalized, on a copy of
+// st. Only the latest precomputation is kept.
+func (sp *StateProcessor[BeaconStateT, _, _]) PrecomputeNextSlot(
+	st BeaconStateT,
+) error {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+	latestHeader, err := st.GetLatestBlockHeader()
+	if err != nil {
+		return err
+	}
+	stateRoot, err := st.ParallelHashTreeRoot(sp.stateHasher)
+	if err != nil {
+		return err
+	}
+	precomputed := &precomputedSlot{
+		slot:       slot,
+		headerRoot: latestHeader.HashTreeRoot(),
+		stateRoot:  stateRoot,
+	}
+
+	sp.precomputedMu.Lock()
+	defer sp.precomputedMu.Unlock()
+	sp.precomputed = precomputed
+	return nil
+}
+
+// precomputedStateRoot returns the precomputed root of the state at the
+// given slot with the given latest block header root, if any. The state a
+// block is finalized with is the only one at its slot with its latest block
+// header, since the header commits to the parent state and to the block
+// body, so a state matching both is the precomputed one.
+func (sp *StateProcessor[_, _, _]) precomputedStateRoot(
+	slot math.Slot,
+	headerRoot common.Root,
+) (common.Root, bool) {
+	sp.precomputedMu.RLock()
+	defer sp.precomputedMu.RUnlock()
+	hit := sp.precomputed != nil &&
+		sp.precomputed.slot == slot &&
+		sp.precomputed.headerRoot == headerRoot
+	sp.metrics.countPrecomputedSlot(hit)
+	if !hit {
+		return common.Root{}, false
+	}
+	return sp.precomputed.stateRoot, true
+}
+
+// resetPrecomputed drops the precomputed state root. A new genesis state
+// has the same slot and latest block header whatever its validators, so
+// the root precomputed for a previous genesis must not be reused.
+func (sp *StateProcessor[_, _, _]) resetPrecomputed() {
+	sp.precomputedMu.Lock()
+	defer sp.precomputedMu.Unlock()
+	sp.precomputed = nil
+}
@@ -21,6 +21,7 @@
 package core
 
 import (
+	"github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/constants"
 	"github.com/berachain/beacon-kit/primitives/crypto"
@@ -31,13 +32,10 @@ import (
 
 // processRandaoReveal processes the randao reveal and
 // ensures it matches the local state.
-func (sp *StateProcessor[
-	BeaconBlockT, _, _, BeaconStateT,
-	ContextT, _, _, _, _, _, ForkDataT, _, _, _, _, _, _,
-]) processRandaoReveal(
+func (sp *StateProcessor[BeaconStateT, ContextT, _]) processRandaoReveal(
 	ctx ContextT,
 	st BeaconStateT,
-	blk BeaconBlockT,
+	blk *types.BeaconBlock,
 ) error {
 	slot, err := st.GetSlot()
 	if err != nil {
@@ -58,8 +56,7 @@ func (sp *StateProcessor[
 	epoch := sp.cs.SlotToEpoch(slot)
 	body := blk.GetBody()
 
-	var fd ForkDataT
-	fd = fd.New(
+	fd := types.NewForkData(
 		version.FromUint32[common.Version](
 			sp.cs.ActiveForkVersionForEpoch(epoch),
 		), genesisValidatorsRoot,
@@ -96,9 +93,7 @@ func (sp *StateProcessor[
 // https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#randao-mixes-updates
 //
 //nolint:lll
-func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
-]) processRandaoMixesReset(
+func (sp *StateProcessor[BeaconStateT, _, _]) processRandaoMixesReset(
 	st BeaconStateT,
 ) error {
 	slot, err := st.GetSlot()
@@ -120,9 +115,7 @@ func (sp *StateProcessor[
 }
 
 // buildRandaoMix as defined in the Ethereum 2.0 specification.
-func (sp *StateProcessor[
-	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
-]) buildRandaoMix(
+func (sp *StateProcessor[_, _, _]) buildRandaoMix(
 	mix common.Bytes32,
 	reveal crypto.BLSSignature,
 ) common.Bytes32 {
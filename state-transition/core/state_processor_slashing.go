@@ -21,6 +21,7 @@
 package core
 
 import (
+	"github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/primitives/math"
 )
 
@@ -28,9 +29,7 @@ import (
 // https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#slashings-balances-updates
 //
 //nolint:lll
-func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
-]) processSlashingsReset(
+func (sp *StateProcessor[BeaconStateT, _, _]) processSlashingsReset(
 	st BeaconStateT,
 ) error {
 	// Get the current epoch.
@@ -47,9 +46,7 @@ func (sp *StateProcessor[
 // https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#proposer-slashings
 //
 //nolint:lll,unused // will be used later
-func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
-]) processProposerSlashing(
+func (sp *StateProcessor[BeaconStateT, _, _]) processProposerSlashing(
 	_ BeaconStateT,
 	// ps ProposerSlashing,
 ) error {
@@ -63,9 +60,7 @@ func (sp *StateProcessor[
 // state.
 //
 //nolint:lll,unused // will be used later
-func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
-]) processSlashings(
+func (sp *StateProcessor[BeaconStateT, _, _]) processSlashings(
 	st BeaconStateT,
 ) error {
 	totalBalance, err := st.GetTotalActiveBalances(sp.cs.SlotsPerEpoch())
@@ -116,11 +111,9 @@ func (sp *StateProcessor[
 // processSlash handles the logic for slashing a validator.
 //
 //nolint:unused // will be used later
-func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, ValidatorT, _, _, _, _,
-]) processSlash(
+func (sp *StateProcessor[BeaconStateT, _, _]) processSlash(
 	st BeaconStateT,
-	val ValidatorT,
+	val *types.Validator,
 	adjustedTotalSlashingBalance uint64,
 	totalBalance uint64,
 ) error {
@@ -0,0 +1,310 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/chain-spec/chain"
+	"github.com/berachain/beacon-kit/config/spec"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/node-core/components"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/transition"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/stretchr/testify/require"
+)
+
+// TestProcessSlotsCatchUp shows that fast-forwarding many empty slots at once
+// leads to the same state and validators set updates as processing them one
+// at a time, even though settled epochs skip the balance-driven work.
+func TestProcessSlotsCatchUp(t *testing.T) {
+	cs := setupChain(t, components.BetnetChainSpecType)
+
+	var (
+		maxBalance = math.Gwei(cs.MaxEffectiveBalance())
+		increment  = math.Gwei(cs.EffectiveBalanceIncrement())
+		minBalance = math.Gwei(cs.EjectionBalance())
+		targetSlot = math.Slot(3*cs.SlotsPerEpoch() + 1)
+	)
+
+	genesis := func() (*TestStateProcessorT, *TestBeaconStateT) {
+		sp, st, _, _ := setupState(t, cs)
+		genDeposits := []*types.Deposit{
+			{
+				Pubkey: [48]byte{0x00},
+				Credentials: types.NewCredentialsFromExecutionAddress(
+					common.ExecutionAddress{},
+				),
+				Amount: minBalance + increment,
+				Index:  uint64(0),
+			},
+			{
+				Pubkey: [48]byte{0x01},
+				Credentials: types.NewCredentialsFromExecutionAddress(
+					common.ExecutionAddress{},
+				),
+				Amount: maxBalance,
+				Index:  uint64(1),
+			},
+		}
+		_, err := sp.InitializePreminedBeaconStateFromEth1(
+			st,
+			genDeposits,
+			new(types.ExecutionPayloadHeader).Empty(),
+			version.FromUint32[common.Version](version.Deneb),
+		)
+		require.NoError(t, err)
+
+		// have the first validator ejected at the first epoch turn
+		require.NoError(t, st.DecreaseBalance(0, increment))
+		return sp, st
+	}
+
+	// fast-forward all the slots at once
+	fastSP, fastST := genesis()
+	fastUpdates, err := fastSP.ProcessSlots(fastST, targetSlot)
+	require.NoError(t, err)
+
+	// process slots one at a time
+	slowSP, slowST := genesis()
+	var slowUpdates transition.ValidatorUpdates
+	for slot := math.Slot(1); slot <= targetSlot; slot++ {
+		var updates transition.ValidatorUpdates
+		updates, err = slowSP.ProcessSlots(slowST, slot)
+		require.NoError(t, err)
+		slowUpdates = append(slowUpdates, updates...)
+	}
+
+	require.Equal(t, slowST.HashTreeRoot(), fastST.HashTreeRoot())
+	require.Equal(t,
+		slowUpdates.CanonicalSort(), fastUpdates.CanonicalSort(),
+	)
+
+	// the ejected validator is evicted with a single update
+	require.Len(t, fastUpdates.CanonicalSort(), 1)
+	val, err := fastST.ValidatorByIndex(0)
+	require.NoError(t, err)
+	require.Equal(t, math.Epoch(1), val.WithdrawableEpoch)
+}
+
+// TestProcessSlotsCatchUpAcrossEjectionFork shows that catching up across
+// the ejection fork ejects the validators left below the ejection balance
+// before it, as processing the slots one at a time does.
+func TestProcessSlotsCatchUpAcrossEjectionFork(t *testing.T) {
+	data := spec.BaseSpec()
+	data.DepositEth1ChainID = spec.BetnetEth1ChainID
+	data.SlotsPerEpoch = 2
+	data.EjectionForkEpoch = 2
+	cs, err := chain.NewChainSpec(data)
+	require.NoError(t, err)
+
+	var (
+		maxBalance = math.Gwei(cs.MaxEffectiveBalance())
+		increment  = math.Gwei(cs.EffectiveBalanceIncrement())
+		minBalance = math.Gwei(cs.EjectionBalance())
+		// The boundaries of epochs 0 and 1 come before the fork, the one
+		// of epoch 2 enforces ejections.
+		targetSlot = math.Slot(3*cs.SlotsPerEpoch() + 1)
+	)
+
+	genesis := func() (*TestStateProcessorT, *TestBeaconStateT) {
+		sp, st, _, _ := setupState(t, cs)
+		genDeposits := []*types.Deposit{
+			{
+				Pubkey: [48]byte{0x00},
+				Credentials: types.NewCredentialsFromExecutionAddress(
+					common.ExecutionAddress{},
+				),
+				Amount: minBalance + increment,
+				Index:  uint64(0),
+			},
+			{
+				Pubkey: [48]byte{0x01},
+				Credentials: types.NewCredentialsFromExecutionAddress(
+					common.ExecutionAddress{},
+				),
+				Amount: maxBalance,
+				Index:  uint64(1),
+			},
+		}
+		_, err = sp.InitializePreminedBeaconStateFromEth1(
+			st,
+			genDeposits,
+			new(types.ExecutionPayloadHeader).Empty(),
+			version.FromUint32[common.Version](version.Deneb),
+		)
+		require.NoError(t, err)
+
+		// drop the first validator to the ejection balance at the first
+		// epoch turn, before the fork
+		require.NoError(t, st.DecreaseBalance(0, increment))
+		return sp, st
+	}
+
+	// fast-forward all the slots at once
+	fastSP, fastST := genesis()
+	fastUpdates, err := fastSP.ProcessSlots(fastST, targetSlot)
+	require.NoError(t, err)
+
+	// process slots one at a time
+	slowSP, slowST := genesis()
+	var slowUpdates transition.ValidatorUpdates
+	for slot := math.Slot(1); slot <= targetSlot; slot++ {
+		var updates transition.ValidatorUpdates
+		updates, err = slowSP.ProcessSlots(slowST, slot)
+		require.NoError(t, err)
+		slowUpdates = append(slowUpdates, updates...)
+	}
+
+	require.Equal(t, slowST.HashTreeRoot(), fastST.HashTreeRoot())
+	require.Equal(t,
+		slowUpdates.CanonicalSort(), fastUpdates.CanonicalSort(),
+	)
+
+	// the validator is ejected at the fork, not before
+	val, err := fastST.ValidatorByIndex(0)
+	require.NoError(t, err)
+	require.Equal(t, minBalance, val.EffectiveBalance)
+	require.Equal(t, math.Epoch(3), val.WithdrawableEpoch)
+}
+
+// TestReadOnlySnapshot shows that snapshots cache the roots of the current
+// slot as processing the next slot does, without touching the source state.
+func TestReadOnlySnapshot(t *testing.T) {
+	cs := setupChain(t, components.BetnetChainSpecType)
+	sp, st, _, _ := setupState(t, cs)
+
+	_, err := sp.InitializePreminedBeaconStateFromEth1(
+		st,
+		[]*types.Deposit{{
+			Pubkey: [48]byte{0x00},
+			Credentials: types.NewCredentialsFromExecutionAddress(
+				common.ExecutionAddress{},
+			),
+			Amount: math.Gwei(cs.MaxEffectiveBalance()),
+			Index:  uint64(0),
+		}},
+		new(types.ExecutionPayloadHeader).Empty(),
+		version.FromUint32[common.Version](version.Deneb),
+	)
+	require.NoError(t, err)
+	slot := math.Slot(cs.SlotsPerEpoch() - 1)
+	_, err = sp.ProcessSlots(st, slot)
+	require.NoError(t, err)
+	preRoot := st.HashTreeRoot()
+
+	snapshot, err := sp.ReadOnlySnapshot(st)
+	require.NoError(t, err)
+	require.Equal(t, preRoot, st.HashTreeRoot())
+
+	snapshotSlot, err := snapshot.GetSlot()
+	require.NoError(t, err)
+	require.Equal(t, slot, snapshotSlot)
+
+	// the snapshot serves the roots the next slot processing caches
+	processed := st.Copy()
+	_, err = sp.ProcessSlots(processed, slot+1)
+	require.NoError(t, err)
+
+	idx := slot.Unwrap() % cs.SlotsPerHistoricalRoot()
+	wantStateRoot, err := processed.StateRootAtIndex(idx)
+	require.NoError(t, err)
+	gotStateRoot, err := snapshot.StateRootAtIndex(idx)
+	require.NoError(t, err)
+	require.Equal(t, preRoot, gotStateRoot)
+	require.Equal(t, wantStateRoot, gotStateRoot)
+
+	wantBlockRoot, err := processed.GetBlockRootAtIndex(idx)
+	require.NoError(t, err)
+	gotBlockRoot, err := snapshot.GetBlockRootAtIndex(idx)
+	require.NoError(t, err)
+	require.Equal(t, wantBlockRoot, gotBlockRoot)
+}
+
+// TestPrecomputeNextSlot shows that processing a slot from a precomputed
+// state root leads to the same state, and that a root precomputed for a
+// previous genesis is not reused.
+func TestPrecomputeNextSlot(t *testing.T) {
+	cs := setupChain(t, components.BetnetChainSpecType)
+	maxBalance := math.Gwei(cs.MaxEffectiveBalance())
+
+	genesis := func(
+		amount math.Gwei,
+	) (*TestStateProcessorT, *TestBeaconStateT) {
+		sp, st, _, _ := setupState(t, cs)
+		_, err := sp.InitializePreminedBeaconStateFromEth1(
+			st,
+			[]*types.Deposit{{
+				Pubkey: [48]byte{0x00},
+				Credentials: types.NewCredentialsFromExecutionAddress(
+					common.ExecutionAddress{},
+				),
+				Amount: amount,
+				Index:  uint64(0),
+			}},
+			new(types.ExecutionPayloadHeader).Empty(),
+			version.FromUint32[common.Version](version.Deneb),
+		)
+		require.NoError(t, err)
+		return sp, st
+	}
+
+	// the reference state is processed without precomputation
+	refSP, ref := genesis(maxBalance)
+	_, err := refSP.ProcessSlots(ref, 1)
+	require.NoError(t, err)
+
+	sp, st := genesis(maxBalance)
+	require.NoError(t, sp.PrecomputeNextSlot(st.Copy()))
+	_, err = sp.ProcessSlots(st, 1)
+	require.NoError(t, err)
+	require.Equal(t, ref.HashTreeRoot(), st.HashTreeRoot())
+
+	// a genesis state with a different validator balance has the same slot
+	// and latest block header as the precomputed one
+	_, st = genesis(maxBalance)
+	require.NoError(t, sp.PrecomputeNextSlot(st))
+	lowerBalance := maxBalance - math.Gwei(cs.EffectiveBalanceIncrement())
+	refSP, ref = genesis(lowerBalance)
+	_, err = refSP.ProcessSlots(ref, 1)
+	require.NoError(t, err)
+
+	_, other, _, _ := setupState(t, cs)
+	_, err = sp.InitializePreminedBeaconStateFromEth1(
+		other,
+		[]*types.Deposit{{
+			Pubkey: [48]byte{0x00},
+			Credentials: types.NewCredentialsFromExecutionAddress(
+				common.ExecutionAddress{},
+			),
+			Amount: lowerBalance,
+			Index:  uint64(0),
+		}},
+		new(types.ExecutionPayloadHeader).Empty(),
+		version.FromUint32[common.Version](version.Deneb),
+	)
+	require.NoError(t, err)
+	_, err = sp.ProcessSlots(other, 1)
+	require.NoError(t, err)
+	require.Equal(t, ref.HashTreeRoot(), other.HashTreeRoot())
+}
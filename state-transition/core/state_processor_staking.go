@@ -25,6 +25,7 @@ import (
 	"slices"
 
 	"github.com/berachain/beacon-kit/config/spec"
+	"github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/math"
@@ -32,12 +33,10 @@ import (
 )
 
 // processOperations processes the operations and ensures they match the
-// local state.
-func (sp *StateProcessor[
-	BeaconBlockT, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
-]) processOperations(
+// local state. Application-defined operations are applied last.
+func (sp *StateProcessor[BeaconStateT, _, _]) processOperations(
 	st BeaconStateT,
-	blk BeaconBlockT,
+	blk *types.BeaconBlock,
 ) error {
 	// Verify that outstanding deposits are processed
 	// up to the maximum number of deposits
@@ -56,20 +55,29 @@ func (sp *StateProcessor[
 	if err := sp.validateNonGenesisDeposits(st, deposits); err != nil {
 		return err
 	}
+
+	// Deposits read from now on are pre-verified over the fork data of this
+	// block, and the ones before its deposits are not needed anymore.
+	forkData, err := sp.depositForkData(st)
+	if err != nil {
+		return err
+	}
+	sp.depositSignatures.observe(forkData)
+	if len(deposits) > 0 {
+		sp.depositSignatures.forget(deposits[0].GetIndex().Unwrap())
+	}
 	for _, dep := range deposits {
 		if err := sp.processDeposit(st, dep); err != nil {
 			return err
 		}
 	}
-	return nil
+	return sp.processCustomOperations(st, blk.GetBody().GetOperations())
 }
 
 // processDeposit processes the deposit and ensures it matches the local state.
-func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, DepositT, _, _, _, _, _, _, _, _, _, _, _,
-]) processDeposit(
+func (sp *StateProcessor[BeaconStateT, _, _]) processDeposit(
 	st BeaconStateT,
-	dep DepositT,
+	dep *types.Deposit,
 ) error {
 	slot, err := st.GetSlot()
 	if err != nil {
@@ -107,11 +115,9 @@ func (sp *StateProcessor[
 }
 
 // applyDeposit processes the deposit and ensures it matches the local state.
-func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, DepositT, _, _, _, _, _, _, ValidatorT, _, _, _, _,
-]) applyDeposit(
+func (sp *StateProcessor[BeaconStateT, _, _]) applyDeposit(
 	st BeaconStateT,
-	dep DepositT,
+	dep *types.Deposit,
 ) error {
 	idx, err := st.ValidatorIndexByPubkey(dep.GetPubkey())
 	if err != nil {
@@ -135,16 +141,43 @@ func (sp *StateProcessor[
 }
 
 // createValidator creates a validator if the deposit is valid.
-func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, DepositT, _, _, _, _, ForkDataT, _, _, _, _, _, _,
-]) createValidator(
+func (sp *StateProcessor[BeaconStateT, _, _]) createValidator(
 	st BeaconStateT,
-	dep DepositT,
+	dep *types.Deposit,
 ) error {
+	forkData, err := sp.depositForkData(st)
+	if err != nil {
+		return err
+	}
+
+	// Verify that the message was signed correctly, unless it was
+	// pre-verified.
+	preVerified, err := sp.depositSignatures.Verify(dep, forkData)
+	sp.metrics.countDepositSignature(preVerified)
+	if err != nil {
+		// Ignore deposits that fail the signature check.
+		sp.logger.Info(
+			"failed deposit signature verification",
+			"deposit_index", dep.GetIndex(),
+			"error", err,
+		)
+
+		return nil
+	}
+
+	// Add the validator to the registry.
+	return sp.addValidatorToRegistry(st, dep)
+}
+
+// depositForkData returns the fork data deposits creating a validator are
+// signed over at the current slot of the state.
+func (sp *StateProcessor[_, _, _]) depositForkData(
+	st ReadOnlyState,
+) (*types.ForkData, error) {
 	// Get the current slot.
 	slot, err := st.GetSlot()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// At genesis, the validators sign over an empty root.
@@ -153,47 +186,26 @@ func (sp *StateProcessor[
 		// Get the genesis validators root to be used to find fork data later.
 		genesisValidatorsRoot, err = st.GetGenesisValidatorsRoot()
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 
 	// Get the current epoch.
 	epoch := sp.cs.SlotToEpoch(slot)
 
-	// Verify that the message was signed correctly.
-	var d ForkDataT
-	if err = dep.VerifySignature(
-		d.New(
-			version.FromUint32[common.Version](
-				sp.cs.ActiveForkVersionForEpoch(epoch),
-			), genesisValidatorsRoot,
-		),
-		sp.cs.DomainTypeDeposit(),
-		sp.signer.VerifySignature,
-	); err != nil {
-		// Ignore deposits that fail the signature check.
-		sp.logger.Info(
-			"failed deposit signature verification",
-			"deposit_index", dep.GetIndex(),
-			"error", err,
-		)
-
-		return nil
-	}
-
-	// Add the validator to the registry.
-	return sp.addValidatorToRegistry(st, dep)
+	return types.NewForkData(
+		version.FromUint32[common.Version](
+			sp.cs.ActiveForkVersionForEpoch(epoch),
+		), genesisValidatorsRoot,
+	), nil
 }
 
 // addValidatorToRegistry adds a validator to the registry.
-func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, DepositT, _, _, _, _, _, _, ValidatorT, _, _, _, _,
-]) addValidatorToRegistry(
+func (sp *StateProcessor[BeaconStateT, _, _]) addValidatorToRegistry(
 	st BeaconStateT,
-	dep DepositT,
+	dep *types.Deposit,
 ) error {
-	var candidateVal ValidatorT
-	candidateVal = candidateVal.New(
+	candidateVal := types.NewValidatorFromDeposit(
 		dep.GetPubkey(),
 		dep.GetWithdrawalCredentials(),
 		dep.GetAmount(),
@@ -201,6 +213,24 @@ func (sp *StateProcessor[
 		math.Gwei(sp.cs.MaxEffectiveBalance()),
 	)
 
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+	nextEpoch, err := slot.Epoch(sp.cs.SlotsPerEpoch()).SafeAdd(1)
+	if err != nil {
+		return err
+	}
+
+	// Validators joining below the min activation balance are marked as
+	// withdrawable, so that their deposits are returned next epoch.
+	if sp.enforcesEjection(slot) &&
+		candidateVal.GetEffectiveBalance() <
+			math.Gwei(sp.cs.MinActivationBalance()) {
+		candidateVal.SetWithdrawableEpoch(nextEpoch)
+		return sp.addValidatorInternal(st, candidateVal, dep.GetAmount())
+	}
+
 	// BeaconKit enforces a cap on the validator set size. If the deposit
 	// breaches the cap, we find the validator with the smallest stake and
 	// mark it as withdrawable so that it will be evicted next epoch and
@@ -224,12 +254,6 @@ func (sp *StateProcessor[
 		return err
 	}
 
-	slot, err := st.GetSlot()
-	if err != nil {
-		return err
-	}
-	nextEpoch := sp.cs.SlotToEpoch(slot) + 1
-
 	if candidateVal.GetEffectiveBalance() <= lowestStakeVal.GetEffectiveBalance() {
 		// in case of tie-break among candidate validator we prefer
 		// existing one so we mark candidate as withdrawable
@@ -252,20 +276,23 @@ func (sp *StateProcessor[
 
 // nextEpochValidatorSet returns the current estimation of what next epoch
 // validator set would be.
-func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, ValidatorT, _, _, _, _,
-]) nextEpochValidatorSet(st BeaconStateT) ([]ValidatorT, error) {
+func (sp *StateProcessor[_, _, _]) nextEpochValidatorSet(
+	st ReadOnlyState,
+) ([]*types.Validator, error) {
 	slot, err := st.GetSlot()
 	if err != nil {
 		return nil, err
 	}
-	nextEpoch := sp.cs.SlotToEpoch(slot) + 1
+	nextEpoch, err := slot.Epoch(sp.cs.SlotsPerEpoch()).SafeAdd(1)
+	if err != nil {
+		return nil, err
+	}
 
 	vals, err := st.GetValidators()
 	if err != nil {
 		return nil, err
 	}
-	activeVals := make([]ValidatorT, 0, len(vals))
+	activeVals := make([]*types.Validator, 0, len(vals))
 	for _, val := range vals {
 		if val.GetEffectiveBalance() <= math.U64(sp.cs.EjectionBalance()) {
 			continue
@@ -280,14 +307,12 @@ func (sp *StateProcessor[
 }
 
 // TODO: consider moving this to BeaconState directly
-func (*StateProcessor[
-	_, _, _, _, _, _, _, _, _, _, _, _, ValidatorT, _, _, _, _,
-]) lowestStakeVal(currentVals []ValidatorT) (
-	ValidatorT,
+func (*StateProcessor[_, _, _]) lowestStakeVal(currentVals []*types.Validator) (
+	*types.Validator,
 	error,
 ) {
 	// TODO: consider heapifying slice instead. We only care about the smallest
-	slices.SortFunc(currentVals, func(lhs, rhs ValidatorT) int {
+	slices.SortFunc(currentVals, func(lhs, rhs *types.Validator) int {
 		var (
 			val1Stake = lhs.GetEffectiveBalance()
 			val2Stake = rhs.GetEffectiveBalance()
@@ -309,11 +334,9 @@ func (*StateProcessor[
 	return currentVals[0], nil
 }
 
-func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, ValidatorT, _, _, _, _,
-]) addValidatorInternal(
+func (sp *StateProcessor[BeaconStateT, _, _]) addValidatorInternal(
 	st BeaconStateT,
-	val ValidatorT,
+	val *types.Validator,
 	depositAmount math.Gwei,
 ) error {
 	// TODO: This is a bug that lives on bArtio. Delete this eventually.
@@ -97,6 +97,7 @@ func TestTransitionUpdateValidators(t *testing.T) {
 
 	blk1 := buildNextBlock(
 		t,
+		sp,
 		st,
 		&types.BeaconBlockBody{
 			ExecutionPayload: &types.ExecutionPayload{
@@ -148,6 +149,7 @@ func TestTransitionUpdateValidators(t *testing.T) {
 	for currEpoch == cs.SlotToEpoch(blk.GetSlot()+1) {
 		blk = buildNextBlock(
 			t,
+			sp,
 			st,
 			&types.BeaconBlockBody{
 				ExecutionPayload: &types.ExecutionPayload{
@@ -172,6 +174,7 @@ func TestTransitionUpdateValidators(t *testing.T) {
 	// finally the block turning epoch
 	blk = buildNextBlock(
 		t,
+		sp,
 		st,
 		&types.BeaconBlockBody{
 			ExecutionPayload: &types.ExecutionPayload{
@@ -254,6 +257,7 @@ func TestTransitionCreateValidator(t *testing.T) {
 
 	blk1 := buildNextBlock(
 		t,
+		sp,
 		st,
 		&types.BeaconBlockBody{
 			ExecutionPayload: &types.ExecutionPayload{
@@ -305,6 +309,7 @@ func TestTransitionCreateValidator(t *testing.T) {
 	for currEpoch == cs.SlotToEpoch(blk.GetSlot()+1) {
 		blk = buildNextBlock(
 			t,
+			sp,
 			st,
 			&types.BeaconBlockBody{
 				ExecutionPayload: &types.ExecutionPayload{
@@ -329,6 +334,7 @@ func TestTransitionCreateValidator(t *testing.T) {
 	// finally the block turning epoch
 	blk = buildNextBlock(
 		t,
+		sp,
 		st,
 		&types.BeaconBlockBody{
 			ExecutionPayload: &types.ExecutionPayload{
@@ -349,6 +355,14 @@ func TestTransitionCreateValidator(t *testing.T) {
 	require.NoError(t, err)
 	require.Len(t, newEpochVals, 1) // just added 1 validator
 
+	// the added validator turns active
+	require.Equal(t, []transition.ValidatorStatusChange{{
+		Index:    idx,
+		Pubkey:   blkDeposit.Pubkey,
+		Previous: transition.ValidatorStatusPending,
+		Current:  transition.ValidatorStatusActive,
+	}}, sp.ValidatorStatusChanges(cs.SlotToEpoch(blk.GetSlot())))
+
 	expectedBalance = blkDeposit.Amount
 	expectedEffectiveBalance = expectedBalance
 
@@ -410,6 +424,7 @@ func TestTransitionWithdrawals(t *testing.T) {
 	// Create test inputs.
 	blk := buildNextBlock(
 		t,
+		sp,
 		st,
 		&types.BeaconBlockBody{
 			ExecutionPayload: &types.ExecutionPayload{
@@ -506,6 +521,7 @@ func TestTransitionMaxWithdrawals(t *testing.T) {
 	// Create test inputs.
 	blk := buildNextBlock(
 		t,
+		sp,
 		st,
 		&types.BeaconBlockBody{
 			ExecutionPayload: &types.ExecutionPayload{
@@ -552,6 +568,7 @@ func TestTransitionMaxWithdrawals(t *testing.T) {
 	// appropriately incremented.
 	blk = buildNextBlock(
 		t,
+		sp,
 		st,
 		&types.BeaconBlockBody{
 			ExecutionPayload: &types.ExecutionPayload{
@@ -650,6 +667,7 @@ func TestTransitionHittingValidatorsCap_ExtraSmall(t *testing.T) {
 
 	blk1 := buildNextBlock(
 		t,
+		sp,
 		st,
 		&types.BeaconBlockBody{
 			ExecutionPayload: &types.ExecutionPayload{
@@ -692,6 +710,7 @@ func TestTransitionHittingValidatorsCap_ExtraSmall(t *testing.T) {
 	for currEpoch == cs.SlotToEpoch(blk.GetSlot()+1) {
 		blk = buildNextBlock(
 			t,
+			sp,
 			st,
 			&types.BeaconBlockBody{
 				ExecutionPayload: &types.ExecutionPayload{
@@ -716,6 +735,7 @@ func TestTransitionHittingValidatorsCap_ExtraSmall(t *testing.T) {
 	require.NoError(t, err)
 	blk = buildNextBlock(
 		t,
+		sp,
 		st,
 		&types.BeaconBlockBody{
 			ExecutionPayload: &types.ExecutionPayload{
@@ -813,6 +833,7 @@ func TestTransitionHittingValidatorsCap_ExtraBig(t *testing.T) {
 
 	blk1 := buildNextBlock(
 		t,
+		sp,
 		st,
 		&types.BeaconBlockBody{
 			ExecutionPayload: &types.ExecutionPayload{
@@ -865,6 +886,7 @@ func TestTransitionHittingValidatorsCap_ExtraBig(t *testing.T) {
 	for currEpoch == cs.SlotToEpoch(blk.GetSlot()+1) {
 		blk = buildNextBlock(
 			t,
+			sp,
 			st,
 			&types.BeaconBlockBody{
 				ExecutionPayload: &types.ExecutionPayload{
@@ -888,6 +910,7 @@ func TestTransitionHittingValidatorsCap_ExtraBig(t *testing.T) {
 
 	blk = buildNextBlock(
 		t,
+		sp,
 		st,
 		&types.BeaconBlockBody{
 			ExecutionPayload: &types.ExecutionPayload{
@@ -998,6 +1021,7 @@ func TestTransitionValidatorCap_DoubleEviction(t *testing.T) {
 
 	blk1 := buildNextBlock(
 		t,
+		sp,
 		st,
 		&types.BeaconBlockBody{
 			ExecutionPayload: &types.ExecutionPayload{
@@ -1054,6 +1078,7 @@ func TestTransitionValidatorCap_DoubleEviction(t *testing.T) {
 
 	blk2 := buildNextBlock(
 		t,
+		sp,
 		st,
 		&types.BeaconBlockBody{
 			ExecutionPayload: &types.ExecutionPayload{
@@ -1104,6 +1129,7 @@ func TestTransitionValidatorCap_DoubleEviction(t *testing.T) {
 	for currEpoch == cs.SlotToEpoch(blk.GetSlot()+1) {
 		blk = buildNextBlock(
 			t,
+			sp,
 			st,
 			&types.BeaconBlockBody{
 				ExecutionPayload: &types.ExecutionPayload{
@@ -1127,6 +1153,7 @@ func TestTransitionValidatorCap_DoubleEviction(t *testing.T) {
 
 	blk = buildNextBlock(
 		t,
+		sp,
 		st,
 		&types.BeaconBlockBody{
 			ExecutionPayload: &types.ExecutionPayload{
@@ -1211,3 +1238,347 @@ func generateTestPK(t *testing.T, rndSeed int) (bytes.B48, int) {
 	rndSeed++
 	return key, rndSeed
 }
+
+// TestTransitionEjection shows that validators whose effective balance drops
+// to the ejection balance are made withdrawable at the epoch turn, while
+// balance decreases absorbed by hysteresis do not trigger an ejection.
+// Deposits below the min activation balance are refunded right away.
+func TestTransitionEjection(t *testing.T) {
+	cs := setupChain(t, components.BetnetChainSpecType)
+	sp, st, ds, ctx := setupState(t, cs)
+
+	var (
+		maxBalance    = math.Gwei(cs.MaxEffectiveBalance())
+		increment     = math.Gwei(cs.EffectiveBalanceIncrement())
+		minBalance    = math.Gwei(cs.EjectionBalance())
+		minActivation = math.Gwei(cs.MinActivationBalance())
+		downThreshold = increment / math.Gwei(cs.HysteresisQuotient()) *
+			math.Gwei(cs.HysteresisDownwardMultiplier())
+		emptyAddress     = common.ExecutionAddress{}
+		emptyCredentials = types.NewCredentialsFromExecutionAddress(
+			emptyAddress,
+		)
+	)
+	require.Greater(t, minActivation, minBalance)
+
+	// STEP 0: Setup initial state via genesis
+	var (
+		genDeposits = []*types.Deposit{
+			{
+				Pubkey:      [48]byte{0x00},
+				Credentials: emptyCredentials,
+				Amount:      minBalance + increment,
+				Index:       uint64(0),
+			},
+			{
+				Pubkey:      [48]byte{0x01},
+				Credentials: emptyCredentials,
+				Amount:      minBalance + increment,
+				Index:       uint64(1),
+			},
+			{
+				Pubkey:      [48]byte{0x02},
+				Credentials: emptyCredentials,
+				Amount:      maxBalance,
+				Index:       uint64(2),
+			},
+		}
+		genPayloadHeader = new(types.ExecutionPayloadHeader).Empty()
+		genVersion       = version.FromUint32[common.Version](version.Deneb)
+	)
+	genVals, err := sp.InitializePreminedBeaconStateFromEth1(
+		st,
+		genDeposits,
+		genPayloadHeader,
+		genVersion,
+	)
+	require.NoError(t, err)
+	require.Len(t, genVals, len(genDeposits))
+
+	// STEP 1: reduce the balance of the first validator right past the
+	// hysteresis threshold and of the second one right before it. Also
+	// deposit a new validator below the min activation balance.
+	ejectedIdx, err := st.ValidatorIndexByPubkey(genDeposits[0].Pubkey)
+	require.NoError(t, err)
+	require.NoError(t, st.DecreaseBalance(ejectedIdx, downThreshold+1))
+
+	keptIdx, err := st.ValidatorIndexByPubkey(genDeposits[1].Pubkey)
+	require.NoError(t, err)
+	require.NoError(t, st.DecreaseBalance(keptIdx, downThreshold))
+
+	smallDeposit := &types.Deposit{
+		Pubkey:      [48]byte{0x03},
+		Credentials: emptyCredentials,
+		Amount:      minActivation - increment,
+		Index:       uint64(len(genDeposits)),
+	}
+
+	blk1 := buildNextBlock(
+		t,
+		sp,
+		st,
+		&types.BeaconBlockBody{
+			ExecutionPayload: &types.ExecutionPayload{
+				Timestamp:    10,
+				ExtraData:    []byte("testing"),
+				Transactions: [][]byte{},
+				Withdrawals: []*engineprimitives.Withdrawal{
+					st.EVMInflationWithdrawal(),
+				},
+				BaseFeePerGas: math.NewU256(0),
+			},
+			Eth1Data: &types.Eth1Data{},
+			Deposits: []*types.Deposit{smallDeposit},
+		},
+	)
+
+	// make sure included deposit is already available in deposit store
+	require.NoError(t, ds.EnqueueDeposits(blk1.Body.Deposits))
+
+	vals, err := sp.Transition(ctx, st, blk1)
+	require.NoError(t, err)
+	require.Empty(t, vals) // validators set updates only at epoch turn
+
+	// the small validator is added but marked as withdrawable next epoch
+	smallIdx, err := st.ValidatorIndexByPubkey(smallDeposit.Pubkey)
+	require.NoError(t, err)
+	smallVal, err := st.ValidatorByIndex(smallIdx)
+	require.NoError(t, err)
+	require.Equal(t, math.Epoch(1), smallVal.WithdrawableEpoch)
+
+	// no ejection happens before the effective balances are updated
+	ejectedVal, err := st.ValidatorByIndex(ejectedIdx)
+	require.NoError(t, err)
+	require.Equal(t,
+		math.Epoch(constants.FarFutureEpoch), ejectedVal.WithdrawableEpoch,
+	)
+
+	// STEP 2: move the chain to the epoch turn
+	blk := blk1
+	currEpoch := cs.SlotToEpoch(blk.GetSlot())
+	for currEpoch == cs.SlotToEpoch(blk.GetSlot()+1) {
+		blk = buildNextBlock(
+			t,
+			sp,
+			st,
+			&types.BeaconBlockBody{
+				ExecutionPayload: &types.ExecutionPayload{
+					Timestamp:    blk.Body.ExecutionPayload.Timestamp + 1,
+					ExtraData:    []byte("testing"),
+					Transactions: [][]byte{},
+					Withdrawals: []*engineprimitives.Withdrawal{
+						st.EVMInflationWithdrawal(),
+					},
+					BaseFeePerGas: math.NewU256(0),
+				},
+				Eth1Data: &types.Eth1Data{},
+				Deposits: []*types.Deposit{},
+			},
+		)
+
+		vals, err = sp.Transition(ctx, st, blk)
+		require.NoError(t, err)
+		require.Empty(t, vals) // validators set updates only at epoch turn
+	}
+
+	// the block turning epoch withdraws both the ejected and the small
+	// validators in full, following the withdrawals sweep order
+	blk = buildNextBlock(
+		t,
+		sp,
+		st,
+		&types.BeaconBlockBody{
+			ExecutionPayload: &types.ExecutionPayload{
+				Timestamp:    blk.Body.ExecutionPayload.Timestamp + 1,
+				ExtraData:    []byte("testing"),
+				Transactions: [][]byte{},
+				Withdrawals: []*engineprimitives.Withdrawal{
+					st.EVMInflationWithdrawal(),
+					{
+						Index:     0,
+						Validator: smallIdx,
+						Address:   emptyAddress,
+						Amount:    smallDeposit.Amount,
+					},
+					{
+						Index:     1,
+						Validator: ejectedIdx,
+						Address:   emptyAddress,
+						Amount:    genDeposits[0].Amount - downThreshold - 1,
+					},
+				},
+				BaseFeePerGas: math.NewU256(0),
+			},
+			Eth1Data: &types.Eth1Data{},
+			Deposits: []*types.Deposit{},
+		},
+	)
+
+	vals, err = sp.Transition(ctx, st, blk)
+	require.NoError(t, err)
+	require.Len(t, vals, 1) // just the ejected validator
+	require.Equal(t, genDeposits[0].Pubkey, vals[0].Pubkey)
+	require.Equal(t, math.Gwei(0), vals[0].EffectiveBalance)
+
+	// the ejected validator turns exiting, while the small one never was
+	// active
+	require.Equal(t, []transition.ValidatorStatusChange{{
+		Index:    ejectedIdx,
+		Pubkey:   genDeposits[0].Pubkey,
+		Previous: transition.ValidatorStatusActive,
+		Current:  transition.ValidatorStatusExiting,
+	}}, sp.ValidatorStatusChanges(cs.SlotToEpoch(blk.GetSlot())))
+
+	ejectedVal, err = st.ValidatorByIndex(ejectedIdx)
+	require.NoError(t, err)
+	require.Equal(t, minBalance, ejectedVal.EffectiveBalance)
+	require.Equal(t, math.Epoch(1), ejectedVal.WithdrawableEpoch)
+
+	// hysteresis absorbs the smaller decrease, so no ejection happens
+	keptVal, err := st.ValidatorByIndex(keptIdx)
+	require.NoError(t, err)
+	require.Equal(t, genDeposits[1].Amount, keptVal.EffectiveBalance)
+	require.Equal(t,
+		math.Epoch(constants.FarFutureEpoch), keptVal.WithdrawableEpoch,
+	)
+}
+
+// TestTransitionEjectionFork replays the same blocks on a chain which never
+// enters the ejection fork and on one entering it at epoch 2. The states
+// before the fork are unchanged, while from the fork on validators at the
+// ejection balance and deposits below the min activation balance are made
+// withdrawable.
+func TestTransitionEjectionFork(t *testing.T) {
+	const (
+		forkEpoch = math.Epoch(2)
+		lastSlot  = math.Slot(6)
+	)
+
+	var (
+		csData        = spec.BaseSpec()
+		maxBalance    = math.Gwei(csData.MaxEffectiveBalance)
+		increment     = math.Gwei(csData.EffectiveBalanceIncrement)
+		minBalance    = math.Gwei(csData.EjectionBalance)
+		minActivation = math.Gwei(csData.MinActivationBalance)
+		downThreshold = increment / math.Gwei(csData.HysteresisQuotient) *
+			math.Gwei(csData.HysteresisDownwardMultiplier)
+		credentials = types.NewCredentialsFromExecutionAddress(
+			common.ExecutionAddress{},
+		)
+		genDeposits = []*types.Deposit{
+			{
+				Pubkey:      [48]byte{0x00},
+				Credentials: credentials,
+				Amount:      minBalance + increment,
+				Index:       0,
+			},
+			{
+				Pubkey:      [48]byte{0x01},
+				Credentials: credentials,
+				Amount:      maxBalance,
+				Index:       1,
+			},
+		}
+		// A deposit below the min activation balance before the fork, and
+		// one after it.
+		blkDeposits = map[math.Slot]*types.Deposit{
+			1: {
+				Pubkey:      [48]byte{0x02},
+				Credentials: credentials,
+				Amount:      minActivation - increment,
+				Index:       2,
+			},
+			4: {
+				Pubkey:      [48]byte{0x03},
+				Credentials: credentials,
+				Amount:      minActivation - increment,
+				Index:       3,
+			},
+		}
+	)
+
+	replay := func(
+		ejectionForkEpoch math.Epoch,
+	) ([]common.Root, *TestBeaconStateT) {
+		data := spec.BaseSpec()
+		data.DepositEth1ChainID = spec.BetnetEth1ChainID
+		data.SlotsPerEpoch = 2
+		data.EjectionForkEpoch = ejectionForkEpoch
+		cs, err := chain.NewChainSpec(data)
+		require.NoError(t, err)
+		sp, st, ds, ctx := setupState(t, cs)
+
+		_, err = sp.InitializePreminedBeaconStateFromEth1(
+			st,
+			genDeposits,
+			new(types.ExecutionPayloadHeader).Empty(),
+			version.FromUint32[common.Version](version.Deneb),
+		)
+		require.NoError(t, err)
+		// Drop the first validator to the ejection balance at the first
+		// effective balance update.
+		require.NoError(t, st.DecreaseBalance(0, downThreshold+1))
+
+		roots := make([]common.Root, 0, lastSlot)
+		for slot := math.Slot(1); slot <= lastSlot; slot++ {
+			deposits := []*types.Deposit{}
+			if dep, ok := blkDeposits[slot]; ok {
+				deposits = append(deposits, dep)
+			}
+			require.NoError(t, ds.EnqueueDeposits(deposits))
+
+			// Withdrawals are the ones expected once the slot is reached,
+			// as a proposer would build them.
+			next := st.Copy()
+			_, err = sp.ProcessSlots(next, slot)
+			require.NoError(t, err)
+			withdrawals, err := next.ExpectedWithdrawals()
+			require.NoError(t, err)
+
+			blk := buildNextBlock(t, sp, st, &types.BeaconBlockBody{
+				ExecutionPayload: &types.ExecutionPayload{
+					Timestamp:     10 + slot,
+					ExtraData:     []byte("testing"),
+					Transactions:  [][]byte{},
+					Withdrawals:   withdrawals,
+					BaseFeePerGas: math.NewU256(0),
+				},
+				Eth1Data: &types.Eth1Data{},
+				Deposits: deposits,
+			})
+			_, err = sp.Transition(ctx, st, blk)
+			require.NoError(t, err)
+			roots = append(roots, st.HashTreeRoot())
+		}
+		return roots, st
+	}
+
+	legacyRoots, legacySt := replay(math.Epoch(constants.FarFutureEpoch))
+	forkRoots, forkSt := replay(forkEpoch)
+
+	// The states before the fork epoch are unchanged.
+	forkSlot := forkEpoch.Unwrap() * 2
+	require.Equal(t, legacyRoots[:forkSlot-1], forkRoots[:forkSlot-1])
+	require.NotEqual(t, legacyRoots[forkSlot-1], forkRoots[forkSlot-1])
+
+	withdrawableEpochs := func(st *TestBeaconStateT) []math.Epoch {
+		vals, err := st.GetValidators()
+		require.NoError(t, err)
+		epochs := make([]math.Epoch, len(vals))
+		for i, val := range vals {
+			epochs[i] = val.GetWithdrawableEpoch()
+		}
+		return epochs
+	}
+	farFuture := math.Epoch(constants.FarFutureEpoch)
+	require.Equal(t,
+		[]math.Epoch{farFuture, farFuture, farFuture, farFuture},
+		withdrawableEpochs(legacySt),
+	)
+	// The validator which joined below the min activation balance before
+	// the fork is ejected with the first one.
+	require.Equal(t,
+		[]math.Epoch{3, farFuture, 3, 3},
+		withdrawableEpochs(forkSt),
+	)
+}
@@ -21,18 +21,82 @@
 package core
 
 import (
+	"cmp"
+	"slices"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/primitives/bytes"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/primitives/transition"
 	"github.com/sourcegraph/conc/iter"
 )
 
+// statusChangesEpochs is the number of epochs the validator status changes
+// are kept for past the latest epoch processed. It bounds the number of epochs
+// a block catching up on empty slots can turn without losing the changes of
+// the first ones.
+const statusChangesEpochs = 32
+
+// processEjections initiates the exit of the validators whose effective
+// balance fell to the ejection balance, marking them as withdrawable next
+// epoch so that their remaining balance is returned.
+func (sp *StateProcessor[BeaconStateT, _, _]) processEjections(
+	st BeaconStateT,
+) error {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+	if !sp.enforcesEjection(slot) {
+		return nil
+	}
+	nextEpoch, err := slot.Epoch(sp.cs.SlotsPerEpoch()).SafeAdd(1)
+	if err != nil {
+		return err
+	}
+
+	validators, err := st.GetValidators()
+	if err != nil {
+		return err
+	}
+	ejectionBalance := math.Gwei(sp.cs.EjectionBalance())
+	for i, val := range validators {
+		if val.GetEffectiveBalance() > ejectionBalance ||
+			val.GetWithdrawableEpoch() != math.Epoch(constants.FarFutureEpoch) {
+			continue
+		}
+
+		val.SetWithdrawableEpoch(nextEpoch)
+		idx := math.ValidatorIndex(i)
+		if err = st.UpdateValidatorAtIndex(idx, val); err != nil {
+			return err
+		}
+		sp.logger.Info(
+			"Ejecting validator below the ejection balance",
+			"validator_index", idx,
+			"effective_balance", val.GetEffectiveBalance(),
+			"withdrawable_epoch", nextEpoch,
+		)
+	}
+	return nil
+}
+
+// enforcesEjection returns true if validators below the ejection or the min
+// activation balance are made withdrawable at the given slot. Before the
+// ejection fork, and on legacy networks, they are kept in the registry,
+// without ever returning their deposits.
+func (sp *StateProcessor[_, _, _]) enforcesEjection(slot math.Slot) bool {
+	return !sp.isLegacyEpochProcessing(slot) &&
+		sp.cs.SlotToEpoch(slot) >= sp.cs.EjectionForkEpoch()
+}
+
 // processValidatorsSetUpdates returns the validators set updates that
 // will be used by consensus.
-func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, ValidatorT, _, _, _, _,
-]) processValidatorsSetUpdates(
-	st BeaconStateT,
+func (sp *StateProcessor[_, _, _]) processValidatorsSetUpdates(
+	st ReadOnlyState,
 ) (transition.ValidatorUpdates, error) {
 	// at this state slot has not been updated yet so
 	// we pick nextEpochValidatorSet
@@ -52,35 +116,134 @@ func (sp *StateProcessor[
 
 	// prevEpoch is calculated assuming current block
 	// will turn epoch but we have not update slot yet
-	prevEpoch := sp.cs.SlotToEpoch(slot)
-	currEpoch := prevEpoch + 1
+	prevEpoch := slot.Epoch(sp.cs.SlotsPerEpoch())
+	currEpoch, err := prevEpoch.SafeAdd(1)
+	if err != nil {
+		return nil, err
+	}
 	if slot == 0 {
 		currEpoch = 0 // prevEpoch for genesis is zero
 	}
-	prevEpochVals := sp.valSetByEpoch[prevEpoch] // picks nil if it's genesis
+	// picks nil if it's genesis
+	prevEpochVals, prevEpochKnown := sp.valSetByEpoch[prevEpoch]
 
 	// calculate diff
 	res := sp.validatorSetsDiffs(prevEpochVals, activeVals)
 
+	// The status changes are only known if the previous set is, which is
+	// not the case for the genesis or for the first epoch turned after a
+	// restart.
+	if prevEpochKnown {
+		changes, cErr := validatorStatusChanges(st, prevEpochVals, activeVals)
+		if cErr != nil {
+			return nil, cErr
+		}
+		sp.statusChangesByEpoch[currEpoch] = changes
+	}
+	for epoch := range sp.statusChangesByEpoch {
+		if epoch+statusChangesEpochs < currEpoch {
+			delete(sp.statusChangesByEpoch, epoch)
+		}
+	}
+
+	// precompute the proposer lookup table for the upcoming epoch, reusing
+	// the previous one if the validators set is unchanged, as it happens
+	// for most epochs crossed while catching up on empty slots.
+	addrs, found := sp.proposerAddrsByEpoch[prevEpoch]
+	if !found || len(res) != 0 {
+		addrs, err = sp.proposerAddresses(st, activeVals)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// clear up sets we won't lookup to anymore
 	sp.valSetByEpoch[currEpoch] = activeVals
+	sp.proposerAddrsByEpoch[currEpoch] = addrs
 	if prevEpoch >= 1 {
 		delete(sp.valSetByEpoch, prevEpoch-1)
+		delete(sp.proposerAddrsByEpoch, prevEpoch-1)
 	}
 	return res, nil
 }
 
+// ProposerAddressAt returns the consensus address of the validator at the
+// given index, as cached for the given epoch. The returned slice must not be
+// modified.
+func (sp *StateProcessor[_, _, _]) ProposerAddressAt(
+	epoch math.Epoch,
+	index math.ValidatorIndex,
+) ([]byte, error) {
+	sp.valSetMu.RLock()
+	defer sp.valSetMu.RUnlock()
+	addr, found := sp.proposerAddrsByEpoch[epoch][index]
+	if !found {
+		return nil, errors.Wrapf(
+			ErrProposerAddressNotFound, "epoch: %d, index: %d",
+			epoch, index,
+		)
+	}
+	return addr, nil
+}
+
+// proposerAddresses computes the proposer lookup table for the given active
+// validators.
+func (sp *StateProcessor[_, _, _]) proposerAddresses(
+	st ReadOnlyState,
+	activeVals []*types.Validator,
+) (map[math.ValidatorIndex][]byte, error) {
+	addrs := make(map[math.ValidatorIndex][]byte, len(activeVals))
+	for _, val := range activeVals {
+		idx, err := st.ValidatorIndexByPubkey(val.GetPubkey())
+		if err != nil {
+			return nil, err
+		}
+		if addrs[idx], err = sp.fGetAddressFromPubKey(
+			val.GetPubkey(),
+		); err != nil {
+			return nil, err
+		}
+	}
+	return addrs, nil
+}
+
+// proposerAddress returns the consensus address of the proposer at the given
+// index, using the lookup table for the given epoch. Proposers missing from
+// the table, e.g. after a restart, are converted and added to it.
+func (sp *StateProcessor[_, _, _]) proposerAddress(
+	epoch math.Epoch,
+	index math.ValidatorIndex,
+	pubkey crypto.BLSPubkey,
+) ([]byte, error) {
+	if addr, err := sp.ProposerAddressAt(epoch, index); err == nil {
+		return addr, nil
+	}
+
+	addr, err := sp.fGetAddressFromPubKey(pubkey)
+	if err != nil {
+		return nil, err
+	}
+
+	sp.valSetMu.Lock()
+	defer sp.valSetMu.Unlock()
+	addrs, ok := sp.proposerAddrsByEpoch[epoch]
+	if !ok {
+		addrs = make(map[math.ValidatorIndex][]byte)
+		sp.proposerAddrsByEpoch[epoch] = addrs
+	}
+	addrs[index] = addr
+	return addr, nil
+}
+
 // Note: validatorSetsDiffs does not need to be a StateProcessor method
 // but it helps simplifying generic instantiation.
-func (*StateProcessor[
-	_, _, _, _, _, _, _, _, _, _, _, _, ValidatorT, _, _, _, _,
-]) validatorSetsDiffs(
-	prevEpochValidators []ValidatorT,
-	currEpochValidator []ValidatorT,
+func (*StateProcessor[_, _, _]) validatorSetsDiffs(
+	prevEpochValidators []*types.Validator,
+	currEpochValidator []*types.Validator,
 ) transition.ValidatorUpdates {
 	currentValSet := iter.Map(
 		currEpochValidator,
-		func(val *ValidatorT) *transition.ValidatorUpdate {
+		func(val **types.Validator) *transition.ValidatorUpdate {
 			v := (*val)
 			return &transition.ValidatorUpdate{
 				Pubkey:           v.GetPubkey(),
@@ -124,3 +287,74 @@ func (*StateProcessor[
 	}
 	return res
 }
+
+// ValidatorStatusChanges returns the validator status changes produced by
+// the processing of the given epoch, nil if the epoch has not been processed
+// lately or if the previous validator set was unknown when it was.
+func (sp *StateProcessor[_, _, _]) ValidatorStatusChanges(
+	epoch math.Epoch,
+) []transition.ValidatorStatusChange {
+	sp.valSetMu.RLock()
+	defer sp.valSetMu.RUnlock()
+	return sp.statusChangesByEpoch[epoch]
+}
+
+// validatorStatusChanges returns the status changes between the validator
+// sets of two consecutive epochs. Validators joining the set turn active
+// from pending, and validators leaving it turn exiting, or slashed if they
+// were slashed. Changes are sorted by validator index.
+func validatorStatusChanges(
+	st ReadOnlyState,
+	prevEpochValidators []*types.Validator,
+	currEpochValidators []*types.Validator,
+) ([]transition.ValidatorStatusChange, error) {
+	prevVals := make(map[crypto.BLSPubkey]struct{}, len(prevEpochValidators))
+	for _, v := range prevEpochValidators {
+		prevVals[v.GetPubkey()] = struct{}{}
+	}
+
+	var changes []transition.ValidatorStatusChange
+	for _, v := range currEpochValidators {
+		pk := v.GetPubkey()
+		if _, found := prevVals[pk]; found {
+			delete(prevVals, pk)
+			continue
+		}
+		changes = append(changes, transition.ValidatorStatusChange{
+			Pubkey:   pk,
+			Previous: transition.ValidatorStatusPending,
+			Current:  transition.ValidatorStatusActive,
+		})
+	}
+	// prevVals now contains the validators leaving the set only, whose
+	// slashing is read from the state below.
+	for pk := range prevVals {
+		changes = append(changes, transition.ValidatorStatusChange{
+			Pubkey:   pk,
+			Previous: transition.ValidatorStatusActive,
+			Current:  transition.ValidatorStatusExiting,
+		})
+	}
+
+	for i := range changes {
+		idx, err := st.ValidatorIndexByPubkey(changes[i].Pubkey)
+		if err != nil {
+			return nil, err
+		}
+		changes[i].Index = idx
+		if changes[i].Current != transition.ValidatorStatusExiting {
+			continue
+		}
+		val, err := st.ValidatorByIndex(idx)
+		if err != nil {
+			return nil, err
+		}
+		if val.IsSlashed() {
+			changes[i].Current = transition.ValidatorStatusSlashed
+		}
+	}
+	slices.SortFunc(changes, func(a, b transition.ValidatorStatusChange) int {
+		return cmp.Compare(a.Index, b.Index)
+	})
+	return changes, nil
+}
@@ -24,6 +24,8 @@ import (
 	"fmt"
 
 	"github.com/berachain/beacon-kit/config/spec"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
 	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/state-transition/core/state"
@@ -40,11 +42,9 @@ import (
 // one
 //
 //nolint:lll // TODO: Simplify when dropping special cases.
-func (sp *StateProcessor[
-	BeaconBlockT, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
-]) processWithdrawals(
+func (sp *StateProcessor[BeaconStateT, _, _]) processWithdrawals(
 	st BeaconStateT,
-	blk BeaconBlockT,
+	blk *types.BeaconBlock,
 ) error {
 	// Dequeue and verify the logs.
 	var (
@@ -63,13 +63,10 @@ func (sp *StateProcessor[
 		st, expectedWithdrawals, payloadWithdrawals)
 }
 
-func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, _, _, _, _, _,
-	_, _, _, _, WithdrawalT, WithdrawalsT, _,
-]) processWithdrawalsByFork(
+func (sp *StateProcessor[BeaconStateT, _, _]) processWithdrawalsByFork(
 	st BeaconStateT,
-	expectedWithdrawals []WithdrawalT,
-	payloadWithdrawals []WithdrawalT,
+	expectedWithdrawals []*engineprimitives.Withdrawal,
+	payloadWithdrawals []*engineprimitives.Withdrawal,
 ) error {
 	slot, err := st.GetSlot()
 	if err != nil {
@@ -131,13 +128,10 @@ func (sp *StateProcessor[
 	}
 }
 
-func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, _, _, _, _, _,
-	_, _, _, _, WithdrawalT, WithdrawalsT, _,
-]) processWithdrawalsBartio(
+func (sp *StateProcessor[BeaconStateT, _, _]) processWithdrawalsBartio(
 	st BeaconStateT,
-	expectedWithdrawals []WithdrawalT,
-	payloadWithdrawals []WithdrawalT,
+	expectedWithdrawals []*engineprimitives.Withdrawal,
+	payloadWithdrawals []*engineprimitives.Withdrawal,
 	slot math.Slot,
 ) error {
 	for i, wd := range expectedWithdrawals {
@@ -199,13 +193,10 @@ func (sp *StateProcessor[
 	return st.SetNextWithdrawalValidatorIndex(nextValidatorIndex)
 }
 
-func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, _, _, _, _, _,
-	_, _, _, _, WithdrawalT, WithdrawalsT, _,
-]) processWithdrawalsDefault(
+func (sp *StateProcessor[BeaconStateT, _, _]) processWithdrawalsDefault(
 	st BeaconStateT,
-	expectedWithdrawals []WithdrawalT,
-	payloadWithdrawals []WithdrawalT,
+	expectedWithdrawals []*engineprimitives.Withdrawal,
+	payloadWithdrawals []*engineprimitives.Withdrawal,
 	slot math.Slot,
 ) error {
 	// Enforce that first withdrawal is EVM inflation
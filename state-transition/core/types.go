@@ -23,85 +23,15 @@ package core
 import (
 	stdbytes "bytes"
 	"context"
+	"time"
 
 	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
 	"github.com/berachain/beacon-kit/primitives/bytes"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/constraints"
-	"github.com/berachain/beacon-kit/primitives/crypto"
-	"github.com/berachain/beacon-kit/primitives/eip4844"
 	"github.com/berachain/beacon-kit/primitives/math"
-	"github.com/karalabe/ssz"
 )
 
-// BeaconBlock represents a generic interface for a beacon block.
-type BeaconBlock[
-	DepositT any,
-	BeaconBlockBodyT BeaconBlockBody[
-		BeaconBlockBodyT, DepositT,
-		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
-	],
-	ExecutionPayloadT ExecutionPayload[
-		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
-	],
-	ExecutionPayloadHeaderT ExecutionPayloadHeader,
-	WithdrawalsT any,
-] interface {
-	IsNil() bool
-	// GetProposerIndex returns the index of the proposer.
-	GetProposerIndex() math.ValidatorIndex
-	// GetSlot returns the slot number of the block.
-	GetSlot() math.Slot
-	// GetBody returns the body of the block.
-	GetBody() BeaconBlockBodyT
-	// GetParentBlockRoot returns the root of the parent block.
-	GetParentBlockRoot() common.Root
-	// GetStateRoot returns the state root of the block.
-	GetStateRoot() common.Root
-}
-
-// BeaconBlockBody represents a generic interface for the body of a beacon
-// block.
-type BeaconBlockBody[
-	BeaconBlockBodyT any,
-	DepositT any,
-	ExecutionPayloadT ExecutionPayload[
-		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
-	],
-	ExecutionPayloadHeaderT ExecutionPayloadHeader,
-	WithdrawalsT any,
-] interface {
-	constraints.EmptyWithVersion[BeaconBlockBodyT]
-	// GetRandaoReveal returns the RANDAO reveal signature.
-	GetRandaoReveal() crypto.BLSSignature
-	// GetExecutionPayload returns the execution payload.
-	GetExecutionPayload() ExecutionPayloadT
-	// GetDeposits returns the list of deposits.
-	GetDeposits() []DepositT
-	// HashTreeRoot returns the hash tree root of the block body.
-	HashTreeRoot() common.Root
-	// GetBlobKzgCommitments returns the KZG commitments for the blobs.
-	GetBlobKzgCommitments() eip4844.KZGCommitments[common.ExecutionHash]
-}
-
-// BeaconBlockHeader is the interface for a beacon block header.
-type BeaconBlockHeader[BeaconBlockHeaderT any] interface {
-	New(
-		slot math.Slot,
-		proposerIndex math.ValidatorIndex,
-		parentBlockRoot common.Root,
-		stateRoot common.Root,
-		bodyRoot common.Root,
-	) BeaconBlockHeaderT
-	HashTreeRoot() common.Root
-	GetSlot() math.Slot
-	GetProposerIndex() math.ValidatorIndex
-	GetParentBlockRoot() common.Root
-	GetStateRoot() common.Root
-	GetBodyRoot() common.Root
-	SetStateRoot(common.Root)
-}
-
 // Context defines an interface for managing state transition context.
 type Context interface {
 	context.Context
@@ -127,35 +57,10 @@ type Context interface {
 	GetConsensusTime() math.U64
 }
 
-// Deposit is the interface for a deposit.
-type Deposit[
-	DepositT any,
-	ForkDataT any,
-	WithdrawlCredentialsT ~[32]byte,
-] interface {
-	// Equals returns true if the Deposit is equal to the other.
-	Equals(DepositT) bool
-	// GetAmount returns the amount of the deposit.
-	GetAmount() math.Gwei
-	// GetPubkey returns the public key of the validator.
-	GetPubkey() crypto.BLSPubkey
-	// GetWithdrawalCredentials returns the withdrawal credentials.
-	GetWithdrawalCredentials() WithdrawlCredentialsT
-	// GetIndex returns deposit index
-	GetIndex() math.U64
-	// VerifySignature verifies the deposit and creates a validator.
-	VerifySignature(
-		forkData ForkDataT,
-		domainType common.DomainType,
-		signatureVerificationFn func(
-			pubkey crypto.BLSPubkey,
-			message []byte, signature crypto.BLSSignature,
-		) error,
-	) error
-}
-
 // DepositStore defines the interface for deposit storage.
 type DepositStore[DepositT any] interface {
+	// EnqueueDeposits adds the deposits to the store.
+	EnqueueDeposits(deposits []DepositT) error
 	// GetDepositsByIndex returns `numView` expected deposits.
 	GetDepositsByIndex(
 		startIndex uint64,
@@ -187,11 +92,6 @@ type ExecutionPayload[
 	ToHeader() (ExecutionPayloadHeaderT, error)
 }
 
-type ExecutionPayloadHeader interface {
-	GetBlockHash() common.ExecutionHash
-	GetTimestamp() math.U64
-}
-
 // Withdrawals defines the interface for managing withdrawal operations.
 type Withdrawals interface {
 	Len() int
@@ -213,67 +113,15 @@ type ExecutionEngine[
 	) error
 }
 
-// ForkData is the interface for the fork data.
-type ForkData[ForkDataT any] interface {
-	// New creates a new fork data object.
-	New(common.Version, common.Root) ForkDataT
-	// ComputeRandaoSigningRoot returns the signing root for the fork data.
-	ComputeRandaoSigningRoot(
-		domainType common.DomainType,
-		epoch math.Epoch,
-	) common.Root
-}
-
-// Validator represents an interface for a validator with generic type
-// ValidatorT.
-type Validator[
-	ValidatorT any,
-	WithdrawalCredentialsT ~[32]byte,
-] interface {
-	constraints.SSZMarshallableRootable
-	SizeSSZ(*ssz.Sizer) uint32
-	// New creates a new validator with the given parameters.
-	New(
-		pubkey crypto.BLSPubkey,
-		withdrawalCredentials WithdrawalCredentialsT,
-		amount math.Gwei,
-		effectiveBalanceIncrement math.Gwei,
-		maxEffectiveBalance math.Gwei,
-	) ValidatorT
-	// IsSlashed returns true if the validator is slashed.
-	IsSlashed() bool
-	// GetPubkey returns the public key of the validator.
-	GetPubkey() crypto.BLSPubkey
-	// GetEffectiveBalance returns the effective balance of the validator in
-	// Gwei.
-	GetEffectiveBalance() math.Gwei
-	// SetEffectiveBalance sets the effective balance of the validator in Gwei.
-	SetEffectiveBalance(math.Gwei)
-	// GetWithdrawableEpoch returns the epoch when the validator can withdraw.
-	GetWithdrawableEpoch() math.Epoch
-	// SetWithdrawableEpoch sets the epoch when the validator can withdraw.
-	SetWithdrawableEpoch(math.Epoch)
-}
-
-type Validators interface {
-	HashTreeRoot() common.Root
-}
-
-// Withdrawal is the interface for a withdrawal.
-type Withdrawal[WithdrawalT any] interface {
-	// Equals returns true if the withdrawal is equal to the other.
-	Equals(WithdrawalT) bool
-	// GetAmount returns the amount of the withdrawal.
-	GetAmount() math.Gwei
-	// GetIndex returns the public key of the validator.
-	GetIndex() math.U64
-	// GetValidatorIndex returns the index of the validator.
-	GetValidatorIndex() math.ValidatorIndex
-	// GetAddress returns the address of the withdrawal.
-	GetAddress() common.ExecutionAddress
-}
-
 // TelemetrySink is an interface for sending metrics to a telemetry backend.
 type TelemetrySink interface {
+	// SetGauge sets a gauge metric to the specified value, identified by the
+	// provided keys.
 	SetGauge(key string, value int64, args ...string)
+	// MeasureSince measures the time since the provided start time,
+	// identified by the provided keys.
+	MeasureSince(key string, start time.Time, args ...string)
+	// IncrementCounter increments a counter metric identified by the
+	// provided keys.
+	IncrementCounter(key string, args ...string)
 }
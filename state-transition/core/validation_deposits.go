@@ -24,16 +24,14 @@ import (
 	"fmt"
 
 	"github.com/berachain/beacon-kit/config/spec"
+	"github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/primitives/math"
 )
 
-func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, DepositT,
-	_, _, _, _, _, _, _, _, _, _, _,
-]) validateGenesisDeposits(
-	st BeaconStateT,
-	deposits []DepositT,
+func (sp *StateProcessor[_, _, _]) validateGenesisDeposits(
+	st ReadOnlyState,
+	deposits []*types.Deposit,
 ) error {
 	switch {
 	case sp.cs.DepositEth1ChainID() == spec.BartioChainID:
@@ -98,12 +96,9 @@ func (sp *StateProcessor[
 	}
 }
 
-func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, DepositT,
-	_, _, _, _, _, _, _, _, _, _, _,
-]) validateNonGenesisDeposits(
-	st BeaconStateT,
-	deposits []DepositT,
+func (sp *StateProcessor[_, _, _]) validateNonGenesisDeposits(
+	st ReadOnlyState,
+	deposits []*types.Deposit,
 ) error {
 	slot, err := st.GetSlot()
 	if err != nil {
@@ -133,7 +128,7 @@ func (sp *StateProcessor[
 		}
 		expectedStartIdx := depositIndex + 1
 
-		var localDeposits []DepositT
+		var localDeposits []*types.Deposit
 		localDeposits, err = sp.ds.GetDepositsByIndex(
 			expectedStartIdx,
 			sp.cs.MaxDepositsPerBlock(),
@@ -20,6 +20,8 @@
 
 package beacondb
 
+import "github.com/berachain/beacon-kit/primitives/common"
+
 // GetLatestExecutionPayloadHeader retrieves the latest execution payload
 // header from the BeaconStore.
 func (kv *KVStore[
@@ -89,3 +91,30 @@ func (kv *KVStore[
 ) error {
 	return kv.eth1Data.Set(kv.ctx, data)
 }
+
+// GetDepositBranchAtIndex retrieves the node of the deposit tree branch at
+// the given height from the beacon state.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) GetDepositBranchAtIndex(
+	index uint64,
+) (common.Root, error) {
+	bz, err := kv.depositBranch.Get(kv.ctx, index)
+	if err != nil {
+		return common.Root{}, err
+	}
+	return common.Root(bz), nil
+}
+
+// UpdateDepositBranchAtIndex sets the node of the deposit tree branch at the
+// given height in the beacon state.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) UpdateDepositBranchAtIndex(
+	index uint64,
+	node common.Root,
+) error {
+	return kv.depositBranch.Set(kv.ctx, index, node[:])
+}
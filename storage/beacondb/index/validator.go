@@ -23,6 +23,7 @@ package index
 import (
 	sdkcollections "cosmossdk.io/collections"
 	"cosmossdk.io/collections/indexes"
+	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/constraints"
 	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/primitives/math"
@@ -35,6 +36,7 @@ const (
 	validatorPubkeyToIndexPrefix           = "val_pk_to_idx"
 	validatorConsAddrToIndexPrefix         = "val_cons_addr_to_idx"
 	validatorEffectiveBalanceToIndexPrefix = "val_eff_bal_to_idx"
+	validatorWithdrawalAddrToIndexPrefix   = "val_wd_addr_to_idx"
 )
 
 // Validator is an interface that combines the ssz.Marshaler and
@@ -45,6 +47,9 @@ type Validator interface {
 	GetPubkey() crypto.BLSPubkey
 	// GetEffectiveBalance returns the effective balance of the validator.
 	GetEffectiveBalance() math.Gwei
+	// GetWithdrawalAddress returns the execution address the withdrawals of
+	// the validator are paid to.
+	GetWithdrawalAddress() (common.ExecutionAddress, error)
 }
 
 // ValidatorsIndex is a struct that holds a unique index for validators based
@@ -59,6 +64,10 @@ type ValidatorsIndex[ValidatorT Validator] struct {
 	// CometBFTAddress is a unique index mapping a validator's Comet BFT address
 	// to their numeric ID.
 	CometBFTAddress *indexes.Unique[[]byte, uint64, ValidatorT]
+	// WithdrawalAddress is a multi-index mapping a validator's withdrawal
+	// execution address to their numeric ID. Validators without eth1
+	// withdrawal credentials are all indexed under the empty address.
+	WithdrawalAddress *indexes.Multi[[]byte, uint64, ValidatorT]
 }
 
 // IndexesList returns a list of all indexes associated with the
@@ -70,6 +79,7 @@ func (a ValidatorsIndex[ValidatorT]) IndexesList() []sdkcollections.Index[
 		a.Pubkey,
 		a.EffectiveBalance,
 		a.CometBFTAddress,
+		a.WithdrawalAddress,
 	}
 }
 
@@ -111,5 +121,20 @@ func NewValidatorsIndex[ValidatorT Validator](
 				return cmtcrypto.AddressHash(pk[:]).Bytes(), nil
 			},
 		),
+		WithdrawalAddress: indexes.NewMulti(
+			sb,
+			sdkcollections.NewPrefix(validatorWithdrawalAddrToIndexPrefix),
+			validatorWithdrawalAddrToIndexPrefix,
+			sdkcollections.BytesKey,
+			sdkcollections.Uint64Key,
+			func(_ uint64, validator ValidatorT) ([]byte, error) {
+				address, err := validator.GetWithdrawalAddress()
+				if err != nil {
+					// Withdrawals are not paid to any address.
+					return []byte{}, nil //nolint:nilerr // indexed as empty.
+				}
+				return address[:], nil
+			},
+		),
 	}
 }
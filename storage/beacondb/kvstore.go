@@ -75,6 +75,8 @@ type KVStore[
 	eth1Data sdkcollections.Item[Eth1DataT]
 	// eth1DepositIndex is the index of the latest eth1 deposit.
 	eth1DepositIndex sdkcollections.Item[uint64]
+	// depositBranch stores the branch of the deposit tree by height.
+	depositBranch sdkcollections.Map[uint64, []byte]
 	// latestExecutionPayloadVersion stores the latest execution payload
 	// version.
 	latestExecutionPayloadVersion sdkcollections.Item[uint32]
@@ -188,6 +190,13 @@ func New[
 			keys.Eth1DepositIndexPrefixHumanReadable,
 			sdkcollections.Uint64Value,
 		),
+		depositBranch: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{keys.DepositBranchPrefix}),
+			keys.DepositBranchPrefixHumanReadable,
+			sdkcollections.Uint64Key,
+			sdkcollections.BytesValue,
+		),
 		latestExecutionPayloadVersion: sdkcollections.NewItem(
 			schemaBuilder,
 			sdkcollections.NewPrefix(
@@ -24,6 +24,7 @@ import (
 	"errors"
 
 	"cosmossdk.io/collections/indexes"
+	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/primitives/math"
 )
@@ -112,6 +113,37 @@ func (kv *KVStore[
 	return math.ValidatorIndex(idx), nil
 }
 
+// ValidatorIndicesByWithdrawalAddress returns the indices of the validators
+// whose withdrawals are paid to the given execution address, in increasing
+// order.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) ValidatorIndicesByWithdrawalAddress(
+	address common.ExecutionAddress,
+) (indices []math.ValidatorIndex, err error) {
+	iter, err := kv.validators.Indexes.WithdrawalAddress.MatchExact(
+		kv.ctx,
+		address[:],
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = errors.Join(err, iter.Close())
+	}()
+
+	indices = make([]math.ValidatorIndex, 0)
+	var idx uint64
+	for ; iter.Valid(); iter.Next() {
+		if idx, err = iter.PrimaryKey(); err != nil {
+			return nil, err
+		}
+		indices = append(indices, math.ValidatorIndex(idx))
+	}
+	return indices, err
+}
+
 // ValidatorByIndex returns the validator address by index.
 func (kv *KVStore[
 	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
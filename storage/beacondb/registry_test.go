@@ -33,6 +33,7 @@ import (
 	"github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/node-core/components"
 	"github.com/berachain/beacon-kit/primitives/bytes"
+	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/storage/beacondb"
 	"github.com/berachain/beacon-kit/storage/db"
@@ -197,6 +198,54 @@ func TestValidators(t *testing.T) {
 	require.Equal(t, inUpdatedVal2, res[1])
 }
 
+func TestValidatorIndicesByWithdrawalAddress(t *testing.T) {
+	store, err := initTestStore()
+	require.NoError(t, err)
+
+	var (
+		addr1 = common.ExecutionAddress{0x01}
+		addr2 = common.ExecutionAddress{0x02}
+	)
+	require.NoError(t, store.AddValidator(&types.Validator{
+		Pubkey: bytes.B48{0x01},
+		WithdrawalCredentials: types.
+			NewCredentialsFromExecutionAddress(addr1),
+	}))
+	require.NoError(t, store.AddValidator(&types.Validator{
+		Pubkey:                bytes.B48{0x02},
+		WithdrawalCredentials: types.WithdrawalCredentials{0x00, 0x01},
+	}))
+	require.NoError(t, store.AddValidator(&types.Validator{
+		Pubkey: bytes.B48{0x03},
+		WithdrawalCredentials: types.
+			NewCredentialsFromExecutionAddress(addr1),
+	}))
+
+	// only the validators with eth1 credentials are paid to an address.
+	indices, err := store.ValidatorIndicesByWithdrawalAddress(addr1)
+	require.NoError(t, err)
+	require.Equal(t, []math.ValidatorIndex{0, 2}, indices)
+
+	indices, err = store.ValidatorIndicesByWithdrawalAddress(addr2)
+	require.NoError(t, err)
+	require.Empty(t, indices)
+
+	// changing the credentials of a validator moves it to the new address.
+	require.NoError(t, store.UpdateValidatorAtIndex(2, &types.Validator{
+		Pubkey: bytes.B48{0x03},
+		WithdrawalCredentials: types.
+			NewCredentialsFromExecutionAddress(addr2),
+	}))
+
+	indices, err = store.ValidatorIndicesByWithdrawalAddress(addr1)
+	require.NoError(t, err)
+	require.Equal(t, []math.ValidatorIndex{0}, indices)
+
+	indices, err = store.ValidatorIndicesByWithdrawalAddress(addr2)
+	require.NoError(t, err)
+	require.Equal(t, []math.ValidatorIndex{2}, indices)
+}
+
 func initTestStore() (
 	*beacondb.KVStore[
 		*types.BeaconBlockHeader,
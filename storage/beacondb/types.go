@@ -21,6 +21,7 @@
 package beacondb
 
 import (
+	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/constraints"
 	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/primitives/math"
@@ -37,4 +38,7 @@ type Validator[SelfT any] interface {
 	GetEffectiveBalance() math.Gwei
 	// IsActive checks if the validator is active at the given epoch.
 	IsActive(epoch math.Epoch) bool
+	// GetWithdrawalAddress returns the execution address the withdrawals of
+	// the validator are paid to.
+	GetWithdrawalAddress() (common.ExecutionAddress, error)
 }
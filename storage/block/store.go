@@ -22,73 +22,114 @@ package block
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/math"
-	lru "github.com/hashicorp/golang-lru/v2"
 )
 
 // KVStore is a simple memory store based implementation that stores metadata of
 // beacon blocks.
 type KVStore[BeaconBlockT BeaconBlock] struct {
+	mu sync.RWMutex
+
 	// Beacon block root to slot mapping is injective for finalized blocks.
-	blockRoots *lru.Cache[common.Root, math.Slot]
+	blockRoots map[common.Root]math.Slot
 
 	// Timestamp to slot mapping is injective for finalized blocks. This is
 	// guaranteed by CometBFT consensus. So each slot will be associated with a
 	// different timestamp (no overwriting) as we store only finalized blocks.
-	timestamps *lru.Cache[math.U64, math.Slot]
+	timestamps map[math.U64]math.Slot
 
 	// Beacon state root to slot mapping is injective for finalized blocks.
-	stateRoots *lru.Cache[common.Root, math.Slot]
+	stateRoots map[common.Root]math.Slot
+
+	// window holds the metadata of the stored blocks, indexed by slot modulo
+	// the availability window, so that the oldest block is evicted whatever
+	// the order the blocks are stored in.
+	window []entry
 
 	// Logger for the store.
 	logger log.Logger
 }
 
+// entry is the metadata stored for a block.
+type entry struct {
+	slot      math.Slot
+	blockRoot common.Root
+	timestamp math.U64
+	stateRoot common.Root
+}
+
 // NewStore creates a new block store.
 func NewStore[BeaconBlockT BeaconBlock](
 	logger log.Logger,
 	availabilityWindow int,
 ) *KVStore[BeaconBlockT] {
-	blockRoots, err := lru.New[common.Root, math.Slot](availabilityWindow)
-	if err != nil {
-		panic(err)
-	}
-	timestamps, err := lru.New[math.U64, math.Slot](availabilityWindow)
-	if err != nil {
-		panic(err)
-	}
-	stateRoots, err := lru.New[common.Root, math.Slot](availabilityWindow)
-	if err != nil {
-		panic(err)
+	if availabilityWindow <= 0 {
+		panic("must provide a positive availability window")
 	}
 	return &KVStore[BeaconBlockT]{
-		blockRoots: blockRoots,
-		timestamps: timestamps,
-		stateRoots: stateRoots,
+		blockRoots: make(map[common.Root]math.Slot, availabilityWindow),
+		timestamps: make(map[math.U64]math.Slot, availabilityWindow),
+		stateRoots: make(map[common.Root]math.Slot, availabilityWindow),
+		window:     make([]entry, availabilityWindow),
 		logger:     logger,
 	}
 }
 
 // Set sets the block by a given index in the store, storing the block root,
 // timestamp, and state root. Only this function may potentially evict
-// entries from the store if the availability window is reached.
+// entries from the store if the availability window is reached. The block
+// evicted is the one a window of slots older, so blocks may be stored in any
+// order; a block older than the one stored in its place is not stored.
 func (kv *KVStore[BeaconBlockT]) Set(blk BeaconBlockT) error {
-	slot := blk.GetSlot()
-	kv.blockRoots.Add(blk.HashTreeRoot(), slot)
-	kv.timestamps.Add(blk.GetTimestamp(), slot)
-	kv.stateRoots.Add(blk.GetStateRoot(), slot)
+	e := entry{
+		slot:      blk.GetSlot(),
+		blockRoot: blk.HashTreeRoot(),
+		timestamp: blk.GetTimestamp(),
+		stateRoot: blk.GetStateRoot(),
+	}
+
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	i := e.slot.Unwrap() % uint64(len(kv.window))
+	if evicted := kv.window[i]; evicted != (entry{}) {
+		if evicted.slot > e.slot {
+			return nil
+		}
+		kv.evict(evicted)
+	}
+	kv.window[i] = e
+	kv.blockRoots[e.blockRoot] = e.slot
+	kv.timestamps[e.timestamp] = e.slot
+	kv.stateRoots[e.stateRoot] = e.slot
 	return nil
 }
 
+// evict removes the metadata of the given block, unless it is mapped to
+// another block since.
+func (kv *KVStore[BeaconBlockT]) evict(e entry) {
+	if kv.blockRoots[e.blockRoot] == e.slot {
+		delete(kv.blockRoots, e.blockRoot)
+	}
+	if kv.timestamps[e.timestamp] == e.slot {
+		delete(kv.timestamps, e.timestamp)
+	}
+	if kv.stateRoots[e.stateRoot] == e.slot {
+		delete(kv.stateRoots, e.stateRoot)
+	}
+}
+
 // GetSlotByBlockRoot retrieves the slot by a given block root from the store.
 func (kv *KVStore[BeaconBlockT]) GetSlotByBlockRoot(
 	blockRoot common.Root,
 ) (math.Slot, error) {
-	slot, ok := kv.blockRoots.Peek(blockRoot)
+	kv.mu.RLock()
+	slot, ok := kv.blockRoots[blockRoot]
+	kv.mu.RUnlock()
 	if !ok {
 		return 0, fmt.Errorf("slot not found at block root: %s", blockRoot)
 	}
@@ -100,7 +141,9 @@ func (kv *KVStore[BeaconBlockT]) GetSlotByBlockRoot(
 func (kv *KVStore[BeaconBlockT]) GetParentSlotByTimestamp(
 	timestamp math.U64,
 ) (math.Slot, error) {
-	slot, ok := kv.timestamps.Peek(timestamp)
+	kv.mu.RLock()
+	slot, ok := kv.timestamps[timestamp]
+	kv.mu.RUnlock()
 	if !ok {
 		return slot, fmt.Errorf("slot not found at timestamp: %d", timestamp)
 	}
@@ -115,7 +158,9 @@ func (kv *KVStore[BeaconBlockT]) GetParentSlotByTimestamp(
 func (kv *KVStore[BeaconBlockT]) GetSlotByStateRoot(
 	stateRoot common.Root,
 ) (math.Slot, error) {
-	slot, ok := kv.stateRoots.Peek(stateRoot)
+	kv.mu.RLock()
+	slot, ok := kv.stateRoots[stateRoot]
+	kv.mu.RUnlock()
 	if !ok {
 		return 0, fmt.Errorf("slot not found at state root: %s", stateRoot)
 	}
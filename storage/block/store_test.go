@@ -86,3 +86,21 @@ func TestBlockStore(t *testing.T) {
 	_, err = blockStore.GetParentSlotByTimestamp(2)
 	require.ErrorContains(t, err, "not found")
 }
+
+func TestBlockStoreEvictsTheOldestBlocks(t *testing.T) {
+	blockStore := block.NewStore[*MockBeaconBlock](noop.NewLogger[any](), 3)
+
+	// Blocks stored out of order are evicted by slot, and a block older than
+	// the window is not stored.
+	for _, slot := range []math.Slot{5, 4, 3, 6, 2} {
+		require.NoError(t, blockStore.Set(&MockBeaconBlock{slot: slot}))
+	}
+	for slot := math.Slot(2); slot <= 6; slot++ {
+		_, err := blockStore.GetSlotByBlockRoot([32]byte{byte(slot)})
+		if slot >= 4 {
+			require.NoError(t, err, "slot %d", slot)
+		} else {
+			require.ErrorContains(t, err, "not found", "slot %d", slot)
+		}
+	}
+}
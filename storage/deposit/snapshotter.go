@@ -0,0 +1,109 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"context"
+	"io"
+
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+	"github.com/berachain/beacon-kit/errors"
+)
+
+const (
+	// SnapshotName is the name of the deposit store in state sync
+	// snapshots.
+	SnapshotName = "deposits"
+	// SnapshotFormat encodes every deposit as an SSZ payload.
+	SnapshotFormat uint32 = 1
+)
+
+// SnapshotName implements snapshottypes.ExtensionSnapshotter.
+func (kv *KVStore[DepositT]) SnapshotName() string {
+	return SnapshotName
+}
+
+// SnapshotFormat implements snapshottypes.ExtensionSnapshotter.
+func (kv *KVStore[DepositT]) SnapshotFormat() uint32 {
+	return SnapshotFormat
+}
+
+// SupportedFormats implements snapshottypes.ExtensionSnapshotter.
+func (kv *KVStore[DepositT]) SupportedFormats() []uint32 {
+	return []uint32{SnapshotFormat}
+}
+
+// SnapshotExtension writes the deposits in the store to a state sync
+// snapshot, so that a node restoring it can propose the deposits not yet
+// included in the beacon state. The store is not versioned, so it is
+// written as of the time of the snapshot rather than of its height: since
+// deposits are final once observed, a restored node holding more of them
+// than at the snapshot height is harmless.
+func (kv *KVStore[DepositT]) SnapshotExtension(
+	_ uint64,
+	payloadWriter snapshottypes.ExtensionPayloadWriter,
+) error {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	return kv.store.Walk(
+		context.TODO(), nil,
+		func(idx uint64, deposit DepositT) (bool, error) {
+			bz, err := deposit.MarshalSSZ()
+			if err != nil {
+				return true, errors.Wrapf(
+					err, "failed to marshal deposit %d", idx,
+				)
+			}
+			return false, payloadWriter(bz)
+		},
+	)
+}
+
+// RestoreExtension restores the deposits of a state sync snapshot.
+func (kv *KVStore[DepositT]) RestoreExtension(
+	_ uint64,
+	format uint32,
+	payloadReader snapshottypes.ExtensionPayloadReader,
+) error {
+	if format != SnapshotFormat {
+		return errors.Wrapf(
+			snapshottypes.ErrUnknownFormat, "format %d", format,
+		)
+	}
+
+	var deposits []DepositT
+	for {
+		bz, err := payloadReader()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		var deposit DepositT
+		deposit = deposit.Empty()
+		if err = deposit.UnmarshalSSZ(bz); err != nil {
+			return errors.Wrap(err, "failed to unmarshal deposit")
+		}
+		deposits = append(deposits, deposit)
+	}
+	return kv.EnqueueDeposits(deposits)
+}
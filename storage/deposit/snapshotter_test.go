@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit_test
+
+import (
+	"io"
+	"testing"
+
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+	db "cosmossdk.io/store/v2/db"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/node-core/components/storage"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/storage/deposit"
+	"github.com/stretchr/testify/require"
+)
+
+func newStore() *deposit.KVStore[*types.Deposit] {
+	return deposit.NewStore[*types.Deposit](
+		storage.NewKVStoreProvider(db.NewMemDB()), noop.NewLogger[any](),
+	)
+}
+
+func TestSnapshotExtension_RoundTrip(t *testing.T) {
+	t.Parallel()
+	src := newStore()
+	deposits := make([]*types.Deposit, 0, 5)
+	for i := range uint64(5) {
+		deposits = append(deposits, types.NewDeposit(
+			crypto.BLSPubkey{byte(i)}, types.WithdrawalCredentials{},
+			math.Gwei(32e9), crypto.BLSSignature{}, i+10,
+		))
+	}
+	require.NoError(t, src.EnqueueDeposits(deposits))
+
+	var payloads [][]byte
+	require.NoError(t, src.SnapshotExtension(
+		1, func(bz []byte) error {
+			payloads = append(payloads, bz)
+			return nil
+		},
+	))
+	require.Len(t, payloads, len(deposits))
+
+	dst := newStore()
+	require.NoError(t, dst.RestoreExtension(
+		1, deposit.SnapshotFormat, func() ([]byte, error) {
+			if len(payloads) == 0 {
+				return nil, io.EOF
+			}
+			bz := payloads[0]
+			payloads = payloads[1:]
+			return bz, nil
+		},
+	))
+
+	restored, err := dst.GetDepositsByIndex(10, 10)
+	require.NoError(t, err)
+	require.Equal(t, deposits, restored)
+}
+
+func TestRestoreExtension_UnknownFormat(t *testing.T) {
+	t.Parallel()
+	err := newStore().RestoreExtension(
+		1, deposit.SnapshotFormat+1, func() ([]byte, error) {
+			return nil, io.EOF
+		},
+	)
+	require.ErrorIs(t, err, snapshottypes.ErrUnknownFormat)
+}
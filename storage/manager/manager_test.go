@@ -31,6 +31,7 @@ import (
 	"time"
 
 	"cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/node-core/components/metrics"
 	"github.com/berachain/beacon-kit/primitives/async"
 	"github.com/berachain/beacon-kit/storage/manager"
 	"github.com/berachain/beacon-kit/storage/pruner"
@@ -51,11 +52,17 @@ func TestDBManager_Start(t *testing.T) {
 	p1 := pruner.NewPruner[
 		manager.BeaconBlock,
 		*mocks.Prunable,
-	](logger, mockPrunable, "pruner1", ch, pruneParamsFn)
+	](
+		logger, mockPrunable, "pruner1", ch, pruneParamsFn,
+		metrics.NewNoOpTelemetrySink(),
+	)
 	p2 := pruner.NewPruner[
 		manager.BeaconBlock,
 		*mocks.Prunable,
-	](logger, mockPrunable, "pruner2", ch, pruneParamsFn)
+	](
+		logger, mockPrunable, "pruner2", ch, pruneParamsFn,
+		metrics.NewNoOpTelemetrySink(),
+	)
 
 	m, err := manager.NewDBManager(logger, p1, p2)
 	require.NoError(t, err)
@@ -0,0 +1,138 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package payloadheader
+
+import (
+	"context"
+
+	asynctypes "github.com/berachain/beacon-kit/async/types"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/primitives/async"
+)
+
+// Service is a Service that listens for finalized blocks and stores their
+// execution payload headers into a Store.
+type Service[
+	BeaconBlockT BeaconBlock[BeaconBlockBodyT],
+	BeaconBlockBodyT BeaconBlockBody[ExecutionPayloadT],
+	ExecutionPayloadT ExecutionPayload[ExecutionPayloadHeaderT],
+	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
+] struct {
+	// logger is used for logging information and errors.
+	logger log.Logger
+	// dispatcher is the dispatcher for the service.
+	dispatcher asynctypes.EventDispatcher
+	// store is where the headers are stored. The headers are not stored if
+	// it is nil.
+	store *Store[ExecutionPayloadHeaderT]
+	// subFinalizedBlkEvents is a channel holding BeaconBlockFinalized
+	// events.
+	subFinalizedBlkEvents chan async.Event[BeaconBlockT]
+}
+
+// NewService creates a new execution payload header service.
+func NewService[
+	BeaconBlockT BeaconBlock[BeaconBlockBodyT],
+	BeaconBlockBodyT BeaconBlockBody[ExecutionPayloadT],
+	ExecutionPayloadT ExecutionPayload[ExecutionPayloadHeaderT],
+	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
+](
+	logger log.Logger,
+	dispatcher asynctypes.EventDispatcher,
+	store *Store[ExecutionPayloadHeaderT],
+) *Service[
+	BeaconBlockT, BeaconBlockBodyT, ExecutionPayloadT,
+	ExecutionPayloadHeaderT,
+] {
+	return &Service[
+		BeaconBlockT, BeaconBlockBodyT, ExecutionPayloadT,
+		ExecutionPayloadHeaderT,
+	]{
+		logger:                logger,
+		dispatcher:            dispatcher,
+		store:                 store,
+		subFinalizedBlkEvents: make(chan async.Event[BeaconBlockT]),
+	}
+}
+
+// Name returns the name of the service.
+func (s *Service[_, _, _, _]) Name() string {
+	return "payload-header-store"
+}
+
+// Start subscribes the service to BeaconBlockFinalized events and starts
+// the main event loop to handle them accordingly.
+func (s *Service[_, _, _, _]) Start(ctx context.Context) error {
+	if s.store == nil {
+		s.logger.Info("payload header store is disabled, skipping")
+		return nil
+	}
+
+	// subscribe a channel to the finalized block events.
+	if err := s.dispatcher.Subscribe(
+		async.BeaconBlockFinalized, s.subFinalizedBlkEvents,
+	); err != nil {
+		s.logger.Error("failed to subscribe to block events", "error", err)
+		return err
+	}
+
+	// start the event loop to listen and handle events.
+	go s.eventLoop(ctx)
+	return nil
+}
+
+// eventLoop is the main event loop for the execution payload header
+// service.
+func (s *Service[_, _, _, _]) eventLoop(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			if err := s.store.Close(); err != nil {
+				s.logger.Error(
+					"failed to close payload header store", "error", err,
+				)
+			}
+			return
+		case event := <-s.subFinalizedBlkEvents:
+			s.handleFinalizedBlock(event.Data())
+		}
+	}
+}
+
+// handleFinalizedBlock stores the execution payload header of a finalized
+// block.
+func (s *Service[BeaconBlockT, _, _, _]) handleFinalizedBlock(
+	blk BeaconBlockT,
+) {
+	slot := blk.GetSlot()
+	header, err := blk.GetBody().GetExecutionPayload().ToHeader()
+	if err != nil {
+		s.logger.Error(
+			"failed to build payload header", "slot", slot, "error", err,
+		)
+		return
+	}
+	if err = s.store.Set(slot, header); err != nil {
+		s.logger.Error(
+			"failed to store payload header", "slot", slot, "error", err,
+		)
+	}
+}
@@ -0,0 +1,197 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package payloadheader
+
+import (
+	"encoding/binary"
+
+	"cosmossdk.io/core/store"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+const (
+	// headerPrefix prefixes the headers, keyed by big endian slot. Values
+	// are the big endian fork version followed by the SSZ encoded header.
+	headerPrefix byte = 'h'
+	// numberPrefix prefixes the slots of the headers, keyed by big endian
+	// execution block number.
+	numberPrefix byte = 'n'
+	// versionSize is the size of an encoded fork version.
+	versionSize = 4
+	// slotSize is the size of an encoded slot.
+	slotSize = 8
+)
+
+// Store durably stores the execution payload headers of the finalized
+// blocks, indexed by beacon slot and by execution block number.
+type Store[
+	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
+] struct {
+	db store.KVStoreWithBatch
+}
+
+// NewStore creates an execution payload header store backed by the given
+// database.
+func NewStore[
+	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
+](db store.KVStoreWithBatch) *Store[ExecutionPayloadHeaderT] {
+	return &Store[ExecutionPayloadHeaderT]{db: db}
+}
+
+// Set stores the execution payload header finalized at the given slot.
+// Finalized headers never change, so storing a header again is a no-op.
+func (s *Store[ExecutionPayloadHeaderT]) Set(
+	slot math.Slot,
+	header ExecutionPayloadHeaderT,
+) error {
+	bz, err := header.MarshalSSZ()
+	if err != nil {
+		return err
+	}
+	value := binary.BigEndian.AppendUint32(
+		make([]byte, 0, versionSize+len(bz)), header.Version(),
+	)
+
+	batch := s.db.NewBatch()
+	defer batch.Close()
+	if err = batch.Set(headerKey(slot), append(value, bz...)); err != nil {
+		return err
+	}
+	if err = batch.Set(
+		numberKey(header.GetNumber()),
+		binary.BigEndian.AppendUint64(
+			make([]byte, 0, slotSize), slot.Unwrap(),
+		),
+	); err != nil {
+		return err
+	}
+	return batch.Write()
+}
+
+// HeaderAtSlot returns the execution payload header finalized at the given
+// slot.
+func (s *Store[ExecutionPayloadHeaderT]) HeaderAtSlot(
+	slot math.Slot,
+) (ExecutionPayloadHeaderT, error) {
+	var header ExecutionPayloadHeaderT
+	bz, err := s.db.Get(headerKey(slot))
+	if err != nil {
+		return header, err
+	}
+	if len(bz) < versionSize {
+		return header, errors.Wrapf(ErrHeaderNotFound, "slot %d", slot)
+	}
+	return header.NewFromSSZ(
+		bz[versionSize:], binary.BigEndian.Uint32(bz[:versionSize]),
+	)
+}
+
+// HeaderByELNumber returns the finalized execution payload header of the
+// given execution block number.
+func (s *Store[ExecutionPayloadHeaderT]) HeaderByELNumber(
+	number math.U64,
+) (ExecutionPayloadHeaderT, error) {
+	var header ExecutionPayloadHeaderT
+	bz, err := s.db.Get(numberKey(number))
+	if err != nil {
+		return header, err
+	}
+	if len(bz) != slotSize {
+		return header, errors.Wrapf(
+			ErrHeaderNotFound, "execution block %d", number,
+		)
+	}
+	return s.HeaderAtSlot(math.Slot(binary.BigEndian.Uint64(bz)))
+}
+
+// AncestorAtDepth returns the finalized execution payload header depth
+// blocks before the one finalized at the given slot. Finalized execution
+// blocks form a single chain, so the ancestor is the header whose block
+// number is depth lower.
+func (s *Store[ExecutionPayloadHeaderT]) AncestorAtDepth(
+	slot math.Slot,
+	depth uint64,
+) (ExecutionPayloadHeaderT, error) {
+	header, err := s.HeaderAtSlot(slot)
+	if err != nil || depth == 0 {
+		return header, err
+	}
+	number := header.GetNumber()
+	if depth > number.Unwrap() {
+		var zero ExecutionPayloadHeaderT
+		return zero, errors.Wrapf(
+			ErrAncestorOutOfRange,
+			"depth %d from execution block %d", depth, number,
+		)
+	}
+	return s.HeaderByELNumber(number - math.U64(depth))
+}
+
+// Rollback removes the headers finalized after the given slot. Execution
+// block numbers increase with slots, so headers are removed from the
+// highest block number down to the first one finalized at or before slot.
+func (s *Store[_]) Rollback(slot math.Slot) error {
+	it, err := s.db.ReverseIterator(
+		[]byte{numberPrefix}, []byte{numberPrefix + 1},
+	)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	batch := s.db.NewBatch()
+	defer batch.Close()
+	for ; it.Valid(); it.Next() {
+		if len(it.Value()) != slotSize {
+			return errors.Wrapf(ErrHeaderNotFound, "key %x", it.Key())
+		}
+		headerSlot := math.Slot(binary.BigEndian.Uint64(it.Value()))
+		if headerSlot <= slot {
+			break
+		}
+		if err = batch.Delete(headerKey(headerSlot)); err != nil {
+			return err
+		}
+		if err = batch.Delete(it.Key()); err != nil {
+			return err
+		}
+	}
+	if err = it.Error(); err != nil {
+		return err
+	}
+	return batch.Write()
+}
+
+// Close closes the underlying database.
+func (s *Store[_]) Close() error {
+	return s.db.Close()
+}
+
+// headerKey returns the key of the header finalized at a slot.
+func headerKey(slot math.Slot) []byte {
+	return binary.BigEndian.AppendUint64([]byte{headerPrefix}, slot.Unwrap())
+}
+
+// numberKey returns the key indexing a header by execution block number.
+func numberKey(number math.U64) []byte {
+	return binary.BigEndian.AppendUint64([]byte{numberPrefix}, number.Unwrap())
+}
@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package payloadheader_test
+
+import (
+	"testing"
+
+	db "cosmossdk.io/store/v2/db"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/storage/payloadheader"
+	"github.com/stretchr/testify/require"
+)
+
+func newHeader(number uint64) *types.ExecutionPayloadHeader {
+	header := new(types.ExecutionPayloadHeader).Empty()
+	header.Number = math.U64(number)
+	header.BlockHash = common.ExecutionHash{byte(number)}
+	return header
+}
+
+func TestStoreQueries(t *testing.T) {
+	store := payloadheader.NewStore[*types.ExecutionPayloadHeader](
+		db.NewMemDB(),
+	)
+	// Execution block numbers are offset from the slots, as after a fork
+	// from an existing execution chain.
+	for slot := math.Slot(1); slot <= 5; slot++ {
+		require.NoError(t, store.Set(slot, newHeader(slot.Unwrap()+10)))
+	}
+
+	got, err := store.HeaderAtSlot(3)
+	require.NoError(t, err)
+	require.Equal(t, newHeader(13), got)
+
+	got, err = store.HeaderByELNumber(15)
+	require.NoError(t, err)
+	require.Equal(t, newHeader(15), got)
+
+	got, err = store.AncestorAtDepth(5, 0)
+	require.NoError(t, err)
+	require.Equal(t, newHeader(15), got)
+
+	got, err = store.AncestorAtDepth(5, 3)
+	require.NoError(t, err)
+	require.Equal(t, newHeader(12), got)
+
+	_, err = store.HeaderAtSlot(6)
+	require.ErrorIs(t, err, payloadheader.ErrHeaderNotFound)
+
+	_, err = store.HeaderByELNumber(10)
+	require.ErrorIs(t, err, payloadheader.ErrHeaderNotFound)
+
+	// The ancestor precedes the first stored header.
+	_, err = store.AncestorAtDepth(5, 5)
+	require.ErrorIs(t, err, payloadheader.ErrHeaderNotFound)
+
+	_, err = store.AncestorAtDepth(5, 16)
+	require.ErrorIs(t, err, payloadheader.ErrAncestorOutOfRange)
+}
+
+func TestStoreRollback(t *testing.T) {
+	store := payloadheader.NewStore[*types.ExecutionPayloadHeader](
+		db.NewMemDB(),
+	)
+	for slot := math.Slot(1); slot <= 5; slot++ {
+		require.NoError(t, store.Set(slot, newHeader(slot.Unwrap()+10)))
+	}
+
+	require.NoError(t, store.Rollback(3))
+	got, err := store.HeaderAtSlot(3)
+	require.NoError(t, err)
+	require.Equal(t, newHeader(13), got)
+	got, err = store.HeaderByELNumber(11)
+	require.NoError(t, err)
+	require.Equal(t, newHeader(11), got)
+
+	_, err = store.HeaderAtSlot(4)
+	require.ErrorIs(t, err, payloadheader.ErrHeaderNotFound)
+	_, err = store.HeaderByELNumber(15)
+	require.ErrorIs(t, err, payloadheader.ErrHeaderNotFound)
+
+	// Headers are stored again once their blocks are finalized again.
+	require.NoError(t, store.Set(4, newHeader(14)))
+	got, err = store.AncestorAtDepth(4, 3)
+	require.NoError(t, err)
+	require.Equal(t, newHeader(11), got)
+}
@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package payloadheader
+
+import (
+	"github.com/berachain/beacon-kit/primitives/constraints"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// BeaconBlock is an interface for beacon blocks.
+type BeaconBlock[BeaconBlockBodyT any] interface {
+	GetSlot() math.Slot
+	GetBody() BeaconBlockBodyT
+}
+
+// BeaconBlockBody is an interface for beacon block bodies.
+type BeaconBlockBody[ExecutionPayloadT any] interface {
+	GetExecutionPayload() ExecutionPayloadT
+}
+
+// ExecutionPayload is an interface for execution payloads.
+type ExecutionPayload[ExecutionPayloadHeaderT any] interface {
+	ToHeader() (ExecutionPayloadHeaderT, error)
+}
+
+// ExecutionPayloadHeader is an interface for execution payload headers.
+type ExecutionPayloadHeader[T any] interface {
+	constraints.SSZMarshallable
+	constraints.Versionable
+	NewFromSSZ([]byte, uint32) (T, error)
+	GetNumber() math.U64
+}
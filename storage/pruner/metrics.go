@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package pruner
+
+import (
+	"strconv"
+	"time"
+)
+
+// metrics is a struct that contains metrics for a pruner.
+type metrics struct {
+	// sink is the telemetry sink.
+	sink TelemetrySink
+	// name is the name of the pruner the metrics are labeled with.
+	name string
+}
+
+// newMetrics creates a new instance of the metrics struct.
+func newMetrics(sink TelemetrySink, name string) *metrics {
+	return &metrics{
+		sink: sink,
+		name: name,
+	}
+}
+
+// measurePruneDuration measures the time taken to prune the store.
+func (m *metrics) measurePruneDuration(start time.Time, err error) {
+	m.sink.MeasureSince(
+		"beacon_kit.storage.pruner.prune_duration", start,
+		"pruner", m.name, "success", strconv.FormatBool(err == nil),
+	)
+}
+
+// setPrunedIndex sets the index the store has been pruned up to.
+func (m *metrics) setPrunedIndex(end uint64) {
+	//#nosec:G115 // indexes fit in int64.
+	m.sink.SetGauge(
+		"beacon_kit.storage.pruner.pruned_index", int64(end),
+		"pruner", m.name,
+	)
+}
@@ -28,6 +28,7 @@ package pruner
 
 import (
 	"context"
+	"time"
 
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/primitives/async"
@@ -44,6 +45,7 @@ type pruner[
 ] struct {
 	prunable                Prunable
 	logger                  log.Logger
+	metrics                 *metrics
 	name                    string
 	subBeaconBlockFinalized chan async.Event[BeaconBlockT]
 	pruneRangeFn            func(async.Event[BeaconBlockT]) (uint64, uint64)
@@ -59,9 +61,11 @@ func NewPruner[
 	name string,
 	subBeaconBlockFinalized chan async.Event[BeaconBlockT],
 	pruneRangeFn func(async.Event[BeaconBlockT]) (uint64, uint64),
+	telemetrySink TelemetrySink,
 ) Pruner[PrunableT] {
 	return &pruner[BeaconBlockT, PrunableT]{
 		logger:                  logger,
+		metrics:                 newMetrics(telemetrySink, name),
 		prunable:                prunable,
 		name:                    name,
 		pruneRangeFn:            pruneRangeFn,
@@ -93,9 +97,14 @@ func (p *pruner[BeaconBlockT, PrunableT]) onFinalizeBlock(
 	event async.Event[BeaconBlockT],
 ) {
 	start, end := p.pruneRangeFn(event)
-	if err := p.prunable.Prune(start, end); err != nil {
+	startTime := time.Now()
+	err := p.prunable.Prune(start, end)
+	p.metrics.measurePruneDuration(startTime, err)
+	if err != nil {
 		p.logger.Error("‼️ error pruning index ‼️", "error", err)
+		return
 	}
+	p.metrics.setPrunedIndex(end)
 }
 
 // Name returns the name of the Pruner.
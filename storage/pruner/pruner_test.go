@@ -31,6 +31,7 @@ import (
 	"time"
 
 	"cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/node-core/components/metrics"
 	"github.com/berachain/beacon-kit/primitives/async"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/storage/pruner"
@@ -80,7 +81,10 @@ func TestPruner(t *testing.T) {
 			testPruner := pruner.NewPruner[
 				pruner.BeaconBlock,
 				pruner.Prunable,
-			](logger, mockPrunable, "TestPruner", ch, pruneRangeFn)
+			](
+				logger, mockPrunable, "TestPruner", ch, pruneRangeFn,
+				metrics.NewNoOpTelemetrySink(),
+			)
 
 			ctx, cancel := context.WithCancel(context.Background())
 			// need to ensure goroutine is stopped
@@ -28,6 +28,7 @@ package pruner
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/berachain/beacon-kit/primitives/async"
 	"github.com/berachain/beacon-kit/primitives/math"
@@ -60,3 +61,13 @@ type Pruner[PrunableT Prunable] interface {
 	Name() string
 	Start(ctx context.Context)
 }
+
+// TelemetrySink is an interface for sending metrics to a telemetry backend.
+type TelemetrySink interface {
+	// SetGauge sets a gauge metric to the specified value, identified by the
+	// provided keys.
+	SetGauge(key string, value int64, args ...string)
+	// MeasureSince measures the time since the provided start time,
+	// identified by the provided keys.
+	MeasureSince(key string, start time.Time, args ...string)
+}
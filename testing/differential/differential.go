@@ -0,0 +1,207 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package differential feeds identical blocks and pre-states to beacon-kit's
+// state transition and to a reference implementation of the consensus
+// specification, and reports the first field of the post-states on which they
+// disagree.
+package differential
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// BeaconState is the beacon state exchanged with the implementations.
+type BeaconState = types.BeaconState[
+	*types.BeaconBlockHeader,
+	*types.Eth1Data,
+	*types.ExecutionPayloadHeader,
+	*types.Fork,
+	*types.Validator,
+	types.BeaconBlockHeader,
+	types.Eth1Data,
+	types.ExecutionPayloadHeader,
+	types.Fork,
+	types.Validator,
+]
+
+// errorField is the field reported when only one implementation fails to
+// process a block.
+const errorField = "<error>"
+
+// Implementation is a consensus layer implementation under comparison.
+type Implementation interface {
+	// Name returns the name of the implementation, used in reports.
+	Name() string
+	// Fields returns the names of the BeaconState fields the implementation
+	// computes. The other fields of its post-states are those of the
+	// pre-states, and are not compared.
+	Fields() []string
+	// ProcessBlock processes the block on top of the pre-state, which is at
+	// the slot of the block, and returns the post-state. The pre-state must
+	// not be modified.
+	ProcessBlock(
+		pre *BeaconState, blk *types.BeaconBlock,
+	) (*BeaconState, error)
+}
+
+// Subject is the implementation under test. It also advances the states to
+// the slots of the blocks, since processing a slot caches the root of the
+// whole state, whose schema beacon-kit does not share with the
+// specification.
+type Subject interface {
+	Implementation
+	// ProcessSlots advances the state to the given slot and returns it. The
+	// state must not be modified.
+	ProcessSlots(st *BeaconState, slot math.Slot) (*BeaconState, error)
+}
+
+// Divergence reports the first field on which two implementations disagree.
+type Divergence struct {
+	// Block is the index of the block whose processing diverged.
+	Block int
+	// Slot is the slot of that block.
+	Slot math.Slot
+	// Field is the name of the first divergent BeaconState field.
+	Field string
+	// Subject and Reference are the roots of the field computed by each
+	// implementation.
+	Subject, Reference common.Root
+	// SubjectErr and ReferenceErr are the errors returned by each
+	// implementation, if any.
+	SubjectErr, ReferenceErr error
+}
+
+// Error implements error.
+func (d *Divergence) Error() string {
+	if d.Field == errorField {
+		return fmt.Sprintf(
+			"block %d (slot %d): subject error %v, reference error %v",
+			d.Block, d.Slot, d.SubjectErr, d.ReferenceErr,
+		)
+	}
+	return fmt.Sprintf(
+		"block %d (slot %d): field %s diverged: subject %s, reference %s",
+		d.Block, d.Slot, d.Field, d.Subject, d.Reference,
+	)
+}
+
+// Harness feeds identical inputs to a subject and a reference
+// implementation and compares the resulting states.
+type Harness struct {
+	subject   Subject
+	reference Implementation
+}
+
+// New returns a harness comparing subject against reference.
+func New(subject Subject, reference Implementation) *Harness {
+	return &Harness{
+		subject:   subject,
+		reference: reference,
+	}
+}
+
+// Run processes the blocks in order on top of pre with both
+// implementations. The pre-state of every block is the subject's post-state
+// of the previous block, advanced to the slot of the block by the subject. A
+// *Divergence is returned for the first block on which the implementations
+// disagree, on the fields both compute.
+func (h *Harness) Run(pre *BeaconState, blocks []*types.BeaconBlock) error {
+	fields := h.fields()
+	for i, blk := range blocks {
+		st, err := h.subject.ProcessSlots(pre, blk.GetSlot())
+		if err != nil {
+			return errors.Wrapf(err, "processing slots up to %d", blk.GetSlot())
+		}
+		subjectPost, subjectErr := h.subject.ProcessBlock(st, blk)
+		referencePost, referenceErr := h.reference.ProcessBlock(st, blk)
+		switch {
+		case subjectErr != nil && referenceErr != nil:
+			// Both implementations reject the block, nothing to build on.
+			return nil
+		case subjectErr != nil || referenceErr != nil:
+			return &Divergence{
+				Block:        i,
+				Slot:         blk.GetSlot(),
+				Field:        errorField,
+				SubjectErr:   subjectErr,
+				ReferenceErr: referenceErr,
+			}
+		}
+
+		d, err := Compare(subjectPost, referencePost, fields)
+		if err != nil {
+			return err
+		}
+		if d != nil {
+			d.Block, d.Slot = i, blk.GetSlot()
+			return d
+		}
+		pre = subjectPost
+	}
+	return nil
+}
+
+// fields returns the fields computed by both implementations.
+func (h *Harness) fields() []string {
+	subject := h.subject.Fields()
+	var fields []string
+	for _, name := range h.reference.Fields() {
+		if slices.Contains(subject, name) {
+			fields = append(fields, name)
+		}
+	}
+	return fields
+}
+
+// Compare compares the given fields of two states and returns the first
+// divergent one, in the order of the BeaconState fields, or nil if the
+// fields are identical.
+func Compare(
+	subject, reference *BeaconState, fields []string,
+) (*Divergence, error) {
+	subjectRoots, err := subject.FieldRoots()
+	if err != nil {
+		return nil, errors.Wrap(err, "subject field roots")
+	}
+	referenceRoots, err := reference.FieldRoots()
+	if err != nil {
+		return nil, errors.Wrap(err, "reference field roots")
+	}
+	for i, name := range types.BeaconStateFieldNames() {
+		if !slices.Contains(fields, name) {
+			continue
+		}
+		if subjectRoots[i] != referenceRoots[i] {
+			return &Divergence{
+				Field:     name,
+				Subject:   subjectRoots[i],
+				Reference: referenceRoots[i],
+			}, nil
+		}
+	}
+	return nil, nil //nolint:nilnil // identical states.
+}
@@ -0,0 +1,194 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package differential_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/testing/differential"
+	"github.com/stretchr/testify/require"
+)
+
+var errBadBlock = errors.New("bad block")
+
+// implementation adapts a function to differential.Subject.
+type implementation struct {
+	fields  []string
+	process func(*differential.BeaconState, *types.BeaconBlock) error
+}
+
+func (*implementation) Name() string {
+	return "implementation"
+}
+
+func (i *implementation) Fields() []string {
+	if i.fields == nil {
+		return types.BeaconStateFieldNames()
+	}
+	return i.fields
+}
+
+func (*implementation) ProcessSlots(
+	st *differential.BeaconState, slot math.Slot,
+) (*differential.BeaconState, error) {
+	post, err := copyState(st)
+	if err != nil {
+		return nil, err
+	}
+	post.Slot = slot
+	return post, nil
+}
+
+func (i *implementation) ProcessBlock(
+	pre *differential.BeaconState, blk *types.BeaconBlock,
+) (*differential.BeaconState, error) {
+	post, err := copyState(pre)
+	if err != nil {
+		return nil, err
+	}
+	return post, i.process(post, blk)
+}
+
+// rewardAll rewards every validator.
+func rewardAll(st *differential.BeaconState, _ *types.BeaconBlock) error {
+	for i := range st.Balances {
+		st.Balances[i]++
+	}
+	return nil
+}
+
+func copyState(
+	st *differential.BeaconState,
+) (*differential.BeaconState, error) {
+	bz, err := st.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	cpy := &differential.BeaconState{}
+	return cpy, cpy.UnmarshalSSZ(bz)
+}
+
+func newState() *differential.BeaconState {
+	return &differential.BeaconState{
+		Fork:              &types.Fork{},
+		LatestBlockHeader: &types.BeaconBlockHeader{},
+		Eth1Data:          &types.Eth1Data{},
+		LatestExecutionPayloadHeader: &types.ExecutionPayloadHeader{
+			BaseFeePerGas: math.NewU256(0),
+		},
+		Validators: []*types.Validator{
+			{Pubkey: [48]byte{0x01}}, {Pubkey: [48]byte{0x02}},
+		},
+		Balances:    []uint64{32, 32},
+		RandaoMixes: []common.Bytes32{{0x01}},
+	}
+}
+
+func newBlocks(n int) []*types.BeaconBlock {
+	blocks := make([]*types.BeaconBlock, n)
+	for i := range blocks {
+		blocks[i] = &types.BeaconBlock{
+			Slot: math.Slot(i + 1),
+			Body: &types.BeaconBlockBody{
+				Eth1Data: &types.Eth1Data{},
+				ExecutionPayload: &types.ExecutionPayload{
+					BaseFeePerGas: math.NewU256(0),
+				},
+			},
+		}
+	}
+	return blocks
+}
+
+func TestHarness(t *testing.T) {
+	reference := &implementation{
+		fields:  []string{"Slot", "Balances"},
+		process: rewardAll,
+	}
+
+	t.Run("identical", func(t *testing.T) {
+		subject := &implementation{process: rewardAll}
+		h := differential.New(subject, reference)
+		require.NoError(t, h.Run(newState(), newBlocks(3)))
+	})
+
+	t.Run("first divergent field", func(t *testing.T) {
+		subject := &implementation{
+			process: func(
+				st *differential.BeaconState, blk *types.BeaconBlock,
+			) error {
+				if blk.GetSlot() == 2 {
+					st.Balances[1] += 2
+				}
+				return rewardAll(st, blk)
+			},
+		}
+		err := differential.New(subject, reference).Run(
+			newState(), newBlocks(3),
+		)
+
+		var d *differential.Divergence
+		require.ErrorAs(t, err, &d)
+		require.Equal(t, 1, d.Block)
+		require.Equal(t, math.Slot(2), d.Slot)
+		require.Equal(t, "Balances", d.Field)
+		require.NotEqual(t, d.Subject, d.Reference)
+	})
+
+	t.Run("fields the reference does not compute", func(t *testing.T) {
+		subject := &implementation{
+			process: func(
+				st *differential.BeaconState, blk *types.BeaconBlock,
+			) error {
+				st.TotalSlashing++
+				return rewardAll(st, blk)
+			},
+		}
+		h := differential.New(subject, reference)
+		require.NoError(t, h.Run(newState(), newBlocks(3)))
+	})
+
+	t.Run("rejected by one implementation", func(t *testing.T) {
+		subject := &implementation{
+			process: func(
+				st *differential.BeaconState, blk *types.BeaconBlock,
+			) error {
+				if blk.GetSlot() == 3 {
+					return errBadBlock
+				}
+				return rewardAll(st, blk)
+			},
+		}
+		err := differential.New(subject, reference).Run(
+			newState(), newBlocks(3),
+		)
+
+		var d *differential.Divergence
+		require.ErrorAs(t, err, &d)
+		require.Equal(t, 2, d.Block)
+		require.ErrorIs(t, d.SubjectErr, errBadBlock)
+		require.NoError(t, d.ReferenceErr)
+	})
+}
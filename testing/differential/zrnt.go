@@ -0,0 +1,423 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package differential
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	zaltair "github.com/protolambda/zrnt/eth2/beacon/altair"
+	zcommon "github.com/protolambda/zrnt/eth2/beacon/common"
+	zdeneb "github.com/protolambda/zrnt/eth2/beacon/deneb"
+	zphase0 "github.com/protolambda/zrnt/eth2/beacon/phase0"
+	zconfigs "github.com/protolambda/zrnt/eth2/configs"
+	"github.com/protolambda/ztyp/codec"
+	zview "github.com/protolambda/ztyp/view"
+)
+
+// ChainSpec is the part of the chain specification the zrnt reference is
+// configured with.
+type ChainSpec interface {
+	SlotsPerEpoch() uint64
+	SlotsPerHistoricalRoot() uint64
+	EpochsPerHistoricalVector() uint64
+	EpochsPerSlashingsVector() uint64
+	HistoricalRootsLimit() uint64
+	ValidatorRegistryLimit() uint64
+	MinDepositAmount() uint64
+	MaxEffectiveBalance() uint64
+	EffectiveBalanceIncrement() uint64
+	MaxBlobCommitmentsPerBlock() uint64
+	GenesisTime() uint64
+	SlotDuration() time.Duration
+}
+
+// Zrnt is a reference implementation backed by zrnt, an executable version
+// of the Deneb consensus specification. It runs the parts of the block
+// processing beacon-kit shares with the specification: the block header,
+// the execution payload, the RANDAO reveal and the deposits.
+//
+// The proposer of the block is taken as the expected one, the execution
+// payload is accepted as valid by the execution engine, and deposits are
+// applied without checking their signatures and proofs, which beacon-kit
+// checks against its own deposit store.
+type Zrnt struct {
+	spec        *zcommon.Spec
+	genesisTime zcommon.Timestamp
+}
+
+// NewZrnt returns a zrnt reference configured with the chain spec.
+func NewZrnt(cs ChainSpec) *Zrnt {
+	spec := *zconfigs.Mainnet
+	spec.SLOTS_PER_EPOCH = zcommon.Slot(cs.SlotsPerEpoch())
+	spec.SLOTS_PER_HISTORICAL_ROOT = zcommon.Slot(cs.SlotsPerHistoricalRoot())
+	spec.EPOCHS_PER_HISTORICAL_VECTOR = zcommon.Epoch(
+		cs.EpochsPerHistoricalVector(),
+	)
+	spec.EPOCHS_PER_SLASHINGS_VECTOR = zcommon.Epoch(
+		cs.EpochsPerSlashingsVector(),
+	)
+	spec.HISTORICAL_ROOTS_LIMIT = zview.Uint64View(cs.HistoricalRootsLimit())
+	spec.VALIDATOR_REGISTRY_LIMIT = zview.Uint64View(
+		cs.ValidatorRegistryLimit(),
+	)
+	spec.MIN_DEPOSIT_AMOUNT = zcommon.Gwei(cs.MinDepositAmount())
+	spec.MAX_EFFECTIVE_BALANCE = zcommon.Gwei(cs.MaxEffectiveBalance())
+	spec.EFFECTIVE_BALANCE_INCREMENT = zcommon.Gwei(
+		cs.EffectiveBalanceIncrement(),
+	)
+	spec.MAX_BLOB_COMMITMENTS_PER_BLOCK = zview.Uint64View(
+		cs.MaxBlobCommitmentsPerBlock(),
+	)
+	spec.SECONDS_PER_SLOT = zcommon.Timestamp(cs.SlotDuration() / time.Second)
+	return &Zrnt{
+		spec:        &spec,
+		genesisTime: zcommon.Timestamp(cs.GenesisTime()),
+	}
+}
+
+// Name implements Implementation.
+func (*Zrnt) Name() string {
+	return "zrnt"
+}
+
+// Fields implements Implementation. The other fields are left out on
+// purpose: beacon-kit takes the Eth1Data from the block instead of voting on
+// it, sweeps withdrawals after the withdrawal of the EVM inflation, and
+// accounts slashings on its own.
+func (*Zrnt) Fields() []string {
+	return []string{
+		"GenesisValidatorsRoot",
+		"Slot",
+		"Fork",
+		"LatestBlockHeader",
+		"BlockRoots",
+		"StateRoots",
+		"Eth1DepositIndex",
+		"LatestExecutionPayloadHeader",
+		"Validators",
+		"Balances",
+		"RandaoMixes",
+	}
+}
+
+// ProcessBlock implements Implementation.
+func (z *Zrnt) ProcessBlock(
+	pre *BeaconState, blk *types.BeaconBlock,
+) (*BeaconState, error) {
+	ctx := context.Background()
+	st, err := z.toView(pre)
+	if err != nil {
+		return nil, errors.Wrap(err, "converting the pre-state")
+	}
+	epc, err := z.epochsContext(st, blk)
+	if err != nil {
+		return nil, err
+	}
+	proposer := zcommon.ValidatorIndex(blk.GetProposerIndex())
+
+	header := new(zcommon.BeaconBlockHeader)
+	if err = toZrnt(header, blk.GetHeader()); err != nil {
+		return nil, err
+	}
+	if err = zcommon.ProcessHeader(
+		ctx, z.spec, st, header, proposer,
+	); err != nil {
+		return nil, err
+	}
+
+	body := blk.GetBody()
+	zbody := new(zdeneb.BeaconBlockBody)
+	bz, err := body.GetExecutionPayload().MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	if err = zbody.ExecutionPayload.Deserialize(
+		z.spec, codec.NewDecodingReader(bytes.NewReader(bz), uint64(len(bz))),
+	); err != nil {
+		return nil, err
+	}
+	for _, commitment := range body.GetBlobKzgCommitments() {
+		zbody.BlobKZGCommitments = append(
+			zbody.BlobKZGCommitments, zcommon.KZGCommitment(commitment),
+		)
+	}
+	if err = zdeneb.ProcessExecutionPayload(
+		ctx, z.spec, st, zbody, acceptingEngine{},
+	); err != nil {
+		return nil, err
+	}
+
+	if err = zphase0.ProcessRandaoReveal(
+		ctx, z.spec, epc, st, zcommon.BLSSignature(body.GetRandaoReveal()),
+	); err != nil {
+		return nil, err
+	}
+
+	for _, dep := range body.GetDeposits() {
+		if err = zphase0.ProcessDeposit(z.spec, epc, st, &zcommon.Deposit{
+			Data: zcommon.DepositData{
+				Pubkey: zcommon.BLSPubkey(dep.GetPubkey()),
+				WithdrawalCredentials: zcommon.Root(
+					dep.GetWithdrawalCredentials(),
+				),
+				Amount:    zcommon.Gwei(dep.GetAmount()),
+				Signature: zcommon.BLSSignature(dep.GetSignature()),
+			},
+		}, true); err != nil {
+			return nil, err
+		}
+	}
+
+	post, err := z.fromView(pre, st)
+	return post, errors.Wrap(err, "converting the post-state")
+}
+
+// epochsContext returns the epochs context of the state, with the proposer
+// of the block as the proposer of its slot. Beacon-kit leaves the choice of
+// proposers to CometBFT and does not activate validators, so the shuffling
+// of the specification does not apply.
+func (z *Zrnt) epochsContext(
+	st *zdeneb.BeaconStateView, blk *types.BeaconBlock,
+) (*zcommon.EpochsContext, error) {
+	vals, err := st.Validators()
+	if err != nil {
+		return nil, err
+	}
+	pc, err := zcommon.NewPubkeyCache(vals)
+	if err != nil {
+		return nil, err
+	}
+	slot := zcommon.Slot(blk.GetSlot())
+	proposers := make([]zcommon.ValidatorIndex, z.spec.SLOTS_PER_EPOCH)
+	proposers[slot%z.spec.SLOTS_PER_EPOCH] = zcommon.ValidatorIndex(
+		blk.GetProposerIndex(),
+	)
+	return &zcommon.EpochsContext{
+		Spec:                 z.spec,
+		ValidatorPubkeyCache: pc,
+		Proposers: &zcommon.ProposersEpoch{
+			Spec:      z.spec,
+			Epoch:     z.spec.SlotToEpoch(slot),
+			Proposers: proposers,
+		},
+	}, nil
+}
+
+// toView converts the state into a zrnt state. The fields beacon-kit does
+// not have are empty, and sized to the registry where the specification
+// requires it.
+//
+//nolint:funlen // one conversion per field.
+func (z *Zrnt) toView(st *BeaconState) (*zdeneb.BeaconStateView, error) {
+	zst := &zdeneb.BeaconState{
+		GenesisTime:           z.genesisTime,
+		GenesisValidatorsRoot: zcommon.Root(st.GenesisValidatorsRoot),
+		Slot:                  zcommon.Slot(st.Slot),
+		Eth1DepositIndex:      zcommon.DepositIndex(st.Eth1DepositIndex),
+		NextWithdrawalIndex: zcommon.WithdrawalIndex(
+			st.NextWithdrawalIndex,
+		),
+		NextWithdrawalValidatorIndex: zcommon.ValidatorIndex(
+			st.NextWithdrawalValidatorIndex,
+		),
+		Slashings: make(
+			zphase0.SlashingsHistory, z.spec.EPOCHS_PER_SLASHINGS_VECTOR,
+		),
+	}
+	for _, obj := range []struct {
+		dst decoder
+		src marshaler
+	}{
+		{&zst.Fork, st.Fork},
+		{&zst.LatestBlockHeader, st.LatestBlockHeader},
+		{&zst.Eth1Data, st.Eth1Data},
+		{&zst.LatestExecutionPayloadHeader, st.LatestExecutionPayloadHeader},
+	} {
+		if err := toZrnt(obj.dst, obj.src); err != nil {
+			return nil, err
+		}
+	}
+	for _, root := range st.BlockRoots {
+		zst.BlockRoots = append(zst.BlockRoots, zcommon.Root(root))
+	}
+	for _, root := range st.StateRoots {
+		zst.StateRoots = append(zst.StateRoots, zcommon.Root(root))
+	}
+	for _, val := range st.Validators {
+		zval := new(zphase0.Validator)
+		if err := toZrnt(zval, val); err != nil {
+			return nil, err
+		}
+		zst.Validators = append(zst.Validators, zval)
+	}
+	for _, balance := range st.Balances {
+		zst.Balances = append(zst.Balances, zcommon.Gwei(balance))
+	}
+	for _, mix := range st.RandaoMixes {
+		zst.RandaoMixes = append(zst.RandaoMixes, zcommon.Root(mix))
+	}
+	count := len(st.Validators)
+	zst.PreviousEpochParticipation = make(zaltair.ParticipationRegistry, count)
+	zst.CurrentEpochParticipation = make(zaltair.ParticipationRegistry, count)
+	zst.InactivityScores = make(zaltair.InactivityScores, count)
+	pubkeys := make(zcommon.SyncCommitteePubkeys, z.spec.SYNC_COMMITTEE_SIZE)
+	zst.CurrentSyncCommittee.Pubkeys = pubkeys
+	zst.NextSyncCommittee.Pubkeys = pubkeys
+
+	var buf bytes.Buffer
+	if err := zst.Serialize(z.spec, codec.NewEncodingWriter(&buf)); err != nil {
+		return nil, err
+	}
+	return zdeneb.AsBeaconStateView(
+		zdeneb.BeaconStateType(z.spec).Deserialize(
+			codec.NewDecodingReader(&buf, uint64(buf.Len())),
+		),
+	)
+}
+
+// fromView returns a copy of pre holding the fields of the zrnt state the
+// reference computes.
+func (z *Zrnt) fromView(
+	pre *BeaconState, view *zdeneb.BeaconStateView,
+) (*BeaconState, error) {
+	var buf bytes.Buffer
+	if err := view.Serialize(codec.NewEncodingWriter(&buf)); err != nil {
+		return nil, err
+	}
+	zst := new(zdeneb.BeaconState)
+	if err := zst.Deserialize(
+		z.spec, codec.NewDecodingReader(&buf, uint64(buf.Len())),
+	); err != nil {
+		return nil, err
+	}
+
+	bz, err := pre.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	st := new(BeaconState)
+	if err = st.UnmarshalSSZ(bz); err != nil {
+		return nil, err
+	}
+	st.GenesisValidatorsRoot = common.Root(zst.GenesisValidatorsRoot)
+	st.Slot = math.Slot(zst.Slot)
+	st.Eth1DepositIndex = uint64(zst.Eth1DepositIndex)
+	for _, obj := range []struct {
+		dst unmarshaler
+		src encoder
+	}{
+		{st.Fork, &zst.Fork},
+		{st.LatestBlockHeader, &zst.LatestBlockHeader},
+		{st.LatestExecutionPayloadHeader, &zst.LatestExecutionPayloadHeader},
+	} {
+		if err = fromZrnt(obj.dst, obj.src); err != nil {
+			return nil, err
+		}
+	}
+	st.BlockRoots = make([]common.Root, 0, len(zst.BlockRoots))
+	for _, root := range zst.BlockRoots {
+		st.BlockRoots = append(st.BlockRoots, common.Root(root))
+	}
+	st.StateRoots = make([]common.Root, 0, len(zst.StateRoots))
+	for _, root := range zst.StateRoots {
+		st.StateRoots = append(st.StateRoots, common.Root(root))
+	}
+	st.Validators = make([]*types.Validator, 0, len(zst.Validators))
+	for _, zval := range zst.Validators {
+		val := new(types.Validator)
+		if err = fromZrnt(val, zval); err != nil {
+			return nil, err
+		}
+		st.Validators = append(st.Validators, val)
+	}
+	st.Balances = make([]uint64, 0, len(zst.Balances))
+	for _, balance := range zst.Balances {
+		st.Balances = append(st.Balances, uint64(balance))
+	}
+	st.RandaoMixes = make([]common.Bytes32, 0, len(zst.RandaoMixes))
+	for _, mix := range zst.RandaoMixes {
+		st.RandaoMixes = append(st.RandaoMixes, common.Bytes32(mix))
+	}
+	return st, nil
+}
+
+type (
+	// marshaler and unmarshaler are beacon-kit SSZ objects.
+	marshaler   interface{ MarshalSSZ() ([]byte, error) }
+	unmarshaler interface{ UnmarshalSSZ(bz []byte) error }
+	// decoder and encoder are zrnt SSZ objects.
+	decoder interface {
+		Deserialize(dr *codec.DecodingReader) error
+	}
+	encoder interface {
+		Serialize(w *codec.EncodingWriter) error
+	}
+)
+
+// toZrnt converts a beacon-kit object into the zrnt object sharing its SSZ
+// schema.
+func toZrnt(dst decoder, src marshaler) error {
+	bz, err := src.MarshalSSZ()
+	if err != nil {
+		return err
+	}
+	return dst.Deserialize(
+		codec.NewDecodingReader(bytes.NewReader(bz), uint64(len(bz))),
+	)
+}
+
+// fromZrnt converts a zrnt object into the beacon-kit object sharing its
+// SSZ schema.
+func fromZrnt(dst unmarshaler, src encoder) error {
+	var buf bytes.Buffer
+	if err := src.Serialize(codec.NewEncodingWriter(&buf)); err != nil {
+		return err
+	}
+	return dst.UnmarshalSSZ(buf.Bytes())
+}
+
+// acceptingEngine is an execution engine accepting every payload, whose
+// validity beacon-kit leaves to its own execution client.
+type acceptingEngine struct{}
+
+func (acceptingEngine) DenebNotifyNewPayload(
+	context.Context, *zdeneb.ExecutionPayload, zcommon.Root,
+) (bool, error) {
+	return true, nil
+}
+
+func (acceptingEngine) DenebIsValidVersionedHashes(
+	context.Context, *zdeneb.ExecutionPayload, []zcommon.Hash32,
+) (bool, error) {
+	return true, nil
+}
+
+func (acceptingEngine) DenebIsValidBlockHash(
+	context.Context, *zdeneb.ExecutionPayload, zcommon.Root,
+) (bool, error) {
+	return true, nil
+}
@@ -0,0 +1,166 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package golden records the post-states of a sequence of blocks processed by
+// the state transition, and checks later runs against them. The golden
+// states are a regression test of the state transition: any change to the
+// post-states, intended or not, is reported down to the first divergent
+// field, and intended changes are recorded again.
+package golden
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// BeaconState is the beacon state whose post-states are recorded.
+type BeaconState = types.BeaconState[
+	*types.BeaconBlockHeader,
+	*types.Eth1Data,
+	*types.ExecutionPayloadHeader,
+	*types.Fork,
+	*types.Validator,
+	types.BeaconBlockHeader,
+	types.Eth1Data,
+	types.ExecutionPayloadHeader,
+	types.Fork,
+	types.Validator,
+]
+
+// filePerm is the permission of the written golden files.
+const filePerm = 0o600
+
+// ErrLengthMismatch is returned when checking a number of post-states other
+// than the number of golden states.
+var ErrLengthMismatch = errors.New("post-states and golden states mismatch")
+
+// State holds the roots of the fields of a golden post-state.
+type State struct {
+	// Slot is the slot of the post-state.
+	Slot math.Slot `json:"slot"`
+	// Fields maps the name of every BeaconState field to its root.
+	Fields map[string]common.Root `json:"fields"`
+}
+
+// States are the golden post-states of a sequence of blocks, in order.
+type States []*State
+
+// Divergence reports the first field of a post-state which differs from its
+// golden state.
+type Divergence struct {
+	// Block is the index of the block whose post-state diverged.
+	Block int
+	// Slot is the slot of the post-state.
+	Slot math.Slot
+	// Field is the name of the first divergent BeaconState field.
+	Field string
+	// Got and Want are the roots of the field in the post-state and in the
+	// golden state.
+	Got, Want common.Root
+}
+
+// Error implements error.
+func (d *Divergence) Error() string {
+	return fmt.Sprintf(
+		"block %d (slot %d): field %s diverged: got %s, want %s",
+		d.Block, d.Slot, d.Field, d.Got, d.Want,
+	)
+}
+
+// Record returns the golden states of the given post-states.
+func Record(posts []*BeaconState) (States, error) {
+	states := make(States, 0, len(posts))
+	for _, post := range posts {
+		roots, err := post.FieldRoots()
+		if err != nil {
+			return nil, err
+		}
+		st := &State{
+			Slot:   post.Slot,
+			Fields: make(map[string]common.Root, len(roots)),
+		}
+		for i, name := range types.BeaconStateFieldNames() {
+			st.Fields[name] = roots[i]
+		}
+		states = append(states, st)
+	}
+	return states, nil
+}
+
+// Load reads the golden states written to path.
+func Load(path string) (States, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var states States
+	if err = json.Unmarshal(bz, &states); err != nil {
+		return nil, errors.Wrapf(err, "decoding %s", path)
+	}
+	return states, nil
+}
+
+// Write writes the golden states to path, creating its directory if needed.
+func (s States) Write(path string) error {
+	bz, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(bz, '\n'), filePerm)
+}
+
+// Check compares the post-states of the blocks, in order, to the golden
+// states. A *Divergence is returned for the first divergent field of the
+// first post-state which differs from its golden state.
+func (s States) Check(posts []*BeaconState) error {
+	if len(posts) != len(s) {
+		return errors.Wrapf(
+			ErrLengthMismatch, "%d != %d", len(posts), len(s),
+		)
+	}
+	for i, post := range posts {
+		roots, err := post.FieldRoots()
+		if err != nil {
+			return err
+		}
+		for j, name := range types.BeaconStateFieldNames() {
+			if roots[j] != s[i].Fields[name] {
+				return &Divergence{
+					Block: i,
+					Slot:  post.Slot,
+					Field: name,
+					Got:   roots[j],
+					Want:  s[i].Fields[name],
+				}
+			}
+		}
+	}
+	return nil
+}
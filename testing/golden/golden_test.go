@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package golden_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/testing/golden"
+	"github.com/stretchr/testify/require"
+)
+
+func newState(slot math.Slot, balances ...uint64) *golden.BeaconState {
+	return &golden.BeaconState{
+		Slot:              slot,
+		Fork:              &types.Fork{},
+		LatestBlockHeader: &types.BeaconBlockHeader{},
+		Eth1Data:          &types.Eth1Data{},
+		LatestExecutionPayloadHeader: &types.ExecutionPayloadHeader{
+			BaseFeePerGas: math.NewU256(0),
+		},
+		Validators: []*types.Validator{
+			{Pubkey: [48]byte{0x01}}, {Pubkey: [48]byte{0x02}},
+		},
+		Balances:    balances,
+		RandaoMixes: []common.Bytes32{{0x01}},
+	}
+}
+
+func newPosts() []*golden.BeaconState {
+	return []*golden.BeaconState{
+		newState(1, 32, 32),
+		newState(2, 33, 33),
+		newState(3, 34, 34),
+	}
+}
+
+func TestStates(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "golden", "states.json")
+	recorded, err := golden.Record(newPosts())
+	require.NoError(t, err)
+	require.NoError(t, recorded.Write(path))
+
+	states, err := golden.Load(path)
+	require.NoError(t, err)
+	require.Equal(t, recorded, states)
+
+	t.Run("unchanged", func(t *testing.T) {
+		require.NoError(t, states.Check(newPosts()))
+	})
+
+	t.Run("first divergent field", func(t *testing.T) {
+		posts := newPosts()
+		posts[1].Balances[1]++
+		posts[1].TotalSlashing = 1
+		posts[2].Balances[0]++
+
+		var d *golden.Divergence
+		require.ErrorAs(t, states.Check(posts), &d)
+		require.Equal(t, 1, d.Block)
+		require.Equal(t, math.Slot(2), d.Slot)
+		require.Equal(t, "Balances", d.Field)
+		require.NotEqual(t, d.Got, d.Want)
+	})
+
+	t.Run("length mismatch", func(t *testing.T) {
+		posts := append(newPosts(), newState(4, 35, 35))
+		require.ErrorIs(t, states.Check(posts), golden.ErrLengthMismatch)
+	})
+}
@@ -0,0 +1,566 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package simulated
+
+import (
+	"encoding/binary"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/execution/client/ethclient"
+	"github.com/berachain/beacon-kit/execution/client/ethclient/rpc"
+	gethprimitives "github.com/berachain/beacon-kit/geth-primitives"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/eip4844"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const (
+	// defaultGasLimit is the gas limit of the payloads built by the engine.
+	defaultGasLimit = 30_000_000
+	// defaultBaseFee is the base fee of the payloads built by the engine.
+	defaultBaseFee = 1_000_000_000
+
+	// errCodeMethodNotFound is the JSON-RPC code for an unknown method.
+	errCodeMethodNotFound = -32601
+	// errCodeInvalidParams is the JSON-RPC code for malformed parameters.
+	errCodeInvalidParams = -32602
+	// errCodeInternal is the JSON-RPC code for a failed handler.
+	errCodeInternal = -32603
+	// errCodeUnknownPayload is the Engine API code for an unknown payload id.
+	errCodeUnknownPayload = -38001
+)
+
+// blobsBundle is the blobs bundle returned alongside built payloads.
+type blobsBundle = engineprimitives.BlobsBundleV1[
+	eip4844.KZGCommitment, eip4844.KZGProof, eip4844.Blob,
+]
+
+// block is a payload known to the engine, along with the parent beacon
+// block root it was sealed with.
+type block struct {
+	payload         *types.ExecutionPayload
+	parentBlockRoot common.Root
+}
+
+// HandlerFunc serves a single JSON-RPC method of the mock engine. Returning
+// an rpc.Error surfaces its code to the caller, any other error is reported
+// as an internal error.
+type HandlerFunc func(params []json.RawMessage) (any, error)
+
+// Engine is an in-process execution client serving the Engine API over HTTP.
+// It keeps a minimal chain of payloads with valid block hashes, so that the
+// consensus layer can build on it and verify it block after block. Every
+// method can be scripted through Handle, SetPayloadStatus and
+// SetPayloadMutator.
+type Engine struct {
+	server  *httptest.Server
+	chainID uint64
+
+	// mu protects the fields below.
+	mu sync.Mutex
+	// blocks are the payloads known to the engine, keyed by block hash.
+	blocks map[common.ExecutionHash]*block
+	// genesis is the hash of the genesis payload.
+	genesis common.ExecutionHash
+	// head is the hash of the last forkchoice head.
+	head common.ExecutionHash
+	// building holds the payloads built in response to forkchoice updates.
+	building map[engineprimitives.PayloadID]*block
+	// nextPayloadID is the id assigned to the next built payload.
+	nextPayloadID uint64
+	// calls counts the requests served per method.
+	calls map[string]int
+	// handlers override the default handling of a method.
+	handlers map[string]HandlerFunc
+	// status, if set, is returned by newPayload and forkchoiceUpdated in
+	// place of the computed status.
+	status engineprimitives.PayloadStatusStr
+	// mutate, if set, is applied to every payload before it is sealed.
+	mutate func(*types.ExecutionPayload)
+}
+
+// NewEngine starts a mock engine for the given EL chain id. The engine is
+// seeded with a genesis payload and stops serving once Close is called.
+func NewEngine(chainID uint64) *Engine {
+	e := &Engine{
+		chainID:  chainID,
+		blocks:   make(map[common.ExecutionHash]*block),
+		building: make(map[engineprimitives.PayloadID]*block),
+		calls:    make(map[string]int),
+		handlers: make(map[string]HandlerFunc),
+	}
+
+	genesis := &types.ExecutionPayload{
+		GasLimit:      defaultGasLimit,
+		BaseFeePerGas: math.NewU256(defaultBaseFee),
+		Transactions:  engineprimitives.Transactions{},
+		Withdrawals:   []*engineprimitives.Withdrawal{},
+	}
+	genesis.BlockHash = BlockHash(genesis, common.Root{})
+	e.blocks[genesis.BlockHash] = &block{payload: genesis}
+	e.genesis = genesis.BlockHash
+	e.head = genesis.BlockHash
+
+	e.server = httptest.NewServer(http.HandlerFunc(e.serveHTTP))
+	return e
+}
+
+// URL returns the address the engine is listening on.
+func (e *Engine) URL() string {
+	return e.server.URL
+}
+
+// Close stops the engine.
+func (e *Engine) Close() {
+	e.server.Close()
+}
+
+// Genesis returns the genesis payload of the engine.
+func (e *Engine) Genesis() *types.ExecutionPayload {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.blocks[e.genesis].payload
+}
+
+// Head returns the payload of the last forkchoice head.
+func (e *Engine) Head() *types.ExecutionPayload {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.blocks[e.head].payload
+}
+
+// Calls returns how many times the given method has been called.
+func (e *Engine) Calls(method string) int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.calls[method]
+}
+
+// Handle overrides the handling of the given method. A nil handler restores
+// the default behaviour.
+func (e *Engine) Handle(method string, handler HandlerFunc) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if handler == nil {
+		delete(e.handlers, method)
+		return
+	}
+	e.handlers[method] = handler
+}
+
+// SetPayloadStatus forces the status returned by newPayload and
+// forkchoiceUpdated, e.g. to simulate a syncing or faulty execution client.
+// An empty status restores the computed one.
+func (e *Engine) SetPayloadStatus(status engineprimitives.PayloadStatusStr) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.status = status
+}
+
+// SetPayloadMutator registers a function applied to every payload the engine
+// builds, before its block hash is computed.
+func (e *Engine) SetPayloadMutator(mutate func(*types.ExecutionPayload)) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.mutate = mutate
+}
+
+// serveHTTP decodes a JSON-RPC request and dispatches it.
+func (e *Engine) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		ID     int               `json:"id"`
+		Method string            `json:"method"`
+		Params []json.RawMessage `json:"params"`
+	}
+	resp := rpc.Response{JSONRPC: "2.0"}
+	body, err := io.ReadAll(r.Body)
+	if err == nil {
+		err = json.Unmarshal(body, &req)
+	}
+	if err != nil {
+		resp.Error = &rpc.Error{Code: errCodeInvalidParams, Message: err.Error()}
+	} else {
+		resp.ID = req.ID
+		resp.Result, resp.Error = e.dispatch(req.Method, req.Params)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	//nolint:errcheck // the client will time out.
+	bz, _ := json.Marshal(resp)
+	//nolint:errcheck // the client will time out.
+	_, _ = w.Write(bz)
+}
+
+// dispatch serves a single method call.
+func (e *Engine) dispatch(
+	method string,
+	params []json.RawMessage,
+) (json.RawMessage, *rpc.Error) {
+	e.mu.Lock()
+	e.calls[method]++
+	handler, ok := e.handlers[method]
+	e.mu.Unlock()
+	if !ok {
+		handler, ok = e.defaultHandler(method)
+	}
+	if !ok {
+		return nil, &rpc.Error{
+			Code:    errCodeMethodNotFound,
+			Message: "the method " + method + " does not exist",
+		}
+	}
+
+	result, err := handler(params)
+	if err != nil {
+		rpcErr := rpc.Error{Code: errCodeInternal, Message: err.Error()}
+		errors.As(err, &rpcErr)
+		return nil, &rpcErr
+	}
+	bz, err := json.Marshal(result)
+	if err != nil {
+		return nil, &rpc.Error{Code: errCodeInternal, Message: err.Error()}
+	}
+	return bz, nil
+}
+
+// defaultHandler returns the built-in handler for the given method.
+func (e *Engine) defaultHandler(method string) (HandlerFunc, bool) {
+	switch method {
+	case "eth_chainId":
+		return func([]json.RawMessage) (any, error) {
+			return math.U64(e.chainID), nil
+		}, true
+	case ethclient.ExchangeCapabilities:
+		return func([]json.RawMessage) (any, error) {
+			return ethclient.BeaconKitSupportedCapabilities(), nil
+		}, true
+	case ethclient.GetClientVersionV1:
+		return func([]json.RawMessage) (any, error) {
+			return []engineprimitives.ClientVersionV1{{
+				Code: "SM", Name: "simulated", Version: "v0.0.0",
+			}}, nil
+		}, true
+	case ethclient.SyncingMethod:
+		return func([]json.RawMessage) (any, error) {
+			return false, nil
+		}, true
+	case "eth_getLogs":
+		return func([]json.RawMessage) (any, error) {
+			return []gethprimitives.Log{}, nil
+		}, true
+	case ethclient.BlockByNumberMethod:
+		return e.blockByNumber, true
+	case ethclient.BlockByHashMethod:
+		return e.blockByHash, true
+	case ethclient.NewPayloadMethodV3:
+		return e.newPayload, true
+	case ethclient.ForkchoiceUpdatedMethodV3:
+		return e.forkchoiceUpdated, true
+	case ethclient.GetPayloadMethodV3:
+		return e.getPayload, true
+	default:
+		return nil, false
+	}
+}
+
+// newPayload validates the block hash of the payload and stores it if its
+// parent is known.
+func (e *Engine) newPayload(params []json.RawMessage) (any, error) {
+	var (
+		payload         types.ExecutionPayload
+		parentBlockRoot common.Root
+	)
+	//nolint:mnd // payload, versioned hashes, parent block root.
+	if len(params) != 3 {
+		return nil, invalidParams("expected 3 params")
+	}
+	if err := json.Unmarshal(params[0], &payload); err != nil {
+		return nil, invalidParams(err.Error())
+	}
+	if err := json.Unmarshal(params[2], &parentBlockRoot); err != nil {
+		return nil, invalidParams(err.Error())
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.status != "" {
+		return e.payloadStatus(e.status, payload.BlockHash), nil
+	}
+	if BlockHash(&payload, parentBlockRoot) != payload.BlockHash {
+		status := e.payloadStatus(
+			engineprimitives.PayloadStatusInvalid, payload.ParentHash,
+		)
+		msg := "invalid block hash"
+		status.ValidationError = &msg
+		return status, nil
+	}
+	if _, ok := e.blocks[payload.ParentHash]; !ok {
+		return e.payloadStatus(
+			engineprimitives.PayloadStatusSyncing, common.ExecutionHash{},
+		), nil
+	}
+	e.blocks[payload.BlockHash] = &block{
+		payload:         &payload,
+		parentBlockRoot: parentBlockRoot,
+	}
+	return e.payloadStatus(
+		engineprimitives.PayloadStatusValid, payload.BlockHash,
+	), nil
+}
+
+// forkchoiceUpdated moves the head of the engine and, if attributes are
+// given, builds a payload on top of it.
+func (e *Engine) forkchoiceUpdated(params []json.RawMessage) (any, error) {
+	var (
+		state engineprimitives.ForkchoiceStateV1
+		attrs *engineprimitives.PayloadAttributes[*engineprimitives.Withdrawal]
+	)
+	if len(params) == 0 {
+		return nil, invalidParams("missing forkchoice state")
+	}
+	if err := json.Unmarshal(params[0], &state); err != nil {
+		return nil, invalidParams(err.Error())
+	}
+	if len(params) > 1 {
+		if err := json.Unmarshal(params[1], &attrs); err != nil {
+			return nil, invalidParams(err.Error())
+		}
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.status != "" {
+		return &engineprimitives.ForkchoiceResponseV1{
+			PayloadStatus: *e.payloadStatus(e.status, state.HeadBlockHash),
+		}, nil
+	}
+	parent, ok := e.blocks[state.HeadBlockHash]
+	if !ok {
+		return &engineprimitives.ForkchoiceResponseV1{
+			PayloadStatus: *e.payloadStatus(
+				engineprimitives.PayloadStatusSyncing,
+				common.ExecutionHash{},
+			),
+		}, nil
+	}
+	e.head = state.HeadBlockHash
+
+	resp := &engineprimitives.ForkchoiceResponseV1{
+		PayloadStatus: *e.payloadStatus(
+			engineprimitives.PayloadStatusValid, state.HeadBlockHash,
+		),
+	}
+	if attrs != nil {
+		id := e.build(parent.payload, attrs)
+		resp.PayloadID = &id
+	}
+	return resp, nil
+}
+
+// getPayload returns a payload previously built by forkchoiceUpdated.
+func (e *Engine) getPayload(params []json.RawMessage) (any, error) {
+	var id engineprimitives.PayloadID
+	if len(params) != 1 {
+		return nil, invalidParams("expected 1 param")
+	}
+	if err := json.Unmarshal(params[0], &id); err != nil {
+		return nil, invalidParams(err.Error())
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	built, ok := e.building[id]
+	if !ok {
+		return nil, rpc.Error{
+			Code: errCodeUnknownPayload, Message: "Unknown payload",
+		}
+	}
+	return &engineprimitives.ExecutionPayloadEnvelope[
+		*types.ExecutionPayload, *blobsBundle,
+	]{
+		ExecutionPayload: built.payload,
+		BlockValue:       math.NewU256(0),
+		BlobsBundle: &blobsBundle{
+			Commitments: []eip4844.KZGCommitment{},
+			Proofs:      []eip4844.KZGProof{},
+			Blobs:       []*eip4844.Blob{},
+		},
+	}, nil
+}
+
+// blockByNumber returns the header of the head or genesis payload.
+func (e *Engine) blockByNumber(params []json.RawMessage) (any, error) {
+	var tag string
+	if len(params) == 0 {
+		return nil, invalidParams("missing block number")
+	}
+	if err := json.Unmarshal(params[0], &tag); err != nil {
+		return nil, invalidParams(err.Error())
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	switch tag {
+	case "latest", "safe", "finalized", "pending":
+		return e.blocks[e.head].header(), nil
+	case "earliest":
+		return e.blocks[e.genesis].header(), nil
+	}
+	number, err := hexutil.DecodeUint64(tag)
+	if err != nil {
+		return nil, invalidParams(err.Error())
+	}
+	for _, b := range e.blocks {
+		if b.payload.Number.Unwrap() == number {
+			return b.header(), nil
+		}
+	}
+	return nil, nil //nolint:nilnil // not found is a null result.
+}
+
+// blockByHash returns the header of the payload with the given hash.
+func (e *Engine) blockByHash(params []json.RawMessage) (any, error) {
+	var hash common.ExecutionHash
+	if len(params) == 0 {
+		return nil, invalidParams("missing block hash")
+	}
+	if err := json.Unmarshal(params[0], &hash); err != nil {
+		return nil, invalidParams(err.Error())
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	b, ok := e.blocks[hash]
+	if !ok {
+		return nil, nil //nolint:nilnil // not found is a null result.
+	}
+	return b.header(), nil
+}
+
+// build assembles and seals a payload on top of the given parent. It must be
+// called with the lock held.
+func (e *Engine) build(
+	parent *types.ExecutionPayload,
+	attrs *engineprimitives.PayloadAttributes[*engineprimitives.Withdrawal],
+) engineprimitives.PayloadID {
+	withdrawals := attrs.Withdrawals
+	if withdrawals == nil {
+		withdrawals = []*engineprimitives.Withdrawal{}
+	}
+	payload := &types.ExecutionPayload{
+		ParentHash:    parent.BlockHash,
+		FeeRecipient:  attrs.SuggestedFeeRecipient,
+		StateRoot:     parent.StateRoot,
+		Random:        attrs.PrevRandao,
+		Number:        parent.Number + 1,
+		GasLimit:      parent.GasLimit,
+		Timestamp:     attrs.Timestamp,
+		BaseFeePerGas: math.NewU256(defaultBaseFee),
+		Transactions:  engineprimitives.Transactions{},
+		Withdrawals:   withdrawals,
+	}
+	if e.mutate != nil {
+		e.mutate(payload)
+	}
+	payload.BlockHash = BlockHash(payload, attrs.ParentBeaconBlockRoot)
+
+	var id engineprimitives.PayloadID
+	e.nextPayloadID++
+	binary.BigEndian.PutUint64(id[:], e.nextPayloadID)
+	e.building[id] = &block{
+		payload:         payload,
+		parentBlockRoot: attrs.ParentBeaconBlockRoot,
+	}
+	return id
+}
+
+// payloadStatus returns a payload status with the given latest valid hash.
+func (*Engine) payloadStatus(
+	status engineprimitives.PayloadStatusStr,
+	latestValidHash common.ExecutionHash,
+) *engineprimitives.PayloadStatusV1 {
+	resp := &engineprimitives.PayloadStatusV1{Status: status}
+	if latestValidHash != (common.ExecutionHash{}) {
+		resp.LatestValidHash = &latestValidHash
+	}
+	return resp
+}
+
+// header returns the execution header of the block.
+func (b *block) header() *gethprimitives.Header {
+	return header(b.payload, b.parentBlockRoot)
+}
+
+// BlockHash computes the execution block hash of the payload, as done by the
+// consensus layer when verifying it.
+func BlockHash(
+	payload *types.ExecutionPayload,
+	parentBlockRoot common.Root,
+) common.ExecutionHash {
+	return common.ExecutionHash(header(payload, parentBlockRoot).Hash())
+}
+
+// header returns the execution header committed to by the payload.
+func header(
+	payload *types.ExecutionPayload,
+	parentBlockRoot common.Root,
+) *gethprimitives.Header {
+	withdrawalsHash := gethprimitives.ExecutionHash(
+		engineprimitives.DeriveTrieRoot(payload.GetWithdrawals()),
+	)
+	return &gethprimitives.Header{
+		ParentHash: gethprimitives.ExecutionHash(payload.ParentHash),
+		UncleHash:  gethprimitives.EmptyUncleHash,
+		Coinbase:   gethprimitives.ExecutionAddress(payload.FeeRecipient),
+		Root:       gethprimitives.ExecutionHash(payload.StateRoot),
+		TxHash: gethprimitives.ExecutionHash(
+			payload.Transactions.TrieRoot(),
+		),
+		ReceiptHash:      gethprimitives.ExecutionHash(payload.ReceiptsRoot),
+		Bloom:            gethprimitives.LogsBloom(payload.LogsBloom),
+		Difficulty:       big.NewInt(0),
+		Number:           new(big.Int).SetUint64(payload.Number.Unwrap()),
+		GasLimit:         payload.GasLimit.Unwrap(),
+		GasUsed:          payload.GasUsed.Unwrap(),
+		Time:             payload.Timestamp.Unwrap(),
+		BaseFee:          payload.BaseFeePerGas.ToBig(),
+		Extra:            payload.GetExtraData(),
+		MixDigest:        gethprimitives.ExecutionHash(payload.Random),
+		WithdrawalsHash:  &withdrawalsHash,
+		ExcessBlobGas:    payload.ExcessBlobGas.UnwrapPtr(),
+		BlobGasUsed:      payload.BlobGasUsed.UnwrapPtr(),
+		ParentBeaconRoot: (*gethprimitives.ExecutionHash)(&parentBlockRoot),
+	}
+}
+
+// invalidParams returns a JSON-RPC invalid params error.
+func invalidParams(msg string) error {
+	return rpc.Error{Code: errCodeInvalidParams, Message: msg}
+}
@@ -0,0 +1,194 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package simulated_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	engineerrors "github.com/berachain/beacon-kit/engine-primitives/errors"
+	"github.com/berachain/beacon-kit/execution/client"
+	"github.com/berachain/beacon-kit/execution/client/ethclient"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/net/jwt"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/berachain/beacon-kit/testing/simulated"
+	"github.com/stretchr/testify/require"
+)
+
+const testChainID = 80087
+
+type (
+	withdrawal        = engineprimitives.Withdrawal
+	payloadAttributes = engineprimitives.PayloadAttributes[*withdrawal]
+	engineClient      = client.EngineClient[
+		*types.ExecutionPayload, *payloadAttributes,
+	]
+)
+
+// newEngineClient returns a started engine client connected to the engine.
+func newEngineClient(
+	t *testing.T,
+	ctx context.Context,
+	engine *simulated.Engine,
+) *engineClient {
+	t.Helper()
+	cfg, err := engine.ClientConfig()
+	require.NoError(t, err)
+	secret, err := jwt.NewRandom()
+	require.NoError(t, err)
+
+	c := client.New[*types.ExecutionPayload, *payloadAttributes](
+		&cfg,
+		noop.NewLogger[any](),
+		secret,
+		metrics.NewNoOpTelemetrySink(),
+		big.NewInt(testChainID),
+		nil,
+	)
+	require.NoError(t, c.Start(ctx))
+	return c
+}
+
+// buildPayload builds a payload on top of the given head.
+func buildPayload(
+	ctx context.Context,
+	c *engineClient,
+	head common.ExecutionHash,
+	timestamp uint64,
+	parentBlockRoot common.Root,
+) (*types.ExecutionPayload, error) {
+	attrs, err := (&payloadAttributes{}).New(
+		version.Deneb,
+		timestamp,
+		common.Bytes32{0x01},
+		common.ExecutionAddress{0x02},
+		[]*engineprimitives.Withdrawal{{Index: 1, Amount: 10}},
+		parentBlockRoot,
+	)
+	if err != nil {
+		return nil, err
+	}
+	id, _, err := c.ForkchoiceUpdated(
+		ctx, forkchoice(head), attrs, version.Deneb,
+	)
+	if err != nil {
+		return nil, err
+	}
+	env, err := c.GetPayload(ctx, *id, version.Deneb)
+	if err != nil {
+		return nil, err
+	}
+	return env.GetExecutionPayload(), nil
+}
+
+func forkchoice(head common.ExecutionHash) *engineprimitives.ForkchoiceStateV1 {
+	return &engineprimitives.ForkchoiceStateV1{
+		HeadBlockHash:      head,
+		SafeBlockHash:      head,
+		FinalizedBlockHash: head,
+	}
+}
+
+func TestEngine_BuildsChain(t *testing.T) {
+	ctx := context.Background()
+	engine := simulated.NewEngine(testChainID)
+	defer engine.Close()
+	c := newEngineClient(t, ctx, engine)
+
+	head := engine.Genesis().GetBlockHash()
+	for i := range uint64(3) {
+		root := common.Root{byte(i + 1)}
+		payload, err := buildPayload(ctx, c, head, 10*(i+1), root)
+		require.NoError(t, err)
+		require.Equal(t, head, payload.GetParentHash())
+		require.Equal(t, i+1, payload.GetNumber().Unwrap())
+
+		// The consensus layer accepts the block hash of the payload.
+		req := engineprimitives.BuildNewPayloadRequest[
+			*types.ExecutionPayload,
+			*engineprimitives.Withdrawal,
+			engineprimitives.Withdrawals,
+		](payload, nil, &root, false)
+		require.NoError(t, req.HasValidVersionedAndBlockHashes())
+
+		valid, err := c.NewPayload(ctx, payload, nil, &root)
+		require.NoError(t, err)
+		require.Equal(t, payload.GetBlockHash(), *valid)
+
+		head = payload.GetBlockHash()
+		_, _, err = c.ForkchoiceUpdated(
+			ctx, forkchoice(head), (*payloadAttributes)(nil), version.Deneb,
+		)
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, head, engine.Head().GetBlockHash())
+	require.Equal(t, 3, engine.Calls(ethclient.NewPayloadMethodV3))
+	require.Equal(t, 3, engine.Calls(ethclient.GetPayloadMethodV3))
+	require.Equal(t, 6, engine.Calls(ethclient.ForkchoiceUpdatedMethodV3))
+
+	header, err := c.HeaderByNumber(ctx, nil)
+	require.NoError(t, err)
+	require.Equal(t, head, common.ExecutionHash(header.Hash()))
+}
+
+func TestEngine_Scripting(t *testing.T) {
+	ctx := context.Background()
+	engine := simulated.NewEngine(testChainID)
+	defer engine.Close()
+	c := newEngineClient(t, ctx, engine)
+
+	root := common.Root{0x01}
+	payload, err := buildPayload(
+		ctx, c, engine.Genesis().GetBlockHash(), 10, root,
+	)
+	require.NoError(t, err)
+
+	// A payload whose block hash does not match its content is invalid.
+	tampered := *payload
+	tampered.GasUsed++
+	_, err = c.NewPayload(ctx, &tampered, nil, &root)
+	require.ErrorIs(t, err, engineerrors.ErrInvalidPayloadStatus)
+
+	// The status can be forced, e.g. to simulate a syncing client.
+	engine.SetPayloadStatus(engineprimitives.PayloadStatusSyncing)
+	_, err = c.NewPayload(ctx, payload, nil, &root)
+	require.ErrorIs(t, err, engineerrors.ErrSyncingPayloadStatus)
+	engine.SetPayloadStatus("")
+
+	// Payloads can be altered before they are sealed.
+	engine.SetPayloadMutator(func(p *types.ExecutionPayload) {
+		p.ExtraData = []byte("simulated")
+	})
+	mutated, err := buildPayload(
+		ctx, c, engine.Genesis().GetBlockHash(), 10, root,
+	)
+	require.NoError(t, err)
+	require.Equal(t, []byte("simulated"), mutated.GetExtraData())
+	_, err = c.NewPayload(ctx, mutated, nil, &root)
+	require.NoError(t, err)
+}
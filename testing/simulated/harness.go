@@ -0,0 +1,251 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package simulated
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"time"
+
+	"github.com/berachain/beacon-kit/errors"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+)
+
+const (
+	// defaultBlockTime is the default time between two simulated blocks.
+	defaultBlockTime = 2 * time.Second
+	// defaultMaxTxBytes is the default byte budget of a proposal.
+	defaultMaxTxBytes = 100 * 1024 * 1024
+)
+
+// ErrProposalRejected is returned when the application rejects a proposal.
+var ErrProposalRejected = errors.New("proposal rejected")
+
+// Application is the set of ABCI methods the harness drives. It is satisfied
+// by the CometBFT service of a beacon node.
+type Application interface {
+	InitChain(
+		context.Context, *cmtabci.InitChainRequest,
+	) (*cmtabci.InitChainResponse, error)
+	PrepareProposal(
+		context.Context, *cmtabci.PrepareProposalRequest,
+	) (*cmtabci.PrepareProposalResponse, error)
+	ProcessProposal(
+		context.Context, *cmtabci.ProcessProposalRequest,
+	) (*cmtabci.ProcessProposalResponse, error)
+	FinalizeBlock(
+		context.Context, *cmtabci.FinalizeBlockRequest,
+	) (*cmtabci.FinalizeBlockResponse, error)
+	Commit(
+		context.Context, *cmtabci.CommitRequest,
+	) (*cmtabci.CommitResponse, error)
+}
+
+// Block is the outcome of a simulated block.
+type Block struct {
+	// Height is the height of the block.
+	Height int64
+	// Time is the time of the block.
+	Time time.Time
+	// Txs are the transactions of the block, as finalized.
+	Txs [][]byte
+	// Result is the response of FinalizeBlock.
+	Result *cmtabci.FinalizeBlockResponse
+}
+
+// HarnessOption configures a Harness.
+type HarnessOption func(*Harness)
+
+// WithProposer sets the consensus address proposing every block.
+func WithProposer(address []byte) HarnessOption {
+	return func(h *Harness) {
+		h.proposer = address
+	}
+}
+
+// WithGenesisTime sets the time of the genesis.
+func WithGenesisTime(t time.Time) HarnessOption {
+	return func(h *Harness) {
+		h.time = t
+	}
+}
+
+// WithBlockTime sets the time between two blocks.
+func WithBlockTime(d time.Duration) HarnessOption {
+	return func(h *Harness) {
+		h.blockTime = d
+	}
+}
+
+// WithProposalHook sets a function which may rewrite the prepared proposal
+// before it is processed and finalized, e.g. to simulate a byzantine
+// proposer.
+func WithProposalHook(
+	hook func(height int64, txs [][]byte) [][]byte,
+) HarnessOption {
+	return func(h *Harness) {
+		h.proposalHook = hook
+	}
+}
+
+// Harness plays the role of CometBFT for a single validator network: it
+// drives an application through the ABCI calls of consecutive heights
+// without running consensus or networking.
+type Harness struct {
+	app     Application
+	chainID string
+
+	proposer     []byte
+	blockTime    time.Duration
+	proposalHook func(height int64, txs [][]byte) [][]byte
+
+	// height is the height of the last committed block.
+	height int64
+	// time is the time of the last committed block.
+	time time.Time
+}
+
+// NewHarness returns a harness driving the given application.
+func NewHarness(
+	app Application,
+	chainID string,
+	opts ...HarnessOption,
+) *Harness {
+	h := &Harness{
+		app:       app,
+		chainID:   chainID,
+		blockTime: defaultBlockTime,
+		time:      time.Now().UTC().Truncate(time.Second),
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
+}
+
+// Height returns the height of the last committed block.
+func (h *Harness) Height() int64 {
+	return h.height
+}
+
+// InitChain initializes the application with the given genesis app state,
+// keyed by module as in a CometBFT genesis file.
+func (h *Harness) InitChain(
+	ctx context.Context,
+	appState []byte,
+) (*cmtabci.InitChainResponse, error) {
+	return h.app.InitChain(ctx, &cmtabci.InitChainRequest{
+		Time:          h.time,
+		ChainId:       h.chainID,
+		AppStateBytes: appState,
+		InitialHeight: 1,
+	})
+}
+
+// ProduceBlock proposes, processes, finalizes and commits the next block.
+// ErrProposalRejected is returned if the application rejects the proposal,
+// in which case the height is not advanced.
+func (h *Harness) ProduceBlock(ctx context.Context) (*Block, error) {
+	height := h.height + 1
+	blockTime := h.time.Add(h.blockTime)
+
+	prepared, err := h.app.PrepareProposal(
+		ctx, &cmtabci.PrepareProposalRequest{
+			MaxTxBytes:      defaultMaxTxBytes,
+			Height:          height,
+			Time:            blockTime,
+			ProposerAddress: h.proposer,
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "prepare proposal at %d", height)
+	}
+
+	txs := prepared.GetTxs()
+	if h.proposalHook != nil {
+		txs = h.proposalHook(height, txs)
+	}
+	hash := proposalHash(height, txs)
+
+	processed, err := h.app.ProcessProposal(
+		ctx, &cmtabci.ProcessProposalRequest{
+			Txs:             txs,
+			Hash:            hash,
+			Height:          height,
+			Time:            blockTime,
+			ProposerAddress: h.proposer,
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "process proposal at %d", height)
+	}
+	if processed.GetStatus() != cmtabci.PROCESS_PROPOSAL_STATUS_ACCEPT {
+		return nil, errors.Wrapf(ErrProposalRejected, "at %d", height)
+	}
+
+	result, err := h.app.FinalizeBlock(ctx, &cmtabci.FinalizeBlockRequest{
+		Txs:             txs,
+		Hash:            hash,
+		Height:          height,
+		Time:            blockTime,
+		ProposerAddress: h.proposer,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "finalize block at %d", height)
+	}
+	if _, err = h.app.Commit(ctx, &cmtabci.CommitRequest{}); err != nil {
+		return nil, errors.Wrapf(err, "commit at %d", height)
+	}
+
+	h.height = height
+	h.time = blockTime
+	return &Block{
+		Height: height,
+		Time:   blockTime,
+		Txs:    txs,
+		Result: result,
+	}, nil
+}
+
+// ProduceBlocks produces n consecutive blocks, stopping at the first error.
+func (h *Harness) ProduceBlocks(ctx context.Context, n int) ([]*Block, error) {
+	blocks := make([]*Block, 0, n)
+	for range n {
+		blk, err := h.ProduceBlock(ctx)
+		if err != nil {
+			return blocks, err
+		}
+		blocks = append(blocks, blk)
+	}
+	return blocks, nil
+}
+
+// proposalHash derives a deterministic block hash for a proposal.
+func proposalHash(height int64, txs [][]byte) []byte {
+	hasher := sha256.New()
+	//nolint:gosec // heights are positive.
+	hasher.Write(binary.BigEndian.AppendUint64(nil, uint64(height)))
+	for _, tx := range txs {
+		hasher.Write(tx)
+	}
+	return hasher.Sum(nil)
+}
@@ -0,0 +1,127 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package simulated
+
+import (
+	"context"
+	"time"
+
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/execution/client"
+	"github.com/berachain/beacon-kit/primitives/net/url"
+)
+
+// clientStartupCheckInterval is the startup check interval of engine clients
+// dialing the mock engine, which is always up.
+const clientStartupCheckInterval = 100 * time.Millisecond
+
+// ErrUnexpectedBlock is returned when a block expected to be rejected is
+// accepted.
+var ErrUnexpectedBlock = errors.New("block unexpectedly accepted")
+
+// ClientConfig returns an engine client configuration dialing the engine.
+func (e *Engine) ClientConfig() (client.Config, error) {
+	cfg := client.DefaultConfig()
+	dialURL, err := url.NewFromRaw(e.URL())
+	if err != nil {
+		return cfg, err
+	}
+	cfg.RPCDialURL = dialURL
+	cfg.RPCStartupCheckInterval = clientStartupCheckInterval
+	return cfg, nil
+}
+
+// Step is a single step of a scenario.
+type Step func(ctx context.Context, s *Scenario) error
+
+// Scenario drives an application backed by a mock engine through a sequence
+// of steps, recording every committed block.
+type Scenario struct {
+	// Engine is the execution client of the application.
+	Engine *Engine
+	// Harness drives the application.
+	Harness *Harness
+	// Blocks are the blocks committed so far.
+	Blocks []*Block
+}
+
+// NewScenario returns a scenario over the given engine and harness.
+func NewScenario(engine *Engine, harness *Harness) *Scenario {
+	return &Scenario{
+		Engine:  engine,
+		Harness: harness,
+	}
+}
+
+// Run executes the steps in order, stopping at the first error.
+func (s *Scenario) Run(ctx context.Context, steps ...Step) error {
+	for i, step := range steps {
+		if err := step(ctx, s); err != nil {
+			return errors.Wrapf(err, "step %d", i)
+		}
+	}
+	return nil
+}
+
+// ProduceBlocks is a step producing n consecutive blocks.
+func ProduceBlocks(n int) Step {
+	return func(ctx context.Context, s *Scenario) error {
+		blocks, err := s.Harness.ProduceBlocks(ctx, n)
+		s.Blocks = append(s.Blocks, blocks...)
+		return err
+	}
+}
+
+// ExpectRejection is a step expecting the next block to be rejected.
+func ExpectRejection() Step {
+	return func(ctx context.Context, s *Scenario) error {
+		blk, err := s.Harness.ProduceBlock(ctx)
+		switch {
+		case errors.Is(err, ErrProposalRejected):
+			return nil
+		case err != nil:
+			return err
+		default:
+			s.Blocks = append(s.Blocks, blk)
+			return errors.Wrapf(ErrUnexpectedBlock, "at %d", blk.Height)
+		}
+	}
+}
+
+// WithPayloadStatus is a step running the given steps while the engine
+// answers every newPayload and forkchoiceUpdated call with status.
+func WithPayloadStatus(
+	status engineprimitives.PayloadStatusStr,
+	steps ...Step,
+) Step {
+	return func(ctx context.Context, s *Scenario) error {
+		s.Engine.SetPayloadStatus(status)
+		defer s.Engine.SetPayloadStatus("")
+		return s.Run(ctx, steps...)
+	}
+}
+
+// Do is a step running an arbitrary function, e.g. to make assertions
+// between blocks.
+func Do(fn func(ctx context.Context, s *Scenario) error) Step {
+	return fn
+}
@@ -0,0 +1,202 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package simulated_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	cometbft "github.com/berachain/beacon-kit/consensus/cometbft/service"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/execution/client/ethclient"
+	"github.com/berachain/beacon-kit/log/phuslu"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/berachain/beacon-kit/testing/simulated"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/require"
+)
+
+// The CometBFT service of a beacon node can be driven by the harness.
+var _ simulated.Application = (*cometbft.Service[*phuslu.Logger])(nil)
+
+// payloadApp is a minimal consensus client: each block carries a single
+// execution payload, built by the proposer and verified by the engine.
+type payloadApp struct {
+	client *engineClient
+	head   common.ExecutionHash
+}
+
+func (a *payloadApp) InitChain(
+	context.Context, *cmtabci.InitChainRequest,
+) (*cmtabci.InitChainResponse, error) {
+	return &cmtabci.InitChainResponse{}, nil
+}
+
+func (a *payloadApp) PrepareProposal(
+	ctx context.Context, req *cmtabci.PrepareProposalRequest,
+) (*cmtabci.PrepareProposalResponse, error) {
+	//nolint:gosec // test timestamps are positive.
+	payload, err := buildPayload(
+		ctx, a.client, a.head, uint64(req.GetTime().Unix()),
+		blockRoot(req.GetHeight()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	bz, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	return &cmtabci.PrepareProposalResponse{Txs: [][]byte{bz}}, nil
+}
+
+func (a *payloadApp) ProcessProposal(
+	ctx context.Context, req *cmtabci.ProcessProposalRequest,
+) (*cmtabci.ProcessProposalResponse, error) {
+	reject := &cmtabci.ProcessProposalResponse{
+		Status: cmtabci.PROCESS_PROPOSAL_STATUS_REJECT,
+	}
+	payload, err := decodePayload(req.GetTxs())
+	if err != nil {
+		//nolint:nilerr // invalid proposals are rejected.
+		return reject, nil
+	}
+	root := blockRoot(req.GetHeight())
+	if _, err = a.client.NewPayload(ctx, payload, nil, &root); err != nil {
+		//nolint:nilerr // invalid proposals are rejected.
+		return reject, nil
+	}
+	return &cmtabci.ProcessProposalResponse{
+		Status: cmtabci.PROCESS_PROPOSAL_STATUS_ACCEPT,
+	}, nil
+}
+
+func (a *payloadApp) FinalizeBlock(
+	ctx context.Context, req *cmtabci.FinalizeBlockRequest,
+) (*cmtabci.FinalizeBlockResponse, error) {
+	payload, err := decodePayload(req.GetTxs())
+	if err != nil {
+		return nil, err
+	}
+	a.head = payload.GetBlockHash()
+	if _, _, err = a.client.ForkchoiceUpdated(
+		ctx, forkchoice(a.head), (*payloadAttributes)(nil), version.Deneb,
+	); err != nil {
+		return nil, err
+	}
+	return &cmtabci.FinalizeBlockResponse{AppHash: a.head[:]}, nil
+}
+
+func (a *payloadApp) Commit(
+	context.Context, *cmtabci.CommitRequest,
+) (*cmtabci.CommitResponse, error) {
+	return &cmtabci.CommitResponse{}, nil
+}
+
+func decodePayload(txs [][]byte) (*types.ExecutionPayload, error) {
+	payload := &types.ExecutionPayload{}
+	if len(txs) != 1 {
+		return nil, simulated.ErrProposalRejected
+	}
+	return payload, json.Unmarshal(txs[0], payload)
+}
+
+func blockRoot(height int64) common.Root {
+	return common.Root{byte(height)}
+}
+
+func TestScenario(t *testing.T) {
+	ctx := context.Background()
+	engine := simulated.NewEngine(testChainID)
+	defer engine.Close()
+
+	app := &payloadApp{
+		client: newEngineClient(t, ctx, engine),
+		head:   engine.Genesis().GetBlockHash(),
+	}
+	corrupt := false
+	harness := simulated.NewHarness(
+		app, "simulated",
+		simulated.WithProposer([]byte{0x01}),
+		simulated.WithProposalHook(func(_ int64, txs [][]byte) [][]byte {
+			if corrupt {
+				return [][]byte{[]byte("garbage")}
+			}
+			return txs
+		}),
+	)
+	_, err := harness.InitChain(ctx, []byte("{}"))
+	require.NoError(t, err)
+
+	scenario := simulated.NewScenario(engine, harness)
+	require.NoError(t, scenario.Run(ctx,
+		simulated.ProduceBlocks(3),
+		// The engine rejects the next payload.
+		simulated.Do(func(context.Context, *simulated.Scenario) error {
+			engine.Handle(
+				ethclient.NewPayloadMethodV3,
+				func([]json.RawMessage) (any, error) {
+					return &engineprimitives.PayloadStatusV1{
+						Status: engineprimitives.PayloadStatusInvalid,
+					}, nil
+				},
+			)
+			return nil
+		}),
+		simulated.ExpectRejection(),
+		simulated.Do(func(context.Context, *simulated.Scenario) error {
+			engine.Handle(ethclient.NewPayloadMethodV3, nil)
+			return nil
+		}),
+		// A byzantine proposer is rejected as well.
+		simulated.Do(func(context.Context, *simulated.Scenario) error {
+			corrupt = true
+			return nil
+		}),
+		simulated.ExpectRejection(),
+		simulated.Do(func(context.Context, *simulated.Scenario) error {
+			corrupt = false
+			return nil
+		}),
+		simulated.ProduceBlocks(2),
+	))
+
+	require.Len(t, scenario.Blocks, 5)
+	require.Equal(t, int64(5), harness.Height())
+	for i, blk := range scenario.Blocks {
+		require.Equal(t, int64(i+1), blk.Height)
+	}
+	last := scenario.Blocks[len(scenario.Blocks)-1]
+	head := engine.Head().GetBlockHash()
+	require.Equal(t, head[:], last.Result.AppHash)
+	require.Equal(t, uint64(5), engine.Head().GetNumber().Unwrap())
+
+	// A syncing engine halts block production.
+	err = scenario.Run(ctx, simulated.WithPayloadStatus(
+		engineprimitives.PayloadStatusSyncing,
+		simulated.ProduceBlocks(1),
+	))
+	require.Error(t, err)
+	require.Equal(t, int64(5), harness.Height())
+}